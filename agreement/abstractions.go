@@ -245,6 +245,16 @@ type KeyManager interface {
 	Record(account basics.Address, round basics.Round, participationType account.ParticipationAction)
 }
 
+// A SigningGuard protects the participation keys of redundant nodes against equivocation.
+// The pseudonode consults it before signing each vote, and does not sign the vote if it
+// returns an error.
+type SigningGuard interface {
+	// GuardVote records that the account signs a vote for the proposal value with the given
+	// digest in the round, period and step. It returns an error if a vote for a different
+	// value was already signed in the same round, period and step, or if it is unable to tell.
+	GuardVote(account basics.Address, round basics.Round, period uint64, step uint64, value crypto.Digest) error
+}
+
 // MessageHandle is an ID referring to a specific message.
 //
 // A MessageHandle of nil denotes that a message is "sourceless".
@@ -22,6 +22,8 @@ import (
 	"context"
 	"time"
 
+	"go.opentelemetry.io/otel/trace"
+
 	"github.com/algorand/go-algorand/agreement"
 	"github.com/algorand/go-algorand/config"
 	"github.com/algorand/go-algorand/logging"
@@ -58,6 +60,9 @@ type networkImpl struct {
 	log logging.Logger
 
 	trace messagetracer.MessageTracer
+
+	// validator validates the messages received over gossip topics, before they are propagated
+	validator *agreement.GossipValidator
 }
 
 // WrapNetwork adapts a network.GossipNode into an agreement.Network.
@@ -80,6 +85,13 @@ func SetTrace(net agreement.Network, trace messagetracer.MessageTracer) {
 	i.trace = trace
 }
 
+// SetValidator modifies the result of WrapNetwork to validate the agreement messages received over
+// gossip topics, before they are propagated to other peers.
+func SetValidator(net agreement.Network, validator *agreement.GossipValidator) {
+	i := net.(*networkImpl)
+	i.validator = validator
+}
+
 func (i *networkImpl) Start() {
 	handlers := []network.TaggedMessageHandler{
 		{Tag: protocol.AgreementVoteTag, MessageHandler: network.HandlerFunc(i.processVoteMessage)},
@@ -87,6 +99,15 @@ func (i *networkImpl) Start() {
 		{Tag: protocol.VoteBundleTag, MessageHandler: network.HandlerFunc(i.processBundleMessage)},
 	}
 	i.net.RegisterHandlers(handlers)
+
+	if i.validator != nil {
+		validatorHandlers := []network.TaggedMessageValidatorHandler{
+			{Tag: protocol.AgreementVoteTag, MessageHandler: network.ValidateHandleFunc(i.validateVoteMessage)},
+			{Tag: protocol.ProposalPayloadTag, MessageHandler: network.ValidateHandleFunc(i.validateProposalMessage)},
+			{Tag: protocol.VoteBundleTag, MessageHandler: network.ValidateHandleFunc(i.validateBundleMessage)},
+		}
+		i.net.RegisterValidatorHandlers(validatorHandlers)
+	}
 }
 
 func messageMetadataFromHandle(h agreement.MessageHandle) *messageMetadata {
@@ -131,6 +152,37 @@ func (i *networkImpl) processMessage(raw network.IncomingMessage, submit chan<-
 	return network.OutgoingMessage{Action: network.Ignore}
 }
 
+func (i *networkImpl) validateVoteMessage(raw network.IncomingMessage) network.OutgoingMessage {
+	return i.validateMessage(raw, i.voteCh, agreementVoteMessageType)
+}
+
+func (i *networkImpl) validateProposalMessage(raw network.IncomingMessage) network.OutgoingMessage {
+	if i.trace != nil {
+		i.trace.HashTrace(messagetracer.Proposal, raw.Data)
+	}
+	return i.validateMessage(raw, i.proposalCh, agreementProposalMessageType)
+}
+
+func (i *networkImpl) validateBundleMessage(raw network.IncomingMessage) network.OutgoingMessage {
+	return i.validateMessage(raw, i.bundleCh, agreementBundleMessageType)
+}
+
+// validateMessage validates a message received over a gossip topic, and only submits it to agreement
+// if valid. Propagating the message is then up to the network, according to the returned action.
+func (i *networkImpl) validateMessage(raw network.IncomingMessage, submit chan<- agreement.Message, msgType string) network.OutgoingMessage {
+	verdict, err := i.validator.Validate(context.Background(), raw.Tag, raw.Data)
+	switch verdict {
+	case agreement.GossipAccept:
+		i.processMessage(raw, submit, msgType)
+		return network.OutgoingMessage{Action: network.Accept}
+	case agreement.GossipReject:
+		i.log.Infof("agreement: rejecting %s message from %v: %v", msgType, raw.Sender, err)
+		return network.OutgoingMessage{Action: network.Disconnect}
+	default:
+		return network.OutgoingMessage{Action: network.Ignore}
+	}
+}
+
 func (i *networkImpl) Messages(t protocol.Tag) <-chan agreement.Message {
 	switch t {
 	case protocol.AgreementVoteTag:
@@ -161,7 +213,12 @@ func (i *networkImpl) Relay(h agreement.MessageHandle, t protocol.Tag, data []by
 			i.log.Infof("agreement: could not (pseudo)relay message with tag %v: %v", t, err)
 		}
 	} else {
-		err = i.net.Relay(context.Background(), t, data, false, metadata.raw.Sender)
+		// the span of the reception of the message, if traced, is the parent of the spans of its relay
+		ctx := context.Background()
+		if metadata.raw.SpanContext.IsValid() {
+			ctx = trace.ContextWithSpanContext(ctx, metadata.raw.SpanContext)
+		}
+		err = i.net.Relay(ctx, t, data, false, metadata.raw.Sender)
 		if err != nil {
 			i.log.Infof("agreement: could not relay message from %v with tag %v: %v", metadata.raw.Sender, t, err)
 		}
@@ -177,6 +234,10 @@ func (i *networkImpl) Disconnect(h agreement.MessageHandle) {
 		return
 	}
 
+	// the agreement disconnects from the peers which send votes it cannot decode or verify
+	if t := metadata.raw.Tag; t == protocol.AgreementVoteTag || t == protocol.VoteBundleTag {
+		i.net.ReportPeerEvent(metadata.raw.Sender, network.PeerEventInvalidVote)
+	}
 	i.net.Disconnect(metadata.raw.Sender)
 }
 
@@ -0,0 +1,135 @@
+// Copyright (C) 2019-2025 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package agreement
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/algorand/go-algorand/data/basics"
+	"github.com/algorand/go-algorand/protocol"
+)
+
+// GossipVerdict is the outcome of the validation of an agreement message received over a gossip topic.
+type GossipVerdict int
+
+const (
+	// GossipAccept indicates the message is valid, and should be delivered and propagated.
+	GossipAccept GossipVerdict = iota
+	// GossipIgnore indicates the message cannot be validated at this time, e.g. since it is for a round
+	// too far from the current one, and should be neither delivered nor propagated.
+	GossipIgnore
+	// GossipReject indicates the message is invalid, and the peer which sent it should be penalized.
+	GossipReject
+)
+
+// GossipValidator validates the agreement messages received over gossip topics before they are
+// propagated, using the same vote verification as the agreement service.
+type GossipValidator struct {
+	ledger   LedgerReader
+	verifier *AsyncVoteVerifier
+}
+
+// MakeGossipValidator creates a GossipValidator verifying the votes against the given ledger with the given verifier.
+func MakeGossipValidator(l LedgerReader, verifier *AsyncVoteVerifier) *GossipValidator {
+	return &GossipValidator{ledger: l, verifier: verifier}
+}
+
+// Validate validates an agreement vote, proposal payload or bundle. It blocks until the votes
+// the message carries are verified.
+func (gv *GossipValidator) Validate(ctx context.Context, tag protocol.Tag, data []byte) (GossipVerdict, error) {
+	switch tag {
+	case protocol.AgreementVoteTag:
+		var uv unauthenticatedVote
+		if err := protocol.Decode(data, &uv); err != nil {
+			return GossipReject, err
+		}
+		return gv.validateVote(ctx, uv)
+	case protocol.ProposalPayloadTag:
+		var tp transmittedPayload
+		if err := protocol.Decode(data, &tp); err != nil {
+			return GossipReject, err
+		}
+		if tp.PriorVote.R.Sender.IsZero() {
+			// the payload can not be attributed to a proposer without its vote
+			return GossipIgnore, errors.New("proposal payload without a proposal-vote")
+		}
+		if tp.Round() != tp.PriorVote.R.Round {
+			return GossipReject, fmt.Errorf("proposal payload for round %d carries a proposal-vote for round %d", tp.Round(), tp.PriorVote.R.Round)
+		}
+		verdict, err := gv.validateVote(ctx, tp.PriorVote)
+		if verdict != GossipAccept {
+			return verdict, err
+		}
+		if tp.PriorVote.R.Proposal != tp.unauthenticatedProposal.value() {
+			return GossipReject, errors.New("proposal payload does not match its proposal-vote")
+		}
+		return GossipAccept, nil
+	case protocol.VoteBundleTag:
+		var ub unauthenticatedBundle
+		if err := protocol.Decode(data, &ub); err != nil {
+			return GossipReject, err
+		}
+		if verdict, err := gv.checkRound(ub.Round); verdict != GossipAccept {
+			return verdict, err
+		}
+		if _, err := ub.verify(ctx, gv.ledger, gv.verifier); err != nil {
+			var dropped *LedgerDroppedRoundError
+			if errors.As(err, &dropped) || ctx.Err() != nil {
+				return GossipIgnore, err
+			}
+			return GossipReject, err
+		}
+		return GossipAccept, nil
+	}
+	return GossipReject, fmt.Errorf("unexpected agreement message tag %v", tag)
+}
+
+// checkRound ensures the round is one the ledger can verify votes for, and the agreement service
+// cares about: the previous, current, or next round.
+func (gv *GossipValidator) checkRound(rnd basics.Round) (GossipVerdict, error) {
+	next := gv.ledger.NextRound()
+	if rnd+1 < next || rnd > next+1 {
+		return GossipIgnore, fmt.Errorf("round %d is out of the validation range of %d-%d", rnd, next.SubSaturate(1), next+1)
+	}
+	return GossipAccept, nil
+}
+
+func (gv *GossipValidator) validateVote(ctx context.Context, uv unauthenticatedVote) (GossipVerdict, error) {
+	if verdict, err := gv.checkRound(uv.R.Round); verdict != GossipAccept {
+		return verdict, err
+	}
+	out := make(chan asyncVerifyVoteResponse, 1)
+	if err := gv.verifier.verifyVote(ctx, gv.ledger, uv, 0, message{}, out); err != nil {
+		return GossipIgnore, err
+	}
+	var res asyncVerifyVoteResponse
+	select {
+	case res = <-out:
+	case <-gv.verifier.ctx.Done():
+		// the verifier is quitting, and might not have enqueued the request
+		return GossipIgnore, gv.verifier.ctx.Err()
+	}
+	if res.cancelled {
+		return GossipIgnore, res.err
+	}
+	if res.err != nil {
+		return GossipReject, res.err
+	}
+	return GossipAccept, nil
+}
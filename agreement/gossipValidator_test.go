@@ -0,0 +1,142 @@
+// Copyright (C) 2019-2025 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package agreement
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/algorand/go-algorand/crypto"
+	"github.com/algorand/go-algorand/protocol"
+	"github.com/algorand/go-algorand/test/partitiontest"
+)
+
+func TestGossipValidatorVote(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	ledger, addresses, vrfSecrets, otSecrets := readOnlyFixture100()
+	avv := MakeAsyncVoteVerifier(nil)
+	defer avv.Quit()
+	gv := MakeGossipValidator(ledger, avv)
+	round := ledger.NextRound()
+
+	validated := false
+	for i, address := range addresses {
+		var proposal proposalValue
+		proposal.BlockDigest = randomBlockHash()
+		rv := rawVote{Sender: address, Round: round, Period: 0, Step: cert, Proposal: proposal}
+		uv, err := makeVote(rv, otSecrets[i], vrfSecrets[i], ledger)
+		require.NoError(t, err)
+		if _, err := uv.verify(ledger); err != nil {
+			// not selected
+			continue
+		}
+		validated = true
+
+		verdict, err := gv.Validate(context.Background(), protocol.AgreementVoteTag, protocol.Encode(&uv))
+		require.NoError(t, err)
+		require.Equal(t, GossipAccept, verdict)
+
+		noSig := uv
+		noSig.Sig = crypto.OneTimeSignature{}
+		verdict, err = gv.Validate(context.Background(), protocol.AgreementVoteTag, protocol.Encode(&noSig))
+		require.Error(t, err)
+		require.Equal(t, GossipReject, verdict)
+
+		future := uv
+		future.R.Round += 2
+		verdict, err = gv.Validate(context.Background(), protocol.AgreementVoteTag, protocol.Encode(&future))
+		require.Error(t, err)
+		require.Equal(t, GossipIgnore, verdict)
+		break
+	}
+	require.True(t, validated)
+
+	verdict, err := gv.Validate(context.Background(), protocol.AgreementVoteTag, []byte{0xff, 0x01})
+	require.Error(t, err)
+	require.Equal(t, GossipReject, verdict)
+
+	verdict, err = gv.Validate(context.Background(), protocol.TxnTag, nil)
+	require.Error(t, err)
+	require.Equal(t, GossipReject, verdict)
+}
+
+func TestGossipValidatorProposal(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	player, _, accs, factory, ledger := testSetup(0)
+	avv := MakeAsyncVoteVerifier(nil)
+	defer avv.Quit()
+	gv := MakeGossipValidator(ledger, avv)
+
+	ve, err := factory.AssembleBlock(player.Round, accs.addresses)
+	require.NoError(t, err)
+
+	validated := false
+	for i, address := range accs.addresses {
+		p, pv, err := proposalForBlock(address, accs.vrfs[i], ve, player.Period, ledger)
+		require.NoError(t, err)
+		rv := rawVote{Sender: address, Round: player.Round, Period: player.Period, Step: propose, Proposal: pv}
+		uv, err := makeVote(rv, accs.ots[i], accs.vrfs[i], ledger)
+		require.NoError(t, err)
+		if _, err := uv.verify(ledger); err != nil {
+			// not selected
+			continue
+		}
+		validated = true
+
+		tp := transmittedPayload{unauthenticatedProposal: p.u(), PriorVote: uv}
+		verdict, err := gv.Validate(context.Background(), protocol.ProposalPayloadTag, protocol.Encode(&tp))
+		require.NoError(t, err)
+		require.Equal(t, GossipAccept, verdict)
+
+		mismatch := tp
+		mismatch.PriorVote.R.Proposal.BlockDigest = randomBlockHash()
+		verdict, err = gv.Validate(context.Background(), protocol.ProposalPayloadTag, protocol.Encode(&mismatch))
+		require.Error(t, err)
+		require.Equal(t, GossipReject, verdict)
+
+		noVote := transmittedPayload{unauthenticatedProposal: p.u()}
+		verdict, err = gv.Validate(context.Background(), protocol.ProposalPayloadTag, protocol.Encode(&noVote))
+		require.Error(t, err)
+		require.Equal(t, GossipIgnore, verdict)
+		break
+	}
+	require.True(t, validated)
+}
+
+func TestGossipValidatorBundle(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	ledger, _, _, _ := readOnlyFixture10()
+	avv := MakeAsyncVoteVerifier(nil)
+	defer avv.Quit()
+	gv := MakeGossipValidator(ledger, avv)
+
+	// a bundle without votes is invalid
+	ub := unauthenticatedBundle{Round: ledger.NextRound(), Step: cert}
+	verdict, err := gv.Validate(context.Background(), protocol.VoteBundleTag, protocol.Encode(&ub))
+	require.Error(t, err)
+	require.Equal(t, GossipReject, verdict)
+
+	ub.Round += 10
+	verdict, err = gv.Validate(context.Background(), protocol.VoteBundleTag, protocol.Encode(&ub))
+	require.Error(t, err)
+	require.Equal(t, GossipIgnore, verdict)
+}
@@ -23,6 +23,7 @@ import (
 	"sync"
 	"time"
 
+	"github.com/algorand/go-algorand/crypto"
 	"github.com/algorand/go-algorand/data/account"
 	"github.com/algorand/go-algorand/data/basics"
 	"github.com/algorand/go-algorand/logging"
@@ -83,6 +84,7 @@ type asyncPseudonode struct {
 	monitor                *coserviceMonitor
 	participationKeysRound basics.Round                          // the round to which the participationKeys matches
 	participationKeys      []account.ParticipationRecordForRound // the list of the participation keys for round participationKeysRound
+	guard                  SigningGuard                          // consulted before signing votes, if set
 
 	proposalsVerifier *pseudonodeVerifier // dynamically generated verifier goroutine that manages incoming proposals making request.
 	votesVerifier     *pseudonodeVerifier // dynamically generated verifier goroutine that manages incoming votes making request.
@@ -133,6 +135,7 @@ type pseudonodeParams struct {
 	voteVerifier *AsyncVoteVerifier
 	log          serviceLogger
 	monitor      *coserviceMonitor
+	guard        SigningGuard
 }
 
 func makePseudonode(params pseudonodeParams) pseudonode {
@@ -145,6 +148,7 @@ func makePseudonode(params pseudonodeParams) pseudonode {
 		quit:      make(chan struct{}),
 		closeWg:   &sync.WaitGroup{},
 		monitor:   params.monitor,
+		guard:     params.guard,
 	}
 
 	pn.proposalsVerifier = pn.makePseudonodeVerifier(params.voteVerifier)
@@ -307,6 +311,10 @@ func (n asyncPseudonode) makeProposals(round basics.Round, period period, accoun
 
 		// attempt to make the vote
 		rv := rawVote{Sender: acc.Account, Round: round, Period: period, Step: propose, Proposal: proposal}
+		if gErr := n.guardVote(rv); gErr != nil {
+			n.log.Warnf("pseudonode.makeProposals: refusing to sign proposal (address %v): %v", acc.Account, gErr)
+			continue
+		}
 		uv, vErr := makeVote(rv, acc.VotingSigner(), acc.VRF, n.ledger)
 		if vErr != nil {
 			n.log.Warnf("pseudonode.makeProposals: could not create vote: %v", vErr)
@@ -327,6 +335,10 @@ func (n asyncPseudonode) makeVotes(round basics.Round, period period, step step,
 	votes := make([]unauthenticatedVote, 0)
 	for _, part := range participation {
 		rv := rawVote{Sender: part.Account, Round: round, Period: period, Step: step, Proposal: proposal}
+		if err := n.guardVote(rv); err != nil {
+			n.log.Warnf("pseudonode.makeVotes: refusing to sign vote (address %v): %v", part.Account, err)
+			continue
+		}
 		uv, err := makeVote(rv, part.VotingSigner(), part.VRF, n.ledger)
 		if err != nil {
 			n.log.Warnf("pseudonode.makeVotes: could not create vote: %v", err)
@@ -337,6 +349,15 @@ func (n asyncPseudonode) makeVotes(round basics.Round, period period, step step,
 	return votes
 }
 
+// guardVote checks with the signing guard, if any, that the vote does not equivocate.
+func (n asyncPseudonode) guardVote(rv rawVote) error {
+	if n.guard == nil {
+		return nil
+	}
+	value := crypto.Hash(protocol.Encode(&rv.Proposal))
+	return n.guard.GuardVote(rv.Sender, rv.Round, uint64(rv.Period), uint64(rv.Step), value)
+}
+
 func (pv *pseudonodeVerifier) close() {
 	close(pv.incomingTasks)
 }
@@ -27,6 +27,7 @@ import (
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 
+	"github.com/algorand/go-algorand/crypto"
 	"github.com/algorand/go-algorand/data/account"
 	"github.com/algorand/go-algorand/data/basics"
 	"github.com/algorand/go-algorand/logging"
@@ -456,6 +457,54 @@ func TestPseudonodeLoadingOfParticipationKeys(t *testing.T) {
 	}
 }
 
+// testSigningGuard refuses to sign two votes for different values in a round, period and step
+type testSigningGuard map[string]crypto.Digest
+
+func (g testSigningGuard) GuardVote(account basics.Address, round basics.Round, period uint64, step uint64, value crypto.Digest) error {
+	key := fmt.Sprintf("%v-%d-%d-%d", account, round, period, step)
+	if signed, ok := g[key]; ok && signed != value {
+		return errors.New("conflicting vote")
+	}
+	g[key] = value
+	return nil
+}
+
+func TestPseudonodeSigningGuard(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	rootSeed := sha256.Sum256([]byte(t.Name()))
+	accounts, balances := createTestAccountsAndBalances(t, 10, rootSeed[:])
+	ledger := makeTestLedger(balances)
+
+	sLogger := serviceLogger{logging.NewLogger()}
+	sLogger.SetLevel(logging.Error)
+
+	keyManager := makeRecordingKeyManager(accounts)
+	pb := makePseudonode(pseudonodeParams{
+		factory:      testBlockFactory{Owner: 0},
+		validator:    testBlockValidator{},
+		keys:         keyManager,
+		ledger:       ledger,
+		voteVerifier: MakeAsyncVoteVerifier(nil),
+		log:          sLogger,
+		guard:        testSigningGuard{},
+	}).(asyncPseudonode)
+	defer pb.Quit()
+
+	rnd := basics.Round(1)
+	keys := pb.loadRoundParticipationKeys(rnd)
+	require.NotEmpty(t, keys)
+	prop := proposalValue{BlockDigest: crypto.Digest{1}}
+	votes := pb.makeVotes(rnd, 0, soft, prop, keys)
+	require.NotEmpty(t, votes)
+
+	// The same votes may be signed again, but not conflicting ones
+	require.Len(t, pb.makeVotes(rnd, 0, soft, prop, keys), len(votes))
+	require.Empty(t, pb.makeVotes(rnd, 0, soft, proposalValue{BlockDigest: crypto.Digest{2}}, keys))
+	require.NotEmpty(t, pb.makeVotes(rnd, 0, cert, proposalValue{BlockDigest: crypto.Digest{2}}, keys))
+}
+
 type substrServiceLogger struct {
 	logging.Logger
 	looupStrings   []string
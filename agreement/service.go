@@ -77,6 +77,9 @@ type Parameters struct {
 	logging.Logger
 	config.Local
 	execpool.BacklogPool
+
+	// SigningGuard is consulted before signing votes if set
+	SigningGuard SigningGuard
 }
 
 // parameters is a convenience typedef for Parameters.
@@ -159,6 +162,7 @@ func (s *Service) Start() {
 		voteVerifier: s.voteVerifier,
 		log:          s.log,
 		monitor:      s.monitor,
+		guard:        s.SigningGuard,
 	})
 
 	s.persistenceLoop.Start()
@@ -250,6 +254,9 @@ func (s *Service) mainLoop(input <-chan externalEvent, output chan<- []action, r
 		s.Clock = clock
 	}
 
+	var spans stepSpans
+	spans.update(status)
+	defer spans.end()
 	for {
 		output <- a
 		fastRecoveryDeadline := Deadline{Duration: status.FastRecoveryDeadline, Type: TimeoutFastRecovery}
@@ -260,6 +267,7 @@ func (s *Service) mainLoop(input <-chan externalEvent, output chan<- []action, r
 		}
 
 		status, a = router.submitTop(s.tracer, status, e)
+		spans.update(status)
 
 		if persistent(a) {
 			s.persistRouter = router
@@ -0,0 +1,175 @@
+// Copyright (C) 2019-2025 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+// Package signguard protects the participation keys shared by redundant nodes, such as an
+// active and a standby validator, against equivocation.
+//
+// A Guard records every vote signed by the node in a local database, a slashing protection
+// database, and refuses to sign a vote for a different proposal value in a round, period and
+// step it already signed a vote in. The redundant nodes may also share a LockService: the first
+// node claiming a round, period and step for an account decides the value the others may sign.
+package signguard
+
+import (
+	"bytes"
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+
+	"github.com/algorand/go-deadlock"
+
+	"github.com/algorand/go-algorand/crypto"
+	"github.com/algorand/go-algorand/data/basics"
+	"github.com/algorand/go-algorand/logging"
+	"github.com/algorand/go-algorand/util/db"
+)
+
+// RetainRounds is the number of rounds the signed votes are kept for. Votes for rounds
+// older than the latest signed round by RetainRounds or more are refused.
+const RetainRounds = 1000
+
+// ErrConflictingVote is returned when a vote for a different value was already signed.
+var ErrConflictingVote = errors.New("a conflicting vote was already signed")
+
+// ErrStaleRound is returned for a vote in a round no longer covered by the guard.
+var ErrStaleRound = errors.New("vote round is older than the rounds covered by the signing guard")
+
+const (
+	createSignedVotes = `CREATE TABLE SignedVotes (
+			account BLOB    NOT NULL,
+			round   INTEGER NOT NULL,
+			period  INTEGER NOT NULL,
+			step    INTEGER NOT NULL,
+			value   BLOB    NOT NULL, --*  digest of the proposal value voted for
+			PRIMARY KEY (account, round, period, step)
+		)`
+	selectSignedVote  = `SELECT value FROM SignedVotes WHERE account=? AND round=? AND period=? AND step=?`
+	insertSignedVote  = `INSERT INTO SignedVotes (account, round, period, step, value) VALUES (?, ?, ?, ?, ?)`
+	selectLatestRound = `SELECT COALESCE(MAX(round), 0) FROM SignedVotes`
+	deleteSignedVotes = `DELETE FROM SignedVotes WHERE round<?`
+)
+
+func dbSchemaUpgrade0(ctx context.Context, tx *sql.Tx, newDatabase bool) error {
+	_, err := tx.Exec(createSignedVotes)
+	return err
+}
+
+// Guard is a slashing protection database of the votes signed by the node, optionally
+// shared with redundant nodes through a LockService. It implements agreement.SigningGuard.
+type Guard struct {
+	store db.Accessor
+	lock  LockService
+	log   logging.Logger
+
+	mu     deadlock.Mutex
+	latest basics.Round
+}
+
+// MakeGuard opens the slashing protection database in filename, and shares the signed votes
+// through lock if it is not nil.
+func MakeGuard(filename string, lock LockService, log logging.Logger) (*Guard, error) {
+	store, err := db.MakeAccessor(filename, false, false)
+	if err != nil {
+		return nil, err
+	}
+	err = db.Initialize(store, []db.Migration{dbSchemaUpgrade0})
+	if err != nil {
+		store.Close()
+		return nil, fmt.Errorf("unable to initialize the signing guard database: %w", err)
+	}
+
+	g := &Guard{store: store, lock: lock, log: log}
+	err = store.Atomic(func(ctx context.Context, tx *sql.Tx) error {
+		return tx.QueryRow(selectLatestRound).Scan(&g.latest)
+	})
+	if err != nil {
+		store.Close()
+		return nil, err
+	}
+	return g, nil
+}
+
+// GuardVote records that the account signs a vote for the proposal value with the given digest
+// in the round, period and step. It returns ErrConflictingVote if a vote for a different value
+// was already signed, by this node or by a node sharing the lock service.
+func (g *Guard) GuardVote(account basics.Address, round basics.Round, period uint64, step uint64, value crypto.Digest) error {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+
+	if round+RetainRounds <= g.latest {
+		return ErrStaleRound
+	}
+
+	var signed []byte
+	err := g.store.Atomic(func(ctx context.Context, tx *sql.Tx) error {
+		err := tx.QueryRow(selectSignedVote, account[:], round, period, step).Scan(&signed)
+		if err == sql.ErrNoRows {
+			signed = nil
+			return nil
+		}
+		return err
+	})
+	if err != nil {
+		return fmt.Errorf("unable to read the signing guard database: %w", err)
+	}
+	if signed != nil {
+		if !bytes.Equal(signed, value[:]) {
+			return ErrConflictingVote
+		}
+		return nil
+	}
+
+	if g.lock != nil {
+		claimed, err := g.lock.Claim(account, round, period, step, value)
+		if err != nil {
+			return fmt.Errorf("unable to claim vote from the lock service: %w", err)
+		}
+		if claimed != value {
+			return ErrConflictingVote
+		}
+	}
+
+	err = g.store.Atomic(func(ctx context.Context, tx *sql.Tx) error {
+		_, err := tx.Exec(insertSignedVote, account[:], round, period, step, value[:])
+		if err != nil {
+			return err
+		}
+		if round > g.latest {
+			_, err = tx.Exec(deleteSignedVotes, round.SubSaturate(RetainRounds-1))
+		}
+		return err
+	})
+	if err != nil {
+		return fmt.Errorf("unable to record vote in the signing guard database: %w", err)
+	}
+
+	if round > g.latest {
+		g.latest = round
+		if g.lock != nil {
+			err = g.lock.Prune(round.SubSaturate(RetainRounds - 1))
+			if err != nil {
+				g.log.Warnf("signing guard unable to prune the lock service: %v", err)
+			}
+		}
+	}
+	return nil
+}
+
+// Close closes the database of the guard.
+func (g *Guard) Close() {
+	g.store.Close()
+}
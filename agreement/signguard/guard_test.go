@@ -0,0 +1,92 @@
+// Copyright (C) 2019-2025 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package signguard
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/algorand/go-algorand/crypto"
+	"github.com/algorand/go-algorand/data/basics"
+	"github.com/algorand/go-algorand/logging"
+	"github.com/algorand/go-algorand/test/partitiontest"
+)
+
+func TestGuardVote(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	a := require.New(t)
+
+	filename := filepath.Join(t.TempDir(), "signguard.sqlite")
+	g, err := MakeGuard(filename, nil, logging.TestingLog(t))
+	a.NoError(err)
+
+	addr := basics.Address{0x01}
+	a.NoError(g.GuardVote(addr, 10, 0, 1, crypto.Digest{1}))
+	a.NoError(g.GuardVote(addr, 10, 0, 1, crypto.Digest{1}))
+	a.ErrorIs(g.GuardVote(addr, 10, 0, 1, crypto.Digest{2}), ErrConflictingVote)
+	a.NoError(g.GuardVote(addr, 10, 0, 2, crypto.Digest{2}))
+	a.NoError(g.GuardVote(addr, 10, 1, 1, crypto.Digest{2}))
+	a.NoError(g.GuardVote(basics.Address{0x02}, 10, 0, 1, crypto.Digest{2}))
+	g.Close()
+
+	// The signed votes survive a restart
+	g, err = MakeGuard(filename, nil, logging.TestingLog(t))
+	a.NoError(err)
+	defer g.Close()
+	a.ErrorIs(g.GuardVote(addr, 10, 0, 1, crypto.Digest{2}), ErrConflictingVote)
+
+	// Old rounds are forgotten, and refused
+	a.NoError(g.GuardVote(addr, 10+RetainRounds, 0, 1, crypto.Digest{1}))
+	a.ErrorIs(g.GuardVote(addr, 10, 0, 1, crypto.Digest{1}), ErrStaleRound)
+	a.NoError(g.GuardVote(addr, 11, 0, 1, crypto.Digest{2}))
+}
+
+func TestGuardVoteSharedLock(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	a := require.New(t)
+
+	lockDir := filepath.Join(t.TempDir(), "lock")
+	_, err := MakeLockService("file:relative")
+	a.ErrorIs(err, ErrInvalidLockService)
+	lock, err := MakeLockService("file:" + lockDir)
+	a.NoError(err)
+
+	// An active and a standby node sharing the lock service
+	active, err := MakeGuard(filepath.Join(t.TempDir(), "active.sqlite"), lock, logging.TestingLog(t))
+	a.NoError(err)
+	defer active.Close()
+	standby, err := MakeGuard(filepath.Join(t.TempDir(), "standby.sqlite"), lock, logging.TestingLog(t))
+	a.NoError(err)
+	defer standby.Close()
+
+	addr := basics.Address{0x01}
+	a.NoError(active.GuardVote(addr, 10, 0, 1, crypto.Digest{1}))
+	a.ErrorIs(standby.GuardVote(addr, 10, 0, 1, crypto.Digest{2}), ErrConflictingVote)
+	a.NoError(standby.GuardVote(addr, 10, 0, 1, crypto.Digest{1}))
+	a.NoError(standby.GuardVote(addr, 10, 0, 2, crypto.Digest{2}))
+	a.ErrorIs(active.GuardVote(addr, 10, 0, 2, crypto.Digest{1}), ErrConflictingVote)
+
+	// The claims of old rounds are pruned
+	a.NoError(active.GuardVote(addr, 10+RetainRounds, 0, 1, crypto.Digest{1}))
+	_, err = os.Stat(filepath.Join(lockDir, "10"))
+	a.ErrorIs(err, os.ErrNotExist)
+	_, err = os.Stat(filepath.Join(lockDir, "1010"))
+	a.NoError(err)
+}
@@ -0,0 +1,131 @@
+// Copyright (C) 2019-2025 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package signguard
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	"github.com/algorand/go-algorand/crypto"
+	"github.com/algorand/go-algorand/data/basics"
+)
+
+const fileLockPrefix = "file:"
+
+// ErrInvalidLockService is returned for an unknown lock service.
+var ErrInvalidLockService = errors.New("the signing guard lock service must be file:<directory>")
+
+// A LockService is shared by redundant nodes, so that only one value is signed by any of them
+// for an account in a round, period and step.
+type LockService interface {
+	// Claim records value for the account in the round, period and step unless a value is
+	// already recorded, and returns the recorded value.
+	Claim(account basics.Address, round basics.Round, period uint64, step uint64, value crypto.Digest) (crypto.Digest, error)
+
+	// Prune forgets the values recorded for the rounds before round.
+	Prune(round basics.Round) error
+}
+
+// MakeLockService returns the lock service of the DoubleSignProtectionLockService setting:
+// file:<directory> for a FileLockService in a directory shared by the nodes.
+func MakeLockService(spec string) (LockService, error) {
+	if !strings.HasPrefix(spec, fileLockPrefix) {
+		return nil, ErrInvalidLockService
+	}
+	dir := strings.TrimPrefix(spec, fileLockPrefix)
+	if !filepath.IsAbs(dir) {
+		return nil, ErrInvalidLockService
+	}
+	err := os.MkdirAll(dir, 0700)
+	if err != nil {
+		return nil, err
+	}
+	return FileLockService{Dir: dir}, nil
+}
+
+// FileLockService is a LockService stand-in keeping the claimed values in files of a directory
+// shared by the nodes, e.g. on a network file system. The value of a round, period and step is
+// claimed by hard linking its file into place, which fails if the file already exists.
+type FileLockService struct {
+	Dir string
+}
+
+// Claim records value unless a value is already recorded, and returns the recorded value.
+func (s FileLockService) Claim(account basics.Address, round basics.Round, period uint64, step uint64, value crypto.Digest) (crypto.Digest, error) {
+	roundDir := filepath.Join(s.Dir, strconv.FormatUint(uint64(round), 10))
+	err := os.MkdirAll(roundDir, 0700)
+	if err != nil {
+		return crypto.Digest{}, err
+	}
+	path := filepath.Join(roundDir, fmt.Sprintf("%s-%d-%d", account, period, step))
+
+	tmp, err := os.CreateTemp(roundDir, ".claim-*")
+	if err != nil {
+		return crypto.Digest{}, err
+	}
+	defer os.Remove(tmp.Name())
+	_, err = tmp.Write(value[:])
+	if err == nil {
+		err = tmp.Sync()
+	}
+	closeErr := tmp.Close()
+	if err == nil {
+		err = closeErr
+	}
+	if err != nil {
+		return crypto.Digest{}, err
+	}
+
+	err = os.Link(tmp.Name(), path)
+	if err == nil {
+		return value, nil
+	}
+	if !errors.Is(err, os.ErrExist) {
+		return crypto.Digest{}, err
+	}
+	claimed, err := os.ReadFile(path)
+	if err != nil {
+		return crypto.Digest{}, err
+	}
+	if len(claimed) != len(crypto.Digest{}) {
+		return crypto.Digest{}, fmt.Errorf("malformed claim %s", path)
+	}
+	return crypto.Digest(claimed), nil
+}
+
+// Prune removes the directories of the rounds before round.
+func (s FileLockService) Prune(round basics.Round) error {
+	entries, err := os.ReadDir(s.Dir)
+	if err != nil {
+		return err
+	}
+	for _, entry := range entries {
+		rnd, err := strconv.ParseUint(entry.Name(), 10, 64)
+		if err != nil || !entry.IsDir() || basics.Round(rnd) >= round {
+			continue
+		}
+		err = os.RemoveAll(filepath.Join(s.Dir, entry.Name()))
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
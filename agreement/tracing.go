@@ -0,0 +1,79 @@
+// Copyright (C) 2019-2025 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package agreement
+
+import (
+	"context"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+
+	"github.com/algorand/go-algorand/util/tracing"
+)
+
+// stepSpans traces the progress of the player: the span of a step is a child of the span of
+// its period, which is itself a child of the span of its round.
+type stepSpans struct {
+	round, period, step trace.Span
+	// ctxRound and ctxPeriod carry the spans of the current round and period
+	ctxRound, ctxPeriod context.Context
+
+	r round
+	p period
+	s step
+}
+
+// update ends the spans of the round, period and step the player left, and starts the spans of the ones it entered.
+func (ss *stepSpans) update(status player) {
+	if ss.round == nil && !tracing.Enabled() {
+		return
+	}
+	if ss.round != nil && ss.r == status.Round && ss.p == status.Period && ss.s == status.Step {
+		return
+	}
+
+	newRound := ss.round == nil || ss.r != status.Round
+	newPeriod := newRound || ss.p != status.Period
+	if ss.step != nil {
+		ss.step.End()
+	}
+	if newPeriod && ss.period != nil {
+		ss.period.End()
+	}
+	if newRound && ss.round != nil {
+		ss.round.End()
+	}
+
+	ss.r, ss.p, ss.s = status.Round, status.Period, status.Step
+	if newRound {
+		ss.ctxRound, ss.round = tracing.Start(context.Background(), "agreement.round", attribute.Int64("algorand.round", int64(status.Round)))
+	}
+	if newPeriod {
+		ss.ctxPeriod, ss.period = tracing.Start(ss.ctxRound, "agreement.period", attribute.Int64("algorand.period", int64(status.Period)))
+	}
+	_, ss.step = tracing.Start(ss.ctxPeriod, "agreement.step", attribute.Int64("algorand.step", int64(status.Step)))
+}
+
+// end ends the spans of the current round, period and step.
+func (ss *stepSpans) end() {
+	for _, span := range []trace.Span{ss.step, ss.period, ss.round} {
+		if span != nil {
+			span.End()
+		}
+	}
+	*ss = stepSpans{}
+}
@@ -0,0 +1,71 @@
+// Copyright (C) 2019-2025 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package agreement
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+
+	"github.com/algorand/go-algorand/test/partitiontest"
+	"github.com/algorand/go-algorand/util/tracing"
+)
+
+func TestStepSpans(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	// nothing is traced until tracing is set up
+	var spans stepSpans
+	spans.update(player{Round: 1})
+	require.Nil(t, spans.round)
+
+	recorder := tracetest.NewSpanRecorder()
+	shutdown, err := tracing.SetupWithProcessor(recorder, 100)
+	require.NoError(t, err)
+	defer shutdown(context.Background())
+
+	spans.update(player{Round: 1, Period: 0, Step: soft})
+	spans.update(player{Round: 1, Period: 0, Step: soft})
+	spans.update(player{Round: 1, Period: 0, Step: cert})
+	spans.update(player{Round: 1, Period: 1, Step: soft})
+	spans.update(player{Round: 2, Period: 0, Step: soft})
+	require.Len(t, recorder.Ended(), 6)
+	spans.end()
+	require.Nil(t, spans.round)
+
+	ended := tracetest.SpanStubsFromReadOnlySpans(recorder.Ended())
+	var names []string
+	for _, s := range ended {
+		names = append(names, s.Name)
+	}
+	require.Equal(t, []string{
+		"agreement.step",   // round 1, period 0, soft
+		"agreement.step",   // round 1, period 0, cert
+		"agreement.period", // round 1, period 0
+		"agreement.step",   // round 1, period 1, soft
+		"agreement.period", // round 1, period 1
+		"agreement.round",  // round 1
+		"agreement.step",   // round 2, period 0, soft
+		"agreement.period", // round 2, period 0
+		"agreement.round",  // round 2
+	}, names)
+	require.Equal(t, ended[2].SpanContext.SpanID(), ended[0].Parent.SpanID())
+	require.Equal(t, ended[5].SpanContext.SpanID(), ended[2].Parent.SpanID())
+	require.Equal(t, ended[5].SpanContext.SpanID(), ended[4].Parent.SpanID())
+}
@@ -20,6 +20,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"os"
 	"sync"
 	"time"
 
@@ -296,6 +297,8 @@ func (cs *CatchpointCatchupService) processStageLedgerDownload() error {
 
 	// download balances file.
 	lf := makeLedgerFetcher(cs.net, cs.ledgerAccessor, cs.log, cs, cs.config)
+	plf := makeParallelLedgerFetcher(lf, cs.blocksDownloadPeerSelector, cs.config.CatchupLedgerDownloadParallelism, cs.ledgerAccessor.DownloadDir())
+	useParallelDownload := cs.config.CatchupLedgerDownloadParallelism > 0
 	attemptsCount := 0
 
 	for {
@@ -308,28 +311,51 @@ func (cs *CatchpointCatchupService) processStageLedgerDownload() error {
 			}
 			return cs.abort(fmt.Errorf("processStageLedgerDownload failed to reset staging balances : %v", err0))
 		}
-		psp, err0 := cs.blocksDownloadPeerSelector.getNextPeer()
-		if err0 != nil {
-			err0 = fmt.Errorf("processStageLedgerDownload: catchpoint catchup was unable to obtain a list of peers to retrieve the catchpoint file from")
-			return cs.abort(err0)
-		}
-		peer := psp.Peer
-		start := time.Now()
-		err0 = lf.downloadLedger(cs.ctx, peer, round)
-		if err0 == nil {
-			cs.log.Infof("ledger downloaded from %s in %d seconds", peerAddress(peer), time.Since(start)/time.Second)
-			start = time.Now()
-			err0 = cs.ledgerAccessor.BuildMerkleTrie(cs.ctx, cs.updateVerifiedCounts)
+		if useParallelDownload {
+			start := time.Now()
+			err0 = plf.downloadLedger(cs.ctx, round, label)
 			if err0 == nil {
-				cs.log.Infof("built merkle trie in %d seconds", time.Since(start)/time.Second)
-				break
+				cs.log.Infof("ledger downloaded in %d seconds", time.Since(start)/time.Second)
+				start = time.Now()
+				err0 = cs.ledgerAccessor.BuildMerkleTrie(cs.ctx, cs.updateVerifiedCounts)
+				if err0 == nil {
+					cs.log.Infof("built merkle trie in %d seconds", time.Since(start)/time.Second)
+					cs.discardLedgerDownload()
+					break
+				}
+				// the verified chunks are consistent with the manifest, but the catchpoint file itself is invalid.
+				cs.log.Infof("failed to build merkle trie for catchpoint file: %v", err0)
+				cs.discardLedgerDownload()
+			} else if errors.Is(err0, errChunkedDownloadUnavailable) {
+				cs.log.Infof("catchpoint file chunks are not available from peers; downloading the catchpoint file as a single stream")
+				useParallelDownload = false
+			} else {
+				cs.log.Infof("failed to download catchpoint ledger: %v", err0)
 			}
-			// failed to build the merkle trie for the above catchpoint file.
-			cs.log.Infof("failed to build merkle trie for catchpoint file from %s: %v", peerAddress(peer), err0)
-			cs.blocksDownloadPeerSelector.rankPeer(psp, peerRankInvalidDownload)
 		} else {
-			cs.log.Infof("failed to download catchpoint ledger from peer %s: %v", peerAddress(peer), err0)
-			cs.blocksDownloadPeerSelector.rankPeer(psp, peerRankDownloadFailed)
+			psp, err1 := cs.blocksDownloadPeerSelector.getNextPeer()
+			if err1 != nil {
+				err1 = fmt.Errorf("processStageLedgerDownload: catchpoint catchup was unable to obtain a list of peers to retrieve the catchpoint file from")
+				return cs.abort(err1)
+			}
+			peer := psp.Peer
+			start := time.Now()
+			err0 = lf.downloadLedger(cs.ctx, peer, round)
+			if err0 == nil {
+				cs.log.Infof("ledger downloaded from %s in %d seconds", peerAddress(peer), time.Since(start)/time.Second)
+				start = time.Now()
+				err0 = cs.ledgerAccessor.BuildMerkleTrie(cs.ctx, cs.updateVerifiedCounts)
+				if err0 == nil {
+					cs.log.Infof("built merkle trie in %d seconds", time.Since(start)/time.Second)
+					break
+				}
+				// failed to build the merkle trie for the above catchpoint file.
+				cs.log.Infof("failed to build merkle trie for catchpoint file from %s: %v", peerAddress(peer), err0)
+				cs.blocksDownloadPeerSelector.rankPeer(psp, peerRankInvalidDownload)
+			} else {
+				cs.log.Infof("failed to download catchpoint ledger from peer %s: %v", peerAddress(peer), err0)
+				cs.blocksDownloadPeerSelector.rankPeer(psp, peerRankDownloadFailed)
+			}
 		}
 
 		// instead of testing for err == cs.ctx.Err() , we'll check on the context itself.
@@ -733,6 +759,7 @@ func (cs *CatchpointCatchupService) stopOrAbort() error {
 // abort aborts the current catchpoint catchup process, reverting to node to standard operation.
 func (cs *CatchpointCatchupService) abort(originatingErr error) error {
 	outError := originatingErr
+	cs.discardLedgerDownload()
 	err0 := cs.ledgerAccessor.ResetStagingBalances(cs.ctx, false)
 	if err0 != nil {
 		outError = fmt.Errorf("unable to reset staging balances : %v; %v", err0, outError)
@@ -745,6 +772,15 @@ func (cs *CatchpointCatchupService) abort(originatingErr error) error {
 	return outError
 }
 
+// discardLedgerDownload removes the catchpoint file chunks persisted by the parallel ledger fetcher.
+func (cs *CatchpointCatchupService) discardLedgerDownload() {
+	if downloadDir := cs.ledgerAccessor.DownloadDir(); downloadDir != "" {
+		if err := os.RemoveAll(downloadDir); err != nil {
+			cs.log.Warnf("unable to remove catchpoint download directory %s : %v", downloadDir, err)
+		}
+	}
+}
+
 // updateStage updates the current catchpoint catchup stage to the provided new stage.
 func (cs *CatchpointCatchupService) updateStage(newStage ledger.CatchpointCatchupState) (err error) {
 	err = cs.ledgerAccessor.SetState(cs.ctx, newStage)
@@ -73,12 +73,24 @@ func makeLedgerFetcher(net network.GossipNode, accessor ledger.CatchpointCatchup
 }
 
 func (lf *ledgerFetcher) requestLedger(ctx context.Context, peer network.HTTPPeer, round basics.Round, method string) (*http.Response, error) {
-	ledgerURL := network.SubstituteGenesisID(lf.net, "/v1/{genesisID}/ledger/"+strconv.FormatUint(uint64(round), 36))
+	return lf.requestLedgerPath(ctx, peer, ledgerPath(round), method, nil)
+}
+
+// ledgerPath returns the path of the catchpoint file for the given round, before the genesis ID substitution
+func ledgerPath(round basics.Round) string {
+	return "/v1/{genesisID}/ledger/" + strconv.FormatUint(uint64(round), 36)
+}
+
+func (lf *ledgerFetcher) requestLedgerPath(ctx context.Context, peer network.HTTPPeer, path string, method string, header http.Header) (*http.Response, error) {
+	ledgerURL := network.SubstituteGenesisID(lf.net, path)
 	lf.log.Debugf("ledger %s %#v peer %#v %T", method, ledgerURL, peer, peer)
 	request, err := http.NewRequestWithContext(ctx, method, ledgerURL, nil)
 	if err != nil {
 		return nil, err
 	}
+	for key, values := range header {
+		request.Header[key] = values
+	}
 
 	network.SetUserAgentHeader(request.Header)
 	httpClient := peer.GetHTTPClient()
@@ -153,15 +165,7 @@ func (lf *ledgerFetcher) getPeerLedger(ctx context.Context, peer network.HTTPPee
 		return err
 	}
 
-	// maxCatchpointFileChunkDownloadDuration is the maximum amount of time we would wait to download a single chunk off a catchpoint file
-	maxCatchpointFileChunkDownloadDuration := 2 * time.Minute
-	if lf.config.MinCatchpointFileDownloadBytesPerSecond > 0 {
-		maxCatchpointFileChunkDownloadDuration += maxCatchpointFileChunkSize * time.Second / time.Duration(lf.config.MinCatchpointFileDownloadBytesPerSecond)
-	} else {
-		maxCatchpointFileChunkDownloadDuration += maxCatchpointFileChunkSize * time.Second / defaultMinCatchpointFileDownloadBytesPerSecond
-	}
-
-	watchdogReader := util.MakeWatchdogStreamReader(response.Body, catchpointFileStreamReadSize, 2*maxCatchpointFileChunkSize, maxCatchpointFileChunkDownloadDuration)
+	watchdogReader := util.MakeWatchdogStreamReader(response.Body, catchpointFileStreamReadSize, 2*maxCatchpointFileChunkSize, lf.maxChunkDownloadDuration())
 	defer watchdogReader.Close()
 	tarReader := tar.NewReader(watchdogReader)
 	var downloadProgress ledger.CatchpointCatchupAccessorProgress
@@ -218,6 +222,17 @@ func (lf *ledgerFetcher) getPeerLedger(ctx context.Context, peer network.HTTPPee
 	}
 }
 
+// maxChunkDownloadDuration returns the maximum amount of time we would wait to download a single chunk off a catchpoint file
+func (lf *ledgerFetcher) maxChunkDownloadDuration() time.Duration {
+	maxCatchpointFileChunkDownloadDuration := 2 * time.Minute
+	if lf.config.MinCatchpointFileDownloadBytesPerSecond > 0 {
+		maxCatchpointFileChunkDownloadDuration += maxCatchpointFileChunkSize * time.Second / time.Duration(lf.config.MinCatchpointFileDownloadBytesPerSecond)
+	} else {
+		maxCatchpointFileChunkDownloadDuration += maxCatchpointFileChunkSize * time.Second / defaultMinCatchpointFileDownloadBytesPerSecond
+	}
+	return maxCatchpointFileChunkDownloadDuration
+}
+
 func (lf *ledgerFetcher) processBalancesBlock(ctx context.Context, sectionName string, bytes []byte, downloadProgress *ledger.CatchpointCatchupAccessorProgress) error {
 	return lf.accessor.ProcessStagingBalances(ctx, sectionName, bytes, downloadProgress)
 }
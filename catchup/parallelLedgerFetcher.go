@@ -0,0 +1,561 @@
+// Copyright (C) 2019-2025 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package catchup
+
+import (
+	"archive/tar"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"slices"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/algorand/go-deadlock"
+
+	"github.com/algorand/go-algorand/crypto"
+	"github.com/algorand/go-algorand/data/basics"
+	"github.com/algorand/go-algorand/ledger"
+	"github.com/algorand/go-algorand/network"
+	"github.com/algorand/go-algorand/protocol"
+	"github.com/algorand/go-algorand/rpcs"
+	"github.com/algorand/go-algorand/util"
+)
+
+const (
+	// catchpointChunkRangesPerWorker is the number of chunk ranges the catchpoint file is split into per download worker.
+	// Smaller ranges spread the download better across peers, while larger ones reduce the load on the serving peer,
+	// which has to decompress its catchpoint file up to the end of each requested range.
+	catchpointChunkRangesPerWorker = 4
+
+	// catchpointManifestFileName is the name of the file storing the catchpoint file manifest within the download spool
+	catchpointManifestFileName = "manifest.msgpack"
+
+	// maxCatchpointManifestSize is a rough upper bound on the encoded size of a catchpoint file manifest
+	maxCatchpointManifestSize = rpcs.MaxCatchpointFileManifestEntries * 128
+
+	// catchpointManifestConfirmations is the number of distinct peers which need to provide the same catchpoint file
+	// manifest before it's used to verify the downloaded chunks
+	catchpointManifestConfirmations = 2
+)
+
+var (
+	// errChunkedDownloadUnavailable is returned when the peers were unable to provide a consistent catchpoint file manifest,
+	// in which case the catchpoint file would be downloaded as a single stream instead.
+	errChunkedDownloadUnavailable = errors.New("a consistent catchpoint file manifest is not available from the peers")
+	// errChunksRangeNotSupported is returned when a peer ignored the requested chunks range
+	errChunksRangeNotSupported = errors.New("peer does not support catchpoint file chunks ranges")
+	// errInvalidCatchpointManifest is returned when the catchpoint file manifest is inconsistent with the catchpoint file header
+	errInvalidCatchpointManifest = errors.New("catchpoint file manifest does not match the catchpoint file header")
+	// errChunkVerificationFailed is returned when a downloaded chunk does not match its manifest entry
+	errChunkVerificationFailed = errors.New("catchpoint file chunk does not match the manifest")
+)
+
+// parallelLedgerFetcher downloads a catchpoint file as ranges of chunks, fetched concurrently from multiple peers.
+// Every chunk is verified against the catchpoint file manifest and persisted in a download spool, so an interrupted
+// download resumes from the chunks already on disk. The chunks are handed to the ledger in their original order.
+type parallelLedgerFetcher struct {
+	*ledgerFetcher
+
+	peerSelector peerSelector
+	parallelism  int
+	spoolDir     string
+}
+
+func makeParallelLedgerFetcher(lf *ledgerFetcher, peerSelector peerSelector, parallelism int, spoolDir string) *parallelLedgerFetcher {
+	return &parallelLedgerFetcher{
+		ledgerFetcher: lf,
+		peerSelector:  peerSelector,
+		parallelism:   parallelism,
+		spoolDir:      spoolDir,
+	}
+}
+
+// downloadLedger downloads the catchpoint file of the given round and processes it into the staging tables.
+func (plf *parallelLedgerFetcher) downloadLedger(ctx context.Context, round basics.Round, label string) error {
+	spoolDir := plf.spoolDir
+	if spoolDir == "" {
+		// without a persistent location, the download can't be resumed; use a temporary spool instead.
+		tempDir, err := os.MkdirTemp("", ledger.CatchpointDownloadDirName)
+		if err != nil {
+			return err
+		}
+		defer os.RemoveAll(tempDir)
+		spoolDir = tempDir
+	}
+	spool, err := openCatchpointDownloadSpool(spoolDir, round)
+	if err != nil {
+		return err
+	}
+	if spool.manifest == nil {
+		manifest, err0 := plf.fetchManifest(ctx, round)
+		if err0 != nil {
+			return err0
+		}
+		if err0 = spool.setManifest(manifest); err0 != nil {
+			return err0
+		}
+	}
+
+	// the header chunk is downloaded first, as it's needed to verify the rest of the manifest.
+	if !spool.has(0) {
+		err = plf.downloadRange(ctx, round, spool, rpcs.ChunksRange{First: 0, Last: 0}, nil)
+		if err != nil {
+			return err
+		}
+	}
+	if err = plf.verifyManifest(spool, label); err != nil {
+		spool.discard()
+		return err
+	}
+
+	downloadCtx, cancelDownload := context.WithCancel(ctx)
+	var wg sync.WaitGroup
+	defer func() {
+		cancelDownload()
+		wg.Wait()
+	}()
+
+	ranges := spool.missingRanges(plf.parallelism * catchpointChunkRangesPerWorker)
+	rangesCh := make(chan rpcs.ChunksRange, len(ranges))
+	for _, chunks := range ranges {
+		rangesCh <- chunks
+	}
+	close(rangesCh)
+	totalChunks := len(spool.manifest.Entries)
+	availableCh := make(chan uint64, totalChunks)
+	errCh := make(chan error, plf.parallelism)
+	for i := 0; i < plf.parallelism && i < len(ranges); i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for chunks := range rangesCh {
+				if err := plf.downloadRange(downloadCtx, round, spool, chunks, availableCh); err != nil {
+					errCh <- err
+					return
+				}
+			}
+		}()
+	}
+
+	available := spool.availableChunks()
+	var downloadProgress ledger.CatchpointCatchupAccessorProgress
+	var writeDuration time.Duration
+	for i := 0; i < totalChunks; i++ {
+		for !available[i] {
+			select {
+			case idx := <-availableCh:
+				available[idx] = true
+			case err = <-errCh:
+				return err
+			case <-ctx.Done():
+				return ctx.Err()
+			}
+		}
+		chunk, err := spool.readChunk(uint64(i))
+		if err != nil {
+			return err
+		}
+		start := time.Now()
+		err = plf.processBalancesBlock(ctx, spool.manifest.Entries[i].Name, chunk, &downloadProgress)
+		if err != nil {
+			return err
+		}
+		writeDuration += time.Since(start)
+		if plf.reporter != nil {
+			plf.reporter.updateLedgerFetcherProgress(&downloadProgress)
+		}
+	}
+	plf.log.Infof("processing %d catchpoint file chunks took %d seconds", totalChunks, writeDuration/time.Second)
+	return nil
+}
+
+// fetchManifest retrieves the catchpoint file manifest, cross-checking it across peers. The chunk digests can only be
+// verified against the manifest, so a manifest is used only once catchpointManifestConfirmations distinct peers have
+// provided it; peers which provided a conflicting manifest are ranked as having served an invalid download.
+func (plf *parallelLedgerFetcher) fetchManifest(ctx context.Context, round basics.Round) (*rpcs.CatchpointFileManifest, error) {
+	type manifestSources struct {
+		manifest *rpcs.CatchpointFileManifest
+		peers    []*peerSelectorPeer
+	}
+	sources := make(map[crypto.Digest]*manifestSources)
+	for attempt := 0; attempt < plf.config.CatchupLedgerDownloadRetryAttempts; attempt++ {
+		psp, err := plf.peerSelector.getNextPeer()
+		if err != nil {
+			return nil, err
+		}
+		manifest, err := plf.getPeerManifest(ctx, psp.Peer, round)
+		if err != nil {
+			if ctx.Err() != nil {
+				return nil, ctx.Err()
+			}
+			plf.log.Infof("failed to download catchpoint manifest from peer %s: %v", peerAddress(psp.Peer), err)
+			plf.peerSelector.rankPeer(psp, peerRankNoCatchpointForRound)
+			continue
+		}
+		digest := crypto.Hash(protocol.Encode(manifest))
+		source := sources[digest]
+		if source == nil {
+			source = &manifestSources{manifest: manifest}
+			sources[digest] = source
+		}
+		if slices.ContainsFunc(source.peers, func(other *peerSelectorPeer) bool { return other.Peer == psp.Peer }) {
+			continue
+		}
+		source.peers = append(source.peers, psp)
+		if len(source.peers) < catchpointManifestConfirmations {
+			continue
+		}
+		for otherDigest, other := range sources {
+			if otherDigest == digest {
+				continue
+			}
+			for _, otherPsp := range other.peers {
+				plf.log.Infof("peer %s provided a conflicting catchpoint manifest for round %d", peerAddress(otherPsp.Peer), round)
+				plf.peerSelector.rankPeer(otherPsp, peerRankInvalidDownload)
+			}
+		}
+		return source.manifest, nil
+	}
+	return nil, errChunkedDownloadUnavailable
+}
+
+func (plf *parallelLedgerFetcher) getPeerManifest(ctx context.Context, peer network.Peer, round basics.Round) (*rpcs.CatchpointFileManifest, error) {
+	httpPeer, ok := peer.(network.HTTPPeer)
+	if !ok {
+		return nil, errNonHTTPPeer
+	}
+	timeoutContext, timeoutContextCancel := context.WithTimeout(ctx, plf.maxChunkDownloadDuration())
+	defer timeoutContextCancel()
+	response, err := plf.requestLedgerPath(timeoutContext, httpPeer, ledgerPath(round)+"/manifest", http.MethodGet, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer response.Body.Close()
+
+	switch response.StatusCode {
+	case http.StatusOK:
+	case http.StatusNotFound:
+		return nil, errNoLedgerForRound
+	default:
+		return nil, fmt.Errorf("getPeerManifest error response status code %d", response.StatusCode)
+	}
+	if contentType := response.Header.Get("Content-Type"); contentType != rpcs.LedgerManifestResponseContentType {
+		return nil, fmt.Errorf("getPeerManifest : http ledger fetcher response has an invalid content type : %s", contentType)
+	}
+	encodedManifest, err := io.ReadAll(io.LimitReader(response.Body, maxCatchpointManifestSize))
+	if err != nil {
+		return nil, err
+	}
+	var manifest rpcs.CatchpointFileManifest
+	if err = protocol.Decode(encodedManifest, &manifest); err != nil {
+		return nil, err
+	}
+	if err = validateCatchpointFileManifest(&manifest, round); err != nil {
+		return nil, err
+	}
+	return &manifest, nil
+}
+
+// validateCatchpointFileManifest performs the sanity checks on the manifest which don't require the header chunk
+func validateCatchpointFileManifest(manifest *rpcs.CatchpointFileManifest, round basics.Round) error {
+	if manifest.Round != round {
+		return fmt.Errorf("catchpoint manifest is for round %d rather than %d", manifest.Round, round)
+	}
+	if len(manifest.Entries) == 0 || manifest.Entries[0].Name != ledger.CatchpointContentFileName {
+		return fmt.Errorf("catchpoint manifest does not start with the %s chunk", ledger.CatchpointContentFileName)
+	}
+	for _, entry := range manifest.Entries {
+		if entry.Size > maxCatchpointFileChunkSize || entry.Size < 1 {
+			return fmt.Errorf("catchpoint manifest has a chunk %s with data size of %d", entry.Name, entry.Size)
+		}
+	}
+	return nil
+}
+
+// verifyManifest verifies that the manifest in the spool describes the catchpoint file whose header chunk is in the spool
+func (plf *parallelLedgerFetcher) verifyManifest(spool *catchpointDownloadSpool, label string) error {
+	encodedHeader, err := spool.readChunk(0)
+	if err != nil {
+		return err
+	}
+	var fileHeader ledger.CatchpointFileHeader
+	if err = protocol.Decode(encodedHeader, &fileHeader); err != nil {
+		return fmt.Errorf("%w: %v", errInvalidCatchpointManifest, err)
+	}
+	if fileHeader.Catchpoint != label {
+		return fmt.Errorf("%w: catchpoint label %s differs from %s", errInvalidCatchpointManifest, fileHeader.Catchpoint, label)
+	}
+	balancesChunks := uint64(0)
+	for _, entry := range spool.manifest.Entries {
+		if ledger.IsCatchpointBalancesFileName(entry.Name) {
+			balancesChunks++
+		}
+	}
+	if balancesChunks != fileHeader.TotalChunks {
+		return fmt.Errorf("%w: %d balances chunks were listed while the header has %d", errInvalidCatchpointManifest, balancesChunks, fileHeader.TotalChunks)
+	}
+	return nil
+}
+
+// downloadRange downloads the given range of chunks, retrying with other peers on failures. Each of the stored chunks
+// is reported on availableCh, if provided.
+func (plf *parallelLedgerFetcher) downloadRange(ctx context.Context, round basics.Round, spool *catchpointDownloadSpool, chunks rpcs.ChunksRange, availableCh chan<- uint64) error {
+	for attempt := 1; ; attempt++ {
+		psp, err := plf.peerSelector.getNextPeer()
+		if err != nil {
+			return err
+		}
+		start := time.Now()
+		stored, err := plf.getPeerChunks(ctx, psp.Peer, round, spool, chunks, availableCh)
+		if err == nil {
+			chunkDownloadDuration := time.Since(start) / time.Duration(chunks.Last-chunks.First+1)
+			plf.peerSelector.rankPeer(psp, plf.peerSelector.peerDownloadDurationToRank(psp, chunkDownloadDuration))
+			return nil
+		}
+		if ctx.Err() != nil {
+			return ctx.Err()
+		}
+		plf.log.Infof("failed to download catchpoint file chunks %d-%d from peer %s: %v", chunks.First, chunks.Last, peerAddress(psp.Peer), err)
+		switch {
+		case errors.Is(err, errChunkVerificationFailed):
+			plf.peerSelector.rankPeer(psp, peerRankInvalidDownload)
+		case errors.Is(err, errNoLedgerForRound), errors.Is(err, errChunksRangeNotSupported):
+			plf.peerSelector.rankPeer(psp, peerRankNoCatchpointForRound)
+		default:
+			plf.peerSelector.rankPeer(psp, peerRankDownloadFailed)
+		}
+		// don't download again the chunks we've already stored.
+		chunks.First += stored
+		if attempt >= plf.config.CatchupLedgerDownloadRetryAttempts {
+			return fmt.Errorf("exceeded number of attempts to download catchpoint file chunks %d-%d : %w", chunks.First, chunks.Last, err)
+		}
+	}
+}
+
+// getPeerChunks requests the given range of chunks from the peer, and stores them in the spool as they arrive.
+// It returns the number of chunks that were stored.
+func (plf *parallelLedgerFetcher) getPeerChunks(ctx context.Context, peer network.Peer, round basics.Round, spool *catchpointDownloadSpool, chunks rpcs.ChunksRange, availableCh chan<- uint64) (stored uint64, err error) {
+	httpPeer, ok := peer.(network.HTTPPeer)
+	if !ok {
+		return 0, errNonHTTPPeer
+	}
+	timeoutContext, timeoutContextCancel := context.WithTimeout(ctx, plf.config.MaxCatchpointDownloadDuration)
+	defer timeoutContextCancel()
+	header := http.Header{"Range": []string{chunks.String()}}
+	response, err := plf.requestLedgerPath(timeoutContext, httpPeer, ledgerPath(round), http.MethodGet, header)
+	if err != nil {
+		return 0, err
+	}
+	defer response.Body.Close()
+
+	switch response.StatusCode {
+	case http.StatusPartialContent:
+	case http.StatusOK:
+		return 0, errChunksRangeNotSupported
+	case http.StatusNotFound:
+		return 0, errNoLedgerForRound
+	default:
+		return 0, fmt.Errorf("getPeerChunks error response status code %d", response.StatusCode)
+	}
+	if contentType := response.Header.Get("Content-Type"); contentType != rpcs.LedgerResponseContentType {
+		return 0, fmt.Errorf("getPeerChunks : http ledger fetcher response has an invalid content type : %s", contentType)
+	}
+
+	watchdogReader := util.MakeWatchdogStreamReader(response.Body, catchpointFileStreamReadSize, 2*maxCatchpointFileChunkSize, plf.maxChunkDownloadDuration())
+	defer watchdogReader.Close()
+	tarReader := tar.NewReader(watchdogReader)
+	for idx := chunks.First; idx <= chunks.Last; idx++ {
+		tarHeader, err := tarReader.Next()
+		if err != nil {
+			return stored, err
+		}
+		entry := spool.manifest.Entries[idx]
+		if tarHeader.Name != entry.Name || uint64(tarHeader.Size) != entry.Size {
+			return stored, fmt.Errorf("%w: received chunk %s of size %d instead of %s of size %d", errChunkVerificationFailed, tarHeader.Name, tarHeader.Size, entry.Name, entry.Size)
+		}
+		chunk := make([]byte, tarHeader.Size)
+		if _, err = io.ReadFull(tarReader, chunk); err != nil {
+			return stored, err
+		}
+		if err = spool.storeChunk(idx, chunk); err != nil {
+			return stored, err
+		}
+		stored++
+		if availableCh != nil {
+			availableCh <- idx
+		}
+		if idx < chunks.Last {
+			if err = watchdogReader.Reset(); err != nil {
+				return stored, fmt.Errorf("getPeerChunks received the following error while reading the catchpoint file : %v", err)
+			}
+		}
+	}
+	return stored, nil
+}
+
+// catchpointDownloadSpool persists the verified chunks of a catchpoint file being downloaded, along with
+// the manifest describing them.
+type catchpointDownloadSpool struct {
+	dir      string
+	manifest *rpcs.CatchpointFileManifest
+
+	mu        deadlock.Mutex
+	available []bool
+}
+
+// openCatchpointDownloadSpool opens the download spool of the given round, loading the manifest and the chunks
+// persisted by a previous download attempt. Spools of any other round are removed.
+func openCatchpointDownloadSpool(baseDir string, round basics.Round) (*catchpointDownloadSpool, error) {
+	roundDirName := strconv.FormatUint(uint64(round), 10)
+	if dirEntries, err := os.ReadDir(baseDir); err == nil {
+		for _, dirEntry := range dirEntries {
+			if dirEntry.Name() != roundDirName {
+				os.RemoveAll(filepath.Join(baseDir, dirEntry.Name()))
+			}
+		}
+	}
+	spool := &catchpointDownloadSpool{dir: filepath.Join(baseDir, roundDirName)}
+	if err := os.MkdirAll(spool.dir, 0700); err != nil {
+		return nil, err
+	}
+	encodedManifest, err := os.ReadFile(filepath.Join(spool.dir, catchpointManifestFileName))
+	if err != nil {
+		if os.IsNotExist(err) {
+			return spool, nil
+		}
+		return nil, err
+	}
+	var manifest rpcs.CatchpointFileManifest
+	if protocol.Decode(encodedManifest, &manifest) != nil || validateCatchpointFileManifest(&manifest, round) != nil {
+		// start over if the persisted manifest can't be used.
+		spool.discard()
+		return spool, os.MkdirAll(spool.dir, 0700)
+	}
+	spool.manifest = &manifest
+	spool.available = make([]bool, len(manifest.Entries))
+	for idx := range manifest.Entries {
+		chunk, err := spool.readChunk(uint64(idx))
+		if err != nil {
+			continue
+		}
+		if spool.verifyChunk(uint64(idx), chunk) == nil {
+			spool.available[idx] = true
+		} else {
+			os.Remove(spool.chunkPath(uint64(idx)))
+		}
+	}
+	return spool, nil
+}
+
+// setManifest persists the manifest of the catchpoint file being downloaded
+func (s *catchpointDownloadSpool) setManifest(manifest *rpcs.CatchpointFileManifest) error {
+	err := writeFileAtomically(filepath.Join(s.dir, catchpointManifestFileName), protocol.Encode(manifest))
+	if err != nil {
+		return err
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.manifest = manifest
+	s.available = make([]bool, len(manifest.Entries))
+	return nil
+}
+
+func (s *catchpointDownloadSpool) chunkPath(idx uint64) string {
+	return filepath.Join(s.dir, strconv.FormatUint(idx, 10)+".chunk")
+}
+
+func (s *catchpointDownloadSpool) verifyChunk(idx uint64, chunk []byte) error {
+	entry := s.manifest.Entries[idx]
+	if uint64(len(chunk)) != entry.Size || crypto.Hash(chunk) != entry.Digest {
+		return fmt.Errorf("%w: digest of chunk %s differs", errChunkVerificationFailed, entry.Name)
+	}
+	return nil
+}
+
+// storeChunk verifies the chunk against the manifest and persists it
+func (s *catchpointDownloadSpool) storeChunk(idx uint64, chunk []byte) error {
+	if err := s.verifyChunk(idx, chunk); err != nil {
+		return err
+	}
+	if err := writeFileAtomically(s.chunkPath(idx), chunk); err != nil {
+		return err
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.available[idx] = true
+	return nil
+}
+
+func (s *catchpointDownloadSpool) readChunk(idx uint64) ([]byte, error) {
+	return os.ReadFile(s.chunkPath(idx))
+}
+
+func (s *catchpointDownloadSpool) has(idx uint64) bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.available[idx]
+}
+
+// availableChunks returns a copy of the chunks availability
+func (s *catchpointDownloadSpool) availableChunks() []bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return append([]bool(nil), s.available...)
+}
+
+// missingRanges splits the catchpoint file into approximately rangesCount ranges, and returns the
+// sub-ranges spanning the chunks that are still missing from each of them.
+func (s *catchpointDownloadSpool) missingRanges(rangesCount int) (ranges []rpcs.ChunksRange) {
+	available := s.availableChunks()
+	rangeLen := (len(available) + rangesCount - 1) / rangesCount
+	if rangeLen == 0 {
+		rangeLen = 1
+	}
+	for rangeStart := 0; rangeStart < len(available); rangeStart += rangeLen {
+		first, last := rangeStart, min(rangeStart+rangeLen, len(available))-1
+		for first <= last && available[first] {
+			first++
+		}
+		for last >= first && available[last] {
+			last--
+		}
+		if first <= last {
+			ranges = append(ranges, rpcs.ChunksRange{First: uint64(first), Last: uint64(last)})
+		}
+	}
+	return ranges
+}
+
+// discard removes the spool content from disk
+func (s *catchpointDownloadSpool) discard() {
+	os.RemoveAll(s.dir)
+}
+
+// writeFileAtomically writes the data into a temporary file, and renames it into the given path once complete
+func writeFileAtomically(path string, data []byte) error {
+	tmpPath := path + ".tmp"
+	if err := os.WriteFile(tmpPath, data, 0600); err != nil {
+		return err
+	}
+	return os.Rename(tmpPath, path)
+}
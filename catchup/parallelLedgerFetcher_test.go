@@ -0,0 +1,328 @@
+// Copyright (C) 2019-2025 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package catchup
+
+import (
+	"archive/tar"
+	"bytes"
+	"compress/gzip"
+	"context"
+	"fmt"
+	"net"
+	"net/http"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/gorilla/mux"
+	"github.com/stretchr/testify/require"
+
+	"github.com/algorand/go-algorand/components/mocks"
+	"github.com/algorand/go-algorand/config"
+	"github.com/algorand/go-algorand/crypto"
+	"github.com/algorand/go-algorand/data/basics"
+	"github.com/algorand/go-algorand/ledger"
+	"github.com/algorand/go-algorand/logging"
+	"github.com/algorand/go-algorand/network"
+	"github.com/algorand/go-algorand/protocol"
+	"github.com/algorand/go-algorand/rpcs"
+	"github.com/algorand/go-algorand/test/partitiontest"
+)
+
+const testCatchpointLabel = "100#AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA"
+
+// recordingCatchpointCatchupAccessor records the names of the chunks it was asked to process
+type recordingCatchpointCatchupAccessor struct {
+	mocks.MockCatchpointCatchupAccessor
+	mu        sync.Mutex
+	processed []string
+	dir       string
+}
+
+func (a *recordingCatchpointCatchupAccessor) ProcessStagingBalances(ctx context.Context, sectionName string, bytes []byte, progress *ledger.CatchpointCatchupAccessorProgress) (err error) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	a.processed = append(a.processed, sectionName)
+	return nil
+}
+
+func (a *recordingCatchpointCatchupAccessor) DownloadDir() string {
+	return a.dir
+}
+
+type staticCatchpointLedger struct {
+	catchpointFile []byte
+}
+
+func (l *staticCatchpointLedger) GetCatchpointStream(round basics.Round) (ledger.ReadCloseSizer, error) {
+	return staticCatchpointStream{bytes.NewReader(l.catchpointFile)}, nil
+}
+
+type staticCatchpointStream struct {
+	*bytes.Reader
+}
+
+func (s staticCatchpointStream) Size() (int64, error) {
+	return s.Reader.Size(), nil
+}
+
+func (s staticCatchpointStream) Close() error {
+	return nil
+}
+
+type routerRegistrar struct {
+	router *mux.Router
+}
+
+func (r *routerRegistrar) RegisterHTTPHandler(path string, handler http.Handler) {
+	r.router.Handle(path, handler)
+}
+
+// makeTestCatchpointChunks creates the chunks of a catchpoint file with the given number of balances chunks
+func makeTestCatchpointChunks(balancesChunks int, label string) (names []string, chunks [][]byte) {
+	header := ledger.CatchpointFileHeader{
+		Version:     ledger.CatchpointFileVersionV8,
+		TotalChunks: uint64(balancesChunks),
+		Catchpoint:  label,
+	}
+	names = append(names, ledger.CatchpointContentFileName)
+	chunks = append(chunks, protocol.Encode(&header))
+	for i := 1; i <= balancesChunks; i++ {
+		names = append(names, fmt.Sprintf("balances.%d.msgpack", i))
+		chunks = append(chunks, []byte(fmt.Sprintf("balances chunk %d", i)))
+	}
+	return names, chunks
+}
+
+func makeTestCatchpointFile(t *testing.T, names []string, chunks [][]byte) []byte {
+	buf := bytes.NewBuffer(nil)
+	gz := gzip.NewWriter(buf)
+	wtar := tar.NewWriter(gz)
+	for i, name := range names {
+		require.NoError(t, wtar.WriteHeader(&tar.Header{Name: name, Mode: 0600, Size: int64(len(chunks[i]))}))
+		_, err := wtar.Write(chunks[i])
+		require.NoError(t, err)
+	}
+	require.NoError(t, wtar.Close())
+	require.NoError(t, gz.Close())
+	return buf.Bytes()
+}
+
+// startTestLedgerServer starts an http server serving the given catchpoint file through a LedgerService.
+// When legacy is set, the server would only serve the catchpoint file as a single stream.
+func startTestLedgerServer(t *testing.T, catchpointFile []byte, legacy bool, rangeRequests *[]string) testHTTPPeer {
+	router := mux.NewRouter()
+	cfg := config.GetDefaultLocal()
+	cfg.EnableLedgerService = true
+	var ls *rpcs.LedgerService
+	if legacy {
+		ls = rpcs.MakeLedgerService(cfg, &staticCatchpointLedger{catchpointFile}, &routerRegistrar{mux.NewRouter()}, "mocknet")
+		router.Handle(rpcs.LedgerServiceLedgerPath, ls)
+	} else {
+		ls = rpcs.MakeLedgerService(cfg, &staticCatchpointLedger{catchpointFile}, &routerRegistrar{router}, "mocknet")
+	}
+	ls.Start()
+	var mu sync.Mutex
+	handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if rangeRequests != nil && r.Header.Get("Range") != "" {
+			mu.Lock()
+			*rangeRequests = append(*rangeRequests, r.Header.Get("Range"))
+			mu.Unlock()
+		}
+		router.ServeHTTP(w, r)
+	})
+	listener, err := net.Listen("tcp", "localhost:")
+	require.NoError(t, err)
+	s := &http.Server{Handler: handler}
+	go s.Serve(listener)
+	t.Cleanup(func() {
+		s.Close()
+		ls.Stop()
+	})
+	return testHTTPPeer(listener.Addr().String())
+}
+
+// roundRobinPeerSelector is a peerSelector which hands out the given peers in turn
+type roundRobinPeerSelector struct {
+	mu    sync.Mutex
+	peers []network.Peer
+	next  int
+	ranks map[network.Peer][]int
+}
+
+func (ps *roundRobinPeerSelector) rankPeer(psp *peerSelectorPeer, rank int) (int, int) {
+	ps.mu.Lock()
+	defer ps.mu.Unlock()
+	ps.ranks[psp.Peer] = append(ps.ranks[psp.Peer], rank)
+	return 0, rank
+}
+
+func (ps *roundRobinPeerSelector) peerDownloadDurationToRank(psp *peerSelectorPeer, blockDownloadDuration time.Duration) (rank int) {
+	return peerRankInitialFirstPriority
+}
+
+func (ps *roundRobinPeerSelector) getNextPeer() (psp *peerSelectorPeer, err error) {
+	ps.mu.Lock()
+	defer ps.mu.Unlock()
+	peer := ps.peers[ps.next%len(ps.peers)]
+	ps.next++
+	return &peerSelectorPeer{Peer: peer}, nil
+}
+
+func makeRoundRobinPeerSelector(peers ...network.Peer) *roundRobinPeerSelector {
+	return &roundRobinPeerSelector{peers: peers, ranks: make(map[network.Peer][]int)}
+}
+
+func TestParallelLedgerFetcher(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	names, chunks := makeTestCatchpointChunks(20, testCatchpointLabel)
+	catchpointFile := makeTestCatchpointFile(t, names, chunks)
+	peerA := startTestLedgerServer(t, catchpointFile, false, nil)
+	peerB := startTestLedgerServer(t, catchpointFile, false, nil)
+
+	accessor := &recordingCatchpointCatchupAccessor{dir: t.TempDir()}
+	cfg := config.GetDefaultLocal()
+	lf := makeLedgerFetcher(&mocks.MockNetwork{}, accessor, logging.TestingLog(t), &dummyLedgerFetcherReporter{}, cfg)
+	ps := makeRoundRobinPeerSelector(&peerA, &peerB)
+	plf := makeParallelLedgerFetcher(lf, ps, 3, accessor.dir)
+
+	err := plf.downloadLedger(context.Background(), basics.Round(100), testCatchpointLabel)
+	require.NoError(t, err)
+	require.Equal(t, names, accessor.processed)
+	require.NotEmpty(t, ps.ranks[&peerA])
+	require.NotEmpty(t, ps.ranks[&peerB])
+
+	// a catchpoint file with a different label is rejected, and its spool is discarded
+	accessor.processed = nil
+	err = plf.downloadLedger(context.Background(), basics.Round(100), "100#BBBBBBBBBBBBBBBBBBBBBBBBBBBBBBBBBBBBBBBBBBBBBBBBBBBB")
+	require.ErrorIs(t, err, errInvalidCatchpointManifest)
+	require.Empty(t, accessor.processed)
+}
+
+func TestParallelLedgerFetcherResume(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	names, chunks := makeTestCatchpointChunks(8, testCatchpointLabel)
+	catchpointFile := makeTestCatchpointFile(t, names, chunks)
+	var rangeRequests []string
+	peer := startTestLedgerServer(t, catchpointFile, false, &rangeRequests)
+
+	// simulate a previous download attempt which has downloaded some of the chunks
+	spoolDir := t.TempDir()
+	manifest := &rpcs.CatchpointFileManifest{Round: 100}
+	for i := range names {
+		manifest.Entries = append(manifest.Entries, rpcs.CatchpointFileManifestEntry{Name: names[i], Size: uint64(len(chunks[i])), Digest: crypto.Hash(chunks[i])})
+	}
+	spool, err := openCatchpointDownloadSpool(spoolDir, basics.Round(100))
+	require.NoError(t, err)
+	require.NoError(t, spool.setManifest(manifest))
+	for _, idx := range []uint64{0, 1, 2, 3, 7, 8} {
+		require.NoError(t, spool.storeChunk(idx, chunks[idx]))
+	}
+	// corrupt chunks are dropped when the spool is reopened
+	require.NoError(t, writeFileAtomically(spool.chunkPath(8), []byte("corrupted")))
+
+	accessor := &recordingCatchpointCatchupAccessor{dir: spoolDir}
+	lf := makeLedgerFetcher(&mocks.MockNetwork{}, accessor, logging.TestingLog(t), &dummyLedgerFetcherReporter{}, config.GetDefaultLocal())
+	plf := makeParallelLedgerFetcher(lf, makeRoundRobinPeerSelector(&peer), 1, spoolDir)
+	err = plf.downloadLedger(context.Background(), basics.Round(100), testCatchpointLabel)
+	require.NoError(t, err)
+	require.Equal(t, names, accessor.processed)
+	require.Equal(t, []string{"chunks=4-5", "chunks=6-8"}, rangeRequests)
+}
+
+func TestParallelLedgerFetcherInvalidPeer(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	names, chunks := makeTestCatchpointChunks(4, testCatchpointLabel)
+	catchpointFile := makeTestCatchpointFile(t, names, chunks)
+	goodPeerA := startTestLedgerServer(t, catchpointFile, false, nil)
+	goodPeerB := startTestLedgerServer(t, catchpointFile, false, nil)
+	corruptedChunks := append([][]byte(nil), chunks...)
+	corruptedChunks[2] = []byte("balances chunk X")
+	badPeer := startTestLedgerServer(t, makeTestCatchpointFile(t, names, corruptedChunks), false, nil)
+
+	accessor := &recordingCatchpointCatchupAccessor{dir: t.TempDir()}
+	lf := makeLedgerFetcher(&mocks.MockNetwork{}, accessor, logging.TestingLog(t), &dummyLedgerFetcherReporter{}, config.GetDefaultLocal())
+	ps := makeRoundRobinPeerSelector(&goodPeerA, &badPeer, &goodPeerB)
+	plf := makeParallelLedgerFetcher(lf, ps, 1, accessor.dir)
+	err := plf.downloadLedger(context.Background(), basics.Round(100), testCatchpointLabel)
+	require.NoError(t, err)
+	require.Equal(t, names, accessor.processed)
+	require.Contains(t, ps.ranks[&badPeer], peerRankInvalidDownload)
+	require.NotContains(t, ps.ranks[&goodPeerA], peerRankInvalidDownload)
+	require.NotContains(t, ps.ranks[&goodPeerB], peerRankInvalidDownload)
+}
+
+func TestParallelLedgerFetcherUnconfirmedManifest(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	names, chunks := makeTestCatchpointChunks(4, testCatchpointLabel)
+	goodPeer := startTestLedgerServer(t, makeTestCatchpointFile(t, names, chunks), false, nil)
+	corruptedChunks := append([][]byte(nil), chunks...)
+	corruptedChunks[2] = []byte("balances chunk X")
+	badPeer := startTestLedgerServer(t, makeTestCatchpointFile(t, names, corruptedChunks), false, nil)
+
+	// with the two peers disagreeing on the manifest, neither of them can be trusted to verify the chunks;
+	// the download falls back to a single stream rather than ranking the honest peer as invalid.
+	accessor := &recordingCatchpointCatchupAccessor{dir: t.TempDir()}
+	cfg := config.GetDefaultLocal()
+	cfg.CatchupLedgerDownloadRetryAttempts = 4
+	lf := makeLedgerFetcher(&mocks.MockNetwork{}, accessor, logging.TestingLog(t), &dummyLedgerFetcherReporter{}, cfg)
+	ps := makeRoundRobinPeerSelector(&goodPeer, &badPeer)
+	plf := makeParallelLedgerFetcher(lf, ps, 1, accessor.dir)
+	err := plf.downloadLedger(context.Background(), basics.Round(100), testCatchpointLabel)
+	require.ErrorIs(t, err, errChunkedDownloadUnavailable)
+	require.Empty(t, accessor.processed)
+	require.NotContains(t, ps.ranks[&goodPeer], peerRankInvalidDownload)
+}
+
+func TestParallelLedgerFetcherLegacyPeers(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	names, chunks := makeTestCatchpointChunks(4, testCatchpointLabel)
+	peer := startTestLedgerServer(t, makeTestCatchpointFile(t, names, chunks), true, nil)
+
+	accessor := &recordingCatchpointCatchupAccessor{}
+	cfg := config.GetDefaultLocal()
+	cfg.CatchupLedgerDownloadRetryAttempts = 3
+	lf := makeLedgerFetcher(&mocks.MockNetwork{}, accessor, logging.TestingLog(t), &dummyLedgerFetcherReporter{}, cfg)
+	plf := makeParallelLedgerFetcher(lf, makeRoundRobinPeerSelector(&peer), 2, "")
+	err := plf.downloadLedger(context.Background(), basics.Round(100), testCatchpointLabel)
+	require.ErrorIs(t, err, errChunkedDownloadUnavailable)
+	require.Empty(t, accessor.processed)
+}
+
+func TestCatchpointDownloadSpoolMissingRanges(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	spool := &catchpointDownloadSpool{available: make([]bool, 10)}
+	require.Equal(t, []rpcs.ChunksRange{{First: 0, Last: 2}, {First: 3, Last: 5}, {First: 6, Last: 8}, {First: 9, Last: 9}}, spool.missingRanges(4))
+
+	for _, idx := range []int{0, 4, 5, 8, 9} {
+		spool.available[idx] = true
+	}
+	require.Equal(t, []rpcs.ChunksRange{{First: 1, Last: 2}, {First: 3, Last: 3}, {First: 6, Last: 7}}, spool.missingRanges(4))
+	require.Equal(t, []rpcs.ChunksRange{{First: 1, Last: 7}}, spool.missingRanges(1))
+}
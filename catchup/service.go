@@ -397,6 +397,10 @@ func (s *Service) fetchAndWrite(ctx context.Context, r basics.Round, prevFetchCo
 		peerRank := peerSelector.peerDownloadDurationToRank(psp, blockDownloadDuration)
 		r1, r2 := peerSelector.rankPeer(psp, peerRank)
 		s.log.Debugf("fetchAndWrite(%d): ranked peer with %d from %d to %d", r, peerRank, r1, r2)
+		if blockDownloadDuration >= highBlockDownloadThreshold {
+			// the block is valid, but took longer than any block download we rank by duration
+			s.net.ReportPeerEvent(peer, network.PeerEventSlowBlockResponse)
+		}
 
 		// Write to ledger, noting that ledger writes must be in order
 		select {
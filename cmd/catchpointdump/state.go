@@ -0,0 +1,202 @@
+// Copyright (C) 2019-2025 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package main
+
+import (
+	"bufio"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/url"
+	"os"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/algorand/go-algorand/daemon/algod/api/client"
+	"github.com/algorand/go-algorand/ledger"
+	"github.com/algorand/go-algorand/ledger/store/trackerdb/sqlitedriver"
+	"github.com/algorand/go-algorand/nodecontrol"
+)
+
+func init() {
+	rootCmd.AddCommand(stateCmd)
+	stateCmd.AddCommand(stateDumpCmd)
+	stateCmd.AddCommand(stateDiffCmd)
+
+	stateDumpCmd.Flags().StringVarP(&outFileName, "output", "o", "", "Specify an outfile for the dump ( i.e. state.dump.txt )")
+}
+
+const stateSourceHelp = `A state source is one of:
+  - a state dump file, as written by "state dump"
+  - a ledger tracker database file ( i.e. ./ledger.tracker.sqlite )
+  - the data directory of a running node
+  - the REST endpoint of a running node, with its admin API token as the user name ( i.e. http://<token>@127.0.0.1:8080 )`
+
+var stateCmd = &cobra.Command{
+	Use:   "state",
+	Short: "Dump or compare the ledger state",
+	Long:  "Dump the ledger state held by the tracker database as a canonical, sorted and hash chained list of all the accounts, resources, key-values and online accounts, or compare two such dumps.\n\n" + stateSourceHelp,
+	Args:  validateNoPosArgsFn,
+	Run: func(cmd *cobra.Command, args []string) {
+		//If no arguments passed, we should fallback to help
+		cmd.HelpFunc()(cmd, args)
+	},
+}
+
+var stateDumpCmd = &cobra.Command{
+	Use:   "dump [source]",
+	Short: "Write a canonical dump of the ledger state",
+	Long:  "Write a canonical dump of the ledger state at the latest round committed to the tracker database. Two nodes holding the same state produce identical dumps.\n\n" + stateSourceHelp,
+	Args:  cobra.ExactArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		outFile := os.Stdout
+		var err error
+		if outFileName != "" {
+			outFile, err = os.OpenFile(outFileName, os.O_RDWR|os.O_TRUNC|os.O_CREATE, 0644)
+			if err != nil {
+				reportErrorf("Unable to create file '%s' : %v", outFileName, err)
+			}
+			defer outFile.Close()
+		}
+
+		source, err := openStateSource(context.Background(), args[0])
+		if err != nil {
+			reportErrorf("Unable to open state source '%s' : %v", args[0], err)
+		}
+		defer source.Close()
+		_, err = io.Copy(outFile, source)
+		if err != nil {
+			reportErrorf("Unable to dump state from '%s' : %v", args[0], err)
+		}
+	},
+}
+
+var stateDiffCmd = &cobra.Command{
+	Use:   "diff [source] [source]",
+	Short: "Compare the ledger state of two sources",
+	Long:  "Compare the ledger state of two sources at the same round, and report the first divergent record. Sources at different rounds are refused, as their states are expected to differ.\n\n" + stateSourceHelp,
+	Args:  cobra.ExactArgs(2),
+	Run: func(cmd *cobra.Command, args []string) {
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+
+		sourceA, err := openStateSource(ctx, args[0])
+		if err != nil {
+			reportErrorf("Unable to open state source '%s' : %v", args[0], err)
+		}
+		defer sourceA.Close()
+		sourceB, err := openStateSource(ctx, args[1])
+		if err != nil {
+			reportErrorf("Unable to open state source '%s' : %v", args[1], err)
+		}
+		defer sourceB.Close()
+
+		diff, err := ledger.DiffStateDumps(sourceA, sourceB)
+		if errors.Is(err, ledger.ErrStateDumpRoundMismatch) {
+			reportErrorf("Unable to compare state : %v; dump both sources once they have committed the same round", err)
+		}
+		if err != nil {
+			reportErrorf("Unable to compare state : %v", err)
+		}
+		if diff.Identical() {
+			reportInfof("The states are identical ( %d records compared ).", diff.Compared)
+			return
+		}
+		reportInfof("The states diverge after %d identical records.", diff.Compared)
+		printStateDumpRecord(args[0], diff.A)
+		printStateDumpRecord(args[1], diff.B)
+		os.Exit(1)
+	},
+}
+
+func printStateDumpRecord(source string, rec *ledger.StateDumpRecord) {
+	if rec == nil {
+		reportInfof("%s : no matching record", source)
+		return
+	}
+	value, err := rec.DecodeValue()
+	if err != nil {
+		value = fmt.Sprintf("<%v>", err)
+	}
+	reportInfof("%s : %s\n  %s", source, rec, value)
+}
+
+// openStateSource returns a reader streaming the state dump of the given source
+func openStateSource(ctx context.Context, source string) (io.ReadCloser, error) {
+	if strings.HasPrefix(source, "http://") || strings.HasPrefix(source, "https://") {
+		u, err := url.Parse(source)
+		if err != nil {
+			return nil, err
+		}
+		token := u.User.Username()
+		u.User = nil
+		restClient := client.MakeRestClient(*u, token)
+		return streamStateDump(func(w io.Writer) error { return restClient.DumpLedgerState(ctx, w) }), nil
+	}
+
+	info, err := os.Stat(source)
+	if err != nil {
+		return nil, err
+	}
+	if info.IsDir() {
+		restClient, err := nodecontrol.MakeNodeController("", source).AlgodClient()
+		if err != nil {
+			return nil, err
+		}
+		return streamStateDump(func(w io.Writer) error { return restClient.DumpLedgerState(ctx, w) }), nil
+	}
+
+	f, err := os.Open(source)
+	if err != nil {
+		return nil, err
+	}
+	reader := bufio.NewReader(f)
+	header, err := reader.Peek(16)
+	if err != nil && err != io.EOF {
+		f.Close()
+		return nil, err
+	}
+	if !strings.HasPrefix(string(header), "SQLite format 3") {
+		// a state dump file
+		return struct {
+			io.Reader
+			io.Closer
+		}{reader, f}, nil
+	}
+	f.Close()
+
+	store, err := sqlitedriver.Open(source, false, log)
+	if err != nil {
+		return nil, err
+	}
+	return streamStateDump(func(w io.Writer) error {
+		defer store.Close()
+		_, err := ledger.DumpTrackerState(ctx, store, w)
+		return err
+	}), nil
+}
+
+// streamStateDump runs the given dump function in the background, and returns a reader for its output
+func streamStateDump(dump func(w io.Writer) error) io.ReadCloser {
+	reader, writer := io.Pipe()
+	go func() {
+		writer.CloseWithError(dump(writer))
+	}()
+	return reader
+}
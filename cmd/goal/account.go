@@ -36,6 +36,7 @@ import (
 	"github.com/algorand/go-algorand/crypto"
 	"github.com/algorand/go-algorand/crypto/passphrase"
 	apiClient "github.com/algorand/go-algorand/daemon/algod/api/client"
+	v2 "github.com/algorand/go-algorand/daemon/algod/api/server/v2"
 	"github.com/algorand/go-algorand/daemon/algod/api/server/v2/generated/model"
 	algodAcct "github.com/algorand/go-algorand/data/account"
 	"github.com/algorand/go-algorand/data/basics"
@@ -71,6 +72,7 @@ var (
 	listAccountInfo    bool
 	onlyShowAssetIDs   bool
 	partKeyIDToDelete  string
+	rekeyForce         bool
 
 	next  string
 	limit uint64
@@ -95,6 +97,7 @@ func init() {
 	accountCmd.AddCommand(accountMultisigCmd)
 	accountCmd.AddCommand(markNonparticipatingCmd)
 	accountCmd.AddCommand(deletePartKeyCmd)
+	accountCmd.AddCommand(rekeyCmd)
 
 	accountMultisigCmd.AddCommand(newMultisigCmd)
 	accountMultisigCmd.AddCommand(deleteMultisigCmd)
@@ -226,6 +229,20 @@ func init() {
 	markNonparticipatingCmd.Flags().MarkDeprecated("firstRound", "use --firstvalid instead")
 	markNonparticipatingCmd.Flags().MarkDeprecated("validRounds", "use --validrounds instead")
 
+	// rekeyCmd flags
+	rekeyCmd.Flags().StringVarP(&accountAddress, "address", "a", "", "Account address to rekey (required)")
+	rekeyCmd.MarkFlagRequired("address")
+	rekeyCmd.Flags().StringVar(&rekeyToAddress, "to", "", "Address of the key or multisig account that will be authorized to sign for the account (required)")
+	rekeyCmd.MarkFlagRequired("to")
+	rekeyCmd.Flags().BoolVar(&rekeyForce, "force", false, "Rekey even if the wallet does not hold the key of the new address")
+	rekeyCmd.Flags().StringVarP(&signerAddress, "signer", "S", "", "Address of key to sign with (defaults to the current auth address of the account)")
+	rekeyCmd.Flags().Uint64VarP(&transactionFee, "fee", "f", 0, "The Fee to set on the rekey transaction (defaults to suggested fee)")
+	rekeyCmd.Flags().Uint64VarP(&firstValid, "firstvalid", "", 0, "FirstValid for the rekey transaction (0 for current)")
+	rekeyCmd.Flags().Uint64VarP(&numValidRounds, "validrounds", "v", 0, "The validity period for the rekey transaction")
+	rekeyCmd.Flags().Uint64Var(&lastValid, "lastvalid", 0, "The last round where the transaction may be committed to the ledger")
+	rekeyCmd.Flags().StringVarP(&statusChangeTxFile, "txfile", "t", "", "Write the signed rekey transaction to this file, rather than posting to network")
+	rekeyCmd.Flags().BoolVarP(&noWaitAfterSend, "no-wait", "N", false, "Don't wait for transaction to commit")
+
 	dumpCmd.Flags().StringVarP(&dumpOutFile, "outfile", "o", "", "Save balance record to specified output file")
 	dumpCmd.Flags().StringVarP(&accountAddress, "address", "a", "", "Account address to retrieve balance (required)")
 	balanceCmd.MarkFlagRequired("address")
@@ -952,7 +969,7 @@ func changeAccountOnlineStatus(
 
 	// Sign & broadcast the transaction
 	wh, pw := ensureWalletHandleMaybePassword(dataDir, wallet, true)
-	signedTxn, err := client.SignTransactionWithWalletAndSigner(wh, pw, signerAddress, utx)
+	signedTxn, err := client.SignTransactionWithWalletAndAuthAddr(wh, pw, signerAddress, utx)
 	if err != nil {
 		return fmt.Errorf(errorSigningTX, err)
 	}
@@ -1540,7 +1557,7 @@ var markNonparticipatingCmd = &cobra.Command{
 
 		// Sign & broadcast the transaction
 		wh, pw := ensureWalletHandleMaybePassword(dataDir, walletName, true)
-		signedTxn, err := client.SignTransactionWithWalletAndSigner(wh, pw, signerAddress, utx)
+		signedTxn, err := client.SignTransactionWithWalletAndAuthAddr(wh, pw, signerAddress, utx)
 		if err != nil {
 			reportErrorf(errorSigningTX, err)
 		}
@@ -1562,3 +1579,89 @@ var markNonparticipatingCmd = &cobra.Command{
 		}
 	},
 }
+
+var rekeyCmd = &cobra.Command{
+	Use:   "rekey",
+	Short: "Authorize another key or multisig account to sign for an account",
+	Long:  "Rekey an account, so that its transactions must be signed by the key or multisig account given with --to instead of its current auth address. Rekeying to the account itself restores its own key. The rekey transaction is signed with the current auth address of the account, simulated, and then sent. To avoid losing control of the account, the new address must be a key of the wallet, or a multisig account of the wallet for which it holds enough keys, unless --force is given.",
+	Args:  validateNoPosArgsFn,
+	Run: func(cmd *cobra.Command, args []string) {
+		checkTxValidityPeriodCmdFlags(cmd)
+
+		rekeyTo, err := basics.UnmarshalChecksumAddress(rekeyToAddress)
+		if err != nil {
+			reportErrorf(failDecodeAddressError, err)
+		}
+
+		dataDir := datadir.EnsureSingleDataDir()
+		client := ensureFullClient(dataDir)
+		authAddr, err := client.AuthAddr(accountAddress)
+		if err != nil {
+			reportErrorf(errorRequestFail, err)
+		}
+		if authAddr == rekeyTo {
+			reportErrorf(rekeyAlreadyAuthError, accountAddress, rekeyTo)
+		}
+
+		wh, pw := ensureWalletHandleMaybePassword(dataDir, walletName, true)
+		if !rekeyForce {
+			err = client.CheckWalletCanSign(wh, rekeyTo)
+			if err != nil {
+				reportErrorf(rekeyTargetNotHeldError, rekeyTo, err)
+			}
+		}
+
+		firstTxRound, lastTxRound, _, err := client.ComputeValidityRounds(firstValid, lastValid, numValidRounds)
+		if err != nil {
+			reportErrorf(errorConstructingTX, err)
+		}
+		utx, err := client.MakeUnsignedRekeyTx(accountAddress, rekeyTo, transactionFee, basics.Round(firstTxRound), basics.Round(lastTxRound))
+		if err != nil {
+			reportErrorf(errorConstructingTX, err)
+		}
+		signedTxn, err := client.SignTransactionWithWalletAndAuthAddr(wh, pw, signerAddress, utx)
+		if err != nil {
+			reportErrorf(errorSigningTX, err)
+		}
+
+		if statusChangeTxFile != "" {
+			err = writeFile(statusChangeTxFile, protocol.Encode(&signedTxn), 0600)
+			if err != nil {
+				reportErrorf(fileWriteError, statusChangeTxFile, err)
+			}
+			return
+		}
+
+		// Simulate the transaction first, so that a wrong signer or an
+		// insufficient balance is reported without spending a fee
+		simulateRequest := v2.PreEncodedSimulateRequest{
+			TxnGroups: []v2.PreEncodedSimulateRequestTransactionGroup{
+				{Txns: []transactions.SignedTxn{signedTxn}},
+			},
+		}
+		simulateResponse, err := client.SimulateTransactions(simulateRequest)
+		if err != nil {
+			reportErrorf(rekeySimulateError, err)
+		}
+		if len(simulateResponse.TxnGroups) > 0 && simulateResponse.TxnGroups[0].FailureMessage != nil {
+			reportErrorf(rekeySimulateError, *simulateResponse.TxnGroups[0].FailureMessage)
+		}
+
+		txid, err := client.BroadcastTransaction(signedTxn)
+		if err != nil {
+			reportErrorf(errorBroadcastingTX, err)
+		}
+		fmt.Printf("Transaction id for rekey transaction: %s\n", txid)
+
+		if noWaitAfterSend {
+			fmt.Println("Note: the auth address will not change until transaction is finalized")
+			return
+		}
+
+		_, err = waitForCommit(client, txid, lastTxRound)
+		if err != nil {
+			reportErrorf("error waiting for transaction to be committed: %v", err)
+		}
+		reportInfof(infoRekeyed, accountAddress, rekeyTo)
+	},
+}
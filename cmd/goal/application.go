@@ -478,7 +478,7 @@ var createAppCmd = &cobra.Command{
 		if outFilename == "" {
 			// Broadcast
 			wh, pw := ensureWalletHandleMaybePassword(dataDir, walletName, true)
-			signedTxn, err2 := client.SignTransactionWithWalletAndSigner(wh, pw, signerAddress, tx)
+			signedTxn, err2 := client.SignTransactionWithWalletAndAuthAddr(wh, pw, signerAddress, tx)
 			if err2 != nil {
 				reportErrorf(errorSigningTX, err2)
 			}
@@ -553,7 +553,7 @@ var updateAppCmd = &cobra.Command{
 		// Broadcast or write transaction to file
 		if outFilename == "" {
 			wh, pw := ensureWalletHandleMaybePassword(dataDir, walletName, true)
-			signedTxn, err2 := client.SignTransactionWithWalletAndSigner(wh, pw, signerAddress, tx)
+			signedTxn, err2 := client.SignTransactionWithWalletAndAuthAddr(wh, pw, signerAddress, tx)
 			if err2 != nil {
 				reportErrorf(errorSigningTX, err2)
 			}
@@ -623,7 +623,7 @@ var optInAppCmd = &cobra.Command{
 		// Broadcast or write transaction to file
 		if outFilename == "" {
 			wh, pw := ensureWalletHandleMaybePassword(dataDir, walletName, true)
-			signedTxn, err2 := client.SignTransactionWithWalletAndSigner(wh, pw, signerAddress, tx)
+			signedTxn, err2 := client.SignTransactionWithWalletAndAuthAddr(wh, pw, signerAddress, tx)
 			if err2 != nil {
 				reportErrorf(errorSigningTX, err2)
 			}
@@ -693,7 +693,7 @@ var closeOutAppCmd = &cobra.Command{
 		// Broadcast or write transaction to file
 		if outFilename == "" {
 			wh, pw := ensureWalletHandleMaybePassword(dataDir, walletName, true)
-			signedTxn, err2 := client.SignTransactionWithWalletAndSigner(wh, pw, signerAddress, tx)
+			signedTxn, err2 := client.SignTransactionWithWalletAndAuthAddr(wh, pw, signerAddress, tx)
 			if err2 != nil {
 				reportErrorf(errorSigningTX, err2)
 			}
@@ -763,7 +763,7 @@ var clearAppCmd = &cobra.Command{
 		// Broadcast or write transaction to file
 		if outFilename == "" {
 			wh, pw := ensureWalletHandleMaybePassword(dataDir, walletName, true)
-			signedTxn, err2 := client.SignTransactionWithWalletAndSigner(wh, pw, signerAddress, tx)
+			signedTxn, err2 := client.SignTransactionWithWalletAndAuthAddr(wh, pw, signerAddress, tx)
 			if err2 != nil {
 				reportErrorf(errorSigningTX, err2)
 			}
@@ -833,7 +833,7 @@ var callAppCmd = &cobra.Command{
 		// Broadcast or write transaction to file
 		if outFilename == "" {
 			wh, pw := ensureWalletHandleMaybePassword(dataDir, walletName, true)
-			signedTxn, err2 := client.SignTransactionWithWalletAndSigner(wh, pw, signerAddress, tx)
+			signedTxn, err2 := client.SignTransactionWithWalletAndAuthAddr(wh, pw, signerAddress, tx)
 			if err2 != nil {
 				reportErrorf(errorSigningTX, err2)
 			}
@@ -903,7 +903,7 @@ var deleteAppCmd = &cobra.Command{
 		// Broadcast or write transaction to file
 		if outFilename == "" {
 			wh, pw := ensureWalletHandleMaybePassword(dataDir, walletName, true)
-			signedTxn, err2 := client.SignTransactionWithWalletAndSigner(wh, pw, signerAddress, tx)
+			signedTxn, err2 := client.SignTransactionWithWalletAndAuthAddr(wh, pw, signerAddress, tx)
 			if err2 != nil {
 				reportErrorf(errorSigningTX, err2)
 			}
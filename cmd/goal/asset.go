@@ -307,7 +307,7 @@ var createAssetCmd = &cobra.Command{
 
 		if outFilename == "" {
 			wh, pw := ensureWalletHandleMaybePassword(dataDir, walletName, true)
-			signedTxn, err2 := client.SignTransactionWithWalletAndSigner(wh, pw, signerAddress, tx)
+			signedTxn, err2 := client.SignTransactionWithWalletAndAuthAddr(wh, pw, signerAddress, tx)
 			if err2 != nil {
 				reportErrorf(errorSigningTX, err2)
 			}
@@ -386,7 +386,7 @@ var destroyAssetCmd = &cobra.Command{
 
 		if outFilename == "" {
 			wh, pw := ensureWalletHandleMaybePassword(dataDir, walletName, true)
-			signedTxn, err2 := client.SignTransactionWithWalletAndSigner(wh, pw, signerAddress, tx)
+			signedTxn, err2 := client.SignTransactionWithWalletAndAuthAddr(wh, pw, signerAddress, tx)
 			if err2 != nil {
 				reportErrorf(errorSigningTX, err2)
 			}
@@ -479,7 +479,7 @@ var configAssetCmd = &cobra.Command{
 
 		if outFilename == "" {
 			wh, pw := ensureWalletHandleMaybePassword(dataDir, walletName, true)
-			signedTxn, err2 := client.SignTransactionWithWalletAndSigner(wh, pw, signerAddress, tx)
+			signedTxn, err2 := client.SignTransactionWithWalletAndAuthAddr(wh, pw, signerAddress, tx)
 			if err2 != nil {
 				reportErrorf(errorSigningTX, err2)
 			}
@@ -566,7 +566,7 @@ var sendAssetCmd = &cobra.Command{
 
 		if outFilename == "" {
 			wh, pw := ensureWalletHandleMaybePassword(dataDir, walletName, true)
-			signedTxn, err2 := client.SignTransactionWithWalletAndSigner(wh, pw, signerAddress, tx)
+			signedTxn, err2 := client.SignTransactionWithWalletAndAuthAddr(wh, pw, signerAddress, tx)
 			if err2 != nil {
 				reportErrorf(errorSigningTX, err2)
 			}
@@ -635,7 +635,7 @@ var freezeAssetCmd = &cobra.Command{
 
 		if outFilename == "" {
 			wh, pw := ensureWalletHandleMaybePassword(dataDir, walletName, true)
-			signedTxn, err2 := client.SignTransactionWithWalletAndSigner(wh, pw, signerAddress, tx)
+			signedTxn, err2 := client.SignTransactionWithWalletAndAuthAddr(wh, pw, signerAddress, tx)
 			if err2 != nil {
 				reportErrorf(errorSigningTX, err2)
 			}
@@ -724,7 +724,7 @@ var optinAssetCmd = &cobra.Command{
 
 		if outFilename == "" {
 			wh, pw := ensureWalletHandleMaybePassword(dataDir, walletName, true)
-			signedTxn, err2 := client.SignTransactionWithWalletAndSigner(wh, pw, signerAddress, tx)
+			signedTxn, err2 := client.SignTransactionWithWalletAndAuthAddr(wh, pw, signerAddress, tx)
 			if err2 != nil {
 				reportErrorf(errorSigningTX, err2)
 			}
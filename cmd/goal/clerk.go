@@ -234,7 +234,7 @@ func createSignedTransaction(client libgoal.Client, signTx bool, dataDir string,
 		// Sign the transaction
 		wh, pw := ensureWalletHandleMaybePassword(dataDir, walletName, true)
 		if signer.IsZero() {
-			stxn, err = client.SignTransactionWithWallet(wh, pw, tx)
+			stxn, err = client.SignTransactionWithWalletAndAuthAddr(wh, pw, "", tx)
 		} else {
 			stxn, err = client.SignTransactionWithWalletAndSigner(wh, pw, signer.String(), tx)
 		}
@@ -876,7 +876,7 @@ var signCmd = &cobra.Command{
 					signedTxn = txnGroup[i]
 				} else {
 					// sign the usual way
-					signedTxn, err = client.SignTransactionWithWalletAndSigner(wh, pw, signerAddress, txnGroup[i].Txn)
+					signedTxn, err = client.SignTransactionWithWalletAndAuthAddr(wh, pw, signerAddress, txnGroup[i].Txn)
 					if err != nil {
 						reportErrorf(errorSigningTX, err)
 					}
@@ -614,7 +614,7 @@ var appExecuteCmd = &cobra.Command{
 
 		if outFilename == "" {
 			wh, pw := ensureWalletHandleMaybePassword(dataDir, walletName, true)
-			signedTxn, err2 := client.SignTransactionWithWalletAndSigner(wh, pw, signerAddress, tx)
+			signedTxn, err2 := client.SignTransactionWithWalletAndAuthAddr(wh, pw, signerAddress, tx)
 			if err2 != nil {
 				reportErrorf(errorSigningTX, err2)
 			}
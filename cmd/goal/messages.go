@@ -92,6 +92,11 @@ const (
 	errorCatchpointLabelMissing             = "A catchpoint argument is needed: %s: %s"
 	errorUnableToLookupCatchpointLabel      = "Unable to fetch catchpoint label"
 	errorTooManyCatchpointLabels            = "The catchup command expect a single catchpoint"
+	errorSnapshotExport                     = "Unable to export ledger snapshot: %v"
+	errorSnapshotImport                     = "Unable to import ledger snapshot: %v"
+	errorSnapshotNodeRunning                = "Node must be stopped before importing a ledger snapshot"
+	infoSnapshotExported                    = "Ledger snapshot exported to: %s"
+	infoSnapshotImported                    = "Ledger snapshot of %s at round %d imported successfully"
 
 	// Asset
 	malformedMetadataHash = "Cannot base64-decode metadata hash %s: %s"
@@ -133,6 +138,10 @@ const (
 	noOutputFileError          = "--msig-params must be specified with an output file name (-o)"
 	infoAutoFeeSet             = "Automatically set fee to %d MicroAlgos"
 	errorTransactionExpired    = "Transaction %s expired before it could be included in a block"
+	rekeyAlreadyAuthError      = "Account %s is already authorized by %s"
+	rekeyTargetNotHeldError    = "Refusing to rekey to %s: %v. Use --force if its key is held elsewhere"
+	rekeySimulateError         = "Simulation of the rekey transaction failed: %s"
+	infoRekeyed                = "Account %s is now authorized by %s"
 
 	loggingNotConfigured = "Remote logging is not currently configured and won't be enabled"
 	loggingNotEnabled    = "Remote logging is current disabled"
@@ -192,6 +201,14 @@ const (
 	errorFailedToReadResponse    = "Couldn't read response: %s"
 	errorFailedToReadPassword    = "Couldn't read password: %s"
 	errorCouldntRenameWallet     = "Couldn't rename wallet: %s"
+	infoChooseBackupPassword     = "Please choose a password for the backup of wallet '%s': "
+	infoBackupPasswordPrompt     = "Please enter the password of the wallet backup: "
+	infoChooseRestoredPassword   = "Please choose a password for the restored wallet: "
+	infoBackedUpWallet           = "Wrote backup of wallet '%s' to %s"
+	infoRestoredWallet           = "Restored wallet '%s'"
+	errorCouldntBackupWallet     = "Couldn't back up wallet: %s"
+	errorCouldntRestoreWallet    = "Couldn't restore wallet: %s"
+	errorBlankBackupPassword     = "The backup password must not be blank"
 
 	// Commands
 	infoPasswordPrompt       = "Please enter the password for wallet '%s': "
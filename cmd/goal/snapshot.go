@@ -0,0 +1,139 @@
+// Copyright (C) 2019-2025 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package main
+
+import (
+	"bufio"
+	"context"
+	"os"
+	"path/filepath"
+
+	"github.com/spf13/cobra"
+
+	"github.com/algorand/go-algorand/cmd/util/datadir"
+	"github.com/algorand/go-algorand/config"
+	"github.com/algorand/go-algorand/data/bookkeeping"
+	"github.com/algorand/go-algorand/ledger"
+	"github.com/algorand/go-algorand/libgoal"
+)
+
+var snapshotFilename string
+
+func init() {
+	nodeCmd.AddCommand(snapshotCmd)
+	snapshotCmd.AddCommand(snapshotExportCmd)
+	snapshotCmd.AddCommand(snapshotImportCmd)
+
+	snapshotExportCmd.Flags().StringVarP(&snapshotFilename, "out", "o", "", "The filename to write the snapshot archive to")
+	snapshotExportCmd.MarkFlagRequired("out")
+	snapshotImportCmd.Flags().StringVarP(&snapshotFilename, "file", "f", "", "The snapshot archive to restore")
+	snapshotImportCmd.MarkFlagRequired("file")
+}
+
+var snapshotCmd = &cobra.Command{
+	Use:   "snapshot",
+	Short: "Export or import a snapshot of the node ledger",
+	Long:  "Export a consistent, checksummed snapshot of the ledger databases of a running node, or restore such a snapshot into a stopped node.",
+	Args:  validateNoPosArgsFn,
+	Run: func(cmd *cobra.Command, args []string) {
+		// If no arguments passed, we should fallback to help
+		cmd.HelpFunc()(cmd, args)
+	},
+}
+
+var snapshotExportCmd = &cobra.Command{
+	Use:   "export",
+	Short: "Export a snapshot of the ledger of a running node",
+	Long:  "Export a snapshot of the tracker, block and crash databases of a running node, captured at its latest committed round. The node keeps running while the snapshot is taken.",
+	Args:  validateNoPosArgsFn,
+	Run: func(cmd *cobra.Command, _ []string) {
+		dataDir := datadir.EnsureSingleDataDir()
+		client := ensureAlgodClient(dataDir)
+
+		// write into a temporary file first, so that a failed export never looks like a valid snapshot
+		tmpFilename := snapshotFilename + ".partial"
+		f, err := os.Create(tmpFilename)
+		if err != nil {
+			reportErrorf(errorSnapshotExport, err)
+		}
+		writer := bufio.NewWriter(f)
+		err = client.ExportLedgerSnapshot(context.Background(), writer)
+		if err == nil {
+			err = writer.Flush()
+		}
+		if err == nil {
+			err = f.Sync()
+		}
+		f.Close()
+		if err == nil {
+			err = os.Rename(tmpFilename, snapshotFilename)
+		}
+		if err != nil {
+			os.Remove(tmpFilename)
+			reportErrorf(errorSnapshotExport, err)
+		}
+		reportInfof(infoSnapshotExported, snapshotFilename)
+	},
+}
+
+var snapshotImportCmd = &cobra.Command{
+	Use:   "import",
+	Short: "Restore a ledger snapshot into a stopped node",
+	Long:  "Restore a ledger snapshot into a stopped node. The snapshot must have been exported from a node of the same network as the one described by the genesis file of the data directory, and every database file is verified against the snapshot checksums before replacing the existing ledger.",
+	Args:  validateNoPosArgsFn,
+	Run: func(cmd *cobra.Command, _ []string) {
+		dataDir := datadir.EnsureSingleDataDir()
+
+		// Ensure the node is stopped -- HealthCheck should fail
+		clientConfig := libgoal.ClientConfig{
+			AlgodDataDir: dataDir,
+			KMDDataDir:   resolveKmdDataDir(dataDir),
+			CacheDir:     ensureCacheDir(dataDir),
+		}
+		client, err := libgoal.MakeClientFromConfig(clientConfig, libgoal.AlgodClient)
+		if err == nil {
+			err = client.HealthCheck()
+			if err == nil {
+				reportErrorln(errorSnapshotNodeRunning)
+			}
+		}
+
+		genesis, err := bookkeeping.LoadGenesisFromFile(filepath.Join(dataDir, config.GenesisJSONFile))
+		if err != nil {
+			reportErrorf(errorSnapshotImport, err)
+		}
+		cfg, err := config.LoadConfigFromDisk(dataDir)
+		if err != nil && !os.IsNotExist(err) {
+			reportErrorf(errLoadingConfig, dataDir, err)
+		}
+		dirs, err := cfg.EnsureAndResolveGenesisDirs(dataDir, genesis.ID(), log)
+		if err != nil {
+			reportErrorf(errorSnapshotImport, err)
+		}
+
+		f, err := os.Open(snapshotFilename)
+		if err != nil {
+			reportErrorf(errorSnapshotImport, err)
+		}
+		defer f.Close()
+		manifest, err := ledger.ReadSnapshotArchive(bufio.NewReader(f), genesis.Hash(), ledger.SnapshotDestinations(dirs))
+		if err != nil {
+			reportErrorf(errorSnapshotImport, err)
+		}
+		reportInfof(infoSnapshotImported, manifest.GenesisID, manifest.Round)
+	},
+}
@@ -36,12 +36,15 @@ var (
 	createUnencryptedWallet bool
 	noDisplaySeed           bool
 	defaultWalletName       string
+	walletBackupFile        string
 )
 
 func init() {
 	walletCmd.AddCommand(newWalletCmd)
 	walletCmd.AddCommand(listWalletsCmd)
 	walletCmd.AddCommand(renameWalletCmd)
+	walletCmd.AddCommand(backupWalletCmd)
+	walletCmd.AddCommand(restoreWalletCmd)
 
 	// Default wallet to use when -w not specified
 	walletCmd.Flags().StringVarP(&defaultWalletName, "default", "f", "", "Set the wallet with this name to be the default wallet")
@@ -50,6 +53,13 @@ func init() {
 	newWalletCmd.Flags().BoolVarP(&recoverWallet, "recover", "r", false, "Recover the wallet from the backup mnemonic provided at wallet creation (NOT the mnemonic provided by goal account export or by algokey). Regenerate accounts in the wallet with `goal account new`")
 	newWalletCmd.Flags().BoolVar(&createUnencryptedWallet, "unencrypted", false, "Create a new wallet without a password.")
 	newWalletCmd.Flags().BoolVar(&noDisplaySeed, "no-display-seed", false, "Create a new wallet without displaying the seed phrase.")
+
+	backupWalletCmd.Flags().StringVarP(&walletBackupFile, "outfile", "o", "", "Filename for writing the encrypted wallet backup")
+	backupWalletCmd.MarkFlagRequired("outfile")
+
+	restoreWalletCmd.Flags().StringVarP(&walletBackupFile, "infile", "i", "", "Filename of the encrypted wallet backup to restore")
+	restoreWalletCmd.MarkFlagRequired("infile")
+	restoreWalletCmd.Flags().BoolVar(&createUnencryptedWallet, "unencrypted", false, "Restore the wallet without a password.")
 }
 
 var walletCmd = &cobra.Command{
@@ -258,6 +268,103 @@ var renameWalletCmd = &cobra.Command{
 	},
 }
 
+var backupWalletCmd = &cobra.Command{
+	Use:   "backup [wallet name]",
+	Short: "Write an encrypted backup of a wallet",
+	Long:  "Write an encrypted backup of the full contents of a wallet: its master derivation key, generated and imported keys, and multisig addresses. The backup is protected by its own password, and can be restored with `goal wallet restore`.",
+	Args:  cobra.ExactArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		dataDir := datadir.EnsureSingleDataDir()
+		client := ensureKmdClient(dataDir)
+		walletName := args[0]
+
+		wh, pw := ensureWalletHandleMaybePassword(dataDir, walletName, true)
+
+		// Fetch a password for the backup
+		fmt.Printf(infoChooseBackupPassword, walletName)
+		backupPassword := ensurePassword()
+		if len(backupPassword) == 0 {
+			reportErrorln(errorBlankBackupPassword)
+		}
+
+		// Confirm the password
+		fmt.Print(infoPasswordConfirmation)
+		passwordConfirmation := ensurePassword()
+		if !bytes.Equal(backupPassword, passwordConfirmation) {
+			reportErrorln(errorPasswordConfirmation)
+		}
+
+		backup, err := client.BackupWallet(wh, pw, backupPassword)
+		if err != nil {
+			reportErrorf(errorCouldntBackupWallet, err)
+		}
+
+		err = writeFile(walletBackupFile, backup, 0600)
+		if err != nil {
+			reportErrorf(fileWriteError, walletBackupFile, err)
+		}
+		reportInfof(infoBackedUpWallet, walletName, walletBackupFile)
+	},
+}
+
+var restoreWalletCmd = &cobra.Command{
+	Use:   "restore [new wallet name]",
+	Short: "Restore a wallet from an encrypted backup",
+	Long:  "Restore a wallet from a backup written by `goal wallet backup`, after checking its integrity. The restored wallet gets a new ID, and the name of the backed up wallet if no name is given.",
+	Args:  cobra.MaximumNArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		dataDir := datadir.EnsureSingleDataDir()
+		accountList := makeAccountsList(dataDir)
+		client := ensureKmdClient(dataDir)
+
+		var walletName []byte
+		if len(args) > 0 {
+			walletName = []byte(args[0])
+		}
+
+		backup, err := readFile(walletBackupFile)
+		if err != nil {
+			reportErrorf(fileReadError, walletBackupFile, err)
+		}
+
+		fmt.Print(infoBackupPasswordPrompt)
+		backupPassword := ensurePassword()
+
+		walletPassword := []byte{}
+		if createUnencryptedWallet {
+			reportInfoln(infoUnencrypted)
+		} else {
+			// Fetch a password for the restored wallet
+			fmt.Print(infoChooseRestoredPassword)
+			walletPassword = ensurePassword()
+
+			// Confirm the password
+			fmt.Print(infoPasswordConfirmation)
+			passwordConfirmation := ensurePassword()
+			if !bytes.Equal(walletPassword, passwordConfirmation) {
+				reportErrorln(errorPasswordConfirmation)
+			}
+		}
+
+		wallet, err := client.RestoreWallet(walletName, walletPassword, backup, backupPassword)
+		if err != nil {
+			reportErrorf(errorCouldntRestoreWallet, err)
+		}
+		reportInfof(infoRestoredWallet, wallet.Name)
+
+		// Check if we're the only wallet
+		wallets, err := client.ListWallets()
+		if err != nil {
+			reportErrorf(errorCouldntListWallets, err)
+		}
+
+		// We are the only wallet -- make us the default
+		if len(wallets) == 1 {
+			accountList.setDefaultWalletID([]byte(wallet.ID))
+		}
+	},
+}
+
 func printWallets(dataDir string, wallets []kmdapi.APIV1Wallet) {
 	accountList := makeAccountsList(dataDir)
 	defaultWalletID := string(accountList.getDefaultWalletID())
@@ -116,3 +116,8 @@ func (m *MockCatchpointCatchupAccessor) CompleteCatchup(ctx context.Context) (er
 func (m *MockCatchpointCatchupAccessor) Ledger() (l ledger.CatchupAccessorClientLedger) {
 	return nil
 }
+
+// DownloadDir returns the directory where the catchpoint file chunks are persisted while being downloaded
+func (m *MockCatchpointCatchupAccessor) DownloadDir() string {
+	return ""
+}
@@ -110,6 +110,9 @@ func (network *MockNetwork) RegisterHTTPHandlerFunc(path string, handler func(ht
 // OnNetworkAdvance - empty implementation
 func (network *MockNetwork) OnNetworkAdvance() {}
 
+// ReportPeerEvent - empty implementation
+func (network *MockNetwork) ReportPeerEvent(peer network.Peer, event network.PeerEvent) {}
+
 // GetGenesisID - empty implementation
 func (network *MockNetwork) GetGenesisID() string {
 	if network.GenesisID == "" {
@@ -95,6 +95,26 @@ func (m *MockParticipationRegistry) Flush(timeout time.Duration) error {
 	return nil
 }
 
+// Locked tells whether the secrets of the registry are unavailable until Unlock.
+func (m *MockParticipationRegistry) Locked() bool {
+	return false
+}
+
+// Lock forgets the secrets of the registry until Unlock.
+func (m *MockParticipationRegistry) Lock() error {
+	return nil
+}
+
+// Unlock decrypts the secrets of the registry with the key derived from material.
+func (m *MockParticipationRegistry) Unlock(material []byte) error {
+	return nil
+}
+
+// Initialize encrypts the secrets of the registry with the key derived from material, and unlocks it.
+func (m *MockParticipationRegistry) Initialize(material []byte) error {
+	return nil
+}
+
 // Close any resources used to implement the interface.
 func (m *MockParticipationRegistry) Close() {
 
@@ -73,6 +73,10 @@ const StateProofFileName = "stateproof.sqlite"
 // It is used for tracking participation key metadata.
 const ParticipationRegistryFilename = "partregistry.sqlite"
 
+// SigningGuardFilename is the name of the slashing protection database file.
+// It is used to avoid signing conflicting votes, see EnableDoubleSignProtection.
+const SigningGuardFilename = "signguard.sqlite"
+
 // ConfigurableConsensusProtocolsFilename defines a set of consensus protocols that
 // are to be loaded from the data directory ( if present ), to override the
 // built-in supported consensus protocols.
@@ -1045,6 +1045,23 @@ func TestResolveLogPaths(t *testing.T) {
 	require.Equal(t, "myCoolLogArchive/node.archive.log", archive)
 }
 
+func TestResolveNetworkCapturePaths(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	cfg := GetDefaultLocal()
+	capture, archive := cfg.ResolveNetworkCapturePaths("root")
+	require.Equal(t, "root/network.capture", capture)
+	require.Equal(t, "root/network.archive.capture", archive)
+
+	// the capture follows the log
+	cfg.HotDataDir = "hot"
+	cfg.ColdDataDir = "cold"
+	cfg.LogFileDir = "mycoolLogDir"
+	capture, archive = cfg.ResolveNetworkCapturePaths("root")
+	require.Equal(t, "mycoolLogDir/network.capture", capture)
+	require.Equal(t, "cold/network.archive.capture", archive)
+}
+
 func TestStoresCatchpoints(t *testing.T) {
 	partitiontest.PartitionTest(t)
 
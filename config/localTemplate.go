@@ -44,7 +44,7 @@ type Local struct {
 	// Version tracks the current version of the defaults so we can migrate old -> new
 	// This is specifically important whenever we decide to change the default value
 	// for an existing parameter. This field tag must be updated any time we add a new version.
-	Version uint32 `version[0]:"0" version[1]:"1" version[2]:"2" version[3]:"3" version[4]:"4" version[5]:"5" version[6]:"6" version[7]:"7" version[8]:"8" version[9]:"9" version[10]:"10" version[11]:"11" version[12]:"12" version[13]:"13" version[14]:"14" version[15]:"15" version[16]:"16" version[17]:"17" version[18]:"18" version[19]:"19" version[20]:"20" version[21]:"21" version[22]:"22" version[23]:"23" version[24]:"24" version[25]:"25" version[26]:"26" version[27]:"27" version[28]:"28" version[29]:"29" version[30]:"30" version[31]:"31" version[32]:"32" version[33]:"33" version[34]:"34" version[35]:"35"`
+	Version uint32 `version[0]:"0" version[1]:"1" version[2]:"2" version[3]:"3" version[4]:"4" version[5]:"5" version[6]:"6" version[7]:"7" version[8]:"8" version[9]:"9" version[10]:"10" version[11]:"11" version[12]:"12" version[13]:"13" version[14]:"14" version[15]:"15" version[16]:"16" version[17]:"17" version[18]:"18" version[19]:"19" version[20]:"20" version[21]:"21" version[22]:"22" version[23]:"23" version[24]:"24" version[25]:"25" version[26]:"26" version[27]:"27" version[28]:"28" version[29]:"29" version[30]:"30" version[31]:"31" version[32]:"32" version[33]:"33" version[34]:"34" version[35]:"35" version[36]:"36"`
 
 	// Archival nodes retain a full copy of the block history. Non-Archival nodes will delete old blocks and only retain what's need to properly validate blockchain messages (the precise number of recent blocks depends on the consensus parameters. Currently the last 1321 blocks are required). This means that non-Archival nodes require significantly less storage than Archival nodes.  If setting this to true for the first time, the existing ledger may need to be deleted to get the historical values stored as the setting only affects current blocks forward. To do this, shutdown the node and delete all .sqlite files within the data/testnet-version directory, except the crash.sqlite file. Restart the node and wait for the node to sync.
 	Archival bool `version[0]:"false"`
@@ -296,6 +296,11 @@ type Local struct {
 	// EnableIncomingMessageFilter enable the filtering of incoming messages.
 	EnableIncomingMessageFilter bool `version[0]:"false"`
 
+	// EnableVoteCompression enables the stateful compression of the agreement votes exchanged with the peers supporting it.
+	// Each direction of a connection keeps a dictionary of the recently exchanged senders, rounds, proposal values and
+	// participation key credentials, which are then sent as short references.
+	EnableVoteCompression bool `version[36]:"false"`
+
 	// DeadlockDetection controls enabling or disabling deadlock detection.
 	// negative (-1) to disable, positive (1) to enable, 0 for default.
 	DeadlockDetection int `version[1]:"0"`
@@ -323,6 +328,16 @@ type Local struct {
 	// TxSyncServeResponseSize the max size the sync server would return.
 	TxSyncServeResponseSize int `version[3]:"1000000"`
 
+	// EnableTxReconciliation enables the reconciliation of pending transaction sets with the websocket peers
+	// supporting it. Such peers periodically exchange sketches of their transaction pools with the relays they
+	// connect to, and only send each other the transactions the other side is missing: relays stop flooding
+	// transactions to them, which cuts the bandwidth spent on redundant transactions.
+	EnableTxReconciliation bool `version[36]:"false"`
+
+	// TxReconciliationInterval is the time interval between two reconciliations of the pending transactions
+	// with each of the peers supporting it, when EnableTxReconciliation is set.
+	TxReconciliationInterval time.Duration `version[36]:"1000000000"`
+
 	// UseXForwardedForAddressField indicates whether or not the node should use the X-Forwarded-For HTTP Header when
 	// determining the source of a connection.  If used, it should be set to the string "X-Forwarded-For", unless the
 	// proxy vendor provides another header field.  In the case of CloudFlare proxy, the "CF-Connecting-IP" header
@@ -468,9 +483,68 @@ type Local struct {
 	// the default of 20480 would be used.
 	MinCatchpointFileDownloadBytesPerSecond uint64 `version[13]:"20480"`
 
+	// CatchupLedgerDownloadParallelism defines the number of peers the catchpoint file chunks are concurrently downloaded from during fast catchup.
+	// The downloaded chunks are verified against the catchpoint file manifest and persisted to disk, allowing an interrupted download to be resumed.
+	// When set to zero, the catchpoint file is downloaded as a single stream from a single peer.
+	CatchupLedgerDownloadParallelism int `version[36]:"4"`
+
 	// NetworkMessageTraceServer is a host:port address to report graph propagation trace info to.
 	NetworkMessageTraceServer string `version[13]:""`
 
+	// EnableNetworkCapture records all the gossip messages sent and received by the node, with their tag, peer and timestamp,
+	// to a network.capture file stored next to node.log. The capture can be replayed into a node with the netreplay tool.
+	EnableNetworkCapture bool `version[36]:"false"`
+
+	// NetworkCaptureSizeLimit is the size limit in bytes of the network capture file, beyond which it is archived.
+	NetworkCaptureSizeLimit uint64 `version[36]:"1073741824"`
+
+	// NetworkCaptureArchiveName is the text/template for creating the network capture archive filenames; see LogArchiveName.
+	// The archives are stored with the log archives and are deleted after LogArchiveMaxAge.
+	NetworkCaptureArchiveName string `version[36]:"network.archive.capture"`
+
+	// EnableTracing exports OpenTelemetry spans of the message propagation, transaction handling, agreement and
+	// block processing of the node to the OTLP collector at TracingEndpoint.
+	EnableTracing bool `version[36]:"false"`
+
+	// TracingEndpoint is the host:port address of the OTLP/HTTP collector the spans are exported to when EnableTracing is set.
+	TracingEndpoint string `version[36]:"localhost:4318"`
+
+	// TracingSamplingPercent is the percentage of the traces started by the node which are exported. The traces started
+	// by the peers of the node are exported depending on the sampling decision of the peer that started them.
+	TracingSamplingPercent uint64 `version[36]:"100"`
+
+	// EnableTracePropagation adds the trace context to the messages sent to the peers which also enable it, so that the
+	// spans of a message on different nodes are part of the same trace. It is meant for private networks, since it increases
+	// the size of every message and discloses the tracing decisions of the node to its peers.
+	EnableTracePropagation bool `version[36]:"false"`
+
+	// EnablePeerReputation keeps a reputation score for each websocket peer, lowered when the peer sends invalid transactions
+	// or votes, repeats the same messages or is slow to serve blocks. The scores are used to prefer the best peers when
+	// connecting to relays, and to disconnect and refuse the peers which score is below PeerReputationDisconnectThreshold.
+	// The score of an incoming peer is kept for its remote IP address, so all the peers connecting from behind the same
+	// NAT or proxy share a single score, and the misbehavior of one of them can get all of them refused.
+	EnablePeerReputation bool `version[36]:"false"`
+
+	// PeerReputationDisconnectThreshold is the reputation score under which a peer is disconnected and refused until its
+	// score recovers. Scores start at zero, and every misbehavior lowers the score of the peer by up to 20.
+	PeerReputationDisconnectThreshold int64 `version[36]:"-100"`
+
+	// EnableQUIC listens for gossip connections over QUIC on the UDP port of NetAddress, and makes relays connect to
+	// the other relays over QUIC when they support it. Each class of messages uses its own QUIC stream, so votes are not
+	// delayed behind proposal payloads. It also adds QUIC to the libp2p transports when EnableP2P or EnableP2PHybridMode is set.
+	EnableQUIC bool `version[36]:"false"`
+
+	// TxnEgressQuota is the maximum number of bytes per second of transaction messages, gossiped or reconciled, sent to
+	// all the peers. The transaction messages exceeding it are not sent, while the agreement messages are never throttled.
+	// On the P2P network, a transaction published or forwarded over the gossipsub topic is accounted once for every peer
+	// subscribed to the topic. 0 disables the quota.
+	TxnEgressQuota uint64 `version[36]:"0"`
+
+	// CatchupEgressQuota is the maximum number of bytes per second of responses to the block requests made over the peer
+	// connections sent to all the peers. The requests exceeding it are answered with an error, and are retried by the requesting peers.
+	// 0 disables the quota.
+	CatchupEgressQuota uint64 `version[36]:"0"`
+
 	// VerifiedTranscationsCacheSize defines the number of transactions that the verified transactions cache would hold before cycling the cache storage in a round-robin fashion.
 	VerifiedTranscationsCacheSize int `version[14]:"30000" version[23]:"150000"`
 
@@ -504,10 +578,57 @@ type Local struct {
 	// AccountUpdatesStatsInterval is the time interval in nanoseconds between accountUpdates telemetry events.
 	AccountUpdatesStatsInterval time.Duration `version[16]:"5000000000"`
 
+	// EnableLedgerTrackerMetrics specifies whether or not to export, for each of the ledger trackers, histograms of the time
+	// spent in each of the commit stages and of the number of rows written, along with the depth of the deferred commits queue.
+	EnableLedgerTrackerMetrics bool `version[36]:"false"`
+
 	// ParticipationKeysRefreshInterval is the duration between two consecutive checks to see if new participation
 	// keys have been placed on the genesis directory. Deprecated and unused.
 	ParticipationKeysRefreshInterval time.Duration `version[16]:"60000000000"`
 
+	// EnableParticipationKeyManager makes the node renew the participation keys of the accounts registered online with
+	// its keys: ParticipationKeyRenewalRounds before a key expires, the node generates and installs the next key, valid
+	// for ParticipationKeyValidityRounds, then registers it online with a keyreg transaction signed by
+	// ParticipationKeyManagerSigner.
+	EnableParticipationKeyManager bool `version[36]:"false"`
+
+	// ParticipationKeyManagerSigner is the signer of the keyreg transactions of the participation key manager, either
+	// "kmd:" followed by the data directory of a kmd, or "unix:" followed by the socket of a signing service speaking
+	// the remote signer protocol of kmd. The keyreg transactions are signed with the auth address of the accounts.
+	ParticipationKeyManagerSigner string `version[36]:""`
+
+	// ParticipationKeyManagerSignerTokenFile is the file holding the API token of the kmd signer of the participation
+	// key manager, e.g. a scoped token with the sign scope for the wallet holding the keys of the accounts.
+	ParticipationKeyManagerSignerTokenFile string `version[36]:""`
+
+	// ParticipationKeyRenewalRounds is the number of rounds before the expiration of the participation key of an
+	// account when the participation key manager renews it.
+	ParticipationKeyRenewalRounds uint64 `version[36]:"100000"`
+
+	// ParticipationKeyValidityRounds is the number of rounds the participation keys generated by the participation key
+	// manager are valid for.
+	ParticipationKeyValidityRounds uint64 `version[36]:"3000000"`
+
+	// ParticipationKeysEncryption enables the encryption of the secrets of the participation keys in the participation
+	// registry, with a key derived from key material read from this source: "passphrase" for a passphrase given to the
+	// /v2/participation/unlock admin endpoint, "file:<path>" for the contents of a file, or "kms:<socket path>" for the
+	// key of the local key management service listening on that Unix socket. The node does not participate until the
+	// registry is unlocked, at startup for a file or key management service, or through /v2/participation/unlock.
+	// The secrets are encrypted on the first unlock at startup, or through /v2/participation/unlock with initialize
+	// set, so that they are not encrypted for good with a mistyped passphrase.
+	ParticipationKeysEncryption string `version[36]:""`
+
+	// EnableDoubleSignProtection enables a slashing protection database of the votes signed by the node, in the
+	// cold data directory, so that it never signs two votes for different values in the same round, period and step.
+	// Enable it on every node sharing participation keys, such as an active and a standby validator.
+	EnableDoubleSignProtection bool `version[36]:"false"`
+
+	// DoubleSignProtectionLockService is the lock service the nodes sharing participation keys coordinate through when
+	// EnableDoubleSignProtection is set, so that only one value is signed by any of them in a round, period and step.
+	// "file:<directory>" uses a directory shared by the nodes, e.g. on a network file system. Votes are not signed
+	// when the lock service is unavailable. When empty, only the votes of the node itself are checked.
+	DoubleSignProtectionLockService string `version[36]:""`
+
 	// DisableNetworking disables all the incoming and outgoing communication a node would perform. This is useful
 	// when we have a single-node private network, where there are no other nodes that need to be communicated with.
 	// Features like catchpoint catchup would be rendered completely non-operational, and many of the node inner
@@ -625,6 +746,12 @@ type Local struct {
 	// P2PHybridNetAddress sets the listen address used for P2P networking, if hybrid mode is set.
 	P2PHybridNetAddress string `version[34]:""`
 
+	// EnableP2PConsensusTopics makes the P2P network gossip agreement votes, proposals and bundles over
+	// gossipsub topics instead of the per-peer streams. Messages on these topics are validated by agreement
+	// before being propagated further, and peers relaying invalid ones are penalized.
+	// Nodes not enabling it do not subscribe to these topics, so it should be set by all nodes of a P2P network.
+	EnableP2PConsensusTopics bool `version[36]:"false"`
+
 	// EnableDHT will turn on the hash table for use with capabilities advertisement
 	EnableDHTProviders bool `version[34]:"false"`
 
@@ -848,6 +975,13 @@ func (cfg *Local) ResolveLogPaths(rootDir string) (liveLog, archive string) {
 	return liveLog, archive
 }
 
+// ResolveNetworkCapturePaths returns the locations of the live network capture and of its archives,
+// which are stored in the directories of the live log and of the log archives respectively.
+func (cfg *Local) ResolveNetworkCapturePaths(rootDir string) (liveCapture, archive string) {
+	liveLog, logArchive := cfg.ResolveLogPaths(rootDir)
+	return filepath.Join(filepath.Dir(liveLog), "network.capture"), filepath.Join(filepath.Dir(logArchive), cfg.NetworkCaptureArchiveName)
+}
+
 type logger interface {
 	Infof(format string, args ...interface{})
 }
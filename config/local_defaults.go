@@ -20,7 +20,7 @@
 package config
 
 var defaultLocal = Local{
-	Version:                                    35,
+	Version:                                    36,
 	AccountUpdatesStatsInterval:                5000000000,
 	AccountsRebuildSynchronousMode:             1,
 	AgreementIncomingBundlesQueueLength:        15,
@@ -41,9 +41,11 @@ var defaultLocal = Local{
 	CatchpointTracking:                         0,
 	CatchupBlockDownloadRetryAttempts:          1000,
 	CatchupBlockValidateMode:                   0,
+	CatchupEgressQuota:                         0,
 	CatchupFailurePeerRefreshRate:              10,
 	CatchupGossipBlockFetchTimeoutSec:          4,
 	CatchupHTTPBlockFetchTimeoutSec:            4,
+	CatchupLedgerDownloadParallelism:           4,
 	CatchupLedgerDownloadRetryAttempts:         50,
 	CatchupParallelBlocks:                      16,
 	ColdDataDir:                                "",
@@ -59,6 +61,7 @@ var defaultLocal = Local{
 	DisableLocalhostConnectionRateLimit:        true,
 	DisableNetworking:                          false,
 	DisableOutgoingConnectionThrottling:        false,
+	DoubleSignProtectionLockService:            "",
 	EnableAccountUpdatesStats:                  false,
 	EnableAgreementReporting:                   false,
 	EnableAgreementTimeMetrics:                 false,
@@ -66,29 +69,40 @@ var defaultLocal = Local{
 	EnableBlockService:                         false,
 	EnableDHTProviders:                         false,
 	EnableDeveloperAPI:                         false,
+	EnableDoubleSignProtection:                 false,
 	EnableExperimentalAPI:                      false,
 	EnableFollowMode:                           false,
 	EnableGossipBlockService:                   true,
 	EnableGossipService:                        true,
 	EnableIncomingMessageFilter:                false,
 	EnableLedgerService:                        false,
+	EnableLedgerTrackerMetrics:                 false,
 	EnableMetricReporting:                      false,
 	EnableNetDevMetrics:                        false,
+	EnableNetworkCapture:                       false,
 	EnableOutgoingNetworkMessageFiltering:      true,
 	EnableP2P:                                  false,
+	EnableP2PConsensusTopics:                   false,
 	EnableP2PHybridMode:                        false,
+	EnableParticipationKeyManager:              false,
+	EnablePeerReputation:                       false,
 	EnablePingHandler:                          true,
 	EnablePrivateNetworkAccessHeader:           false,
 	EnableProcessBlockStats:                    false,
 	EnableProfiler:                             false,
+	EnableQUIC:                                 false,
 	EnableRequestLogger:                        false,
 	EnableRuntimeMetrics:                       false,
 	EnableTopAccountsReporting:                 false,
+	EnableTracePropagation:                     false,
+	EnableTracing:                              false,
 	EnableTxBacklogAppRateLimiting:             true,
 	EnableTxBacklogRateLimiting:                true,
+	EnableTxReconciliation:                     false,
 	EnableTxnEvalTracer:                        false,
 	EnableUsageLog:                             false,
 	EnableVerbosedTransactionSyncLogging:       false,
+	EnableVoteCompression:                      false,
 	EndpointAddress:                            "127.0.0.1:0",
 	FallbackDNSResolverAddress:                 "",
 	ForceFetchTransactions:                     false,
@@ -114,6 +128,8 @@ var defaultLocal = Local{
 	MaxConnectionsPerIP:                        8,
 	MinCatchpointFileDownloadBytesPerSecond:    20480,
 	NetAddress:                                 "",
+	NetworkCaptureArchiveName:                  "network.archive.capture",
+	NetworkCaptureSizeLimit:                    1073741824,
 	NetworkMessageTraceServer:                  "",
 	NetworkProtocolVersion:                     "",
 	NodeExporterListenAddress:                  ":9100",
@@ -125,9 +141,15 @@ var defaultLocal = Local{
 	P2PHybridNetAddress:                        "",
 	P2PPersistPeerID:                           false,
 	P2PPrivateKeyLocation:                      "",
+	ParticipationKeyManagerSigner:              "",
+	ParticipationKeyManagerSignerTokenFile:     "",
+	ParticipationKeyRenewalRounds:              100000,
+	ParticipationKeyValidityRounds:             3000000,
+	ParticipationKeysEncryption:                "",
 	ParticipationKeysRefreshInterval:           60000000000,
 	PeerConnectionsUpdateInterval:              3600,
 	PeerPingPeriodSeconds:                      0,
+	PeerReputationDisconnectThreshold:          -100,
 	PriorityPeers:                              map[string]bool{},
 	ProposalAssemblyTime:                       500000000,
 	PublicAddress:                              "",
@@ -145,6 +167,8 @@ var defaultLocal = Local{
 	TLSCertFile:                                "",
 	TLSKeyFile:                                 "",
 	TelemetryToLog:                             true,
+	TracingEndpoint:                            "localhost:4318",
+	TracingSamplingPercent:                     100,
 	TrackerDBDir:                               "",
 	TransactionSyncDataExchangeRate:            0,
 	TransactionSyncSignificantMessageThreshold: 0,
@@ -159,9 +183,11 @@ var defaultLocal = Local{
 	TxIncomingFilteringFlags:                   1,
 	TxPoolExponentialIncreaseFactor:            2,
 	TxPoolSize:                                 75000,
+	TxReconciliationInterval:                   1000000000,
 	TxSyncIntervalSeconds:                      60,
 	TxSyncServeResponseSize:                    1000000,
 	TxSyncTimeoutSeconds:                       30,
+	TxnEgressQuota:                             0,
 	UseXForwardedForAddressField:               "",
 	VerifiedTranscationsCacheSize:              150000,
 }
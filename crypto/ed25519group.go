@@ -0,0 +1,150 @@
+// Copyright (C) 2019-2025 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package crypto
+
+// #cgo CFLAGS: -Wall -std=c99
+// #cgo darwin,amd64 CFLAGS: -I${SRCDIR}/libs/darwin/amd64/include
+// #cgo darwin,amd64 LDFLAGS: ${SRCDIR}/libs/darwin/amd64/lib/libsodium.a
+// #cgo darwin,arm64 CFLAGS: -I${SRCDIR}/libs/darwin/arm64/include
+// #cgo darwin,arm64 LDFLAGS: ${SRCDIR}/libs/darwin/arm64/lib/libsodium.a
+// #cgo linux,amd64 CFLAGS: -I${SRCDIR}/libs/linux/amd64/include
+// #cgo linux,amd64 LDFLAGS: ${SRCDIR}/libs/linux/amd64/lib/libsodium.a
+// #cgo linux,arm64 CFLAGS: -I${SRCDIR}/libs/linux/arm64/include
+// #cgo linux,arm64 LDFLAGS: ${SRCDIR}/libs/linux/arm64/lib/libsodium.a
+// #cgo linux,arm CFLAGS: -I${SRCDIR}/libs/linux/arm/include
+// #cgo linux,arm LDFLAGS: ${SRCDIR}/libs/linux/arm/lib/libsodium.a
+// #cgo windows,amd64 CFLAGS: -I${SRCDIR}/libs/windows/amd64/include
+// #cgo windows,amd64 LDFLAGS: ${SRCDIR}/libs/windows/amd64/lib/libsodium.a
+// #include <stdint.h>
+// #include "sodium.h"
+import "C"
+
+import (
+	"encoding/binary"
+	"errors"
+)
+
+//msgp:ignore Ed25519Scalar Ed25519Point
+
+// Ed25519Scalar is an integer modulo the order of the prime order subgroup of
+// ed25519, encoded in little-endian. The arithmetic of Ed25519Scalar and
+// Ed25519Point is meant for protocols producing ordinary ed25519 signatures,
+// such as threshold signing.
+type Ed25519Scalar [32]byte
+
+// Ed25519Point is an encoded point of ed25519
+type Ed25519Point [32]byte
+
+// ErrEd25519InvalidPoint is returned when an operation is given an invalid
+// point, or its result is the identity point
+var ErrEd25519InvalidPoint = errors.New("invalid ed25519 point")
+
+// ErrEd25519ZeroScalar is returned when inverting zero
+var ErrEd25519ZeroScalar = errors.New("cannot invert a zero ed25519 scalar")
+
+// RandomEd25519Scalar returns a uniformly random non-zero scalar
+func RandomEd25519Scalar() (s Ed25519Scalar) {
+	C.crypto_core_ed25519_scalar_random((*C.uchar)(&s[0]))
+	return
+}
+
+// Ed25519ScalarFromUint64 returns the scalar equal to v
+func Ed25519ScalarFromUint64(v uint64) (s Ed25519Scalar) {
+	binary.LittleEndian.PutUint64(s[:], v)
+	return
+}
+
+// Ed25519ScalarFromHash reduces a 512-bit hash, e.g. a SHA-512 digest, to a
+// scalar, as ed25519 does to compute the challenge of a signature
+func Ed25519ScalarFromHash(h [64]byte) (s Ed25519Scalar) {
+	C.crypto_core_ed25519_scalar_reduce((*C.uchar)(&s[0]), (*C.uchar)(&h[0]))
+	return
+}
+
+// IsCanonical returns whether s is reduced modulo the group order
+func (s Ed25519Scalar) IsCanonical() bool {
+	return C.crypto_core_ed25519_scalar_is_canonical((*C.uchar)(&s[0])) == 1
+}
+
+// IsZero returns whether s is zero
+func (s Ed25519Scalar) IsZero() bool {
+	return s == Ed25519Scalar{}
+}
+
+// Add returns s + t
+func (s Ed25519Scalar) Add(t Ed25519Scalar) (r Ed25519Scalar) {
+	C.crypto_core_ed25519_scalar_add((*C.uchar)(&r[0]), (*C.uchar)(&s[0]), (*C.uchar)(&t[0]))
+	return
+}
+
+// Sub returns s - t
+func (s Ed25519Scalar) Sub(t Ed25519Scalar) (r Ed25519Scalar) {
+	C.crypto_core_ed25519_scalar_sub((*C.uchar)(&r[0]), (*C.uchar)(&s[0]), (*C.uchar)(&t[0]))
+	return
+}
+
+// Mul returns s * t
+func (s Ed25519Scalar) Mul(t Ed25519Scalar) (r Ed25519Scalar) {
+	C.crypto_core_ed25519_scalar_mul((*C.uchar)(&r[0]), (*C.uchar)(&s[0]), (*C.uchar)(&t[0]))
+	return
+}
+
+// Invert returns the multiplicative inverse of s
+func (s Ed25519Scalar) Invert() (r Ed25519Scalar, err error) {
+	if C.crypto_core_ed25519_scalar_invert((*C.uchar)(&r[0]), (*C.uchar)(&s[0])) != 0 {
+		return r, ErrEd25519ZeroScalar
+	}
+	return r, nil
+}
+
+// Ed25519ScalarBaseMult returns s times the base point of ed25519
+func Ed25519ScalarBaseMult(s Ed25519Scalar) (p Ed25519Point, err error) {
+	if C.crypto_scalarmult_ed25519_base_noclamp((*C.uchar)(&p[0]), (*C.uchar)(&s[0])) != 0 {
+		return p, ErrEd25519InvalidPoint
+	}
+	return p, nil
+}
+
+// IsValid returns whether p is the canonical encoding of a point of the prime
+// order subgroup, other than the identity
+func (p Ed25519Point) IsValid() bool {
+	return C.crypto_core_ed25519_is_valid_point((*C.uchar)(&p[0])) == 1
+}
+
+// ScalarMult returns s times p. p must be valid.
+func (p Ed25519Point) ScalarMult(s Ed25519Scalar) (r Ed25519Point, err error) {
+	if C.crypto_scalarmult_ed25519_noclamp((*C.uchar)(&r[0]), (*C.uchar)(&s[0]), (*C.uchar)(&p[0])) != 0 {
+		return r, ErrEd25519InvalidPoint
+	}
+	return r, nil
+}
+
+// Add returns p + q
+func (p Ed25519Point) Add(q Ed25519Point) (r Ed25519Point, err error) {
+	if C.crypto_core_ed25519_add((*C.uchar)(&r[0]), (*C.uchar)(&p[0]), (*C.uchar)(&q[0])) != 0 {
+		return r, ErrEd25519InvalidPoint
+	}
+	return r, nil
+}
+
+// Sub returns p - q
+func (p Ed25519Point) Sub(q Ed25519Point) (r Ed25519Point, err error) {
+	if C.crypto_core_ed25519_sub((*C.uchar)(&r[0]), (*C.uchar)(&p[0]), (*C.uchar)(&q[0])) != 0 {
+		return r, ErrEd25519InvalidPoint
+	}
+	return r, nil
+}
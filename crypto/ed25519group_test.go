@@ -0,0 +1,111 @@
+// Copyright (C) 2019-2025 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package crypto
+
+import (
+	"crypto/sha512"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/algorand/go-algorand/test/partitiontest"
+)
+
+func TestEd25519ScalarArithmetic(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	two := Ed25519ScalarFromUint64(2)
+	three := Ed25519ScalarFromUint64(3)
+	require.Equal(t, Ed25519ScalarFromUint64(5), two.Add(three))
+	require.Equal(t, Ed25519ScalarFromUint64(6), two.Mul(three))
+	require.Equal(t, Ed25519ScalarFromUint64(1), three.Sub(two))
+
+	s := RandomEd25519Scalar()
+	require.True(t, s.IsCanonical())
+	inv, err := s.Invert()
+	require.NoError(t, err)
+	require.Equal(t, Ed25519ScalarFromUint64(1), s.Mul(inv))
+	require.True(t, s.Sub(s).IsZero())
+	_, err = Ed25519Scalar{}.Invert()
+	require.Error(t, err)
+
+	var notCanonical Ed25519Scalar
+	for i := range notCanonical {
+		notCanonical[i] = 0xff
+	}
+	require.False(t, notCanonical.IsCanonical())
+}
+
+func TestEd25519PointArithmetic(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	a := RandomEd25519Scalar()
+	b := RandomEd25519Scalar()
+	aG, err := Ed25519ScalarBaseMult(a)
+	require.NoError(t, err)
+	require.True(t, aG.IsValid())
+	bG, err := Ed25519ScalarBaseMult(b)
+	require.NoError(t, err)
+
+	// (a+b)G = aG + bG, and (a*b)G = b(aG)
+	sum, err := aG.Add(bG)
+	require.NoError(t, err)
+	expected, err := Ed25519ScalarBaseMult(a.Add(b))
+	require.NoError(t, err)
+	require.Equal(t, expected, sum)
+	diff, err := sum.Sub(bG)
+	require.NoError(t, err)
+	require.Equal(t, aG, diff)
+	prod, err := aG.ScalarMult(b)
+	require.NoError(t, err)
+	expected, err = Ed25519ScalarBaseMult(a.Mul(b))
+	require.NoError(t, err)
+	require.Equal(t, expected, prod)
+
+	_, err = Ed25519ScalarBaseMult(Ed25519Scalar{})
+	require.Error(t, err)
+	require.False(t, Ed25519Point{}.IsValid())
+}
+
+// TestEd25519SchnorrSignature checks that a signature computed with the group
+// arithmetic is an ordinary ed25519 signature
+func TestEd25519SchnorrSignature(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	x := RandomEd25519Scalar()
+	pk, err := Ed25519ScalarBaseMult(x)
+	require.NoError(t, err)
+
+	msg := []byte("a message")
+	k := RandomEd25519Scalar()
+	r, err := Ed25519ScalarBaseMult(k)
+	require.NoError(t, err)
+	h := sha512.New()
+	h.Write(r[:])
+	h.Write(pk[:])
+	h.Write(msg)
+	var digest [64]byte
+	copy(digest[:], h.Sum(nil))
+	c := Ed25519ScalarFromHash(digest)
+	z := k.Add(c.Mul(x))
+
+	var sig Signature
+	copy(sig[:32], r[:])
+	copy(sig[32:], z[:])
+	require.True(t, SignatureVerifier(pk).VerifyBytes(msg, sig))
+	require.False(t, SignatureVerifier(pk).VerifyBytes([]byte("another message"), sig))
+}
@@ -158,6 +158,22 @@ crypto_core_ed25519_scalar_sub(unsigned char *z, const unsigned char *x,
     crypto_core_ed25519_scalar_add(z, x, yn);
 }
 
+void
+crypto_core_ed25519_scalar_mul(unsigned char *z, const unsigned char *x,
+                               const unsigned char *y)
+{
+    unsigned char zero[crypto_core_ed25519_SCALARBYTES];
+
+    memset(zero, 0, sizeof zero);
+    sc25519_muladd(z, x, y, zero);
+}
+
+int
+crypto_core_ed25519_scalar_is_canonical(const unsigned char *s)
+{
+    return sc25519_is_canonical(s);
+}
+
 void
 crypto_core_ed25519_scalar_reduce(unsigned char *r,
                                   const unsigned char *s)
@@ -447,6 +447,16 @@ type catchupParams struct {
 	Min uint64 `url:"min"`
 }
 
+type addPriorityPeerParams struct {
+	Address  string `url:"address"`
+	Duration uint64 `url:"duration"`
+}
+
+type disconnectPeerParams struct {
+	Address string `url:"address"`
+	Ban     uint64 `url:"ban,omitempty"`
+}
+
 // PendingTransactionsByAddr returns all the pending transactions for an addr.
 func (client RestClient) PendingTransactionsByAddr(addr string, max uint64) (response model.PendingTransactionsResponse, err error) {
 	err = client.get(&response, fmt.Sprintf("/v2/accounts/%s/transactions/pending", addr), pendingTransactionsByAddrParams{max})
@@ -760,6 +770,67 @@ func (client RestClient) doGetWithQuery(ctx context.Context, path string, queryA
 	return
 }
 
+// ExportLedgerSnapshot streams a snapshot archive of the node's ledger databases into w.
+// The archive may be far larger than maxRawResponseBytes, and therefore isn't buffered in memory.
+func (client RestClient) ExportLedgerSnapshot(ctx context.Context, w io.Writer) error {
+	return client.streamResponse(ctx, "/v2/ledger/snapshot", w)
+}
+
+// DumpLedgerState streams a canonical dump of the node's ledger state into w.
+// The dump may be far larger than maxRawResponseBytes, and therefore isn't buffered in memory.
+func (client RestClient) DumpLedgerState(ctx context.Context, w io.Writer) error {
+	return client.streamResponse(ctx, "/v2/ledger/statedump", w)
+}
+
+// GetPeers lists the peers the node is connected to
+func (client RestClient) GetPeers() (response model.PeersResponse, err error) {
+	err = client.get(&response, "/v2/peers", nil)
+	return
+}
+
+// AddPriorityPeer connects the node to the relay at address, and keeps it connected for duration seconds
+func (client RestClient) AddPriorityPeer(address string, duration uint64) error {
+	return client.submitForm(nil, "/v2/peers", addPriorityPeerParams{Address: address, Duration: duration}, nil, "POST", false, false, true)
+}
+
+// DisconnectPeer disconnects the node from the peers with address, and bans it for ban seconds if not zero
+func (client RestClient) DisconnectPeer(address string, ban uint64) (response model.DisconnectPeerResponse, err error) {
+	err = client.delete(&response, "/v2/peers", disconnectPeerParams{Address: address, Ban: ban}, false)
+	return
+}
+
+// GetPhonebook returns the entries of the node's phonebook
+func (client RestClient) GetPhonebook() (response model.PhonebookResponse, err error) {
+	err = client.get(&response, "/v2/peers/phonebook", nil)
+	return
+}
+
+// streamResponse performs a GET request and copies the response body into w
+func (client RestClient) streamResponse(ctx context.Context, path string, w io.Writer) error {
+	queryURL := client.serverURL
+	queryURL.Path = path
+
+	req, err := http.NewRequestWithContext(ctx, "GET", queryURL.String(), nil)
+	if err != nil {
+		return err
+	}
+	req.Header.Set(authHeader, client.apiToken)
+
+	httpClient := http.Client{}
+	resp, err := httpClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	err = extractError(resp)
+	if err != nil {
+		return err
+	}
+	_, err = io.Copy(w, resp.Body)
+	return err
+}
+
 // RawDryrun gets the raw DryrunResponse associated with the passed address
 func (client RestClient) RawDryrun(data []byte) (response []byte, err error) {
 	var blob Blob
@@ -807,6 +878,12 @@ func (client RestClient) GetParticipationKeys() (response model.ParticipationKey
 	return
 }
 
+// GetParticipationHealth gets the health of all of the participation keys
+func (client RestClient) GetParticipationHealth() (response model.ParticipationHealthResponse, err error) {
+	err = client.get(&response, "/v2/participation/health", nil)
+	return
+}
+
 // GetParticipationKeyByID gets a single participation key
 func (client RestClient) GetParticipationKeyByID(participationID string) (response model.ParticipationKeyResponse, err error) {
 	err = client.get(&response, fmt.Sprintf("/v2/participation/%s", participationID), nil)
@@ -819,6 +896,21 @@ func (client RestClient) RemoveParticipationKeyByID(participationID string) (err
 	return
 }
 
+// UnlockParticipationKeys unlocks the participation keys encrypted at rest, with the passphrase if not empty,
+// or else with the key material of the source configured on the node. The keys are encrypted if they are not
+// yet when initialize is set.
+func (client RestClient) UnlockParticipationKeys(passphrase string, initialize bool) (err error) {
+	var request model.UnlockParticipationKeysRequest
+	if passphrase != "" {
+		request.Passphrase = &passphrase
+	}
+	if initialize {
+		request.Initialize = &initialize
+	}
+	err = client.post(nil, "/v2/participation/unlock", nil, protocol.EncodeJSON(&request), true)
+	return
+}
+
 /* Endpoint registered for follower nodes */
 
 // SetSyncRound sets the sync round for the catchup service
@@ -29,6 +29,7 @@ var (
 	errFailedRetrievingLatestBlockHeaderStatus = "failed retrieving latest block header"
 	errFailedRetrievingTimeStampOffset         = "failed retrieving timestamp offset from node: %v"
 	errFailedSettingTimeStampOffset            = "failed to set timestamp offset on the node: %v"
+	errFailedRollingBackLedger                 = "failed to roll back the ledger: %v"
 	errFailedRetrievingSyncRound               = "failed retrieving sync round from ledger"
 	errFailedSettingSyncRound                  = "failed to set sync round on the ledger"
 	errFailedParsingFormatOption               = "failed to parse the format option"
@@ -44,6 +45,10 @@ var (
 	errFailedToParseCatchpoint                 = "failed to parse catchpoint"
 	errFailedToAbortCatchup                    = "failed to abort catchup : %v"
 	errFailedToStartCatchup                    = "failed to start catchup : %v"
+	errFailedToExportLedgerSnapshot            = "failed to export ledger snapshot : %v"
+	errFailedToDumpLedgerState                 = "failed to dump ledger state : %v"
+	errFailedToAddPriorityPeer                 = "failed to add priority peer : %v"
+	errPeerManagementUnavailable               = "the network of the node does not support peer management"
 	errCatchpointWouldNotInitialize            = "the node has already been initialized"
 	errOperationNotAvailableDuringCatchup      = "operation not available during catchup"
 	errRESTPayloadZeroLength                   = "payload was of zero length"
@@ -114,227 +114,249 @@ func RegisterHandlersWithBaseURL(router EchoRouter, si ServerInterface, baseURL
 // Base64 encoded, gzipped, json marshaled Swagger object
 var swaggerSpec = []string{
 
-	"H4sIAAAAAAAC/+y9/5PbNrIg/q+g9F6VY3/EGdtx8jb+1Na7iZ1k5+IkLo+TvfdsXwKRLQk7FMAFwBkp",
-	"Pv/vV+gGSJAEJWpm4iRX+5M9Ir40Go1Gf0P3+1muNpWSIK2ZPX0/q7jmG7Cg8S+e56qWNhOF+6sAk2tR",
-	"WaHk7Gn4xozVQq5m85lwv1bcrmfzmeQbaNu4/vOZhn/WQkMxe2p1DfOZydew4W5gu6tc62akbbZSmR/i",
-	"jIY4fz77sOcDLwoNxgyh/EGWOyZkXtYFMKu5NDx3nwy7FnbN7FoY5jszIZmSwNSS2XWnMVsKKAtzEhb5",
-	"zxr0Llqln3x8SR9aEDOtShjC+UxtFkJCgAoaoJoNYVaxApbYaM0tczM4WENDq5gBrvM1Wyp9AFQCIoYX",
-	"ZL2ZPX0zMyAL0LhbOYgr/O9SA/wKmeV6BXb2bp5a3NKCzqzYJJZ27rGvwdSlNQzb4hpX4gokc71O2He1",
-	"sWwBjEv26utn7NNPP/3CLWTDrYXCE9noqtrZ4zVR99nTWcEthM9DWuPlSmkui6xp/+rrZzj/hV/g1Fbc",
-	"GEgfljP3hZ0/H1tA6JggISEtrHAfOtTveiQORfvzApZKw8Q9ocZ3uinx/L/rruTc5utKCWkT+8LwK6PP",
-	"SR4Wdd/HwxoAOu0rhyntBn3zMPvi3ftH80cPP/zbm7Psv/2fn336YeLynzXjHsBAsmFeaw0y32UrDRxP",
-	"y5rLIT5eeXowa1WXBVvzK9x8vkFW7/sy15dY5xUva0cnItfqrFwpw7gnowKWvC4tCxOzWpaOTbnRPLUz",
-	"YVil1ZUooJg77nu9Fvma5dzQENiOXYuydDRYGyjGaC29uj2H6UOMEgfXjfCBC/rjIqNd1wFMwBa5QZaX",
-	"ykBm1YHrKdw4XBYsvlDau8ocd1mx12tgOLn7QJct4k46mi7LHbO4rwXjhnEWrqY5E0u2UzW7xs0pxSX2",
-	"96txWNswhzTcnM496g7vGPoGyEggb6FUCVwi8sK5G6JMLsWq1mDY9Rrs2t95GkylpAGmFv+A3Lpt/58X",
-	"P3zPlGbfgTF8BS95fslA5qqA4oSdL5lUNiINT0uIQ9dzbB0ertQl/w+jHE1szKri+WX6Ri/FRiRW9R3f",
-	"ik29YbLeLEC7LQ1XiFVMg621HAOIRjxAihu+HU76Wtcyx/1vp+3Ico7ahKlKvkOEbfj2rw/nHhzDeFmy",
-	"CmQh5IrZrRyV49zch8HLtKplMUHMsW5Po4vVVJCLpYCCNaPsgcRPcwgeIY+DpxW+InDCIKPgNLMcAEfC",
-	"NkEz7nS7L6ziK4hI5oT96JkbfrXqEmRD6Gyxw0+VhiuhatN0GoERp94vgUtlIas0LEWCxi48OhyDoTae",
-	"A2+8DJQrabmQUDjmjEArC8SsRmGKJtyv7wxv8QU38PmTsTu+/Tpx95eqv+t7d3zSbmOjjI5k4up0X/2B",
-	"TUtWnf4T9MN4biNWGf082Eixeu1um6Uo8Sb6h9u/gIbaIBPoICLcTUasJLe1hqdv5QP3F8vYheWy4Lpw",
-	"v2zop+/q0ooLsXI/lfTTC7US+YVYjSCzgTWpcGG3Df3jxkuzY7tN6hUvlLqsq3hBeUdxXezY+fOxTaYx",
-	"jyXMs0bbjRWP19ugjBzbw26bjRwBchR3FXcNL2GnwUHL8yX+s10iPfGl/tX9U1Wl622rZQq1jo79lYzm",
-	"A29WOKuqUuTcIfGV/+y+OiYApEjwtsUpXqhP30cgVlpVoK2gQXlVZaXKeZkZyy2O9O8alrOns387be0v",
-	"p9TdnEaTv3C9LrCTE1lJDMp4VR0xxksn+pg9zMIxaPyEbILYHgpNQtImOlISjgWXcMWlPWlVlg4/aA7w",
-	"Gz9Ti2+SdgjfPRVsFOGMGi7AkARMDe8ZFqGeIVoZohUF0lWpFs0Pn5xVVYtB/H5WVYQPlB5BoGAGW2Gs",
-	"uY/L5+1Jiuc5f37CvonHRlFcyXLnLgcSNdzdsPS3lr/FGtuSX0M74j3DcDuVPnFbE9DgxPy7oDhUK9aq",
-	"dFLPQVpxjf/m28Zk5n6f1PnPQWIxbseJCxUtjznScfCXSLn5pEc5Q8Lx5p4TdtbvezOycaPsIRhz3mLx",
-	"rokHfxEWNuYgJUQQRdTkt4drzXczLyRmKOwNyeRHA0QhFV8JidDOnfok2YZf0n4oxLsjBDCNXkS0RBJk",
-	"Y0L1MqdH/cnAzvInoNbUxgZJ1EmqpTAW9WpszNZQouDMZSDomFRuRBkTNnzPIhqYrzWviJb9FxK7hER9",
-	"nhoRrLe8eCfeiUmYI3YfbTRCdWO2fJB1JiFBrtGD4ctS5Zd/42Z9Byd8EcYa0j5Ow9bAC9Bszc06cXB6",
-	"tN2ONoW+XUOkWbaIpjpplvhCrcwdLLFUx7CuqnrGy9JNPWRZvdXiwJMOclky15jBRqDB3CuOZGEn/Yt9",
-	"xfO1EwtYzsty3pqKVJWVcAWlU9qFlKDnzK65bQ8/jhz0GjxHBhyzs8Ci1XgzE5rYdGOL0MA2HG+gjdNm",
-	"qrLbp+Gghm+gJwXhjahqtCJEisb587A6uAKJPKkZGsFv1ojWmnjwEze3/4QzS0WLIwugDe67Bn8Nv+gA",
-	"7Vq396lsp1C6IJu1db8JzXKlaQi64f3k7j/AdduZqPOTSkPmh9D8CrThpVtdb1H3G/K9q9N54GQW3PLo",
-	"ZHoqTCtgxDmwH4p3oBNWmh/wP7xk7rOTYhwltdQjUBhRkTu1oIvZoYpmcg3Q3qrYhkyZrOL55VFQPmsn",
-	"T7OZSSfvK7Ke+i30i2h26PVWFOautgkHG9ur7gkh21VgRwNZZC/TieaagoDXqmLEPnogEKfA0Qghanvn",
-	"19qXapuC6Uu1HVxpagt3shNunMnM/ku1fe4hU/ow5nHsKUh3C5R8AwZvNxkzTjdL65c7Wyh9M2mid8FI",
-	"1nobGXejRsLUvIckbFpXmT+bCY8FNegN1AZ47BcC+sOnMNbBwoXlvwEWjBv1LrDQHeiusaA2lSjhDkh/",
-	"nRTiFtzAp4/Zxd/OPnv0+OfHn33uSLLSaqX5hi12Fgz7xJvlmLG7Eu4ntSOULtKjf/4k+Ki646bGMarW",
-	"OWx4NRyKfF+k/VIz5toNsdZFM666AXASRwR3tRHaGbl1HWjPYVGvLsBap+m+1Gp559xwMEMKOmz0stJO",
-	"sDBdP6GXlk4L1+QUtlbz0wpbgiwozsCtQxinA24Wd0JUYxtftLMUzGO0gIOH4thtaqfZxVuld7q+C/MG",
-	"aK108gqutLIqV2Xm5DyhEgaKl74F8y3CdlX93wlads0Nc3Oj97KWxYgdwm7l9PuLhn69lS1u9t5gtN7E",
-	"6vy8U/ali/xWC6lAZ3YrGVJnxzyy1GrDOCuwI8oa34Al+Uts4MLyTfXDcnk31k6FAyXsOGIDxs3EqIWT",
-	"fgzkSlIw3wGTjR91Cnr6iAleJjsOgMfIxU7m6Cq7i2M7bs3aCIl+e7OTeWTacjCWUKw6ZHl7E9YYOmiq",
-	"eyYBjkPHC/yMtvrnUFr+tdKvW/H1G63q6s7Zc3/OqcvhfjHeG1C4vsEMLOSq7AaQrhzsJ6k1/i4LetYY",
-	"EWgNCD1S5AuxWttIX3yp1W9wJyZnSQGKH8hYVLo+Q5PR96pwzMTW5g5EyXawlsM5uo35Gl+o2jLOpCoA",
-	"N782aSFzJOQQY50wRMvGcivaJ4RhC3DUlfParbauGAYgDe6LtmPGczqhGaLGjIRfNHEz1Iqmo3C2UgMv",
-	"dmwBIJla+BgHH32Bi+QYPWWDmOZF3AS/6MBVaZWDMVBk3hR9ELTQjq4OuwdPCDgC3MzCjGJLrm8N7OXV",
-	"QTgvYZdhrJ9hn3z7k7n/O8BrleXlAcRimxR6+/a0IdTTpt9HcP3JY7IjSx1RrRNvHYMowcIYCo/Cyej+",
-	"9SEa7OLt0XIFGkNKflOKD5PcjoAaUH9jer8ttHU1EsHu1XQn4bkNk1yqIFilBiu5sdkhtuwadWwJbgUR",
-	"J0xxYhx4RPB6wY2lMCghC7Rp0nWC85AQ5qYYB3hUDXEj/xQ0kOHYubsHpalNo46YuqqUtlCk1oAe2dG5",
-	"vodtM5daRmM3Oo9VrDZwaOQxLEXje2R5DRj/4Lbxv3qP7nBx6FN39/wuicoOEC0i9gFyEVpF2I2jeEcA",
-	"EaZFNBGOMD3KaUKH5zNjVVU5bmGzWjb9xtB0Qa3P7I9t2yFxkZOD7u1CgUEHim/vIb8mzFL89pob5uEI",
-	"LnY051C81hBmdxgzI2QO2T7KRxXPtYqPwMFDWlcrzQvICij5LhEcQJ8Zfd43AO54q+4qCxkF4qY3vaXk",
-	"EPe4Z2iF45mU8MjwC8vdEXSqQEsgvveBkQvAsVPMydPRvWYonCu5RWE8XDZtdWJEvA2vlHU77ukBQfYc",
-	"fQrAI3hohr45KrBz1uqe/Sn+C4yfoJEjjp9kB2ZsCe34Ry1gxBbs3zhF56XH3nscOMk2R9nYAT4ydmRH",
-	"DNMvubYiFxXqOt/C7s5Vv/4EScc5K8ByUULBog+kBlZxf0YhpP0xb6YKTrK9DcEfGN8SywlhOl3gL2GH",
-	"OvdLepsQmTruQpdNjOruJy4ZAhoinp0IHjeBLc9tuXOCml3Djl2DBmbqBYUwDP0pVlVZPEDSP7NnRu+d",
-	"TfpG97qLL3CoaHmpWDPSCfbD97qnGHTQ4XWBSqlygoVsgIwkBJNiR1il3K4L//wpPIAJlNQB0jNtdM03",
-	"1/8900EzroD9l6pZziWqXLWFRqZRGgUFFCDdDE4Ea+b0wYkthqCEDZAmiV8ePOgv/MEDv+fCsCVchzeD",
-	"rmEfHQ8eoB3npTK2c7juwB7qjtt54vpAx5W7+LwW0ucphyOe/MhTdvJlb/DG2+XOlDGecN3yb80Aeidz",
-	"O2XtMY1Mi/bCcSf5crrxQYN1475fiE1dcnsXXiu44mWmrkBrUcBBTu4nFkp+dcXLH5pu+B4SckejOWQ5",
-	"vuKbOBa8dn3o4Z8bR0jhDjAF/U8FCM6p1wV1OqBitpGqYrOBQnAL5Y5VGnKg925OcjTNUk8YRcLnay5X",
-	"qDBoVa98cCuNgwy/NmSa0bUcDJEUquxWZmjkTl0APkwtPHl04hRwp9L1LeSkwFzzZj7/ynXKzRztQd9j",
-	"kHSSzWejGq9D6lWr8RJyuu82J1wGHXkvwk878URXCqLOyT5DfMXb4g6T29zfxmTfDp2CcjhxFPHbfhwL",
-	"+nXqdrm7A6GHBmIaKg0Gr6jYTGXoq1rGb7RDqODOWNgMLfnU9eeR4/dqVF9UshQSso2SsEumJRESvsOP",
-	"yeOE1+RIZxRYxvr2dZAO/D2wuvNMocbb4hd3u39C+x4r87XSd+USpQEni/cTPJAH3e1+ypv6SXlZJlyL",
-	"/gVnnwGYeROsKzTjxqhcoMx2Xpi5jwomb6R/7tlF/8vmXcodnL3+uD0fWpwcAG3EUFaMs7wUaEFW0lhd",
-	"5/at5GijipaaCOIKyvi41fJZaJI2kyasmH6ot5JjAF9juUoGbCwhYab5GiAYL029WoGxPV1nCfBW+lZC",
-	"sloKi3Nt3HHJ6LxUoDGS6oRabviOLR1NWMV+Ba3YorZd6R8fKBsrytI79Nw0TC3fSm5ZCdxY9p2Qr7c4",
-	"XHD6hyMrwV4rfdlgIX27r0CCESZLB5t9Q18xrt8vf+1j/DHcnT6HoNM2Y8LMLbOTJOV/f/KfT9+cZf/N",
-	"s18fZl/8f6fv3j/5cP/B4MfHH/761//T/enTD3+9/5//ntqpAHvq+ayH/Py514zPn6P6E4Xq92H/aPb/",
-	"jZBZksjiaI4ebbFPMFWEJ6D7XeOYXcNbabfSEdIVL0XheMtNyKF/wwzOIp2OHtV0NqJnDAtrPVKpuAWX",
-	"YQkm02ONN5aihvGZ6Yfq6JT0b8/xvCxrSVsZpG96hxniy9Ry3iQjoDxlTxm+VF/zEOTp/3z82eezefvC",
-	"vPk+m8/813cJShbFNpVHoIBtSleMH0ncM6ziOwM2zT0Q9mQoHcV2xMNuYLMAbdai+vicwlixSHO48GTJ",
-	"25y28lxSgL87P+ji3HnPiVp+fLitBiigsutU/qKOoIat2t0E6IWdVFpdgZwzcQInfZtP4fRFH9RXAl+G",
-	"wFSt1BRtqDkHRGiBKiKsxwuZZFhJ0U/veYO//M2dq0N+4BRc/TlTEb33vvnqNTv1DNPco5QWNHSUhCCh",
-	"SvvHk52AJMfN4jdlb+Vb+RyWaH1Q8ulbWXDLTxfciNyc1gb0l7zkMoeTlWJPw3vM59zyt3IgaY0mVowe",
-	"TbOqXpQiZ5exQtKSJyXLGo7w9u0bXq7U27fvBrEZQ/XBT5XkLzRB5gRhVdvMp/rJNFxznfJ9mSbVC45M",
-	"ubz2zUpCtqrJQBpSCfnx0zyPV5Xpp3wYLr+qSrf8iAyNT2jgtowZq5r3aE5A8U963f5+r/zFoPl1sKvU",
-	"Bgz7ZcOrN0Ladyx7Wz98+Cm+7GtzIPzir3xHk7sKJltXRlNS9I0quHBSKzFWPav4KuVie/v2jQVe4e6j",
-	"vLxBG0dZMuzWeXUYHhjgUO0CmifOoxtAcBz9OBgXd0G9QlrH9BLwE25h9wH2rfYrej9/4+068Aaf13ad",
-	"ubOdXJVxJB52psn2tnJCVojGMGKF2qpPjLcAlq8hv/QZy2BT2d280z0E/HhBM7AOYSiXHb0wxGxK6KBY",
-	"AKurgntRnMtdP62NoRcVOOgruITda9UmYzomj003rYoZO6hIqZF06Yg1PrZ+jP7m+6iy8NDUZyfBx5uB",
-	"LJ42dBH6jB9kEnnv4BCniKKT9mMMEVwnEEHEP4KCGyzUjXcr0k8tT8gcpBVXkEEpVmKRSsP796E/LMDq",
-	"qNJnHvRRyM2Ahoklc6r8gi5Wr95rLlfgrmd3pSrDS8qqmgzaQH1oDVzbBXC7184v44QUATpUKa/x5TVa",
-	"+OZuCbB1+y0sWuwkXDutAg1F1MZHL5+Mx58R4FDcEJ7QvdUUTkZ1XY+6RMbBcCs32G3UWh+aF9MZwkXf",
-	"N4ApS9W12xcHhfLZNimpS3S/1IavYER3ib13E/NhdDx+OMghiSQpg6hlX9QYSAJJkKlx5tacPMPgvrhD",
-	"jGpmLyAzzEQOYu8zwiTaHmGLEgXYJnKV9p7rjheVsgKPgZZmLaBlKwoGMLoYiY/jmptwHDFfauCyk6Sz",
-	"3zDty77UdOdRLGGUFLVJPBduwz4HHej9PkFdyEoXUtHFSv+EtHJO98LnC6ntUBJF0wJKWNHCqXEglDZh",
-	"UrtBDo4flkvkLVkqLDEyUEcCgJ8DnObygDHyjbDJI6TIOAIbAx9wYPa9is+mXB0DpPQJn3gYG6+I6G9I",
-	"P+yjQH0njKrKXa5ixN+YBw7gU1G0kkUvohqHYULOmWNzV7x0bM7r4u0ggwxpqFD08qH50Jv7Y4rGHtcU",
-	"XflHrYmEhJusJpZmA9BpUXsPxAu1zeiFclIXWWwXjt6TbxfwvXTqYFIuunuGLdQWw7nwaqFY+QOwjMMR",
-	"wIhsL1thkF6x35icRcDsm3a/nJuiQoMk4w2tDbmMCXpTph6RLcfI5ZMovdyNAOiZodpaDd4scdB80BVP",
-	"hpd5e6vN27Sp4VlY6viPHaHkLo3gb2gf6yaE+1ub+G88uVg4UR8lE97QsnSbDIXUuaKsg8ckKOyTQweI",
-	"PVh92ZcDk2jtxnp18RphLcVKHPMdOiWHaDNQAirBWUc0zS5TkQJOlwe8xy9Ct8hYh7vH5e5+FECoYSWM",
-	"hdZpFOKCfg9zPMf0yUotx1dnK71063ulVHP5k9scO3aW+dFXgBH4S6GNzdDjllyCa/S1QSPS165pWgLt",
-	"hihSsQFRpDkuTnsJu6wQZZ2mVz/vt8/dtN83F42pF3iLCUkBWgssjpEMXN4zNcW2713wC1rwC35n6512",
-	"GlxTN7F25NKd409yLnoMbB87SBBgijiGuzaK0j0MMnpwPuSOkTQaxbSc7PM2DA5TEcY+GKUWnr2P3fw0",
-	"UnItURrA9AtBtVpBEdKbBX+YjJLIlUquoipOVbUvZ94Jo9R1mHluT9I6H4YPY0H4kbifCVnANg19rBUg",
-	"5O3LOky4h5OsQFK6krRZKImaOMQfW0S2uo/sC+0/AEgGQb/uObPb6GTapWY7cQNK4IXXSQyE9e0/lsMN",
-	"8aibj4VPdzKf7j9COCDSlLBRYZNhGoIRBsyrShTbnuOJRh01gvGjrMsj0hayFj/YAQx0g6CTBNdJpe1D",
-	"rb2B/RR13lOnlVHstQ8sdvTNc/8Av6g1ejA6kc3DvO2NrjZx7d/+dGGV5ivwXqiMQLrVELicY9AQZUU3",
-	"zAoKJynEcgmx98XcxHPQAW5gYy8mkG6CyNIumlpI+/mTFBkdoJ4WxsMoS1NMghbGfPKvh16uINNHpqTm",
-	"Soi25gauquRz/W9hl/3Ey9opGUKbNjzXu526l+8Ru361+RZ2OPLBqFcH2IFdQcvTK0AaTFn6m08mSmB9",
-	"z3RS/KN62dnCI3bqLL1Ld7Q1vijDOPG3t0ynaEF3Kbc5GG2QhINlym5cpGMT3OmBLuL7pHxoE0RxWAaJ",
-	"5P14KmFCCcvhVdTkojhEu6+Bl4F4cTmzD/PZ7SIBUreZH/EArl82F2gSzxhpSp7hTmDPkSjnVaXVFS8z",
-	"Hy8xdvlrdeUvf2wewis+siaTpuzXX529eOnB/zCf5SVwnTWWgNFVYbvqT7MqKuOw/yqhbN/e0EmWomjz",
-	"m4zMcYzFNWb27hmbBkVR2viZ6Cj6mItlOuD9IO/zoT60xD0hP1A1ET+tz5MCfrpBPvyKizI4GwO0I8Hp",
-	"uLhplXWSXCEe4NbBQlHMV3an7GZwutOno6WuAzwJ5/oBU1OmNQ7pE1ciK/LBP/zOpaevle4wf/8yMRk8",
-	"9NuJVU7IJjyOxGqH+pV9YeqEkeD1y+oXdxofPIiP2oMHc/ZL6T9EAOLvC/876hcPHiS9h0kzlmMSaKWS",
-	"fAP3m1cWoxvxcRVwCdfTLuizq00jWapxMmwolKKAArqvPfautfD4LPwvBZTgfjqZoqTHm07ojoGZcoIu",
-	"xl4iNkGmGyqZaZiS/ZhqfATrSAuZvS/JQM7Y4RGS9QYdmJkpRZ4O7ZAL49irpGBK15hh4xFrrRuxFiOx",
-	"ubIW0Viu2ZScqT0gozmSyDTJtK0t7hbKH+9ain/WwEThtJqlAI33Wu+qC8oBjjoQSNN2MT8w+ana4W9j",
-	"B9njbwq2oH1GkL3+u+eNTyksNFX058gI8HjGAePeE73t6cNTM71mW3dDMKfpMVNKpwdG5511I3MkS6EL",
-	"ky21+hXSjhD0HyUSYQTHp0Az768gU5F7fZbSOJXbiu7t7Ie2e7puPLbxt9aFw6KbqmM3uUzTp/q4jbyJ",
-	"0mvS6Zo9kseUsDjCoPs0YIS14PGKgmGxDEqIPuKSzhNlgei8MEufyvgt5ymN355KD/Pg/WvJrxc8VSPG",
-	"6UIOpmh7O3FSVrHQOWyAaXIc0OwsiuBu2grKJFeBbn0Qw6y0N9RraNrJGk2rwCBFxarLnMIUSqMSw9Ty",
-	"mkuqIu76Eb/yvQ2QC971ulYa80CadEhXAbnYJM2xb9++KfJh+E4hVoIKZNcGogrMfiBGySaRinwV6yZz",
-	"h0fN+ZI9nEdl4P1uFOJKGLEoAVs8ohYLbvC6bNzhTRe3PJB2bbD54wnN17UsNBR2bQixRrFG90QhrwlM",
-	"XIC9BpDsIbZ79AX7BEMyjbiC+w6LXgiaPX30BQbU0B8PU7esL3C+j2UXyLNDsHaajjEmlcZwTNKPmo6+",
-	"XmqAX2H8dthzmqjrlLOELf2FcvgsbbjkK0i/z9gcgIn64m6iO7+HF0neADBWqx0TNj0/WO7408ibb8f+",
-	"CAyWq81G2I0P3DNq4+ipLa9Mk4bhqNa/rxcV4AofMf61CuF/PVvXR1Zj+GbkzRZGKX+PPtoYrXPGKfln",
-	"KdrI9FCvk52H3MJYQKupm0W4cXO5paMsiYHqS1ZpIS3aP2q7zP7i1GLNc8f+TsbAzRafP0kUourWapHH",
-	"Af7R8a7BgL5Ko16PkH2QWXxf9olUMts4jlLcb3MsRKdyNFA3HZI5Fhe6f+ipkq8bJRslt7pDbjzi1Lci",
-	"PLlnwFuSYrOeo+jx6JV9dMqsdZo8eO126MdXL7yUsVE6VTCgPe5e4tBgtYArfDGX3iQ35i33QpeTduE2",
-	"0P++8U9B5IzEsnCWk4pA5NHc91jeSfE/fddmPkfHKr1E7NkAlU5YO73d7iNHGx5ndev7bylgDL+NYG4y",
-	"2nCUIVZGou8pvL7p83vEC/VBoj3vGBwf/cK008FRjn/wAIF+8GDuxeBfHnc/E3t/8CCdgDhpcnO/tli4",
-	"jUaMfVN7+KVKGMBC1cImoMjnR0gYIMcuKffBMcGFH2rOuhXiPr4UcTfvu9LRpulT8PbtG/wS8IB/9BHx",
-	"OzNL3MD2lcL4Ye9WyEySTNF8j+LcOftSbacSTu8OCsTzB0DRCEommudwJYMKoEl3/cF4kYhG3agLKJVT",
-	"MuOiQLE9/8+DZ7f4+R5s16Isfmpzu/UuEs1lvk5GCS9cx59JRu9cwcQqk3VG1lxKKJPDkW77c9CBE1r6",
-	"P9TUeTZCTmzbr0BLy+0trgW8C2YAKkzo0Cts6SaIsdpNm9WkZShXqmA4T1vUomWOw1LOqRKaiffNOOym",
-	"tj5uFd+C+4RDS1FiGGbab4wtM83tSAItrHce6gu5cbD8uCEzA40OmnGxwYvZ8E1VAp7MK9B8hV2VhF53",
-	"TKGGI0cVK5ip3CdsiQkrFLO1lkwtl9EyQFqhodzNWcWNoUEeumXBFueePX308GHS7IXYmbBSwmJY5g/t",
-	"Uh6dYhP64ossUSmAo4A9DOuHlqKO2dgh4fiakv+swdgUT8UP9HIVvaTu1qZ6kk3t0xP2DWY+ckTcSXWP",
-	"5sqQRLibULOuSsWLOSY3fv3V2QtGs1IfKiFP9SxXaK3rkn/SvTI9wWjI7DSSOWf6OPtTebhVG5s15SdT",
-	"uQldi7ZApujF3KAdL8bOCXtOJtSmgD9NwjBFtt5AEVW7JCUeicP9x1qer9E22ZGAxnnl9EKsgZ21npvo",
-	"9WFT/QgZtoPb12KlUqxzpuwa9LUwgC/y4Qq66RCb3KDeNh7SI3aXp2spiVJOjhBGm1pHx6I9AEeSbAgq",
-	"SELWQ/yRlimqx3xsXdoL7JV+i9Erctvz+ofkeiHFNvvOOxdyLpUUOZZCSEnSmLptmptyQtWItH/RzPwJ",
-	"TRyuZGnd5i2wx+Josd3ACD3ihi7/6KvbVKIO+tPC1pdcW4E1nrNBMQ+Vrr1DTEgDvpqVI6KYTyqdCGpK",
-	"PoRoAiiOJCPMyjRi4fzaffve278xKcalkGjp8mjz+hm5rEoj0DMtmbBspcD49XRf85g3rs8JZmksYPvu",
-	"5IVaifxCrHAMCqNzy6aY0eFQZyGC1EdsurbPXFufO7/5uRMORpOeVZWfdLwOelKQtFs5iuBU3FIIJImQ",
-	"24wfj7aH3PaGfuN96ggNrjBqDSq8hweE0dTS7o7yldMtiaKwBaMXlckEukImwHghZHChpi+IPHkl4Mbg",
-	"eR3pZ3LNLekOk3jaa+DlyAMIfKFMPvjbDtWvHOBQgmsMc4xvY1sGfIRxNA1aiZ/LHQuHwlF3JEw842UT",
-	"Op0o6o1SlReiCnxc1CvznWIcjnFn4clkB10Hn+813bEax7E30ViOwkVdrMBmvChSqa2+xK8Mv4ZHYrCF",
-	"vG6KUDWvA7s5yofU5ifKlTT1Zs9cocEtp4vq5ieoIa7dH3YYM+0sdvhvqgLT+M74oOmjX+WGCOniuMT8",
-	"w1fGKanX0XRmxCqbjgm8U26PjnbqmxF62/9OKT081/1DvMbtcbl4j1L87St3ccSJewfx6XS1NHl1MRZc",
-	"4feQ8KjJCNnlSniVDeqMYdQDbl5iy3rAh4ZJwK94OfISPvaV0P1K/oOx9/D5aPoGbn16LsvZXhY0mvKI",
-	"YoV73pehC3EsPpjCg+/Oa+HXuheh4767bzueOooRa5nFqIfuZk60doOP9aJ9ezWWIiHU6cDvcT0QH8Uz",
-	"92ng4UqoOkRfhRjooBLSrz4FT6fux8j6ky8Lfm+vxaiP5bWvX0vL9Dr5tz+RF5aBtHr3B/C4DDa9X1Qm",
-	"Ie2SeaptwprSh5NKIXZuxSk1bFLlUrxsGGxlxFo6tDQoPzMgq+dTxIEBPj7MZ+fFURdmquTOjEZJHbsX",
-	"YrW2mLH/b8AL0C8PVCRoqxDgEauUEW0F0tIN5lPArnG4k6mPDRwBi7iiwnCsEIR6BbnFsrNtcJ0GOKa+",
-	"gpssOH3+VZlgXJ1u3mT4ggT7qhAMa80euOMHiZOi5F9Up/Nkes79syaEml6AXXPTpmvpvZme/HJzuYQc",
-	"syLvTVT19zXIKAnSPNhlEJZllLdKNO+YMK/38VbHFqB9eaT2whPV17k1OGPv2C9hd8+wDjUkC4c2j/hu",
-	"kjgYMUAusJBDesyQ7KPGhGkoA7EQQoJ9Kua2OMZozuco7doN5wok6S6ONhXbninTRc8nzeW6HpX2EZ/k",
-	"jOWyGtZMHtc/nmOJauMD5HiTeDjW0tn5sHDOtU9cjGnFGt9JSGEMJvwWcgjSLKW49PUDECvkqbrmuggt",
-	"7iQpFN1NIg30splZtA84hkEOiVIM+BYqL5UTI7KxB2XdNxNNwOE9Q5GhbQIfhGsJWkPRuERKZSCzKjz4",
-	"2AfHPlRQ+OuNkGBGyx8RcKOpr1+1ub2xDBzHVNfcR73GC2QaNtxBp6MM3ONz7kP2M/oeHuGHMmAHLUwN",
-	"vR6uRxue7ggzQGJM9Uvmb8vDj/tvYmwSUoLOguepn45bdjOyYd7Nos7pgo4PRmOQm5w7Zw8rSdpp8uEq",
-	"ezpC9Ej+EnanpASFQr5hB2OgSXIi0KOEo71NvlPzm0nBvboT8H7fPHKVUmU24uw4H+YQ71P8pcgvAXMA",
-	"NiHuIzXa2SdoY2+82dfrXciZXVUgobh/wtiZpEdFwbHdLS/Ym1zes/vm3+KsRU1p/b1R7eStTL/OwIT7",
-	"+pbcLAyzn4cZcKzullPRIAcyVG/lWMjNNSbn71bxPJmqlQ9dzf0q8i1RERQpmeSCPFbP8KCnDEeYAiHK",
-	"1YGOTM68p4uZUqVieW+SpsENlcZUPBkCZEFOyRbQQOEHTyIgWRc9cQop9Z1PeqeWTEPrRL5p9r9hCfeU",
-	"Rt+fuZmly++WSkOnGLvrTZk+m4cvmEYT/7MQVnO9u0mOvkEJ+YH1ZBTLB8OxmkisdiFtNNYQh2WprjNk",
-	"VllT5yKl2rp2pnsZh6JrbT93qhcQxXVx4wW1HVvzguVKa8jjHun3ngTVRmnISoVhXikP9NI6uXuDj7wk",
-	"K9WKqSpXBVC9mDQFjc1VS8lRbIIoqiaJAqIdfC1MfSI6njilu1PJj5ShqLU6onZ+DvRyvc3qRIvOyJc5",
-	"ErEMxmdx8hiixkN499T+T/Pmpdgi3YBOHfkls7qGOfMt+jWy/cHnGthGGEOgNLR0LcoSH46LbeR5bQIX",
-	"0qgdEXvPMazySmDsTTeJAEnDlbvzmswKMQ+4iNMeMbvWql6towTTDZxB5dW1V4jjUX40NYZH4QsyN8UT",
-	"tlHGek2TRmqX3IacfZIrabUqy65RikT0lbe0f8e3Z3luXyh1ueD55X3Ua6WyzUqLeXhf3Q8ObGfSvdRi",
-	"3Qs4o3Lmh1P1UjsMlfNEO5lB9ljc0YXdIzDfHeagh23uZ8OF9dfVZaZpNeZMMm7VRuTpM/XnirYbjZFL",
-	"sahkzjKqrUhZJrAZHvb4smqCK5BFDtEMkieLw50xzwi8kxnZjfsvSuD9cdkSPKMZuSiHzMVLUVk+Kuv1",
-	"AEBI6emzrTUVZIwlsYarqBWlSkAXeR/QibcKRiLdDjY3wp0DZeFWQA2iHxsAPyHjw5xyy1Ek5UJtw/f7",
-	"bfK5GwH/YT+Vd5jHWIjXRUtamoK8QqKaEY6QTnG9Nx7qNT57X0yNimqK50684SMAxuOkOjBMipY6Fowl",
-	"FyUUWar24nljo5pHmrZ/mtUviS6M5+Q5r0PpQzd2rcEnTiERX3f9XxV3pKSa5kNLsixgC/Su41fQimoa",
-	"ziP/C5RU8rBnDFBVVsIVdMLHfDaXGkVNcQWhr2k6swKgQm9k30aWiouK7/Ke4cSvPYsia6ZgN2lJIcTS",
-	"TrEDZpKkUWcrMzomZupRchBdiaLmHfyZY0WOrhnQHeUEqgY6Qhb0yKnT/EgjvAoDnIX+KVEmYOLdND50",
-	"NAtKo24fAzoYJ1mbsVMv02GScaqixsGCsxWNI5ZIvOUbpuLXctwgOST5Vt2auE9CyQixX20hR6nG6ztQ",
-	"eI1nxEnhs54gtUuAgrQC1yVhbV+DZFJFJSavuWlUlTaHYviBJsZGQnpt+gZO5Taa8fY7y3AwZnrJ1EYV",
-	"Cd3Q6c3N87/LSdx7EEfHS9GIAf/8b4/9K1C3VzuwAZbylm4/neyPRRr9Lea5+Jwt6jBQWaprqhkZ66HP",
-	"IfhBifqCC8iL5aK5lkPU5tyn9+ybOkQUr77hO6Y0/uO0zn/WvBTLHfIZAj90Y2bNHQl5xytFBPgoUDfx",
-	"fvFqHgAL1hYVpqJ1i6ljRsPt3CgR0O4iD8V9FNvwS4i3AYMdiH/m1jFOUy/QcuGu7N52DrHgFx9StGx4",
-	"EWv6mCiyW0Y9pA52vf//9i1cPFXI71aVPA8VQn2Joi6fwSrAgbjsGjb7H0sO+VoggaaycEu0OryuL25g",
-	"Mj2SdaVeIIyVX+mAPai4Oqg8c6tlTLT89mps7HlmOmkpd70LU6NuBkDHdRoPgR+Xrfw4+E/mcB1bxhTw",
-	"/yh4HylUG8NLNWk/ApY7GTgSsJK1eqG2mYalORRgQuZqp87rNndHMLEKmWvghiJuzn/wimebolRIpwhT",
-	"TGjj02xGKWApZMsshaxqm9BjMFOp3EUIi43+iNYRF9qYlOCEySte/nAFWotibOPc6aCSjnGJiODo8H0T",
-	"JozmTh0OIEyrw+H7zNaMHjdzFzgVoaJwTWO5LLgu4uZCshy0u/fZNd+Zm3uUGufAIZ8Sj6SZbtaAyLuE",
-	"pE2AlDvvFL6lv6cBkN+h42eCwwbjghPOGjLtWDXinxnC8Kdw2Gz4NivVCl8RjhwIn5sWPXykAiqJZnCS",
-	"z6atO8xjxK+wfxpMy+8ZkVU465Qp9p/7H3ArUY38UQq79+STjbL/rJPibulgBqTKVRv8T8QyPI+pl7g+",
-	"+Ur8GjcIm+GpSqA9iDYRRvxDXbv4yC5iGIR/xh0bwaeXO+tGWqTe+5JlIEOLgdkT3g+mDWXnuQ/PGprS",
-	"BqYGQsrcv5Y+0tJG9vlwL42AR7Xp/VnvTtuEzLhxjqkRt/99dFapKsunxHxS5Y7Cuwk8pF0YR+gjcgKM",
-	"rLsJjzFNLZtO3qNOUZtjy+SNFtU55O2q8n1K/5iZaISjd10Qaom8jCq3o3ULX/I0xpR5/41Z1wzWMAnG",
-	"mYa81mgmvua7w2XHRjJGX/zt7LNHj39+/NnnzDVghViBabOO98p2tXGBQvbtPh83EnCwPJvehJB9gBAX",
-	"/I/hUVWzKf6sEbc1bUrRQdGyY+zLiQsgcRwT5aJutFc4Thva/8fartQi73zHUij47fdMq7JMV31o5KqE",
-	"AyW1W5ELxWkgFWgjjHWMsOsBFbaNiDZrNA9i7t8ryiajZA7BfuypQNiRkKvUQsYCapGf4dtu7zVisK1K",
-	"z6vI07NvXV5PIwsdCo0YFbMAVqnKi/ZiyVIQ4QsiHb2s9YZPtIhHMbINs6Vo2RQh+sjzNOnFBbP3c/tu",
-	"MVeb5vRuExPiRTiUNyDNMf/EeN6Cm3CS1rT/h+EfiUQMd8Y1muX+FrwiqR/crCj/JNCGj/IT5IEAjLy2",
-	"7byTjB6KRYmINXkJ0J8QHMh98eO71rF88FkIQhI6HAAvfj7btmteMnhwfueMvt81SImW8m6MEjrLP/Qi",
-	"N7De5iKJtsgbTawFQ2xJDcXC6Lm1eda8Yh7RSgaPnbVSljnNtCwTj6TJjoNnKiYcpxLoK15+fK7xtdDG",
-	"niE+oHg1/jQqfikbI5lQaW6Wp+8FnzR39Cr27qaWL/Fh9t/B7VHynvNDeSf84DZD4w5WrF+FW4HeerNr",
-	"HJOCrB59zha+2EalIRem79y/DsJJ8zAUtFj6gFbY2gMvUQ+t8ydlb0HGyxCJw76P3FuNz95D2B7R35mp",
-	"jJzcJJWnqG9AFgn8pXhUXJz3wHVxy8IMN0v7EiVwOzLty7Ds8NTlUWoTd+nUBobrnHxbd3CbuKjbtU3N",
-	"WTS5vsPbt2/sYkqqoXQtBtcdcx3dSVGGo0oy/AZZjghHfgw/b4pifhrLe0u5XUdyc/f2oxblwYCVTqb1",
-	"D/PZCiQYYTCX+M++dszHvUsDBJR5YXhUCdbbpIshxCTW2pk8mirKoT4hfbrvlsh5ja8a81oLu8O6wcGA",
-	"Jn5O5mP6psnt4XPDNL40f/dZdQlN7fY2E0htwu36jeIl3kfk4pPuFlLlCfuKMnz7g/LXe4v/gE//8qR4",
-	"+Omj/1j85eFnD3N48tkXDx/yL57wR198+gge/+WzJw/h0fLzLxaPi8dPHi+ePH7y+Wdf5J8+ebR48vkX",
-	"/3HP8SEHMgEaUvs/nf2v7Kxcqezs5Xn22gHb4oRX4ltwe4O68lJhXUuH1BxPImy4KGdPw0//I5ywk1xt",
-	"2uHDrzNfn2m2trYyT09Pr6+vT+Iupyt8+p9ZVefr0zAPVhvsyCsvz5sYfYrDwR1trce4qZ4UzvDbq68u",
-	"XrOzl+cnLcHMns4enjw8eeRLW0teidnT2af4E56eNe77KebXPDU+df5p81brw3zwraoosb775GnU/7UG",
-	"XmKCHffHBqwWefikgRc7/39zzVcr0Cf4eoN+unp8GqSR0/c+c8KHfd9O48iQ0/edBBPFgZ4h8uFQk9P3",
-	"oXTu/gE7ZVN9zFnUYSKg+5qdLrBcztSmEK9ufCmoxpjT9yiIj/5+6q0p6Y+oENFJOw2JWkZa0pP89McO",
-	"Ct/brVvI/uFcm2i8nNt8XVen7/E/eGiiFVGGz1O7lafoQD5930GE/zxARPf3tnvc4mqjCgjAqeWS6g3v",
-	"+3z6nv6NJoJtBVo4aRSz6vhfKfvZKZad2w1/3knv7iwhlbPmR2mAtOVQcWAn8/bpW8NHzovQ+GIn8yA2",
-	"h5hI5A6PHz6k6Z/gf2a+LFMvs8upP88z09Sh32u06eTURN7bs9c18NIDP7AnM4Th0ceD4VxSHKRjxnRp",
-	"fJjPPvuYWDiXTr7hJcOWNP2nH3ETQF+JHNhr2FRKcy3KHftRNqGcUZHcFAVeSnUtA+RO4qg3G653KMlv",
-	"1BUY5uvvRsTJNDjZicI9MASgpWG88rjjI29mVb0oRT6bUwbVdyit2ZTgEoxIw5mCAa0dvHsqvjl4Jqbv",
-	"Qlce3pOyZhKcB5IZ0PBDYX64v2Hv+y5YmupeaoNm/2IE/2IEd8gIbK3l6BGN7i/MuwaVf+Ka83wN+/jB",
-	"8LaMLvhZpVKJJS72MAtf3WSMV1x0eUUbajh7+mZa8T/v9SCDdgHGHeaToMw4Sb3VNXTDkcKZR59rtNf7",
-	"6pp/ePeHuN+fcRnOc2fHya3JdSlAN1TA5bDgzL+4wP8zXIAqZ3Ha1zmzUJYmPvtW4dknD5BPpynJMzeR",
-	"D3Syn7bCdOfn02C3SOmg3ZbvO3929Sqzrm2hrqNZ0OJP7qqhluE+1qb/9+k1FzZbKu2TbvKlBT3sbIGX",
-	"p77CTu/XNqn94Atm6o9+jJ+TJn895V7dSH1DXjfWcaAPp756lW+kUYiCDp9bq1tsxUI+29iv3rxzXA6L",
-	"sHsW3Bplnp6e4rOYtTL2dPZh/r5nsIk/vmsIK9QOnVVaXGGNg3fz2TZTWqyE5GXmrRptmbDZ45OHsw//",
-	"NwAA//+q4vIh1ggBAA==",
+	"H4sIAAAAAAAC/+x9/XMbN7Lgv4Lie1WOfaRkO07exldb77RxktXFSVyRk713sW8XnGmSWA2BWQAjifH5",
+	"f7/qxsdgZjDkUJKd7NX+ZIuDj0aj0Wj057tZoba1kiCtmT1/N6u55luwoOkvXhSqkXYhSvyrBFNoUVuh",
+	"5Ox5+MaM1UKuZ/OZwF9rbjez+UzyLcyep/3nMw3/aISGcvbc6gbmM1NsYMtxYLursXUc6WaxVgs/xJkb",
+	"4vzF7P2eD7wsNRgzhPIHWe2YkEXVlMCs5tLwAj8Zdi3shtmNMMx3ZkIyJYGpFbObTmO2ElCV5iQs8h8N",
+	"6F2ySj/5+JLetyAutKpgCOeXarsUEgJUEIGKG8KsYiWsqNGGW4YzIKyhoVXMANfFhq2UPgCqAyKFF2Sz",
+	"nT3/ZWZAlqBptwoQV/TflQb4FRaW6zXY2dt5bnErC3phxTaztHOPfQ2mqaxh1JbWuBZXIBn2OmHfNcay",
+	"JTAu2Y9ff8k+/fTTL3AhW24tlJ7IRlfVzp6uyXWfPZ+V3EL4PKQ1Xq2V5rJcxPY/fv0lzX/hFzi1FTcG",
+	"8oflDL+w8xdjCwgdMyQkpIU17UOH+rFH5lC0Py9hpTRM3BPX+F43JZ3/N92VgttiUyshbWZfGH1l7nOW",
+	"hyXd9/GwCECnfY2Y0jjoL48XX7x992T+5PH7f/vlbPG//Z+fffp+4vK/jOMewEC2YdFoDbLYLdYaOJ2W",
+	"DZdDfPzo6cFsVFOVbMOvaPP5lli978uwr2OdV7xqkE5EodVZtVaGcU9GJax4U1kWJmaNrMAYGs1TOxOG",
+	"1VpdiRLKOROSXW9EsWEFN24IaseuRVUhDTYGyjFay69uz2F6n6IE4boVPmhBv19ktOs6gAm4IW6wKCpl",
+	"YGHVgesp3Dhcliy9UNq7yhx3WbHXG2A0OX5wly3hTiJNV9WOWdrXknHDOAtX05yJFduphl3T5lTikvr7",
+	"1SDWtgyRRpvTuUfx8I6hb4CMDPKWSlXAJSEvnLshyuRKrBsNhl1vwG78nafB1EoaYGr5dygsbvv/vPjh",
+	"e6Y0+w6M4Wt4xYtLBrJQJZQn7HzFpLIJaXhaIhxiz7F1eLhyl/zfjUKa2Jp1zYvL/I1eia3IrOo7fiO2",
+	"zZbJZrsEjVsarhCrmAbbaDkGkBvxAClu+c1w0te6kQXtfzttR5ZDahOmrviOELblN398PPfgGMaritUg",
+	"SyHXzN7IUTkO5z4M3kKrRpYTxByLe5pcrKaGQqwElCyOsgcSP80heIQ8Dp5W+ErAEfIAOEJOA0fCTYZm",
+	"8HTjF1bzNSQkc8J+8syNvlp1CTISOlvu6FOt4UqoxsROIzDS1PslcKksLGoNK5GhsQuPDsM4c208B956",
+	"GahQ0nIhoWRCOqCVBcesRmFKJtz/3hne4ktu4PNns/eHvk7c/ZXq7/reHZ+029Ro4Y5k5urEr/7A5iWr",
+	"Tv8J78N0biPWC/fzYCPF+jXeNitR0U30d9y/gIbGEBPoICLcTUasJbeNhudv5CP8iy3YheWy5LrEX7bu",
+	"p++ayooLscafKvfTS7UWxYVYjyAzwpp9cFG3rfsHx8uzY3uTfVe8VOqyqdMFFZ2H63LHzl+MbbIb81jC",
+	"PIuv3fTh8fomPEaO7WFv4kaOADmKu5pjw0vYaUBoebGif25WRE98pX/Ff+q6wt62XuVQi3Tsr2RSH3i1",
+	"wlldV6LgiMQf/Wf8ikwA3EOCty1O6UJ9/i4BsdaqBm2FG5TX9aJSBa8WxnJLI/27htXs+ezfTlv9y6nr",
+	"bk6TyV9irwvqhCKrE4MWvK6PGOMVij5mD7NABk2fiE04tkdCk5BuE5GUBLLgCq64tCezee5Mtgf4Fz9T",
+	"i28n7Th8955gowhnruESjJOAXcMHhiWoZ4RWRmglgXRdqWX84ZOzum4xSN/P6trhg6RHECSYwY0w1jyk",
+	"5fP2JKXznL84Yd+kY5MorlC9tAQvauDdsPK3lr/Fom7Jr6Ed8YFhtJ2orHk/j2gwBux9UBw9KzaqQqnn",
+	"IK1g4z/7timZ4e+TOv9zkFiK23HiwlbMY869ceiX5HHzSY9yhoTj1T0n7Kzf93Zkg6PsIRhz3mLxvomH",
+	"fhEWtuYgJSQQJdTkt4drzXczLyQuSNgbkslPBhyF1HwtJEE7x+eTZFt+6fZDEd6REMDEd5GjJRq0VaF6",
+	"mdOj/mSgZ/knoNbcxgZJ1DDOKmEsvaupMdtARYIzl4GgU1K5FWVM2PA9i4gwX2teO1r2X5zYJSS9510j",
+	"B+sdL96Jd2IW5vZzutEE1a3Z8kHWmYUEP/Rh+FOliss/c7O5hxO+DGMNaZ+mYRvgJWi24WaTOTg92m5H",
+	"m0Lf2JBoli2TqU7iEl+qtbmHJVbqGNZV11/yqsKphyyrt1oaeNJBriqGjRlshbXtw9Fp2N37i33Fiw2K",
+	"BazgVTVvVUWqXlRwBRVTmgkpUdtlN9y2h59GDu8aOkcGkNlZYMlqvJqJVGw66iI0sC2nG2iLr5m66vaJ",
+	"HNTwLfSkILoRVUNahOShcf4irA6uQBJPikMT+HGNpK1JBz9hZ/ETzSyVW5zTANpgvov4i/yiAzS2bu9T",
+	"2U6hdOl01hZ/E5oVSrsh3A3vJ8f/ANdtZ0edn9QaFn4Iza9AG17h6nqLehjJ975O54GTWXLLk5PpqTD/",
+	"AHOcg/qReAc6o6X5gf7DK4afUYpBSmqpR5AwohJzaukuZkSVmwkbkL5Vsa1TZTLULx4F5Zft5Hk2M+nk",
+	"feW0p34L/SLiDr2+EaW5r22iwcb2qntCnO4qsKOBLLKX6SRzTUHAa1Uzxz56IDhOQaM5hKibe7/W/qRu",
+	"cjD9Sd0MrjR1A/eyE+rG/WcSs/+TunnhIVP6MOZp7ClIxwVKvgVDt5tMGSfO0trlzpZK306a6F0wkrXW",
+	"RsZx1ESYmveQRE2beuHPZsZi4Rr0BmodPPYLAf3hcxjrYOHC8g+ABWN5AvwdsNAd6L6xoLa1qOAeSH+T",
+	"FeJQP/zpU3bx57PPnjz969PPPkeSrLVaa75ly50Fwz7xajlm7K6Ch9nXEUkX+dE/fxZsVN1xc+MY1egC",
+	"trweDuVsX+7165oxbDfEWhfNtOoI4CSOCHi1ObQzZ9ZF0F7AsllfgLX40n2l1ereueFghhx01OhVrVGw",
+	"MF07oZeWTktscgo3VvPTmlqCLInmaR3CcGNgu7wXohrb+LKdpWQeoyUcPBTHblM7zS7dKmEKJSUU9hWA",
+	"vodVlnFAGNEBtObGGkAblvaY8ObvTDBp9QfnRDzonW7uQ80DWiudFUVqrawqVLVAeVeojKLmlW/BfItA",
+	"tnX/dwctu+aG4dxkxW1kOaKPQfPs5HvcDf36RrY0svcmd+vNrM7PO2WHushvX2M16IW9kYxOaUdNtNJq",
+	"yzgrqSNt4DdgnRwqtnBh+bb+YbW6H62vooEytCy2YHAm5lowIZmBQknn1HiAjP2oU9DTR0ywttlxADxG",
+	"LnayIJPhfbCvca3eVkjyXzA7WSQqPoSxgnINegI+pqvyxtDhpnpgMuAgOl7SZ7JZvIDK8q+Vft2K8d9o",
+	"1dT3fk3155y6HO4X460iJfYN6nAh11XXkXaNsJ/k1vibLOjLqExxayDoiSJfivXGJu/mV1p9ANkgO0sO",
+	"UPrglGYV9hmqzr5XJTIT25h7EKnbwVoOh3Sb8jW+VI1lnElVAm1+Y/LC9ojrJfl8kauaTeV30tMIw5aA",
+	"1FXwBleLJm6Vuy/ajgteuBO6INSYQxe6a+Wmc259lQZeolIMJFNL7+vhvVBokZy8yGwQV72on+EXHbhq",
+	"rQowBs1pTvN9ELTQzl0ddg+eCHACOM7CjGIrru8M7OXVQTgvYbcgn0fDPvn2Z/PwN4DXKsurA4ilNjn0",
+	"9vWKQ6inTb+P4PqTp2TnNJaOaplV9DqpwMIYCo/Cyej+9SEa7OLd0XIFmlxrPijFh0nuRkAR1A9M73eF",
+	"tqlHPPm9ugIlPNwwyaUKglVusIobuzjElrFRuhaDK0g4YY4T08AjgtdLbqxzBxOyJN2uu05oHupDU4wD",
+	"PPoMwZF/Di+Q4diFkgakaUx8jpimrpW2UObWQJbp0bm+h5s4l1olY8c3j1WsMXBo5DEsJeN7ZLmVOARx",
+	"G+3Q3rI9XBz5FuA9v8uisgNEi4h9gFyEVgl2U2/mEUCEaRHtCEeYHuVEF+r5zFhV18gt7KKRsd8Ymi5c",
+	"6zP7U9t2SFzO2ENzslKBIUOSb+8hv3aYdX7sG26YhyO4GpBay/mtDWHGw7gwQhaw2Ef59MTDVukROHhI",
+	"m3qteQmLEiq+yzhJuM/Mfd43AO14+9xVFhbOITm/6S0lB//PPUMrGi/DNL9XjL6wAo8gPgVaAvG9D4xc",
+	"Ao2dY06ejh7EoWiu7BaF8WjZbqszI9JteKVQOxfogUD2HH0KwCN4iEPfHhXUedG+PftT/BcYP0Foc4tJ",
+	"dmDGltCOf9QCRnTiPtYrOS899t7jwFm2OcrGDvCRsSM7oqB/xbUVhajprfNn4JW9ndvFJEVWZ7JvYefm",
+	"y+izsgrDDbUOkkSdjoUiCz1n+zPc+0u2P0HWH4KVYLlA3XHywb1qu2A7z+D+mObj7cAU3J9F76sRnAPo",
+	"+zBukhp4+koADts03ZBTNdJR/ew00iduceQ9kail7kPvkBmVCRcmh+sIXvr4XEqbwA0vbLVjnASmHbsG",
+	"Dcw0S+d2M7QBWlUv0gGyNsU9M3qPgqw9f6+LwwUNlSwv5x/p3m/74Xvde8R10OHfbbVS1QRt5gAZWQgm",
+	"+TuxWuGuCx+yF4K2wjHpAOkv2GoXwPXXeopmWgH7L9Wwgkt6HjcWovypNAl12JdmECaZ0zvUthiCCrbg",
+	"Xv305dGj/sIfPfJ7LgxbwXWIc330aIiOR4/cIdgoCUul7sPnB6TV4ggnhjj3V9Lq3WHbhx9+6pmvw/DM",
+	"93QLVsZ2WOV9sDeu7XlGtiHrMkpl2YvNRbXsNzv6kacs+VVv8DApMRFj/EnF5d+Z4/VY0c2UtaeHYppL",
+	"pr2ZuPLXXSe+wbpp3y/Etqm4vQ/TMlzxaqGuQGtRwkEq9xMLJb+64tUPsRsFLUOBh7KARUGhthPHgtfY",
+	"x0Xn4jhCCitCZM5UgODc9bpwnQ7oP1p3crHdQim4hWrHag0FlM4kJAwzcaknjIZlxYbLNb1mtWrW3gPd",
+	"jUM3HAaBU9htIwdDZCV+eyMXZIHJ3XjelzTEJaOsDxz1DX3zjXtdX/M4nw9Fn8K1kj3om7OyFtz5bFQd",
+	"g0i9atUxDjnd4OoJt1/nMZLgp514op2PUIeS7BBf6bbgYcLN/TD2pHboHJTDiRO3/PbjmGc+6oKq3T1I",
+	"eW4gpqHWYOhOTnWoxn1VqzSRQvDn3RkL26GZyXX968jx+3FUmaFkJSQstkrCLps7SEj4jj7meju5YKQz",
+	"SWhjffsP5A78PbC680yhxrvil3a7f0L75lTztdL3Za93A04WfSaYxw/KQ37K2xrx0V98aPf2YdZ9BmDm",
+	"0aNeaMaNUYUgIfW8NHN30Lyp3Mdkd9H/KgaP3cPZ64/bM/CmGTzIgAFVzTgrKkHmDSWN1U1h30hOCtRk",
+	"qRlPy6ApGlepfxma5HX4GRW7H+qN5ORlG9WqWW+iFWR0iF8DBM26adZrMLb3uFsBvJG+lZCskcLSXFs8",
+	"Lgt3XmrQ5O544lpiMMUKacIq9itoxZaN7T53KIuAsaigd9ZmnIap1RvJLauAG8u+E+jLhMMFj5RwZCXY",
+	"a6UvIxbyt/saJBhhFnmP0G/cVwq+8cvf+EAc/L/vHDzD27QmM1xmJ5PR//nkP59jBiO++PXx4ov/dvr2",
+	"3bP3Dx8Nfnz6/o9//L/dnz59/8eH//nvuZ0KsItyFPLzF14VcP6C3ntJPE0f9o9mnNoKucgSWepq1KMt",
+	"9gnlc/EE9LCrubUbeCPRj8wqTCckSm5vRw79G2ZwFt3p6FFNZyN6mtqw1iMfFXfgMizDZHqs8dZS1NCJ",
+	"Op9NAjcyJIjAVmzVSLeVQfp2wdLB+VGt5jFjiEsm+JxROokND57Y/s+nn30+m7dpIOL32Xzmv77NULIo",
+	"b3LJPkq4yb0V00imB4bVfGfA5rkHwZ7183SOR+mwW0CtitmI+uNzCmPFMs/hQlyhV7LdyHPponDw/JD9",
+	"fefNemr18eG2GqCE2m5yScY6ghq1ancToOcThSHPIOdMnMBJX8lV4nvRe5xWwFfBe1wrNeU1FM+BI7RA",
+	"FQnW04VMUqzk6KcXg+Qvf3PvzyE/cA6u/pw5t/sH33z1mp16hmkeELb80EmmkMxT2n3oestZxjuBn2/k",
+	"G/kCVqR9UPL5G1lyy0+X3IjCnDYG9J94xWUBJ2vFnoeg6Rfc8jdyIGmNZj9NMhuwullWokDrRI48XUa7",
+	"4Qhv3vyCauw3b94OHIeGzwc/VZa/uAkWKAirxi58Pq6Fhmuuc4ZZE/Mx0cjUe++sTshWjdMI+/GZHz/P",
+	"83hdm35eluHy67rC5SdkaHzWEdwyZqyKQaPCxLh73N/vlb8YNL8OepXGgGF/2/L6FyHtW7Z40zx+/Cmw",
+	"TqKSv/krH2lyV8Nk7cpo3pi+UoUW7p6VFFCyqPk6Z/998+YXC7ym3Sd5eYtbgIIudUtxEqOAaKh2AQEf",
+	"4xvg4Dg6gp8Wd+F6hdyr+SXQJ9rCbpaEO+1XkuTi1tt1IFEGb+xmgWc7uyqDJB52JqZkXHMhTXAVQssV",
+	"HgKfvXKJKkUoLn1aQdjWdjfvdFerjqAZWIcwLuGkCwOmlGdkkcFElHXJvSjO5a6fe8q4sCca9Ee4hN1r",
+	"1WZMOybZVDf3kRk7qESpiXSJxJoeWz9Gf/O9y2OIBvcphCjCOpDF80gXoc/4QXYi7z0c4hxRdHLzjCGC",
+	"6wwiqMMYCm6xUBzvTqSfW56QBUgrrmABlViLZS5X9l+GBsAAK1KlTw/qXeTjgAZtgsIatnQXq3/ea9Sx",
+	"M06+T7UyvHKpj7MeRfQe2gDXdgnc7tXzyzRrTIAO+7NrPFlOwzfHJcAN7rewpLGTcA2lVxS5Nt61/mTc",
+	"OdIBDuUt4Qnd25fCyehb16MukxY03MoRu/FZ6/1GUzp7vYnft0B5hdU17gtCoXxKXJd5KblfGsPXMPJ2",
+	"Sa13E5PWdCx+NMghiSQrg1CYXkfUGEgCWZBd4wWuOXuGAb/gIaZnZs9bOMzkLOLeZkSZ7j3ClhUJsNGt",
+	"2u091x0rqlzvAy3PWkDLVhQMYHQxkh7HDTfhOJbzhMtOks4+YG6mffkjzxNH1yRzccwOGW7DPgcdvPt9",
+	"FsmQOjLki0wf/RNyP85njgFkt0NJEk1LqGDtFu4aB0Jps5q1G4Rw/LBaEW9Z5HxmEwV1IgD4OQBfLo8Y",
+	"c7YRNnmEHBknYJOnBw3Mvlfp2ZTrY4CUPisbD2PTFZH8DfmoUxdFgsKoqvFyFSP2xiJwAJ8vppUseu7+",
+	"NAwTcs6QzV3xCqQNb/F2kEEaQ3pQ9JIWel+jh2MPjT2mKXflH7Um6nGr1aTSbAA6L2rvgXipbhYujUD2",
+	"LbK8WSK9ZwNrsFf2YLqEkQ8MW6obcs6jq8UFchyAZRyOAEYLAGUCxLVTvzE5ywGzb9r9cm6OCg37JEqd",
+	"LbmMCXpTph6RLcfI5ZMkB+StAOipodqCKl4tcVB90BVPhpd5e6vN29zGIWYxd/zHjlB2l0bwN9SPdbM2",
+	"/rnNzjmeAdA3+jjpKoeapbukEXWdCRBzVBbRPjl0gNiD1Vd9OTCL1k6rHl4TrOVYCRMyY5Qcos1ABfQI",
+	"XnRE08Ul7PJveaB7/CJ0S5R1tHtc7h4mHpMa1sJYaI1GwS/ot1DHc8pxrtRqfHW21itc349KxcufOjpl",
+	"fGeZH30FFB6yEhrjENDill0CNvrakBLpa2yal0A7m81cRRBR5jkuTYsRhaWomjy9+nm/fYHTfh8vGtMs",
+	"6RYT0jloLamCTdYNfc/ULvBi74JfugW/5Pe23mmnAZvixBrJpTvHP8m56DGwfewgQ4A54hju2ihK9zDI",
+	"JBvCkDsm0mji03Kyz9owOExlGPugl1rIyTB287uRsmtJcnXmw1fVeo1hfC4FV7CHySTTY6XkOim1Vtf7",
+	"ElueYH5/49ND7sks6eMOYCzqIBH3FwIttnnok2YO8jbsk7Ji0iRopqdcOnm1kFofiGmgFomu7iPbQvsR",
+	"D1kn6Nc9Y3brnex2KW4nbUAFvPRvEgNhffuP5XBDPOrmY+7TnfTE+48QDUg0JWxSfWiYI2OEAfO6FuVN",
+	"z/DkRh1VgvGjtMsj0haxFj/YAQx0naCzBNfJd+9drb2C/ZTevKf4KnO+196xGOmbFz47RNlosmB0PJuH",
+	"xRXiW23i2r/9+cIqzdfgrVALB9KdhqDlHIOGpHSBYVY4d5JSrFaQWl/MbSwHHeAGOvZyAulmiCxvommE",
+	"tJ8/y5HRAeppYTyMsjzFZGhhzCb/emjl8m1TVVK8EpKtuYWpKptL4lvYLX5GpQOrudCmdc/1Zqfu5XvE",
+	"rl9tv4UdjXzQ6xUBO7ArpHn6EYgGc5r++MkkWeYfmBRj7nnZ2cIjduosv0v3tDW+cso48be3TLqi3lLu",
+	"cjBaJwmEZcpuXOR9E/D0QBfxfVI+tAmiPCyDJPJ+OpUwoc7s8CqKiVIO0S5mewzES8uZvZ/P7uYJkLvN",
+	"/IgHcP0qXqBZPJOnqbMMdxx7jkQ5r9F/i1cL7y8xdvlrdeUvf2oe3Cs+8ksmT9mvvzp7+cqDjybpCrhe",
+	"RE3A6KqoXf1PsypXa2X/VeJS8ntFp9MUJZsf06anPhbXlH6/p2waVC5q/Wfa8YLPxSrv8H6Q93lXH7fE",
+	"PS4/UEePn9bmSZ17Tj78iosqGBsDtCPO6bS4aeWvslwhHeDOzkKJz9fiXtnN4HTnT0dLXQd4Es31A+VN",
+	"zb84pM+qSqzIO//we5eevla6w/x9ZGLWeejDiVUoZDs8jvhqhyKzfWHqhDnB62/rv+FpfPQoPWqPHs3Z",
+	"3yr/IQGQfl/63+l98ejREGh32+WZBGmpJN/CwxhlMboRH/cBLuF62gV9drWNkqUaJ8NIoc4LKKD72mPv",
+	"WguPz9L/guZY/OlkyiM93XSH7hSYKSfoYiwSMTqZbl1dW8OU7PtUUxAskhYxe183xRljh0dINlsyYC5M",
+	"JYq8a4dcGmSv0jlTYmNGjUe0tThiI0Z8c2UjkrGw2ZSEvj0gkzmyyDTZnMIt7pbKH+9Gin80wEQJ0uIn",
+	"Tfda76oLjwMadSCQ5vVifmDqkwx/Fz3IHntT0AXtU4Lstd+9iDalsNBcZa4jPcDTGQeMe4/3tqcPT80u",
+	"mm3TdcGc9o4JBr2s+sBbEAOj88a6kTnaIqDUz2X7EWax0upXyBtCyH6UyfzhJ6LnCPXOee71WUo0Kof1",
+	"pLMf2u7pb+Oxjb/zWzgsOpYGvM1lmj/Vx23kbR69Jp9LfD5Lj2QeLveRdUMDRlgLHa/EGZZqFQXvIy7d",
+	"eXJZIDoRZvlTmbQwp2789lR6mPu7WlT8esmLy/xbCGFKtrfjJ2UVC53DBpiY48DNzhIP7thWuDSHNejW",
+	"BjFMmXzLd42bdvKLpn3AYMfO02Xu3BQqozLDNPKaSwvBjcHxK9/bgDPBY69rpSlJqcm7dJVQiG1WHfvm",
+	"zS9lMXTfKcVauCr2jYGkTLofiLlMqERFvtR8zNzhUXO+Yo/n7ZkMu1GKK2HQkZlaPHEtltzQdRnN4bEL",
+	"Lg+k3Rhq/nRC800jSw2l3RiHWKNYfHuSkBcdE5dgrwEke0ztnnzBPiGXTCOu4CFi0QtBs+dPviCHGvfH",
+	"49wtW8KKN5Xdx7JL4tnBWTtPx+ST6sZAJulHzXtfrzTArzB+O+w5Ta7rlLNELf2Fcvgsbbnka8jHZ2wP",
+	"wOT60m6SOb+HF0mNSjBWqx0TNj8/WI78aSTmG9mfA4MVarsVdusd94zaIj21NdDdpGG4EzobjqdHuMJH",
+	"8n+tg/tfT9f1kZ8xfJunB05eyt+TjTZF65xxl5m2Eq1neiiqy85D4muqcheL2znc4Fy4dJIlcQupoJKQ",
+	"lvQfjV0t/oDPYs0LSznyRsBdLD9/lqkW1y2oJI8D/KPjXYMBfZVHvR4h+yCz+L4YBS8XW4Gs/mGbYyE5",
+	"laOOutlp7Zhf6P6hp0q+OMpilNyaDrnxhFPfifDkngHvSIpxPUfR49Er++iU2eg8efAGd+inH196KWOr",
+	"dK6aRXvcvcShwWoBV1CObhKOece90NWkXbgL9L+t/1MQOROxLJzl7EMgsWjuC5ZHKf7n79q0/GRYdZGI",
+	"PR2g0hltp9fbfWRvw+O0bn37rXMYo28jmJuMNhpliJUR73v6ue3zW/gL9UFye95ROD75G9P4Bic5/tEj",
+	"Ahr1jq7p3552Pzv2/uhRPjt2VuWGv7ZYuMuLmPrm9hCrpz5/N1JaNDoU+fwIw/0bvaTwAzLBpR9qzrpl",
+	"HD++FHE/8V15b9P8KUDnUvwS8EB/9BHxGzNL2sA2SmH8sHfL2GZJpozfEz93zv6kbqYSTu8OCsTzO0DR",
+	"CEomqudoJYMyvVlz/UF/kYRGcdQloHup6VSsSvX5/zx4xsXP92C7EVX5c5vbrXeRaC6LTdZLeIkd/+pk",
+	"9M4V7FhlDmtocZRQZYdzb9u/hjdw5pX+dzV1nq2QE9v2y0S75fYW1wLeBTMAFSZE9Apb4QQpVrtps2Ja",
+	"hmqtSkbztBVXWuY4rLeeq3M7JEE37Lax3m+VYsF9wqGVqPB/I3ZjarnQ3I4k0NIUx7hqR4QrQEsVPdjc",
+	"6KAZF1u6mA3HMlh0Mq8A/QOxq5LQ604p1GjkpJwKMzV+opaUsEIx22iJVSeTZYC0QkO1m7OaG+MGeYzL",
+	"ghuae/b8yePHWbUXYWfCSh0WwzJ/aJfy5JSauC++ApirU3EUsIdhfd9S1DEbOyQcX/D0Hw0Ym+Op9MFF",
+	"rmJnurVdsdNYoPiEfUOZj5CIO7n9EZqYRLibULOpK8XLOSU3Rs8c5mZ1fTQQoqjY6hrh75F/1rwyPcFo",
+	"yOw0kjln+jj7U3ngqo1dxNqoudyE2KKt3ip6Pjekx0uxc8JeOBWqCQo6NwmjFNl6C2VSitU94ok48D/W",
+	"8mKDDVRHAhrnldOrBAd21lpukujDq/CRGDbC7QsFuzrBc6ZQgXwtMF3xhlu4gm46xABG0I2H9Ijd5elG",
+	"SkcpJ0cIo7EQ17FoD8DRuNGpIAtZD/FHaqZc0fRjiyZfUK98LEavAnPP6h+S64UU2+w7b1wouFRSFFT7",
+	"ISdJU+q2aWbKCWUy8vZFM/MnNHO4snWfYyywx+JoJej5rIO4ock/+Yqb6qjD/WnhxtcDXIM1nrNBOQ/l",
+	"6L1BTEgDvtQaElHKJ5XOODVlAyGiA8WRZERZmUY0nF/jt++9/huPILsUkjRdHm3+feZMVpjHAqldMmHZ",
+	"WoHx6+lG85hfsM8JZWks4ebtyUu1FsWFWNMYzo0Ol+18RodDnQUPUu+xiW2/xLY+d378ueMO5iY9q2s/",
+	"aTaiNe5wrjr5KIJzfkvBkSRBbhw/HW0Pue11/ab7FAkNiyowY6Gme3hAGLHQe3cULKnQOIqiFsxFVOaQ",
+	"UgmZAeOlkMGEmr8giuyVQBtD53Wknyk0t8Wmw4YOOYyOBEBQhHJxeR9D9TaYUEJrDHOMb2Nbo36EccQG",
+	"rcTP5Y6FQ4HUnQgTGP4YXXGHFedJqvJCVEnBRb0a9DnGgYx7EUImO+g6GL4Xu1M1jmNvorEchcumXIPF",
+	"/He51FZ/oq+MvoYgMawI0sSSYjE6sJujfEhtfqJCSdNs98wVGtxxulIYbgxsl1XGbfRF/Ahl3GGkNLSs",
+	"4L+5klPjO+Odpo+Oyg0e0uVxifmHUcY5qRdpeoH5l6Zjgu6Uu6Ojnfp2hN72v1dKD+G6v4to3B6XS/co",
+	"x9++wosjTdw78E93V0vMq0u+4Iq+h4RHMSNklyvht2FhNfJ6oM3LbFkP+NAwC/gVr0Yi4VNbibtfnf1g",
+	"LB6+GE3fwK1Pz2U528uCRlMeOV/hnvVlaEIc8w927sH3Z7Xwa92L0HHb3bcdS53zEWuZxaiF7nZGtHaD",
+	"j7WifXs1liIh1Omg72k9EO/F47y1ag1XQjV+w6IPdHgSul99Cp5O3Y+R9WcjC35rq8WojeW1L67slunf",
+	"5N/+7KywVE1u9zuwuAw2vV9UJiPtUouEYP0TeKA1G3nUdm7FKTVscuVSvGwYdGWOtXRoaVB+ZkBWL6aI",
+	"AwN8vJ/PzsujLsxcyZ2ZGyV37F6K9cZSxv4/Ay9BvzpQkaCtQkBHrFZGtPVkKxzMp4Dd0HAnU4MNkIBF",
+	"WlFhOFZwQr2CwlJN5Na5TgMcU18BJwtGn39VJhh/TseYDF+QYF8VgmHl4AN3/CBxUpL8yxUmPZmec/8s",
+	"ulC7CDAslBfTtfRipidHbq5WUFBW5L2Jqv6yAZkkQZoHvQzBskryVokYx0R5vY/XOrYAVfyW8FT8/sAZ",
+	"i2O/hN0DwzrUkC0cGoP4bpM4mDDgTGAhh/SYItl7jQkTKYOwEFyCXXdoi2OM5nxO0q7dcq5Akoynqdj2",
+	"TJmvyD9pLux6VNpHCskZy2U1Ui09d8SVpuTHzgM9jaDJ1BxHyLs5zeTaKWbDAMI6dT3TwlySPZO4nmko",
+	"FX825dPSwGg2kjZtrWvmJxNVhSchjhrZEGqRPYVol8gfZxcj3vJuzAUFMuQBSA5giho8iWsVi2m4GXEm",
+	"pVnMfY5/L2FFfojJEmgF/ii3aMGUvox8Vlf9ZO1Ske3A9b7FweeFbXgVz91IQrAkSyyeq26O6pj/XAXD",
+	"EiEGraoOO+Y2cH3U2+GmpvLsd0KDMCyMg0eWkv8eRs3c17QR1jCz4ToK/G3y+ku4Bf4ioS0k3vPl/iO0",
+	"4mhF6Tyyig2vKpBrcKeKImDioIF0LQlfZMnABaw1L4DVoIUq84eqBcsnmM29Edtp6EiE6m0B2ESqQKS7",
+	"moD9xInJnHe/26bUVOhxpNCQoI/9fYARMkOHQF9OhFq5MwwgzHZP9YSPfE8eKNYwwgJjLzLNUQp7pZ3r",
+	"NxKWkLeg6AmlGkagCZ3Ccu8XpA9wrc9nrcw3SrtBDFQS+nyo7R3YCF5GeaJyt4wRSi48MR6+bbfKa2z8",
+	"6P6bCTX86IY1jrinkHYu5VAryyS4yB7F7BrC/T3LsMIcG8peApnrMStPQU5COGM1gO6wqkJJGS6IIx5E",
+	"3bhScOMK4wPgUL5xNWfqjZKwVOoyzSjCJVONXSsUOK5hadCvy9IQTtemYasssI0yIbBXyEJtsbnyBVbi",
+	"nC6qmbNXT1/RD1lmSarWRShacfAixdbhzosJR0Nv994N89/ihDpgxmXIQ4CYJFH/LUGIm74YY6JWbCGU",
+	"65U+fzXyCjAYBCPMxtX+YNFFDw8BdYBaFZs8B1kBFdsYIafwlXEpVSOLVkoOq5xu1PHIQlsXH1G3aqg4",
+	"HtroxVeDDiYkRv36pOoIVMjUN5H49ha4aZKyOAnOatCEf8TOVknhQsOIZSkXg8oaaUUVx8hjbmvWC7Va",
+	"4C8ajO2YuvZRUFgPCkO+7wegaPLlmgwTtf4QUPh9Gq8t1fI9KazwYfDpduWvI4RnMSaxVWJZP62P4EW1",
+	"FkoLu9sPZuCnnIX2/RFTAP1VMHkLQgdW8x36LX2A3UBHmKngdMvQ3jcko46M3lG5U4M8VsvL0UQvv/zU",
+	"5fG1BlcTC7vd+wpHFS7xQPQYfouShCUnhNnbvD3cJ0d6feQM2EPnBhzczXlhhgSixJYybpx+AZaLyvjo",
+	"SR6rUqUuHOiN1q+qfO2rWlHO+ehYG+pbgQm/hQITbpZKXPrikiRbOzdmrEkSWtxLxnBqxkQe6FWcWbTZ",
+	"PYYRMMM7xSXKKSpSBC3Gsg31TmqIRn1gXNhwm92Z4FqB1lBGf9lKGVhYFWS/fXDsQ4UhPdOtkGBGa2M7",
+	"4Ebrov3YFn7bikIrTnXQuA+JTheI4ipH6HRSnm18zn3I/tJ9Dxkag5bhoPtRpNfFwfi7kNdFmAESU6pf",
+	"MW9KOZz58TaeSEJK0Ivgltyv1Sa76fqpKEvZeIEwPRjRW2tyYuU9rCTrxFMMV9kzICcZFC9hd+os5D6X",
+	"YtzBFGinYHagJ9Voept8r75ZJgf3+l7A+22LDNRKVYsRT9jzYYG5PsVfCtJ04U0R8h+gfPigezZwEvYJ",
+	"OWDGUIfrzS4UVKtrkFA+PGHsTLqMMyHqIS1xN5hcPrD75r+hWcvG1Xz0Hlcnb2Q+dQddnvqO3CwMs5+H",
+	"GZDlnadyg+yfyN7IsXisa6rcCGWK05OpLhvDOISeBJUQlYMiK5MExcZX6PySz3bfTfQWVSG3U7PcRu3B",
+	"pYRyQQ/MPc98+p5UJAlwC8PcCMe/9H1QjBnzJHJfO7O1EbbHPfVr0EYYC9IutKrGpHH6lJRMlcrGMtck",
+	"fr/4/uK4eTWEMq0jM8bvzBSqNaIkslCkyVI1TmvoJ/Hu9zSL1bt9Br9DO4gLTXV8x+/lQax2F/acNCs7",
+	"pFauiw1WljwGsaOvGQdGZr8TauvsSu7UXrgghC/pes7pRymrbZJ+mWJTOPPBC8xUKpee4TaZd3GoPFbT",
+	"yQggC3JKAtgIhR88iwAfmHmgyov/HOqYqBXT0MYF3bagi6+R4gQqM+ak1Z85ztKVUshWlcxIcceueFO4",
+	"S4mkSIuil8Jqrne3KbvSRVWOYEexfDDCNgbXtgtpA2yHOKwqdb0gEWMRSxfn7NHYznRF6GD6b/sxq8ik",
+	"H0N1ufHPqx3b8JIVSmso0h4jTgkE1VZpWGCRrqwG7qVYWXwtb4U1jCrjrpmqC1WCKwGep6CxuRopOT12",
+	"IAmUzKLA0Q6u1PdJ6HjilCgJu9CABT2QDlbMDJv/Gvu4ZKRton636IULTxlJQgHGJ+b3GHKNh/AS4bhM",
+	"1n330BH9u7ghugGdO/Koh2tgznwLGr1DQnTw8fLcCmMcKJGWgofLSty0/ABiLFoetSOP1XNSUF4JCqfs",
+	"5oWlHvg0LSAmy015wEWayZ7ZjVbNepPUDIxwBnOnbrwxNB3lJ9NQxCslBcMpnrGtMtbrh9xI7ZLbKOJP",
+	"CiWtVlXV9TN0D+u1d57+jt+cFYV9qdQl5nd9SNooqWxcaTkPKTP78d7tTLpXLaIrNi+IBszh6muuHc4S",
+	"uMBkBtljcQM/50MXewLm28Mc9LAb9dlwYf11dZlpXvmAYrtVW1Hkz9Q/VwD1aNhzjkXlUOF6uIPviJgO",
+	"e3pZxXg5YpFDNIPkWd+UM+YZgY8bInaD/6V3c3/c1jg4clEOmYuXohbFqKzXA4AgddksbaMpAqQjiUWu",
+	"otbOTkNRT31AJ94qFFx6N9hwhHsHysKdgBoEtEcAP3Eqw7krF+J8jjAhkv/+sK0ncivg3++n8g7zGIva",
+	"vWhJS1OTmHt8hCPkqxbuDXF9TZlMl1MDXU0ISph4wycAjIe+dmCYFAB7LBjOdy/rXHAeNcvzRD/mn67J",
+	"6MLfyzQLK7i7sNFNgIuq0eBzYTsRX3dDGmpuN+HqxOZD+w/aEsCQMPMraEWhS+U8camHylnseio8VS8q",
+	"uIJORLCjZdOQqIkOBb6viZ1ZCVBTgElfs517Gad3eU/n4Ne+SIIlp2A3q/90iHU7xQ4oN7Oq2Bu5cMfE",
+	"TD1KCNGVKBvewZ85VuToKu/xKGdQNXgjLMI7cuo0P7kRfgwDnIX+OVEmYOLtND50NAvKo24fAzoY+t6Y",
+	"sVMv85Hvafb5aBal2coYW+NIvOUbpubXctyMMCT59rk1cZ+Ekgliv7qBgqQa/96B0r949nsQErU7Vzwn",
+	"Na5lxka2Acmkap89ZEMIT5W2LE74wU1MjYT0r+lbeIK3Aep331lGgzHTq48x+pDQkU5vb1T7TU7i3oM4",
+	"Ol6ORgz4jG579F+Buv2zgxqopiqZxP1E2Z9c7/0t5rn4nC2bMBBqKyhJUOcd+gKC94KSqeHWrSgUliBd",
+	"s0O3u8GGqg6RpCDZOt0s/iOVZf9oeCVWO+IzDvzQjXz/sUyMc5dwQV4+sB8n3i9eBU/OqG1RYSq3bjF1",
+	"zGS4HY6SAI0XeajXrtiWX0K6DeR+7/gnecwy0yxJc4FXdm87h1jwiw9Zt7e8TF/6VPtn1+EOoRoc9v7v",
+	"bXqzdKpQsqOueOF2O1ad7/IZslIE4rIb2O7Pfzfka4EEEi/oQLQ6JEwtb6EyPZJ15ZLKjFXU7oCdPCO6",
+	"BbXvZxkTNb+9ssl7MgdOWsp978JkV7w+0ORwE+qmHADf1bvybT8K/rNlucaWMQX83wveYz37cXipycfA",
+	"ciepcgZWp61eqpuFhtVBD0dqjcC3AJuoYhWy0MCNszOe/+Afnm3VKUGWchfmHz0R4iglrIRsmaWQdWMz",
+	"7xgqPiV3CcJSpT+hdcSENiYloDB5xasfrkBrUY5tHJ4OtUprZCEkwdDh+2ZUGPFOHQ4gTPuGo5R7rRo9",
+	"bYYXeClWK9DOgG0slyXXZdpcSFaAtlygx8nO3N6iFI0Dh2xKPJFmuolgE+sSkbYDpNp5V4472nsigPwe",
+	"DT8TDDavN+Cpv2uscaodq0bsM0MY/ikMNlt+gzY+Sgw3ciB8uTGy8FEzCgYruHTy2bR1h3mM+BX2T0OV",
+	"Vj0jsopmnTLF/nP/A20lPSN/ksLuPflOR9nP1OdSKbiDGZCK6tGQz8URy/A81kV+srqbYDF6qfvA2EB7",
+	"kGziWChJVy8+sovkBuEzc6ZKcDPdPtTxtMjcMF4zsCCNgdmTsaX1MCFcG69KGjiJ9lUNDilznwDzSE2b",
+	"08+He2kEPEQ0GH/Wu9NGRzccZ7rsk/iH5CGqVb0opnhqu2LMpQMgQNqFcYQ+EiPAyLqje4yJ5clTauzW",
+	"KafxzG3E716d9EPWrrrY9+gfUxONcPSuCUKtiJfREXbKMaVTZcq8nzasqwaLTIJxpqFoNKmJr/ku6ybT",
+	"qTU/UgTw4s9nnz15+tenn33OsAEWugTTFpL0g0S2Eb15hezrfT6u/+5geTa/CSGhLH2O9seQJytuij9r",
+	"jtuatkpUZ/XHmrQzF0DmOFIO4zZly633isZps7X8vrYrt8h737EcCj78nqGbRr6Qb5SrMgaU3G4lJhR8",
+	"gbT+iT0LqLBtHIPZkHqQyrlduQThKrhitlQg7IjLVW4hY27wxM/wUwwGhZu68rzKWXr2rcu/05yGjoRG",
+	"8opBLZaqvWgvViwHEWUD0EmyRK/4JI144tkema3zcc9nu6B4kTzpoc8GvYTViu3n9q2hMDDqDKfHTcyI",
+	"F+FQ3oI0x+wT46lob8NJWtX+74Z/ZHLr3hvXiMv9ELwi+z7Yk0bybOD3EPPKTgJtmGc1Qx4EwEgCxU7q",
+	"uyT3V1JbTjsrAdkTggG5L3581xqWDwZzESShwwHw0oyIbbsYf+TB+Y2LtH0XkZIs5e0YJXSWfyjJYmC9",
+	"8SJJtsgrTawF49hSJg9HkkHTfBkTU468Sgb5K7VSluHLtKoyeS+dHofOVEo4FO3rnfc/Ltf4Wmhjzwgf",
+	"UP44HtCYJj9MkexQaW5XeuUlnzR3xT/A1PIV5dr8C+AeZe85P5Q3wg9uM1Lu8Mq5V6+iNRoku6YxaafZ",
+	"k8/Z0tdPrjUUwvSN+9dBOIlJgUCjdSxmrNufXPDQOn9W9g5kvAqeOOz7xLwVbfYewvaI/sZMZeTkZqk8",
+	"R30DssjgL8ejsObFtIK7d621e7tM3klNjiMzeacro5opk5dH66BLpzEwXOfk27qD28xF3a5tahr6ySV7",
+	"sSr6ckr2+Hx5XexO6evvpc7uUVV2P0DieocjP4afN0cxP0k8hP0Uo+ZwdA5aD7Cr9yrsZRaNesl8MmEf",
+	"yZRVYX8lC72r7di40Re25sbUG82RXL3xJEQvghsCSrZz1rRO6zYNp3t+Ju9LmoFrSEag+caSjrajjuim",
+	"21lHljOc7IT9xTlTUQ7ReYCLbbkFjeFflDGOJ6lRvE9K9EInhetgSz1e82lbckL9z2PJYVwlt5FKnL2j",
+	"ikU7Dxpc07qqmEEBJBhhqHLoX32l+I8rZgUIXGajIRd3sN4lObxDTGatncmTqZKKqROKpfpumQqXFKZe",
+	"NFrY3QXiP+hWxV+z1Re+iblafSb4aGb1YpFVlyCDK1Cb2bUxQfD6RvGKRBVn/ZXArFLVCfvK1fP0PPSP",
+	"D5b/AZ/+4Vn5+NMn/7H8w+PPHhfw7LMvHj/mXzzjT7749Ak8/cNnzx7Dk9XnXyyflk+fPV0+e/rs88++",
+	"KD599mT57PMv/uMBXlEIsgM0FPJ9Pvtfi7NqrRZnr84XrxHYFie8Fpgs/f17UqOsFC6fkFoQ54MtF9Xs",
+	"efjpfwTme1KobTt8+BW5rMbmG2tr8/z09Pr6+iTtcrqmXC4Lq5picxrmeT/vi7KvzmP4hnPRoh1tDQsn",
+	"s5YUzujbj19dvGZnr85PZklKp9njk8cnT3B8VYPktZg9n31KP9Hp2dC+n1I1rVPjC+WexjC+9/PBt7p2",
+	"ZXTxk6dR/9cmZKTGP7ZgtSjCJ+RUO/9/c83Xa9AnFNjjfrp6ehoE1dN3PqD5/b5vp6nT0Om7Tsag8kDP",
+	"4BRzqMnpO59358CAqQ7stE2QGzpMBHRfs9OlujmiKaSrG1+Ky4Z8+o7eaKO/n3pFW/4jvZXdSTsNadlH",
+	"WrocK/mPHRS+sze4kP3DYZtkvAItqU19+o7+Q4cmWZGr53Vqb+Qp+RacvhPl8PMAEd3f2+5pi6utKiEA",
+	"p1YrA/bA59N37t/3w3auuslp0A4PIcJsp1rgi4ZX7a++m5G8Nhtlhx8s8uJmWw+/NHVd7YY/76S3sleQ",
+	"S5P7kzTgJDPXgWGHNuIy8qjzMjS+2MkivNaCKy5xnqePH7vpn9F/iMn6B29C2aeeV8ycrHBQV9ipzkV8",
+	"vacmjvAGAetkRjA8+XgwnEvnfouM3l1I7+ezzz4mFs6lBS15xailm/7Tj7gJoK9EAew1bGuluRbVjv0k",
+	"owexuxIpyjdHgZdSXcsAOWVE3m653tHbZKuuwLCtkOQA0xIn02DwVnLRlEFudjRM1ylHHvXLrG6WlShm",
+	"c1eL7S1JgjYnFAXd5XCmoLdtB++eim8Ononpu9CVtffkN5sE54EcGm744RtyuL9h7/uWfzfVg9wGzf7F",
+	"CP7FCO6REdhGy9EjmtxflOodah9ZXfBiA/v4wfC2TK7qWa1yGpOLPczC10kf4xUXXV7RerjOnv8ynsYQ",
+	"T3ab78jJMqRnKMEIn0aZHkr4CmjfMTpypHDmydSf7LVfwOz54wyzePu7uN+/5FFz0tlxZ03nuhKgIxVw",
+	"OSxd/y8u8P8NF/iGKrVwt69zZgE9bpOzbxWdfWd4dDQhXGWXqXygo89rhenOz6dBJ5J733Zbdp7Rg69O",
+	"3Tr29V3nz+57rwbnRZr+eRpT7rUfzKaxpbpOVkIPCGeJHb5J8GNj+n+fXnNhUT3tS59QfrZhZwu8ImoR",
+	"FfR+bUvwDr5QXeHkxzRSOvvrKfdPmtw34qdjHQfv+dxX/2QdaRQc/MPnVmuYauGIl0f92y9vkZMa0FeB",
+	"zbdKpeenpxTxtVHGns7ez9/1FE7px7eReN8FBl9rcYXQ4LebhdJiLSRmHHNamUWrOHp68nj2/v8NALjS",
+	"20opJQEA",
 }
 
 // GetSwagger returns the content of the embedded swagger specification file
@@ -130,232 +130,254 @@ func RegisterHandlersWithBaseURL(router EchoRouter, si ServerInterface, baseURL
 // Base64 encoded, gzipped, json marshaled Swagger object
 var swaggerSpec = []string{
 
-	"H4sIAAAAAAAC/+y9f5PbtpIo+lVQ2q1y7CfO2I6TPfGrU/smdpIzL07i8jjZt2v7JRDZknCGAngAcEaK",
-	"r7/7LXQDJEiCEjUzsZOq+5c9In40Go1Go3++n+VqUykJ0prZ0/ezimu+AQsa/+J5rmppM1G4vwowuRaV",
-	"FUrOnoZvzFgt5Go2nwn3a8XtejafSb6Bto3rP59p+FctNBSzp1bXMJ+ZfA0b7ga2u8q1bkbaZiuV+SHO",
-	"aIjz57MPez7wotBgzBDKn2S5Y0LmZV0As5pLw3P3ybBrYdfMroVhvjMTkikJTC2ZXXcas6WAsjAnYZH/",
-	"qkHvolX6yceX9KEFMdOqhCGcz9RmISQEqKABqtkQZhUrYImN1twyN4ODNTS0ihngOl+zpdIHQCUgYnhB",
-	"1pvZ0zczA7IAjbuVg7jC/y41wO+QWa5XYGfv5qnFLS3ozIpNYmnnHvsaTF1aw7AtrnElrkAy1+uE/VAb",
-	"yxbAuGSvvn3GPv/886/cQjbcWig8kY2uqp09XhN1nz2dFdxC+DykNV6ulOayyJr2r759hvNf+AVObcWN",
-	"gfRhOXNf2PnzsQWEjgkSEtLCCvehQ/2uR+JQtD8vYKk0TNwTanynmxLP/0l3Jec2X1dKSJvYF4ZfGX1O",
-	"8rCo+z4e1gDQaV85TGk36JuH2Vfv3j+aP3r44d/enGX/4//84vMPE5f/rBn3AAaSDfNaa5D5Lltp4Hha",
-	"1lwO8fHK04NZq7os2Jpf4ebzDbJ635e5vsQ6r3hZOzoRuVZn5UoZxj0ZFbDkdWlZmJjVsnRsyo3mqZ0J",
-	"wyqtrkQBxdxx3+u1yNcs54aGwHbsWpSlo8HaQDFGa+nV7TlMH2KUOLhuhA9c0J8XGe26DmACtsgNsrxU",
-	"BjKrDlxP4cbhsmDxhdLeVea4y4q9XgPDyd0HumwRd9LRdFnumMV9LRg3jLNwNc2ZWLKdqtk1bk4pLrG/",
-	"X43D2oY5pOHmdO5Rd3jH0DdARgJ5C6VK4BKRF87dEGVyKVa1BsOu12DX/s7TYColDTC1+Cfk1m37/3vx",
-	"049MafYDGMNX8JLnlwxkrgooTtj5kkllI9LwtIQ4dD3H1uHhSl3y/zTK0cTGrCqeX6Zv9FJsRGJVP/Ct",
-	"2NQbJuvNArTb0nCFWMU02FrLMYBoxAOkuOHb4aSvdS1z3P922o4s56hNmKrkO0TYhm///nDuwTGMlyWr",
-	"QBZCrpjdylE5zs19GLxMq1oWE8Qc6/Y0ulhNBblYCihYM8oeSPw0h+AR8jh4WuErAicMMgpOM8sBcCRs",
-	"EzTjTrf7wiq+gohkTtjPnrnhV6suQTaEzhY7/FRpuBKqNk2nERhx6v0SuFQWskrDUiRo7MKjwzEYauM5",
-	"8MbLQLmSlgsJhWPOCLSyQMxqFKZowv3vneEtvuAGvnwydse3Xyfu/lL1d33vjk/abWyU0ZFMXJ3uqz+w",
-	"acmq03/C+zCe24hVRj8PNlKsXrvbZilKvIn+6fYvoKE2yAQ6iAh3kxEryW2t4elb+cD9xTJ2YbksuC7c",
-	"Lxv66Ye6tOJCrNxPJf30Qq1EfiFWI8hsYE0+uLDbhv5x46XZsd0m3xUvlLqsq3hBeefhutix8+djm0xj",
-	"HkuYZ81rN354vN6Gx8ixPey22cgRIEdxV3HX8BJ2Ghy0PF/iP9sl0hNf6t/dP1VVut62WqZQ6+jYX8mo",
-	"PvBqhbOqKkXOHRJf+c/uq2MCQA8J3rY4xQv16fsIxEqrCrQVNCivqqxUOS8zY7nFkf5dw3L2dPZvp63+",
-	"5ZS6m9No8heu1wV2ciIriUEZr6ojxnjpRB+zh1k4Bo2fkE0Q20OhSUjaREdKwrHgEq64tCftk6XDD5oD",
-	"/MbP1OKbpB3Cd+8JNopwRg0XYEgCpob3DItQzxCtDNGKAumqVIvmh8/OqqrFIH4/qyrCB0qPIFAwg60w",
-	"1tzH5fP2JMXznD8/Yd/FY6MormS5c5cDiRrublj6W8vfYo1uya+hHfGeYbidSp+4rQlocGL+XVAcPivW",
-	"qnRSz0FacY3/4dvGZOZ+n9T5r0FiMW7HiQsfWh5z9MbBX6LHzWc9yhkSjlf3nLCzft+bkY0bZQ/BmPMW",
-	"i3dNPPiLsLAxBykhgiiiJr89XGu+m3khMUNhb0gmPxsgCqn4SkiEdu6eT5Jt+CXth0K8O0IA07yLiJZI",
-	"gmxUqF7m9Kg/GehZ/gLUmtrYIIk6SbUUxuK7GhuzNZQoOHMZCDomlRtRxoQN37OIBuZrzSuiZf+FxC4h",
-	"8T1PjQjWW168E+/EJMwRu482GqG6MVs+yDqTkCDX6MHwdanyy39ws76DE74IYw1pH6dha+AFaLbmZp04",
-	"OD3abkebQt+uIdIsW0RTnTRLfKFW5g6WWKpjWFdVPeNl6aYesqzeanHgSQe5LJlrzGAjUGHuH46kYaf3",
-	"F/uG52snFrCcl+W8VRWpKivhCkr3aBdSgp4zu+a2Pfw4cnjX4Dky4JidBRatxquZUMWmG12EBrbheANt",
-	"3GumKrt9Gg5q+AZ6UhDeiKpGLUL00Dh/HlYHVyCRJzVDI/jNGlFbEw9+4ub2n3BmqWhxpAG0wXzX4K/h",
-	"Fx2gXev2PpXtFEoXpLO27jehWa40DUE3vJ/c/Qe4bjsTdX5Wacj8EJpfgTa8dKvrLep+Q753dToPnMyC",
-	"Wx6dTE+F6QcYcQ7sh+Id6ISW5if8Dy+Z++ykGEdJLfUIFEZUZE4t6GJ2qKKZXAPUtyq2IVUmq3h+eRSU",
-	"z9rJ02xm0sn7hrSnfgv9Ipoder0VhbmrbcLBxvaqe0JIdxXY0UAW2ct0ormmIOC1qhixjx4IxClwNEKI",
-	"2t75tfa12qZg+lptB1ea2sKd7IQbZzKz/1ptn3vIlD6MeRx7CtLdAiXfgMHbTcaM083S2uXOFkrfTJro",
-	"XTCStdZGxt2okTA17yEJm9ZV5s9mwmJBDXoDtQ4e+4WA/vApjHWwcGH5H4AF40a9Cyx0B7prLKhNJUq4",
-	"A9JfJ4W4BTfw+WN28Y+zLx49/vXxF186kqy0Wmm+YYudBcM+82o5ZuyuhPvJ1xFKF+nRv3wSbFTdcVPj",
-	"GFXrHDa8Gg5Fti96/VIz5toNsdZFM666AXASRwR3tRHaGZl1HWjPYVGvLsBa99J9qdXyzrnhYIYUdNjo",
-	"ZaWdYGG6dkIvLZ0WrskpbK3mpxW2BFmQn4FbhzDuDbhZ3AlRjW180c5SMI/RAg4eimO3qZ1mF2+V3un6",
-	"LtQboLXSySu40sqqXJWZk/OESigoXvoWzLcI21X1fydo2TU3zM2N1staFiN6CLuV0+8vGvr1Vra42XuD",
-	"0XoTq/PzTtmXLvLbV0gFOrNbyZA6O+qRpVYbxlmBHVHW+A4syV9iAxeWb6qflsu70XYqHCihxxEbMG4m",
-	"Ri2c9GMgV5Kc+Q6obPyoU9DTR0ywMtlxADxGLnYyR1PZXRzbcW3WRki025udzCPVloOxhGLVIcvbq7DG",
-	"0EFT3TMJcBw6XuBn1NU/h9Lyb5V+3Yqv32lVV3fOnvtzTl0O94vx1oDC9Q1qYCFXZdeBdOVgP0mt8ZMs",
-	"6FmjRKA1IPRIkS/Eam2j9+JLrf6AOzE5SwpQ/EDKotL1GaqMflSFYya2NncgSraDtRzO0W3M1/hC1ZZx",
-	"JlUBuPm1SQuZIy6H6OuELlo2lltRPyEMW4CjrpzXbrV1xdABaXBftB0zntMJzRA1ZsT9ovGboVY0Hbmz",
-	"lRp4sWMLAMnUwvs4eO8LXCRH7ykbxDQv4ib4RQeuSqscjIEi86rog6CFdnR12D14QsAR4GYWZhRbcn1r",
-	"YC+vDsJ5CbsMff0M++z7X8z9TwCvVZaXBxCLbVLo7evThlBPm34fwfUnj8mONHVEtU68dQyiBAtjKDwK",
-	"J6P714dosIu3R8sVaHQp+UMpPkxyOwJqQP2D6f220NbViAe7f6Y7Cc9tmORSBcEqNVjJjc0OsWXXqKNL",
-	"cCuIOGGKE+PAI4LXC24suUEJWaBOk64TnIeEMDfFOMCjzxA38i/hBTIcO3f3oDS1aZ4jpq4qpS0UqTWg",
-	"RXZ0rh9h28ylltHYzZvHKlYbODTyGJai8T2y/AsY/+C2sb96i+5wcWhTd/f8LonKDhAtIvYBchFaRdiN",
-	"vXhHABGmRTQRjjA9ymlch+czY1VVOW5hs1o2/cbQdEGtz+zPbdshcZGRg+7tQoFBA4pv7yG/JsyS//aa",
-	"G+bhCCZ2VOeQv9YQZncYMyNkDtk+yscnnmsVH4GDh7SuVpoXkBVQ8l3COYA+M/q8bwDc8fa5qyxk5Iib",
-	"3vSWkoPf456hFY5nUsIjwy8sd0fQPQVaAvG9D4xcAI6dYk6eju41Q+FcyS0K4+GyaasTI+JteKWs23FP",
-	"Dwiy5+hTAB7BQzP0zVGBnbP27dmf4r/B+AkaOeL4SXZgxpbQjn/UAkZ0wT7GKTovPfbe48BJtjnKxg7w",
-	"kbEjO6KYfsm1Fbmo8K3zPezu/OnXnyBpOGcFWC5KKFj0gZ6BVdyfkQtpf8ybPQUn6d6G4A+Ub4nlBDed",
-	"LvCXsMM390uKTYhUHXfxlk2M6u4nLhkCGjyenQgeN4Etz225c4KaXcOOXYMGZuoFuTAM7SlWVVk8QNI+",
-	"s2dGb51N2kb3mosvcKhoeSlfM3oT7Ifvde9h0EGHfwtUSpUTNGQDZCQhmOQ7wirldl348KcQABMoqQOk",
-	"Z9pomm+u/3umg2ZcAftvVbOcS3xy1RYamUZpFBRQgHQzOBGsmdM7J7YYghI2QC9J/PLgQX/hDx74PReG",
-	"LeE6xAy6hn10PHiAepyXytjO4boDfag7bueJ6wMNV+7i86+QPk857PHkR56yky97gzfWLnemjPGE65Z/",
-	"awbQO5nbKWuPaWSatxeOO8mW0/UPGqwb9/1CbOqS27uwWsEVLzN1BVqLAg5ycj+xUPKbK17+1HTDeEjI",
-	"HY3mkOUYxTdxLHjt+lDgnxtHSOEOMDn9TwUIzqnXBXU68MRsPVXFZgOF4BbKHas05EDxbk5yNM1STxh5",
-	"wudrLlf4YNCqXnnnVhoHGX5tSDWjazkYIilU2a3MUMmdugC8m1oIeXTiFHD3pOtryOkBc82b+XyU65Sb",
-	"OdqDvsUgaSSbz0ZfvA6pV+2Ll5DTjduccBl05L0IP+3EE00piDon+wzxFW+LO0xuc/8YlX07dArK4cSR",
-	"x2/7cczp1z23y90dCD00ENNQaTB4RcVqKkNf1TKO0Q6ugjtjYTPU5FPXX0eO36vR96KSpZCQbZSEXTIt",
-	"iZDwA35MHie8Jkc6o8Ay1rf/BunA3wOrO88UarwtfnG3+ye0b7Ey3yp9VyZRGnCyeD/BAnnQ3O6nvKmd",
-	"lJdlwrToIzj7DMDMG2ddoRk3RuUCZbbzwsy9VzBZI324Zxf9L5u4lDs4e/1xeza0ODkA6oihrBhneSlQ",
-	"g6yksbrO7VvJUUcVLTXhxBUe4+Nay2ehSVpNmtBi+qHeSo4OfI3mKumwsYSEmuZbgKC8NPVqBcb23jpL",
-	"gLfStxKS1VJYnGvjjktG56UCjZ5UJ9Ryw3ds6WjCKvY7aMUWte1K/xigbKwoS2/Qc9MwtXwruWUlcGPZ",
-	"D0K+3uJwwegfjqwEe630ZYOF9O2+AglGmCztbPYdfUW/fr/8tffxR3d3+hycTtuMCTO3zE6SlP//s/98",
-	"+uYs+x+e/f4w++r/On33/smH+w8GPz7+8Pe//6/uT59/+Pv9//z31E4F2FPhsx7y8+f+ZXz+HJ8/kat+",
-	"H/aPpv/fCJkliSz25ujRFvsMU0V4ArrfVY7ZNbyVdisdIV3xUhSOt9yEHPo3zOAs0unoUU1nI3rKsLDW",
-	"Ix8Vt+AyLMFkeqzxxlLU0D8zHaiORkkfe47nZVlL2sogfVMcZvAvU8t5k4yA8pQ9ZRipvubBydP/+fiL",
-	"L2fzNsK8+T6bz/zXdwlKFsU2lUeggG3qrRgHSdwzrOI7AzbNPRD2pCsd+XbEw25gswBt1qL6+JzCWLFI",
-	"c7gQsuR1Tlt5LsnB350fNHHuvOVELT8+3FYDFFDZdSp/UUdQw1btbgL03E4qra5Azpk4gZO+zqdw70Xv",
-	"1FcCXwbHVK3UlNdQcw6I0AJVRFiPFzJJsZKin154g7/8zZ0/h/zAKbj6c6Y8eu99981rduoZprlHKS1o",
-	"6CgJQeIp7YMnOw5JjpvFMWVv5Vv5HJaofVDy6VtZcMtPF9yI3JzWBvTXvOQyh5OVYk9DPOZzbvlbOZC0",
-	"RhMrRkHTrKoXpcjZZfwgacmTkmUNR3j79g0vV+rt23cD34zh88FPleQvNEHmBGFV28yn+sk0XHOdsn2Z",
-	"JtULjky5vPbNSkK2qklBGlIJ+fHTPI9XlemnfBguv6pKt/yIDI1PaOC2jBmrmng0J6D4kF63vz8qfzFo",
-	"fh30KrUBw37b8OqNkPYdy97WDx9+jpF9bQ6E3/yV72hyV8Fk7cpoSoq+UgUXTs9K9FXPKr5Kmdjevn1j",
-	"gVe4+ygvb1DHUZYMu3WiDkOAAQ7VLqAJcR7dAILj6OBgXNwF9QppHdNLwE+4hd0A7FvtVxQ/f+PtOhCD",
-	"z2u7ztzZTq7KOBIPO9Nke1s5ISt4YxixwteqT4y3AJavIb/0GctgU9ndvNM9OPx4QTOwDmEolx1FGGI2",
-	"JTRQLIDVVcG9KM7lrp/WxlBEBQ76Ci5h91q1yZiOyWPTTatixg4qUmokXTpijY+tH6O/+d6rLASa+uwk",
-	"GLwZyOJpQxehz/hBJpH3Dg5xiig6aT/GEMF1AhFE/CMouMFC3Xi3Iv3U8oTMQVpxBRmUYiUWqTS8/zW0",
-	"hwVYHVX6zIPeC7kZ0DCxZO4pv6CL1T/vNZcrcNezu1KV4SVlVU06beB7aA1c2wVwu1fPL+OEFAE6fFJe",
-	"Y+Q1avjmbgmwdfstLGrsJFy7VwUqiqiN914+Gfc/I8ChuCE8oXv7UjgZfet61CUyDoZbucFu86z1rnkx",
-	"nSFc9H0DmLJUXbt9cVAon22TkrpE90tt+ApG3i6x9W5iPoyOxQ8HOSSRJGUQteyLGgNJIAkyNc7cmpNn",
-	"GNwXd4jxmdlzyAwzkYHY24wwibZH2KJEAbbxXKW957pjRaWswGOgpVkLaNmKggGMLkbi47jmJhxHzJca",
-	"uOwk6ewPTPuyLzXdeeRLGCVFbRLPhduwz0EH736foC5kpQup6OJH/4S0cu7theELqe1QEkXTAkpY0cKp",
-	"cSCUNmFSu0EOjp+WS+QtWcotMVJQRwKAnwPcy+UBY2QbYZNHSJFxBDY6PuDA7EcVn025OgZI6RM+8TA2",
-	"XhHR35AO7CNHfSeMqspdrmLE3pgHDuBTUbSSRc+jGodhQs6ZY3NXvHRszr/F20EGGdLwQdHLh+Zdb+6P",
-	"PTT2mKboyj9qTSQk3GQ1sTQbgE6L2nsgXqhtRhHKybfIYrtw9J6MXcB46dTBpFx09wxbqC26c+HVQr7y",
-	"B2AZhyOAEeletsIgvWK/MTmLgNk37X45N0WFBknGK1obchkT9KZMPSJbjpHLZ1F6uRsB0FNDtbUavFri",
-	"oPqgK54ML/P2Vpu3aVNDWFjq+I8doeQujeBvqB/rJoT7R5v4bzy5WDhRHyUT3lCzdJsMhdS5oqyDxyQo",
-	"7JNDB4g9WH3ZlwOTaO36enXxGmEtxUoc8x0aJYdoM1ACPoKzjmiaXaY8BdxbHvAevwjdImUd7h6Xu/uR",
-	"A6GGlTAWWqNR8Av6FOp4jumTlVqOr85WeunW90qp5vInszl27Czzo68APfCXQhubocUtuQTX6FuDSqRv",
-	"XdO0BNp1UaRiA6JIc1yc9hJ2WSHKOk2vft7vn7tpf2wuGlMv8BYTkhy0FlgcI+m4vGdq8m3fu+AXtOAX",
-	"/M7WO+00uKZuYu3IpTvHX+Rc9BjYPnaQIMAUcQx3bRSlexhkFHA+5I6RNBr5tJzsszYMDlMRxj7opRbC",
-	"3sdufhopuZYoDWA6QlCtVlCE9GbBHiajJHKlkquoilNV7cuZd8IodR1mntuTtM674cOYE34k7mdCFrBN",
-	"Qx+/ChDyNrIOE+7hJCuQlK4krRZKoiZ28ccWka7uI9tC+wEASSfo1z1jduudTLvUbCduQAm88G8SA2F9",
-	"+4/lcEM86uZj7tOdzKf7jxAOiDQlbFTYZJiGYIQB86oSxbZneKJRR5Vg/Cjt8oi0hazFD3YAA10n6CTB",
-	"dVJpe1drr2A/xTfvqXuVke+1dyx29M1zH4Bf1BotGB3P5mHe9uatNnHt3/9yYZXmK/BWqIxAutUQuJxj",
-	"0BBlRTfMCnInKcRyCbH1xdzEctABbqBjLyaQboLI0iaaWkj75ZMUGR2gnhbGwyhLU0yCFsZs8q+HVq4g",
-	"00eqpOZKiLbmBqaqZLj+97DLfuFl7R4ZQpvWPdebnbqX7xG7frX5HnY48kGvVwfYgV1BzdMrQBpMafqb",
-	"TyZKYH3PdFL84/Oys4VH7NRZepfuaGt8UYZx4m9vmU7Rgu5SbnMwWicJB8uU3bhI+ya40wNdxPdJ+dAm",
-	"iOKwDBLJ+/FUwoQSlsOrqMlFcYh2XwMvA/HicmYf5rPbeQKkbjM/4gFcv2wu0CSe0dOULMMdx54jUc6r",
-	"SqsrXmbeX2Ls8tfqyl/+2Dy4V3zkl0yasl9/c/bipQf/w3yWl8B11mgCRleF7aq/zKqojMP+q4SyfXtF",
-	"J2mKos1vMjLHPhbXmNm7p2waFEVp/Weio+h9LpZph/eDvM+7+tAS97j8QNV4/LQ2T3L46Tr58CsuymBs",
-	"DNCOOKfj4qZV1klyhXiAWzsLRT5f2Z2ym8HpTp+OlroO8CSc6ydMTZl+cUifuBJZkXf+4XcuPX2rdIf5",
-	"+8jEpPPQHydWOSGb8Djiqx3qV/aFqRNGgtdvq9/caXzwID5qDx7M2W+l/xABiL8v/O/4vnjwIGk9TKqx",
-	"HJNALZXkG7jfRFmMbsTHfYBLuJ52QZ9dbRrJUo2TYUOh5AUU0H3tsXethcdn4X8poAT308mUR3q86YTu",
-	"GJgpJ+hiLBKxcTLdUMlMw5Ts+1RjEKwjLWT2viQDGWOHR0jWGzRgZqYUedq1Qy6MY6+SnCldY4aNR7S1",
-	"bsRajPjmylpEY7lmU3Km9oCM5kgi0yTTtra4Wyh/vGsp/lUDE4V71SwFaLzXelddeBzgqAOBNK0X8wOT",
-	"naod/jZ6kD32pqAL2qcE2Wu/e97YlMJCU0V/jvQAj2ccMO493tuePjw1UzTbuuuCOe0dM6V0emB03lg3",
-	"MkeyFLow2VKr3yFtCEH7USIRRjB8ClTz/g4y5bnXZymNUbmt6N7Ofmi7p7+Nxzb+1m/hsOim6thNLtP0",
-	"qT5uI2/y6DXpdM0eyWOPsNjDoBsaMMJa8HhFzrBYBiV4H3FJ54myQHQizNKnMo7lPKXx21PpYR7Ev5b8",
-	"esFTNWLcW8jBFG1vx0/KKhY6hw0wTY4Dmp1FHtxNW0GZ5CrQrQ1imJX2hu8amnbyi6Z9wCBFxU+XObkp",
-	"lEYlhqnlNZdURdz1I37lexsgE7zrda005oE0aZeuAnKxSapj3759U+RD951CrAQVyK4NRBWY/UCMkk0i",
-	"Ffkq1k3mDo+a8yV7OI/KwPvdKMSVMGJRArZ4RC0W3OB12ZjDmy5ueSDt2mDzxxOar2tZaCjs2hBijWLN",
-	"2xOFvMYxcQH2GkCyh9ju0VfsM3TJNOIK7jsseiFo9vTRV+hQQ388TN2yvsD5PpZdIM8OztppOkafVBrD",
-	"MUk/atr7eqkBfofx22HPaaKuU84StvQXyuGztOGSryAdn7E5ABP1xd1Ec34PL5KsAWCsVjsmbHp+sNzx",
-	"p5GYb8f+CAyWq81G2I133DNq4+ipLa9Mk4bhqNa/rxcV4Aof0f+1Cu5/PV3XR37G8M1IzBZ6Kf+INtoY",
-	"rXPGKflnKVrP9FCvk52H3MJYQKupm0W4cXO5paMsiY7qS1ZpIS3qP2q7zP7mnsWa5479nYyBmy2+fJIo",
-	"RNWt1SKPA/yj412DAX2VRr0eIfsgs/i+7DOpZLZxHKW43+ZYiE7lqKNu2iVzzC90/9BTJV83SjZKbnWH",
-	"3HjEqW9FeHLPgLckxWY9R9Hj0Sv76JRZ6zR58Nrt0M+vXngpY6N0qmBAe9y9xKHBagFXGDGX3iQ35i33",
-	"QpeTduE20H9a/6cgckZiWTjLyYdAZNHcFyzvpPhffmgzn6NhlSIRezpApRPaTq+3+8jehsdp3fr2W3IY",
-	"w28jmJuMNhxliJUR73tyr2/6fAp/oT5ItOcdheOj35h2b3CU4x88QKAfPJh7Mfi3x93PxN4fPEgnIE6q",
-	"3NyvLRZu8yLGvqk9/FolFGChamHjUOTzIyQUkGOXlPvgmODCDzVn3QpxH1+KuJv4rrS3afoUvH37Br8E",
-	"POAffUR8YmaJG9hGKYwf9m6FzCTJFM33yM+ds6/Vdirh9O6gQDx/AhSNoGSieg5XMqgAmjTXH/QXiWjU",
-	"jbqAUrlHZlwUKNbn/3Xw7BY/34PtWpTFL21ut95FornM10kv4YXr+CvJ6J0rmFhlss7ImksJZXI4etv+",
-	"Gt7AiVf6P9XUeTZCTmzbr0BLy+0trgW8C2YAKkzo0Cts6SaIsdpNm9WkZShXqmA4T1vUomWOw1LOqRKa",
-	"ifhmHHZTW++3irHgPuHQUpTohpm2G2PLTHM7kkAL652H+kJuHCw/bkjNQKODZlxs8GI2fFOVgCfzCjRf",
-	"YVclodcdU6jhyFHFCmYq9wlbYsIKxWytJVPLZbQMkFZoKHdzVnFjaJCHblmwxblnTx89fJhUeyF2JqyU",
-	"sBiW+VO7lEen2IS++CJLVArgKGAPw/qhpahjNnZIOL6m5L9qMDbFU/EDRa6ildTd2lRPsql9esK+w8xH",
-	"jog7qe5RXRmSCHcTatZVqXgxx+TGr785e8FoVupDJeSpnuUKtXVd8k+aV6YnGA2ZnUYy50wfZ38qD7dq",
-	"Y7Om/GQqN6Fr0RbIFD2fG9Tjxdg5Yc9JhdoU8KdJGKbI1hsoomqX9IhH4nD/sZbna9RNdiSgcV45vRBr",
-	"YGet5SaKPmyqHyHDdnD7WqxUinXOlF2DvhYGMCIfrqCbDrHJDep14yE9Ynd5upaSKOXkCGG0qXV0LNoD",
-	"cCTJBqeCJGQ9xB+pmaJ6zMfWpb3AXulYjF6R257VPyTXCym22Q/euJBzqaTIsRRCSpLG1G3TzJQTqkak",
-	"7Ytm5k9o4nAlS+s2scAei6PFdgMj9Igbmvyjr25TiTroTwtbX3JtBdZ4zgbFPFS69gYxIQ34alaOiGI+",
-	"qXTCqSkZCNE4UBxJRpiVaUTD+a379qPXf2NSjEshUdPl0ebfZ2SyKo1Ay7RkwrKVAuPX043mMW9cnxPM",
-	"0ljA9t3JC7US+YVY4RjkRueWTT6jw6HOggep99h0bZ+5tj53fvNzxx2MJj2rKj/peB30pCBpt3IUwSm/",
-	"peBIEiG3GT8ebQ+57XX9xvvUERpcodcaVHgPDwijqaXdHeUb97YkisIWjCIqkwl0hUyA8ULIYEJNXxB5",
-	"8krAjcHzOtLP5JpbejtM4mmvgZcjARAYoUw2+NsO1a8c4FCCawxzjG9jWwZ8hHE0DVqJn8sdC4fCUXck",
-	"TDzjZeM6nSjqjVKVF6IKDC7qlflOMQ7HuLMQMtlB18HwvaY7VuM49iYay1G4qIsV2IwXRSq11df4leHX",
-	"ECQGW8jrpghVEx3YzVE+pDY/Ua6kqTd75goNbjldVDc/QQ1x7f6ww5hpZ7HDf1MVmMZ3xjtNHx2VGzyk",
-	"i+MS8w+jjFNSr6PpzIhVNh0TeKfcHh3t1Dcj9Lb/nVJ6CNf9U0Tj9rhcvEcp/vaNuzjixL0D/3S6Wpq8",
-	"uugLrvB7SHjUZITsciW8ygZ1xtDrATcvsWU94EPDJOBXvByJhI9tJXS/kv1gLB4+H03fwK1Pz2U528uC",
-	"RlMeka9wz/oyNCGO+QeTe/DdWS38WvcidNx2933HUkc+Yi2zGLXQ3cyI1m7wsVa076/GUiSEOh34Pa4H",
-	"4r145j4NPFwJVQfvq+ADHZ6E9KtPwdOp+zGy/mRkwae2WozaWF77+rW0TP8m//4XssIykFbv/gQWl8Gm",
-	"94vKJKRdUk+1TVhT+nBSKcTOrTilhk2qXIqXDYOujFhLh5YG5WcGZPV8ijgwwMeH+ey8OOrCTJXcmdEo",
-	"qWP3QqzWFjP2/wN4AfrlgYoEbRUCPGKVMqKtQFq6wXwK2DUOdzI12MARsIgrKgzHCk6oV5BbLDvbOtdp",
-	"gGPqK7jJgtHn/1QmGH9ONzEZviDBvioEw1qzB+74QeKkKPkX1ek8mZ5z/6xxoaYIsGtu2nQtvZjpyZGb",
-	"yyXkmBV5b6Kq/1qDjJIgzYNeBmFZRnmrRBPHhHm9j9c6tgDtyyO1F56ovs6twRmLY7+E3T3DOtSQLBza",
-	"BPHdJHEwYoBMYCGH9Jgi2XuNCdNQBmIhuAT7VMxtcYzRnM9R2rUbzhVI0l0cbSq2PVOmi55Pmst1PSrt",
-	"I4bkjOWyGtZMHn9/PMcS1cY7yPEm8XD8Smfnw8I51z5xMaYVa2wnIYUxmPBbyCFIs5Ti0tcPQKyQpeqa",
-	"6yK0uJOkUHQ3iTTQy2Zm0QZwDJ0cEqUYMBYqL5UTI7KxgLJuzETjcHjPkGdom8AH4VqC1lA0JpFSGcis",
-	"CgEf++DYhwpyf70REsxo+SMCbjT19as2tzeWgeOY6pp7r9d4gUzDhjvodJSBe3zOfch+Rt9DEH4oA3ZQ",
-	"w9TQ6+F6tCF0R5gBEmOqXzJ/Wx4O7r+JsklICToLlqd+Om7ZzciGeTeLOqcLOj4YjUJucu6cPawkqafJ",
-	"h6vsvRGiIPlL2J3SIygU8g07GANNkhOBHiUc7W3ynarfTAru1Z2A92nzyFVKldmIseN8mEO8T/GXIr8E",
-	"zAHYuLiP1Ghnn6GOvbFmX693IWd2VYGE4v4JY2eSgoqCYbtbXrA3ubxn982/xVmLmtL6e6XayVuZjs7A",
-	"hPv6ltwsDLOfhxlwrO6WU9EgBzJUb+WYy801JufvVvE8mfoqH5qa+1XkW6IiKFIyyQVZrJ7hQU8pjjAF",
-	"QpSrAw2ZnHlLFzOlSvny3iRNgxsqjal4MgTIgpySLaCBwg+eRECyLnriFFLqO5/0Ti2ZhtaIfNPsf8MS",
-	"7qkXfX/mZpYuv1sqDZ1i7K43ZfpsAl8wjSb+ZyGs5np3kxx9gxLyA+3JKJYPumM1nljtQlpvrCEOy1Jd",
-	"Z8issqbORepp69qZ7mUciq61/dypXkDk18WNF9R2bM0LliutIY97pOM9CaqN0pCVCt28UhbopXVy9waD",
-	"vCQr1YqpKlcFUL2YNAWNzVVLyVFsgsirJokCoh2MFqY+ER1PnNLdqWRHylDUWh1ROz8HilxvszrRojOy",
-	"ZY54LIPxWZw8hqjxEN49tf/TvHkptkg3oFNHfsmsrmHOfIt+jWx/8LkGthHGECgNLV2LssTAcbGNLK+N",
-	"40IatSNi7zm6VV4J9L3pJhEgabhyd16TWSHmARdx2iNm11rVq3WUYLqBMzx5de0fxPEoP5sa3aMwgsxN",
-	"8YRtlLH+pUkjtUtuXc4+y5W0WpVlVylFIvrKa9p/4NuzPLcvlLpc8PzyPr5rpbLNSot5iK/uOwe2M+le",
-	"arHuBZxROfPDqXqpHbrKeaKdzCB7LO7owu4RmO8Oc9DDOvez4cL66+oy0/Qz5kwybtVG5Okz9dfythv1",
-	"kUuxqGTOMqqtSFkmsBke9viyapwrkEUO0QySJ4vDnTHPCLyRGdmN+y9K4P1x2RI8oxm5KIfMxUtRWT4q",
-	"6/UAQEgp9NnWmgoyxpJYw1XUilIloIm8D+jEWwU9kW4HmxvhzoGycCugBt6PDYCfkfJhTrnlyJNyobbh",
-	"+/02+dyNgP+wn8o7zGPMxeuiJS1NTl4hUc0IR0inuN7rD/Uaw94XU72imuK5E2/4CIBxP6kODJO8pY4F",
-	"Y8lFCUWWqr143uio5tFL24dm9UuiC+M5ec7rUPrQjV1r8IlTSMTXXftXxR0pqab5UJMsC9gCxXX8DlpR",
-	"TcN5ZH+Bkkoe9pQBqspKuIKO+5jP5lKjqCmuIPQ1TWdWAFRojezryFJ+UfFd3lOc+LVnkWfNFOwmNSmE",
-	"WNopdkBNklTqbGVGx8RMPUoOoitR1LyDP3OsyNFVA7qjnEDV4I2QhXfk1Gl+phFehQHOQv+UKBMw8W4a",
-	"HzqaBaVRt48BHfSTrM3YqZdpN8k4VVFjYMHZisYQSyTe8g1T8Ws5rpAcknz73Jq4T0LJCLHfbCFHqca/",
-	"d6DwL54RI4XPeoLULgEKehW4Lglt+xokkyoqMXnNTfNUaXMohh9oYmwkpH9N38Co3Hoz3n5nGQ7GTC+Z",
-	"2uhDQjd0enP1/Cc5iXsP4uh4KRox4MP/9ui/AnX7Zwc2wFLe0u2nk/2xSKO/xTwXn7NFHQYqS3VNNSPj",
-	"d+hzCHZQor5gAvJiuWiu5eC1OffpPfuqDhH5q2/4jimN/7hX579qXorlDvkMgR+6MbPmjoS84ZU8ArwX",
-	"qJt4v3g1D4AFbYsKU9G6xdQxo+F2bpQIaHeRh+I+im34JcTbgM4OxD9z6xinqReouXBXdm87h1jwiw8p",
-	"Wja8iF/6mCiyW0Y9pA52vf/vNhYunirkd6tKnocKob5EUZfPYBXgQFx2DZv9wZJDvhZIoKks3BKtDtH1",
-	"xQ1UpkeyrlQEwlj5lQ7Yg4qrg8ozt1rGRM1vr8bGnjDTSUu5612Y6nUzADqu03gI/Lhs5cfBfzKH69gy",
-	"poD/Z8H7SKHaGF6qSfsRsNzJwJGAlbTVC7XNNCzNIQcTUle757xuc3cEFauQuQZuyOPm/Cf/8GxTlArp",
-	"HsLkE9rYNJtRClgK2TJLIavaJt4xmKlU7iKExUp/ROuICW1MSnDC5BUvf7oCrUUxtnHudFBJx7hERDB0",
-	"+L4JFUZzpw4HEKZ9w2F8ZqtGj5u5C5yKUJG7prFcFlwXcXMhWQ7a3fvsmu/MzS1KjXHgkE2JR9JMN2tA",
-	"ZF1C0iZAyp03Ct/S3tMAyO/Q8DPBYIN+wQljDal2rBqxzwxh+EsYbDZ8m5VqhVGEIwfC56ZFCx89AZVE",
-	"NTjJZ9PWHeYx4nfYPw2m5feMyCqcdcoU+8/9T7iV+Iz8WQq79+STjrIf1kl+t3QwA1LlqnX+J2IZnsdU",
-	"JK5PvhJH4wZhM4SqBNqDaBNhxD7U1YuP7CK6Qfgw7lgJPr3cWdfTIhXvS5qBDDUGZo97P5jWlZ3n3j1r",
-	"qEobqBoIKXMfLX2kpo308+FeGgGPatP7s96dtnGZceMcUyNuf3x0Vqkqy6f4fFLljsKbCTykXRhH6CMy",
-	"Aoysu3GPMU0tm07eo05Rm2PL5I0W1Tlk7aryfY/+MTXRCEfvmiDUEnkZVW5H7RZG8jTKlHk/xqyrBmuY",
-	"BONMQ15rVBNf893hsmMjGaMv/nH2xaPHvz7+4kvmGrBCrMC0Wcd7Zbtav0Ah+3qfj+sJOFieTW9CyD5A",
-	"iAv2xxBU1WyKP2vEbU2bUnRQtOwY/XLiAkgcx0S5qBvtFY7Tuvb/ubYrtcg737EUCv74PdOqLNNVHxq5",
-	"KmFASe1WZEJxL5AKtBHGOkbYtYAK23pEmzWqBzH37xVlk1Eyh6A/9lQg7IjLVWohYw61yM8wtttbjRhs",
-	"q9LzKrL07FuXf6eRhg6FRvSKWQCrVOVFe7FkKYgwgkhHkbVe8Yka8chHtmG25C2bIkTveZ4mvbhg9n5u",
-	"3y3matOc3m1iQrwIh/IGpDlmnxjPW3ATTtKq9v80/CORiOHOuEaz3D+CVyTfBzcryj8JtGFQfoI8EICR",
-	"aNtOnGQUKBYlItZkJUB7QjAg98WPH1rD8sGwEIQkdDgAXhw+27ZrIhk8OJ84o+8PDVKipbwbo4TO8g9F",
-	"5AbW21wk0RZ5pYm1YIgtqaFYGIVbm2dNFPPIq2QQ7KyVssy9TMsyESRNehw8UzHhuCeBvuLlx+ca3wpt",
-	"7BniA4pX46FRcaRsjGRCpblZnr4XfNLcUVTs3U0tX2Jg9n+B26PkPeeH8kb4wW2Gyh2sWL8KtwLFerNr",
-	"HJOcrB59yRa+2EalIRemb9y/DsJJExgKWiy9Qyts7YFI1EPr/EXZW5DxMnjisB8j81Zjs/cQtkf0EzOV",
-	"kZObpPIU9Q3IIoG/FI+Ki/MeuC5uWZjhZmlfogRuR6Z9GZYdnro8Sm3iLp3awHCdk2/rDm4TF3W7tqk5",
-	"iybXd3j79o1dTEk1lK7F4LpjrqM7KcpwVEmGPyDLEeHIj+HnTVHML2N5bym360hu7t5+1KI86LDSybT+",
-	"YT5bgQQjDOYS/9XXjvm4d2mAgDIvDI8qwXqbdDGEmMRaO5NHU0U51CekT/fdEjmvMaoxr7WwO6wbHBRo",
-	"4tdkPqbvmtwePjdMY0vzd59Vl9DUbm8zgdQm3K7fKV7ifUQmPuluIVWesG8ow7c/KH+/t/gP+PxvT4qH",
-	"nz/6j8XfHn7xMIcnX3z18CH/6gl/9NXnj+Dx37548hAeLb/8avG4ePzk8eLJ4ydffvFV/vmTR4snX371",
-	"H/ccH3IgE6Ahtf/T2f+XnZUrlZ29PM9eO2BbnPBKfA9ub/CtvFRY19IhNceTCBsuytnT8NP/E07YSa42",
-	"7fDh15mvzzRbW1uZp6en19fXJ3GX0xWG/mdW1fn6NMyD1QY78srL88ZHn/xwcEdb7TFuqieFM/z26puL",
-	"1+zs5flJSzCzp7OHJw9PHvnS1pJXYvZ09jn+hKdnjft+ivk1T41PnX/axGp9mA++VRUl1nefPI36v9bA",
-	"S0yw4/7YgNUiD5808GLn/2+u+WoF+gSjN+inq8enQRo5fe8zJ3zY9+009gw5fd9JMFEc6Nl4PiRtki+U",
-	"ukSTeJCP7pmeH8dJXJn7vHDop5bofGHOW0YYyiujzXn29E1K9+J9KKt6UYqc0fWN9Os2JyKvJm1Iyz5Q",
-	"0TZrS/u3zNAxuIfZV+/ef/G3Dykhqw/ID94g2FpAvEsuRnlhgMJJgOtfNehdCxha62cxGENzYTp72tay",
-	"yhc+8LOdsJ+9pwN+JZ7SeIT6oLAm8VzoNAKYGyIFV4OFd1jjD13/kBweP3wYTr6XqyOyOvXUGqO7a3sY",
-	"+AUdk86gU/g6IRS5xWSIjyHF/mwo5ZLDppCcvOrR3XbDL8nqgg51TPu4WY9R76OLSG7iR/y2BOb+B5Y0",
-	"mhCUTTMNhZIPQ245cgKDK22sGCsFqf28e1OqdvWH+ezJkdSwV0HVyR+aAP8HXjqQoQhpYwiCRx8PgnNJ",
-	"Hp/u2qHr8cN89sXHxMG5dMyLlwxbRuV3ExQvL6W6lqGlk2XqzYbrHUoqdsoe+yxHaEsM7Yju6WLl7gy/",
-	"mRFbxkIkFWjhHoy8nL37cOh6OX0fyq7vv4w6Jbe9v3LUYeIlt6/Z6QJLrU1tCiZqPL4UVIGZ0/d4Qkd/",
-	"P/Wa+PRHVKaRlHYaknyNtKR0LumPHRS+t1u3kP3DuTbReDm3+bquTt/jf1DgilZE2aFP7VaeovPR6fsO",
-	"IvznASK6v7fd4xZXG1VAAE4tl1Srft/n0/f0bzRRhzBboaYroHwTNXq2hvxylr77eqnzo16M5FG+KKEg",
-	"5vRkQgepbNzpRgf6FYofhv30PRNLBv0phAkzHHFuKbHoKVZ03bW4DD/vZJ78cbjNnaSKIz+fhudQSrTt",
-	"tnzf+bN75My6toW6jmZBRSJpwYeQuY+16f99es2FzZZK+1x+WAJ+2NkCL0994Y7er22u7MEXTAAe/RhH",
-	"qSV/PeUe1bNKmQTZvuLXkfXvDBuThADGfq3wRTF2O22zhZBIQfEN1eoP6ONQNh7cS06uQUe5YIIZ5uHB",
-	"ZCBa8SLnBkuP+xo4A2n9Q/LYfWxp42tesJBDJWOt7HHmX6mdpf05JJEku3kOV1A6imFKs0O85xPLMl88",
-	"/PzjTX8B+krkwF7DplKaa1Hu2M+yCcC5MSv+Fslb8/wSZfyG5Mk7U/PrbkyPTmeV6BaJCklGgNktW3NZ",
-	"lD4OX9VY/c7RJhpdVeT2466wUCStUhoBoOyTUJAjhDlhF42bCDpd1OGZVBDZoFUEcyrTJBxdSMiMOOEq",
-	"mc+2meMHK5CZ50jZQhU7X15opvm13VJs/YDtkZw5whMHUmDqqxd0RhoFv/HwudVTxno/VEg0Gr8379yD",
-	"GMvWe11Fq8Z6enqKgURrZezpzL3nuyqu+OO7BnOh2uqs0uIKq0Ig0pQW7plaZl4P1BZWmz0+eTj78L8D",
-	"AAD//6UnopQICgEA",
+	"H4sIAAAAAAAC/+x9f3PcNrLgV0HNe1WOfUPJdpy8ja+23ilxktXFSVyWk713sW+DIXtmsOIAXACUZuLT",
+	"d7/qBkCCJDjDkWQnqbq/bA3xo9FoNBr98/0sV5tKSZDWzJ6/n1Vc8w1Y0PQXz3NVS5uJAv8qwORaVFYo",
+	"OXsevjFjtZCr2Xwm8NeK2/VsPpN8A7Pncf/5TMO/aqGhmD23uob5zORr2HAc2O4qbN2MtM1WKvNDnLkh",
+	"zl/MbvZ84EWhwZghlD/KcseEzMu6AGY1l4bn+Mmwa2HXzK6FYb4zE5IpCUwtmV13GrOlgLIwJ2GR/6pB",
+	"76JV+snHl3TTgphpVcIQzq/UZiEkBKigAarZEGYVK2BJjdbcMpwBYQ0NrWIGuM7XbKn0AVAdEDG8IOvN",
+	"7PkvMwOyAE27lYO4ov8uNcBvkFmuV2Bn7+apxS0t6MyKTWJp5x77GkxdWsOoLa1xJa5AMux1wr6vjWUL",
+	"YFyy1998xT799NMvcCEbbi0UnshGV9XOHq/JdZ89nxXcQvg8pDVerpTmssia9q+/+Yrmv/ALnNqKGwPp",
+	"w3KGX9j5i7EFhI4JEhLSwor2oUP92CNxKNqfF7BUGibuiWt8r5sSz/+77krObb6ulJA2sS+MvjL3OcnD",
+	"ou77eFgDQKd9hZjSOOgvj7Mv3r1/Mn/y+ObffjnL/rf/87NPbyYu/6tm3AMYSDbMa61B5rtspYHTaVlz",
+	"OcTHa08PZq3qsmBrfkWbzzfE6n1fhn0d67ziZY10InKtzsqVMox7MipgyevSsjAxq2UJxtBontqZMKzS",
+	"6koUUMyZkOx6LfI1y7lxQ1A7di3KEmmwNlCM0Vp6dXsO002MEoTrVvigBf1xkdGu6wAmYEvcIMtLZSCz",
+	"6sD1FG4cLgsWXyjtXWWOu6zYmzUwmhw/uMuWcCeRpstyxyzta8G4YZyFq2nOxJLtVM2uaXNKcUn9/WoQ",
+	"axuGSKPN6dyjeHjH0DdARgJ5C6VK4JKQF87dEGVyKVa1BsOu12DX/s7TYColDTC1+CfkFrf9f178+ANT",
+	"mn0PxvAVvOL5JQOZqwKKE3a+ZFLZiDQ8LREOsefYOjxcqUv+n0YhTWzMquL5ZfpGL8VGJFb1Pd+KTb1h",
+	"st4sQOOWhivEKqbB1lqOAeRGPECKG74dTvpG1zKn/W+n7chySG3CVCXfEcI2fPvXx3MPjmG8LFkFshBy",
+	"xexWjspxOPdh8DKtallMEHMs7ml0sZoKcrEUULBmlD2Q+GkOwSPkcfC0wlcEjpAHwBFyGjgStgmawdON",
+	"X1jFVxCRzAn7yTM3+mrVJciG0NliR58qDVdC1abpNAIjTb1fApfKQlZpWIoEjV14dBjGmWvjOfDGy0C5",
+	"kpYLCQUT0gGtLDhmNQpTNOH+987wFl9wA58/m90c+jpx95eqv+t7d3zSblOjzB3JxNWJX/2BTUtWnf4T",
+	"3ofx3EasMvfzYCPF6g3eNktR0k30T9y/gIbaEBPoICLcTUasJLe1hudv5SP8i2XswnJZcF3gLxv30/d1",
+	"acWFWOFPpfvppVqJ/EKsRpDZwJp8cFG3jfsHx0uzY7tNviteKnVZV/GC8s7DdbFj5y/GNtmNeSxhnjWv",
+	"3fjh8WYbHiPH9rDbZiNHgBzFXcWx4SXsNCC0PF/SP9sl0RNf6t/wn6oqsbetlinUIh37K5nUB16tcFZV",
+	"pcg5IvG1/4xfkQmAe0jwtsUpXajP30cgVlpVoK1wg/KqykqV8zIzllsa6d81LGfPZ/922upfTl13cxpN",
+	"/hJ7XVAnFFmdGJTxqjpijFco+pg9zAIZNH0iNuHYHglNQrpNRFISyIJLuOLSnszmqTPZHuBf/Ewtvp20",
+	"4/Dde4KNIpy5hgswTgJ2DR8YFqGeEVoZoZUE0lWpFs0Pn5xVVYtB+n5WVQ4fJD2CIMEMtsJY85CWz9uT",
+	"FM9z/uKEfRuPTaK4QvXSAryogXfD0t9a/hZrdEt+De2IDwyj7URlzc28QYMxYO+D4uhZsVYlSj0HaQUb",
+	"/823jckMf5/U+c9BYjFux4kLWzGPOffGoV+ix80nPcoZEo5X95yws37f25ENjrKHYMx5i8X7Jh76RVjY",
+	"mIOUEEEUUZPfHq413828kJiRsDckk58MOAqp+EpIgnaOzyfJNvzS7YcivCMhgGneRY6WaNBWheplTo/6",
+	"k4Ge5U9AramNDZKoYZyVwlh6V1NjtoaSBGcuA0HHpHIrypiw4XsW0cB8rXnlaNl/cWKXkPSed40crHe8",
+	"eCfeiUmY28/xRhNUt2bLB1lnEhL80Ifhy1Lll3/jZn0PJ3wRxhrSPk3D1sAL0GzNzTpxcHq03Y42hb6x",
+	"IdEsW0RTnTRLfKlW5h6WWKpjWFdVfcXLEqcesqzeamngSQe5LBk2ZrAR1rYPR6dhd+8v9jXP1ygWsJyX",
+	"5bxVFakqK+EKSqY0E1KitsuuuW0PP40c3jV0jgwgs7PAotV4NROp2HSji9DANpxuoA2+Zqqy26fhoIZv",
+	"oCcF0Y2oatIiRA+N8xdhdXAFknhSMzSB36yRtDXx4CfsrPlEM0vlFuc0gDaY7xr8NfyiAzS2bu9T2U6h",
+	"dOF01hZ/E5rlSrsh3A3vJ8f/ANdtZ0edn1QaMj+E5legDS9xdb1FPWzI975O54GTWXDLo5PpqTD9AHOc",
+	"g/qReAc6oaX5kf7DS4afUYpBSmqpR5AwoiJzauEuZkSVmwkbkL5VsY1TZTLULx4F5Vft5Gk2M+nkfe20",
+	"p34L/SKaHXqzFYW5r22iwcb2qntCnO4qsKOBLLKX6URzTUHAG1Uxxz56IDhOQaM5hKjtvV9rX6ptCqYv",
+	"1XZwpakt3MtOqK37zyRm/6XavvCQKX0Y8zT2FKTjAiXfgKHbTcaME2dp7XJnC6VvJ030LhjJWmsj4zhq",
+	"JEzNe0iipnWV+bOZsFi4Br2BWgeP/UJAf/gUxjpYuLD8A2DBWB4BfwcsdAe6byyoTSVKuAfSXyeFONQP",
+	"f/qUXfzt7LMnT//x9LPPkSQrrVaab9hiZ8GwT7xajhm7K+Fh8nVE0kV69M+fBRtVd9zUOEbVOocNr4ZD",
+	"OduXe/26ZgzbDbHWRTOtugFwEkcEvNoc2pkz6yJoL2BRry7AWnzpvtJqee/ccDBDCjpq9KrSKFiYrp3Q",
+	"S0unBTY5ha3V/LSiliALonlahzDcGNgs7oWoxja+aGcpmMdoAQcPxbHb1E6zi7dKmFxJCbl9BaDvYZVF",
+	"MyCM6ABac2MFoA2Le0x483cmmLT6g3MiHvRO1/eh5gGtlU6KIpVWVuWqzFDeFSqhqHnlWzDfIpBt1f/d",
+	"QcuuuWE4N1lxa1mM6GPQPDv5HndDv9nKlkb23uRuvYnV+Xmn7FAX+e1rrAKd2a1kdEo7aqKlVhvGWUEd",
+	"aQO/BevkULGBC8s31Y/L5f1ofRUNlKBlsQGDMzHXggnJDORKOqfGA2TsR52Cnj5igrXNjgPgMXKxkzmZ",
+	"DO+DfY1r9TZCkv+C2ck8UvEhjCUUK9AT8DFdlTeGDjfVA5MAB9Hxkj6TzeIFlJZ/o/SbVoz/Vqu6uvdr",
+	"qj/n1OVwvxhvFSmwb1CHC7kqu460K4T9JLXG32VBXzXKFLcGgp4o8qVYrW30bn6l1QeQDZKzpAClD05p",
+	"VmKfoersB1UgM7G1uQeRuh2s5XBItzFf4wtVW8aZVAXQ5tcmLWyPuF6Szxe5qtlYfic9jTBsAUhdOa9x",
+	"tWjiVqn7ou2Y8dyd0IxQYw5d6K6Vm8659ZUaeIFKMZBMLbyvh/dCoUVy8iKzQVz1on6CX3TgqrTKwRg0",
+	"pznN90HQQjt3ddg9eCLACeBmFmYUW3J9Z2Avrw7CeQm7jHweDfvku5/Nw98BXqssLw8gltqk0NvXKw6h",
+	"njb9PoLrTx6TndNYOqplVtHrpAQLYyg8Ciej+9eHaLCLd0fLFWhyrfmgFB8muRsBNaB+YHq/K7R1NeLJ",
+	"79UVKOHhhkkuVRCsUoOV3NjsEFvGRvFaDK4g4oQpTkwDjwheL7mxzh1MyIJ0u+46oXmoD00xDvDoMwRH",
+	"/jm8QIZj50oakKY2zXPE1FWltIUitQayTI/O9QNsm7nUMhq7efNYxWoDh0Yew1I0vkeWW4lDELeNHdpb",
+	"toeLI98CvOd3SVR2gGgRsQ+Qi9Aqwm7szTwCiDAtoh3hCNOjnMaFej4zVlUVcgub1bLpN4amC9f6zP7U",
+	"th0SlzP20JysUGDIkOTbe8ivHWadH/uaG+bhCK4GpNZyfmtDmPEwZkbIHLJ9lE9PPGwVH4GDh7SuVpoX",
+	"kBVQ8l3CScJ9Zu7zvgFox9vnrrKQOYfk9Ka3lBz8P/cMrWi8BNP8QTH6wnI8gvgUaAnE9z4wcgE0doo5",
+	"eTp60AxFcyW3KIxHy3ZbnRiRbsMrhdq5QA8EsufoUwAewUMz9O1RQZ2z9u3Zn+K/wPgJQptbTLIDM7aE",
+	"dvyjFjCiE/exXtF56bH3HgdOss1RNnaAj4wd2REF/SuurchFRW+dvwEv7e3cLiYpsjqTfQc7N19Cn5VU",
+	"GK6pdZAkqngsFFnoOduf4d5fsv0Jkv4QrADLBeqOow/uVdsF23kG98c0H28HpuD+rPG+GsE5gL4P4yap",
+	"gaevBOCwTdMNOVUj3aifnUb6xC2OvCcitdR96B0SozLhwuRwHcFLH59LcRPY8tyWO8ZJYNqxa9DATL1w",
+	"bjdDG6BVVRYPkLQp7pnRexQk7fl7XRwuaKhoeSn/SPd+2w/fm94jroMO/26rlConaDMHyEhCMMnfiVUK",
+	"d134kL0QtBWOSQdIf8GWuwCuv9ZjNNMK2H+pmuVc0vO4ttDIn0qTUId9aQZhojm9Q22LIShhA+7VT18e",
+	"Peov/NEjv+fCsCVchzjXR4+G6Hj0yB2CtZKwUOo+fH5AWi2OcGJo5v5aWr07bPvww08981UYnvmebsHK",
+	"2A6rvA/2xrU9T8g2ZF1GqSx5sbmolv1mRz/ylCW/6g0eJiUmYow/qbj8O3O8HivaTll7fCimuWTa7cSV",
+	"v+k68Q3WTft+ITZ1ye19mJbhipeZugKtRQEHqdxPLJT8+oqXPzbdKGgZcjyUOWQ5hdpOHAveYB8XnYvj",
+	"CCmsCJE5UwGCc9frwnU6oP9o3cnFZgOF4BbKHas05FA4k5AwzDRLPWE0LMvXXK7oNatVvfIe6G4cuuEw",
+	"CJzCbms5GCIp8dutzMgCk7rxvC9piEtGWR846hv65hv3ur7mzXw+FH0K14r2oG/OSlpw57NRdQwi9apV",
+	"xzjkdIOrJ9x+ncdIhJ924ol2PkIdSrJDfMXbgocJN/fD2JPaoVNQDieO3PLbj2Oe+agLKnf3IOW5gZiG",
+	"SoOhOznWoRr3VS3jRArBn3dnLGyGZibX9R8jx+/1qDJDyVJIyDZKwi6ZO0hI+J4+pno7uWCkM0loY337",
+	"D+QO/D2wuvNMoca74pd2u39C++ZU843S92WvdwNOFn0mmMcPykN+ytsa8dFffGj39mHWfQZg5o1HvdCM",
+	"G6NyQULqeWHm7qB5U7mPye6i/1UTPHYPZ68/bs/AG2fwIAMGlBXjLC8FmTeUNFbXuX0rOSlQo6UmPC2D",
+	"pmhcpf5VaJLW4SdU7H6ot5KTl22jVk16Ey0hoUP8BiBo1k29WoGxvcfdEuCt9K2EZLUUluba4HHJ3Hmp",
+	"QJO744lricEUS6QJq9hvoBVb1Lb73KEsAsaigt5Zm3EappZvJbesBG4s+16gLxMOFzxSwpGVYK+Vvmyw",
+	"kL7dVyDBCJOlPUK/dV8p+MYvf+0DcfD/vnPwDG/TmsxwmZ1MRv/nk/98jhmMePbb4+yL/3b67v2zm4eP",
+	"Bj8+vfnrX/9v96dPb/768D//PbVTAXZRjEJ+/sKrAs5f0Hsviqfpw/7RjFMbIbMkkcWuRj3aYp9QPhdP",
+	"QA+7mlu7hrcS/ciswnRCouD2duTQv2EGZ9Gdjh7VdDaip6kNaz3yUXEHLsMSTKbHGm8tRQ2dqNPZJHAj",
+	"Q4IIbMWWtXRbGaRvFywdnB/Vct5kDHHJBJ8zSiex5sET2//59LPPZ/M2DUTzfTaf+a/vEpQsim0q2UcB",
+	"29RbMY5kemBYxXcGbJp7EOxJP0/neBQPuwHUqpi1qD4+pzBWLNIcLsQVeiXbVp5LF4WD54fs7ztv1lPL",
+	"jw+31QAFVHadSjLWEdSoVbubAD2fKAx5Bjln4gRO+kquAt+L3uO0BL4M3uNaqSmvoeYcOEILVBFhPV7I",
+	"JMVKin56MUj+8jf3/hzyA6fg6s+Zcrt/8O3Xb9ipZ5jmAWHLDx1lCkk8pd2HrrecZbwT+PlWvpUvYEna",
+	"ByWfv5UFt/x0wY3IzWltQH/JSy5zOFkp9jwETb/glr+VA0lrNPtplNmAVfWiFDlaJ1Lk6TLaDUd4+/YX",
+	"VGO/fftu4Dg0fD74qZL8xU2QoSCsapv5fFyZhmuuU4ZZ0+RjopGp995ZnZCtaqcR9uMzP36a5/GqMv28",
+	"LMPlV1WJy4/I0PisI7hlzFjVBI0K08Td4/7+oPzFoPl10KvUBgz7dcOrX4S071j2tn78+FNgnUQlv/or",
+	"H2lyV8Fk7cpo3pi+UoUW7p6VFFCSVXyVsv++ffuLBV7R7pO8vMEtQEGXusU4aaKAaKh2AQEf4xvg4Dg6",
+	"gp8Wd+F6hdyr6SXQJ9rCbpaEO+1XlOTi1tt1IFEGr+06w7OdXJVBEg8706RkXHEhTXAVQssVHgKfvXKB",
+	"KkXIL31aQdhUdjfvdFfLjqAZWIcwLuGkCwOmlGdkkcFElFXBvSjO5a6fe8q4sCca9DVcwu6NajOmHZNs",
+	"qpv7yIwdVKLUSLpEYo2PrR+jv/ne5TFEg/sUQhRhHcjieUMXoc/4QXYi7z0c4hRRdHLzjCGC6wQiqMMY",
+	"Cm6xUBzvTqSfWp6QOUgrriCDUqzEIpUr++9DA2CAFanSpwf1LvLNgAZtgsIatnAXq3/ea9SxM06+T5Uy",
+	"vHSpj5MeRfQeWgPXdgHc7tXzyzhrTIAO+7NrPFlOwzfHJcAW91tY0thJuIbCK4pcG+9afzLuHOkAh+KW",
+	"8ITu7UvhZPSt61GXSAsabuUGu82z1vuNxnT2Zt183wDlFVbXuC8IhfIpcV3mpeh+qQ1fwcjbJbbeTUxa",
+	"07H40SCHJJKkDEJheh1RYyAJJEF2jTNcc/IMA37BQ0zPzJ63cJjJWcS9zYgy3XuELUoSYBu3arf3XHes",
+	"qHK1D7Q0awEtW1EwgNHFSHwc19yE41jMIy47STr7gLmZ9uWPPI8cXaPMxU12yHAb9jno4N3vs0iG1JEh",
+	"X2T86J+Q+3E+cwwguR1KkmhaQAkrt3DXOBBKm9Ws3SCE48flknhLlvKZjRTUkQDg5wB8uTxizNlG2OQR",
+	"UmQcgU2eHjQw+0HFZ1OujgFS+qxsPIxNV0T0N6SjTl0UCQqjqsLLVYzYG/PAAXy+mFay6Ln70zBMyDlD",
+	"NnfFS5A2vMXbQQZpDOlB0Uta6H2NHo49NPaYptyVf9SaqMetVhNLswHotKi9B+KF2mYujUDyLbLYLpDe",
+	"k4E12Ct5MF3CyAeGLdSWnPPoanGBHAdgGYcjgNECQJkAce3Ub0zOcsDsm3a/nJuiQsM+aaTOllzGBL0p",
+	"U4/IlmPk8kmUA/JWAPTUUG1BFa+WOKg+6Ionw8u8vdXmbW7jELOYOv5jRyi5SyP4G+rHulkb/9Zm5xzP",
+	"AOgbfZx0lUPN0l3SiLrOBIg5Kotonxw6QOzB6qu+HJhEa6dVD68R1lKshAmZMEoO0WagBHoEZx3RNLuE",
+	"XfotD3SPX4RukbKOdo/L3cPIY1LDShgLrdEo+AX9Hup4TjnOlVqOr85Weonre61Uc/lTR6eM7yzzo6+A",
+	"wkOWQmMcAlrckkvARt8YUiJ9g03TEmhns5mrCCKKNMelaTGisBBlnaZXP+93L3DaH5qLxtQLusWEdA5a",
+	"C6pgk3RD3zO1C7zYu+CXbsEv+b2td9ppwKY4sUZy6c7xJzkXPQa2jx0kCDBFHMNdG0XpHgYZZUMYcsdI",
+	"Go18Wk72WRsGh6kIYx/0Ugs5GcZufjdSci1Rrs50+KparTCMz6XgCvYwGWV6LJVcRaXWqmpfYssTzO9v",
+	"fHrIPZklfdwBjEUdROJ+JtBim4Y+auYgb8M+KSsmTYJmesqlk1YLqdWBmAZqEenqPrIttB/xkHSCftMz",
+	"ZrfeyW6Xmu2kDSiBF/5NYiCsb/+xHG6IR918zH26k554/xGiAYmmhI2qDw1zZIwwYF5Votj2DE9u1FEl",
+	"GD9KuzwibRFr8YMdwEDXCTpJcJ18997V2ivYT+nNe4qvMud77R2Lkb557rNDFLUmC0bHs3lYXKF5q01c",
+	"+3c/X1il+Qq8FSpzIN1pCFrOMWiIShcYZoVzJynEcgmx9cXcxnLQAW6gYy8mkG6CyNImmlpI+/mzFBkd",
+	"oJ4WxsMoS1NMghbGbPJvhlYu3zZWJTVXQrQ1tzBVJXNJfAe77GdUOrCKC21a91xvdupevkfs+tXmO9jR",
+	"yAe9XhGwA7tCmqfXQDSY0vQ3n0yUZf6BiTHmnpedLTxip87Su3RPW+Mrp4wTf3vLxCvqLeUuB6N1kkBY",
+	"puzGRdo3AU8PdBHfJ+VDmyCKwzJIJO/HUwkT6swOr6ImUcoh2sVsj4F4aTmzm/nsbp4AqdvMj3gA16+a",
+	"CzSJZ/I0dZbhjmPPkSjnFfpv8TLz/hJjl79WV/7yp+bBveIjv2TSlP3m67OXrzz4aJIugeus0QSMrora",
+	"VX+aVblaK/uvEpeS3ys6naYo2vwmbXrsY3FN6fd7yqZB5aLWf6YdL/hcLNMO7wd5n3f1cUvc4/IDVePx",
+	"09o8qXPPyYdfcVEGY2OAdsQ5nRY3rfxVkivEA9zZWSjy+cruld0MTnf6dLTUdYAn0Vw/Ut7U9ItD+qyq",
+	"xIq88w+/d+npG6U7zN9HJiadhz6cWIVCtsPjiK92KDLbF6ZOmBO8fl39iqfx0aP4qD16NGe/lv5DBCD9",
+	"vvC/0/vi0aMh0O62SzMJ0lJJvoGHTZTF6EZ83Ae4hOtpF/TZ1aaRLNU4GTYU6ryAArqvPfautfD4LPwv",
+	"aI7Fn06mPNLjTXfojoGZcoIuxiIRGyfTjatra5iSfZ9qCoJF0iJm7+umOGPs8AjJekMGzMyUIk+7dsiF",
+	"QfYqnTMlNmbUeERbiyPWYsQ3V9YiGgubTUno2wMymiOJTJPMKdzibqH88a6l+FcNTBQgLX7SdK/1rrrw",
+	"OKBRBwJpWi/mB6Y+0fB30YPssTcFXdA+Jche+92LxqYUFpqqzHWkB3g844Bx7/He9vThqdlFs627LpjT",
+	"3jHBoJdUH3gLYmB03lg3MkdbBJT6uWw/wmRLrX6DtCGE7EeJzB9+InqOUO+U516fpTRG5bCeePZD2z39",
+	"bTy28Xd+C4dFN6UBb3OZpk/1cRt5m0evSecSn8/iI5mGy31k3dCAEdZCxytyhqVaRcH7iEt3nlwWiE6E",
+	"WfpURi3MqRu/PZUe5v6u5iW/XvD8Mv0WQpii7e34SVnFQuewAabJceBmZ5EHd9NWuDSHFejWBjFMmXzL",
+	"d42bdvKLpn3AYMfO02Xu3BRKoxLD1PKaSwvBjcHxK9/bgDPBY69rpSlJqUm7dBWQi01SHfv27S9FPnTf",
+	"KcRKuCr2tYGoTLofiLlMqERFvtR8k7nDo+Z8yR7P2zMZdqMQV8KgIzO1eOJaLLih67IxhzddcHkg7dpQ",
+	"86cTmq9rWWgo7No4xBrFmrcnCXmNY+IC7DWAZI+p3ZMv2CfkkmnEFTxELHohaPb8yRfkUOP+eJy6ZQtY",
+	"8rq0+1h2QTw7OGun6Zh8Ut0YyCT9qGnv66UG+A3Gb4c9p8l1nXKWqKW/UA6fpQ2XfAXp+IzNAZhcX9pN",
+	"Muf38CKpUQHGarVjwqbnB8uRP43EfCP7c2CwXG02wm68455RG6Sntga6mzQMd0Jnw/H0Bq7wkfxfq+D+",
+	"19N1feRnDN+k6YGTl/IPZKON0Tpn3GWmLUXrmR6K6rLzkPiaqtw1xe0cbnAuXDrJkriFVFBJSEv6j9ou",
+	"s7/gs1jz3FKOvBFws8XnzxLV4roFleRxgH90vGswoK/SqNcjZB9kFt8Xo+BlthHI6h+2ORaiUznqqJuc",
+	"1o75he4feqrki6Nko+RWd8iNR5z6ToQn9wx4R1Js1nMUPR69so9OmbVOkwevcYd+ev3SSxkbpVPVLNrj",
+	"7iUODVYLuIJidJNwzDvuhS4n7cJdoP99/Z+CyBmJZeEsJx8CkUVzX7A8SvE/f9+m5SfDqotE7OkAlU5o",
+	"O73e7iN7Gx6ndevbb53DGH0bwdxktNEoQ6yMeN/Tz22f38NfqA+S2/OOwvHJr0zjG5zk+EePCGjUO7qm",
+	"vz7tfnbs/dGjdHbspMoNf22xcJcXMfVN7SFWT33+fqS0aONQ5PMjDPdv9JLCD8gEF36oOeuWcfz4UsT9",
+	"xHelvU3TpwCdS/FLwAP90UfE78wsaQPbKIXxw94tY5skmaL5Hvm5c/al2k4lnN4dFIjnD4CiEZRMVM/R",
+	"SgZlepPm+oP+IhGN4qgLQPdS06lYFevz/zx4xsXP92C7FmXxc5vbrXeRaC7zddJLeIEd/+Fk9M4V7Fhl",
+	"CmtocZRQJodzb9t/hDdw4pX+TzV1no2QE9v2y0S75fYW1wLeBTMAFSZE9Apb4gQxVrtps5q0DOVKFYzm",
+	"aSuutMxxWG89Ved2SIJu2E1tvd8qxYL7hENLUeL/RuzG1DLT3I4k0NIUx7hsR4QrQEsVPdjc6KAZFxu6",
+	"mA3HMlh0Mq8A/QOxq5LQ604p1GjkqJwKMxV+opaUsEIxW2uJVSejZYC0QkO5m7OKG+MGeYzLgi3NPXv+",
+	"5PHjpNqLsDNhpQ6LYZk/tkt5ckpN3BdfAczVqTgK2MOw3rQUdczGDgnHFzz9Vw3GpngqfXCRq9iZbm1X",
+	"7LQpUHzCvqXMR0jEndz+CE2TRLibULOuSsWLOSU3Rs8c5mZ1fTQQoqjY6grh75F/0rwyPcFoyOw0kjln",
+	"+jj7U3ngqo3NmtqoqdyE2KKt3ip6Pjekx4uxc8JeOBWqCQo6NwmjFNl6A0VUitU94ok48D/W8nyNDVRH",
+	"AhrnldOrBAd21lpuoujDq/CRGDbC7QsFuzrBc6ZQgXwtMF3xmlu4gm46xABG0I2H9Ijd5elaSkcpJ0cI",
+	"o00hrmPRHoCjcRungiRkPcQfqZlyRdOPLZp8Qb3SsRi9Csw9q39IrhdSbLPvvXEh51JJkVPth5QkTanb",
+	"ppkpJ5TJSNsXzcyf0MThStZ9bmKBPRZHK0HPZx3EDU3+0VfcVEcd7k8LW18PcAXWeM4GxTyUo/cGMSEN",
+	"+FJrSEQxn1Q64dSUDIRoHCiOJCPKyjSi4fwGv/3g9d94BNmlkKTp8mjz7zNnssI8FkjtkgnLVgqMX083",
+	"msf8gn1OKEtjAdt3Jy/VSuQXYkVjODc6XLbzGR0OdRY8SL3HJrb9Ctv63PnNzx13MDfpWVX5SZMRrc0O",
+	"p6qTjyI45bcUHEki5Dbjx6PtIbe9rt90nyKhYVEFZixUdA8PCKMp9N4dBUsq1I6iqAVzEZUppJRCJsB4",
+	"KWQwoaYviDx5JdDG0Hkd6WdyzW2+7rChQw6jIwEQFKGcX97HUL0NJpTQGsMc49vY1qgfYRxNg1bi53LH",
+	"wqFA6o6ECQx/bFxxhxXnSaryQlRBwUW9GvQpxoGMOwshkx10HQzfa7pTNY5jb6KxHIWLuliBxfx3qdRW",
+	"X9JXRl9DkBhWBKmbkmJNdGA3R/mQ2vxEuZKm3uyZKzS443SFMNwY2CzKhNvoi+YjFM0OI6WhZQX/TZWc",
+	"Gt8Z7zR9dFRu8JAujkvMP4wyTkm9SNMZ5l+ajgm6U+6Ojnbq2xF62/9eKT2E6/4honF7XC7eoxR/+xov",
+	"jjhx78A/3V0tTV5d8gVX9D0kPGoyQna5En4bFlYjrwfavMSW9YAPDZOAX/FyJBI+tpW4+9XZD8bi4fPR",
+	"9A3c+vRclrO9LGg05ZHzFe5ZX4YmxDH/YOcefH9WC7/WvQgdt91917HUOR+xllmMWuhuZ0RrN/hYK9p3",
+	"V2MpEkKdDvoe1wPxXjzOW6vScCVU7Tes8YEOT0L3q0/B06n7MbL+ZGTB7221GLWxvPHFld0y/Zv8u5+d",
+	"FZaqye3+ABaXwab3i8okpF1qERGsfwIPtGYjj9rOrTilhk2qXIqXDYOuzLGWDi0Nys8MyOrFFHFggI+b",
+	"+ey8OOrCTJXcmblRUsfupVitLWXs/xvwAvSrAxUJ2ioEdMQqZURbT7bEwXwK2DUNdzI12AAJWMQVFYZj",
+	"BSfUK8gt1URunes0wDH1FXCyYPT5/5UJxp/TTUyGL0iwrwrBsHLwgTt+kDgpSv7lCpOeTM+5f9a4ULsI",
+	"MCyU16Rr6cVMT47cXC4hp6zIexNV/X0NMkqCNA96GYJlGeWtEk0cE+X1Pl7r2AJU8lvCU/L7A2csjv0S",
+	"dg8M61BDsnBoE8R3m8TBhAFnAgs5pMcUyd5rTJiGMggLwSXYdYe2OMZozuco7dot5wokyXicim3PlOmK",
+	"/JPmwq5HpX2kkJyxXFYj1dJTR1xpSn7sPNDjCJpEzXGEvJvTTK6cYjYMIKxT1zMtzCXZM4nrmZpS8SdT",
+	"Pi0MjGYjadPWumZ+MlGWeBKaURs2hFpkTyHaJfLH2cWIt7wbM6NAhjQA0QGMUYMncaWaYhpuRpxJadbk",
+	"Pse/F7AkP8RoCbQCf5RbtGBKX0Y+q8t+snapyHbget/i4PPc1rxszt1IQrAoSyyeq26O6ib/uQqGJUIM",
+	"WlUddsxt4Pqot8O2ovLsd0KDMCyMg0eWkv8eRs3c17QR1jCz5roR+Nvk9ZdwC/w1hJZJvOeL/UdoydGK",
+	"0nlk5WteliBX4E4VRcA0gwbStSR8kSUDF7DSPAdWgRaqSB+qFiyfYDb1RmynoSMRqrcFYCOpApHuagL2",
+	"EydGc979bptSU6HHkUJDgr7p7wOMkBk6BPpyItTKnWEAYTZ7qid85HvyQLGGERbY9CLTHKWwV9q5fiNh",
+	"CXkLip5QqmEEmtApLPd+QfoA1/p81sp8o7QbxEAloc+H2t6BjeBllCYqd8sYoWTmifHwbbtRXmPjR/ff",
+	"TKjhRzesccQ9hbRTKYdaWSbCRfIoJtcQ7u9ZghWm2FDyEkhcj0l5ClISwhmrAHSHVeVKynBBHPEg6saV",
+	"ghtXGB8Ah/KNqzlTrZWEhVKXcUYRLpmq7UqhwHENC4N+XZaGcLo2DRtlga2VCYG9QuZqg82VL7DSzOmi",
+	"mjl79fQV/ZBklqRqzULRioMXKbYOd16TcDT0du/dMP8tTqgDZlyGPASIiRL13xKEZtOzMSZqxQZCuV7p",
+	"81cjrwCDQTDCrF3tD9a46OEhoA5QqXyd5iBLoGIbI+QUvjIupapl3krJYZXTjToeWWjr4iPqVg0lx0Pb",
+	"ePFVoIMJiVG/Pqk6AhUy9k0kvr0BbuqoLE6Eswo04R+xs1FSuNAwYlnKxaCyWlpRNmOkMbcxq0wtM/xF",
+	"g7EdU9c+CgrrQWHI9/0AFE2+XJNhotYfAgq/T+O1pVq+J4UVPgw+3q70dYTwZGMSWykW1dPqCF5UaaG0",
+	"sLv9YAZ+yllo3x8xBtBfBZO3IHRgFd+h39IH2A10hJkKTrcM7X1DMurI6B2VOzXIm2p5KZro5Zefujy+",
+	"0uBqYmG3e1/hqMKlORA9ht+iJGLJEWH2Nm8P90mRXh85A/bQuQEHd3NamCGBKLKljBunX4DlojQ+epI3",
+	"ValiFw70RutXVb72Va0o53zjWBvqW4EJv4UCE26WUlz64pIkWzs3ZqxJElrcS8ZwasZEGuhlM7Nos3sM",
+	"I2CGd4pLlJOXpAjKxrIN9U5qiEZ9YFzYcJvdmeBagtZQNP6ypTKQWRVkv31w7EOFIT3TrZBgRmtjO+BG",
+	"66K9bgu/bUSuFac6aNyHRMcLRHGVI3Q6Ks82Puc+ZH/lvocMjUHLcND9qKHX7GD8XcjrIswAiTHVL5k3",
+	"pRzO/HgbTyQhJegsuCX3a7XJbrp+KspS1F4gjA9G4601ObHyHlaSdOLJh6vsGZCjDIqXsDt1FnKfS7HZ",
+	"wRhop2B2oEfVaHqbfK++WSYF9+pewPt9iwxUSpXZiCfs+bDAXJ/iLwVpuvCmCPkPUD580D0bOAn7hBww",
+	"m1CH6/UuFFSrKpBQPDxh7Ey6jDMh6iEucTeYXD6w++bf0qxF7Wo+eo+rk7cynbqDLk99R24WhtnPwwzI",
+	"4s5TuUH2T2S3ciwe65oqN0IR4/RkqsvGMA6hJ0FFROWgSMokQbHxNTq/pLPddxO9NaqQ26lZbqP24FJC",
+	"kdEDc88zn75HFUkC3MIwN8LxL30fFGPGPInc185sbYTtcU/9CrQRxoK0mVblmDROn6KSqVLZpsw1id8v",
+	"frg4bl4NoUzryIzNd2Zy1RpRIlmooclC1U5r6Cfx7vc0i9W7fQa/QzuIC411fMfv5UGsdhf2nDQrO6RW",
+	"rvM1VpY8BrGjrxkHRmK/I2rr7Erq1F64IISv6HpO6Ucpq22UfpliUzjzwQvMlCqVnuE2mXdxqDRW48kI",
+	"IAtySgLYBgo/eBIBPjDzQJUX/znUMVFLpqGNC7ptQRdfI8UJVGbMSas/czNLV0ohW1U0I8Udu+JN4S4l",
+	"kiItil4Iq7ne3absShdVKYIdxfLBCNsmuLZdSBtgO8RhWarrjESMrCldnLJHYzvTFaGD6b/tx6wik34T",
+	"qsuNf17t2JoXLFdaQx73GHFKIKg2SkOGRbqSGriXYmnxtbwR1jCqjLtiqspVAa4EeJqCxuaqpeT02IEo",
+	"UDKJAkc7uFLfJ6LjiVOiJOxCAzJ6IB2smBk2/w32cclI20T9btGZC08ZSUIBxifm9xhyjYfwEuG4TNZ9",
+	"99AR/bvYEt2ATh151MPVMGe+BY3eISE6+Hh5boQxDpSGloKHy1JsW34ATSxaGrUjj9VzUlBeCQqn7OaF",
+	"pR74NM2hSZYb84CLOJM9s2ut6tU6qhnYwBnMnbr2xtB4lJ9MTRGvlBQMp3jGNspYrx9yI7VLbqOIP8mV",
+	"tFqVZdfP0D2sV955+nu+Pctz+1KpS8zv+pC0UVLZZqXFPKTM7Md7tzPpXrWIrticEQ2Yw9XXXDucJXCB",
+	"yQyyx+IGfs6HLvYIzHeHOehhN+qz4cL66+oy07TyAcV2qzYiT5+pP1cA9WjYc4pFpVDheriD74iYDnt8",
+	"WTXxcsQih2gGyZO+KWfMMwIfN0TsBv9L7+b+uK1xcOSiHDIXL0Vl+ais1wOAIHXZLG2tKQKkI4k1XEWt",
+	"nJ2Gop76gE68VSi49G6w4Qj3DpSFOwE1CGhvAPzEqQznrlyI8znChEj++8O2nsitgL/ZT+Ud5jEWtXvR",
+	"kpamJk3u8RGOkK5auDfE9Q1lMl1MDXQ1IShh4g0fATAe+tqBYVIA7LFgON+9pHPBeaNZnkf6Mf90jUYX",
+	"/l6mWVjO3YWNbgJclLUGnwvbifi6G9JQcbsOVyc2H9p/0JYAhoSZ30ArCl0q5pFLPZTOYtdT4akqK+EK",
+	"OhHBjpZNTaImOhT4vqbpzAqAigJM+prt1Ms4vst7Oge/9iwKlpyC3aT+0yHW7RQ7oNxMqmK3MnPHxEw9",
+	"SgjRlShq3sGfOVbk6Crv8SgnUDV4I2ThHTl1mp/cCK/DAGehf0qUCZh4N40PHc2C0qjbx4AOhr7XZuzU",
+	"y3Tke5x9vjGL0mxFE1vjSLzlG6bi13LcjDAk+fa5NXGfhJIRYr/eQk5SjX/vQOFfPPs9CInanSuekxpX",
+	"MmEjW4NkUrXPHrIhhKdKWxYn/OAmpkZC+tf0LTzB2wD1u+8so8GY6dXHGH1I6IZOb29U+11O4t6DODpe",
+	"ikYM+Ixue/Rfgbr9s4MaqLosmMT9RNmfXO/9Lea5+Jwt6jAQaisoSVDnHfoCgveCkrHh1q0oFJYgXbND",
+	"t7vBhqoOEaUg2TjdLP4jlWX/qnkpljviMw780I18/7FMjHOXcEFePrAfJ94vXgVPzkbbosJUbt1i6pjR",
+	"cDscJQIaL/JQr12xDb+EeBvI/d7xT/KYZaZekOYCr+zedg6x4Bcfsm5veBG/9Kn2z67DHUI1OOz939v0",
+	"ZvFUoWRHVfLc7XZTdb7LZ8hKEYjLrmGzP//dkK8FEoi8oAPR6pAwtbiFyvRI1pVKKjNWUbsDdvSM6BbU",
+	"vp9lTNT89som78kcOGkp970Lk13x+kCTw02om3IAfFfvyrf9KPhPluUaW8YU8P8oeG/q2Y/DS00+BpY7",
+	"SZUTsDpt9UJtMw3Lgx6O1BqBbwE2jYpVyFwDN87OeP6jf3i2VacEWcpdmH/jidCMUsBSyJZZClnVNvGO",
+	"oeJTchchLFb6E1pHTGhjUgIKk1e8/PEKtBbF2Mbh6VDLuEYWQhIMHb5vQoXR3KnDAYRp33CUcq9Vo8fN",
+	"8AIvxHIJ2hmwjeWy4LqImwvJctCWC/Q42ZnbW5Qa48AhmxKPpJluItjIukSk7QApd96V4472ngZAfo+G",
+	"nwkGmzdr8NTfNdY41Y5VI/aZIQx/CoPNhm/RxkeJ4UYOhC83RhY+akbBYDmXTj6btu4wjxG/wf5pqNKq",
+	"Z0RW0axTpth/7n+kraRn5E9S2L0n3+ko+5n6XCoFdzADUlE9GvK5OGIZnscqT09WdRMsNl7qPjA20B5E",
+	"mzgWStLVi4/sIrlB+MycsRLcTLcPdTwtEjeM1wxkpDEwezK2tB4mhGvjVUkDJ9G+qsEhZe4TYB6paXP6",
+	"+XAvjYCHiAbjz3p32sbRDceZLvtE/iFpiCpVZfkUT21XjLlwAARIuzCO0EdkBBhZd+MeY5ry5DE1duuU",
+	"03jmNuJ3r076IWtXle979I+piUY4etcEoZbEy+gIO+WY0rEyZd5PG9ZVgzVMgnGmIa81qYmv+S7pJtOp",
+	"NT9SBPDib2efPXn6j6effc6wARa6BNMWkvSDNGyj8eYVsq/3+bj+u4Pl2fQmhISy9LmxP4Y8Wc2m+LPm",
+	"uK1pq0R1Vn+sSTtxASSOI+UwblO23HqvaJw2W8sfa7tSi7z3HUuh4MPvGbpppAv5NnJVwoCS2q3IhIIv",
+	"kNY/sWcBFbaNYzBrUg9SObcrlyBcBVfMlgqEHXG5Si1kzA2e+Bl+aoJBYVuVnlc5S8++dfl3mtPQkdBI",
+	"XjGoxVKVF+3FkqUgomwAOkqW6BWfpBGPPNsbZut83NPZLiheJE166LNBL2G1ZPu5fWsoDIw6welxExPi",
+	"RTiUtyDNMfvEeCra23CSVrX/h+Efidy698Y1muV+CF6RfB/sSSN5NvB7aPLKTgJtmGc1QR4EwEgCxU7q",
+	"uyj3V1RbTjsrAdkTggG5L3583xqWDwZzESShwwHw4oyIbbsm/siD8zsXafu+QUq0lHdjlNBZ/qEki4H1",
+	"NhdJtEVeaWItGMeWEnk4ogya5qsmMeXIq2SQv1IrZZmSqBtJ5L10ehw6UzHhULSvd97/uFzjG6GNPSN8",
+	"QPF6PKAxTn4YI9mh0tyu9MpLPmnukn+AqeUryrX5d8A9St5zfihvhB/cZqTc4aVzr1421miQ7JrGpJ1m",
+	"Tz5nC18/udKQC9M37l8H4aRJCgQarWNNxrr9yQUPrfNnZe9AxsvgicN+iMxbjc3eQ9ge0d+ZqYyc3CSV",
+	"p6hvQBYJ/KV4FNa8mFZw9661dm+XyTuqyXFkJu94ZVQzZfLyaB106dQGhuucfFt3cJu4qNu1TU1DP7lk",
+	"L1ZFX0zJHp8ur4vdKX39vdTZParK7gdIXO9w5Mfw86Yo5ieJh7CfYtQcjs5B6wF29V6FvcyijV4ynUzY",
+	"RzIlVdhfy1zvKjs2buMLW3FjqrXmSK7eeBKiF8ENAQXbOWtap3WbhtM9P6P3Jc3ANUQj0HxjSUfbUUd0",
+	"0+2sI8sZTnbC/u6cqSiH6DzAxTbcgsbwL8oYx6PUKN4npfFCJ4XrYEs9XtNpW1JC/c9jyWFcJbeRSpy9",
+	"o4pFOw8aXOO6qphBASQYYahy6D98pfiPK2YFCFxmoyEXd7DeJTm8Q0xirZ3Jo6miiqkTiqX6bokKlxSm",
+	"ntda2N0F4j/oVsU/ktUXvm1ytfpM8I2Z1YtFVl2CDK5AbWbX2gTB61vFSxJVnPVXArNKlSfsa1fP0/PQ",
+	"vz5Y/Ad8+pdnxeNPn/zH4i+PP3ucw7PPvnj8mH/xjD/54tMn8PQvnz17DE+Wn3+xeFo8ffZ08ezps88/",
+	"+yL/9NmTxbPPv/iPB3hFIcgO0FDI9/nsf2Vn5UplZ6/OszcIbIsTXglMln5zQ2qUpcLlE1Jz4nyw4aKc",
+	"PQ8//Y/AfE9ytWmHD78il9XYfG1tZZ6fnl5fX5/EXU5XlMsls6rO16dhnpt5D+Nnr86b8A3nokU72hoW",
+	"TmYtKZzRt9dfX7xhZ6/OT2ZRSqfZ45PHJ09wfFWB5JWYPZ99Sj/R6VnTvp9SNa1T4wvlnjZhfDfzwbeq",
+	"cmV08ZOnUf/XOmSkxj82YLXIwyfkVDv/f3PNVyvQJxTY4366enoaBNXT9z6g+Wbft9PYaej0fSdjUHGg",
+	"Z+MUkzRXY9QZeUsE0fmB6bn4IHqbbTgvEP2uJfnlmPOWERKK/Tkxs+e/pNRyIdtpvShFzpxkR/SLmxOR",
+	"V5RYM7AP0sHOHPvEhbTMEBnc4+yLd+8/+8tNisv3Afne24pb45j31qYAQIpdOQlw/asGvWsBI0eOWQzG",
+	"0JKcznCAeb195j0/G8YVQvtCcTylcRZe7LplZkKnEcBwiBRcDRbezWdO32Mc83v6+HE4+f7JFZHVqafW",
+	"GN1ds9TAZeyY/DSxS1dKXsbFZISPIcX+ZHwq4oqvhOQu4II8sTf80hnkXEJB7YU2j1Hvvk1IbsQpvy2B",
+	"uR9RM7ZNoISwRMXhY8s/CS0lXHE5pfqFm2kor94MueXICQxe1rHOtBQ+aSo1ZmtU25O3apts5WY+e3Yk",
+	"NezVXXaqhSXA/56XCDLaSFrX0GePn3w8CM6lcwbGa8ddjzfz2WcfEwfn0oKWvGTU0l2IFOOboHh5KdW1",
+	"DC0pH/Jmw/WOJBU7ZY992joyM4d2ju7dxcrxDP8yc2zZ5xnWYgPS8nL27ubQ9XL63udsO3AZxfaT0za5",
+	"eugw8ZLb1+x0obZHNAUTNR5fisukf/qeTujo76feSJP+SHpWJ6WdhpIeIy1dfq70xw4K39stLmT/cNgm",
+	"Gi/nNl/X1el7+g8JXNGKXC3IU7uVp+SXdvpeFMPPA0R0f2+7xy2uNqqAAJxaLg3YA59P37t/b4btXGWs",
+	"02BZHELUoeBW+ulKMl9Hjb7CpNyz9CXZe6tHvZgTXDEGoHBc7NmEDu653nS61cl/TXKKYT9+h5oA6E8h",
+	"TJjhiAPusWokr8xaRdsTPlh85tSbavilrqpyN/x5J/Pkj8MN66gJRn4+DU+tlNjcbdmRzgdfnRZn7Ov7",
+	"zp9dVlCBc06L/zxtMnm1H8y6toW6jlZCyHMGnuHq8WNt+n+fXnNhUevlKypQ2qdhZwu8PPVlxnu/tpU9",
+	"B1+oXGn0Y8RY0r+ecr+ds0qZxGl6za8jw/YZNXYSDhj7pSp2e27XbbYQkru8ae0N2+o/3MehbH8zT8hl",
+	"5AMarIvDxHCU50YrXuTc6fR8uqjBa+MmyQ0+trT0JS9YUEBmrJWdzvwru7O0P4YkleSCLzBOGimGKc0O",
+	"scTfWRb77PGnH2/6C9BXIgf2BjaV0lyLcsd+kk1s2a1viG+IvDX39S0akneOx5g0MaYcpRNe6d5p1R+Q",
+	"KH8OMLtlay6LEnTj9u9z2+P4GxV5tOHNanwu5EppAsClQ4bC+fiYE3bReECRP1EdnnmFI5tNKDwVJ9D3",
+	"FvIJNxyaEZAfrEBmniNlC1XsMv981vzabl3aiAHbc3LyCE8cSLGpr15QG2kUQiLC51bPGustSaHSaCx/",
+	"eYcPegP6KuhaWjXc89NTipFbK2NPZzfz+JvpfXzXYO590CRUWlwhNDeENKUFPrPLzOuxslbV9vTk8ezm",
+	"/w0Aj1FBQlsmAQA=",
 }
 
 // GetSwagger returns the content of the embedded swagger specification file
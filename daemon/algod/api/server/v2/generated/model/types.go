@@ -721,6 +721,102 @@ type ParticipationKey struct {
 	LastVote *uint64 `json:"last-vote,omitempty"`
 }
 
+// ParticipationKeyHealth Reports whether the account of a participation key is participating, and whether it is at risk of being suspended.
+type ParticipationKeyHealth struct {
+	// Absent The account is absent, and will be suspended by the next proposer checking it.
+	Absent bool `json:"absent"`
+
+	// AbsentAfter The last round the account may go without proposing or heartbeating before it is absent and may be suspended. Not set if the account cannot be absent.
+	AbsentAfter *uint64 `json:"absent-after,omitempty"`
+
+	// ActualProposals The number of blocks the account proposed over the last 1000 rounds.
+	ActualProposals uint64 `json:"actual-proposals"`
+
+	// Address Address the key was generated for.
+	Address string `json:"address"`
+
+	// ExpectedProposals The number of blocks the account is expected to have proposed over the last 1000 rounds, given its share of the online stake.
+	ExpectedProposals uint64 `json:"expected-proposals"`
+
+	// HeartbeatNeeded The account failed the current challenge, and must heartbeat before the end of its grace period.
+	HeartbeatNeeded bool `json:"heartbeat-needed"`
+
+	// HeartbeatPending A heartbeat sent for the account by the node is still valid.
+	HeartbeatPending bool `json:"heartbeat-pending"`
+
+	// Id The key's ParticipationID.
+	Id string `json:"id"`
+
+	// IncentiveEligible The account is eligible for incentives, and therefore checked for absenteeism.
+	IncentiveEligible bool `json:"incentive-eligible"`
+
+	// LastBlockProposal Round when this key was last used to propose a block.
+	LastBlockProposal *uint64 `json:"last-block-proposal,omitempty"`
+
+	// LastHeartbeat The last round the account heartbeated, as recorded on chain.
+	LastHeartbeat *uint64 `json:"last-heartbeat,omitempty"`
+
+	// LastProposed The last round the account proposed a block, as recorded on chain.
+	LastProposed *uint64 `json:"last-proposed,omitempty"`
+
+	// LastVote Round when this key was last used to vote.
+	LastVote *uint64 `json:"last-vote,omitempty"`
+
+	// Registered The key is the one the account is registered online with.
+	Registered bool `json:"registered"`
+
+	// SuspensionChecked The account is among the online accounts the proposers check for absenteeism.
+	SuspensionChecked bool `json:"suspension-checked"`
+}
+
+// Peer A peer the node is connected to.
+type Peer struct {
+	// Address The address the peer is managed by: the phonebook address of an outgoing websocket peer, the remote host of an incoming one, or the peer ID of a P2P peer.
+	Address string `json:"address"`
+
+	// BytesReceived The number of bytes of the messages received from the peer.
+	BytesReceived uint64 `json:"bytes-received"`
+
+	// BytesSent The number of bytes of the messages sent to the peer.
+	BytesSent uint64 `json:"bytes-sent"`
+
+	// ConnectedAt The time the connection was established, in seconds since the epoch.
+	ConnectedAt uint64 `json:"connected-at"`
+
+	// Features The features announced by the peer.
+	Features []string `json:"features"`
+
+	// MessageDelay The relative average per-message delay of an outgoing peer, in nanoseconds, as measured by the connection performance monitor. It is omitted until measured.
+	MessageDelay *uint64 `json:"message-delay,omitempty"`
+
+	// MsgOfInterestMessages The number of message of interest messages received from the peer.
+	MsgOfInterestMessages uint64 `json:"msg-of-interest-messages"`
+
+	// OtherMessages The number of other messages received from the peer.
+	OtherMessages uint64 `json:"other-messages"`
+
+	// Outgoing Whether the node initiated the connection.
+	Outgoing bool `json:"outgoing"`
+
+	// PeerId The libp2p peer ID of a P2P peer.
+	PeerId *string `json:"peer-id,omitempty"`
+
+	// Priority Whether the peer is a priority peer.
+	Priority bool `json:"priority"`
+
+	// ProposalMessages The number of proposal payload messages received from the peer.
+	ProposalMessages uint64 `json:"proposal-messages"`
+
+	// TxnMessages The number of transaction messages received from the peer.
+	TxnMessages uint64 `json:"txn-messages"`
+
+	// Version The protocol version of the connection.
+	Version string `json:"version"`
+
+	// VoteMessages The number of agreement vote messages received from the peer.
+	VoteMessages uint64 `json:"vote-messages"`
+}
+
 // PendingTransactionResponse Details about a pending transaction. If the transaction was recently confirmed, includes confirmation details like the round and reward details.
 type PendingTransactionResponse struct {
 	// ApplicationIndex The application index if the transaction was found and it created an application.
@@ -766,6 +862,30 @@ type PendingTransactionResponse struct {
 	Txn map[string]interface{} `json:"txn"`
 }
 
+// PhonebookEntry An address of the phonebook.
+type PhonebookEntry struct {
+	// Address The address, or the peer ID of a P2P peer.
+	Address string `json:"address"`
+
+	// BannedUntil The time until which the address is banned, in seconds since the epoch.
+	BannedUntil *uint64 `json:"banned-until,omitempty"`
+
+	// Networks The networks the address belongs to.
+	Networks []string `json:"networks"`
+
+	// PersistentRoles The roles which are not updated from DNS.
+	PersistentRoles []string `json:"persistent-roles"`
+
+	// Reputation The reputation score of the address.
+	Reputation float64 `json:"reputation"`
+
+	// RetryAfter The time until which the address is not connected to, in seconds since the epoch.
+	RetryAfter *uint64 `json:"retry-after,omitempty"`
+
+	// Roles The roles of the address: relay or archival.
+	Roles []string `json:"roles"`
+}
+
 // ScratchChange A write operation into a scratch slot.
 type ScratchChange struct {
 	// NewValue Represents an AVM value.
@@ -1020,6 +1140,15 @@ type TealValue struct {
 	Uint uint64 `json:"uint"`
 }
 
+// UnlockParticipationKeysRequest Request to unlock the participation keys of the node.
+type UnlockParticipationKeysRequest struct {
+	// Initialize Encrypt the participation keys with the passphrase if they are not encrypted yet. The passphrase cannot be changed once the keys are encrypted with it.
+	Initialize *bool `json:"initialize,omitempty"`
+
+	// Passphrase The passphrase the participation keys are encrypted with. When not set, the key material is read from the source configured by ParticipationKeysEncryption.
+	Passphrase *string `json:"passphrase,omitempty"`
+}
+
 // Version algod version information.
 type Version struct {
 	Build          BuildVersion `json:"build"`
@@ -1216,6 +1345,12 @@ type DisassembleResponse struct {
 	Result string `json:"result"`
 }
 
+// DisconnectPeerResponse defines model for DisconnectPeerResponse.
+type DisconnectPeerResponse struct {
+	// Disconnected The number of peers disconnected.
+	Disconnected uint64 `json:"disconnected"`
+}
+
 // DryrunResponse defines model for DryrunResponse.
 type DryrunResponse struct {
 	Error string `json:"error"`
@@ -1327,12 +1462,20 @@ type NodeStatusResponse struct {
 	UpgradeYesVotes *uint64 `json:"upgrade-yes-votes,omitempty"`
 }
 
+// ParticipationHealthResponse defines model for ParticipationHealthResponse.
+type ParticipationHealthResponse = []ParticipationKeyHealth
+
 // ParticipationKeyResponse Represents a participation key used by the node.
 type ParticipationKeyResponse = ParticipationKey
 
 // ParticipationKeysResponse defines model for ParticipationKeysResponse.
 type ParticipationKeysResponse = []ParticipationKey
 
+// PeersResponse defines model for PeersResponse.
+type PeersResponse struct {
+	Peers []Peer `json:"peers"`
+}
+
 // PendingTransactionsResponse PendingTransactions is an array of signed transactions exactly as they were submitted.
 type PendingTransactionsResponse struct {
 	// TopTransactions An array of signed transaction objects.
@@ -1342,6 +1485,11 @@ type PendingTransactionsResponse struct {
 	TotalTransactions uint64 `json:"total-transactions"`
 }
 
+// PhonebookResponse defines model for PhonebookResponse.
+type PhonebookResponse struct {
+	Entries []PhonebookEntry `json:"entries"`
+}
+
 // PostParticipationResponse defines model for PostParticipationResponse.
 type PostParticipationResponse struct {
 	// PartId encoding of the participation ID.
@@ -1590,6 +1738,24 @@ type GenerateParticipationKeysParams struct {
 	Last uint64 `form:"last" json:"last"`
 }
 
+// DisconnectPeerParams defines parameters for DisconnectPeer.
+type DisconnectPeerParams struct {
+	// Address The address of the peer, as listed by GetPeers.
+	Address string `form:"address" json:"address"`
+
+	// Ban The number of seconds the address is banned for.
+	Ban *uint64 `form:"ban,omitempty" json:"ban,omitempty"`
+}
+
+// AddPriorityPeerParams defines parameters for AddPriorityPeer.
+type AddPriorityPeerParams struct {
+	// Address The address of the relay.
+	Address string `form:"address" json:"address"`
+
+	// Duration The number of seconds the peer is kept connected.
+	Duration uint64 `form:"duration" json:"duration"`
+}
+
 // ShutdownNodeParams defines parameters for ShutdownNode.
 type ShutdownNodeParams struct {
 	Timeout *uint64 `form:"timeout,omitempty" json:"timeout,omitempty"`
@@ -1634,6 +1800,9 @@ type SimulateTransactionParams struct {
 // SimulateTransactionParamsFormat defines parameters for SimulateTransaction.
 type SimulateTransactionParamsFormat string
 
+// UnlockParticipationKeysJSONRequestBody defines body for UnlockParticipationKeys for application/json ContentType.
+type UnlockParticipationKeysJSONRequestBody = UnlockParticipationKeysRequest
+
 // TealCompileTextRequestBody defines body for TealCompile for text/plain ContentType.
 type TealCompileTextRequestBody = TealCompileTextBody
 
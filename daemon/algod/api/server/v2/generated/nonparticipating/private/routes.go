@@ -36,6 +36,24 @@ type ServerInterface interface {
 	// Starts a catchpoint catchup.
 	// (POST /v2/catchup/{catchpoint})
 	StartCatchup(ctx echo.Context, catchpoint string, params StartCatchupParams) error
+	// Exports a snapshot of the ledger databases.
+	// (GET /v2/ledger/snapshot)
+	GetLedgerSnapshot(ctx echo.Context) error
+	// Dumps the ledger state.
+	// (GET /v2/ledger/statedump)
+	GetLedgerStateDump(ctx echo.Context) error
+	// Disconnects a peer.
+	// (DELETE /v2/peers)
+	DisconnectPeer(ctx echo.Context, params DisconnectPeerParams) error
+	// Lists the connected peers.
+	// (GET /v2/peers)
+	GetPeers(ctx echo.Context) error
+	// Adds a temporary priority peer.
+	// (POST /v2/peers)
+	AddPriorityPeer(ctx echo.Context, params AddPriorityPeerParams) error
+	// Dumps the phonebook.
+	// (GET /v2/peers/phonebook)
+	GetPhonebook(ctx echo.Context) error
 
 	// (POST /v2/shutdown)
 	ShutdownNode(ctx echo.Context, params ShutdownNodeParams) error
@@ -124,6 +142,104 @@ func (w *ServerInterfaceWrapper) StartCatchup(ctx echo.Context) error {
 	return err
 }
 
+// GetLedgerSnapshot converts echo context to params.
+func (w *ServerInterfaceWrapper) GetLedgerSnapshot(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(Api_keyScopes, []string{""})
+
+	// Invoke the callback with all the unmarshalled arguments
+	err = w.Handler.GetLedgerSnapshot(ctx)
+	return err
+}
+
+// GetLedgerStateDump converts echo context to params.
+func (w *ServerInterfaceWrapper) GetLedgerStateDump(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(Api_keyScopes, []string{""})
+
+	// Invoke the callback with all the unmarshalled arguments
+	err = w.Handler.GetLedgerStateDump(ctx)
+	return err
+}
+
+// DisconnectPeer converts echo context to params.
+func (w *ServerInterfaceWrapper) DisconnectPeer(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(Api_keyScopes, []string{""})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params DisconnectPeerParams
+	// ------------- Required query parameter "address" -------------
+
+	err = runtime.BindQueryParameter("form", true, true, "address", ctx.QueryParams(), &params.Address)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter address: %s", err))
+	}
+
+	// ------------- Optional query parameter "ban" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "ban", ctx.QueryParams(), &params.Ban)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter ban: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshalled arguments
+	err = w.Handler.DisconnectPeer(ctx, params)
+	return err
+}
+
+// GetPeers converts echo context to params.
+func (w *ServerInterfaceWrapper) GetPeers(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(Api_keyScopes, []string{""})
+
+	// Invoke the callback with all the unmarshalled arguments
+	err = w.Handler.GetPeers(ctx)
+	return err
+}
+
+// AddPriorityPeer converts echo context to params.
+func (w *ServerInterfaceWrapper) AddPriorityPeer(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(Api_keyScopes, []string{""})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params AddPriorityPeerParams
+	// ------------- Required query parameter "address" -------------
+
+	err = runtime.BindQueryParameter("form", true, true, "address", ctx.QueryParams(), &params.Address)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter address: %s", err))
+	}
+
+	// ------------- Required query parameter "duration" -------------
+
+	err = runtime.BindQueryParameter("form", true, true, "duration", ctx.QueryParams(), &params.Duration)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter duration: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshalled arguments
+	err = w.Handler.AddPriorityPeer(ctx, params)
+	return err
+}
+
+// GetPhonebook converts echo context to params.
+func (w *ServerInterfaceWrapper) GetPhonebook(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(Api_keyScopes, []string{""})
+
+	// Invoke the callback with all the unmarshalled arguments
+	err = w.Handler.GetPhonebook(ctx)
+	return err
+}
+
 // ShutdownNode converts echo context to params.
 func (w *ServerInterfaceWrapper) ShutdownNode(ctx echo.Context) error {
 	var err error
@@ -177,6 +293,12 @@ func RegisterHandlersWithBaseURL(router EchoRouter, si ServerInterface, baseURL
 	router.PUT(baseURL+"/debug/settings/pprof", wrapper.PutDebugSettingsProf, m...)
 	router.DELETE(baseURL+"/v2/catchup/:catchpoint", wrapper.AbortCatchup, m...)
 	router.POST(baseURL+"/v2/catchup/:catchpoint", wrapper.StartCatchup, m...)
+	router.GET(baseURL+"/v2/ledger/snapshot", wrapper.GetLedgerSnapshot, m...)
+	router.GET(baseURL+"/v2/ledger/statedump", wrapper.GetLedgerStateDump, m...)
+	router.DELETE(baseURL+"/v2/peers", wrapper.DisconnectPeer, m...)
+	router.GET(baseURL+"/v2/peers", wrapper.GetPeers, m...)
+	router.POST(baseURL+"/v2/peers", wrapper.AddPriorityPeer, m...)
+	router.GET(baseURL+"/v2/peers/phonebook", wrapper.GetPhonebook, m...)
 	router.POST(baseURL+"/v2/shutdown", wrapper.ShutdownNode, m...)
 
 }
@@ -184,232 +306,267 @@ func RegisterHandlersWithBaseURL(router EchoRouter, si ServerInterface, baseURL
 // Base64 encoded, gzipped, json marshaled Swagger object
 var swaggerSpec = []string{
 
-	"H4sIAAAAAAAC/+y9e5PbtpI4+lVQ2q3yY8UZ23GyJ751au/ETnLmxklcHid7d23fE4hsSThDATwAOCPF",
-	"19/9V+gGSJAEJWpm4iS1+5c9Ih6NRqPR6OeHWa42lZIgrZk9+zCruOYbsKDxL57nqpY2E4X7qwCTa1FZ",
-	"oeTsWfjGjNVCrmbzmXC/VtyuZ/OZ5Bto27j+85mGf9ZCQzF7ZnUN85nJ17DhbmC7q1zrZqRttlKZH+KM",
-	"hjh/Mfu45wMvCg3GDKH8UZY7JmRe1gUwq7k0PHefDLsWds3sWhjmOzMhmZLA1JLZdacxWwooC3MSFvnP",
-	"GvQuWqWffHxJH1sQM61KGML5XG0WQkKAChqgmg1hVrEClthozS1zMzhYQ0OrmAGu8zVbKn0AVAIihhdk",
-	"vZk9ezszIAvQuFs5iCv871ID/AqZ5XoFdvZ+nlrc0oLOrNgklnbusa/B1KU1DNviGlfiCiRzvU7Y97Wx",
-	"bAGMS/b6m+fss88++9ItZMOthcIT2eiq2tnjNVH32bNZwS2Ez0Na4+VKaS6LrGn/+pvnOP+FX+DUVtwY",
-	"SB+WM/eFnb8YW0DomCAhIS2scB861O96JA5F+/MClkrDxD2hxne6KfH8v+uu5Nzm60oJaRP7wvAro89J",
-	"HhZ138fDGgA67SuHKe0Gffso+/L9h8fzx48+/svbs+y//Z+ff/Zx4vKfN+MewECyYV5rDTLfZSsNHE/L",
-	"msshPl57ejBrVZcFW/Mr3Hy+QVbv+zLXl1jnFS9rRyci1+qsXCnDuCejApa8Li0LE7Nalo5NudE8tTNh",
-	"WKXVlSigmDvue70W+Zrl3NAQ2I5di7J0NFgbKMZoLb26PYfpY4wSB9eN8IEL+uMio13XAUzAFrlBlpfK",
-	"QGbVgesp3DhcFiy+UNq7yhx3WbE3a2A4uftAly3iTjqaLssds7ivBeOGcRaupjkTS7ZTNbvGzSnFJfb3",
-	"q3FY2zCHNNyczj3qDu8Y+gbISCBvoVQJXCLywrkbokwuxarWYNj1Guza33kaTKWkAaYW/4Dcum3/fy5+",
-	"/IEpzb4HY/gKXvH8koHMVQHFCTtfMqlsRBqelhCHrufYOjxcqUv+H0Y5mtiYVcXzy/SNXoqNSKzqe74V",
-	"m3rDZL1ZgHZbGq4Qq5gGW2s5BhCNeIAUN3w7nPSNrmWO+99O25HlHLUJU5V8hwjb8O1fH809OIbxsmQV",
-	"yELIFbNbOSrHubkPg5dpVctigphj3Z5GF6upIBdLAQVrRtkDiZ/mEDxCHgdPK3xF4IRBRsFpZjkAjoRt",
-	"gmbc6XZfWMVXEJHMCfvJMzf8atUlyIbQ2WKHnyoNV0LVpuk0AiNOvV8Cl8pCVmlYigSNXXh0OAZDbTwH",
-	"3ngZKFfSciGhcMwZgVYWiFmNwhRNuP+9M7zFF9zAF0/H7vj268TdX6r+ru/d8Um7jY0yOpKJq9N99Qc2",
-	"LVl1+k94H8ZzG7HK6OfBRorVG3fbLEWJN9E/3P4FNNQGmUAHEeFuMmIlua01PHsnH7q/WMYuLJcF14X7",
-	"ZUM/fV+XVlyIlfuppJ9eqpXIL8RqBJkNrMkHF3bb0D9uvDQ7ttvku+KlUpd1FS8o7zxcFzt2/mJsk2nM",
-	"YwnzrHntxg+PN9vwGDm2h902GzkC5CjuKu4aXsJOg4OW50v8Z7tEeuJL/av7p6pK19tWyxRqHR37KxnV",
-	"B16tcFZVpci5Q+Jr/9l9dUwA6CHB2xaneKE++xCBWGlVgbaCBuVVlZUq52VmLLc40r9qWM6ezf7ltNW/",
-	"nFJ3cxpN/tL1usBOTmQlMSjjVXXEGK+c6GP2MAvHoPETsglieyg0CUmb6EhJOBZcwhWX9qR9snT4QXOA",
-	"3/qZWnyTtEP47j3BRhHOqOECDEnA1PCeYRHqGaKVIVpRIF2VatH8cP+sqloM4vezqiJ8oPQIAgUz2Apj",
-	"zQNcPm9PUjzP+YsT9m08NoriSpY7dzmQqOHuhqW/tfwt1uiW/BraEe8Zhtup9InbmoAGJ+bfBcXhs2Kt",
-	"Sif1HKQV1/hvvm1MZu73SZ3/HCQW43acuPCh5TFHbxz8JXrc3O9RzpBwvLrnhJ31+96MbNwoewjGnLdY",
-	"vGviwV+EhY05SAkRRBE1+e3hWvPdzAuJGQp7QzL5yQBRSMVXQiK0c/d8kmzDL2k/FOLdEQKY5l1EtEQS",
-	"ZKNC9TKnR/3JQM/yJ6DW1MYGSdRJqqUwFt/V2JitoUTBmctA0DGp3IgyJmz4nkU0MF9rXhEt+y8kdgmJ",
-	"73lqRLDe8uKdeCcmYY7YfbTRCNWN2fJB1pmEBLlGD4avSpVf/o2b9R2c8EUYa0j7OA1bAy9AszU368TB",
-	"6dF2O9oU+nYNkWbZIprqpFniS7Uyd7DEUh3DuqrqOS9LN/WQZfVWiwNPOshlyVxjBhuBCnP/cCQNO72/",
-	"2Nc8XzuxgOW8LOetqkhVWQlXULpHu5AS9JzZNbft4ceRw7sGz5EBx+wssGg1Xs2EKjbd6CI0sA3HG2jj",
-	"XjNV2e3TcFDDN9CTgvBGVDVqEaKHxvmLsDq4Aok8qRkawW/WiNqaePATN7f/hDNLRYsjDaAN5rsGfw2/",
-	"6ADtWrf3qWynULognbV1vwnNcqVpCLrh/eTuP8B125mo836lIfNDaH4F2vDSra63qAcN+d7V6TxwMgtu",
-	"eXQyPRWmH2DEObAfinegE1qaH/E/vGTus5NiHCW11CNQGFGRObWgi9mhimZyDVDfqtiGVJms4vnlUVA+",
-	"bydPs5lJJ+9r0p76LfSLaHbozVYU5q62CQcb26vuCSHdVWBHA1lkL9OJ5pqCgDeqYsQ+eiAQp8DRCCFq",
-	"e+fX2ldqm4LpK7UdXGlqC3eyE26cycz+K7V94SFT+jDmcewpSHcLlHwDBm83GTNON0trlztbKH0zaaJ3",
-	"wUjWWhsZd6NGwtS8hyRsWleZP5sJiwU16A3UOnjsFwL6w6cw1sHCheW/ARaMG/UusNAd6K6xoDaVKOEO",
-	"SH+dFOIW3MBnT9jF384+f/zk708+/8KRZKXVSvMNW+wsGHbfq+WYsbsSHiRfRyhdpEf/4mmwUXXHTY1j",
-	"VK1z2PBqOBTZvuj1S82YazfEWhfNuOoGwEkcEdzVRmhnZNZ1oL2ARb26AGvdS/eVVss754aDGVLQYaNX",
-	"lXaChenaCb20dFq4JqewtZqfVtgSZEF+Bm4dwrg34GZxJ0Q1tvFFO0vBPEYLOHgojt2mdppdvFV6p+u7",
-	"UG+A1konr+BKK6tyVWZOzhMqoaB45Vsw3yJsV9X/naBl19wwNzdaL2tZjOgh7FZOv79o6Ddb2eJm7w1G",
-	"602szs87ZV+6yG9fIRXozG4lQ+rsqEeWWm0YZwV2RFnjW7Akf4kNXFi+qX5cLu9G26lwoIQeR2zAuJkY",
-	"tXDSj4FcSXLmO6Cy8aNOQU8fMcHKZMcB8Bi52MkcTWV3cWzHtVkbIdFub3Yyj1RbDsYSilWHLG+vwhpD",
-	"B011zyTAceh4iZ9RV/8CSsu/UfpNK75+q1Vd3Tl77s85dTncL8ZbAwrXN6iBhVyVXQfSlYP9JLXG32VB",
-	"zxslAq0BoUeKfClWaxu9F19p9RvciclZUoDiB1IWla7PUGX0gyocM7G1uQNRsh2s5XCObmO+xheqtowz",
-	"qQrAza9NWsgccTlEXyd00bKx3Ir6CWHYAhx15bx2q60rhg5Ig/ui7ZjxnE5ohqgxI+4Xjd8MtaLpyJ2t",
-	"1MCLHVsASKYW3sfBe1/gIjl6T9kgpnkRN8EvOnBVWuVgDBSZV0UfBC20o6vD7sETAo4AN7Mwo9iS61sD",
-	"e3l1EM5L2GXo62fY/e9+Ng9+B3itsrw8gFhsk0JvX582hHra9PsIrj95THakqSOqdeKtYxAlWBhD4VE4",
-	"Gd2/PkSDXbw9Wq5Ao0vJb0rxYZLbEVAD6m9M77eFtq5GPNj9M91JeG7DJJcqCFapwUpubHaILbtGHV2C",
-	"W0HECVOcGAceEbxecmPJDUrIAnWadJ3gPCSEuSnGAR59hriRfw4vkOHYubsHpalN8xwxdVUpbaFIrQEt",
-	"sqNz/QDbZi61jMZu3jxWsdrAoZHHsBSN75HlX8D4B7eN/dVbdIeLQ5u6u+d3SVR2gGgRsQ+Qi9Aqwm7s",
-	"xTsCiDAtoolwhOlRTuM6PJ8Zq6rKcQub1bLpN4amC2p9Zn9q2w6Ji4wcdG8XCgwaUHx7D/k1YZb8t9fc",
-	"MA9HMLGjOof8tYYwu8OYGSFzyPZRPj7xXKv4CBw8pHW10ryArICS7xLOAfSZ0ed9A+COt89dZSEjR9z0",
-	"preUHPwe9wytcDyTEh4ZfmG5O4LuKdASiO99YOQCcOwUc/J0dK8ZCudKblEYD5dNW50YEW/DK2Xdjnt6",
-	"QJA9R58C8AgemqFvjgrsnLVvz/4U/wXGT9DIEcdPsgMztoR2/KMWMKIL9jFO0XnpsfceB06yzVE2doCP",
-	"jB3ZEcX0K66tyEWFb53vYHfnT7/+BEnDOSvAclFCwaIP9Ays4v6MXEj7Y97sKThJ9zYEf6B8SywnuOl0",
-	"gb+EHb65X1FsQqTquIu3bGJUdz9xyRDQ4PHsRPC4CWx5bsudE9TsGnbsGjQwUy/IhWFoT7GqyuIBkvaZ",
-	"PTN662zSNrrXXHyBQ0XLS/ma0ZtgP3xveg+DDjr8W6BSqpygIRsgIwnBJN8RVim368KHP4UAmEBJHSA9",
-	"00bTfHP93zMdNOMK2H+pmuVc4pOrttDINEqjoIACpJvBiWDNnN45scUQlLABeknil4cP+wt/+NDvuTBs",
-	"CdchZtA17KPj4UPU47xSxnYO1x3oQ91xO09cH2i4cheff4X0ecphjyc/8pSdfNUbvLF2uTNljCdct/xb",
-	"M4DeydxOWXtMI9O8vXDcSbacrn/QYN247xdiU5fc3oXVCq54makr0FoUcJCT+4mFkl9f8fLHphvGQ0Lu",
-	"aDSHLMcovoljwRvXhwL/3DhCCneAyel/KkBwTr0uqNOBJ2brqSo2GygEt1DuWKUhB4p3c5KjaZZ6wsgT",
-	"Pl9zucIHg1b1yju30jjI8GtDqhldy8EQSaHKbmWGSu7UBeDd1ELIoxOngLsnXV9DTg+Ya97M56Ncp9zM",
-	"0R70LQZJI9l8NvridUi9al+8hJxu3OaEy6Aj70X4aSeeaEpB1DnZZ4iveFvcYXKb+9uo7NuhU1AOJ448",
-	"ftuPY06/7rld7u5A6KGBmIZKg8ErKlZTGfqqlnGMdnAV3BkLm6Emn7r+feT4vR59LypZCgnZRknYJdOS",
-	"CAnf48fkccJrcqQzCixjfftvkA78PbC680yhxtviF3e7f0L7FivzjdJ3ZRKlASeL9xMskAfN7X7Km9pJ",
-	"eVkmTIs+grPPAMy8cdYVmnFjVC5QZjsvzNx7BZM10od7dtH/qolLuYOz1x+3Z0OLkwOgjhjKinGWlwI1",
-	"yEoaq+vcvpMcdVTRUhNOXOExPq61fB6apNWkCS2mH+qd5OjA12iukg4bS0ioab4BCMpLU69WYGzvrbME",
-	"eCd9KyFZLYXFuTbuuGR0XirQ6El1Qi03fMeWjiasYr+CVmxR2670jwHKxoqy9AY9Nw1Ty3eSW1YCN5Z9",
-	"L+SbLQ4XjP7hyEqw10pfNlhI3+4rkGCEydLOZt/SV/Tr98tfex9/dHenz8HptM2YMHPL7CRJ+f/u/8ez",
-	"t2fZf/Ps10fZl/92+v7D048PHg5+fPLxr3/9/7s/ffbxrw/+419TOxVgT4XPesjPX/iX8fkLfP5Ervp9",
-	"2D+Z/n8jZJYkstibo0db7D6mivAE9KCrHLNreCftVjpCuuKlKBxvuQk59G+YwVmk09Gjms5G9JRhYa1H",
-	"PipuwWVYgsn0WOONpaihf2Y6UB2Nkj72HM/Lspa0lUH6pjjM4F+mlvMmGQHlKXvGMFJ9zYOTp//zyedf",
-	"zOZthHnzfTaf+a/vE5Qsim0qj0AB29RbMQ6SuGdYxXcGbJp7IOxJVzry7YiH3cBmAdqsRfXpOYWxYpHm",
-	"cCFkyeuctvJckoO/Oz9o4tx5y4lafnq4rQYooLLrVP6ijqCGrdrdBOi5nVRaXYGcM3ECJ32dT+Hei96p",
-	"rwS+DI6pWqkpr6HmHBChBaqIsB4vZJJiJUU/vfAGf/mbO38O+YFTcPXnTHn03vv26zfs1DNMc49SWtDQ",
-	"URKCxFPaB092HJIcN4tjyt7Jd/IFLFH7oOSzd7Lglp8uuBG5Oa0N6K94yWUOJyvFnoV4zBfc8ndyIGmN",
-	"JlaMgqZZVS9KkbPL+EHSkiclyxqO8O7dW16u1Lt37we+GcPng58qyV9ogswJwqq2mU/1k2m45jpl+zJN",
-	"qhccmXJ57ZuVhGxVk4I0pBLy46d5Hq8q00/5MFx+VZVu+REZGp/QwG0ZM1Y18WhOQPEhvW5/f1D+YtD8",
-	"OuhVagOG/bLh1Vsh7XuWvasfPfoMI/vaHAi/+Cvf0eSugsnaldGUFH2lCi6cnpXoq55VfJUysb1799YC",
-	"r3D3UV7eoI6jLBl260QdhgADHKpdQBPiPLoBBMfRwcG4uAvqFdI6ppeAn3ALuwHYt9qvKH7+xtt1IAaf",
-	"13adubOdXJVxJB52psn2tnJCVvDGMGKFr1WfGG8BLF9DfukzlsGmsrt5p3tw+PGCZmAdwlAuO4owxGxK",
-	"aKBYAKurgntRnMtdP62NoYgKHPQ1XMLujWqTMR2Tx6abVsWMHVSk1Ei6dMQaH1s/Rn/zvVdZCDT12Ukw",
-	"eDOQxbOGLkKf8YNMIu8dHOIUUXTSfowhgusEIoj4R1Bwg4W68W5F+qnlCZmDtOIKMijFSixSaXj/c2gP",
-	"C7A6qvSZB70XcjOgYWLJ3FN+QRerf95rLlfgrmd3pSrDS8qqmnTawPfQGri2C+B2r55fxgkpAnT4pLzG",
-	"yGvU8M3dEmDr9ltY1NhJuHavClQUURvvvXwy7n9GgENxQ3hC9/alcDL61vWoS2QcDLdyg93mWetd82I6",
-	"Q7jo+wYwZam6dvvioFA+2yYldYnul9rwFYy8XWLr3cR8GB2LHw5ySCJJyiBq2Rc1BpJAEmRqnLk1J88w",
-	"uC/uEOMzs+eQGWYiA7G3GWESbY+wRYkCbOO5SnvPdceKSlmBx0BLsxbQshUFAxhdjMTHcc1NOI6YLzVw",
-	"2UnS2W+Y9mVfarrzyJcwSoraJJ4Lt2Gfgw7e/T5BXchKF1LRxY/+CWnl3NsLwxdS26EkiqYFlLCihVPj",
-	"QChtwqR2gxwcPy6XyFuylFtipKCOBAA/B7iXy0PGyDbCJo+QIuMIbHR8wIHZDyo+m3J1DJDSJ3ziYWy8",
-	"IqK/IR3YR476ThhVlbtcxYi9MQ8cwKeiaCWLnkc1DsOEnDPH5q546dicf4u3gwwypOGDopcPzbvePBh7",
-	"aOwxTdGVf9SaSEi4yWpiaTYAnRa190C8UNuMIpSTb5HFduHoPRm7gPHSqYNJuejuGbZQW3TnwquFfOUP",
-	"wDIORwAj0r1shUF6xX5jchYBs2/a/XJuigoNkoxXtDbkMiboTZl6RLYcI5f7UXq5GwHQU0O1tRq8WuKg",
-	"+qArngwv8/ZWm7dpU0NYWOr4jx2h5C6N4G+oH+smhPtbm/hvPLlYOFGfJBPeULN0mwyF1LmirIPHJCjs",
-	"k0MHiD1YfdWXA5No7fp6dfEaYS3FShzzHRolh2gzUAI+grOOaJpdpjwF3Fse8B6/CN0iZR3uHpe7B5ED",
-	"oYaVMBZao1HwC/o91PEc0ycrtRxfna300q3vtVLN5U9mc+zYWeYnXwF64C+FNjZDi1tyCa7RNwaVSN+4",
-	"pmkJtOuiSMUGRJHmuDjtJeyyQpR1ml79vN+9cNP+0Fw0pl7gLSYkOWgtsDhG0nF5z9Tk2753wS9pwS/5",
-	"na132mlwTd3E2pFLd44/ybnoMbB97CBBgCniGO7aKEr3MMgo4HzIHSNpNPJpOdlnbRgcpiKMfdBLLYS9",
-	"j938NFJyLVEawHSEoFqtoAjpzYI9TEZJ5EolV1EVp6ralzPvhFHqOsw8tydpnXfDhzEn/Ejcz4QsYJuG",
-	"Pn4VIORtZB0m3MNJViApXUlaLZRETezijy0iXd0ntoX2AwCSTtBvesbs1juZdqnZTtyAEnjh3yQGwvr2",
-	"H8vhhnjUzcfcpzuZT/cfIRwQaUrYqLDJMA3BCAPmVSWKbc/wRKOOKsH4UdrlEWkLWYsf7AAGuk7QSYLr",
-	"pNL2rtZewX6Kb95T9yoj32vvWOzom+c+AL+oNVowOp7Nw7ztzVtt4tq/+/nCKs1X4K1QGYF0qyFwOceg",
-	"IcqKbpgV5E5SiOUSYuuLuYnloAPcQMdeTCDdBJGlTTS1kPaLpykyOkA9LYyHUZammAQtjNnk3wytXEGm",
-	"j1RJzZUQbc0NTFXJcP3vYJf9zMvaPTKENq17rjc7dS/fI3b9avMd7HDkg16vDrADu4Kap9eANJjS9Def",
-	"TJTA+p7ppPjH52VnC4/YqbP0Lt3R1viiDOPE394ynaIF3aXc5mC0ThIOlim7cZH2TXCnB7qI75PyoU0Q",
-	"xWEZJJL346mECSUsh1dRk4viEO2+AV4G4sXlzD7OZ7fzBEjdZn7EA7h+1VygSTyjpylZhjuOPUeinFeV",
-	"Vle8zLy/xNjlr9WVv/yxeXCv+MQvmTRlv/n67OUrD/7H+Swvgeus0QSMrgrbVX+aVVEZh/1XCWX79opO",
-	"0hRFm99kZI59LK4xs3dP2TQoitL6z0RH0ftcLNMO7wd5n3f1oSXucfmBqvH4aW2e5PDTdfLhV1yUwdgY",
-	"oB1xTsfFTausk+QK8QC3dhaKfL6yO2U3g9OdPh0tdR3gSTjXj5iaMv3ikD5xJbIi7/zD71x6+kbpDvP3",
-	"kYlJ56HfTqxyQjbhccRXO9Sv7AtTJ4wEr19Wv7jT+PBhfNQePpyzX0r/IQIQf1/43/F98fBh0nqYVGM5",
-	"JoFaKsk38KCJshjdiE/7AJdwPe2CPrvaNJKlGifDhkLJCyig+9pj71oLj8/C/1JACe6nkymP9HjTCd0x",
-	"MFNO0MVYJGLjZLqhkpmGKdn3qcYgWEdayOx9SQYyxg6PkKw3aMDMTCnytGuHXBjHXiU5U7rGDBuPaGvd",
-	"iLUY8c2VtYjGcs2m5EztARnNkUSmSaZtbXG3UP5411L8swYmCveqWQrQeK/1rrrwOMBRBwJpWi/mByY7",
-	"VTv8bfQge+xNQRe0Twmy1373orEphYWmiv4c6QEezzhg3Hu8tz19eGqmaLZ11wVz2jtmSun0wOi8sW5k",
-	"jmQpdGGypVa/QtoQgvajRCKMYPgUqOb9FWTKc6/PUhqjclvRvZ390HZPfxuPbfyt38Jh0U3VsZtcpulT",
-	"fdxG3uTRa9Lpmj2Sxx5hsYdBNzRghLXg8YqcYbEMSvA+4pLOE2WB6ESYpU9lHMt5SuO3p9LDPIh/Lfn1",
-	"gqdqxLi3kIMp2t6On5RVLHQOG2CaHAc0O4s8uJu2gjLJVaBbG8QwK+0N3zU07eQXTfuAQYqKny5zclMo",
-	"jUoMU8trLqmKuOtH/Mr3NkAmeNfrWmnMA2nSLl0F5GKTVMe+e/e2yIfuO4VYCSqQXRuIKjD7gRglm0Qq",
-	"8lWsm8wdHjXnS/ZoHpWB97tRiCthxKIEbPGYWiy4weuyMYc3XdzyQNq1weZPJjRf17LQUNi1IcQaxZq3",
-	"Jwp5jWPiAuw1gGSPsN3jL9l9dMk04goeOCx6IWj27PGX6FBDfzxK3bK+wPk+ll0gzw7O2mk6Rp9UGsMx",
-	"ST9q2vt6qQF+hfHbYc9poq5TzhK29BfK4bO04ZKvIB2fsTkAE/XF3URzfg8vkqwBYKxWOyZsen6w3PGn",
-	"kZhvx/4IDJarzUbYjXfcM2rj6Kktr0yThuGo1r+vFxXgCh/R/7UK7n89XdcnfsbwzUjMFnop/4A22hit",
-	"c8Yp+WcpWs/0UK+TnYfcwlhAq6mbRbhxc7mloyyJjupLVmkhLeo/arvM/uKexZrnjv2djIGbLb54mihE",
-	"1a3VIo8D/JPjXYMBfZVGvR4h+yCz+L7svlQy2ziOUjxocyxEp3LUUTftkjnmF7p/6KmSrxslGyW3ukNu",
-	"POLUtyI8uWfAW5Jis56j6PHolX1yyqx1mjx47Xbop9cvvZSxUTpVMKA97l7i0GC1gCuMmEtvkhvzlnuh",
-	"y0m7cBvof1//pyByRmJZOMvJh0Bk0dwXLO+k+J+/bzOfo2GVIhF7OkClE9pOr7f7xN6Gx2nd+vZbchjD",
-	"byOYm4w2HGWIlRHve3Kvb/r8Hv5CfZBozzsKx8e/MO3e4CjHP3yIQD98OPdi8C9Pup+JvT98mE5AnFS5",
-	"uV9bLNzmRYx9U3v4lUoowELVwsahyOdHSCggxy4p98ExwYUfas66FeI+vRRxN/FdaW/T9Cl49+4tfgl4",
-	"wD/6iPidmSVuYBulMH7YuxUykyRTNN8jP3fOvlLbqYTTu4MC8fwBUDSCkonqOVzJoAJo0lx/0F8kolE3",
-	"6gJK5R6ZcVGgWJ//58GzW/x8D7ZrURY/t7ndeheJ5jJfJ72EF67j30lG71zBxCqTdUbWXEook8PR2/bv",
-	"4Q2ceKX/Q02dZyPkxLb9CrS03N7iWsC7YAagwoQOvcKWboIYq920WU1ahnKlCobztEUtWuY4LOWcKqGZ",
-	"iG/GYTe19X6rGAvuEw4tRYlumGm7MbbMNLcjCbSw3nmoL+TGwfLjhtQMNDpoxsUGL2bDN1UJeDKvQPMV",
-	"dlUSet0xhRqOHFWsYKZyn7AlJqxQzNZaMrVcRssAaYWGcjdnFTeGBnnklgVbnHv27PGjR0m1F2JnwkoJ",
-	"i2GZP7ZLeXyKTeiLL7JEpQCOAvYwrB9bijpmY4eE42tK/rMGY1M8FT9Q5CpaSd2tTfUkm9qnJ+xbzHzk",
-	"iLiT6h7VlSGJcDehZl2VihdzTG785uuzl4xmpT5UQp7qWa5QW9cl/6R5ZXqC0ZDZaSRzzvRx9qfycKs2",
-	"NmvKT6ZyE7oWbYFM0fO5QT1ejJ0T9oJUqE0Bf5qEYYpsvYEiqnZJj3gkDvcfa3m+Rt1kRwIa55XTC7EG",
-	"dtZabqLow6b6ETJsB7evxUqlWOdM2TXoa2EAI/LhCrrpEJvcoF43HtIjdpenaymJUk6OEEabWkfHoj0A",
-	"R5JscCpIQtZD/JGaKarHfGxd2gvslY7F6BW57Vn9Q3K9kGKbfe+NCzmXSoocSyGkJGlM3TbNTDmhakTa",
-	"vmhm/oQmDleytG4TC+yxOFpsNzBCj7ihyT/66jaVqIP+tLD1JddWYI3nbFDMQ6VrbxAT0oCvZuWIKOaT",
-	"SiecmpKBEI0DxZFkhFmZRjSc37hvP3j9NybFuBQSNV0ebf59Riar0gi0TEsmLFspMH493Wge89b1OcEs",
-	"jQVs35+8VCuRX4gVjkFudG7Z5DM6HOoseJB6j03X9rlr63PnNz933MFo0rOq8pOO10FPCpJ2K0cRnPJb",
-	"Co4kEXKb8ePR9pDbXtdvvE8docEVeq1BhffwgDCaWtrdUb52b0uiKGzBKKIymUBXyAQYL4UMJtT0BZEn",
-	"rwTcGDyvI/1Mrrmlt8MknvYGeDkSAIERymSDv+1Q/coBDiW4xjDH+Da2ZcBHGEfToJX4udyxcCgcdUfC",
-	"xHNeNq7TiaLeKFV5IarA4KJeme8U43CMOwshkx10HQzfa7pjNY5jb6KxHIWLuliBzXhRpFJbfYVfGX4N",
-	"QWKwhbxuilA10YHdHOVDavMT5UqaerNnrtDgltNFdfMT1BDX7g87jJl2Fjv8N1WBaXxnvNP00VG5wUO6",
-	"OC4x/zDKOCX1OprOjFhl0zGBd8rt0dFOfTNCb/vfKaWHcN0/RDRuj8vFe5Tib1+7iyNO3DvwT6erpcmr",
-	"i77gCr+HhEdNRsguV8KrbFBnDL0ecPMSW9YDPjRMAn7Fy5FI+NhWQvcr2Q/G4uHz0fQN3Pr0XJazvSxo",
-	"NOUR+Qr3rC9DE+KYfzC5B9+d1cKvdS9Cx21333UsdeQj1jKLUQvdzYxo7QYfa0X77mosRUKo04Hf43og",
-	"3otn7tPAw5VQdfC+Cj7Q4UlIv/oUPJ26HyPrT0YW/N5Wi1Ebyxtfv5aW6d/k3/1MVlgG0urdH8DiMtj0",
-	"flGZhLRL6qm2CWtKH04qhdi5FafUsEmVS/GyYdCVEWvp0NKg/MyArF5MEQcG+Pg4n50XR12YqZI7Mxol",
-	"dexeitXaYsb+vwEvQL86UJGgrUKAR6xSRrQVSEs3mE8Bu8bhTqYGGzgCFnFFheFYwQn1CnKLZWdb5zoN",
-	"cEx9BTdZMPr8b2WC8ed0E5PhCxLsq0IwrDV74I4fJE6Kkn9Rnc6T6Tn3zxoXaooAu+amTdfSi5meHLm5",
-	"XEKOWZH3Jqr6zzXIKAnSPOhlEJZllLdKNHFMmNf7eK1jC9C+PFJ74Ynq69wanLE49kvY3TOsQw3JwqFN",
-	"EN9NEgcjBsgEFnJIjymSvdeYMA1lIBaCS7BPxdwWxxjN+RylXbvhXIEk3cXRpmLbM2W66PmkuVzXo9I+",
-	"YkjOWC6rYc3k8ffHCyxRbbyDHG8SD8evdHY+LJxz7RMXY1qxxnYSUhiDCb+FHII0Sykuff0AxApZqq65",
-	"LkKLO0kKRXeTSAO9bGYWbQDH0MkhUYoBY6HyUjkxIhsLKOvGTDQOh/cMeYa2CXwQriVoDUVjEimVgcyq",
-	"EPCxD459qCD31xshwYyWPyLgRlNfv25ze2MZOI6prrn3eo0XyDRsuINORxm4x+fch+zn9D0E4YcyYAc1",
-	"TA29Hq5HG0J3hBkgMab6JfO35eHg/psom4SUoLNgeeqn45bdjGyYd7Ooc7qg44PRKOQm587Zw0qSepp8",
-	"uMreGyEKkr+E3Sk9gkIh37CDMdAkORHoUcLR3ibfqfrNpOBe3Ql4v28euUqpMhsxdpwPc4j3Kf5S5JeA",
-	"OQAbF/eRGu3sPurYG2v29XoXcmZXFUgoHpwwdiYpqCgYtrvlBXuTy3t23/xbnLWoKa2/V6qdvJPp6AxM",
-	"uK9vyc3CMPt5mAHH6m45FQ1yIEP1Vo653Fxjcv5uFc+Tqa/yoam5X0W+JSqCIiWTXJDF6jke9JTiCFMg",
-	"RLk60JDJmbd0MVOqlC/vTdI0uKHSmIonQ4AsyCnZAhoo/OBJBCTroidOIaW+80nv1JJpaI3IN83+Nyzh",
-	"nnrR92duZunyu6XS0CnG7npTps8m8AXTaOJ/FsJqrnc3ydE3KCE/0J6MYvmgO1bjidUupPXGGuKwLNV1",
-	"hswqa+pcpJ62rp3pXsah6Frbz53qBUR+Xdx4QW3H1rxgudIa8rhHOt6ToNooDVmp0M0rZYFeWid3bzDI",
-	"S7JSrZiqclUA1YtJU9DYXLWUHMUmiLxqkigg2sFoYeoT0fHEKd2dSnakDEWt1RG183OgyPU2qxMtOiNb",
-	"5ojHMhifxcljiBoP4d1T+z/Nm5dii3QDOnXkl8zqGubMt+jXyPYHn2tgG2EMgdLQ0rUoSwwcF9vI8to4",
-	"LqRROyL2nqNb5ZVA35tuEgGShit35zWZFWIecBGnPWJ2rVW9WkcJphs4w5NX1/5BHI/yk6nRPQojyNwU",
-	"T9lGGetfmjRSu+TW5ex+rqTVqiy7SikS0Vde0/49357luX2p1OWC55cP8F0rlW1WWsxDfHXfObCdSfdS",
-	"i3Uv4IzKmR9O1Uvt0FXOE+1kBtljcUcXdo/AfH+Ygx7WuZ8NF9ZfV5eZpp8xZ5JxqzYiT5+pP5e33aiP",
-	"XIpFJXOWUW1FyjKBzfCwx5dV41yBLHKIZpA8WRzujHlG4I3MyG7cf1EC74/LluAZzchFOWQuXorK8lFZ",
-	"rwcAQkqhz7bWVJAxlsQarqJWlCoBTeR9QCfeKuiJdDvY3Ah3DpSFWwE18H5sALxPyoc55ZYjT8qF2obv",
-	"D9rkczcC/uN+Ku8wjzEXr4uWtDQ5eYVENSMcIZ3ieq8/1BsMe19M9YpqiudOvOEjAMb9pDowTPKWOhaM",
-	"JRclFFmq9uJ5o6OaRy9tH5rVL4kujOfkOa9D6UM3dq3BJ04hEV937V8Vd6SkmuZDTbIsYAsU1/EraEU1",
-	"DeeR/QVKKnnYUwaoKivhCjruYz6bS42ipriC0Nc0nVkBUKE1sq8jS/lFxXd5T3Hi155FnjVTsJvUpBBi",
-	"aafYATVJUqmzlRkdEzP1KDmIrkRR8w7+zLEiR1cN6I5yAlWDN0IW3pFTp/mJRngdBjgL/VOiTMDE+2l8",
-	"6GgWlEbdPgZ00E+yNmOnXqbdJONURY2BBWcrGkMskXjLN0zFr+W4QnJI8u1za+I+CSUjxH69hRylGv/e",
-	"gcK/eEaMFD7rCVK7BCjoVeC6JLTta5BMqqjE5DU3zVOlzaEYfqCJsZGQ/jV9A6Ny6814+51lOBgzvWRq",
-	"ow8J3dDpzdXzv8tJ3HsQR8dL0YgBH/63R/8VqNs/O7ABlvKWbj+d7I9FGv0t5rn4nC3qMFBZqmuqGRm/",
-	"Q19AsIMS9QUTkBfLRXMtB6/NuU/v2Vd1iMhffcN3TGn8x706/1nzUix3yGcI/NCNmTV3JOQNr+QR4L1A",
-	"3cT7xat5ACxoW1SYitYtpo4ZDbdzo0RAu4s8FPdRbMMvId4GdHYg/plbxzhNvUDNhbuye9s5xIJffEjR",
-	"suFF/NLHRJHdMuohdbDr/X+1sXDxVCG/W1XyPFQI9SWKunwGqwAH4rJr2OwPlhzytUACTWXhlmh1iK4v",
-	"bqAyPZJ1pSIQxsqvdMAeVFwdVJ651TIman57NTb2hJlOWspd78JUr5sB0HGdxkPgx2UrPw3+kzlcx5Yx",
-	"Bfw/Ct5HCtXG8FJN2k+A5U4GjgSspK1eqG2mYWkOOZiQuto953WbuyOoWIXMNXBDHjfnP/qHZ5uiVEj3",
-	"ECaf0Mam2YxSwFLIllkKWdU28Y7BTKVyFyEsVvojWkdMaGNSghMmr3j54xVoLYqxjXOng0o6xiUigqHD",
-	"902oMJo7dTiAMO0bDuMzWzV63Mxd4FSEitw1jeWy4LqImwvJctDu3mfXfGdublFqjAOHbEo8kma6WQMi",
-	"6xKSNgFS7rxR+Jb2ngZAfoeGnwkGG/QLThhrSLVj1Yh9ZgjDn8Jgs+HbrFQrjCIcORA+Ny1a+OgJqCSq",
-	"wUk+m7buMI8Rv8L+aTAtv2dEVuGsU6bYf+5/xK3EZ+RPUti9J590lP2wTvK7pYMZkCpXrfM/EcvwPKYi",
-	"cX3ylTgaNwibIVQl0B5Emwgj9qGuXnxkF9ENwodxx0rw6eXOup4WqXhf0gxkqDEwe9z7wbSu7Dz37llD",
-	"VdpA1UBImfto6SM1baSfD/fSCHhUm96f9e60jcuMG+eYGnH746OzSlVZPsXnkyp3FN5M4CHtwjhCH5ER",
-	"YGTdjXuMaWrZdPIedYraHFsmb7SoziFrV5Xve/SPqYlGOHrXBKGWyMuocjtqtzCSp1GmzPsxZl01WMMk",
-	"GGca8lqjmvia7w6XHRvJGH3xt7PPHz/5+5PPv2CuASvECkybdbxXtqv1CxSyr/f5tJ6Ag+XZ9CaE7AOE",
-	"uGB/DEFVzab4s0bc1rQpRQdFy47RLycugMRxTJSLutFe4Tita/8fa7tSi7zzHUuh4LffM63KMl31oZGr",
-	"EgaU1G5FJhT3AqlAG2GsY4RdC6iwrUe0WaN6EHP/XlE2GSVzCPpjTwXCjrhcpRYy5lCL/Axju73ViMG2",
-	"Kj2vIkvPvnX5dxpp6FBoRK+YBbBKVV60F0uWgggjiHQUWesVn6gRj3xkG2ZL3rIpQvSe52nSiwtm7+f2",
-	"3WKuNs3p3SYmxItwKG9AmmP2ifG8BTfhJK1q/w/DPxKJGO6MazTL/S14RfJ9cLOi/JNAGwblJ8gDARiJ",
-	"tu3ESUaBYlEiYk1WArQnBANyX/z4vjUsHwwLQUhChwPgxeGzbbsmksGD8ztn9P2+QUq0lPdjlNBZ/qGI",
-	"3MB6m4sk2iKvNLEWDLElNRQLo3Br87yJYh55lQyCnbVSlrmXaVkmgqRJj4NnKiYc9yTQV7z89FzjG6GN",
-	"PUN8QPF6PDQqjpSNkUyoNDfL0/eST5o7ioq9u6nlKwzM/k9we5S85/xQ3gg/uM1QuYMV61fhVqBYb3aN",
-	"Y5KT1eMv2MIX26g05ML0jfvXQThpAkNBi6V3aIWtPRCJemidPyt7CzJeBk8c9kNk3mps9h7C9oj+zkxl",
-	"5OQmqTxFfQOySOAvxaPi4rwHrotbFma4WdqXKIHbkWlfhmWHpy6PUpu4S6c2MFzn5Nu6g9vERd2ubWrO",
-	"osn1Hd69e2sXU1INpWsxuO6Y6+hOijIcVZLhN8hyRDjyY/h5UxTz81jeW8rtOpKbu7cftSgPOqx0Mq1/",
-	"nM9WIMEIg7nE/+5rx3zauzRAQJkXhkeVYL1NuhhCTGKtncmjqaIc6hPSp/tuiZzXGNWY11rYHdYNDgo0",
-	"8fdkPqZvm9wePjdMY0vzd59Vl9DUbm8zgdQm3K7fKl7ifUQmPuluIVWesK8pw7c/KH+9t/h3+OwvT4tH",
-	"nz3+98VfHn3+KIenn3/56BH/8il//OVnj+HJXz5/+ggeL7/4cvGkePL0yeLpk6dffP5l/tnTx4unX3z5",
-	"7/ccH3IgE6Ahtf+z2f+bnZUrlZ29Os/eOGBbnPBKfAdub/CtvFRY19IhNceTCBsuytmz8NP/HU7YSa42",
-	"7fDh15mvzzRbW1uZZ6en19fXJ3GX0xWG/mdW1fn6NMyD1QY78sqr88ZHn/xwcEdb7TFuqieFM/z2+uuL",
-	"N+zs1flJSzCzZ7NHJ49OHvvS1pJXYvZs9hn+hKdnjft+ivk1T41PnX/axmol7Xav0WU9COd6BQW730Td",
-	"/FtjuTUPQvDOUpR4ZfzDEDE2qzgvkLh8jdIZVl1DZywE68mjR2EvvKQTXTinGP3x7MOsrW3fFyYGSH3T",
-	"ApyErK35OFz0T/JSqmvJMBkgHaB6s+F6RyvoYCMaHLeJrwwq2bW44hZm713vPs6ryhcsGEM5VrnqnvLQ",
-	"GQmkyXjvThglwvdlB0wK5cNiCbfE/t7kkIPJEruDjV45mEP6nCahojcIeZyhzZgQ1pwRUjsMED2fVXUC",
-	"nV9jYI3Zh7N5lISfoFFl0WB8gNFX9f8QjDrS9XfT7NkH99caeImJtdwfG0eoefikgRc7/39zzVcr0Cd+",
-	"ne6nqyen4RVy+sFnTPm479tp7BF2+qGTWKY40DN4PB1qcvohlMzeP2CnXLL3NY06TAR0X7PTBZbJmtoU",
-	"4tWNLwVp3px+wAf46O+nXoua/oiKELphT0OCppGWlIoj/bGDwg926xayfzjXJhov5zZf19XpB/wPku1H",
-	"Ou0lpDI5UYkOztrmcyYs4wulsQKzzdeOG4TSr8JELQdH/sz1ek4QhEr66F40e/Z2GP+FA7EwEooo7v5t",
-	"JYjOTK2QiOaUiCk0InCnfSsIv32Uffn+w+P540cf/8UJuv7Pzz/7ONF7/nkzLrtopNiJDd/fkuMNdDbt",
-	"ImmTGgY2fGR4WhiP7/Fb1RuINcg4UN+xN/zwrYQM+Okd8vhu3uEEf/+KFyykScC5H3+6uc8l+Yg7QZUE",
-	"6o/z2eefcvXn0pE8L4NIdkPh7YwOf8wUmN/slPA2n0klo2SKckVihkqlqhjhN8byG/CbC9frf/lNp+HA",
-	"yodxeKRt9cXdI78eukyaWnYQMsyG2AJeXHGZh2CsNjoC94skb08YjQNubWBZlyENSVWKJdXMV6oME5m6",
-	"qhzHWXLTUJYPyXAPZsqi0AzNapkrSa5TGP0SDMCYDQGNyOZSVJ0uYumoyldzp0isk7Dp/6xB79pd3wj3",
-	"8h28mVrnvt+ShRMe74CFdwe6Yxb+5Eg2+udf8f/sS+vpo798OghC8qI3YgOqtn/WS/OCbrBbXZpehqf6",
-	"G6d2K0/Rvfv0Q+e54j8Pnivd39vucYurjSogPCHUcmlQtbLv8+kH+jeaCLYVaLEBSWX5/a90c5xiUfjd",
-	"8OedzJM/DtfRycs88vNp0KimXsndlh86f3ZffmZd20JdUx3TpLyC1ycv2YZLvqIg/kYJ6e5BP0CbMpr9",
-	"WDUXlY/dZRzL76natlpiCmXxAf2NHR9vtMabayUkToAGWZyFL11XHl3gvgLmUId44SH7QRUwlI1SF6GH",
-	"sXMZNkchVWvy/d1oJyPG+/G4g4KGY/J6GJKR+1ib/t+n11xYJ0H53M2I0WFnC7w89YXaer+2tVEGX7Dg",
-	"S/RjnJUg+esp756LrgbFbdlYx4F6JfXVaxBGGoVgmvC5Nd7ExhAkl8YM8va923UD+ipQUqvbf3Z6itGV",
-	"a2XsKUqiXb1//PF9s9GhBHWz4e7bNlNarITkZeaVZG21ydmTk0ezj/8nAAD//1Xaw+sdDwEA",
+	"H4sIAAAAAAAC/+y9e3cbN7I4+FVweH/nOPaPlGzHyZ14z5y7ip1kvHESn8jJ7N3YOwN2F0mMmkAPgJbE",
+	"eP3d91Th0ehuNNmUZGcyN3/ZYuNRKBQKhXq+mxVqWysJ0prZ03ezmmu+BQua/uJFoRppF6LEv0owhRa1",
+	"FUrOnoZvzFgt5Ho2nwn8teZ2M5vPJN/C7Gnafz7T8M9GaChnT61uYD4zxQa2HAe2uxpbx5GuF2u18EOc",
+	"uSFePJ+93/OBl6UGY4ZQ/iCrHROyqJoSmNVcGl7gJ8OuhN0wuxGG+c5MSKYkMLVidtNpzFYCqtKchEX+",
+	"swG9S1bpJx9f0vsWxIVWFQzhfKa2SyEhQAURqLghzCpWwooabbhlOAPCGhpaxQxwXWzYSukDoDogUnhB",
+	"NtvZ019mBmQJmnarAHFJ/11pgF9hYbleg529necWt7KgF1ZsM0t74bGvwTSVNYza0hrX4hIkw14n7LvG",
+	"WLYExiX78etn7NNPP/0CF7Ll1kLpiWx0Ve3s6Zpc99nTWckthM9DWuPVWmkuy0Vs/+PXz2j+c7/Aqa24",
+	"MZA/LGf4hb14PraA0DFDQkJaWNM+dKgfe2QORfvzElZKw8Q9cY3vdFPS+X/TXSm4LTa1EtJm9oXRV+Y+",
+	"Z3lY0n0fD4sAdNrXiCmNg/7ycPHF23eP5o8evv+PX84W/4//87NP309c/rM47gEMZBsWjdYgi91irYHT",
+	"adlwOcTHj54ezEY1Vck2/JI2n2+J1fu+DPs61nnJqwbpRBRanVVrZRj3ZFTCijeVZWFi1sgKjKHRPLUz",
+	"YVit1aUooZwzIdnVRhQbVnDjhqB27EpUFdJgY6Aco7X86vYcpvcpShCuG+GDFvSvi4x2XQcwAdfEDRZF",
+	"pQwsrDpwPYUbh8uSpRdKe1eZ4y4r9noDjCbHD+6yJdxJpOmq2jFL+1oybhhn4WqaM7FiO9WwK9qcSlxQ",
+	"f78axNqWIdJoczr3KB7eMfQNkJFB3lKpCrgk5IVzN0SZXIl1o8Gwqw3Yjb/zNJhaSQNMLf8BhcVt/7/O",
+	"f/ieKc2+A2P4Gl7x4oKBLFQJ5Ql7sWJS2YQ0PC0RDrHn2Do8XLlL/h9GIU1szbrmxUX+Rq/EVmRW9R2/",
+	"Fttmy2SzXYLGLQ1XiFVMg220HAPIjXiAFLf8ejjpa93Igva/nbYjyyG1CVNXfEcI2/LrPz+ce3AM41XF",
+	"apClkGtmr+WoHIdzHwZvoVUjywlijsU9TS5WU0MhVgJKFkfZA4mf5hA8Qh4HTyt8JeAIeQAcIaeBI+E6",
+	"QzN4uvELq/kaEpI5YT955kZfrboAGQmdLXf0qdZwKVRjYqcRGGnq/RK4VBYWtYaVyNDYuUeHYZy5Np4D",
+	"b70MVChpuZBQMiEd0MqCY1ajMCUT7n/vDG/xJTfw+ZPZ+0NfJ+7+SvV3fe+OT9ptarRwRzJzdeJXf2Dz",
+	"klWn/4T3YTq3EeuF+3mwkWL9Gm+blajoJvoH7l9AQ2OICXQQEe4mI9aS20bD0zfyAf7FFuzccllyXeIv",
+	"W/fTd01lxblY40+V++mlWoviXKxHkBlhzT64qNvW/YPj5dmxvc6+K14qddHU6YKKzsN1uWMvno9tshvz",
+	"WMI8i6/d9OHx+jo8Ro7tYa/jRo4AOYq7mmPDC9hpQGh5saJ/rldET3ylf8V/6rrC3rZe5VCLdOyvZFIf",
+	"eLXCWV1XouCIxB/9Z/yKTADcQ4K3LU7pQn36LgGx1qoGbYUblNf1olIFrxbGcksj/S8Nq9nT2X+ctvqX",
+	"U9fdnCaTv8Re59QJRVYnBi14XR8xxisUfcweZoEMmj4Rm3Bsj4QmId0mIikJZMEVXHJpT2bz3JlsD/Av",
+	"fqYW307acfjuPcFGEc5cwyUYJwG7hvcMS1DPCK2M0EoC6bpSy/jDJ2d13WKQvp/VtcMHSY8gSDCDa2Gs",
+	"uU/L5+1JSud58fyEfZOOTaK4QvXSEryogXfDyt9a/haLuiW/hnbEe4bRdqKy5v08osEYsHdBcfSs2KgK",
+	"pZ6DtIKN/+LbpmSGv0/q/PsgsRS348SFrZjHnHvj0C/J4+aTHuUMCcere07YWb/vzcgGR9lDMOZFi8W7",
+	"Jh76RVjYmoOUkECUUJPfHq413828kLggYW9IJj8ZcBRS87WQBO0cn0+SbfmF2w9FeEdCABPfRY6WaNBW",
+	"heplTo/6k4Ge5XdArbmNDZKoYZxVwlh6V1NjtoGKBGcuA0GnpHIjypiw4XsWEWG+0rx2tOy/OLFLSHrP",
+	"u0YO1ltevBPvxCzM7ed0owmqG7Plg6wzCwl+6MPwZaWKi79ws7mDE74MYw1pn6ZhG+AlaLbhZpM5OD3a",
+	"bkebQt/YkGiWLZOpTuISX6q1uYMlVuoY1lXXz3hV4dRDltVbLQ086SBXFcPGDLbC2vbh6DTs7v3FvuLF",
+	"BsUCVvCqmreqIlUvKriEiinNhJSo7bIbbtvDTyOHdw2dIwPI7CywZDVezUQqNh11ERrYltMNtMXXTF11",
+	"+0QOavgWelIQ3YiqIS1C8tB48TysDi5BEk+KQxP4cY2krUkHP2Fn8RPNLJVbnNMA2mC+i/iL/KIDNLZu",
+	"71PZTqF06XTWFn8TmhVKuyHcDe8nx/8A121nR52f1BoWfgjNL0EbXuHqeou6H8n3rk7ngZNZcsuTk+mp",
+	"MP8Ac5yD+pF4BzqjpfmB/sMrhp9RikFKaqlHkDCiEnNq6S5mRJWbCRuQvlWxrVNlMtQvHgXls3byPJuZ",
+	"dPK+ctpTv4V+EXGHXl+L0tzVNtFgY3vVPSFOdxXY0UAW2ct0krmmIOC1qpljHz0QHKeg0RxC1PWdX2tf",
+	"quscTF+q68GVpq7hTnZCXbv/TGL2X6rr5x4ypQ9jnsaegnRcoORbMHS7yZRx4iytXe5sqfTNpIneBSNZ",
+	"a21kHEdNhKl5D0nUtKkX/mxmLBauQW+g1sFjvxDQHz6HsQ4Wzi3/AFgwlifA3wIL3YHuGgtqW4sK7oD0",
+	"N1khDvXDnz5m5385++zR4789/uxzJMlaq7XmW7bcWTDsE6+WY8buKriffR2RdJEf/fMnwUbVHTc3jlGN",
+	"LmDL6+FQzvblXr+uGcN2Q6x10UyrjgBO4oiAV5tDO3NmXQTtOSyb9TlYiy/dV1qt7pwbDmbIQUeNXtUa",
+	"BQvTtRN6aem0xCancG01P62pJciSaJ7WIQw3BrbLOyGqsY0v21lK5jFawsFDcew2tdPs0q0SplBSQmFf",
+	"Aeg7WGUZB4QRHUBrbqwBtGFpjwlv/s4Ek1Z/cE7Eg97p5i7UPKC10llRpNbKqkJVC5R3hcooal75Fsy3",
+	"CGRb93930LIrbhjOTVbcRpYj+hg0z06+x93Qr69lSyN7b3K33szq/LxTdqiL/PY1VoNe2GvJ6JR21EQr",
+	"rbaMs5I60gZ+A9bJoWIL55Zv6x9Wq7vR+ioaKEPLYgsGZ2KuBROSGSiUdE6NB8jYjzoFPX3EBGubHQfA",
+	"Y+R8JwsyGd4F+xrX6m2FJP8Fs5NFouJDGCso16An4GO6Km8MHW6qeyYDDqLjJX0mm8VzqCz/WunXrRj/",
+	"jVZNfefXVH/OqcvhfjHeKlJi36AOF3JddR1p1wj7SW6Nv8mCnkVlilsDQU8U+VKsNzZ5N7/S6gPIBtlZ",
+	"coDSB6c0q7DPUHX2vSqRmdjG3IFI3Q7Wcjik25Sv8aVqLONMqhJo8xuTF7ZHXC/J54tc1Wwqv5OeRhi2",
+	"BKSugje4WjRxq9x90XZc8MKd0AWhxhy60F0rN51z66s08BKVYiCZWnpfD++FQovk5EVmg7jqRf0Mv+jA",
+	"VWtVgDFoTnOa74OghXbu6rB78ESAE8BxFmYUW3F9a2AvLg/CeQG7Bfk8GvbJtz+b+78BvFZZXh1ALLXJ",
+	"obevVxxCPW36fQTXnzwlO6exdFTLrKLXSQUWxlB4FE5G968P0WAXb4+WS9DkWvNBKT5McjsCiqB+YHq/",
+	"LbRNPeLJ79UVKOHhhkkuVRCscoNV3NjFIbaMjdK1GFxBwglznJgGHhG8XnJjnTuYkCXpdt11QvNQH5pi",
+	"HODRZwiO/HN4gQzHLpQ0IE1j4nPENHWttIUytwayTI/O9T1cx7nUKhk7vnmsYo2BQyOPYSkZ3yPLrcQh",
+	"iNtoh/aW7eHiyLcA7/ldFpUdIFpE7APkPLRKsJt6M48AIkyLaEc4wvQoJ7pQz2fGqrpGbmEXjYz9xtB0",
+	"7lqf2Z/atkPicsYempOVCgwZknx7D/mVw6zzY99wwzwcwdWA1FrOb20IMx7GhRGygMU+yqcnHrZKj8DB",
+	"Q9rUa81LWJRQ8V3GScJ9Zu7zvgFox9vnrrKwcA7J+U1vKTn4f+4ZWtF4Gab5vWL0hRV4BPEp0BKI731g",
+	"5BJo7Bxz8nR0Lw5Fc2W3KIxHy3ZbnRmRbsNLhdq5QA8EsufoUwAewUMc+uaooM6L9u3Zn+K/wfgJQpsb",
+	"TLIDM7aEdvyjFjCiE/exXsl56bH3HgfOss1RNnaAj4wd2REF/SuurShETW+dvwCv7M3cLiYpsjqTfQs7",
+	"N19Gn5VVGG6odZAk6nQsFFnoOduf4c5fsv0Jsv4QrATLBeqOkw/uVdsF23kG98c0H28HpuD+LHpfjeAc",
+	"QN+FcZPUwNNXAnDYpumGnKqRjupnp5E+cYsj74lELXUXeofMqEy4MDlcR/DSx+dS2gSueWGrHeMkMO3Y",
+	"FWhgplk6t5uhDdCqepEOkLUp7pnRexRk7fl7XRzOaahkeTn/SPd+2w/f694jroMO/26rlaomaDMHyMhC",
+	"MMnfidUKd134kL0QtBWOSQdIf8FWuwCuv9ZTNNMK2H+rhhVc0vO4sRDlT6VJqMO+NIMwyZzeobbFEFSw",
+	"Bffqpy8PHvQX/uCB33Nh2AquQpzrgwdDdDx44A7BRklYKnUXPj8grRZHODHEub+SVu8O2z788FPPfB2G",
+	"Z76nW7AytsMq74K9cW1fZGQbsi6jVJa92FxUy36zox95ypJf9QYPkxITMcafVFz+rTlejxVdT1l7eiim",
+	"uWTa64krf9114husm/b9XGybitu7MC3DJa8W6hK0FiUcpHI/sVDyq0te/RC7UdAyFHgoC1gUFGo7cSx4",
+	"jX1cdC6OI6SwIkTmTAUIXrhe567TAf1H604utlsoBbdQ7VitoYDSmYSEYSYu9YTRsKzYcLmm16xWzdp7",
+	"oLtx6IbDIHAKu23kYIisxG+v5YIsMLkbz/uShrhklPWBo76hb75xr+srHufzoehTuFayB31zVtaCO5+N",
+	"qmMQqZetOsYhpxtcPeH26zxGEvy0E0+08xHqUJId4ivdFjxMuLkfxp7UDp2Dcjhx4pbffhzzzEddULW7",
+	"AynPDcQ01BoM3cmpDtW4r2qVJlII/rw7Y2E7NDO5rn8bOX4/jiozlKyEhMVWSdhlcwcJCd/Rx1xvJxeM",
+	"dCYJbaxv/4Hcgb8HVneeKdR4W/zSbvdPaN+car5W+q7s9W7AyaLPBPP4QXnIT3lTIz76iw/t3j7Mus8A",
+	"zDx61AvNuDGqECSkvijN3B00byr3Mdld9L+KwWN3cPb64/YMvGkGDzJgQFUzzopKkHlDSWN1U9g3kpMC",
+	"NVlqxtMyaIrGVerPQpO8Dj+jYvdDvZGcvGyjWjXrTbSCjA7xa4CgWTfNeg3G9h53K4A30rcSkjVSWJpr",
+	"i8dl4c5LDZrcHU9cSwymWCFNWMV+Ba3YsrHd5w5lETAWFfTO2ozTMLV6I7llFXBj2XcCfZlwuOCREo6s",
+	"BHul9EXEQv52X4MEI8wi7xH6jftKwTd++RsfiIP/952DZ3ib1mSGy+xkMvp/P/mvp5jBiC9+fbj44n+f",
+	"vn335P39B4MfH7//85//v+5Pn77/8/3/+l+5nQqwi3IU8hfPvSrgxXN67yXxNH3YP5pxaivkIktkqatR",
+	"j7bYJ5TPxRPQ/a7m1m7gjUQ/MqswnZAoub0ZOfRvmMFZdKejRzWdjehpasNaj3xU3ILLsAyT6bHGG0tR",
+	"QyfqfDYJ3MiQIAJbsVUj3VYG6dsFSwfnR7Wax4whLpngU0bpJDY8eGL7Px9/9vls3qaBiN9n85n/+jZD",
+	"yaK8ziX7KOE691ZMI5nuGVbznQGb5x4Ee9bP0zkepcNuAbUqZiPqj88pjBXLPIcLcYVeyXYtX0gXhYPn",
+	"h+zvO2/WU6uPD7fVACXUdpNLMtYR1KhVu5sAPZ8oDHkGOWfiBE76Sq4S34ve47QCvgre41qpKa+heA4c",
+	"oQWqSLCeLmSSYiVHP70YJH/5mzt/DvmBc3D158y53d/75qvX7NQzTHOPsOWHTjKFZJ7S7kPXW84y3gn8",
+	"fCPfyOewIu2Dkk/fyJJbfrrkRhTmtDGgv+QVlwWcrBV7GoKmn3PL38iBpDWa/TTJbMDqZlmJAq0TOfJ0",
+	"Ge2GI7x58wuqsd+8eTtwHBo+H/xUWf7iJligIKwau/D5uBYarrjOGWZNzMdEI1PvvbM6IVs1TiPsx2d+",
+	"/DzP43Vt+nlZhsuv6wqXn5Ch8VlHcMuYsSoGjQoT4+5xf79X/mLQ/CroVRoDhv19y+tfhLRv2eJN8/Dh",
+	"p8A6iUr+7q98pMldDZO1K6N5Y/pKFVq4e1ZSQMmi5uuc/ffNm18s8Jp2n+TlLW4BCrrULcVJjAKiodoF",
+	"BHyMb4CD4+gIflrcuesVcq/ml0CfaAu7WRJutV9Jkosbb9eBRBm8sZsFnu3sqgySeNiZmJJxzYU0wVUI",
+	"LVd4CHz2yiWqFKG48GkFYVvb3bzTXa06gmZgHcK4hJMuDJhSnpFFBhNR1iX3ojiXu37uKePCnmjQH+EC",
+	"dq9VmzHtmGRT3dxHZuygEqUm0iUSa3ps/Rj9zfcujyEa3KcQogjrQBZPI12EPuMH2Ym8d3CIc0TRyc0z",
+	"hgiuM4igDmMouMFCcbxbkX5ueUIWIK24hAVUYi2WuVzZfx0aAAOsSJU+Pah3kY8DGrQJCmvY0l2s/nmv",
+	"UcfOOPk+1crwyqU+znoU0XtoA1zbJXC7V88v06wxATrsz67wZDkN3xyXANe438KSxk7CFZReUeTaeNf6",
+	"k3HnSAc4lDeEJ3RvXwono29dj7pMWtBwK0fsxmet9xtN6ez1Jn7fAuUVVle4LwiF8ilxXeal5H5pDF/D",
+	"yNsltd5NTFrTsfjRIIckkqwMQmF6HVFjIAlkQXaNF7jm7BkG/IKHmJ6ZPW/hMJOziHubEWW69whbViTA",
+	"Rrdqt/dcd6yocr0PtDxrAS1bUTCA0cVIehw33ITjWM4TLjtJOvuAuZn25Y98kTi6JpmLY3bIcBv2Oejg",
+	"3e+zSIbUkSFfZPron5D7cT5zDCC7HUqSaFpCBWu3cNc4EEqb1azdIITjh9WKeMsi5zObKKgTAcDPAfhy",
+	"ecCYs42wySPkyDgBmzw9aGD2vUrPplwfA6T0Wdl4GJuuiORvyEeduigSFEZVjZerGLE3FoED+HwxrWTR",
+	"c/enYZiQc4Zs7pJXIG14i7eDDNIY0oOil7TQ+xrdH3to7DFNuSv/qDVRjxutJpVmA9B5UXsPxEt1vXBp",
+	"BLJvkeX1Euk9G1iDvbIH0yWMvGfYUl2Tcx5dLS6Q4wAs43AEMFoAKBMgrp36jclZDph90+6Xc3NUaNgn",
+	"UepsyWVM0Jsy9YhsOUYunyQ5IG8EQE8N1RZU8WqJg+qDrngyvMzbW23e5jYOMYu54z92hLK7NIK/oX6s",
+	"m7XxL212zvEMgL7Rx0lXOdQs3SaNqOtMgJijsoj2yaEDxB6svurLgVm0dlr18JpgLcdKmJAZo+QQbQYq",
+	"oEfwoiOaLi5gl3/LA93j56Fboqyj3eNydz/xmNSwFsZCazQKfkG/hTqeU45zpVbjq7O1XuH6flQqXv7U",
+	"0SnjO8v86Cug8JCV0BiHgBa37BKw0deGlEhfY9O8BNrZbOYqgogyz3FpWowoLEXV5OnVz/vtc5z2+3jR",
+	"mGZJt5iQzkFrSRVssm7oe6Z2gRd7F/zSLfglv7P1TjsN2BQn1kgu3Tl+J+eix8D2sYMMAeaIY7hroyjd",
+	"wyCTbAhD7phIo4lPy8k+a8PgMJVh7INeaiEnw9jN70bKriXJ1ZkPX1XrNYbxuRRcwR4mk0yPlZLrpNRa",
+	"Xe9LbHmC+f2NTw+5J7OkjzuAsaiDRNxfCLTY5qFPmjnI27BPyopJk6CZnnLp5NVCan0gpoFaJLq6j2wL",
+	"7Uc8ZJ2gX/eM2a13stuluJ20ARXw0r9JDIT17T+Www3xqJuPuU930hPvP0I0INGUsEn1oWGOjBEGzOta",
+	"lNc9w5MbdVQJxo/SLo9IW8Ra/GAHMNB1gs4SXCffvXe19gr2U3rznuKrzPlee8dipG9e+OwQZaPJgtHx",
+	"bB4WV4hvtYlr//bnc6s0X4O3Qi0cSLcagpZzDBqS0gWGWeHcSUqxWkFqfTE3sRx0gBvo2MsJpJshsryJ",
+	"phHSfv4kR0YHqKeF8TDK8hSToYUxm/zroZXLt01VSfFKSLbmBqaqbC6Jb2G3+BmVDqzmQpvWPdebnbqX",
+	"7xG7frn9FnY08kGvVwTswK6Q5ulHIBrMafrjJ5Nkmb9nUoy552VnC4/YqbP8Lt3R1vjKKePE394y6Yp6",
+	"S7nNwWidJBCWKbtxnvdNwNMDXcT3SfnQJojysAySyPvpVMKEOrPDqygmSjlEu5jtMRAvLWf2fj67nSdA",
+	"7jbzIx7A9at4gWbxTJ6mzjLccew5EuW8Rv8tXi28v8TY5a/Vpb/8qXlwr/jIL5k8Zb/+6uzlKw8+mqQr",
+	"4HoRNQGjq6J29e9mVa7Wyv6rxKXk94pOpylKNj+mTU99LK4o/X5P2TSoXNT6z7TjBZ+LVd7h/SDv864+",
+	"bol7XH6gjh4/rc2TOvecfPglF1UwNgZoR5zTaXHTyl9luUI6wK2dhRKfr8WdspvB6c6fjpa6DvAkmusH",
+	"ypuaf3FIn1WVWJF3/uF3Lj19rXSH+fvIxKzz0IcTq1DIdngc8dUORWb7wtQJc4LX39d/x9P44EF61B48",
+	"mLO/V/5DAiD9vvS/0/viwYMh0O62yzMJ0lJJvoX7McpidCM+7gNcwtW0C/rschslSzVOhpFCnRdQQPeV",
+	"x96VFh6fpf8FzbH408mUR3q66Q7dKTBTTtD5WCRidDLdurq2hinZ96mmIFgkLWL2vm6KM8YOj5BstmTA",
+	"XJhKFHnXDrk0yF6lc6bExowaj2hrccRGjPjmykYkY2GzKQl9e0Amc2SRabI5hVvcLZU/3o0U/2yAiRKk",
+	"xU+a7rXeVRceBzTqQCDN68X8wNQnGf42epA99qagC9qnBNlrv3sebUphobnKXEd6gKczDhj3Hu9tTx+e",
+	"ml0026brgjntHRMMeln1gbcgBkbnjXUjc7RFQKmfy/YjzGKl1a+QN4SQ/SiT+cNPRM8R6p3z3OuzlGhU",
+	"DutJZz+03dPfxmMbf+u3cFh0LA14k8s0f6qP28ibPHpNPpf4fJYeyTxc7iPrhgaMsBY6XokzLNUqCt5H",
+	"XLrz5LJAdCLM8qcyaWFO3fjtqfQw93e1qPjVkhcX+bcQwpRsb8dPyioWOocNMDHHgZudJR7csa1waQ5r",
+	"0K0NYpgy+YbvGjft5BdN+4DBjp2ny9y5KVRGZYZp5BWXFoIbg+NXvrcBZ4LHXldKU5JSk3fpKqEQ26w6",
+	"9s2bX8pi6L5TirVwVewbA0mZdD8Qc5lQiYp8qfmYucOj5sWKPZy3ZzLsRikuhUFHZmrxyLVYckPXZTSH",
+	"xy64PJB2Y6j54wnNN40sNZR2YxxijWLx7UlCXnRMXIK9ApDsIbV79AX7hFwyjbiE+4hFLwTNnj76ghxq",
+	"3B8Pc7dsCSveVHYfyy6JZwdn7Twdk0+qGwOZpB8173290gC/wvjtsOc0ua5TzhK19BfK4bO05ZKvIR+f",
+	"sT0Ak+tLu0nm/B5eJDUqwVitdkzY/PxgOfKnkZhvZH8ODFao7VbYrXfcM2qL9NTWQHeThuFO6Gw4nh7h",
+	"Ch/J/7UO7n89XddHfsbwbZ4eOHkpf0822hStc8ZdZtpKtJ7poaguexESX1OVu1jczuEG58KlkyyJW0gF",
+	"lYS0pP9o7GrxJ3wWa15YypE3Au5i+fmTTLW4bkEleRzgHx3vGgzoyzzq9QjZB5nF98UoeLnYCmT199sc",
+	"C8mpHHXUzU5rx/xC9w89VfLFURaj5NZ0yI0nnPpWhCf3DHhLUozrOYoej17ZR6fMRufJgze4Qz/9+NJL",
+	"GVulc9Us2uPuJQ4NVgu4hHJ0k3DMW+6Fribtwm2g/239n4LImYhl4SxnHwKJRXNfsDxK8T9/16blJ8Oq",
+	"i0Ts6QCVzmg7vd7uI3sbHqd169tvncMYfRvB3GS00ShDrIx439PPbZ/fwl+oD5Lb847C8dHfmcY3OMnx",
+	"Dx4Q0Kh3dE3//rj72bH3Bw/y2bGzKjf8tcXCbV7E1De3h1g99em7kdKi0aHI50cY7t/oJYUfkAku/VBz",
+	"1i3j+PGliLuJ78p7m+ZPATqX4peAB/qjj4jfmFnSBrZRCuOHvVvGNksyZfye+Llz9qW6nko4vTsoEM+/",
+	"AIpGUDJRPUcrGZTpzZrrD/qLJDSKoy4B3UtNp2JVqs///eAZFz/fg+1GVOXPbW633kWiuSw2WS/hJXb8",
+	"m5PRO1ewY5U5rKHFUUKVHc69bf8W3sCZV/o/1NR5tkJObNsvE+2W21tcC3gXzABUmBDRK2yFE6RY7abN",
+	"imkZqrUqGc3TVlxpmeOw3nquzu2QBN2w28Z6v1WKBfcJh1aiwv+N2I2p5UJzO5JAS1Mc46odES4BLVX0",
+	"YHOjg2ZcbOliNhzLYNHJvAT0D8SuSkKvO6VQo5GTcirM1PiJWlLCCsVsoyVWnUyWAdIKDdVuzmpujBvk",
+	"IS4Lrmnu2dNHDx9m1V6EnQkrdVgMy/yhXcqjU2rivvgKYK5OxVHAHob1fUtRx2zskHB8wdN/NmBsjqfS",
+	"Bxe5ip3p1nbFTmOB4hP2DWU+QiLu5PZHaGIS4W5CzaauFC/nlNwYPXOYm9X10UCIomKra4S/R/5Z88r0",
+	"BKMhs9NI5pzp4+xP5YGrNnYRa6PmchNii7Z6q+j53JAeL8XOCXvuVKgmKOjcJIxSZOstlEkpVveIJ+LA",
+	"/1jLiw02UB0JaJxXTq8SHNhZa7lJog8vw0di2Ai3LxTs6gTPmUIF8pXAdMUbbuESuukQAxhBNx7SI3aX",
+	"pxspHaWcHCGMxkJcx6I9AEfjRqeCLGQ9xB+pmXJF048tmnxOvfKxGL0KzD2rf0iuF1Jss++8caHgUklR",
+	"UO2HnCRNqdummSknlMnI2xfNzJ/QzOHK1n2OscAei6OVoOezDuKGJv/kK26qow73p4VrXw9wDdZ4zgbl",
+	"PJSj9wYxIQ34UmtIRCmfVDrj1JQNhIgOFEeSEWVlGtFwfo3fvvf6bzyC7EJI0nR5tPn3mTNZYR4LpHbJ",
+	"hGVrBcavpxvNY37BPieUpbGE67cnL9VaFOdiTWM4NzpctvMZHQ51FjxIvccmtn2GbX3u/Phzxx3MTXpW",
+	"137SbERr3OFcdfJRBOf8loIjSYLcOH462h5y2+v6TfcpEhoWVWDGQk338IAwYqH37ihYUqFxFEUtmIuo",
+	"zCGlEjIDxkshgwk1f0EU2SuBNobO60g/U2hui02HDR1yGB0JgKAI5eLiLobqbTChhNYY5hjfxrZG/Qjj",
+	"iA1aiZ/LHQuHAqk7ESYw/DG64g4rzpNU5YWokoKLejXoc4wDGfcihEx20HUwfC92p2ocx95EYzkKl025",
+	"Bov573Kprb6kr4y+hiAxrAjSxJJiMTqwm6N8SG1+okJJ02z3zBUa3HK6UhhuDGyXVcZt9Hn8CGXcYaQ0",
+	"tKzgv7mSU+M7452mj47KDR7S5XGJ+YdRxjmpF2l6gfmXpmOC7pTbo6Od+maE3va/U0oP4br/EtG4PS6X",
+	"7lGOv32FF0eauHfgn+6ulphXl3zBFX0PCY9iRsguV8Jvw8Jq5PVAm5fZsh7woWEW8EtejUTCp7YSd786",
+	"+8FYPHwxmr6BW5+ey3K2lwWNpjxyvsI968vQhDjmH+zcg+/OauHXuheh47a7bzuWOucj1jKLUQvdzYxo",
+	"7QYfa0X79nIsRUKo00Hf03og3ovHeWvVGi6FavyGRR/o8CR0v/oUPJ26HyPrz0YW/NZWi1Eby2tfXNkt",
+	"07/Jv/3ZWWGpmtzuX8DiMtj0flGZjLRLLRKC9U/ggdZs5FHbuRWn1LDJlUvxsmHQlTnW0qGlQfmZAVk9",
+	"nyIODPDxfj57UR51YeZK7szcKLlj91KsN5Yy9v8FeAn61YGKBG0VAjpitTKirSdb4WA+BeyGhjuZGmyA",
+	"BCzSigrDsYIT6iUUlmoit851GuCY+go4WTD6/FGZYPw5HWMyfEGCfVUIhpWDD9zxg8RJSfIvV5j0ZHrO",
+	"/bPoQu0iwLBQXkzX0ouZnhy5uVpBQVmR9yaq+usGZJIEaR70MgTLKslbJWIcE+X1Pl7r2AJU8RvCU/G7",
+	"A2csjv0CdvcM61BDtnBoDOK7SeJgwoAzgYUc0mOKZO81JkykDMJCcAl23aEtjjGa8zlJu3bDuQJJMp6m",
+	"YtszZb4i/6S5sOtRaR8pJGcsl9VItfTcEVeakh87D/Q0giZTcxwh7+Y0k2unmA0DCOvU9UwLc0H2TOJ6",
+	"pqFU/NmUT0sDo9lI2rS1rpmfTFQVnoQ4amRDqEX2FKJdIn+cXYx4y7sxFxTIkAcgOYApavAkrlUspuFm",
+	"xJmUZjH3Of69hBX5ISZLoBX4o9yiBVP6MvJZXfWTtUtFtgPX+wYHnxe24VU8dyMJwZIssXiuujmqY/5z",
+	"FQxLhBi0qjrsmJvA9VFvh+uayrPfCg3CsDAOHllK/nsYNXNf00ZYw8yG6yjwt8nrL+AG+IuEtpB4z5f7",
+	"j9CKoxWl88gqNryqQK7BnSqKgImDBtK1JHyRJQMXsNa8AFaDFqrMH6oWLJ9gNvdGbKehIxGqtwVgE6kC",
+	"ke5qAvYTJyZz3v5um1JToceRQkOCPvb3AUbIDB0CfTkRauXOMIAw2z3VEz7yPXmgWMMIC4y9yDRHKeyV",
+	"dq7fSFhC3oCiJ5RqGIEmdArLvVuQPsC1Pp+1Mt8o7QYxUEno86G2d2AjeBnlicrdMkYoufDEePi23Sqv",
+	"sfGj+28m1PCjG9Y44p5C2rmUQ60sk+AiexSzawj39yzDCnNsKHsJZK7HrDwFOQnhjNUAusOqCiVluCCO",
+	"eBB140rBjSuMD4BD+cbVnKk3SsJSqYs0owiXTDV2rVDguIKlQb8uS0M4XZuGrbLANsqEwF4hC7XF5soX",
+	"WIlzuqhmzl49fkU/ZJklqVoXoWjFwYsUW4c7LyYcDb3dezfMf4MT6oAZlyEPAWKSRP03BCFu+mKMiVqx",
+	"hVCuV/r81cgrwGAQjDAbV/uDRRc9PATUAWpVbPIcZAVUbGOEnMJXxqVUjSxaKTmscrpRxyMLbV18RN2q",
+	"oeJ4aKMXXw06mJAY9euTqiNQIVPfROLbW+CmScriJDirQRP+ETtbJYULDSOWpVwMKmukFVUcI4+5rVkv",
+	"1GqBv2gwtmPq2kdBYT0oDPm+H4CiyZdrMkzU+kNA4fdpvLZUy/eksMKHwafblb+OEJ7FmMRWiWX9uD6C",
+	"F9VaKC3sbj+YgZ9yFtr3R0wB9FfB5C0IHVjNd+i39AF2Ax1hpoLTLUN715CMOjJ6R+VODfJYLS9HE738",
+	"8lOXx9caXE0s7HbnKxxVuMQD0WP4LUoSlpwQZm/z9nCfHOn1kTNgD50bcHA354UZEogSW8q4cfo5WC4q",
+	"46MneaxKlbpwoDdav6ryla9qRTnno2NtqG8FJvwWCky4WSpx4YtLkmzt3JixJklocScZw6kZE3mgV3Fm",
+	"0Wb3GEbADO8UlyinqEgRtBjLNtQ7qSEa9Z5xYcNtdmeCawVaQxn9ZStlYGFVkP32wbEPFYb0TDdCghmt",
+	"je2AG62L9mNb+G0rCq041UHjPiQ6XSCKqxyh00l5tvE59yH7mfseMjQGLcNB96NIr4uD8Xchr4swAySm",
+	"VL9i3pRyOPPjTTyRhJSgF8EtuV+rTXbT9VNRlrLxAmF6MKK31uTEyntYSdaJpxiusmdATjIoXsDu1FnI",
+	"fS7FuIMp0E7B7EBPqtH0NvlOfbNMDu71nYD32xYZqJWqFiOesC+GBeb6FH8hSNOFN0XIf4Dy4b3u2cBJ",
+	"2CfkgBlDHa42u1BQra5BQnn/hLEz6TLOhKiHtMTdYHJ5z+6b/5pmLRtX89F7XJ28kfnUHXR56ltyszDM",
+	"fh5mQJa3nsoNsn8iey3H4rGuqHIjlClOT6a6bAzjEHoSVEJUDoqsTBIUG1+h80s+23030VtUhdxMzXIT",
+	"tQeXEsoFPTD3PPPpe1KRJMAtDHMjHP/S90ExZsyTyH3tzNZG2B731K9BG2EsSLvQqhqTxulTUjJVKhvL",
+	"XJP4/fz78+Pm1RDKtI7MGL8zU6jWiJLIQpEmS9U4raGfxLvf0yxW7/YZ/A7tIC401fEdv5cHsdpd2FPS",
+	"rOyQWrkuNlhZ8hjEjr5mHBiZ/U6orbMruVN77oIQntH1nNOPUlbbJP0yxaZw5oMXmKlULj3DTTLv4lB5",
+	"rKaTEUAW5JQEsBEKP3gWAT4w80CVF/851DFRK6ahjQu6aUEXXyPFCVRmzEmrP3OcpSulkK0qmZHijl3x",
+	"pnCXEkmRFkUvhdVc725SdqWLqhzBjmL5YIRtDK5tF9IG2A5xWFXqakEixiKWLs7Zo7Gd6YrQwfTf9mNW",
+	"kUk/hupy459XO7bhJSuU1lCkPUacEgiqrdKwwCJdWQ3cS7Gy+FreCmsYVcZdM1UXqgRXAjxPQWNzNVJy",
+	"euxAEiiZRYGjHVyp75PQ8cQpURJ2oQELeiAdrJgZNv819nHJSNtE/W7RCxeeMpKEAoxPzO8x5BoP4SXC",
+	"cZms++6hI/p3cU10Azp35FEP18Cc+RY0eoeE6ODj5bkVxjhQIi0FD5eVuG75AcRYtDxqRx6rL0hBeSko",
+	"nLKbF5Z64NO0gJgsN+UB52kme2Y3WjXrTVIzMMIZzJ268cbQdJSfTEMRr5QUDKd4wrbKWK8fciO1S26j",
+	"iD8plLRaVVXXz9A9rNfeefo7fn1WFPalUheY3/U+aaOksnGl5TykzOzHe7cz6V61iK7YvCAaMIerr7l2",
+	"OEvgApMZZI/FDfycD13sCZhvD3PQw27UZ8OF9dfVZaZ55QOK7VZtRZE/U7+vAOrRsOcci8qhwvVwB98R",
+	"MR329LKK8XLEIodoBsmzvilnzDMCHzdE7Ab/S+/m/ritcXDkohwyFy9FLYpRWa8HAEHqslnaRlMESEcS",
+	"i1xFrZ2dhqKe+oBOvFUouPR2sOEIdw6UhVsBNQhojwB+4lSGc1cuxPkcYUIk//1+W0/kRsC/30/lHeYx",
+	"FrV73pKWpiYx9/gIR8hXLdwb4vqaMpkupwa6mhCUMPGGTwAYD33twDApAPZYMJzvXta54EXULM8T/Zh/",
+	"uiajC38v0yys4O7CRjcBLqpGg8+F7UR83Q1pqLndhKsTmw/tP2hLAEPCzK+gFYUulfPEpR4qZ7HrqfBU",
+	"vajgEjoRwY6WTUOiJjoU+L4mdmYlQE0BJn3Ndu5lnN7lPZ2DX/siCZacgt2s/tMh1u0UO6DczKpir+XC",
+	"HRMz9SghRJeibHgHf+ZYkaOrvMejnEHV4I2wCO/IqdP85Eb4MQxwFvrnRJmAibfT+NDRLCiPun0M6GDo",
+	"e2PGTr3MR76n2eejWZRmK2NsjSPxlm+Yml/JcTPCkOTb59bEfRJKJoj96hoKkmr8ewdK/+LZ70FI1O5c",
+	"8ZzUuJYZG9kGJJOqffaQDSE8VdqyOOEHNzE1EtK/pm/gCd4GqN9+ZxkNxkyvPsboQ0JHOr25Ue03OYl7",
+	"D+LoeDkaMeAzuu3RfwXq9s8OaqCaqmQS9xNlf3K997eY5+JztmzCQKitoCRBnXfocwjeC0qmhlu3olBY",
+	"gnTNDt3uBhuqOkSSgmTrdLP4j1SW/bPhlVjtiM848EM38v3HMjHOXcIFefnAfpx4v3gVPDmjtkWFqdy6",
+	"xdQxk+F2OEoCNF7koV67Ylt+Aek2kPu945/kMctMsyTNBV7Zve0cYsEvPmTd3vIyfelT7Z9dhzuEanDY",
+	"+/9o05ulU4WSHXXFC7fbsep8l8+QlSIQl93Adn/+uyFfCySQeEEHotUhYWp5A5Xpkawrl1RmrKJ2B+zk",
+	"GdEtqH03y5io+e2VTd6TOXDSUu56Fya74vWBJoebUDflAPiu3pVv+1Hwny3LNbaMKeD/q+A91rMfh5ea",
+	"fAwsd5IqZ2B12uqlul5oWB30cKTWCHwLsIkqViELDdw4O+OLH/zDs606JchS7sL8oydCHKWElZAtsxSy",
+	"bmzmHUPFp+QuQViq9Ce0jpjQxqQEFCYvefXDJWgtyrGNw9OhVmmNLIQkGDp834wKI96pwwGEad9wlHKv",
+	"VaOnzfACL8VqBdoZsI3lsuS6TJsLyQrQlgv0ONmZm1uUonHgkE2JJ9JMNxFsYl0i0naAVDvvynFLe08E",
+	"kN+h4WeCweb1Bjz1d401TrVj1Yh9ZgjD78Jgs+XXaOOjxHAjB8KXGyMLHzWjYLCCSyefTVt3mMeIX2H/",
+	"NFRp1TMiq2jWKVPsP/c/0FbSM/InKezek+90lP1MfS6VgjuYAamoHg35XByxDM9jXeQnq7sJFqOXug+M",
+	"DbQHySaOhZJ09eIju0huED4zZ6oEN9PtQx1Pi8wN4zUDC9IYmD0ZW1oPE8K18aqkgZNoX9XgkDL3CTCP",
+	"1LQ5/Xy4l0bAQ0SD8We9O210dMNxpss+iX9IHqJa1Ytiiqe2K8ZcOgACpF0YR+gjMQKMrDu6x5hYnjyl",
+	"xm6dchrP3ET87tVJP2Ttqot9j/4xNdEIR++aINSKeBkdYaccUzpVpsz7acO6arDIJBhnGopGk5r4iu+y",
+	"bjKdWvMjRQDP/3L22aPHf3v82ecMG2ChSzBtIUk/SGQb0ZtXyL7e5+P67w6WZ/ObEBLK0udofwx5suKm",
+	"+LPmuK1pq0R1Vn+sSTtzAWSOI+UwblO23HivaJw2W8u/1nblFnnnO5ZDwYffM3TTyBfyjXJVxoCS263E",
+	"hIIvkNY/sWcBFbaNYzAbUg9SObdLlyBcBVfMlgqEHXG5yi1kzA2e+Bl+isGgcF1Xnlc5S8++dfl3mtPQ",
+	"kdBIXjGoxVK1F+3FiuUgomwAOkmW6BWfpBFPPNsjs3U+7vlsFxQvkic99Nmgl7Basf3cvjUUBkad4fS4",
+	"iRnxIhzKG5DmmH1iPBXtTThJq9r/l+Efmdy6d8Y14nI/BK/Ivg/2pJE8G/g9xLyyk0Ab5lnNkAcBMJJA",
+	"sZP6Lsn9ldSW085KQPaEYEDuix/ftYblg8FcBEnocAC8NCNi2y7GH3lwfuMibd9FpCRLeTtGCZ3lH0qy",
+	"GFhvvEiSLfJKE2vBOLaUycORZNA0z2JiypFXySB/pVbKMiVRN5LJe+n0OHSmUsKhaF/vvP9xucbXQht7",
+	"RviA8sfxgMY0+WGKZIdKc7PSKy/5pLkr/gGmlq8o1+ZfAfcoe8/5obwRfnCbkXKHV869ehWt0SDZFY1J",
+	"O80efc6Wvn5yraEQpm/cvwrCSUwKBBqtYzFj3f7kgofW+bOytyDjVfDEYd8n5q1os/cQtkf0N2YqIyc3",
+	"S+U56huQRQZ/OR6FNS+mFdy9ba3dm2XyTmpyHJnJO10Z1UyZvDxaB106jYHhOiff1h3cZi7qdm1T09BP",
+	"LtmLVdGXU7LH58vrYndKX38ndXaPqrL7ARLXOxz5Mfy8OYr5SeIh7KcYNYejc9B6gF29V2Evs2jUS+aT",
+	"CftIpqwK+ytZ6F1tx8aNvrA1N6beaI7k6o0nIXoR3BBQsp2zpnVat2k43fMzeV/SDFxDMgLNN5Z0tB11",
+	"RDfdzjqynOFkJ+yvzpmKcojOA1xsyy1oDP+ijHE8SY3ifVKiFzopXAdb6vGaT9uSE+p/HksO4yq5jVTi",
+	"7B1VLNp50OCa1lXFDAogwQhDlUP/5ivFf1wxK0DgMhsNubiD9TbJ4R1iMmvtTJ5MlVRMnVAs1XfLVLik",
+	"MPWi0cLuzhH/Qbcq/patvvBNzNXqM8FHM6sXi6y6ABlcgdrMro0Jgtc3ilckqjjrrwRmlapO2Feunqfn",
+	"oX++t/xP+PRPT8qHnz76z+WfHn72sIAnn33x8CH/4gl/9MWnj+Dxnz578hAerT7/Yvm4fPzk8fLJ4yef",
+	"f/ZF8emTR8snn3/xn/fwikKQHaChkO/T2f+9OKvWanH26sXiNQLb4oTXApOlv39PapSVwuUTUgvifLDl",
+	"opo9DT/9n4H5nhRq2w4ffkUuq7H5xtraPD09vbq6Okm7nK4pl8vCqqbYnIZ53s/7ouyrFzF8w7lo0Y62",
+	"hoWTWUsKZ/Ttx6/OX7OzVy9OZklKp9nDk4cnj3B8VYPktZg9nX1KP9Hp2dC+n1I1rVPjC+WetmF8WZPu",
+	"jxTNEN5tGnnnJzEg639Ho765H+K6sNItShMYy4PQxVW8KIm4rI+wmc/cC9w4cnz88GHYCy8EJ7LIKQ6G",
+	"vzn+kSuL836eYcYe4Cxk1IHWMVz0T/JCqivJqPSPO0DNdsv1zq2gg41kcNomvjZkf9HikluYvcXefZzX",
+	"tS9PPIZyLeASuqc8dCYCifVtuQxlb32RYZND+bA08i2xv7cU1GCyzO5Qo1cIc8iSGuAJtkKPM3IncAiL",
+	"Z8RdXgNEz2d1Y3PSBTopmn04mycldx00qiojxgcYfdX8D8Eokq6/m2ZP3+Ffm5CJHv/YIqEW4ZMGXu78",
+	"/80VX69Bn/h14k+Xj0/DA/X0nU9k8H7ft9MEYfhz+9dClAd6Bme4Q01O3/l8WwcGTHXfp21i7NBhIqD7",
+	"mp0u1fURTSFd3fhSiObN6TvSzYz+fuoV7PmPpCNzN+xpKMcw0tLlVsp/7KDwnb3GhewfDtsk4xXcFpum",
+	"Pn1H/yGyfe9OewW5BM+uIDdnbfM5Wp34kkom0K/IDVxkLDkCtC0HR/4Mez1zENBtGjzPZk9/GYYG0kAs",
+	"jEQiCt6/rQTRmakVEsnSljCFKAJ32reC8C8PF1+8ffdo/ujh+/9AQdf/+dmn7ycGVjyL47LzKMVObPj2",
+	"lhxvoM5rF+k2KTKw4SPD08J46Jffqt5ALCJjv5qqP/zwGU0M+Mkd8vhulcEMf/+Slyy80WnuRx9v7hfS",
+	"hQ+goOoE6vfz2Wcfc/UvJJI8r4JIdkPh7cwd/pQpML/ZOeFtPpNKdsqlODFDGTuZ3xjLb8BvzrHXH/ym",
+	"03BgAKYQTaeI3wpJHpCDAiBtGihfTy6EnfDykoe02iIJnKH9og6BMKJvdmNg1VQhQ02NMTLORKWqMJFp",
+	"6ho5zoqbSFk+WgcfzC7BRhyaNbJQ0nnVUWBU8A2gRBnkX2AuRN3pIla+Ho1UIUjvJGz6PxvQu3bXt0LO",
+	"5sM3U+v3+SFZuMPjHbDw7kB3zMIfH8lGf/8r/p99aT15+KePB4FfOXsttqAa+3u9NM/dDXarS9PL8K7a",
+	"9qm9lqfk+X/6rvNc8Z8Hz5Xu7233tMXlVpUQnhBqtTJgD3w+fef+fT9s5+6K0+C7NYQIa5FosQVpedX+",
+	"6rsZyWuzUXZUw3NuNfAtoVTJ6B/ne0UPJndfWc2LC9Bz76WAN0Shyd+JW77kBrUXBa8tWQG8P4XPkOSs",
+	"xRbKMIiz0VOVBs4sDzkJ4YS1HgRUvjbA4L+7uNQtl2KF41bCRCO7Vw+QC9a8l/wb/6JyL6bZ4ohwCXoX",
+	"4Y4qs4G6yhdmDVg86pJShQW7MITg7lFq7QlCcromD+oQz1Ik9b2a435FvOCaO6szJ3+I6jT9px9v+nPQ",
+	"l6IA9hq2tdJci2rHfpIxWPnGXPCr69q/HUYOajyPxzLFwDUst1A223oK2wiJtObMKG29wy25QVKpLApi",
+	"2tbxGHuNVhJ+PW89AVyUXr9S1AZdXH3eNr/CSdzlhD1vtrWzBl8pkmRNCJltI7xpsWQKpZgl0mnjf0QJ",
+	"0uLC9jEGcj1ERB3kDBau7Sn5997AeuBgRDz+cYz/bY6xI86EqGmXb3pkm7qudsP7fyeL7I9DOaLjHzDy",
+	"82mwseb05t2WHfX84Ktz3xj7+q7zZ1ePXEOMSssrW58L4/MqtxXQEs8NV0Iz5u4mflPHV++SS9NNmhzf",
+	"wKWCTtZmCqG2wV6zagwY79AbKtSYuU8AjWMsyaO+Fj5da5ehtDBTfbgDWpa0xlvwJqQSWNyQQOQ0Cd8A",
+	"DWbGnuRp0bwxLczgmbc/ti2kr84mKw/1VnOwLHlXPeB1KLOnD+d3ryroPoXLiPrDBegcKaU9JqR/7kyQ",
+	"ewnPj57zD4XvvwX/TxgVb4sFTFP8ZqWyl8J0mN5YPcl5ZIdCp0XxQvWkqPvr16vL1MbaZsUj4jyzOz2o",
+	"kfFPrCeTix/uR6bSkFPPZIvB2vHVPySxf5OT2J6bwR7f0hLzLEoiKpE+XCEGPGUXAHVIA+Ymnufyrnyj",
+	"jBH111xSkXiEbB5jdNyQZaOTdPvJ5cupXOtTMgWEn0PXtsarr9Qwd/HT26ayIjQmVsEZFlOMexZHwDIn",
+	"vu+QD5yV5Stfu+6GYg0h6jcSYEKlxwuok+0ZAybgfy80Uax5NF2s6YL7w7d/XP7/FiznrCxJ8+mH3WXK",
+	"iR7xCMQ+5jQWMhpV27SMzh8eGFZBaqWGWhQX7k3TKbVr6O6P1emDeB9HzAsEEbY7FQpAWi3gCLGgWx3q",
+	"kIAQhp8qIrRI9D3/EBL+DdU1nYJhxxxTs2lsqa5otXl5gRwJeOXLtVN0XXTHtoqFAdroFvZDq7zwCW6R",
+	"rTgzX+svz6yKaRPaYFc65THlwVpImgBhZjQLlbdifHhBDo/4uYfse1XC8KLP3Zgexs67P27Mh3j3D03Q",
+	"74/cPsstuNDgofoMPzam//fpFRcWfUkWZDNzBcOGnS3wiohdVND7tRSGGwPb5fCL3ukm0dR1Undnfz3l",
+	"XX1g5xtt2VjHgaNp7qv3pRxpFDLOhc9tGEsaFkLkEgNCfnmLu25AXwZKaqMcnp6eUgpSFG9PySenGwGR",
+	"fnwbN/pdIL+w4fjteqG0WAuJJbCcu/CijWR4fPJw9v7/HwAHLnTgukMBAA==",
 }
 
 // GetSwagger returns the content of the embedded swagger specification file
@@ -75,6 +75,9 @@ type ServerInterface interface {
 	// Given a timestamp offset in seconds, adds the offset to every subsequent block header's timestamp.
 	// (POST /v2/devmode/blocks/offset/{offset})
 	SetBlockTimeStampOffset(ctx echo.Context, offset uint64) error
+	// Rolls the ledger back to an earlier round.
+	// (POST /v2/devmode/ledger/rollback/{round})
+	RollbackLedger(ctx echo.Context, round uint64) error
 	// Get the current supply reported by the ledger.
 	// (GET /v2/ledger/supply)
 	GetSupply(ctx echo.Context) error
@@ -561,6 +564,24 @@ func (w *ServerInterfaceWrapper) SetBlockTimeStampOffset(ctx echo.Context) error
 	return err
 }
 
+// RollbackLedger converts echo context to params.
+func (w *ServerInterfaceWrapper) RollbackLedger(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "round" -------------
+	var round uint64
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "round", runtime.ParamLocationPath, ctx.Param("round"), &round)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter round: %s", err))
+	}
+
+	ctx.Set(Api_keyScopes, []string{""})
+
+	// Invoke the callback with all the unmarshalled arguments
+	err = w.Handler.RollbackLedger(ctx, round)
+	return err
+}
+
 // GetSupply converts echo context to params.
 func (w *ServerInterfaceWrapper) GetSupply(ctx echo.Context) error {
 	var err error
@@ -738,6 +759,7 @@ func RegisterHandlersWithBaseURL(router EchoRouter, si ServerInterface, baseURL
 	router.GET(baseURL+"/v2/deltas/:round/txn/group", wrapper.GetTransactionGroupLedgerStateDeltasForRound, m...)
 	router.GET(baseURL+"/v2/devmode/blocks/offset", wrapper.GetBlockTimeStampOffset, m...)
 	router.POST(baseURL+"/v2/devmode/blocks/offset/:offset", wrapper.SetBlockTimeStampOffset, m...)
+	router.POST(baseURL+"/v2/devmode/ledger/rollback/:round", wrapper.RollbackLedger, m...)
 	router.GET(baseURL+"/v2/ledger/supply", wrapper.GetSupply, m...)
 	router.GET(baseURL+"/v2/stateproofs/:round", wrapper.GetStateProof, m...)
 	router.GET(baseURL+"/v2/status", wrapper.GetStatus, m...)
@@ -753,308 +775,333 @@ func RegisterHandlersWithBaseURL(router EchoRouter, si ServerInterface, baseURL
 // Base64 encoded, gzipped, json marshaled Swagger object
 var swaggerSpec = []string{
 
-	"H4sIAAAAAAAC/+y9/XfbtrIo+q9g6d618nFFOUnTnt281XWfm6Stb/O1Yrf7nNPktRA5krBNAdwAKEvN",
-	"y/9+FwYACZKgRNmyk7T+KbFIAoPBYDDf82GUimUhOHCtRk8+jAoq6RI0SPyLpqkouU5YZv7KQKWSFZoJ",
-	"PnrinxGlJePz0XjEzK8F1YvReMTpEup3zPfjkYR/l0xCNnqiZQnjkUoXsKRmYL0pzNvVSOtkLhI3xLEd",
-	"4uTZ6OOWBzTLJCjVhfI1zzeE8TQvMyBaUq5oah4pcsH0gugFU8R9TBgnggMRM6IXjZfJjEGeqYlf5L9L",
-	"kJtglW7y/iV9rEFMpMihC+dTsZwyDh4qqICqNoRoQTKY4UsLqomZwcDqX9SCKKAyXZCZkDtAtUCE8AIv",
-	"l6Mnv40U8Awk7lYKbIX/nUmAPyHRVM5Bj96PY4ubaZCJZsvI0k4c9iWoMteK4Lu4xjlbASfmqwl5WSpN",
-	"pkAoJ29/eEq++uqrb81CllRryByR9a6qnj1ck/189GSUUQ3+cZfWaD4XkvIsqd5/+8NTnP/ULXDoW1Qp",
-	"iB+WY/OEnDzrW4D/MEJCjGuY4z40qN98ETkU9c9TmAkJA/fEvnzQTQnn/6S7klKdLgrBuI7sC8GnxD6O",
-	"8rDg8208rAKg8X5hMCXNoL89SL59/+Hh+OGDj//jt+Pkv92fX3/1ceDyn1bj7sBA9MW0lBJ4uknmEiie",
-	"lgXlXXy8dfSgFqLMM7KgK9x8ukRW774l5lvLOlc0Lw2dsFSK43wuFKGOjDKY0TLXxE9MSp4bNmVGc9RO",
-	"mCKFFCuWQTY23PdiwdIFSamyQ+B75ILluaHBUkHWR2vx1W05TB9DlBi4LoUPXNDni4x6XTswAWvkBkma",
-	"CwWJFjuuJ3/jUJ6R8EKp7yq132VFzhZAcHLzwF62iDtuaDrPN0TjvmaEKkKJv5rGhM3IRpTkAjcnZ+f4",
-	"vVuNwdqSGKTh5jTuUXN4+9DXQUYEeVMhcqAckefPXRdlfMbmpQRFLhagF+7Ok6AKwRUQMf0XpNps+/85",
-	"ff2KCEleglJ0Dm9oek6ApyKDbEJOZoQLHZCGoyXEofmybx0Ortgl/y8lDE0s1byg6Xn8Rs/ZkkVW9ZKu",
-	"2bJcEl4upyDNlvorRAsiQZeS9wFkR9xBiku67k56Jkue4v7X0zZkOUNtTBU53SDClnT93YOxA0cRmuek",
-	"AJ4xPid6zXvlODP3bvASKUqeDRBztNnT4GJVBaRsxiAj1ShbIHHT7IKH8f3gqYWvABw/SC841Sw7wOGw",
-	"jtCMOd3mCSnoHAKSmZBfHHPDp1qcA68InUw3+KiQsGKiVNVHPTDi1NslcC40JIWEGYvQ2KlDh2Ew9h3H",
-	"gZdOBkoF15RxyAxzRqCFBsusemEKJtyu73Rv8SlV8M3jvju+fjpw92eivetbd3zQbuNLiT2SkavTPHUH",
-	"Ni5ZNb4foB+Gcys2T+zPnY1k8zNz28xYjjfRv8z+eTSUCplAAxH+blJszqkuJTx5x++bv0hCTjXlGZWZ",
-	"+WVpf3pZ5pqdsrn5Kbc/vRBzlp6yeQ8yK1ijChd+trT/mPHi7Fivo3rFCyHOyyJcUNpQXKcbcvKsb5Pt",
-	"mPsS5nGl7YaKx9naKyP7fqHX1Ub2ANmLu4KaF89hI8FAS9MZ/rOeIT3RmfzT/FMUuflaF7MYag0duysZ",
-	"zQfOrHBcFDlLqUHiW/fYPDVMAKwiQes3jvBCffIhALGQogCpmR2UFkWSi5TmidJU40j/U8Js9GT0P45q",
-	"+8uR/VwdBZO/MF+d4kdGZLViUEKLYo8x3hjRR21hFoZB4yNkE5btodDEuN1EQ0rMsOAcVpTrSa2yNPhB",
-	"dYB/czPV+LbSjsV3SwXrRTixL05BWQnYvnhHkQD1BNFKEK0okM5zMa1+uHtcFDUG8flxUVh8oPQIDAUz",
-	"WDOl1T1cPq1PUjjPybMJ+TEcG0VxwfONuRysqGHuhpm7tdwtVtmW3BrqEe8ogtsp5MRsjUeDEfMPQXGo",
-	"VixEbqSenbRiXv7JvRuSmfl90MdfBomFuO0nLlS0HOasjoO/BMrN3RbldAnHmXsm5Lj97eXIxoyyhWDU",
-	"SY3FQxMP/sI0LNVOSgggCqjJbQ+Vkm5GTkhMUNjrkskvCiyFFHTOOEI7NuoTJ0t6bvdDIN4NIYCq9CJL",
-	"S1aCrEyoTuZ0qJ907CxfALXGNtZLokZSzZnSqFfjy2QBOQrOlHuCDknlUpQxYMO3LKKC+ULSwtKye2LF",
-	"LsZRn7cvWVivePEOvBOjMAfsPthohOrSbHkn64xCglyjBcP3uUjPf6JqcYATPvVjdWkfpyELoBlIsqBq",
-	"ETk4LdquRxtC3+ZFpFkyDaaaVEt8IebqAEvMxT6sqyie0jw3U3dZVmu1OPCgg5znxLxMYMnQYO4UR2th",
-	"t/oXeU7ThRELSErzfFybikSR5LCC3CjtjHOQY6IXVNeHH0f2eg2eIwWG2WkgwWqcmQlNbLKyRUggS4o3",
-	"0NJoM0Xe/KbioIouoSUF4Y0oSrQiBIrGyTO/OlgBR55UDY3gV2tEa004+MTM7R7hzFzYxVkLoPbuuwp/",
-	"Fb9oAG3eru9TXk8hZGZt1tr8xiRJhbRD2BveTW7+A1TWH1vqvFtISNwQkq5AKpqb1bUWda8i30Odzh0n",
-	"M6OaBifTUWFcAbOcA79D8Q5kxErzGv9Dc2IeGynGUFJNPQyFERG4UzN7MRtU2ZnMC2hvFWRpTZmkoOn5",
-	"XlA+rSePs5lBJ++5tZ66LXSLqHbobM0ydahtwsH69qp5QqztyrOjjiyylekEcw1BwJkoiGUfLRAsp8DR",
-	"LELE+uDX2vdiHYPpe7HuXGliDQfZCTPOYGb/vVg/c5AJuRvzOPYQpJsFcroEhbcbDxmnmaX2yx1Phbyc",
-	"NNG6YDipvY2EmlEDYWrcQhK+WhaJO5sRj4V9oTVQHeCxXQhoDx/DWAMLp5peAxaUGfUQWGgOdGgsiGXB",
-	"cjgA6S+iQtyUKvjqETn96fjrh49+f/T1N4YkCynmki7JdKNBkbvOLEeU3uRwL6odoXQRH/2bx95H1Rw3",
-	"No4SpUxhSYvuUNb3ZbVf+xox73Wx1kQzrroCcBBHBHO1WbQT69Y1oD2DaTk/Ba2NpvtGitnBuWFnhhh0",
-	"+NKbQhrBQjX9hE5aOsrMK0ew1pIeFfgm8MzGGZh1MGV0wOX0IETVt/FZPUtGHEYz2Hko9t2meppNuFVy",
-	"I8tDmDdASiGjV3AhhRapyBMj5zERMVC8cW8Q94bfrqL9u4WWXFBFzNzovSx51mOH0Gs+/P6yQ5+teY2b",
-	"rTeYXW9kdW7eIfvSRH6thRQgE73mBKmzYR6ZSbEklGT4IcoaP4K28hdbwqmmy+L1bHYYa6fAgSJ2HLYE",
-	"ZWYi9g0j/ShIBbfBfDtMNm7UIehpI8Z7mXQ/AA4jpxueoqvsEMe235q1ZBz99mrD08C0ZWDMIZs3yPLq",
-	"Jqw+dNip7qgIOAYdL/Ax2uqfQa7pD0Ke1eLrj1KUxcHZc3vOocuhbjHOG5CZb70ZmPF53gwgnRvYJ7E1",
-	"fpIFPa2MCHYNCD1S5As2X+hAX3wjxTXcidFZYoDiA2ssys03XZPRK5EZZqJLdQBRsh6s5nCGbkO+Rqei",
-	"1IQSLjLAzS9VXMjsCTnEWCcM0dKh3Ir2CabIFAx1pbQ0qy0LggFInfui/jChqT2hCaJG9YRfVHEz9i07",
-	"nQ1nyyXQbEOmAJyIqYtxcNEXuEiK0VPai2lOxI3wiwZchRQpKAVZ4kzRO0Hz79mrQ2/BEwKOAFezECXI",
-	"jMorA3u+2gnnOWwSjPVT5O7Pv6p7nwBeLTTNdyAW34mht21P60I9bPptBNeePCQ7a6mzVGvEW8MgctDQ",
-	"h8K9cNK7f22IOrt4dbSsQGJIybVSvJ/kagRUgXrN9H5VaMuiJ4LdqelGwjMbxikXXrCKDZZTpZNdbNm8",
-	"1LAlmBUEnDDGiXHgHsHrBVXahkExnqFN014nOI8VwswU/QD3qiFm5F+9BtIdOzX3IFelqtQRVRaFkBqy",
-	"2BrQI9s71ytYV3OJWTB2pfNoQUoFu0buw1IwvkOW04DxD6or/6vz6HYXhz51c89voqhsAFEjYhsgp/6t",
-	"ALthFG8PIEzViLaEw1SLcqrQ4fFIaVEUhlvopOTVd31oOrVvH+tf6ne7xGWdHPbezgQodKC49x3kFxaz",
-	"Nn57QRVxcHgXO5pzbLxWF2ZzGBPFeArJNspHFc+8FR6BnYe0LOaSZpBkkNNNJDjAPib28bYBcMdrdVdo",
-	"SGwgbnzTa0r2cY9bhhY4nooJjwSfkNQcQaMK1ATivt4xcgY4dow5OTq6Uw2Fc0W3yI+Hy7ZbHRkRb8OV",
-	"0GbHHT0gyI6jDwG4Bw/V0JdHBX6c1Lpne4r/AuUmqOSI/SfZgOpbQj3+XgvosQW7HKfgvLTYe4sDR9lm",
-	"LxvbwUf6jmyPYfoNlZqlrEBd52fYHFz1a08QdZyTDDRlOWQkeGDVwCL8ntgQ0vaYl1MFB9neuuB3jG+R",
-	"5fgwnSbw57BBnfuNzU0ITB2H0GUjo5r7iXKCgPqIZyOCh6/AmqY63xhBTS9gQy5AAlHl1IYwdP0pWhRJ",
-	"OEDUP7NlRuedjfpGt7qLT3GoYHmxWDOrE2yH76ylGDTQ4XSBQoh8gIWsg4woBINiR0ghzK4zl/7kE2A8",
-	"JTWAdEwbXfPV9X9HNdCMKyD/JUqSUo4qV6mhkmmEREEBBUgzgxHBqjldcGKNIchhCVaTxCf377cXfv++",
-	"23OmyAwufM6gebGNjvv30Y7zRijdOFwHsIea43YSuT7QcWUuPqeFtHnK7ognN/KQnXzTGrzydpkzpZQj",
-	"XLP8KzOA1slcD1l7SCPDor1w3EG+nGZ8UGfduO+nbFnmVB/CawUrmidiBVKyDHZycjcxE/z5iuavq88w",
-	"HxJSQ6MpJClm8Q0cC87MNzbxz4zDODMH2Ab9DwUITuxXp/ajHSpmHanKlkvIGNWQb0ghIQWb72YkR1Ut",
-	"dUJsJHy6oHyOCoMU5dwFt9pxkOGXyppmZMk7Q0SFKr3mCRq5YxeAC1PzKY9GnAJqVLq2hdwqMBe0ms9l",
-	"uQ65mYM9aHsMok6y8ahX4zVIXdUar0VOM29zwGXQkPcC/NQTD3SlIOqM7NPFV7gt5jCZzb0ek309dAzK",
-	"7sRBxG/9sC/o16jb+eYAQo8diEgoJCi8okIzlbJPxSzM0fahghulYdm15NtPf+85fm979UXBc8YhWQoO",
-	"m2hZEsbhJT6MHie8Jns+RoGl79u2DtKAvwVWc54h1HhV/OJut09o22OlfhDyUC5RO+Bg8X6AB3Knu91N",
-	"eVk/Kc3ziGvRZXC2GYAaV8G6TBKqlEgZymwnmRq7qGDrjXTpnk30v6nyUg5w9trjtnxoYXEAtBFDXhBK",
-	"0pyhBVlwpWWZ6necoo0qWGokiMsr4/1Wy6f+lbiZNGLFdEO94xQD+CrLVTRgYwYRM80PAN54qcr5HJRu",
-	"6TozgHfcvcU4KTnTONfSHJfEnpcCJEZSTeybS7ohM0MTWpA/QQoyLXVT+scEZaVZnjuHnpmGiNk7TjXJ",
-	"gSpNXjJ+tsbhvNPfH1kO+kLI8woL8dt9DhwUU0k82OxH+xTj+t3yFy7GH8Pd7WMfdFpXTBiZZTaKpPx/",
-	"d//3k9+Ok/+myZ8Pkm//19H7D48/3rvf+fHRx++++/+bP3318bt7//t/xnbKwx5Ln3WQnzxzmvHJM1R/",
-	"glD9Nuw3Zv9fMp5EiSyM5mjRFrmLpSIcAd1rGsf0At5xveaGkFY0Z5nhLZchh/YN0zmL9nS0qKaxES1j",
-	"mF/rnkrFFbgMiTCZFmu8tBTVjc+MJ6qjU9LlnuN5mZXcbqWXvm0epo8vE7NxVYzA1il7QjBTfUF9kKf7",
-	"89HX34zGdYZ59Xw0Hrmn7yOUzLJ1rI5ABuuYrhgmSdxRpKAbBTrOPRD2aCidje0Ih13CcgpSLVhx85xC",
-	"aTaNczifsuRsTmt+wm2Avzk/6OLcOM+JmN083FoCZFDoRax+UUNQw7fq3QRohZ0UUqyAjwmbwKRt88mM",
-	"vuiC+nKgMx+YKoUYog1V58ASmqeKAOvhQgYZVmL000pvcJe/Org65AaOwdWeMxbRe+fH52fkyDFMdceW",
-	"tLBDB0UIIqq0S55sBCQZbhbmlL3j7/gzmKH1QfAn73hGNT2aUsVSdVQqkN/TnPIUJnNBnvh8zGdU03e8",
-	"I2n1FlYMkqZJUU5zlpLzUCGpydMWy+qO8O7dbzSfi3fv3ndiM7rqg5sqyl/sBIkRhEWpE1fqJ5FwQWXM",
-	"96WqUi84sq3ltW1WK2SL0hpIfSkhN36c59GiUO2SD93lF0Vulh+QoXIFDcyWEaVFlY9mBBSX0mv295Vw",
-	"F4OkF96uUipQ5I8lLX5jXL8nybvywYOvMLOvroHwh7vyDU1uChhsXektSdE2quDCrVqJsepJQecxF9u7",
-	"d79poAXuPsrLS7Rx5DnBzxpZhz7BAIeqF1ClOPdugIVj7+RgXNyp/cqXdYwvAR/hFjYTsK+0X0H+/KW3",
-	"a0cOPi31IjFnO7oqZUjc70xV7W1uhCwfjaHYHLVVVxhvCiRdQHruKpbBstCbceNzH/DjBE3POpiytexs",
-	"hiFWU0IHxRRIWWTUieKUb9plbZTNqMBB38I5bM5EXYxpnzo2zbIqqu+gIqUG0qUh1vDYujHam++iynyi",
-	"qatOgsmbniyeVHThv+k/yFbkPcAhjhFFo+xHHyKojCDCEn8PCi6xUDPelUg/tjzGU+CarSCBnM3ZNFaG",
-	"959df5iH1VClqzzoopCrARVhM2JU+am9WJ16Lymfg7mezZUqFM1tVdVo0AbqQwugUk+B6q12fh4WpPDQ",
-	"oUp5gZnXaOEbmyXA2uw302ix43BhtAo0FNl3XPTypD/+zAIO2SXh8Z/XmsKkV9d1qItUHPS3coXdSq11",
-	"oXkhnSFc9vkSsGSpuDD7YqAQrtqmLeoS3C+lonPo0V1C793AehgNjx8OsksiicogYtYWNTqSQBRk+3Ji",
-	"1hw9w2CemEOMamYrINPPZB3EzmeERbQdwqY5CrBV5KrdeyobXlRbFbgPtDhrAclrUdCD0cRIeBwXVPnj",
-	"iPVSPZcdJJ1dY9mXbaXpToJYwqAoalV4zt+GbQ7a0ftdgTpflc6XoguV/gFl5YzuhekLse0QHEXTDHKY",
-	"24Xblz2h1AWT6g0ycLyezZC3JLGwxMBAHQgAbg4wmst9QqxvhAweIUbGAdgY+IADk1ciPJt8vg+Q3BV8",
-	"on5svCKCvyGe2GcD9Y0wKgpzubIef2PqOYArRVFLFq2IahyGMD4mhs2taG7YnNPF60E6FdJQoWjVQ3Oh",
-	"N/f6FI0tril75e+1JiskXGY1oTTrgY6L2lsgnop1YjOUo7rIdD019B7NXcB86djBtLXo7igyFWsM58Kr",
-	"xcbK74ClHw4PRmB7WTOF9Irf9clZFpht026Xc2NUqJBknKG1Ipc+QW/I1D2yZR+53A3Ky10KgJYZqu7V",
-	"4MwSO80HTfGke5nXt9q4Lpvq08Jix7/vCEV3qQd/XftYsyDcT3Xhv/7iYv5E3UglvK5l6SoVCu3Hha06",
-	"uE+BwjY5NIDYgtU3bTkwitZmrFcTrwHWYqzEMN+uU7KLNgU5oBKcNETT5DwWKWB0ecB7/NR/FhjrcPco",
-	"39wLAgglzJnSUDuNfFzQpzDHUyyfLMSsf3W6kDOzvrdCVJe/dZvjh41l3vgKMAJ/xqTSCXrcokswL/2g",
-	"0Ij0g3k1LoE2QxRtswGWxTkuTnsOmyRjeRmnVzfvz8/MtK+qi0aVU7zFGLcBWlNsjhENXN4ytY1t37rg",
-	"F3bBL+jB1jvsNJhXzcTSkEtzji/kXLQY2DZ2ECHAGHF0d60XpVsYZJBw3uWOgTQaxLRMtnkbOocp82Pv",
-	"jFLzae99N78dKbqWoAxgPENQzOeQ+fJm3h/GgyJyueDzoItTUWyrmTchtnQdVp7bUrTOheFDXxB+IO4n",
-	"jGewjkMfagUIeZ1ZhwX3cJI5cFuuJG4WiqImDPHHNwJb3Q37QtsJANEg6LOWM7uOTra7VG0nbkAONHM6",
-	"iQK/vu3HsrshDnXjvvDpRuXT7UcIB0SaYjpobNItQ9DDgGlRsGzdcjzZUXuNYHQv63KPtIWsxQ22AwPN",
-	"IOgowTVKabtQa2dgP0Kd98hoZTb22gUWG/qmqUvAz0qJHoxGZHO3bnulqw1c+8+/nmoh6RycFyqxIF1p",
-	"CFzOPmgIqqIropkNJ8nYbAah90VdxnPQAK5jY88GkG6EyOIumpJx/c3jGBntoJ4axt0oi1NMhBb6fPJn",
-	"XS+Xl+kDU1J1JQRbcwlXVTRd/2fYJL/SvDRKBpOqDs91bqfm5bvHrq+WP8MGR94Z9WoA27EraHl6C0iD",
-	"MUt/9UgFBazvqEaJf1QvG1u4x04dx3fpQFvjmjL0E399yzSaFjSXcpWDUQdJGFiG7MZpPDbBnB5oIr5N",
-	"yrs2gWW7ZZBA3g+nYsq3sOxeRVUtil20ewY098SLyxl9HI+uFgkQu83ciDtw/aa6QKN4xkhT6xluBPbs",
-	"iXJaFFKsaJ64eIm+y1+Klbv88XUfXnHDmkycss+eH79448D/OB6lOVCZVJaA3lXhe8UXsyrbxmH7VWKr",
-	"fTtDp7UUBZtfVWQOYywusLJ3y9jUaYpSx88ER9HFXMziAe87eZ8L9bFL3BLyA0UV8VP7PG3ATzPIh64o",
-	"y72z0UPbE5yOixvWWSfKFcIBrhwsFMR8JQdlN53THT8dNXXt4Ek412ssTRnXOLgrXImsyAX/0INLTz8I",
-	"2WD+LjMxGjx0fWKVEbItHntitX3/yrYwNSFW8Ppj/oc5jffvh0ft/v0x+SN3DwIA8fep+x31i/v3o97D",
-	"qBnLMAm0UnG6hHtVlkXvRtysAs7hYtgFfbxaVpKl6CfDikJtFJBH94XD3oVkDp+Z+yWDHMxPkyFKerjp",
-	"Ft0hMENO0GlfJmIVZLq0LTMVEbwdU41JsIa0kNm7lgzWGds9QrxcogMzUTlL46EdfKoMe+U2mNK8TPDl",
-	"HmutGbFkPbG5vGTBWOa1ITVTW0AGc0SRqaJlW2vcTYU73iVn/y6BsMxoNTMGEu+11lXnlQMctSOQxu1i",
-	"bmDrp6qHv4odZIu/yduCthlBtvrvnlU+Jb/QWNOfPSPAwxk7jHtL9LajD0fNNptt0QzBHKbHDGmd7hmd",
-	"c9b1zBFthc5UMpPiT4g7QtB/FCmE4R2fDM28fwKPRe61WUrlVK47utez79ru4bpx38ZfWRf2i666jl3m",
-	"Mo2f6v028jJKr4qXa3ZI7lPCwgiDZmpAD2vB4xUEw2IbFB99RLk9T7YKRCPDLH4qw1zOIzt+fSodzJ38",
-	"15xeTGmsR4zRhQxMwfY24qS0IP5jvwGqqnFgZydBBHf1LrOV5AqQtQ+iW5X2knqNnXawRlMrMEhRoeoy",
-	"tmEKuRKRYUp+QbntIm6+s/zKfa3AuuDNVxdCYh1IFQ/pyiBly6g59t2737K0G76TsTmzDbJLBUEHZjcQ",
-	"scUmkYpcF+uqcodDzcmMPBgHbeDdbmRsxRSb5oBvPLRvTKnC67Jyh1efmOUB1wuFrz8a8Pqi5JmETC+U",
-	"RawSpNI9UcirAhOnoC8AOHmA7z38ltzFkEzFVnDPYNEJQaMnD7/FgBr7x4PYLesanG9j2RnybB+sHadj",
-	"jEm1Yxgm6UaNR1/PJMCf0H87bDlN9tMhZwnfdBfK7rO0pJzOIZ6fsdwBk/0WdxPd+S28cOsNAKWl2BCm",
-	"4/ODpoY/9eR8G/ZnwSCpWC6ZXrrAPSWWhp7q9sp2Uj+c7fXv+kV5uPxDjH8tfPhfy9Z1w2oMXfbkbGGU",
-	"8iv00YZoHRNqi3/mrI5M9/06yYmvLYwNtKq+WRY3Zi6zdJQlMVB9RgrJuEb7R6lnyT+MWixpatjfpA/c",
-	"ZPrN40gjqmavFr4f4DeOdwkK5CqOetlD9l5mcd+Su1zwZGk4SnavrrEQnMreQN14SGZfXOj2oYdKvmaU",
-	"pJfcyga50YBTX4nw+JYBr0iK1Xr2ose9V3bjlFnKOHnQ0uzQL29fOCljKWSsYUB93J3EIUFLBivMmItv",
-	"khnzinsh80G7cBXoP238kxc5A7HMn+WoIhB4NLclyxsp/teXdeVzdKzaTMSWDVDIiLXT2e1uONpwP6tb",
-	"239rA8bwWQ/mBqMNR+lipSf63obXV998inihNkh2zxsGx4d/EGl0cJTj799HoO/fHzsx+I9HzceWvd+/",
-	"Hy9AHDW5mV9rLFxFI8ZvY3v4vYgYwHzXwiqgyNVHiBgg+y4p88AwwakbakyaHeJuXoo4TH5XPNo0fgre",
-	"vfsNn3g84B9tRHxiZokbWGcp9B/2ZofMKMlk1fMgzp2S78V6KOG07iBPPJ8BinpQMtA8hyvpdACNuut3",
-	"xosENGpGnUIujJIZNgUK7flfDp7N4sdbsF2yPPu1ru3Wukgk5ekiGiU8NR/+bmX0xhVsWWW0z8iCcg55",
-	"dDir2/7udeCIlv4vMXSeJeMD3213oLXLbS2uBrwJpgfKT2jQy3RuJgix2iybVZVlyOciIzhP3dSiZo7d",
-	"Vs6xFpqR/GYcdllqF7eKueCu4NCM5RiGGfcb45uJpLqngBb2O/f9hcw42H5cWTODHR0koWyJF7OiyyIH",
-	"PJkrkHSOnwoOrc+xhBqOHHSsIKowj/BNLFghiC4lJ2I2C5YBXDMJ+WZMCqqUHeSBWRasce7Rk4cPHkTN",
-	"XoidASu1WPTLfF0v5eERvmKfuCZLthXAXsDuhvVjTVH7bGyXcFxPyX+XoHSMp+IDm7mKXlJza9t+klXv",
-	"0wn5ESsfGSJulLpHc6UvItwsqFkWuaDZGIsbnz0/fkHsrPYb20Le9rOco7WuSf5R98rwAqO+slNP5Zzh",
-	"42wv5WFWrXRStZ+M1SY0b9QNMlkr5gbteCF2JuSZNaFWDfztJARLZMslZEG3S6vEI3GY/2hN0wXaJhsS",
-	"UD+vHN6I1bOz2nMTZB9W3Y+QYRu4XS9W24p1TIRegLxgCjAjH1bQLIdY1QZ1tnFfHrG5PFlybillsocw",
-	"WvU62hftHjgryfqggihkLcTvaZmy/Zj37Ut7il/FczFaTW5bXn9fXM+X2CYvnXMhpVxwlmIrhJgkjaXb",
-	"hrkpB3SNiPsX1cid0MjhirbWrXKBHRZ7m+16RugQ13X5B0/NplrqsH9qWLuWa3PQynE2yMa+07VziDGu",
-	"wHWzMkQU8kkhI0FN0USIKoBiTzLCqkw9Fs4fzLNXzv6NRTHOGUdLl0Ob08+syypXDD3TnDBN5gKUW08z",
-	"m0f9Zr6ZYJXGDNbvJy/EnKWnbI5j2DA6s2wbM9od6thHkLqITfPuU/Ouq51f/dwIB7OTHheFm7S/D3pU",
-	"kNRr3ovgWNySDyQJkFuNH462hdy2hn7jfWoIDVYYtQYF3sMdwqh6aTdHeW50S0tR+AaxGZXRArqMR8B4",
-	"wbh3ocYviDR6JeDG4Hnt+U6lkmqrOwziaWdA854ECMxQtj74qw7V7hxgUIJr9HP0b2PdBryHcVQv1BI/",
-	"5RviD4Wh7kCYeErzKnQ60tQbpSonRGWYXNRq8x1jHIZxJz5lsoGunel71efYjWPfm6ivRuG0zOagE5pl",
-	"sdJW3+NTgk99khisIS2rJlRVdmCzRnmX2txEqeCqXG6Zy79wxemCvvkRagh79/sdxko70w3+G+vA1L8z",
-	"Lmh676xcHyGd7VeYv5tlHJN6DU0nis2T4ZjAO+Xq6Kinvhyh198flNJ9uu5nkY3b4nLhHsX423NzcYSF",
-	"ezvx6fZqqerqYiy4wOe+4FFVEbLJlfAq6/QZw6gH3LzIlrWA9y9GAV/RvCcTPvSV2PvV+g/68uHT3vIN",
-	"VLvyXJqSrSyot+SRjRVueV+6LsS++GAbHnw4r4Vb61aE9vvufm546myMWM0sej10l3Oi1Ru8rxft51Vf",
-	"iQTfpwOfh/1AXBTP2JWBhxUTpY++8jHQXiW0v7oSPI2+Hz3rj2YWfGqvRa+P5cz1r7XLdDr5z79aLywB",
-	"ruXmM/C4dDa93VQmIu1a81T9CqlaHw5qhdi4FYf0sIm1S3GyobeVWdbSoKVO+5kOWT0bIg508PFxPDrJ",
-	"9rowYy13RnaU2LF7weYLjRX7fwKagXyzoyNB3YUAj1ghFKs7kOZmMFcCdoHDTYYmGxgCZmFHhe5YPgh1",
-	"BanGtrN1cJ0E2Ke/gpnMO31uOxP0q9NVToZrSLCtC0G31+yOO75TOCko/mX7dE6G19w/rkKobQbYBVV1",
-	"uZZWzvTgzM3ZDFKsiry1UNU/F8CDIkhjb5dBWGZB3SpW5TFhXe/9rY41QNvqSG2FJ+ivc2Vw+vLYz2Fz",
-	"R5EGNUQbh1ZJfJcpHIwYsC4wX0O6z5DsosaYqigDseBDgl0p5ro5Rm/N56Ds2iXn8iRpLo66FNuWKeNN",
-	"zwfNZT7dq+wjpuT01bLq9kzu1z+eYYtq5QLkaFV4ONTSyUm3cc6FK1yMZcUq34kvYQzK/+ZrCNpZcnbu",
-	"+gcgVqyn6oLKzL9xkKJQ9m5icaBn1cysTuDoBjlEWjFgLlSaCyNGJH0JZc2ciSrg8I6ykaF1AR+EawZS",
-	"Qla5RHKhINHCJ3xsg2MbKmz466WQoHrbH1ngektfv61re2MbOIqlrqmLeg0XSCQsqYFOBhW4++fchuyn",
-	"9rlPwvdtwHZamCp63d2P1qfuMNVBYkj1M+Juy93J/ZcxNjHOQSbe89Qux82bFdmw7mZWpvaCDg9GZZAb",
-	"XDtnCyuJ2mnS7ipbOkKQJH8OmyOrBPlGvn4HQ6Ct5GRBDwqOtjb5oOY3FYN7fhDwPm0duUKIPOlxdpx0",
-	"a4i3Kf6cpeeANQCrEPeeHu3kLtrYK2/2xWLja2YXBXDI7k0IOeY2qcg7tpvtBVuT8zt62/xrnDUrbVl/",
-	"Z1SbvOPx7AwsuC+vyM38MNt5mALD6q44lR1kR4XqNe8LubnA4vzNLp6ToVp519Xc7iJfE5WFIiaTnFqP",
-	"1VM86DHDEZZACGp1oCOTEufpIioXsVjey5RpMEPFMRVOhgBp4EOqBVRQuMGjCIj2RY+cQlv6zhW9EzMi",
-	"oXYiX7b6X7eFe0yjb89czdLkdzMhodGM3XxtK31WiS9YRhP/M2VaUrm5TI2+Tgv5jvWkF8s7w7GqSKx6",
-	"IXU0VheHeS4uEmRWSdXnIqbamvdU8zL2Tdfq78ypnkIQ10WVE9Q2ZEEzkgopIQ2/iOd7WqiWQkKSCwzz",
-	"inmgZ9rI3UtM8uIkF3MiilRkYPvFxCmob66Sc4piEwRRNVEUWNrBbGH7TUDHA6c0d6r1IyUoas336J2f",
-	"gs1cr6s62UUn1pfZE7EMylVxchiyL3fh3dL7P86bZ2yNdAMyduRnRMsSxsS90e6R7Q4+lUCWTCkLSkVL",
-	"FyzPMXGcrQPPaxW4EEdtj9h7gmGVK4axN80iAlYaLsydV1VWCHnAaVj2iOiFFOV8ERSYruD0Kq8snUIc",
-	"jvKLKjE8CjPIzBSPyVIo7TRNO1K95Drk7G4quJYiz5tGKSuiz52l/SVdH6epfiHE+ZSm5/dQr+VCVyvN",
-	"xj6/uh0cWM8kW6XFmhdwYtuZ7y7Va9/DUDlHtIMZZIvF7d3YPQDz/W4OutvmftxdWHtdTWYaV2OOOaFa",
-	"LFkaP1NfVrRdb4xcjEVFa5bZ3oq2ygS+hoc9vKyq4ApkkV00A6fR5nDHxDEC52RGdmP+ixJ4e1wyA8do",
-	"ei7KLnNxUlSS9sp6LQAQUpv6rEtpGzKGkljFVcTclkpAF3kb0IG3CkYiXQ02M8LBgdJwJaA60Y8VgHet",
-	"8WFsa8vZSMqpWPvn9+ric5cC/uN2Km8wj74Qr9OatKQN8vKFano4QrzE9dZ4qDNMe58OjYqqmucOvOED",
-	"APrjpBowDIqW2heMGWU5ZEms9+JJZaMaB5q2S81qt0RnynHylJa+9aEZu5TgCqdYEV82/V8FNaQkqte7",
-	"lmSewRpsXsefIIXtaTgO/C+Q25aHLWOAKJIcVtAIH3PVXEoUNdkK/Leq+phkAAV6I9s2slhcVHiXtwwn",
-	"bu1JEFkzBLtRS4pFrN0pssNMEjXqrHlij4kaepQMRCuWlbSBP7WvyNE0A5qjHEFVR0dIvB45dJpf7Ahv",
-	"/QDH/vuYKOMx8X4YH9qbBcVRt40B7YyTLFXfqefxMMmwVFHlYMHZssoRa0m85huqoBe83yDZJfla3Rq4",
-	"T0zwALHP15CiVOP0HcicxtPjpHBVT5DaOUBmtQLzScTavgBOuAhaTF5QVakqdQ1F/4OdGF9i3GnTl3Aq",
-	"19GMV99ZgoMR1Sqm1qtIyIpOL2+e/yQncetB7B0vRiMKXPrfFvuXp26nduAL2Mqbm/00sj82aXS3mOPi",
-	"YzIt/UB5Li5sz8hQD30G3g9qqc+7gJxYzqpr2Udtjl15z7apgwXx6ku6IULiP0br/HdJczbbIJ+x4PvP",
-	"iFpQQ0LO8WojAlwUqJl4u3g19oB5a4vwU9l1s6FjBsNtzCgB0OYi9819BFnScwi3AYMdLP9MtWGcqpyi",
-	"5cJc2a3t7GLBLd6XaFnSLNT0sVBks426Lx1svv5/6ly4cCpf363Iaeo7hLoWRU0+g12APXHpBSy3J0t2",
-	"+ZongaqzcE200mfXZ5cwme7JumIZCH3tVxpgdzqudjrPXGkZAy2/rR4bW9JMBy3l0LswNOqmA3TYp3EX",
-	"+GHbypvBf7SGa98yhoD/ueC9p1FtCK/tSXsDWG5U4IjAaq3VU7FOJMzUrgATa6426rysa3d4EyvjqQSq",
-	"bMTNyWuneNYlShk3irCNCa18mtUoGcwYr5kl40WpI3oMVirlmwBhodEf0drjQuuTEowwuaL56xVIybK+",
-	"jTOnw7Z0DFtEeEeH+zZiwqju1O4ATNU6HOZn1mb08DVzgdsmVDZcU2nKMyqz8HXGSQrS3Pvkgm7U5T1K",
-	"lXNgl0+JBtJMs2pA4F1C0raA5BvnFL6iv6cCkB7Q8TPAYYNxwRFnjTXtaNHjn+nC8EU4bJZ0neRijlmE",
-	"PQfC1aZFD59VAQVHM7iVz4at28+j2J+wfRosy+8YkRY465Aptp/717iVqEb+wpneevKtjbKd1mnjbu3B",
-	"9Ejl8zr43xJL9zzGMnFd8ZUwG9cLmz5VxdMeBJsIPf6hpl28ZxcxDMKlcYdG8OHtzpqRFrF8X2sZSNBi",
-	"oLaE94OqQ9lp6sKzuqa0jqnBImXssqX3tLRZ+7y/l3rAs73p3VlvTluFzJhx9ukRtz0/OilEkaRDYj5t",
-	"547MuQkcpE0Ye+gjcAL0rLsKj1FVL5tG3aNGU5t92+T1NtXZ5e0q0m1Kf5+ZqIejN10QYoa8zHZuR+sW",
-	"ZvJUxpRxO8esaQarmAShREJaSjQTX9DN7rZjPRWjT386/vrho98fff0NMS+QjM1B1VXHW2276rhAxtt2",
-	"n5uNBOwsT8c3wVcfsIjz/kefVFVtijtrltuquqRop2nZPvblyAUQOY6RdlGX2iscpw7t/7y2K7bIg+9Y",
-	"DAXXv2dS5Hm860MlV0UcKLHdClwoRgMpQCqmtGGETQ8o03VEtFqgeRBr/65sNRnBU/D2Y0cFTPeEXMUW",
-	"0hdQi/wMc7ud14jAusgdr7Kenm3rcnqatdCh0IhRMVMghSicaM9mJAYRZhDJILPWGT7RIh7EyFbM1kbL",
-	"xgjRRZ7HSS9smL2d2zebueo4pzebGBEv/KG8BGn2+Sf66xZchpPUpv3Phn9ECjEcjGtUy70OXhHVDy7X",
-	"lH8QaN2k/Ah5IAA92baNPMkgUSwoRCytlwD9Cd6B3BY/XtaO5Z1pIQiJ/2AHeGH6bP1elcngwPnEFX1f",
-	"VkgJlvK+jxIay9+VketZb3WRBFvkjCZag7JsSXTFwiDdWj2tsph7tJJOsrMUQhOjmeZ5JEna2nHwTIWE",
-	"Y1QCuaL5zXONH5hU+hjxAdnb/tSoMFM2RLJFpbpcnb4XdNDcQVbs4abmbzAx+59g9ih6z7mhnBO+c5uh",
-	"cQc71s/9rWBzvckFjmmDrB5+Q6au2UYhIWWq7dy/8MJJlRgKks1cQCus9Y5M1F3r/FXoK5DxzEfikFeB",
-	"e6vy2TsI6yP6iZlKz8mNUnmM+jpkEcFfjEeFzXl3XBdXbMxwubIvQQG3Pcu+dNsOD12eLW1iLp1SQXed",
-	"g2/rBm4jF3W9tqE1iwb3d3j37jc9HVJqKN6LwXyOtY4O0pRhr5YM11DlyOLIjeHmjVHMr311b21t157a",
-	"3K39KFm+M2ClUWn943g0Bw6KKawl/rvrHXOzd6mHwFZe6B5VC+tVysVYxETW2pg8mCqooT6gfLr7LFLz",
-	"GrMa01IyvcG+wd6Axn6P1mP6sart4WrDVL40d/dpcQ5V7/a6Ekip/O36o6A53kfWxcfNLSTyCXluK3y7",
-	"g/Ldnel/wFf/eJw9+Orhf0z/8eDrByk8/vrbBw/ot4/pw2+/egiP/vH14wfwcPbNt9NH2aPHj6aPHz3+",
-	"5utv068eP5w+/ubb/7hj+JAB2QLqS/s/Gf1ncpzPRXL85iQ5M8DWOKEF+xnM3qCuPBPY19IgNcWTCEvK",
-	"8tET/9P/60/YJBXLenj/68j1ZxottC7Uk6Oji4uLSfjJ0RxT/xMtynRx5OfBboMNeeXNSRWjb+NwcEdr",
-	"6zFuqiOFY3z29vnpGTl+czKpCWb0ZPRg8mDy0LW25rRgoyejr/AnPD0L3PcjrK95pFzp/KMqV+vjuPOs",
-	"KGxhffPI0aj7awE0xwI75o8laMlS/0gCzTbu/+qCzucgJ5i9YX9aPTry0sjRB1c54aMBLOo2tHXWg+La",
-	"PhCxKKc5S32NMqas/dgG2KuwuayzrJdqTKa2/7AP4uUZhijZagQq7MF9khlE2+9PambnWyijX3n05LdI",
-	"OSuf+eE7+4ZBZ0E42v85ff2KCEmcWvSGpudV1otPc6pTu8IsJ/PlxNP9v0uQm5ouHcccj1TVHhx4uTTM",
-	"x6XPLNW8aFZ2raWxmLWog2w/syGn4EBUhU5qhoemwQCSmn0blvwg+fb9h6//8XE0ABCsuqMAGz3+QfP8",
-	"D2tegzVG1rYib8Z9MVHjunAGflDv5BgtWdXT4PP6nWZB9D+44PBH3zY4wKL7QPPcvCg4xPbgPbYiRGLB",
-	"s/rowQPPoJz4H0B35A5VMMugHgDWu1CN4kniEgN1GZl99LaqjSlpYQ/jsY8f3hSVf8e+NDH86vEBF9qs",
-	"4Hnl5baH6yz6e5oR6fKXcSkPv9ilnHAbC2ouJHtxfhyPvv6C9+aEG55Dc4JvBn1+uzfNL/yciwvu3zRC",
-	"U7lcUrlBkUhXvLDdmIbOFTpVkUXasx2UX+Pz0fuPvdfeURj0ePShUTspu9KlaL0sjbZOu+/JHs6JY9ms",
-	"NPfD3eOiwJjP0+r5cVHYtuEYRwAMbz9YM6XVvQn5Mfy64RyxkFjfSCMpwHfR9r25G77yoB9n9NJuVCW4",
-	"vb8/7f193DSSsAy4ZjOGAnsMmMYp2ApTJ1rpqhdoN0koqJG0b0B0VR/biRaJ6702cAzXhf9wjQUHlEax",
-	"M72PqZA7GfUt7npw1ycmBfBWElPd1fBmWLMvtVvdJI0r4xoZ9xcu9L2kuaGTYLmtljYnz26Fwb+VMFiV",
-	"5Jxb6awoDiAe+syNXa8cfXBlJg8hNaJ6PEheDDXv4Nsg+P5ui+Pcm5Dj9juXYyuuTOdOSdC8dysDfg4y",
-	"oK1zukv6c3T8SeW+MO9rnzSshsBifh/08Rcu6P2NkdUr2RlId8t0l2CfHXnNMetrY6t/STnNIe1WQvtb",
-	"S2hV8ewryWhh7OuRK0MQSGxXMvC1DXhMV5JYs4B6wNmw3ggm5NsjPK7j/A2LsQHMLnRZjb3yiJ5aq1fa",
-	"zRp3VMuuiPUjhDrs95uTZ7ukqy/IFDS4D3LkFojvzXXz0qhn4u3NeCaG8abHDx7fHAThLrwSmvyAt/g1",
-	"c8hrZWlxstqXhW3jSEdTsd7FlXiLLVUV6syhbfCoqhDpOHhu3rYBIHcx5bfZOevehHzvXq3LgLiU9rkw",
-	"jMqnilE5tx8ZXmeQQe74P5/g+Hcm5AdMgNRqjHFsmFmBLzKunzx89NVj94qkFzZMrP3e9JvHT46/+869",
-	"VkjGNYYMWD2n87rS8skC8ly4D9wd0R3XPHjyn//135PJ5M5OtirW329e2Va7nwtvHcdKHlYE0LdbX/gm",
-	"xbR11wJ5J+puxMP/vVhHbwGxvr2FPtktZLD/l7h9pk0ycopoZexsNOM54G1kj8k+99HY3T+YxVFdJhPy",
-	"Sri+aGVOpS0QgzV0FZmXVFKuAbKJp1RMwVO2kl2aM6wdIIkCuQKZKFbVqi4lVFVMCgkrDL+vq7w2INjN",
-	"6DFI97Nl8i/pOsibn1bXtBZuyWj2XNI1wUYfmijQY1tCbU2++448GNfaS56bAZIKMTHmuqTr0Q1a/Spi",
-	"G1oX6JnDjpC7Y39x7CEWpFr6qQpM1qrG351zf7GSuyV3t7EH4px7O35qx05oR3Ddx7ZaEKxgp7EcsiqL",
-	"It/UhXCNlOdFqDiLMzMMNQ58xj6CnabpqBLaRu/tIb41AlyJlbQJak+2gQmt6ugD6uUhz+icW0zI+3u5",
-	"SwPfkRRL7zwSZAY6Xbhc4BbqI+xJunzEft60ZJwtDZQPxgPkrqrORtVmpdG6+S7Gm2OlHKyPt8GCWxIL",
-	"2rEZFua459vRumrUWPCgDsCOo9YOn5hJY2JY3VHgwGIYkl23YnO45IzakgFDGqIFeaXocQQZOXWv8T80",
-	"D5FWtSjx9RcR/RUGXYtYay2wTaNdjoLPcS5oo+Xtbiif1pN3JUhEyyEctrcI3g/BHW7+3NVnsKfQLeKv",
-	"kMXgdd+EvBJ1Cr1V+f6SvtLrFEWue0GvBAcbFGBEdUuLt/7fSk6qr0lfO8UqXHVDsMvKTEe+5tBWwekn",
-	"89IO4WmIuIH1i65d5riGK/ynaGWmxi1j1jbZWRiiHm0IczYv2g4OoZA0+ZRq1yfhp5+hLvYpONbNsBg8",
-	"pJ7POLGAH5bpYDkiS8xHha8d1ceBXpiXA7nMVmgazI20qOLmIFIHiUwhF3yuPk9WtI064niJUImtumUb",
-	"wXTWP/kbnt2nrkuLdnnSrvaVYjwFosQSUGUwMroroW0h/MfNQajZ0vca52E+7ifmLl8/+Ormpj8FuWIp",
-	"kDNYFkJSyfIN+YVX3Viuwu0UoW7PQ/N1hDkwju6xZo20NCzodAUm6Hr9x83cztBeV3lUVq4SpQZp6/u1",
-	"mm6xDpOOGbCRYbwwUx9AnsvF/EsT5zzWh5alfkrzHNG1yyuGAw8Kq85zu5+wZFrXTS7C25U8p+mi2ttx",
-	"bY6sWhH6aujjVv1MHNn1pbO1CRSYfdZAgtUE1gqQtqm6xk5TzrS2LHPNirz5TdWrE3sXReKmLG2GbQ9O",
-	"nvnVWW+ymNVDt+nX1053g0/M3O4RzsyFXRyVgLw7NP+FZtpJA2jbxcvHiwe9l1wHKVeakclWrcw62Kco",
-	"gMr6Y0v5dwsJiRtC0hVIRfGwthZ171ZU/zxE9bUrzvyZCOpRp+pVef3lr6JG2PcHvWbZx91yeVDfeE+R",
-	"nPFAJA/ZhT1rl5fFd7sf2r3QT56FmTWiqgDmBYQeUAyK9kwu+1+jgT4brCojZk4PK7kF1BfldBKrS3sR",
-	"s3EVWGoUUjF7Qt7x+0QtqK8Z7f589PU3fa4Rqhaull7X71QPZB7bYYY4n75oV9phJY4Kv09uerf328Tx",
-	"iGXrSGF8nsE66MXS7NXs7sM7yvnq4t1Finh96EoxDYddgrmm1IIVN1+DWGk2jRdh95a4quf/Cf++Msja",
-	"QrlGaig+Re3Z8UhLgAwKvdhZkhrfqncTXHFqplwbIVs4eEzYBCa2rm7d7i2bg7uYKMmBzqq+bUIMSTwM",
-	"+IwhNE8VAdbDhQyRpKP0gzIvEuXN20nrBD170XnktYXiTyqE6U8lhCUtKayJlk8nk2EDinEQKlZIoUUq",
-	"chv3WRaFkLo63WoyyPIAfYJew/DQR7hXEubWLFM7XTpn+NYBbABNylZfjEvnzKMp5tOJLeqShXLruYaw",
-	"tDNREKvgt0D4pHztVqmM8bOW++dL9/7oXtI7sDMopTpdlMXRB/wPFgr+WCcZYwsVdaTX/AibZh592BoO",
-	"jCw1N7KJtN1XGibdTgvOaFDvC/y87vTyg5Dt9uY7w31bSBu3L33bABTjhiPs8Xq0yb+1ErbVddba8KtH",
-	"g0RG7JzXqoZG0Dawot2gf5Avi2GbhkZI+DZ66fNaUO1PnDGeERpsY8vWJGTNCK7Zp3jdi/4ULsqbD9n6",
-	"+gs+Z6+EJifLIoclcA3Z1SL1SZvD+dtj63W7n2Dgrv5uOH/3zg9vfJ+EVMkiOy/4PfSeoOwS+OmoxDpI",
-	"5q6+oaj525v8s7rJn1be1pAMb+/lL+delj516vYK/vyv4K++2NVcYwzTwCv5Es7h5jVca+J7XsgdYcDZ",
-	"sFqGg21+ZVS926tUPwjpu+Td3uJfqFPU7uTgQKwhFppdllg35SGyzj4r6IfZGfI8YmnoO6jjKtaLYYFJ",
-	"kTJsJ3SSqbELKrPGCXeKbwWfz1rwCfb6Vu65NT18YaaHHinHaf15PkTQ2FcAWi1FBt6xKmYzV9C5T/pp",
-	"trA05Kk0XRbEfjnpjcM+Y0s4NW++tlMc9IqtwW6JRS3wDLIUpIJnakAUhxv1svcQOpr6Abhxz2a1Ax4W",
-	"V+ppcmmSfRvUi+xQAmkjX2HrUV/Y2iEjgxUxBDg5ANkefbD/ojmtECqymlNPwJ2Nueu2xVbqtuM2ACRv",
-	"UAi1Jb/9V2JGHtiC3SXHJPe6xzjlGdFyYwRVX59QAs1J2khureDonpzT3pOzUxXorK5nTXFdQNQn9JAR",
-	"DK3CAj/f+AF4Srkj+S6CtCCUcJhTzVbgXf6T2+pZl77NXO2qLQxwTGiW2dNYbwKsQG6IKqfKyDq8maN0",
-	"RzXPyx4MA9YFSGauaJrXDnirJhzZ0ljb4ohO7RtXvLRavMgW5JLNqEV/s7pyXWJGXrJUiuN8LqpYeLVR",
-	"GpadDt7u0997Gix4Q0I3ZlXwnHFIloLH+kq/xqcv8WHsaywv1vfxmXnY923rvm3C3wKrOc+QO/mq+P1M",
-	"Tv+VAl1aq5VQCGm026mtR2Tpf8+j5A/Nhqfdk7ThaeDUcg+DgcJ20o2fj3w6QqO5dPTND40/XQk996Za",
-	"lDoTF8EsaAOw4YxDqmeh8L1nkkdtc2tmTzJ1vVa36/Q2BXiIna3qaaRncP2wv23w3zQJ2zlnQiJxOY0r",
-	"kKqlyN1mYv+lMrEH7/te3Nj2yN/F0Up1WNnllcjAjlun45qjH+vawkUGrpV/V2SpwiLjKUP+/qrfayVx",
-	"pLScLzQpC6JFLF2k/jChqWWyiVWE4hMGdZKtuoTTLegKCM0l0Mwor8CJmJpF1zcpLpIqrFTtc05c8GdU",
-	"aArgKqRIQSnIEt+lZhdo/j0bqq634AkBR4CrWYgSZEbllYE9X+2E8xw2CSrDitz9+VejWt84vFZo3I5Y",
-	"Wx83gt522nUX6mHTbyO49uQh2dmEbku1mCInlkUOLkkugsK9cNK7f22IOrt4dbRgFhm7Zor3k1yNgCpQ",
-	"r5nerwptWSTm/u6C+NQ+PWNLlMQ45cJbIGOD5VTpZBdbNi+Fa1FmBQEnjHFiHLhHNX1BlX7r8qUzLPNo",
-	"rxOcx8rYZop+gM0tanWLyMi/2oexsVNzH3JVKuJG8DlQkMXWwGG9Za5XsK7mwtopfuwqycraAneN3Iel",
-	"YHyHrKBVD6E68Pub4SKLQ0sldaaMLiobQNSI2AbIqX8rwG7o8O8BhKka0ZZwsPVASDlVndrxSGlRFIZb",
-	"6KTk1Xd9aDq1bx/rX+p3u8Rla2HYezsToMIEOAf5hcWsQlPugiri4CBLeu5y5Oau9WoXZnMYEyyzlGyj",
-	"fDTumrfCI7DzkJbFXNIMkgxyGjG6/GIfE/t42wC44548k5XQkEyxRkp802tKlr3GpGpogeOpmPBI8AlJ",
-	"zRE0ynNNIO7rHSNngGPHmJOjozvVUDhXdIv8eLhsu9U9BiwzhtlxRw8IsuPoQwDuwUM19OVRgR8ntfmg",
-	"PcV/gXITVHLE/pNsQPUtoR5/rwW0DX/hBda4KVrsvcWBo2yzl43t4CN9RzZmavwi3QLtKKdrTLJrmloD",
-	"BXByGeX26IIyncyEtIJ0Qmca5M7Q+X9S5h3nPn1XuKorBEdw96YbB5l82ADPcRELAnHXhSERV0nK3GGU",
-	"PCRLxkttn4hSj235awk0XRihPbTB2pGwhbEr0iRhTmWWY3vbWXVvCmmLPunWBY9AR/IRmxq/WfcPQg7q",
-	"AtAsHUmZJiXXLA86IVV6++dnvby1SNxaJG4tErcWiVuLxK1F4tYicWuRuLVI3Fokbi0StxaJv69F4lOV",
-	"SUq8xOErNnLBk3Yw5W0s5V+qqnx1VXkDCVonLijTrq+/r1LQb7fYwxCkgeaIA5ZDf3S3DTo9e378gihR",
-	"yhRIaiBknBQ5NaoBrHXVZXpKFXzz2Kca2quTLm2rerxfzQtfPSKnPx37iqMLVxmz+e7dYxuvRpTe5HDP",
-	"tUUDnllJ1PdHA26Q7tqjUX8l+G7Urjc3yzEyXpHn+PYzWEEuCpC2mCG2E+xafM6A5k8dbnYYfP5pJneh",
-	"tn+Y0f4YN4xeDm1LWngx36+VKkJtxiV5FuRg/jGjuYI/+tIw7XhLWgzoRIjM5HuRbVonxOzaEW5g82zU",
-	"dUcZp3ITqRLVTYFok4YWhl05wurasj4evDpul2i7ZLaLwmLSui2DHx+9j8qjZWGrDesMZRN1Zy06GcVy",
-	"TNu1UEcVgIMKA2KahN0T8tZ+92nLACJE7ojVzPyziWJsvlkxDXzXKBGO9XypuQQe8dHTi2d/bAg7K1Mg",
-	"TCviC+zuvl7Go3ViRpoDTxwDSqYi2yQN9jVq3EIZU1QpWE5330Qh/8QTV10+5sn2e+rTXCPPgsVt48kh",
-	"0awTx4B7uPNGw2DeXGELR3TsOcD4dbPoPjYagkAcf4oZlVq8b1+mV0+zuWV8t4wvOI0tiYBxV5C8zUQm",
-	"18j45EaWvJ/nPV9DWhrgwpN8F63z6JKDtW44WTOYlvO50Ra6Pjpso4PjMcE/ESu0yx3KBfejIDt41d7/",
-	"qknq7eG63CXIG7/rKzPew+2gfIPOjGVB+ca7fCFRbFnmFoe2qfRhGa2tGR4rMV3b/vqs2m+8yS+w3bqr",
-	"tvm7RQu5oIrY/YWMlDxzGU+d2tZrPrzOiR36bM1rNr21poldb2R1bt4hV4Tf5WaquSIFyESvuT1QjcPk",
-	"OhjYk/tJa2nfXhs3d23YRHXoYbDdavw1QzjQ7SEDvobXR9BzqU7Ma3Rios10wsYztGj0p7iEzZnsmwcN",
-	"LOkM34wvqc0tzn8KeUEoSXOG3lXBlZZlqt9xiv6bYGGTbuyJN1T3876n/pW4CzHi4XNDveMUg4wqr06U",
-	"B84g4sL4AcCzWFXO56AMHw0JaAbwjru3GCclN1qYmJElS6VIbGqtOV9GdpnYN5d0Q2ZY0USQP0EKMjW3",
-	"frDr1pasNMtzF+xipiFi9o5TTXKgSpOXzHBgM5wvp1CFnIG+EPK8wkK8V88cOCimkrhh5kf7FNvhuOV7",
-	"AyAaM+3juo3FzfbB8bCzrBfyk2cYo4bVmHOmwv6LbdhvzDe+ZDyJEtnZAogLF2vTFrmLNeAcAd1rOo70",
-	"At5xc/tpQZDjU305cmh7gDpn0Z6OFtU0NqLlKPJrHaT+HYTLkAiTuXW7/IVSSAM68J5N3HhbX7+193u6",
-	"WBpXLmBr0L4L2T517RN7XnIKRMNI1ipw4944a4C81X/x5ZeVPLwu6dF4MG2yO2CXXTUb5CHe/IaPCc0F",
-	"n9u6ika7FLhPjBelxgDw6zTgwYrmiViBlCwDNXClTPDnK5q/rj77OB7BGtJES5pCYi0KQ7F2Zr6xdIqN",
-	"BjnTjOYJatVDAYIT+9Wp/WjHfRx0G10uIWNUQ74hhYQUMluIjClS6/MTW6CBpAvK53h1S1HOF/Y1O84F",
-	"SKgaMxoVuj1EvBDMmie2KF0XxmPXqDms2ws0XUQax+AFZ3R2T1BZoyfVwD1olBztU9LHo15B2yB1VYfO",
-	"WeQ02cwAKaIhDwT4qSc+RI3WW6K/JfovnehjJRURdbOWtcLiK9yWazZrXXcB0Ru0kn2S6sK3Jfr/6iX6",
-	"PQdShBJJGzpIvDccVYRpcoFlkaZAzP1VonXeNdxz+jpm2gVH3VXaVK49X7qgjLuaOlVeA8JhVOLlkmnt",
-	"29Nei2HTMjO0aBp0QFpKpjeotdCC/X4O5v/vjdivQK68QlPKfPRktNC6eHJ0lIuU5guh9NHo4zh8ploP",
-	"31fwf/C6SCHZyuhXHxFsIdmccXPnXtD5HGRtQhw9mjwYffy/AQAA///NtAadu78BAA==",
+	"H4sIAAAAAAAC/+y9+3cbN9Ig+q/gcPccP5Yt2Y6Tb+J75uxV7Dy0cRwfS8nst3HuBOwGSXxqAj0AWiIn",
+	"1//7nio8Gt2NJpsSJdsz+skWG49CoVAo1PPPSS5XlRRMGD158eekooqumGEK/6J5LmthMl7AXwXTueKV",
+	"4VJMXvhvRBvFxWIynXD4taJmOZlOBF2xyYu4/3Si2D9qrlgxeWFUzaYTnS/ZisLAZlNB6zDSOlvIzA1x",
+	"Yoc4fTX5sOUDLQrFtO5D+bMoN4SLvKwLRoyiQtMcPmlyxc2SmCXXxHUmXBApGJFzYpatxmTOWVnoI7/I",
+	"f9RMbaJVusmHl/ShATFTsmR9OF/K1YwL5qFiAaiwIcRIUrA5NlpSQ2AGgNU3NJJoRlW+JHOpdoBqgYjh",
+	"ZaJeTV78NtFMFEzhbuWMX+J/54qxf7LMULVgZvL7NLW4uWEqM3yVWNqpw75iui6NJtgW17jgl0wQ6HVE",
+	"fqq1ITNGqCDvvntJvvjii69hIStqDCsckQ2uqpk9XpPtPnkxKahh/nOf1mi5kIqKIgvt3333Euc/cwsc",
+	"24pqzdKH5QS+kNNXQwvwHRMkxIVhC9yHFvVDj8ShaH6esblUbOSe2MYH3ZR4/o+6Kzk1+bKSXJjEvhD8",
+	"SuznJA+Lum/jYQGAVvsKMKVg0N+eZF///ufT6dMnH/7bbyfZ/3F/fvnFh5HLfxnG3YGBZMO8VoqJfJMt",
+	"FKN4WpZU9PHxztGDXsq6LMiSXuLm0xWyeteXQF/LOi9pWQOd8FzJk3IhNaGOjAo2p3VpiJ+Y1KJkWuNo",
+	"jtoJ16RS8pIXrJgSLsjVkudLklNth8B25IqXJdBgrVkxRGvp1W05TB9ilABc18IHLujTRUazrh2YYGvk",
+	"BlleSs0yI3dcT/7GoaIg8YXS3FV6v8uKnC8Zwcnhg71sEXcCaLosN8TgvhaEakKJv5qmhM/JRtbkCjen",
+	"5BfY360GsLYigDTcnNY9Cod3CH09ZCSQN5OyZFQg8vy566NMzPmiVkyTqyUzS3fnKaYrKTQjcvZfLDew",
+	"7f/r7Oc3RCryE9OaLthbml8QJnJZsOKInM6JkCYiDUdLiEPoObQOB1fqkv8vLYEmVnpR0fwifaOXfMUT",
+	"q/qJrvmqXhFRr2ZMwZb6K8RIopiplRgCyI64gxRXdN2f9FzVIsf9b6ZtyXJAbVxXJd0gwlZ0/dcnUweO",
+	"JrQsScVEwcWCmLUYlONg7t3gZUrWohgh5hjY0+hi1RXL+ZyzgoRRtkDiptkFDxf7wdMIXxE4XOwAh4tx",
+	"4Ai2TtAMnG74Qiq6YBHJHJFfHHPDr0ZeMBEIncw2+KlS7JLLWodOAzDi1NslcCENyyrF5jxBY2cOHZpQ",
+	"Yts4DrxyMlAuhaFcsIJwYYGWhllmNQhTNOH2907/Fp9Rzb56Pvmw6+vI3Z/L7q5v3fFRu42NMnskE1cn",
+	"fHUHNi1ZtfqPeB/Gc2u+yOzPvY3ki3O4bea8xJvov2D/PBpqjUyghQh/N2m+ENTUir14Lx7DXyQjZ4aK",
+	"gqoCflnZn36qS8PP+AJ+Ku1Pr+WC52d8MYDMAGvywYXdVvYfGC/Njs06+a54LeVFXcULylsP19mGnL4a",
+	"2mQ75r6EeRJeu/HD43ztHyP79jDrsJEDQA7irqLQ8IJtFANoaT7Hf9ZzpCc6V/+Ef6qqhN6mmqdQC3Ts",
+	"rmRUHzi1wklVlTyngMR37jN8BSbA7EOCNi2O8UJ98WcEYqVkxZThdlBaVVkpc1pm2lCDI/13xeaTF5P/",
+	"dtzoX45td30cTf4aep1hJxBZrRiU0araY4y3IProLcwCGDR+QjZh2R4KTVzYTQRS4sCCS3ZJhTmaTFNn",
+	"sjnAv7mZGnxbacfiu/MEG0Q4sQ1nTFsJ2DZ8oEmEeoJoJYhWFEgXpZyFHx6eVFWDQfx+UlUWHyg9Mo6C",
+	"GVtzbfQjXD5tTlI8z+mrI/J9PDaK4hLUSzPmRA24G+bu1nK3WNAtuTU0Iz7QBLcTlDUfpgENWjNzCIrD",
+	"Z8VSliD17KQVaPyDaxuTGfw+qvPnQWIxboeJC1oRhzn7xsFfosfNww7l9AnHqXuOyEm37/XIBkbZQjD6",
+	"tMHioYkHf+GGrfROSoggiqjJbQ9Vim4mTkjMUNjrk8kvmlkKqeiCC4R2Cs8nQVb0wu6HRLwDITAd3kWW",
+	"lnDQRoXqZE6H+qOenuUzoNbUxnpJVBNKSq4NvquxMVmyEgVnKjxBx6RyLcoYseFbFhFgvlK0srTsvlix",
+	"iwt8z9tGFtYbXrwj78QkzM3neKMRqmuz5Z2sMwkJfOjC8E0p84sfqF4e4ITP/Fh92sdpyJLRgimypHqZ",
+	"ODgd2m5GG0Pf0BBplsyiqY7CEl/LhT7AEku5D+uqqpe0LGHqPsvqrBYHHnWQy5JAY8JW3Jjm4Wg17Pb9",
+	"Rb6l+RLEApLTspw2qiJZZSW7ZCWRinAhQNtlltQ0hx9H9u8aPEeaAbMzjESrcWomVLGpoItQjKwo3kAr",
+	"eM1UZbtP4KCarlhHCsIbUdaoRYgeGqev/OrYJRPIk8LQCH5YI2pr4sGPyEn4hDMLaRdnNYDGm+8C/gK/",
+	"aAENrZv7VDRTSFVYnbWB37giuVR2CHvDu8nhP4yqprOlzoeVYpkbQtFLpjQtYXWdRT0K5Huo07njZBbU",
+	"0OhkOipMP8As58B+KN4xldDS/Iz/oSWBzyDFACU11MNRGJGRObWwFzOgys4EDVDfKsnKqjIJ6Bf3gvJl",
+	"M3mazYw6ed9a7anbQreIsEPna17oQ20TDja0V+0TYnVXnh31ZJGtTCeaawwCzmVFLPvogGA5BY5mESLX",
+	"B7/WvpHrFEzfyHXvSpNrdpCdkGv7n1HM/hu5fuUgk2o35nHsMUiHBQq6YhpvNxEzTpilscudzKS6njTR",
+	"uWAEaayNhMKokTA17SAJm9ZV5s5mwmJhG3QGahw8tgsB3eFTGGth4czQW8CCNjQC/gZYaA90aCzIVcVL",
+	"dgDSXyaFONAPf/GMnP1w8uXTZ39/9uVXQJKVkgtFV2S2MUyTh04tR7TZlOxR8nWE0kV69K+eextVe9zU",
+	"OFrWKmcrWvWHsrYv+/q1zQi062OtjWZcdQBwFEdkcLVZtBNr1gXQXrFZvThjxsBL962S84Nzw94MKeiw",
+	"0dtKgWCh23ZCJy0dF9DkmK2NoscVtmSiQJrHdXBNtWar2UGIamjji2aWgjiMFmznodh3m5ppNvFWcZ1L",
+	"IVhu3jKmDrDKIgzIBnQAjbmxYkxpEvcY8eZvTTBq9TvnBDyojaoPoeZhSkmVFEUqJY3MZZmBvMtlQlHz",
+	"1rUgroUn26r7u4WWXFFNYG604taiGNDHgHl29D1uhz5fi4ZGtt7kdr2J1bl5x+xQG/nNa6xiKjNrQfCU",
+	"ttREcyVXhJICO+IGfs+MlUP5ip0Zuqp+ns8Po/WVOFCClvmKaZiJ2BaEC6JZLoV1atxBxm7UMejpIsZb",
+	"28wwAA4jZxuRo8nwEOxrWKu34gL9F/RG5JGKD2AsWbFgagQ+xqvyhtBhp3qgE+AAOl7jZ7RZvGKlod9J",
+	"dd6I8d8rWVcHv6a6c45dDnWLcVaRAvp6dTgXi7LtSLsA2I9Sa/woC3oZlCl2DQg9UuRrvlia6N38Vslb",
+	"kA2Ss6QAxQ9WaVZCn77q7I0sgJmYWh9ApG4Gazgc0G3M1+hM1oZQImTBcPNrnRa2B1wv0ecLXdVMLL+j",
+	"noZrMmNAXTmtYbVg4pap+6LpmNHcntAMUaN3Xei2lZ3OuvWVitEClGJMEDlzvh7OCwUXSdGLzHhx1Yn6",
+	"CX7RgqtSMmdagznNar53gubb2avDbMETAo4Ah1mIlmRO1Y2BvbjcCecF22To86jJwx9/1Y8+ArxGGlru",
+	"QCy2SaG3q1fsQz1u+m0E1508JjursbRUS4zE10nJDBtC4V44Gdy/LkS9Xbw5Wi6ZQteaW6V4P8nNCCiA",
+	"esv0flNo62rAk9+pK0DCgw0TVEgvWKUGK6k22S62DI3itWhYQcQJU5wYBx4QvF5Tbaw7GBcF6nbtdYLz",
+	"YB+cYhjgwWcIjPyrf4H0x86l0EzoWofniK6rSirDitQa0DI9ONcbtg5zyXk0dnjzGElqzXaNPISlaHyH",
+	"LLsSiyBqgh3aWbb7i0PfArjnN0lUtoBoELENkDPfKsJu7M08AAjXDaIt4XDdoZzgQj2daCOrCriFyWoR",
+	"+g2h6cy2PjG/NG37xGWNPTgnKSTTaEhy7R3kVxaz1o99STVxcHhXA1RrWb+1PsxwGDPNRc6ybZSPTzxo",
+	"FR+BnYe0rhaKFiwrWEk3CScJ+5nYz9sGwB1vnrvSsMw6JKc3vaFk7/+5ZWiJ4yWY5htJ8AvJ4QjCU6Ah",
+	"ENd7x8gFw7FTzMnR0YMwFM6V3CI/Hi7bbnViRLwNLyVo5zw9IMiOo48BeAAPYejrowI7Z83bszvFfzLt",
+	"JvBtrjHJhumhJTTj77WAAZ24i/WKzkuHvXc4cJJtDrKxHXxk6MgOKOjfUmV4zit86/zAaGmu53YxSpHV",
+	"muxHtrHzJfRZSYXhElt7SaKKxwKRBZ+z3RkO/pLtTpD0hyAFM5SD7jj6YF+1bbCtZ3B3TH13OzAG9yfB",
+	"+2oA54ypQxg3UQ08fiWM7bZp2iHHaqSD+tlqpI/s4tB7IlJLHULvkBiVcBsmB+vwXvrwXIqbsDXNTbkh",
+	"FAWmDbliihFdz6zbTd8GaGSVxQMkbYpbZnQeBUl7/lYXhzMcKlpeyj/Svt+2w3feecS10OHebZWU5Qht",
+	"Zg8ZSQhG+TuRSsKucxey54O2/DFpAeku2HLjwXXXeoxmXAH5T1mTnAp8HteGBflTKhTqoC/OwHU0p3Oo",
+	"bTDESrZi9tWPXx4/7i788WO351yTObvyca6PH/fR8fixPQRLKdhMykP4/DBhFN/DiSHM/a0warPb9uGG",
+	"H3vmKz88cT3tgqU2LVZ5CPZGlTlNyDZoXQapLHmx2aiW7WZHN/KYJb/tDO4nRSaitTupsPwbc7wOK1qP",
+	"WXt8KMa5ZJr1yJWft534euvGfT/jq7qk5hCmZXZJy0xeMqV4wXZSuZuYS/HtJS1/Dt0waJnlcChzluUY",
+	"ajtyLHYOfWx0LozDBTfcR+aMBYid2l5nttMO/UfjTs5XK1Zwali5IZViOSusSYhrosNSjwgOS/IlFQt8",
+	"zSpZL5wHuh0HbzgIAsew21r0hkhK/GYtMrTApG4850vq45JB1mcU9A1d8419XV/RMJ8LRR/DtaI96Jqz",
+	"khbc6WRQHQNIvWzUMRY57eDqEbdf6zES4aeZeKSdD1EHkmwfX/G2wGGCzb0de1IzdArK/sSRW37zccgz",
+	"H3RB5eYAUp4diChWKabxTo51qNp+lfM4kYL3591ow1Z9M5Pt+veB4/duUJkhRckFy1ZSsE0ydxAX7Cf8",
+	"mOpt5YKBziihDfXtPpBb8HfAas8zhhpvil/c7e4J7ZpT9XdSHcpebwccLfqMMI/vlIfclNc14oO/eN/u",
+	"7cKsuwxAT4NHPVeEai1zjkLqaaGn9qA5U7mLyW6j/20IHjvA2euO2zHwxhk80IDByopQkpcczRtSaKPq",
+	"3LwXFBWo0VITnpZeUzSsUn/pm6R1+AkVuxvqvaDoZRvUqklvojlL6BC/Y8xr1nW9WDBtOo+7OWPvhWvF",
+	"BakFNzjXCo5LZs9LxRS6Ox7ZlhBMMQeaMJL8kylJZrVpP3cwi4A2oKC31maYhsj5e0ENKRnVhvzEwZcJ",
+	"hvMeKf7ICmaupLoIWEjf7gsmmOY6S3uEfm+/YvCNW/7SBeLA/11n7xnepDWZwDJbmYz+v4f/8wVkMKLZ",
+	"P59kX/+P49//fP7h0ePej88+/PWv/3/7py8+/PXR//zvqZ3ysPNiEPLTV04VcPoK33tRPE0X9jszTq24",
+	"yJJEFrsadWiLPMR8Lo6AHrU1t2bJ3gvwIzMS0gnxgprrkUP3humdRXs6OlTT2oiOptavdc9HxQ24DEkw",
+	"mQ5rvLYU1XeiTmeTgI30CSKgFZnXwm6ll75tsLR3fpTzacgYYpMJviCYTmJJvSe2+/PZl19Npk0aiPB9",
+	"Mp24r78nKJkX61Syj4KtU2/FOJLpgSYV3Whm0twDYU/6eVrHo3jYFQOtil7y6u45hTZ8luZwPq7QKdnW",
+	"4lTYKBw4P2h/3ziznpzfPdxGMVawyixTScZaghq2anaTsY5PFIQ8MzEl/IgddZVcBbwXncdpyejce48r",
+	"Kce8hsI5sITmqSLCeryQUYqVFP10YpDc5a8P/hxyA6fg6s6Zcrt/8P235+TYMUz9ALHlho4yhSSe0vZD",
+	"21vOENoK/Hwv3otXbI7aBylevBcFNfR4RjXP9XGtmfqGllTk7GghyQsfNP2KGvpe9CStweynUWYDUtWz",
+	"kudgnUiRp81o1x/h/fvfQI39/v3vPceh/vPBTZXkL3aCDARhWZvM5ePKFLuiKmWY1SEfE46MvbfOaoVs",
+	"WVuNsBufuPHTPI9Wle7mZekvv6pKWH5EhtplHYEtI9rIEDTKdYi7h/19I93FoOiV16vUmmnyx4pWv3Fh",
+	"fifZ+/rJky8YaSUq+cNd+UCTm4qN1q4M5o3pKlVw4fZZiQElWUUXKfvv+/e/GUYr3H2Ul1ewBSDoYrcY",
+	"JyEKCIdqFuDxMbwBFo69I/hxcWe2l8+9ml4CfsItbGdJuNF+RUkurr1dOxJl0NosMzjbyVVpIHG/MyEl",
+	"44Jyob2rEFiu4BC47JUzUCmy/MKlFWSrymymre5y3hI0Pevg2iactGHAmPIMLTKQiLIqqBPFqdh0c09p",
+	"G/aEg75jF2xzLpuMafskm2rnPtJDBxUpNZIugVjjY+vG6G6+c3n00eAuhRBGWHuyeBHowvcZPshW5D3A",
+	"IU4RRSs3zxAiqEogAjsMoeAaC4XxbkT6qeVxkTNh+CXLWMkXfJbKlf23vgHQwwpU6dKDOhf5MKAGmyA3",
+	"mszsxeqe9wp07ISi71MlNS1t6uOkRxG+h5aMKjNj1GzV84s4a4yHDvqTKzhZVsM3hSWwNew3N6ixE+yK",
+	"FU5RZNs41/qjYedICzgrrgmP7968FI4G37oOdYm0oP5WDtgNz1rnNxrT2fkyfF8xzCssr2BfAArpUuLa",
+	"zEvR/VJrumADb5fYejcyaU3L4oeD7JJIkjIIhum1RI2eJJAE2TbOYM3JM8zgCxxifGZ2vIX9TNYi7mxG",
+	"mOneIWxWogAb3Krt3lPVsqKKxTbQ0qyFKdGIgh6MNkbi47ik2h/HYhpx2VHS2S3mZtqWP/I0cnSNMheH",
+	"7JD+Nuxy0N6732WR9Kkjfb7I+NE/IvfjdGIZQHI7pEDRtGAlW9iF28aeUJqsZs0GARw/z+fIW7KUz2yk",
+	"oI4EADcHg5fLY0KsbYSMHiFFxhHY6OmBA5M3Mj6bYrEPkMJlZaN+bLwior9ZOurURpGAMCoruFz5gL0x",
+	"9xzA5YtpJIuOuz8OQ7iYEmBzl7Rkwvi3eDNIL40hPig6SQudr9GjoYfGFtOUvfL3WhP2uNZqYmnWA50W",
+	"tbdAPJPrzKYRSL5FZusZ0HsysAZ6JQ+mTRj5QJOZXKNzHl4tNpBjByzDcHgwGgAwEyCsHfsNyVkWmG3T",
+	"bpdzU1SoycMgdTbkMiTojZl6QLYcIpeHUQ7IawHQUUM1BVWcWmKn+qAtnvQv8+ZWmza5jX3MYur4Dx2h",
+	"5C4N4K+vH2tnbfyhyc45nAHQNbqbdJV9zdJN0ojazgiI3iuLaJccWkBswerbrhyYRGurVQevEdZSrIRw",
+	"kTBK9tGmWcnwEZy1RNPsgm3Sb3mG9/iZ7xYp63D3qNg8ijwmFVtwbVhjNPJ+QR9DHU8xx7mU8+HVmUrN",
+	"YX3vpAyXP3a0yvjWMu98BRgeMucK4hDA4pZcAjT6TqMS6TtompZAW5tNbEUQXqQ5Lk4LEYUFL+s0vbp5",
+	"f3wF074JF42uZ3iLcWEdtGZYwSbphr5laht4sXXBr+2CX9ODrXfcaYCmMLECcmnP8Zmciw4D28YOEgSY",
+	"Io7+rg2idAuDjLIh9LljJI1GPi1H26wNvcNU+LF3eqn5nAxDN78dKbmWKFdnOnxVLhYQxmdTcHl7mIgy",
+	"PZZSLKJSa1W1LbHlEeT31y495JbMki7ugA1FHUTifsbBYpuGPmpmIW/CPjErJk4CZnrMpZNWC8nFjpgG",
+	"bBHp6u7YFtqNeEg6QZ93jNmNd7LdpbCduAElo4V7k2jm17f9WPY3xKFuOuQ+3UpPvP0I4YBIU9xE1Yf6",
+	"OTIGGDCtKl6sO4YnO+qgEozupV0ekLaQtbjBdmCg7QSdJLhWvnvnau0U7Mf45j2GV5n1vXaOxUDfNHfZ",
+	"IYpaoQWj5dncL64Q3moj1/7jr2dGKrpgzgqVWZBuNAQuZx80RKULNDHcupMUfD5nsfVFX8dy0AKup2Mv",
+	"RpBugsjSJpqaC/PV8xQZ7aCeBsbdKEtTTIIWhmzy530rl2sbq5LClRBtzTVMVclcEj+yTfYrKB1IRbnS",
+	"jXuuMzu1L989dv1y9SPb4Mg7vV4BsB27gpqndwxpMKXpD590lGX+gY4xZp+XrS3cY6dO0rt0oK1xlVOG",
+	"ib+5ZeIVdZZyk4PROEkALGN24yztmwCnh7UR3yXlXZvAi90ySCTvx1Nx7evM9q+ikChlF+1CtkdPvLic",
+	"yYfp5GaeAKnbzI24A9dvwwWaxDN6mlrLcMuxZ0+U0wr8t2iZOX+JoctfyUt3+WNz715xxy+ZNGWff3vy",
+	"+q0DH0zSJaMqC5qAwVVhu+qzWZWttbL9KrEp+Z2i02qKos0PadNjH4srTL/fUTb1Khc1/jPNeN7nYp52",
+	"eN/J+5yrj13iFpcfVgWPn8bmiZ07Tj70kvLSGxs9tAPO6bi4ceWvklwhHuDGzkKRz1d2UHbTO93p09FQ",
+	"1w6ehHP9jHlT0y8O4bKqIityzj/04NLTd1K1mL+LTEw6D92eWAVCtsXjgK+2LzLbFaaOiBW8/lj8Aafx",
+	"8eP4qD1+PCV/lO5DBCD+PnO/4/vi8eM+0Pa2SzMJ1FIJumKPQpTF4Ebc7QNcsKtxF/TJ5SpIlnKYDAOF",
+	"Wi8gj+4rh70rxR0+C/cLmGPhp6Mxj/R40y26Y2DGnKCzoUjE4GS6snVtNZGi61ONQbBAWsjsXd0Ua4zt",
+	"HyFRr9CAmemS52nXDjHTwF6FdaaExgQbD2hrYcSaD/jmippHY0GzMQl9O0BGcySRqZM5hRvczaQ73rXg",
+	"/6gZ4QUTBj4pvNc6V51/HOCoPYE0rRdzA2OfaPib6EG22Ju8LmibEmSr/e5VsCn5haYqc+3pAR7P2GPc",
+	"W7y3HX04arbRbMu2C+a4d4w36CXVB86C6BmdM9YNzNEUAcV+NtsP19lcyX+ytCEE7UeJzB9uInyOYO+U",
+	"516XpQSjsl9PPPuu7R7/Nh7a+Bu/hf2iQ2nA61ym6VO930Ze59Gr07nEp5P4SKbhsh9JOzRggLXg8Yqc",
+	"YbFWkfc+osKeJ5sFohVhlj6VUQt9bMdvTqWDubureUmvZjS/SL+FAKZoe1t+UkYS39lvgA45DuzsJPLg",
+	"Dm25TXNYMdXYIPopk6/5rrHTjn7RNA8Y6Nh6ukytm0KpZWKYWlxRYZh3Y7D8yvXWzJrgodeVVJikVKdd",
+	"ugqW81VSHfv+/W9F3nffKfiC2yr2tWZRmXQ3ELGZUJGKXKn5kLnDoeZ0Tp5MmzPpd6Pgl1yDIzO2eGpb",
+	"zKjG6zKYw0MXWB4TZqmx+bMRzZe1KBQrzFJbxGpJwtsThbzgmDhj5ooxQZ5gu6dfk4fokqn5JXsEWHRC",
+	"0OTF06/Rocb+8SR1yxZsTuvSbGPZBfJs76ydpmP0SbVjAJN0o6a9r+eKsX+y4dthy2myXcecJWzpLpTd",
+	"Z2lFBV2wdHzGagdMti/uJprzO3gR2Khg2ii5Idyk52eGAn8aiPkG9mfBILlcrbhZOcc9LVdAT00NdDup",
+	"H+4Iz4bl6QEu/xH9Xyvv/tfRdd3xM4au0vRA0Uv5DdpoY7ROCbWZaUveeKb7orrk1Ce+xip3obidxQ3M",
+	"BUtHWRK2EAsqcWFQ/1GbefYXeBYrmhvMkTcAbjb76nmiWly7oJLYD/A7x7timqnLNOrVANl7mcX1hSh4",
+	"ka04sPpHTY6F6FQOOuompzVDfqHbhx4r+cIo2SC51S1yoxGnvhHhiS0D3pAUw3r2ose9V3bnlFmrNHnQ",
+	"Gnbol3evnZSxkipVzaI57k7iUMwozi5ZMbhJMOYN90KVo3bhJtB/XP8nL3JGYpk/y8mHQGTR3BYsD1L8",
+	"rz81afnRsGojETs6QKkS2k6nt7tjb8P9tG5d+611GMNvA5gbjTYcpY+VAe97/Lnp8zH8hbog2T1vKRyf",
+	"/kEUvMFRjn/8GIEGvaNt+sez9mfL3h8/TmfHTqrc4NcGCzd5EWPf1B5C9dQXfw6UFg0ORS4/Qn//Bi8p",
+	"+ABMcOaGmpJ2Gce7lyIOE9+V9jZNnwJwLoUvHg/4RxcRH5lZ4gY2UQrDh71dxjZJMkX4Hvm5U/KNXI8l",
+	"nM4d5InnE0DRAEpGqudwJb0yvUlz/U5/kYhGYdQZA/dS3apYFevzPx88w+KnW7Bd87L4tcnt1rlIFBX5",
+	"MuklPIOOf7cyeusKtqwyhTWwOApWJoezb9u/+zdw4pX+X3LsPCsuRrbtlom2y+0srgG8DaYHyk8I6OWm",
+	"hAlirLbTZoW0DOVCFgTnaSquNMyxX289Vee2T4J22FVtnN8qxoK7hENzXsL/BuzG2DJT1Awk0FIYxzhv",
+	"RmSXDCxV+GCzozNFKF/hxawplMHCk3nJwD8QukrBOt0xhRqOHJVTIbqCT9gSE1ZIYmoloOpktAwmDFes",
+	"3ExJRbW2gzyBZbE1zj158fTJk6TaC7EzYqUWi36ZPzdLeXqMTewXVwHM1qnYC9jdsH5oKGqfje0Tjit4",
+	"+o+aaZPiqfjBRq5CZ7y1bbHTUKD4iHyPmY+AiFu5/QGakES4nVCzrkpJiykmNwbPHGJntX0UQ0RhsdUF",
+	"wN8h/6R5ZXyCUZ/ZaSBzzvhxtqfygFVrk4XaqKnchNCiqd7KOz43qMeLsXNEXlkVqvYKOjsJwRTZasWK",
+	"qBSrfcQjccB/jKH5EhrIlgQ0zCvHVwn27Kyx3ETRh5f+IzJsgNsVCrZ1gqdEggL5ikO64iU17JK10yF6",
+	"MLxu3KdHbC9P1UJYSjnaQxgNhbj2RbsHDscNTgVJyDqI31MzZYum71s0+Qx7pWMxOhWYO1Z/n1zPp9gm",
+	"PznjQk6FFDzH2g8pSRpTt40zU44ok5G2L+qJO6GJw5Ws+xxigR0WBytBTyctxPVN/tFX2FRLHfZPw9au",
+	"HuCCGe04Gyumvhy9M4hxoZkrtQZEFPNJqRJOTclAiOBAsScZYVamAQ3nd/DtjdN/wxEkF1ygpsuhzb3P",
+	"rMkK8lgAtQvCDVlIpt162tE8+jfoc4RZGgu2/v3otVzw/IwvcAzrRgfLtj6j/aFOvAep89iEti+hrcud",
+	"H35uuYPZSU+qyk2ajGgNO5yqTj6I4JTfknckiZAbxo9H20JuW12/8T4FQoOiCkQbVuE93COMUOi9PQqU",
+	"VKgtRWELYiMqU0gpuUiA8ZoLb0JNXxB58krAjcHzOtBP54qafNliQ7scRgcCIDBCOb84xFCdDUaU4Br9",
+	"HMPb2NSoH2AcoUEj8VOxIf5QAHVHwgSEPwZX3H7FeZSqnBBVYHBRpwZ9inEA4858yGQLXTvD90J3rMax",
+	"7000lKNwVhcLZiD/XSq11Tf4leBXHyQGFUHqUFIsRAe2c5T3qc1NlEuh69WWuXyDG05XcE21ZqtZmXAb",
+	"fRU+siLsMFAaWFbg31TJqeGdcU7Te0fleg/pYr/E/P0o45TUCzSdQf6l8ZjAO+Xm6Gimvh6hN/0PSuk+",
+	"XPeTiMbtcLl4j1L87Vu4OOLEvT3/dHu1hLy66Asu8btPeBQyQra5EnzrF1ZDrwfcvMSWdYD3DZOAX9Jy",
+	"IBI+tpXY+9XaD4bi4fPB9A3UuPRchpKtLGgw5ZH1Fe5YX/omxCH/YOsefDirhVvrVoQO2+5+bFnqrI9Y",
+	"wywGLXTXM6I1G7yvFe3Hy6EUCb5OB36P64E4Lx7rrVUpdsll7TYs+ED7J6H91aXgadX9GFh/MrLgY1st",
+	"Bm0s5664sl2me5P/+Ku1wmI1uc0nYHHpbXq3qExC2sUWEcG6J3BPazbwqG3dimNq2KTKpTjZ0OvKLGtp",
+	"0VKv/EyPrF6NEQd6+PgwnZwWe12YqZI7EztK6ti95oulwYz9PzBaMPV2R0WCpgoBHrFKat7Uky1hMJcC",
+	"donDHY0NNgAC5nFFhf5Y3gn1kuUGayI3znWKsX3qK8Bk3uhzX5lg+DkdYjJcQYJtVQj6lYN33PG9xElR",
+	"8i9bmPRofM79k+BCbSPAoFBeSNfSiZkeHbk5n7McsyJvTVT1tyUTURKkqdfLICzzKG8VD3FMmNd7f61j",
+	"A1BJrwlPSQ8HzlAc+wXbPNCkRQ3JwqEhiO86iYMRA9YE5nNIDymSndcY14EyEAveJdh2Z01xjMGcz1Ha",
+	"tWvO5UmS0DgV25Yp0xX5R80FXfdK+4ghOUO5rAaqpaeOuFSY/Nh6oMcRNIma4wB5O6eZWFjFrB+AG6uu",
+	"J4rrC7RnItfTNabiT6Z8mmk2mI2kSVtrm7nJeFnCSQijBjYEWmRHIcom8ofZ+YC3vB0zw0CGNADRAYxR",
+	"AydxIUMxDTsjzCQVCbnP4e8Zm6MfYrQEXIE7yg1aIKUvQZ/VeTdZu5BoO7C9r3HwaW5qWoZzN5AQLMoS",
+	"C+eqnaM65D+X3rCEiAGrqsWOvg5cd3o7rCssz34jNHBN/DhwZDH5727UTF1NG2400UuqgsDfJK+/YNfA",
+	"XyC0TMA9X2w/QnMKVpTWIytf0rJkYsHsqcIImDCoJ12DwhdaMmABC0VzRiqmuCzSh6oByyWYTb0Rm2nw",
+	"SPjqbR7YSKoApNuagN3EidGcN7/bxtRU6HAk3xChD/1dgBEwQ4tAV04EW9kzzBjXqy3VE+74ntxRrGGA",
+	"BYZeaJrDFPZSWddvICwurkHRI0o1DEDjO/nlHhakW7jWp5NG5hukXS8GSsG6fKjp7dkIXEZporK3jOZS",
+	"ZI4Yd9+2K+k0Nm509037Gn54w2pL3GNIO5VyqJFlIlwkj2JyDf7+niRYYYoNJS+BxPWYlKdYSkI4IRVj",
+	"qsWqcimEvyD2eBC140qZHZdrFwAH8o2tOVMtpWAzKS/ijCJUEFmbhQSB44rNNPh1GRzC6toUW0nDyFJq",
+	"H9jLRS5X0Fy6AithThvVTMnbZ2/xhySzRFVr5otW7LxIobW/80LCUd/bvnf9/Nc4oRaYYRlyFyA6StR/",
+	"TRDCpmdDTNTwFfPleoXLXw28gmkIguF6aWt/kOCiB4cAO7BK5ss0B5kzLLYxQE7+K6FCyFrkjZTsVzne",
+	"qOOQBbYuOqBuVaykcGiDF1/FlDchEezXJVVLoFzEvonIt1eM6joqixPhrGIK8Q/YWUnBbWgYsixpY1BJ",
+	"LQwvwxhpzK30IpPzDH5RTJuWqWsbBfn1gDDk+t4CRaMv12iYsPVtQOH2abi2VMP3BDfchcHH25W+jgCe",
+	"bEhiK/mselbtwYsqxaXiZrMdTM9PKfHtuyPGALqrYPQW+A6kohvwW7qF3QBHmLHgtMvQHhqSQUdG56jc",
+	"qkEequWlaKKTX37s8uhCMVsTC7odfIWDCpdwIDoMv0FJxJIjwuxs3hbukyK9LnJ67KF1A/bu5rQwgwJR",
+	"ZEsZNk6/YobyUrvoSRqqUsUuHOCN1q2qfOWqWmHO+eBY6+tbMe1/8wUm7Cwlv3DFJVG2tm7MUJPEtzhI",
+	"xnBsRnga6HmYmTfZPfoRMP07xSbKyUtUBGVD2YY6J9VHoz7QNmy4ye6McM2ZUqwI/rKl1Cwz0st+2+DY",
+	"hgqNeqZrIUEP1sa2wA3WRXvXFH5b8VxJinXQqAuJjhcI4ioF6FRUnm14zm3Ifmm/+wyNXsuw0/0o0Gu2",
+	"M/7O53XhuofEmOrnxJlSdmd+vI4nEheCqcy7JXdrtYl2un4sylLUTiCMD0bw1hqdWHkLK0k68eT9VXYM",
+	"yFEGxQu2ObYWcpdLMexgDLRVMFvQo2o0nU0+qG+WTsG9OAh4H7fIQCVlmQ14wp72C8x1Kf6Co6YLbgqf",
+	"/wDkwwftswGTkIfogBlCHa6WG19QraqYYMWjI0JOhM0446Me4hJ3vcnFA7Nt/jXOWtS25qPzuDp6L9Kp",
+	"O/DyVDfkZn6Y7TxMM1HceCo7yPaJzFoMxWNdYeVGVsQ4PRrrstGPQ+hIUBFRWSiSMolXbHwLzi/pbPft",
+	"RG9BFXI9Nct11B5UCFZk+MDc8szH71FFEg8318SOsP9L3wXF6CFPIvu1NVsTYbvfU79iSnNtmDCZkuWQ",
+	"NI6fopKpQppQ5hrF71dvzvabVzFfpnVgxvCd6Fw2RpRIFgo0Wcjaag3dJM79HmcxarPN4LdrB2GhsY5v",
+	"/73cidX2wl6gZmUD1EpVvoTKkvsgdvA1Y8FI7HdEba1dSZ3aMxuE8BKv55R+FLPaRumXMTaFEhe8QHQp",
+	"U+kZrpN5F4ZKYzWeDAEyTIxJABugcIMnEeACM3dUeXGffR0TOSeKNXFB1y3o4mqkWIFKDzlpdWcOs7Sl",
+	"FLRVRTNi3LEt3uTvUiQp1KKoGTeKqs11yq60UZUi2EEs74ywDcG1zUKaANs+DstSXmUoYmShdHHKHg3t",
+	"dFuE9qb/ph8xEk36IVSXave82pAlLUgulWJ53GPAKQGhWknFMijSldTAveZzA6/lFTeaYGXcBZFVLgtm",
+	"S4CnKWhorloIio8dFgVKJlFgaQdW6vpEdDxySpCEbWhAhg+knRUz/eafQx+bjLRJ1G8XndnwlIEkFEy7",
+	"xPwOQ7ZxH14kHJvJuuseOqB/52ukG6ZSRx70cDWbEtcCR2+REB58uDxXXGsLSqAl7+Ey5+uGH7AQi5ZG",
+	"7cBj9RQVlJccwynbeWGxBzxNcxaS5cY84CzOZE/MUsl6sYxqBgY4vblT1c4YGo/yi64x4hWTgsEUz8lK",
+	"auP0Q3akZslNFPHDXAqjZFm2/Qztw3rhnKd/ouuTPDevpbyA/K6PUBslpAkrLaY+ZWY33ruZSXWqRbTF",
+	"5gxpQO+uvmbbwSyeC4xmkB0W1/Nz3nWxR2D+vpuD7najPukvrLuuNjNNKx9AbDdyxfP0mfq8AqgHw55T",
+	"LCqFCtvDHnxLxHjY48sqxMshi+yjmQma9E05IY4RuLghZDfwX3w3d8dtjIMDF2WfuTgpKssHZb0OAAip",
+	"zWZpaoURIC1JLHAVubB2Gox66gI68lbB4NKbwQYjHBwow24EVC+gPQD40KoMp7ZciPU5goRI7vujpp7I",
+	"tYD/sJ3KW8xjKGr3rCEthU1C7vEBjpCuWrg1xPUcM5nOxga6ah+UMPKGjwAYDn1twTAqAHZfMKzvXtK5",
+	"4DRolqeRfsw9XaPRubuXcRaSU3thg5sA5WWtmMuFbUV81Q5pqKhZ+qsTmvftP2BLYBqFmX8yJTF0qZhG",
+	"LvWstBa7jgpPVlnJLlkrItjSsq5R1ASHAtdXh86kYKzCAJOuZjv1Mo7v8o7Owa09i4Ilx2A3qf+0iLU7",
+	"RXYoN5Oq2LXI7DHRY48SQHTJi5q28Kf3FTnayns4yglU9d4ImX9Hjp3mFzvCOz/Aie+fEmU8Jn4fx4f2",
+	"ZkFp1G1jQDtD32s9dOpFOvI9zj4fzKI4WxFiayyJN3xDV/RKDJsR+iTfPLdG7hOXIkLst2uWo1Tj3jus",
+	"cC+e7R6ESO3WFc9KjQuRsJEtmSBCNs8etCH4p0pTFsf/YCfGRly41/Q1PMGbAPWb7yzBwYju1McYfEio",
+	"QKfXN6p9lJO49SAOjpeiEc1cRrct+i9P3e7ZgQ1kXRZEwH6C7I+u9+4Wc1x8Sma1Hwi0FZgkqPUOfcW8",
+	"94IUseHWrsgXlkBds0W3vcH6qg4epSBZWd0s/COkIf+oacnnG+QzFnzfDX3/oUyMdZewQV4usB8m3i5e",
+	"eU/OoG2Rfiq7bj52zGi4DYwSAQ0Xua/XLsmKXrB4G9D93vJP9Jglup6h5gKu7M529rHgFu+zbq9oEb/0",
+	"sfbPpsUdfDU46P3/NOnN4ql8yY6qpLnd7VB1vs1n0Erhicss2Wp7/rs+X/MkEHlBe6JVPmFqcQ2V6Z6s",
+	"K5VUZqiidgvs6BnRLqh9mGWM1Px2yiZvyRw4aimH3oXRrnhdoNHhxtdN2QG+rXfl2t4J/pNluYaWMQb8",
+	"TwXvoZ79MLzY5C6w3EqqnIDVaqtncp0pNt/p4YitAfgGYB1UrFzkilFt7YynP7uHZ1N1iqOl3Ib5B0+E",
+	"MErB5lw0zJKLqjaJdwwWnxKbCGGx0h/ROmBCG5ISQJi8pOXPl0wpXgxtHJwOOY9rZAEk3tDh+iZUGOFO",
+	"7Q/AdfOGw5R7jRo9bgYXeMHnc6asAVsbKgqqirg5FyRnylAOHicbfX2LUjAO7LIp0UiaaSeCjaxLSNoW",
+	"kHLjXDluaO8JANIDGn5GGGzOl8xRf9tYY1U7Rg7YZ/owfBYGmxVdg40PE8MNHAhXbgwtfNgMg8FyKqx8",
+	"Nm7dfh7N/8m2T4OVVh0jMhJnHTPF9nP/M24lPiN/EdxsPflWR9nN1GdTKdiD6ZEK6lGfz8USS/88Vnl6",
+	"sqqdYDF4qbvAWE97LNrEoVCStl58YBfRDcJl5oyV4Hq8fajlaZG4YZxmIEONgd6SsaXxMEFca6dK6jmJ",
+	"dlUNFilTlwBzT02b1c/7e2kAPEA00+6st6cNjm4wznjZJ/IPSUNUySrLx3hq22LMhQXAQ9qGcYA+IiPA",
+	"wLqDe4wO5cljamzXKcfx9HXE706d9F3Wrirf9ugfUhMNcPS2CULOkZfhEbbKMaliZcq0mzasrQYLTIJQ",
+	"olheK1QTX9FN0k2mVWt+oAjg2Q8nXz599vdnX35FoAEUumS6KSTpBglsI3jzctHV+9yt/25veSa9CT6h",
+	"LH4O9kefJytsijtrltvqpkpUa/X7mrQTF0DiOGIO4yZly7X3CsdpsrV8WtuVWuTBdyyFgtvfM3DTSBfy",
+	"DXJVwoCS2q3IhAIvkMY/sWMB5aaJY9BLVA9iObdLmyBcelfMhgq4GXC5Si1kyA0e+Rl8CsGgbF2VjldZ",
+	"S8+2dbl3mtXQodCIXjGgxZKVE+35nKQgwmwAKkqW6BSfqBGPPNsDs7U+7ulsFxgvkiY98NnAl7Cck+3c",
+	"vjEUekad4PSwiQnxwh/Ka5DmkH1iOBXtdThJo9r/ZPhHIrfuwbhGWO5t8Irk+2BLGsmTnt9DyCs7CrR+",
+	"ntUEeSAAAwkUW6nvotxfUW05Za0EaE/wBuSu+PFTY1jeGcyFkPgOO8CLMyI27UL8kQPnIxdp+ykgJVrK",
+	"70OU0Fr+riSLnvWGiyTaIqc0MYZpy5YSeTiiDJr6ZUhMOfAq6eWvVFIaIgXoRhJ5L60eB89UTDgY7euc",
+	"9++Wa3zHlTYniA9WvBsOaIyTH8ZItqjU1yu98pqOmruktzC1eIu5Nv/GYI+S95wbyhnhe7cZKndoad2r",
+	"58EazQS5wjFxp8nTr8jM1U+uFMu57hr3r7xwEpICMQXWsZCxbntywV3r/FWaG5Dx3HvikDeReSvY7B2E",
+	"zRH9yExl4OQmqTxFfT2ySOAvxaOg5sW4grs3rbV7vUzeUU2OPTN5xyvDmimjl4frwEun1qy/ztG3dQu3",
+	"iYu6WdvYNPSjS/ZCVfTZmOzx6fK60B3T1x+kzu5eVXZvIXG9xZEbw82bophfBBzCbopRvTs6B6wH0NV5",
+	"FXYyiwa9ZDqZsItkSqqwvxW52lRmaNzgC1tRraulokCuznjioxeZHYIVZGOtaa3WTRpO+/yM3pc4A1Us",
+	"GgHnG0o62ow6oJtuZh1YTn+yI/I360yFOUSnHi6yooYpCP/CjHE0So3ifFKCFzoqXHtb6vCaTtuSEup/",
+	"HUoOYyu5DVTi7BxVKNq50+Aa11WFDApMMM01Vg79u6sUf7dilofAZjbqc3EL602Sw1vEJNbamjyaKqqY",
+	"OqJYquuWqHCJYep5rbjZnAH+vW6V/z1ZfeH7kKvVZYIPZlYnFhl5wYR3BWoyu9baC17fS1qiqGKtv4IR",
+	"I2V5RL619TwdD/3rg9l/sC/+8rx48sXT/5j95cmXT3L2/MuvnzyhXz+nT7/+4il79pcvnz9hT+dffT17",
+	"Vjx7/mz2/Nnzr778Ov/i+dPZ86++/o8HcEUByBZQX8j3xeR/ZyflQmYnb0+zcwC2wQmtOCRL//AB1Shz",
+	"CctHpObI+diK8nLywv/0/3rme5TLVTO8/xW4rILmS2Mq/eL4+Orq6ijucrzAXC6ZkXW+PPbzfJh2MH7y",
+	"9jSEb1gXLdzRxrBwNGlI4QS/vfv27JycvD09mkQpnSZPjp4cPYXxZcUErfjkxeQL/AlPzxL3/RiraR1r",
+	"Vyj3OITxfZj2vlWVLaMLnxyNur+WPiM1/LFiRvHcfwJOtXH/11d0sWDqCAN77E+Xz469oHr8pwto/gCA",
+	"JS3KtqpqVEozpAytZyXPfUUSrq1pwcZetNL+OqNLraEkR4mp55x/tyjQe82ml9GT6SQg/LQARNv+pw2z",
+	"QzS6s6AnL35LFK/wQUFxRu5QGKjxVPxfZz+/IVIR92J+C/pBHxDlI+CaqL84AA56Hnm6/0fN1KahSwvo",
+	"ZDqxbBYJWtQrYD4usmqlF1W7jltzHaQUiT1k+5mBnJqJ41SgnuGh1jiCpGHfwJKfZF///ueXf/kwGQEI",
+	"Xo6aoQTyBy3LP6zmla3R6brjlDUdcpebNpmQsEOzk1NUcoavUfemTbv86R9CCvbH0DY4wJL7QMsSGkrB",
+	"Unvw+3TiiQXP6rMnTzyDci/DCLpjd6iiWUZV/P0wbY3iSeIaA/UZmf30LlTCUrSyh9F9sSHezvRnGx0B",
+	"v3p+wIW263XdeLnd4XqL/oYWRDnhGZfy9LNdyqmwbsJwIdmL88N08uVnvDenwjAlaEmwpb158Rj3b5pf",
+	"xIWQV8K3xMTLqxVVGxSJTJNeuVOGni402tuRRdqz3SqwMPn9w+C1dxytHn6Ok+EVN7oUrQGuGY+cvtp9",
+	"Tw5wThzLBiy6Hx6eVBW6A5+F7ydV9Ra4pUYXE8bx9mNrro1+dES+j3u37GYWEms2a8WLRFlbOkm5HmjL",
+	"rK11LHlptxJW3N/fH/f+Pmnrz3jBhIHQOjUATOsUbIWp58h20wu0Hz8WJb3b11c+VMN0okVGq2qPMexx",
+	"2pIZokljCG8Gn+8IfYgb/ztUHZTskooxNajsTL+nnpA7GfU97gZwNyQmRfAGick2nLG7Ys2+sF64SVpX",
+	"xi0y7s9c6PuJlkAn0XI7BexPX90Lg/9WwmDIsWzLBdGqOoB46IN6djU5/tPlDT6E1AgjjZMX45d31DeK",
+	"y3jY4TiPjshJt8312IrLu7xTEoR29zLgpyAD4r7vlP4cHX9UuS8OCdwnQq8lsMDvozp/5oLevzGyBiU7",
+	"gHS3THcN9tmT1xyzvjW2+i8ppzmk3Uto/9YSWqiGcCMZLXaLPm5qJvoON1LwdRV43ARJLP7U4myYigZz",
+	"NdgjPG1CQIDFWN9259UOxS3tAw8+uXel3axp72nZF7G+Z/Eb9pvN6atd0tVnpAoaqWlI3gLpvbltXpq0",
+	"TLy7G8vEON70/Mnzu4Mg3gUoz/sd3uK3zCFvlaWlyWpfFraNIx3P5HoXVxIdthSSF8KhbfGokKN2Gn2H",
+	"1tYB5CFGg8+oZl899y+nR0fkG9e0yRDjsh0sJC2bKEKqFrYT8DpABnng/3yB4z84It9hbKzRU3RxhDFs",
+	"Qy7Mi6fPvnjumkAJBfSe67abffX8xclf/+qaVYoLgy4D9p3Ta66NerFkZSldB3dH9MeFDy/+93/+n6Oj",
+	"owc72apcf7N5A/zw0+Gt01Q2zEAAQ7v1mW9S6rUu7L7sRN2dWPi/kevkLSDX97fQR7uFAPv/ErfPrE1G",
+	"7iEalJ2t6moHvI2Y3vc+mrr7BwN8wmUCJfqdN25dUmVzB2F6ZU0WNVVUGAaKO+/tPMeKdpjkMC85ppVQ",
+	"RDMFhYU0L1q+ty7BTQXRq8LECYBbEOxm9Ex/ykz+J7qOy/GGa9pIt2RUe67o2ldaQWdmzK63Jn/9K3ky",
+	"bV4vkG5FrrOAmBRzXdH15A61foHYxqaMeuWwI9Vu318ce4wGqZF+Qu7R5qnx7865P1vJ3ZK729gDcc69",
+	"DT+NYSfWI+CPOzQIVrAzmClb11VVbpocybRsRKg0i4MZxioHPmEbwU7VdPIR2kXv/SG+VwLciJV0CWpP",
+	"toGxzvr4T3yXxzyjd24xVvPfy1wa2Y6UXHnjkSRzZkBTAQjpoj7BnpQLVR3mTSsuIF/b5MWT6Qi5K6Rg",
+	"CRV44oB18hD9zTGJEqZO3ACBSIW5DsFGRA17hPkRZyFROebCaByw06i1w2cwaUoMa4pNHFgMQ7LrJ/OO",
+	"l1xQm01iTIXLKOQYLY5MJU7dz/gfiFpqkBaq1/jUnIj+gEF8GnhtAUUSdzEKPvy9csnORkP5spm8L0GW",
+	"skXE1zfY3iN4PwT3uPm3LnWHPYVuEf8KUQz+7ZuRN7LJrtCU3/yXs5Xepihy2wt6IwWzTgEgqltavLf/",
+	"BjmpuSZ9Wh374GpqxV1XZjr26ai2Ck4/QKMdwtMYcQMmu32Z4xau8B+SSbtatwys7WhnzpBmtDHMGRra",
+	"4h6xkHT0MZ9dH4WffoJvsY/Bse6GxeAh9XzG/iTFYZkOZqqyxHxc+bRiQxzoNTSO5DKbvGs0NzIyqp7d",
+	"T5Hli5N/mqxoG3Wk8ZKgEvzgagT11n/0b3h2X7oCPsbFSbu0aLZkupYrhk8GkNFddnUL4V/uDkLDwctP",
+	"1pjbLYrH/cjc5csnX9zd9GdMXfKckXO2qqSiipcb8osIhXpuwu00oW7PY/V1gjlwgeaxdvq8PM71dQMm",
+	"KBdbzIFO0d4kANVWrpK1YcqmfuzUY+M9Jp1SYCPDeA1TH0Ceg2R0n5k457E+NmP5S1qWiK5dVjEceJRb",
+	"dVna/WQrqP9fJDbuiHwL3kR+b6eNOjJUqfSJ8qed1Ko4sitZaHMTaAb7bBiJVhNpK5iy9fZhfOZVa6u6",
+	"NLwq231C6iosa5Xwm7K0GVfEOH3lV2etyXLeDN2lXyNbgx+Rk/AJZxbSLs5nyYrVf7Ga9qgFNFWxv3hU",
+	"lssVF3NZO7nqpFFtnH2qilHVdLaU/7BSLHNDKHrJlKZ4WDuLenQvqn8aovra5e3+RAT1pFH1prz++ldR",
+	"y+37T7MGd5WdcnmU+npPkZyLSCSP2YU9a9eXxXebH7pl8k9fxZE1MmQA8wLCACiAoj2Dy/7HZKTNBhoB",
+	"Ldh3WC0soD5fq5NYXdiLnE+DY6kU0O0FeS8eE72kPp24+/PZl18NmUaoXro0i327UzMQfLbDjDE+fdam",
+	"tMNKHAG/L+56t/fbxOmEF+s+kFhQOyrT0y7j7e7DB9rZ6tKFZ6p06vDwMI2HXTG4pvSSV3efnlobPkvn",
+	"5/eauDOsaHa+Fqfim6CQtTmUQWqoPkZa4unEKMYKVpnlzmzl2KrZTebylnPtKkzZnNJTwo/YEbaJKgEW",
+	"C+YuJkpKRuehpJ+UYwIPIz4DhOapIsJ6vJAxknSSflDmRaK8ez1pE6BnLzqPvK5Q/FGFMPOxhLCsI4W1",
+	"0fLxZDIGLaeRq1ilpJG5LK3fZ11VUplwuvXRKM0DGxL0WoqHIcK9kTC35oXeadI5x1YH0AG0KVt/Niad",
+	"c4+mlE0ntahrJspt5hrD0s5lRewDvwPCR+Vr94/KFD/rmH8+d+uPGSS9AxuDcmryZV0d/4n/wUTBH5og",
+	"Y6yuo4/NWhxjPdXjP7e6AyNLLUE2UbYwT0ul26vOmnTqfY3dmyJA30nVrXy/0923g7Rp99LH2cnpqzR7",
+	"vJ3X5L/1I2yr6ayz4Tf3BkmM2Duv/izHFSUD7UalpRwFu3qyCRK+9176tBbU2BPnXBSERtvY0TVJ1TCC",
+	"W7Yp3vaiP4aJ8u5dtr78jM8ZhAicQo2CFROGFTfz1CddDudvj63X7X6Cgbv6++78/Ts/vvF9EFKQRXZe",
+	"8Hu8e6K0S8xPRxX8V8NdfUde8/c3+Sd1k78M1taYDO/v5c/nXlY+dOr+Cv70r+AvPtvV3KIP08gr+RrG",
+	"4fY13LzE97yQe8KA02F1FAfb7Mr49O6uUn8nlS+geH+Lf6ZGUbuTox2xxmhodmli3ZSHiDr7pKAfp2cA",
+	"p7OepmHooE6DrxfHBJMy51hO6LTQU3uInXLCneJ7weeTFnyivb6Xe+5VD5+Z6mFAynGv/rIcI2jsKwBd",
+	"rmTBvGFVzucuofOQ9NMuYQnkqQ1dVcT2PBr0wz7nK3YGLX+2Uxz0im3A7ohFHfAAWZrlUhR6hBeHG/W6",
+	"9xDgyQwDcOeWzbADHhaX6uno2iT7LsoX2aME0kW+xtKjPrG1Q0bBLsnKFR6+Kdke/2n/RXVaJVM1kc+Y",
+	"SYNLHrptsZm67bgtAMlbFEJtym/fS87JE5uwuxYajYuh/DwVBTFqg+WXXX5CxSCQvhXcGuDon5yzwZOz",
+	"8ynQW93AmtJvAdmc0EN6MHQSC/x45wfgpa0mjfvURZCRhBLBFtTwS+ZN/kf32bOufZu53FVbGOAU8k/Z",
+	"09hsArtkakN0PdMg64h2jNID3T4v12AY9mFwrGRZzmh+ESvg0xzjHbviwoFpO3tfwOjOjQ/VlBRc51Qh",
+	"p7DLsWsAhmAfJLaYOamoNoTbyBRiqFow44ZDz1dX+VyWLnhDNHl5NblgFeJyxVYSZthEAE6dAoBrvI5g",
+	"razwTX6i65M8N6+lvAAEhHSIIaqpzYTeOURZyWSvUFSYN0YbTmfknXpNfXSec+4K6/vUii1CcXeAxVhT",
+	"695vmMfXPRO6rnwiMdiqR4JwgTOqSs7Utbx62LpiioO8T8vGm8exFi1opZfS9D/A2S/qVdX/gqn5tvkx",
+	"ntkWNxSaO7IQjklU22vaS/YWJhBwfuK5klC9PMTi6I02bDWZdqRw1/XvAwVevCKz7zMvRckFy1ZSpOra",
+	"/4xff8KPqd6Y3nCo8zl8HOrbkffb8HfAas8z5k1wU/x+Igf/Ro52ndUqVkllmsvI0v+ep88fmo3I+ydp",
+	"I/LoTncfo4GkGPj52IdDtYrbJ1u2auj3vtbC5elKfv2z9adL/+lbMrxVW38eV0sp2EzKaES9rE0hr6KV",
+	"IGuxLttjMgSigmHPQLbGrtCOEOf6di0Lt2lRj/CQOr/ha6IuevNxuDT6v2miCWeAjonExW1fMqU7yqr7",
+	"bBP/UtkmRu/7Xhwfhqz1Lo5W68PKR29kwey4TcoBOPqpylQo4msPREcsCq7f6bBIf0c27TqBajmtIVtH",
+	"XREjUyFxTceM5pbJZlbZk54wygWPrex0S3rJCC0VowUo6JggcgaLbm5rXCTVmI3fx9U5B/ekYBbBVSmZ",
+	"M62hYqCrxLULNN/OhuOYLXhCwBHgMAvRksypujGwF5c74bxgmwwVfpo8/PFX/egjwGsF0+2IxTYp9HZT",
+	"S/ShHjf9NoLrTh6TnU1aYakWw4Al2FIMGwBmP5wM7l8Xot4u3hwtGCnLb5ni/SQ3I6AA6i3T+02hrasM",
+	"7u8+iC/tV9CUw4YJKqS3sqQGK6k22S62DI3itWhYQcQJU5wYBx54/r6m2rxzysIC7iBXVxTnwT44xTDA",
+	"cIva90ti5F/tx9TYuRSaCV1r4kbwcZ6sSK1BsPWWud6wdZhLzqOxQyCptXfsGnkIS9H4DllROTJCTeTb",
+	"BMMlFofWGOrUJX1UtoBoELENkDPfKsJu7NQ0AAjXDaIt4XDdoZyQi3s60UZWFXALk9Ui9BtC05ltfWJ+",
+	"adr2icvm+8E5SSGZjoN8HeRXXqEM2ukl1cTBQVb0wsUBL1x56T7McBgzTCWXbaN8NGBBq/gI7DykdbVQ",
+	"tGBZwUqaUOz8Yj8T+3nbALjjnjyzS2lYNsM8UOlNbyhZDSqswtASx0swzTeS4BeSwxGEx3NDIK73jpEL",
+	"hmOnmJOjowdhKJwruUV+PFy23eoBJRmMATtuG1mQHUcfA/AAHsLQ10cFds4a9UF3iv9k2k3g21xjkg3T",
+	"Q0toxt9rAV3lYnyBtW6KDnvvcOAk2xxkYzv4yNCRTakzP0urQ9eT8xYDidvq3OgBeHSdx+3xFeUGst5b",
+	"QTqjc8PUzvCgv1HunYN8igLpMksRHMHdm24cZPJxkU/HRSwIxF0XQCIuWx7hmlDylKy4qI39ImsztSn+",
+	"FaP5khUtNLiRuG4S0Sm2oKoomcbKWP7elMqaw0zngkegEzHX7Rc/rPs7qUZVOmmnx6XckFoYXkbV3sK7",
+	"/dPTXt5rJO41EvcaiXuNxL1G4l4jca+RuNdI3Gsk7jUS9xqJe43Ev69G4mOlgsu8xOGz0gopsq7D+L2r",
+	"5r9U5YxwVXkFCWonQIcAbCnKxDKst9hDEWQYLREHvGTD/ujWsf7825PXRMta5YzkACEXpCopF8SwtQmV",
+	"9GdUs6+e+3Bqe3XSFYFEvfZ+hQZfPCNnP5z4rMpLl/233fbhifWJI9psSvbIlX5korCSqK8ByQQg3ZWA",
+	"pP5KaLmYkzkvMfpHk2+x9SvIwycrpmzCViyZ2tf4nDNavnS42aHw+RtM7sIJ/oDR/pi2lF4ObStaeTHf",
+	"r5VqQm1UOXkVxZn/MaelZn8MhZrb8Va0GlFtFZnJN7LYdE4I7NoxbmD7bDS5lbmgapPIhNf3OO+ShpHA",
+	"rhxh9XVZHw6eAbxPtH0y20VhKWndlvpIjz5E5alxmg3rDWWTEcw7dDJJxdF38z1PAoCjkp9iKJjdE/LO",
+	"9vuo9xtBiNwRa5j5J+PF2G4ZmAa2FdJ41vO5hip4xCdPL579KRB2UeeMcKOJo7gR1wuU1YWRFkxkjgFl",
+	"M1lsshb7mrRuoYJrqjVbzXbfRDH/xBMXLh+zTCyndU99nGvkVbS4bTw5Jpp15hjwAHfeGDaaNwds4YiO",
+	"PUcYv20WPcRGYxCI408ppVKH9+3L9JppNveM757xRaexIxFw4QItu0zk6BYZn9qoWgzzvG/XLK8BuPgk",
+	"P0TtPJrkQFsTG1kLNqsXC3gt9G10sDSG40Hk5cdhhXa5Y7ngfhRkB3/nfexvmoijO1yfu0S5MR767LOP",
+	"cDuo2KAxY1VRsfEmX9A6rOrS4tAWzj8so7V1EVJp9Bvd35BW+61rEetu3VXb/t2ihVxRTez+soLUonBR",
+	"Vd2JzVqMz+Vkhz5fi4ZNb83bZNebWJ2bd8wV4Xe5nU5Dk4qpzKyFPVCtw+SqtNiT+1HrBdxfG3d3bdhk",
+	"HGyAwfYrjjQM4UC3h4r4Gl4fzWRRDF/86zFthyy2vqFGYzjEJS5AZ1se1LGkN3zbv6RRtzj7KSsrQkle",
+	"crSuSqGNqnPzXlC030QLO+r7nnhF9TDve+mbpE2ICQufG+q9oOhkFKw6SR44ZwkTxneMeRar68WCaeCj",
+	"MQHNGXsvXCsuSC24wblWPFcys+G7cL5AdjmyLaHA6ByzNknyT6YkmdUmHlNbXbI2YB+0zi4wDZHz94Ia",
+	"UjKqDfmJAweG4XzKmOByxsyVVBcBC+l6ZAsmmOY6SytmvrdfseSXW75XAML/XeemVM/d1vrysPNiEHKo",
+	"uqoJxYzzJddxjdku7HdmG19xkSWJDIz4zl2sS1vkIea5dAT0qG04Mkv2XsDtZyRBjk/N9cihawHqnUV7",
+	"OjpU09qIjqHIr3XU8+8gXIYkmMy92eVfKIQ0ogNv2cSNtzVEOnu/p4mldeUyLH88dCHbr65E7EAj94Bo",
+	"Kck6Sbxci/MWyFvtF59/6tzDvyU9Gg/2muwPmEzd07qtjSR+w6eEllIsbO5YeF1K3CcuqtqgA/htKvDY",
+	"JS0zecmU4gXTI1fKpfj2kpY/h24fphPQPmRG0ZxlVqMwFmvn0MfSKYzDBTeclhm+qscCxE5trzPbacd9",
+	"HFVUXq1Ywalh5YZUiuWssMkWuSbNe/6InEWZtuBCUbJeLG0zO84VUywUn4UndHeI5N1u1iKziTf7MJ64",
+	"YvRxbnLwkU8Ux8IL7oqG+Vz2jDGv8gRHwbTKQ4/06WRQ0AakXjaucxY5bTYzQopoyQMRfpqJD5GH+p7o",
+	"74n+cyf6VNpYRN28o62w+Iq35ZbVWredJPkOtWQfJYP6fRmSf/UyJJ4DaUKJoq03SLr+JdWEG3KFaZFm",
+	"jMD9VaN23hUVde91m/2zOeoum7B2JUjzJeXC5dQJcQ0IhyG5XK24Mb4E960oNi0zQ40moIPlteJmg68W",
+	"WvG/XzD4/+8g9mumLv2Dplbl5MVkaUz14vi4lDktl1Kb48mHafxNdz7+HuD/079FKsUvqWH4bZ1JxRdc",
+	"wJ17RRcLphoV4uTZ0ZPJh/87ANuKDAAy4AEA",
 }
 
 // GetSwagger returns the content of the embedded swagger specification file
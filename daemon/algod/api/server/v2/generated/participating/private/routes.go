@@ -39,6 +39,12 @@ type ServerInterface interface {
 	// Generate and install participation keys to the node.
 	// (POST /v2/participation/generate/{address})
 	GenerateParticipationKeys(ctx echo.Context, address string, params GenerateParticipationKeysParams) error
+	// Return the health of the participation keys
+	// (GET /v2/participation/health)
+	GetParticipationHealth(ctx echo.Context) error
+	// Unlock the participation keys.
+	// (POST /v2/participation/unlock)
+	UnlockParticipationKeys(ctx echo.Context) error
 	// Delete a given participation key by ID
 	// (DELETE /v2/participation/{participation-id})
 	DeleteParticipationKeyByID(ctx echo.Context, participationId string) error
@@ -151,6 +157,28 @@ func (w *ServerInterfaceWrapper) GenerateParticipationKeys(ctx echo.Context) err
 	return err
 }
 
+// GetParticipationHealth converts echo context to params.
+func (w *ServerInterfaceWrapper) GetParticipationHealth(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(Api_keyScopes, []string{""})
+
+	// Invoke the callback with all the unmarshalled arguments
+	err = w.Handler.GetParticipationHealth(ctx)
+	return err
+}
+
+// UnlockParticipationKeys converts echo context to params.
+func (w *ServerInterfaceWrapper) UnlockParticipationKeys(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(Api_keyScopes, []string{""})
+
+	// Invoke the callback with all the unmarshalled arguments
+	err = w.Handler.UnlockParticipationKeys(ctx)
+	return err
+}
+
 // DeleteParticipationKeyByID converts echo context to params.
 func (w *ServerInterfaceWrapper) DeleteParticipationKeyByID(ctx echo.Context) error {
 	var err error
@@ -239,6 +267,8 @@ func RegisterHandlersWithBaseURL(router EchoRouter, si ServerInterface, baseURL
 	router.GET(baseURL+"/v2/participation", wrapper.GetParticipationKeys, m...)
 	router.POST(baseURL+"/v2/participation", wrapper.AddParticipationKey, m...)
 	router.POST(baseURL+"/v2/participation/generate/:address", wrapper.GenerateParticipationKeys, m...)
+	router.GET(baseURL+"/v2/participation/health", wrapper.GetParticipationHealth, m...)
+	router.POST(baseURL+"/v2/participation/unlock", wrapper.UnlockParticipationKeys, m...)
 	router.DELETE(baseURL+"/v2/participation/:participation-id", wrapper.DeleteParticipationKeyByID, m...)
 	router.GET(baseURL+"/v2/participation/:participation-id", wrapper.GetParticipationKeyByID, m...)
 	router.POST(baseURL+"/v2/participation/:participation-id", wrapper.AppendKeys, m...)
@@ -248,236 +278,261 @@ func RegisterHandlersWithBaseURL(router EchoRouter, si ServerInterface, baseURL
 // Base64 encoded, gzipped, json marshaled Swagger object
 var swaggerSpec = []string{
 
-	"H4sIAAAAAAAC/+y9/5PbNpIo/q+gdFflLyfO2I6T2/hTW/eZ2El2Lk7i8ji5d2f77UJkS8IOBXABcEaK",
-	"n//3V+gGSJAEJWpm4mzq5Sd7RHxpNBqN/obuD7NcbSolQVoze/ZhVnHNN2BB4188z1UtbSYK91cBJtei",
-	"skLJ2bPwjRmrhVzN5jPhfq24Xc/mM8k30LZx/eczDf+ohYZi9szqGuYzk69hw93Adle51s1I22ylMj/E",
-	"GQ1x/mL2cc8HXhQajBlC+aMsd0zIvKwLYFZzaXjuPhl2Leya2bUwzHdmQjIlgakls+tOY7YUUBbmJCzy",
-	"HzXoXbRKP/n4kj62IGZalTCE87naLISEABU0QDUbwqxiBSyx0Zpb5mZwsIaGVjEDXOdrtlT6AKgERAwv",
-	"yHoze/Z2ZkAWoHG3chBX+N+lBvgFMsv1Cuzs/Ty1uKUFnVmxSSzt3GNfg6lLaxi2xTWuxBVI5nqdsO9r",
-	"Y9kCGJfs9TfP2WefffalW8iGWwuFJ7LRVbWzx2ui7rNns4JbCJ+HtMbLldJcFlnT/vU3z3H+C7/Aqa24",
-	"MZA+LGfuCzt/MbaA0DFBQkJaWOE+dKjf9UgcivbnBSyVhol7Qo3vdFPi+X/TXcm5zdeVEtIm9oXhV0af",
-	"kzws6r6PhzUAdNpXDlPaDfr2Ufbl+w+P548fffyXt2fZ//g/P//s48TlP2/GPYCBZMO81hpkvstWGjie",
-	"ljWXQ3y89vRg1qouC7bmV7j5fIOs3vdlri+xzite1o5ORK7VWblShnFPRgUseV1aFiZmtSwdm3KjeWpn",
-	"wrBKqytRQDF33Pd6LfI1y7mhIbAduxZl6WiwNlCM0Vp6dXsO08cYJQ6uG+EDF/TPi4x2XQcwAVvkBlle",
-	"KgOZVQeup3DjcFmw+EJp7ypz3GXF3qyB4eTuA122iDvpaLosd8zivhaMG8ZZuJrmTCzZTtXsGjenFJfY",
-	"36/GYW3DHNJwczr3qDu8Y+gbICOBvIVSJXCJyAvnbogyuRSrWoNh12uwa3/naTCVkgaYWvwdcuu2/T8v",
-	"fvyBKc2+B2P4Cl7x/JKBzFUBxQk7XzKpbEQanpYQh67n2Do8XKlL/u9GOZrYmFXF88v0jV6KjUis6nu+",
-	"FZt6w2S9WYB2WxquEKuYBltrOQYQjXiAFDd8O5z0ja5ljvvfTtuR5Ry1CVOVfIcI2/Dtnx/NPTiG8bJk",
-	"FchCyBWzWzkqx7m5D4OXaVXLYoKYY92eRherqSAXSwEFa0bZA4mf5hA8Qh4HTyt8ReCEQUbBaWY5AI6E",
-	"bYJm3Ol2X1jFVxCRzAn7yTM3/GrVJciG0Nlih58qDVdC1abpNAIjTr1fApfKQlZpWIoEjV14dDgGQ208",
-	"B954GShX0nIhoXDMGYFWFohZjcIUTbhf3xne4gtu4IunY3d8+3Xi7i9Vf9f37vik3cZGGR3JxNXpvvoD",
-	"m5asOv0n6Ifx3EasMvp5sJFi9cbdNktR4k30d7d/AQ21QSbQQUS4m4xYSW5rDc/eyYfuL5axC8tlwXXh",
-	"ftnQT9/XpRUXYuV+Kumnl2ol8guxGkFmA2tS4cJuG/rHjZdmx3ab1CteKnVZV/GC8o7iutix8xdjm0xj",
-	"HkuYZ422Gyseb7ZBGTm2h902GzkC5CjuKu4aXsJOg4OW50v8Z7tEeuJL/Yv7p6pK19tWyxRqHR37KxnN",
-	"B96scFZVpci5Q+Jr/9l9dUwASJHgbYtTvFCffYhArLSqQFtBg/KqykqV8zIzllsc6V81LGfPZv9y2tpf",
-	"Tqm7OY0mf+l6XWAnJ7KSGJTxqjpijFdO9DF7mIVj0PgJ2QSxPRSahKRNdKQkHAsu4YpLe9KqLB1+0Bzg",
-	"t36mFt8k7RC+eyrYKMIZNVyAIQmYGt4zLEI9Q7QyRCsKpKtSLZof7p9VVYtB/H5WVYQPlB5BoGAGW2Gs",
-	"eYDL5+1Jiuc5f3HCvo3HRlFcyXLnLgcSNdzdsPS3lr/FGtuSX0M74j3DcDuVPnFbE9DgxPy7oDhUK9aq",
-	"dFLPQVpxjf/i28Zk5n6f1Pn3QWIxbseJCxUtjznScfCXSLm536OcIeF4c88JO+v3vRnZuFH2EIw5b7F4",
-	"18SDvwgLG3OQEiKIImry28O15ruZFxIzFPaGZPKTAaKQiq+ERGjnTn2SbMMvaT8U4t0RAphGLyJaIgmy",
-	"MaF6mdOj/mRgZ/kdUGtqY4Mk6iTVUhiLejU2ZmsoUXDmMhB0TCo3oowJG75nEQ3M15pXRMv+C4ldQqI+",
-	"T40I1ltevBPvxCTMEbuPNhqhujFbPsg6k5Ag1+jB8FWp8su/cLO+gxO+CGMNaR+nYWvgBWi25madODg9",
-	"2m5Hm0LfriHSLFtEU500S3ypVuYOlliqY1hXVT3nZemmHrKs3mpx4EkHuSyZa8xgI9Bg7hVHsrCT/sW+",
-	"5vnaiQUs52U5b01FqspKuILSKe1CStBzZtfctocfRw56DZ4jA47ZWWDRaryZCU1surFFaGAbjjfQxmkz",
-	"Vdnt03BQwzfQk4LwRlQ1WhEiReP8RVgdXIFEntQMjeA3a0RrTTz4iZvbf8KZpaLFkQXQBvddg7+GX3SA",
-	"dq3b+1S2UyhdkM3aut+EZrnSNATd8H5y9x/guu1M1Hm/0pD5ITS/Am146VbXW9SDhnzv6nQeOJkFtzw6",
-	"mZ4K0woYcQ7sh+Id6ISV5kf8Dy+Z++ykGEdJLfUIFEZU5E4t6GJ2qKKZXAO0tyq2IVMmq3h+eRSUz9vJ",
-	"02xm0sn7mqynfgv9IpoderMVhbmrbcLBxvaqe0LIdhXY0UAW2ct0ormmIOCNqhixjx4IxClwNEKI2t75",
-	"tfaV2qZg+kptB1ea2sKd7IQbZzKz/0ptX3jIlD6MeRx7CtLdAiXfgMHbTcaM083S+uXOFkrfTJroXTCS",
-	"td5Gxt2okTA17yEJm9ZV5s9mwmNBDXoDtQEe+4WA/vApjHWwcGH5r4AF40a9Cyx0B7prLKhNJUq4A9Jf",
-	"J4W4BTfw2RN28Zezzx8/+euTz79wJFlptdJ8wxY7C4bd92Y5ZuyuhAdJ7Qili/ToXzwNPqruuKlxjKp1",
-	"DhteDYci3xdpv9SMuXZDrHXRjKtuAJzEEcFdbYR2Rm5dB9oLWNSrC7DWabqvtFreOTcczJCCDhu9qrQT",
-	"LEzXT+ilpdPCNTmFrdX8tMKWIAuKM3DrEMbpgJvFnRDV2MYX7SwF8xgt4OChOHab2ml28Vbpna7vwrwB",
-	"WiudvIIrrazKVZk5OU+ohIHilW/BfIuwXVX/d4KWXXPD3NzovaxlMWKHsFs5/f6iod9sZYubvTcYrTex",
-	"Oj/vlH3pIr/VQirQmd1KhtTZMY8stdowzgrsiLLGt2BJ/hIbuLB8U/24XN6NtVPhQAk7jtiAcTMxauGk",
-	"HwO5khTMd8Bk40edgp4+YoKXyY4D4DFysZM5usru4tiOW7M2QqLf3uxkHpm2HIwlFKsOWd7ehDWGDprq",
-	"nkmA49DxEj+jrf4FlJZ/o/SbVnz9Vqu6unP23J9z6nK4X4z3BhSubzADC7kquwGkKwf7SWqNv8mCnjdG",
-	"BFoDQo8U+VKs1jbSF19p9SvciclZUoDiBzIWla7P0GT0gyocM7G1uQNRsh2s5XCObmO+xheqtowzqQrA",
-	"za9NWsgcCTnEWCcM0bKx3Ir2CWHYAhx15bx2q60rhgFIg/ui7ZjxnE5ohqgxI+EXTdwMtaLpKJyt1MCL",
-	"HVsASKYWPsbBR1/gIjlGT9kgpnkRN8EvOnBVWuVgDBSZN0UfBC20o6vD7sETAo4AN7Mwo9iS61sDe3l1",
-	"EM5L2GUY62fY/e9+Ng9+A3itsrw8gFhsk0Jv3542hHra9PsIrj95THZkqSOqdeKtYxAlWBhD4VE4Gd2/",
-	"PkSDXbw9Wq5AY0jJr0rxYZLbEVAD6q9M77eFtq5GIti9mu4kPLdhkksVBKvUYCU3NjvEll2jji3BrSDi",
-	"hClOjAOPCF4vubEUBiVkgTZNuk5wHhLC3BTjAI+qIW7kn4MGMhw7d/egNLVp1BFTV5XSForUGtAjOzrX",
-	"D7Bt5lLLaOxG57GK1QYOjTyGpWh8jyyvAeMf3Db+V+/RHS4Oferunt8lUdkBokXEPkAuQqsIu3EU7wgg",
-	"wrSIJsIRpkc5TejwfGasqirHLWxWy6bfGJouqPWZ/altOyQucnLQvV0oMOhA8e095NeEWYrfXnPDPBzB",
-	"xY7mHIrXGsLsDmNmhMwh20f5qOK5VvEROHhI62qleQFZASXfJYID6DOjz/sGwB1v1V1lIaNA3PSmt5Qc",
-	"4h73DK1wPJMSHhl+Ybk7gk4VaAnE9z4wcgE4doo5eTq61wyFcyW3KIyHy6atToyIt+GVsm7HPT0gyJ6j",
-	"TwF4BA/N0DdHBXbOWt2zP8V/g/ETNHLE8ZPswIwtoR3/qAWM2IL9G6fovPTYe48DJ9nmKBs7wEfGjuyI",
-	"YfoV11bkokJd5zvY3bnq158g6ThnBVguSihY9IHUwCruzyiEtD/mzVTBSba3IfgD41tiOSFMpwv8JexQ",
-	"535FbxMiU8dd6LKJUd39xCVDQEPEsxPB4yaw5bktd05Qs2vYsWvQwEy9oBCGoT/FqiqLB0j6Z/bM6L2z",
-	"Sd/oXnfxBQ4VLS8Va0Y6wX743vQUgw46vC5QKVVOsJANkJGEYFLsCKuU23Xhnz+FBzCBkjpAeqaNrvnm",
-	"+r9nOmjGFbD/VjXLuUSVq7bQyDRKo6CAAqSbwYlgzZw+OLHFEJSwAdIk8cvDh/2FP3zo91wYtoTr8GbQ",
-	"Neyj4+FDtOO8UsZ2Dtcd2EPdcTtPXB/ouHIXn9dC+jzlcMSTH3nKTr7qDd54u9yZMsYTrlv+rRlA72Ru",
-	"p6w9ppFp0V447iRfTjc+aLBu3PcLsalLbu/CawVXvMzUFWgtCjjIyf3EQsmvr3j5Y9MN30NC7mg0hyzH",
-	"V3wTx4I3rg89/HPjCCncAaag/6kAwTn1uqBOB1TMNlJVbDZQCG6h3LFKQw703s1JjqZZ6gmjSPh8zeUK",
-	"FQat6pUPbqVxkOHXhkwzupaDIZJCld3KDI3cqQvAh6mFJ49OnALuVLq+hZwUmGvezOdfuU65maM96HsM",
-	"kk6y+WxU43VIvWo1XkJO993mhMugI+9F+GknnuhKQdQ52WeIr3hb3GFym/vrmOzboVNQDieOIn7bj2NB",
-	"v07dLnd3IPTQQExDpcHgFRWbqQx9Vcv4jXYIFdwZC5uhJZ+6/nXk+L0e1ReVLIWEbKMk7JJpSYSE7/Fj",
-	"8jjhNTnSGQWWsb59HaQDfw+s7jxTqPG2+MXd7p/QvsfKfKP0XblEacDJ4v0ED+RBd7uf8qZ+Ul6WCdei",
-	"f8HZZwBm3gTrCs24MSoXKLOdF2buo4LJG+mfe3bR/6p5l3IHZ68/bs+HFicHQBsxlBXjLC8FWpCVNFbX",
-	"uX0nOdqooqUmgriCMj5utXwemqTNpAkrph/qneQYwNdYrpIBG0tImGm+AQjGS1OvVmBsT9dZAryTvpWQ",
-	"rJbC4lwbd1wyOi8VaIykOqGWG75jS0cTVrFfQCu2qG1X+scHysaKsvQOPTcNU8t3kltWAjeWfS/kmy0O",
-	"F5z+4chKsNdKXzZYSN/uK5BghMnSwWbf0leM6/fLX/sYfwx3p88h6LTNmDBzy+wkSfnf9//j2duz7H94",
-	"9suj7Mt/O33/4enHBw8HPz75+Oc//5/uT599/POD//jX1E4F2FPPZz3k5y+8Znz+AtWfKFS/D/sns/9v",
-	"hMySRBZHc/Roi93HVBGegB50jWN2De+k3UpHSFe8FIXjLTchh/4NMziLdDp6VNPZiJ4xLKz1SKXiFlyG",
-	"JZhMjzXeWIoaxmemH6qjU9K/PcfzsqwlbWWQvukdZogvU8t5k4yA8pQ9Y/hSfc1DkKf/88nnX8zm7Qvz",
-	"5vtsPvNf3ycoWRTbVB6BArYpXTF+JHHPsIrvDNg090DYk6F0FNsRD7uBzQK0WYvq03MKY8UizeHCkyVv",
-	"c9rKc0kB/u78oItz5z0navnp4bYaoIDKrlP5izqCGrZqdxOgF3ZSaXUFcs7ECZz0bT6F0xd9UF8JfBkC",
-	"U7VSU7Sh5hwQoQWqiLAeL2SSYSVFP73nDf7yN3euDvmBU3D150xF9N779us37NQzTHOPUlrQ0FESgoQq",
-	"7R9PdgKSHDeL35S9k+/kC1ii9UHJZ+9kwS0/XXAjcnNaG9Bf8ZLLHE5Wij0L7zFfcMvfyYGkNZpYMXo0",
-	"zap6UYqcXcYKSUuelCxrOMK7d295uVLv3r0fxGYM1Qc/VZK/0ASZE4RVbTOf6ifTcM11yvdlmlQvODLl",
-	"8to3KwnZqiYDaUgl5MdP8zxeVaaf8mG4/Koq3fIjMjQ+oYHbMmasat6jOQHFP+l1+/uD8heD5tfBrlIb",
-	"MOxvG169FdK+Z9m7+tGjz/BlX5sD4W/+ync0uatgsnVlNCVF36iCCye1EmPVs4qvUi62d+/eWuAV7j7K",
-	"yxu0cZQlw26dV4fhgQEO1S6geeI8ugEEx9GPg3FxF9QrpHVMLwE/4RZ2H2Dfar+i9/M33q4Db/B5bdeZ",
-	"O9vJVRlH4mFnmmxvKydkhWgMI1aorfrEeAtg+RryS5+xDDaV3c073UPAjxc0A+sQhnLZ0QtDzKaEDooF",
-	"sLoquBfFudz109oYelGBg76GS9i9UW0ypmPy2HTTqpixg4qUGkmXjljjY+vH6G++jyoLD019dhJ8vBnI",
-	"4llDF6HP+EEmkfcODnGKKDppP8YQwXUCEUT8Iyi4wULdeLci/dTyhMxBWnEFGZRiJRapNLz/NfSHBVgd",
-	"VfrMgz4KuRnQMLFkTpVf0MXq1XvN5Qrc9eyuVGV4SVlVk0EbqA+tgWu7AG732vllnJAiQIcq5TW+vEYL",
-	"39wtAbZuv4VFi52Ea6dVoKGI2vjo5ZPx+DMCHIobwhO6t5rCyaiu61GXyDgYbuUGu41a60PzYjpDuOj7",
-	"BjBlqbp2++KgUD7bJiV1ie6X2vAVjOgusfduYj6MjscPBzkkkSRlELXsixoDSSAJMjXO3JqTZxjcF3eI",
-	"Uc3sBWSGmchB7H1GmETbI2xRogDbRK7S3nPd8aJSVuAx0NKsBbRsRcEARhcj8XFccxOOI+ZLDVx2knT2",
-	"K6Z92Zea7jyKJYySojaJ58Jt2OegA73fJ6gLWelCKrpY6Z+QVs7pXvh8IbUdSqJoWkAJK1o4NQ6E0iZM",
-	"ajfIwfHjcom8JUuFJUYG6kgA8HOA01weMka+ETZ5hBQZR2Bj4AMOzH5Q8dmUq2OAlD7hEw9j4xUR/Q3p",
-	"h30UqO+EUVW5y1WM+BvzwAF8KopWsuhFVOMwTMg5c2zuipeOzXldvB1kkCENFYpePjQfevNgTNHY45qi",
-	"K/+oNZGQcJPVxNJsADotau+BeKG2Gb1QTuoii+3C0Xvy7QK+l04dTMpFd8+whdpiOBdeLRQrfwCWcTgC",
-	"GJHtZSsM0iv2G5OzCJh90+6Xc1NUaJBkvKG1IZcxQW/K1COy5Ri53I/Sy90IgJ4Zqq3V4M0SB80HXfFk",
-	"eJm3t9q8TZsanoWljv/YEUru0gj+hvaxbkK4v7SJ/8aTi4UT9Uky4Q0tS7fJUEidK8o6eEyCwj45dIDY",
-	"g9VXfTkwidZurFcXrxHWUqzEMd+hU3KINgMloBKcdUTT7DIVKeB0ecB7/CJ0i4x1uHtc7h5EAYQaVsJY",
-	"aJ1GIS7otzDHc0yfrNRyfHW20ku3vtdKNZc/uc2xY2eZn3wFGIG/FNrYDD1uySW4Rt8YNCJ945qmJdBu",
-	"iCIVGxBFmuPitJewywpR1ml69fN+98JN+0Nz0Zh6gbeYkBSgtcDiGMnA5T1TU2z73gW/pAW/5He23mmn",
-	"wTV1E2tHLt05fifnosfA9rGDBAGmiGO4a6Mo3cMgowfnQ+4YSaNRTMvJPm/D4DAVYeyDUWrh2fvYzU8j",
-	"JdcSpQFMvxBUqxUUIb1Z8IfJKIlcqeQqquJUVfty5p0wSl2Hmef2JK3zYfgwFoQfifuZkAVs09DHWgFC",
-	"3r6sw4R7OMkKJKUrSZuFkqiJQ/yxRWSr+8S+0P4DgGQQ9JueM7uNTqZdarYTN6AEXnidxEBY3/5jOdwQ",
-	"j7r5WPh0J/Pp/iOEAyJNCRsVNhmmIRhhwLyqRLHtOZ5o1FEjGD/KujwibSFr8YMdwEA3CDpJcJ1U2j7U",
-	"2hvYT1HnPXVaGcVe+8BiR9889w/wi1qjB6MT2TzM297oahPX/t3PF1ZpvgLvhcoIpFsNgcs5Bg1RVnTD",
-	"rKBwkkIslxB7X8xNPAcd4AY29mIC6SaILO2iqYW0XzxNkdEB6mlhPIyyNMUkaGHMJ/9m6OUKMn1kSmqu",
-	"hGhrbuCqSj7X/w522c+8rJ2SIbRpw3O926l7+R6x61eb72CHIx+MenWAHdgVtDy9BqTBlKW/+WSiBNb3",
-	"TCfFP6qXnS08YqfO0rt0R1vjizKME397y3SKFnSXcpuD0QZJOFim7MZFOjbBnR7oIr5Pyoc2QRSHZZBI",
-	"3o+nEiaUsBxeRU0uikO0+wZ4GYgXlzP7OJ/dLhIgdZv5EQ/g+lVzgSbxjJGm5BnuBPYciXJeVVpd8TLz",
-	"8RJjl79WV/7yx+YhvOITazJpyn7z9dnLVx78j/NZXgLXWWMJGF0Vtqt+N6uiMg77rxLK9u0NnWQpija/",
-	"ycgcx1hcY2bvnrFpUBSljZ+JjqKPuVimA94P8j4f6kNL3BPyA1UT8dP6PCngpxvkw6+4KIOzMUA7EpyO",
-	"i5tWWSfJFeIBbh0sFMV8ZXfKbganO306Wuo6wJNwrh8xNWVa45A+cSWyIh/8w+9cevpG6Q7z9y8Tk8FD",
-	"v55Y5YRswuNIrHaoX9kXpk4YCV5/W/3NncaHD+Oj9vDhnP2t9B8iAPH3hf8d9YuHD5Pew6QZyzEJtFJJ",
-	"voEHzSuL0Y34tAq4hOtpF/TZ1aaRLNU4GTYUSlFAAd3XHnvXWnh8Fv6XAkpwP51MUdLjTSd0x8BMOUEX",
-	"Yy8RmyDTDZXMNEzJfkw1PoJ1pIXM3pdkIGfs8AjJeoMOzMyUIk+HdsiFcexVUjCla8yw8Yi11o1Yi5HY",
-	"XFmLaCzXbErO1B6Q0RxJZJpk2tYWdwvlj3ctxT9qYKJwWs1SgMZ7rXfVBeUARx0IpGm7mB+Y/FTt8Lex",
-	"g+zxNwVb0D4jyF7/3YvGpxQWmir6c2QEeDzjgHHvid729OGpmV6zrbshmNP0mCml0wOj8866kTmSpdCF",
-	"yZZa/QJpRwj6jxKJMILjU6CZ9xeQqci9PktpnMptRfd29kPbPV03Htv4W+vCYdFN1bGbXKbpU33cRt5E",
-	"6TXpdM0eyWNKWBxh0H0aMMJa8HhFwbBYBiVEH3FJ54myQHRemKVPZfyW85TGb0+lh3nw/rXk1wueqhHj",
-	"dCEHU7S9nTgpq1joHDbANDkOaHYWRXA3bQVlkqtAtz6IYVbaG+o1NO1kjaZVYJCiYtVlTmEKpVGJYWp5",
-	"zSVVEXf9iF/53gbIBe96XSuNeSBNOqSrgFxskubYd+/eFvkwfKcQK0EFsmsDUQVmPxCjZJNIRb6KdZO5",
-	"w6PmfMkezaMy8H43CnEljFiUgC0eU4sFN3hdNu7wpotbHki7Ntj8yYTm61oWGgq7NoRYo1ije6KQ1wQm",
-	"LsBeA0j2CNs9/pLdx5BMI67ggcOiF4Jmzx5/iQE19Mej1C3rC5zvY9kF8uwQrJ2mY4xJpTEck/SjpqOv",
-	"lxrgFxi/HfacJuo65SxhS3+hHD5LGy75CtLvMzYHYKK+uJvozu/hRZI3AIzVaseETc8Pljv+NPLm27E/",
-	"AoPlarMRduMD94zaOHpqyyvTpGE4qvXv60UFuMJHjH+tQvhfz9b1idUYvhl5s4VRyj+gjzZG65xxSv5Z",
-	"ijYyPdTrZOchtzAW0GrqZhFu3Fxu6ShLYqD6klVaSIv2j9ousz85tVjz3LG/kzFws8UXTxOFqLq1WuRx",
-	"gH9yvGswoK/SqNcjZB9kFt+X3ZdKZhvHUYoHbY6F6FSOBuqmQzLH4kL3Dz1V8nWjZKPkVnfIjUec+laE",
-	"J/cMeEtSbNZzFD0evbJPTpm1TpMHr90O/fT6pZcyNkqnCga0x91LHBqsFnCFL+bSm+TGvOVe6HLSLtwG",
-	"+t82/imInJFYFs5yUhGIPJr7Hss7Kf7n79vM5+hYpZeIPRug0glrp7fbfeJow+Osbn3/LQWM4bcRzE1G",
-	"G44yxMpI9D2F1zd9fot4oT5ItOcdg+PjvzHtdHCU4x8+RKAfPpx7MfhvT7qfib0/fJhOQJw0ublfWyzc",
-	"RiPGvqk9/EolDGChamETUOTzIyQMkGOXlPvgmODCDzVn3Qpxn16KuJv3Xelo0/QpePfuLX4JeMA/+oj4",
-	"jZklbmD7SmH8sHcrZCZJpmi+R3HunH2ltlMJp3cHBeL5J0DRCEommudwJYMKoEl3/cF4kYhG3agLKJVT",
-	"MuOiQLE9//eDZ7f4+R5s16Isfm5zu/UuEs1lvk5GCS9cx7+SjN65golVJuuMrLmUUCaHI932r0EHTmjp",
-	"f1dT59kIObFtvwItLbe3uBbwLpgBqDChQ6+wpZsgxmo3bVaTlqFcqYLhPG1Ri5Y5Dks5p0poJt4347Cb",
-	"2vq4VXwL7hMOLUWJYZhpvzG2zDS3Iwm0sN55qC/kxsHy44bMDDQ6aMbFBi9mwzdVCXgyr0DzFXZVEnrd",
-	"MYUajhxVrGCmcp+wJSasUMzWWjK1XEbLAGmFhnI3ZxU3hgZ55JYFW5x79uzxo0dJsxdiZ8JKCYthmT+2",
-	"S3l8ik3oiy+yRKUAjgL2MKwfW4o6ZmOHhONrSv6jBmNTPBU/0MtV9JK6W5vqSTa1T0/Yt5j5yBFxJ9U9",
-	"mitDEuFuQs26KhUv5pjc+M3XZy8ZzUp9qIQ81bNcobWuS/5J98r0BKMhs9NI5pzp4+xP5eFWbWzWlJ9M",
-	"5SZ0LdoCmaIXc4N2vBg7J+wFmVCbAv40CcMU2XoDRVTtkpR4JA73H2t5vkbbZEcCGueV0wuxBnbWem6i",
-	"14dN9SNk2A5uX4uVSrHOmbJr0NfCAL7IhyvopkNscoN623hIj9hdnq6lJEo5OUIYbWodHYv2ABxJsiGo",
-	"IAlZD/FHWqaoHvOxdWkvsFf6LUavyG3P6x+S64UU2+x771zIuVRS5FgKISVJY+q2aW7KCVUj0v5FM/Mn",
-	"NHG4kqV1m7fAHoujxXYDI/SIG7r8o69uU4k66E8LW19ybQXWeM4GxTxUuvYOMSEN+GpWjohiPql0Iqgp",
-	"+RCiCaA4kowwK9OIhfMb9+0Hb//GpBiXQqKly6PN62fksiqNQM+0ZMKylQLj19N9zWPeuj4nmKWxgO37",
-	"k5dqJfILscIxKIzOLZtiRodDnYUIUh+x6do+d2197vzm5044GE16VlV+0vE66ElB0m7lKIJTcUshkCRC",
-	"bjN+PNoectsb+o33qSM0uMKoNajwHh4QRlNLuzvK1063JIrCFoxeVCYT6AqZAOOlkMGFmr4g8uSVgBuD",
-	"53Wkn8k1t6Q7TOJpb4CXIw8g8IUy+eBvO1S/coBDCa4xzDG+jW0Z8BHG0TRoJX4udywcCkfdkTDxnJdN",
-	"6HSiqDdKVV6IKvBxUa/Md4pxOMadhSeTHXQdfL7XdMdqHMfeRGM5Chd1sQKb8aJIpbb6Cr8y/BoeicEW",
-	"8ropQtW8DuzmKB9Sm58oV9LUmz1zhQa3nC6qm5+ghrh2f9hhzLSz2OG/qQpM4zvjg6aPfpUbIqSL4xLz",
-	"D18Zp6ReR9OZEatsOibwTrk9Otqpb0bobf87pfTwXPef4jVuj8vFe5Tib1+7iyNO3DuIT6erpcmri7Hg",
-	"Cr+HhEdNRsguV8KrbFBnDKMecPMSW9YDPjRMAn7Fy5GX8LGvhO5X8h+MvYfPR9M3cOvTc1nO9rKg0ZRH",
-	"FCvc874MXYhj8cEUHnx3Xgu/1r0IHffdfdfx1FGMWMssRj10N3OitRt8rBftu6uxFAmhTgd+j+uB+Cie",
-	"uU8DD1dC1SH6KsRAB5WQfvUpeDp1P0bWn3xZ8Ft7LUZ9LG98/VpaptfJv/uZvLAMpNW7fwKPy2DT+0Vl",
-	"EtIumafaJqwpfTipFGLnVpxSwyZVLsXLhsFWRqylQ0uD8jMDsnoxRRwY4OPjfHZeHHVhpkruzGiU1LF7",
-	"KVZrixn7/wK8AP3qQEWCtgoBHrFKGdFWIC3dYD4F7BqHO5n62MARsIgrKgzHCkGoV5BbLDvbBtdpgGPq",
-	"K7jJgtPnj8oE4+p08ybDFyTYV4VgWGv2wB0/SJwUJf+iOp0n03PunzUh1PQC7JqbNl1L78305JebyyXk",
-	"mBV5b6Kq/1qDjJIgzYNdBmFZRnmrRPOOCfN6H291bAHal0dqLzxRfZ1bgzP2jv0SdvcM61BDsnBo84jv",
-	"JomDEQPkAgs5pMcMyT5qTJiGMhALISTYp2Jui2OM5nyO0q7dcK5Aku7iaFOx7ZkyXfR80lyu61FpH/FJ",
-	"zlguq2HN5HH94wWWqDY+QI43iYdjLZ2dDwvnXPvExZhWrPGdhBTGYMJvIYcgzVKKS18/ALFCnqprrovQ",
-	"4k6SQtHdJNJAL5uZRfuAYxjkkCjFgG+h8lI5MSIbe1DWfTPRBBzeMxQZ2ibwQbiWoDUUjUukVAYyq8KD",
-	"j31w7EMFhb/eCAlmtPwRATea+vp1m9sby8BxTHXNfdRrvECmYcMddDrKwD0+5z5kP6fv4RF+KAN20MLU",
-	"0OvherTh6Y4wAyTGVL9k/rY8/Lj/JsYmISXoLHie+um4ZTcjG+bdLOqcLuj4YDQGucm5c/awkqSdJh+u",
-	"sqcjRI/kL2F3SkpQKOQbdjAGmiQnAj1KONrb5Ds1v5kU3Ks7Ae+3zSNXKVVmI86O82EO8T7FX4r8EjAH",
-	"YBPiPlKjnd1HG3vjzb5e70LO7KoCCcWDE8bOJD0qCo7tbnnB3uTynt03/xZnLWpK6++NaifvZPp1Bibc",
-	"17fkZmGY/TzMgGN1t5yKBjmQoXorx0JurjE5f7eK58lUrXzoau5XkW+JiqBIySQX5LF6jgc9ZTjCFAhR",
-	"rg50ZHLmPV3MlCoVy3uTNA1uqDSm4skQIAtySraABgo/eBIBybroiVNIqe980ju1ZBpaJ/JNs/8NS7in",
-	"NPr+zM0sXX63VBo6xdhdb8r02Tx8wTSa+J+FsJrr3U1y9A1KyA+sJ6NYPhiO1URitQtpo7GGOCxLdZ0h",
-	"s8qaOhcp1da1M93LOBRda/u5U72AKK6LGy+o7diaFyxXWkMe90i/9ySoNkpDVioM80p5oJfWyd0bfOQl",
-	"WalWTFW5KoDqxaQpaGyuWkqOYhNEUTVJFBDt4Gth6hPR8cQp3Z1KfqQMRa3VEbXzc6CX621WJ1p0Rr7M",
-	"kYhlMD6Lk8cQNR7Cu6f2f5o3L8UW6QZ06sgvmdU1zJlv0a+R7Q8+18A2whgCpaGla1GW+HBcbCPPaxO4",
-	"kEbtiNh7jmGVVwJjb7pJBEgartyd12RWiHnARZz2iNm1VvVqHSWYbuAMKq+uvUIcj/KTqTE8Cl+QuSme",
-	"so0y1muaNFK75Dbk7H6upNWqLLtGKRLRV97S/j3fnuW5fanU5YLnlw9Qr5XKNist5uF9dT84sJ1J91KL",
-	"dS/gjMqZH07VS+0wVM4T7WQG2WNxRxd2j8B8f5iDHra5nw0X1l9Xl5mm1ZgzybhVG5Gnz9TvK9puNEYu",
-	"xaKSOcuotiJlmcBmeNjjy6oJrkAWOUQzSJ4sDnfGPCPwTmZkN+6/KIH3x2VL8Ixm5KIcMhcvRWX5qKzX",
-	"AwAhpafPttZUkDGWxBquolaUKgFd5H1AJ94qGIl0O9jcCHcOlIVbATWIfmwAvE/GhznllqNIyoXahu8P",
-	"2uRzNwL+434q7zCPsRCvi5a0NAV5hUQ1IxwhneJ6bzzUG3z2vpgaFdUUz514w0cAjMdJdWCYFC11LBhL",
-	"LkooslTtxfPGRjWPNG3/NKtfEl0Yz8lzXofSh27sWoNPnEIivu76vyruSEk1zYeWZFnAFuhdxy+gFdU0",
-	"nEf+Fyip5GHPGKCqrIQr6ISP+WwuNYqa4gpCX9N0ZgVAhd7Ivo0sFRcV3+U9w4lfexZF1kzBbtKSQoil",
-	"nWIHzCRJo85WZnRMzNSj5CC6EkXNO/gzx4ocXTOgO8oJVA10hCzokVOn+YlGeB0GOAv9U6JMwMT7aXzo",
-	"aBaURt0+BnQwTrI2Y6depsMk41RFjYMFZysaRyyReMs3TMWv5bhBckjyrbo1cZ+EkhFiv95CjlKN13eg",
-	"8BrPiJPCZz1BapcABWkFrkvC2r4GyaSKSkxec9OoKm0OxfADTYyNhPTa9A2cym004+13luFgzPSSqY0q",
-	"Erqh05ub53+Tk7j3II6Ol6IRA/753x77V6Bur3ZgAyzlLd1+OtkfizT6W8xz8Tlb1GGgslTXVDMy1kNf",
-	"QPCDEvUFF5AXy0VzLYeozblP79k3dYgoXn3Dd0xp/Mdpnf+oeSmWO+QzBH7oxsyaOxLyjleKCPBRoG7i",
-	"/eLVPAAWrC0qTEXrFlPHjIbbuVEioN1FHor7KLbhlxBvAwY7EP/MrWOcpl6g5cJd2b3tHGLBLz6kaNnw",
-	"Itb0MVFkt4x6SB3sev9/7Vu4eKqQ360qeR4qhPoSRV0+g1WAA3HZNWz2P5Yc8rVAAk1l4ZZodXhdX9zA",
-	"ZHok60q9QBgrv9IBe1BxdVB55lbLmGj57dXY2PPMdNJS7noXpkbdDICO6zQeAj8uW/lp8J/M4Tq2jCng",
-	"/7PgfaRQbQwv1aT9BFjuZOBIwErW6oXaZhqW5lCACZmrnTqv29wdwcQqZK6BG4q4Of/RK55tilIhnSJM",
-	"MaGNT7MZpYClkC2zFLKqbUKPwUylchchLDb6I1pHXGhjUoITJq94+eMVaC2KsY1zp4NKOsYlIoKjw/dN",
-	"mDCaO3U4gDCtDofvM1szetzMXeBUhIrCNY3lsuC6iJsLyXLQ7t5n13xnbu5RapwDh3xKPJJmulkDIu8S",
-	"kjYBUu68U/iW/p4GQH6Hjp8JDhuMC044a8i0Y9WIf2YIw+/CYbPh26xUK3xFOHIgfG5a9PCRCqgkmsFJ",
-	"Ppu27jCPEb/A/mkwLb9nRFbhrFOm2H/uf8StRDXyJyns3pNPNsr+s06Ku6WDGZAqV23wPxHL8DymXuL6",
-	"5Cvxa9wgbIanKoH2INpEGPEPde3iI7uIYRD+GXdsBJ9e7qwbaZF670uWgQwtBmZPeD+YNpSd5z48a2hK",
-	"G5gaCClz/1r6SEsb2efDvTQCHtWm92e9O20TMuPGOaZG3P730VmlqiyfEvNJlTsK7ybwkHZhHKGPyAkw",
-	"su4mPMY0tWw6eY86RW2OLZM3WlTnkLeryvcp/WNmohGO3nVBqCXyMqrcjtYtfMnTGFPm/TdmXTNYwyQY",
-	"ZxryWqOZ+JrvDpcdG8kYffGXs88fP/nrk8+/YK4BK8QKTJt1vFe2q40LFLJv9/m0kYCD5dn0JoTsA4S4",
-	"4H8Mj6qaTfFnjbitaVOKDoqWHWNfTlwAieOYKBd1o73CcdrQ/n+u7Uot8s53LIWCX3/PtCrLdNWHRq5K",
-	"OFBSuxW5UJwGUoE2wljHCLseUGHbiGizRvMg5v69omwySuYQ7MeeCoQdCblKLWQsoBb5Gb7t9l4jBtuq",
-	"9LyKPD371uX1NLLQodCIUTELYJWqvGgvliwFEb4g0tHLWm/4RIt4FCPbMFuKlk0Roo88T5NeXDB7P7fv",
-	"FnO1aU7vNjEhXoRDeQPSHPNPjOctuAknaU37/zT8I5GI4c64RrPcX4NXJPWDmxXlnwTa8FF+gjwQgJHX",
-	"tp13ktFDsSgRsSYvAfoTggO5L3583zqWDz4LQUhChwPgxc9n23bNSwYPzm+c0ff7BinRUt6PUUJn+Yde",
-	"5AbW21wk0RZ5o4m1YIgtqaFYGD23Ns+bV8wjWsngsbNWyjKnmZZl4pE02XHwTMWE41QCfcXLT881vhHa",
-	"2DPEBxSvx59GxS9lYyQTKs3N8vS95JPmjl7F3t3U8hU+zP4vcHuUvOf8UN4JP7jN0LiDFetX4Vagt97s",
-	"GsekIKvHX7CFL7ZRaciF6Tv3r4Nw0jwMBS2WPqAVtvbAS9RD6/xZ2VuQ8TJE4rAfIvdW47P3ELZH9Ddm",
-	"KiMnN0nlKeobkEUCfykeFRfnPXBd3LIww83SvkQJ3I5M+zIsOzx1eZTaxF06tYHhOiff1h3cJi7qdm1T",
-	"cxZNru/w7t1bu5iSaihdi8F1x1xHd1KU4aiSDL9CliPCkR/Dz5uimJ/H8t5SbteR3Ny9/ahFeTBgpZNp",
-	"/eN8tgIJRhjMJf5XXzvm096lAQLKvDA8qgTrbdLFEGISa+1MHk0V5VCfkD7dd0vkvMZXjXmthd1h3eBg",
-	"QBN/TeZj+rbJ7eFzwzS+NH/3WXUJTe32NhNIbcLt+q3iJd5H5OKT7hZS5Qn7mjJ8+4Py53uLf4fP/vS0",
-	"ePTZ439f/OnR549yePr5l48e8S+f8sdffvYYnvzp86eP4PHyiy8XT4onT58snj55+sXnX+afPX28ePrF",
-	"l/9+z/EhBzIBGlL7P5v9r+ysXKns7NV59sYB2+KEV+I7cHuDuvJSYV1Lh9QcTyJsuChnz8JP/384YSe5",
-	"2rTDh19nvj7TbG1tZZ6dnl5fX5/EXU5X+PQ/s6rO16dhHqw22JFXXp03MfoUh4M72lqPcVM9KZzht9df",
-	"X7xhZ6/OT1qCmT2bPTp5dPLYl7aWvBKzZ7PP8Cc8PWvc91PMr3lqfOr80/atVtJv9xpD1oNwrldQsPvN",
-	"q5t/azy35kF4vLMUJV4ZfzdEjM0qzgskLl+jdIZV1zAYC8F68uhR2Asv6UQXzim+/nj2YdbWtu8LEwOk",
-	"vmkBTkLW1nwcLvoneSnVtWSYDJAOUL3ZcL2jFXSwEQ2O28RXBo3sWlxxC7P3rncf51XlCxaMoRyrXHVP",
-	"eeiMBNJkvHcnjBLh+7IDJoXyYbGEW2J/b3LIwWSJ3cFGrxzMIX1Ok1DRO4Q8ztBnTAhrzgiZHQaIns+q",
-	"OoHOr/FhjdmHs3mUhJ+gUWXRYHyA0Vf1/yMYdaTr76bZsw/urzXwEhNruT82jlDz8EkDL3b+/+aar1ag",
-	"T/w63U9XT06DFnL6wWdM+bjv22kcEXb6oZNYpjjQM0Q8HWpy+iGUzN4/YKdcso81jTpMBHRfs9MFlsma",
-	"2hTi1Y0vBWnenH5ABXz091NvRU1/REMI3bCnIUHTSEtKxZH+2EHhB7t1C9k/nGsTjZdzm6/r6vQD/gfJ",
-	"NloRZfY9tVt5ioEjpx86iPCfB4jo/t52j1tcbVQBATi1XFKd8X2fTz/Qv9FEsK1AC6eFYjYt/ytlPTzF",
-	"cpO74c87mSd/HK6jk/HtwGWO2QRNiKbqJopLXh/97HPmtsxuWi6bfs67oYA9lKT2rezjfPb0DrlyN1Nw",
-	"ApiveMFCYgOc+/Gnm/tcUlS3Ey1JBEYInn46CDrbx76DHftBWfYN2mo+zmeff8qdOJdOc+RlEOhuKPpN",
-	"Oz79a9TJ3k0zuSJBRVGyi+5ROyuKAdGTDgnGfqXwdh3D2MasKu+lbZHWqtBCuiXMp4nNw/SRlOQsCBJS",
-	"FTCLlVura/h4S57Qi+/i2p4nTMroG8GHHstQoD8CNZkLsR/9QiMPzR+HSPj8RZi0fR/xB0/5g6c0POXz",
-	"R599uukvQF+JHNgb2FRKcy3KHftJNg9vbszjzooimUC2e/QP8rj5bJvlqoAVyMwzsGyhip2vyDPrTHAJ",
-	"ZC0bCDKnwbrU0RhGuGewW6WklTYcfPbsbSoswj9vrOpFKXJGlnU0LVXcriPLT5PRs8v85nssE/NE1nhW",
-	"iLJusjnYa+VfSw8vlMjaYhUz/9B48eBBFHbHroUs1PWDkwDuP2pAPu/hDdPMEgBGMb7DAkmtw9ABOABr",
-	"bD70NE7Bzp7JX/KbzV3yY6d+/2vboJqMef958eMP0WtEsjRQQBC+hSPSxYcLWmFA/jXHiFAqpPicbEDl",
-	"Dl/VWm5r06nhdvLHPfQH77897/+2SaFM1dsslmUasqToLjiZJPAmefuHzp/ebjGjcOxU4mP3O+NshZU3",
-	"hxfUYsfOXwy0V+rWvxK+2mHT3q2Q4Pd9EI9i/CPsZZ9I4xayUrYJSqdF/SFk/iFk3kpxnXx4puiuScsS",
-	"1cPlA31sHkrbdh7+YPJyDPEagDLF/vSbHt872fihbStly6Ik61Cw6ANlp+ij+Q8W8QeLuB2L+BYShxFP",
-	"rWcaCaI7ztY1lWFgKqWiE2IZpI7QvC65jh4EHzJhn+GIaVXwV+Ean9pgl8QV2evw3YCggNnEBt6tDe8P",
-	"lvcHy/v9sLyzw4ymK5jc2up1CbsNrxpbl1nXtlDXkYccYaFg96GPjxT//t+n11zYbKm0L9nDlxb0sLMF",
-	"Xp76+ty9X9uSmIMvWOcz+jFORpf89ZR3nZZdx7ljvWMdB1711FfvOB5pFHIohM9tzF4cA4dsv4l+e/ve",
-	"sWwD+ircCG1I17PTU0yqs1bGns4+zj/0wr3ij+8b8vjQ3COeTD4iXSgtVkLyMvOxEVkbtvXk5NHs4/8N",
-	"AAD//1nr4yEUHQEA",
+	"H4sIAAAAAAAC/+y9/XMbN7Io+q+geE5VYh9Ssh0nZ+NXW+cpcZLViZO4Iif7zot9d8GZJonVEJgFMJIY",
+	"X//vt7rxMZgZDDmUZGdTNz/Z4uCj0Wg0Gv35dlaoba0kSGtmz97Oaq75Fixo+osXhWqkXYgS/yrBFFrU",
+	"Vig5exa+MWO1kOvZfCbw15rbzWw+k3wLs2dp//lMwz8boaGcPbO6gfnMFBvYchzY7mpsHUe6WazVwg9x",
+	"5oY4fz57t+cDL0sNxgyh/EFWOyZkUTUlMKu5NLzAT4ZdC7thdiMM852ZkExJYGrF7KbTmK0EVKU5CYv8",
+	"ZwN6l6zSTz6+pHctiAutKhjC+aXaLoWEABVEoOKGMKtYCStqtOGW4QwIa2hoFTPAdbFhK6UPgOqASOEF",
+	"2Wxnz36ZGZAlaNqtAsQV/XelAX6FheV6DXb2Zp5b3MqCXlixzSzt3GNfg2kqaxi1pTWuxRVIhr1O2HeN",
+	"sWwJjEv249dfsk8++eRzXMiWWwulJ7LRVbWzp2ty3WfPZiW3ED4PaY1Xa6W5LBex/Y9ff0nzX/gFTm3F",
+	"jYH8YTnDL+z8+dgCQscMCQlpYU370KF+7JE5FO3PS1gpDRP3xDW+101J5/9Nd6XgttjUSkib2RdGX5n7",
+	"nOVhSfd9PCwC0GlfI6Y0DvrLo8Xnb94+nj9+9O7ffjlb/P/+z08/eTdx+V/GcQ9gINuwaLQGWewWaw2c",
+	"TsuGyyE+fvT0YDaqqUq24Ve0+XxLrN73ZdjXsc4rXjVIJ6LQ6qxaK8O4J6MSVrypLAsTs0ZWYAyN5qmd",
+	"CcNqra5ECeWcCcmuN6LYsIIbNwS1Y9eiqpAGGwPlGK3lV7fnML1LUYJw3QoftKB/XWS06zqACbghbrAo",
+	"KmVgYdWB6yncOFyWLL1Q2rvKHHdZsVcbYDQ5fnCXLeFOIk1X1Y5Z2teSccM4C1fTnIkV26mGXdPmVOKS",
+	"+vvVINa2DJFGm9O5R/HwjqFvgIwM8pZKVcAlIS+cuyHK5EqsGw2GXW/Abvydp8HUShpgavkPKCxu+39f",
+	"/PA9U5p9B8bwNbzkxSUDWagSyhN2vmJS2YQ0PC0RDrHn2Do8XLlL/h9GIU1szbrmxWX+Rq/EVmRW9R2/",
+	"Edtmy2SzXYLGLQ1XiFVMg220HAPIjXiAFLf8ZjjpK93Igva/nbYjyyG1CVNXfEcI2/KbPz+ae3AM41XF",
+	"apClkGtmb+SoHIdzHwZvoVUjywlijsU9TS5WU0MhVgJKFkfZA4mf5hA8Qh4HTyt8JeAIeQAcIaeBI+Em",
+	"QzN4uvELq/kaEpI5YT955kZfrboEGQmdLXf0qdZwJVRjYqcRGGnq/RK4VBYWtYaVyNDYhUeHYZy5Np4D",
+	"b70MVChpuZBQMiEd0MqCY1ajMCUT7n/vDG/xJTfw2dPZu0NfJ+7+SvV3fe+OT9ptarRwRzJzdeJXf2Dz",
+	"klWn/4T3YTq3EeuF+3mwkWL9Cm+blajoJvoH7l9AQ2OICXQQEe4mI9aS20bDs9fyIf7FFuzCcllyXeIv",
+	"W/fTd01lxYVY40+V++mFWoviQqxHkBlhzT64qNvW/YPj5dmxvcm+K14oddnU6YKKzsN1uWPnz8c22Y15",
+	"LGGexddu+vB4dRMeI8f2sDdxI0eAHMVdzbHhJew0ILS8WNE/NyuiJ77Sv+I/dV1hb1uvcqhFOvZXMqkP",
+	"vFrhrK4rUXBE4o/+M35FJgDuIcHbFqd0oT57m4BYa1WDtsINyut6UamCVwtjuaWR/l3DavZs9m+nrf7l",
+	"1HU3p8nkL7DXBXVCkdWJQQte10eM8RJFH7OHWSCDpk/EJhzbI6FJSLeJSEoCWXAFV1zak9k8dybbA/yL",
+	"n6nFt5N2HL57T7BRhDPXcAnGScCu4UeGJahnhFZGaCWBdF2pZfzh47O6bjFI38/q2uGDpEcQJJjBjTDW",
+	"PKDl8/YkpfOcPz9h36RjkyiuUL20BC9q4N2w8reWv8WibsmvoR3xI8NoO1FZ824e0WAM2PugOHpWbFSF",
+	"Us9BWsHGf/FtUzLD3yd1/n2QWIrbceLCVsxjzr1x6JfkcfNxj3KGhOPVPSfsrN/3dmSDo+whGHPeYvG+",
+	"iYd+ERa25iAlJBAl1OS3h2vNdzMvJC5I2BuSyU8GHIXUfC0kQTvH55NkW37p9kMR3pEQwMR3kaMlGrRV",
+	"oXqZ06P+ZKBn+R1Qa25jgyRqGGeVMJbe1dSYbaAiwZnLQNApqdyKMiZs+J5FRJivNa8dLfsvTuwSkt7z",
+	"rpGD9Y4X78Q7MQtz+zndaILq1mz5IOvMQoIf+jB8Uani8i/cbO7hhC/DWEPap2nYBngJmm242WQOTo+2",
+	"29Gm0Dc2JJply2Sqk7jEF2pt7mGJlTqGddX1l7yqcOohy+qtlgaedJCrimFjBlthbftwdBp29/5iX/Fi",
+	"g2IBK3hVzVtVkaoXFVxBxZRmQkrUdtkNt+3hp5HDu4bOkQFkdhZYshqvZiIVm466CA1sy+kG2uJrpq66",
+	"fSIHNXwLPSmIbkTVkBYheWicPw+rgyuQxJPi0AR+XCNpa9LBT9hZ/EQzS+UW5zSANpjvIv4iv+gAja3b",
+	"+1S2UyhdOp21xd+EZoXSbgh3w/vJ8T/AddvZUefHtYaFH0LzK9CGV7i63qIeRPK9r9N54GSW3PLkZHoq",
+	"zD/AHOegfiTegc5oaX6g//CK4WeUYpCSWuoRJIyoxJxauosZUeVmwgakb1Vs61SZDPWLR0H5ZTt5ns1M",
+	"OnlfOe2p30K/iLhDr25Eae5rm2iwsb3qnhCnuwrsaCCL7GU6yVxTEPBK1cyxjx4IjlPQaA4h6uber7Uv",
+	"1E0Opi/UzeBKUzdwLzuhbtx/JjH7L9TNcw+Z0ocxT2NPQTouUPItGLrdZMo4cZbWLne2VPp20kTvgpGs",
+	"tTYyjqMmwtS8hyRq2tQLfzYzFgvXoDdQ6+CxXwjoD5/DWAcLF5a/BywYyxPg74CF7kD3jQW1rUUF90D6",
+	"m6wQh/rhT56wi7+cffr4yd+efPoZkmSt1VrzLVvuLBj2sVfLMWN3FTzIvo5IusiP/tnTYKPqjpsbx6hG",
+	"F7Dl9XAoZ/tyr1/XjGG7Ida6aKZVRwAncUTAq82hnTmzLoL2HJbN+gKsxZfuS61W984NBzPkoKNGL2uN",
+	"goXp2gm9tHRaYpNTuLGan9bUEmRJNE/rEIYbA9vlvRDV2MaX7Swl8xgt4eChOHab2ml26VYJUygpobAv",
+	"AfQ9rLKMA8KIDqA1N9YA2rC0x4Q3f2eCSas/OCfiQe90cx9qHtBa6awoUmtlVaGqBcq7QmUUNS99C+Zb",
+	"BLKt+787aNk1NwznJituI8sRfQyaZyff427oVzeypZG9N7lbb2Z1ft4pO9RFfvsaq0Ev7I1kdEo7aqKV",
+	"VlvGWUkdaQO/AevkULGFC8u39Q+r1f1ofRUNlKFlsQWDMzHXggnJDBRKOqfGA2TsR52Cnj5igrXNjgPg",
+	"MXKxkwWZDO+DfY1r9bZCkv+C2ckiUfEhjBWUa9AT8DFdlTeGDjfVRyYDDqLjBX0mm8VzqCz/WulXrRj/",
+	"jVZNfe/XVH/OqcvhfjHeKlJi36AOF3JddR1p1wj7SW6Nv8mCvozKFLcGgp4o8oVYb2zybn6p1XuQDbKz",
+	"5AClD05pVmGfoerse1UiM7GNuQeRuh2s5XBItylf40vVWMaZVCXQ5jcmL2yPuF6Szxe5qtlUfic9jTBs",
+	"CUhdBW9wtWjiVrn7ou244IU7oQtCjTl0obtWbjrn1ldp4CUqxUAytfS+Ht4LhRbJyYvMBnHVi/oZftGB",
+	"q9aqAGPQnOY03wdBC+3c1WH34IkAJ4DjLMwotuL6zsBeXh2E8xJ2C/J5NOzjb382D34DeK2yvDqAWGqT",
+	"Q29frziEetr0+wiuP3lKdk5j6aiWWUWvkwosjKHwKJyM7l8fosEu3h0tV6DJtea9UnyY5G4EFEF9z/R+",
+	"V2ibesST36srUMLDDZNcqiBY5QaruLGLQ2wZG6VrMbiChBPmODENPCJ4veDGOncwIUvS7brrhOahPjTF",
+	"OMCjzxAc+efwAhmOXShpQJrGxOeIaepaaQtlbg1kmR6d63u4iXOpVTJ2fPNYxRoDh0Yew1IyvkeWW4lD",
+	"ELfRDu0t28PFkW8B3vO7LCo7QLSI2AfIRWiVYDf1Zh4BRJgW0Y5whOlRTnShns+MVXWN3MIuGhn7jaHp",
+	"wrU+sz+1bYfE5Yw9NCcrFRgyJPn2HvJrh1nnx77hhnk4gqsBqbWc39oQZjyMCyNkAYt9lE9PPGyVHoGD",
+	"h7Sp15qXsCih4ruMk4T7zNznfQPQjrfPXWVh4RyS85veUnLw/9wztKLxMkzze8XoCyvwCOJToCUQ3/vA",
+	"yCXQ2Dnm5OnoozgUzZXdojAeLdttdWZEug2vFGrnAj0QyJ6jTwF4BA9x6Nujgjov2rdnf4r/AeMnCG1u",
+	"MckOzNgS2vGPWsCITtzHeiXnpcfeexw4yzZH2dgBPjJ2ZEcU9C+5tqIQNb11/gK8srdzu5ikyOpM9i3s",
+	"3HwZfVZWYbih1kGSqNOxUGSh52x/hnt/yfYnyPpDsBIsF6g7Tj64V20XbOcZ3B/TfLgdmIL7s+h9NYJz",
+	"AH0fxk1SA09fCcBhm6YbcqpGOqqfnUb6xC2OvCcStdR96B0yozLhwuRwHcFLH59LaRO44YWtdoyTwLRj",
+	"16CBmWbp3G6GNkCr6kU6QNamuGdG71GQtefvdXG4oKGS5eX8I937bT98r3qPuA46/LutVqqaoM0cICML",
+	"wSR/J1Yr3HXhQ/ZC0FY4Jh0g/QVb7QK4/lpP0UwrYP+jGlZwSc/jxkKUP5UmoQ770gzCJHN6h9oWQ1DB",
+	"Ftyrn748fNhf+MOHfs+FYSu4DnGuDx8O0fHwoTsEGyVhqdR9+PyAtFoc4cQQ5/5KWr07bPvww08983UY",
+	"nvmebsHK2A6rvA/2xrU9z8g2ZF1GqSx7sbmolv1mRz/ylCW/7A0eJiUmYow/qbj8O3O8Hiu6mbL29FBM",
+	"c8m0NxNX/qrrxDdYN+37hdg2Fbf3YVqGK14t1BVoLUo4SOV+YqHkV1e8+iF2o6BlKPBQFrAoKNR24ljw",
+	"Cvu46FwcR0hhRYjMmQoQnLteF67TAf1H604utlsoBbdQ7VitoYDSmYSEYSYu9YTRsKzYcLmm16xWzdp7",
+	"oLtx6IbDIHAKu23kYIisxG9v5IIsMLkbz/uShrhklPWBo76hb75xr+trHufzoehTuFayB31zVtaCO5+N",
+	"qmMQqVetOsYhpxtcPeH26zxGEvy0E0+08xHqUJId4ivdFjxMuLnvx57UDp2Dcjhx4pbffhzzzEddULW7",
+	"BynPDcQ01BoM3cmpDtW4r2qVJlII/rw7Y2E7NDO5rn8bOX4/jiozlKyEhMVWSdhlcwcJCd/Rx1xvJxeM",
+	"dCYJbaxv/4Hcgb8HVneeKdR4V/zSbvdPaN+car5W+r7s9W7AyaLPBPP4QXnIT3lbIz76iw/t3j7Mus8A",
+	"zDx61AvNuDGqECSknpdm7g6aN5X7mOwu+l/G4LF7OHv9cXsG3jSDBxkwoKoZZ0UlyLyhpLG6KexryUmB",
+	"miw142kZNEXjKvUvQ5O8Dj+jYvdDvZacvGyjWjXrTbSCjA7xa4CgWTfNeg3G9h53K4DX0rcSkjVSWJpr",
+	"i8dl4c5LDZrcHU9cSwymWCFNWMV+Ba3YsrHd5w5lETAWFfTO2ozTMLV6LbllFXBj2XcCfZlwuOCREo6s",
+	"BHut9GXEQv52X4MEI8wi7xH6jftKwTd++RsfiIP/952DZ3ib1mSGy+xkMvpfH//XM8xgxBe/Plp8/h+n",
+	"b94+fffg4eDHJ+/+/Of/3f3pk3d/fvBf/57bqQC7KEchP3/uVQHnz+m9l8TT9GH/YMaprZCLLJGlrkY9",
+	"2mIfUz4XT0APuppbu4HXEv3IrMJ0QqLk9nbk0L9hBmfRnY4e1XQ2oqepDWs98lFxBy7DMkymxxpvLUUN",
+	"najz2SRwI0OCCGzFVo10WxmkbxcsHZwf1WoeM4a4ZILPGKWT2PDgie3/fPLpZ7N5mwYifp/NZ/7rmwwl",
+	"i/Iml+yjhJvcWzGNZPrIsJrvDNg89yDYs36ezvEoHXYLqFUxG1F/eE5hrFjmOVyIK/RKtht5Ll0UDp4f",
+	"sr/vvFlPrT483FYDlFDbTS7JWEdQo1btbgL0fKIw5BnknIkTOOkruUp8L3qP0wr4KniPa6WmvIbiOXCE",
+	"FqgiwXq6kEmKlRz99GKQ/OVv7v055AfOwdWfM+d2/9E3X71ip55hmo8IW37oJFNI5intPnS95SzjncDP",
+	"1/K1fA4r0j4o+ey1LLnlp0tuRGFOGwP6C15xWcDJWrFnIWj6Obf8tRxIWqPZT5PMBqxulpUo0DqRI0+X",
+	"0W44wuvXv6Aa+/XrNwPHoeHzwU+V5S9uggUKwqqxC5+Pa6HhmuucYdbEfEw0MvXeO6sTslXjNMJ+fObH",
+	"z/M8Xtemn5dluPy6rnD5CRkan3UEt4wZq2LQqDAx7h7393vlLwbNr4NepTFg2N+3vP5FSPuGLV43jx59",
+	"AqyTqOTv/spHmtzVMFm7Mpo3pq9UoYW7ZyUFlCxqvs7Zf1+//sUCr2n3SV7e4hagoEvdUpzEKCAaql1A",
+	"wMf4Bjg4jo7gp8VduF4h92p+CfSJtrCbJeFO+5Ukubj1dh1IlMEbu1ng2c6uyiCJh52JKRnXXEgTXIXQ",
+	"coWHwGevXKJKEYpLn1YQtrXdzTvd1aojaAbWIYxLOOnCgCnlGVlkMBFlXXIvinO56+eeMi7siQb9ES5h",
+	"90q1GdOOSTbVzX1kxg4qUWoiXSKxpsfWj9HffO/yGKLBfQohirAOZPEs0kXoM36Qnch7D4c4RxSd3Dxj",
+	"iOA6gwjqMIaCWywUx7sT6eeWJ2QB0oorWEAl1mKZy5X916EBMMCKVOnTg3oX+TigQZugsIYt3cXqn/ca",
+	"deyMk+9TrQyvXOrjrEcRvYc2wLVdArd79fwyzRoToMP+7BpPltPwzXEJcIP7LSxp7CRcQ+kVRa6Nd60/",
+	"GXeOdIBDeUt4Qvf2pXAy+tb1qMukBQ23csRufNZ6v9GUzl5t4vctUF5hdY37glAonxLXZV5K7pfG8DWM",
+	"vF1S693EpDUdix8NckgiycogFKbXETUGkkAWZNd4gWvOnmHAL3iI6ZnZ8xYOMzmLuLcZUaZ7j7BlRQJs",
+	"dKt2e891x4oq1/tAy7MW0LIVBQMYXYykx3HDTTiO5TzhspOks/eYm2lf/sjzxNE1yVwcs0OG27DPQQfv",
+	"fp9FMqSODPki00f/hNyP85ljANntUJJE0xIqWLuFu8aBUNqsZu0GIRw/rFbEWxY5n9lEQZ0IAH4OwJfL",
+	"Q8acbYRNHiFHxgnY5OlBA7PvVXo25foYIKXPysbD2HRFJH9DPurURZGgMKpqvFzFiL2xCBzA54tpJYue",
+	"uz8Nw4ScM2RzV7wCacNbvB1kkMaQHhS9pIXe1+jB2ENjj2nKXflHrYl63Go1qTQbgM6L2nsgXqqbhUsj",
+	"kH2LLG+WSO/ZwBrslT2YLmHkR4Yt1Q0559HV4gI5DsAyDkcAowWAMgHi2qnfmJzlgNk37X45N0eFhn0c",
+	"pc6WXMYEvSlTj8iWY+TycZID8lYA9NRQbUEVr5Y4qD7oiifDy7y91eZtbuMQs5g7/mNHKLtLI/gb6se6",
+	"WRv/0mbnHM8A6Bt9mHSVQ83SXdKIus4EiDkqi2ifHDpA7MHqy74cmEVrp1UPrwnWcqyECZkxSg7RZqAC",
+	"egQvOqLp4hJ2+bc80D1+EbolyjraPS53DxKPSQ1rYSy0RqPgF/RbqOM55ThXajW+OlvrFa7vR6Xi5U8d",
+	"nTK+s8wPvgIKD1kJjXEIaHHLLgEbfW1IifQ1Ns1LoJ3NZq4iiCjzHJemxYjCUlRNnl79vN8+x2m/jxeN",
+	"aZZ0iwnpHLSWVMEm64a+Z2oXeLF3wS/cgl/we1vvtNOATXFijeTSneN3ci56DGwfO8gQYI44hrs2itI9",
+	"DDLJhjDkjok0mvi0nOyzNgwOUxnGPuilFnIyjN38bqTsWpJcnfnwVbVeYxifS8EV7GEyyfRYKblOSq3V",
+	"9b7ElieY39/49JB7Mkv6uAMYizpIxP2FQIttHvqkmYO8DfukrJg0CZrpKZdOXi2k1gdiGqhFoqv7wLbQ",
+	"fsRD1gn6Vc+Y3Xonu12K20kbUAEv/ZvEQFjf/mM53BCPuvmY+3QnPfH+I0QDEk0Jm1QfGubIGGHAvK5F",
+	"edMzPLlRR5Vg/Cjt8oi0RazFD3YAA10n6CzBdfLde1drr2A/pTfvKb7KnO+1dyxG+uaFzw5RNposGB3P",
+	"5mFxhfhWm7j2b3++sErzNXgr1MKBdKchaDnHoCEpXWCYFc6dpBSrFaTWF3Mby0EHuIGOvZxAuhkiy5to",
+	"GiHtZ09zZHSAeloYD6MsTzEZWhizyb8aWrl821SVFK+EZGtuYarK5pL4FnaLn1HpwGoutGndc73ZqXv5",
+	"HrHrV9tvYUcjH/R6RcAO7Appnn4EosGcpj9+MkmW+Y9MijH3vOxs4RE7dZbfpXvaGl85ZZz421smXVFv",
+	"KXc5GK2TBMIyZTcu8r4JeHqgi/g+KR/aBFEelkESeT+dSphQZ3Z4FcVEKYdoF7M9BuKl5czezWd38wTI",
+	"3WZ+xAO4fhkv0CyeydPUWYY7jj1HopzX6L/Fq4X3lxi7/LW68pc/NQ/uFR/4JZOn7Fdfnb146cFHk3QF",
+	"XC+iJmB0VdSu/t2sytVa2X+VuJT8XtHpNEXJ5se06amPxTWl3+8pmwaVi1r/mXa84HOxyju8H+R93tXH",
+	"LXGPyw/U0eOntXlS556TD7/iogrGxgDtiHM6LW5a+assV0gHuLOzUOLztbhXdjM43fnT0VLXAZ5Ec/1A",
+	"eVPzLw7ps6oSK/LOP/zepaevle4wfx+ZmHUeen9iFQrZDo8jvtqhyGxfmDphTvD6+/rveBofPkyP2sOH",
+	"c/b3yn9IAKTfl/53el88fDgE2t12eSZBWirJt/AgRlmMbsSHfYBLuJ52QZ9dbaNkqcbJMFKo8wIK6L72",
+	"2LvWwuOz9L+gORZ/OpnySE833aE7BWbKCboYi0SMTqZbV9fWMCX7PtUUBIukRcze101xxtjhEZLNlgyY",
+	"C1OJIu/aIZcG2at0zpTYmFHjEW0tjtiIEd9c2YhkLGw2JaFvD8hkjiwyTTancIu7pfLHu5Hinw0wUYK0",
+	"+EnTvda76sLjgEYdCKR5vZgfmPokw99FD7LH3hR0QfuUIHvtd8+jTSksNFeZ60gP8HTGAePe473t6cNT",
+	"s4tm23RdMKe9Y4JBL6s+8BbEwOi8sW5kjrYIKPVz2X6EWay0+hXyhhCyH2Uyf/iJ6DlCvXOee32WEo3K",
+	"YT3p7Ie2e/rbeGzj7/wWDouOpQFvc5nmT/VxG3mbR6/J5xKfz9IjmYfLfWTd0IAR1kLHK3GGpVpFwfuI",
+	"S3eeXBaIToRZ/lQmLcypG789lR7m/q4WFb9e8uIy/xZCmJLt7fhJWcVC57ABJuY4cLOzxIM7thUuzWEN",
+	"urVBDFMm3/Jd46ad/KJpHzDYsfN0mTs3hcqozDCNvObSQnBjcPzK9zbgTPDY61ppSlJq8i5dJRRim1XH",
+	"vn79S1kM3XdKsRauin1jICmT7gdiLhMqUZEvNR8zd3jUnK/Yo3l7JsNulOJKGHRkphaPXYslN3RdRnN4",
+	"7ILLA2k3hpo/mdB808hSQ2k3xiHWKBbfniTkRcfEJdhrAMkeUbvHn7OPySXTiCt4gFj0QtDs2ePPyaHG",
+	"/fEod8uWsOJNZfex7JJ4dnDWztMx+aS6MZBJ+lHz3tcrDfArjN8Oe06T6zrlLFFLf6EcPktbLvka8vEZ",
+	"2wMwub60m2TO7+FFUqMSjNVqx4TNzw+WI38aiflG9ufAYIXaboXdesc9o7ZIT20NdDdpGO6Ezobj6RGu",
+	"8JH8X+vg/tfTdX3gZwzf5umBk5fy92SjTdE6Z9xlpq1E65keiuqy85D4mqrcxeJ2Djc4Fy6dZEncQiqo",
+	"JKQl/UdjV4s/4bNY88JSjrwRcBfLz55mqsV1CyrJ4wD/4HjXYEBf5VGvR8g+yCy+L0bBy8VWIKt/0OZY",
+	"SE7lqKNudlo75he6f+ipki+Oshglt6ZDbjzh1HciPLlnwDuSYlzPUfR49Mo+OGU2Ok8evMEd+unHF17K",
+	"2Cqdq2bRHncvcWiwWsAVlKObhGPecS90NWkX7gL9b+v/FETORCwLZzn7EEgsmvuC5VGK//m7Ni0/GVZd",
+	"JGJPB6h0Rtvp9XYf2NvwOK1b337rHMbo2wjmJqONRhliZcT7nn5u+/wW/kJ9kNyedxSOj//ONL7BSY5/",
+	"+JCARr2ja/r3J93Pjr0/fJjPjp1VueGvLRbu8iKmvrk9xOqpz96OlBaNDkU+P8Jw/0YvKfyATHDph5qz",
+	"bhnHDy9F3E98V97bNH8K0LkUvwQ80B99RPzGzJI2sI1SGD/s3TK2WZIp4/fEz52zL9TNVMLp3UGBeP4F",
+	"UDSCkonqOVrJoExv1lx/0F8koVEcdQnoXmo6FatSff7vB8+4+PkebDeiKn9uc7v1LhLNZbHJegkvsePf",
+	"nIzeuYIdq8xhDS2OEqrscO5t+7fwBs680v+hps6zFXJi236ZaLfc3uJawLtgBqDChIheYSucIMVqN21W",
+	"TMtQrVXJaJ624krLHIf11nN1bock6IbdNtb7rVIsuE84tBIV/m/EbkwtF5rbkQRamuIYV+2IcAVoqaIH",
+	"mxsdNONiSxez4VgGi07mFaB/IHZVEnrdKYUajZyUU2Gmxk/UkhJWKGYbLbHqZLIMkFZoqHZzVnNj3CCP",
+	"cFlwQ3PPnj1+9Cir9iLsTFipw2JY5g/tUh6fUhP3xVcAc3UqjgL2MKzvWoo6ZmOHhOMLnv6zAWNzPJU+",
+	"uMhV7Ey3tit2GgsUn7BvKPMREnEntz9CE5MIdxNqNnWleDmn5MbomcPcrK6PBkIUFVtdI/w98s+aV6Yn",
+	"GA2ZnUYy50wfZ38qD1y1sYtYGzWXmxBbtNVbRc/nhvR4KXZO2HOnQjVBQecmYZQiW2+hTEqxukc8EQf+",
+	"x1pebLCB6khA47xyepXgwM5ay00SfXgVPhLDRrh9oWBXJ3jOFCqQrwWmK95wC1fQTYcYwAi68ZAesbs8",
+	"3UjpKOXkCGE0FuI6Fu0BOBo3OhVkIesh/kjNlCuafmzR5AvqlY/F6FVg7ln9Q3K9kGKbfeeNCwWXSoqC",
+	"aj/kJGlK3TbNTDmhTEbevmhm/oRmDle27nOMBfZYHK0EPZ91EDc0+SdfcVMddbg/Ldz4eoBrsMZzNijn",
+	"oRy9N4gJacCXWkMiSvmk0hmnpmwgRHSgOJKMKCvTiIbza/z2vdd/4xFkl0KSpsujzb/PnMkK81ggtUsm",
+	"LFsrMH493Wge8wv2OaEsjSXcvDl5odaiuBBrGsO50eGync/ocKiz4EHqPTax7ZfY1ufOjz933MHcpGd1",
+	"7SfNRrTGHc5VJx9FcM5vKTiSJMiN46ej7SG3va7fdJ8ioWFRBWYs1HQPDwgjFnrvjoIlFRpHUdSCuYjK",
+	"HFIqITNgvBAymFDzF0SRvRJoY+i8jvQzhea22HTY0CGH0ZEACIpQLi7vY6jeBhNKaI1hjvFtbGvUjzCO",
+	"2KCV+LncsXAokLoTYQLDH6Mr7rDiPElVXogqKbioV4M+xziQcS9CyGQHXQfD92J3qsZx7E00lqNw2ZRr",
+	"sJj/Lpfa6gv6yuhrCBLDiiBNLCkWowO7OcqH1OYnKpQ0zXbPXKHBHacrheHGwHZZZdxGn8ePUMYdRkpD",
+	"ywr+mys5Nb4z3mn66Kjc4CFdHpeYfxhlnJN6kaYXmH9pOiboTrk7Otqpb0fobf97pfQQrvsvEY3b43Lp",
+	"HuX421d4caSJewf+6e5qiXl1yRdc0feQ8ChmhOxyJfw2LKxGXg+0eZkt6wEfGmYBv+LVSCR8aitx96uz",
+	"H4zFwxej6Ru49em5LGd7WdBoyiPnK9yzvgxNiGP+wc49+P6sFn6texE6brv7tmOpcz5iLbMYtdDdzojW",
+	"bvCxVrRvr8ZSJIQ6HfQ9rQfivXict1at4Uqoxm9Y9IEOT0L3q0/B06n7MbL+bGTBb221GLWxvPLFld0y",
+	"/Zv825+dFZaqye3+BSwug03vF5XJSLvUIiFY/wQeaM1GHrWdW3FKDZtcuRQvGwZdmWMtHVoalJ8ZkNXz",
+	"KeLAAB/v5rPz8qgLM1dyZ+ZGyR27F2K9sZSx/y/AS9AvD1QkaKsQ0BGrlRFtPdkKB/MpYDc03MnUYAMk",
+	"YJFWVBiOFZxQr6CwVBO5da7TAMfUV8DJgtHnj8oE48/pGJPhCxLsq0IwrBx84I4fJE5Kkn+5wqQn03Pu",
+	"n0UXahcBhoXyYrqWXsz05MjN1QoKyoq8N1HVXzcgkyRI86CXIVhWSd4qEeOYKK/38VrHFqCK3xKeit8f",
+	"OGNx7Jew+8iwDjVkC4fGIL7bJA4mDDgTWMghPaZI9l5jwkTKICwEl2DXHdriGKM5n5O0a7ecK5Ak42kq",
+	"tj1T5ivyT5oLux6V9pFCcsZyWY1US88dcaUp+bHzQE8jaDI1xxHybk4zuXaK2TCAsE5dz7Qwl2TPJK5n",
+	"GkrFn035tDQwmo2kTVvrmvnJRFXhSYijRjaEWmRPIdol8sfZxYi3vBtzQYEMeQCSA5iiBk/iWsViGm5G",
+	"nElpFnOf499LWJEfYrIEWoE/yi1aMKUvI5/VVT9Zu1RkO3C9b3HweWEbXsVzN5IQLMkSi+eqm6M65j9X",
+	"wbBEiEGrqsOOuQ1cH/R2uKmpPPud0CAMC+PgkaXkv4dRM/c1bYQ1zGy4jgJ/m7z+Em6Bv0hoC4n3fLn/",
+	"CK04WlE6j6xiw6sK5BrcqaIImDhoIF1LwhdZMnABa80LYDVoocr8oWrB8glmc2/Edho6EqF6WwA2kSoQ",
+	"6a4mYD9xYjLn3e+2KTUVehwpNCToY38fYITM0CHQlxOhVu4MAwiz3VM94QPfkweKNYywwNiLTHOUwl5p",
+	"5/qNhCXkLSh6QqmGEWhCp7Dc+wXpPVzr81kr843SbhADlYQ+H2p7BzaCl1GeqNwtY4SSC0+Mh2/brfIa",
+	"Gz+6/2ZCDT+6YY0j7imknUs51MoyCS6yRzG7hnB/zzKsMMeGspdA5nrMylOQkxDOWA2gO6yqUFKGC+KI",
+	"B1E3rhTcuML4ADiUb1zNmXqjJCyVukwzinDJVGPXCgWOa1ga9OuyNITTtWnYKgtso0wI7BWyUFtsrnyB",
+	"lTini2rm7OWTl/RDllmSqnURilYcvEixdbjzYsLR0Nu9d8P8tzihDphxGfIQICZJ1H9LEOKmL8aYqBVb",
+	"COV6pc9fjbwCDAbBCLNxtT9YdNHDQ0AdoFbFJs9BVkDFNkbIKXxlXErVyKKVksMqpxt1PLLQ1sVH1K0a",
+	"Ko6HNnrx1aCDCYlRvz6pOgIVMvVNJL69BW6apCxOgrMaNOEfsbNVUrjQMGJZysWgskZaUcUx8pjbmvVC",
+	"rRb4iwZjO6aufRQU1oPCkO/7HiiafLkmw0St3wcUfp/Ga0u1fE8KK3wYfLpd+esI4VmMSWyVWNZP6iN4",
+	"Ua2F0sLu9oMZ+ClnoX1/xBRAfxVM3oLQgdV8h35L72E30BFmKjjdMrT3DcmoI6N3VO7UII/V8nI00csv",
+	"P3V5fK3B1cTCbve+wlGFSzwQPYbfoiRhyQlh9jZvD/fJkV4fOQP20LkBB3dzXpghgSixpYwbp5+D5aIy",
+	"PnqSx6pUqQsHeqP1qypf+6pWlHM+OtaG+lZgwm+hwISbpRKXvrgkydbOjRlrkoQW95IxnJoxkQd6FWcW",
+	"bXaPYQTM8E5xiXKKihRBi7FsQ72TGqJRPzIubLjN7kxwrUBrKKO/bKUMLKwKst8+OPahwpCe6VZIMKO1",
+	"sR1wo3XRfmwLv21FoRWnOmjch0SnC0RxlSN0OinPNj7nPmR/6b6HDI1By3DQ/SjS6+Jg/F3I6yLMAIkp",
+	"1a+YN6Uczvx4G08kISXoRXBL7tdqk910/VSUpWy8QJgejOitNTmx8h5WknXiKYar7BmQkwyKl7A7dRZy",
+	"n0sx7mAKtFMwO9CTajS9Tb5X3yyTg3t9L+D9tkUGaqWqxYgn7PmwwFyf4i8Fabrwpgj5D1A+/Kh7NnAS",
+	"9jE5YMZQh+vNLhRUq2uQUD44YexMuowzIeohLXE3mFx+ZPfNf0Ozlo2r+eg9rk5ey3zqDro89R25WRhm",
+	"Pw8zIMs7T+UG2T+RvZFj8VjXVLkRyhSnJ1NdNoZxCD0JKiEqB0VWJgmKja/Q+SWf7b6b6C2qQm6nZrmN",
+	"2oNLCeWCHph7nvn0PalIEuAWhrkRjn/p+6AYM+ZJ5L52ZmsjbI976tegjTAWpF1oVY1J4/QpKZkqlY1l",
+	"rkn8fv79xXHzaghlWkdmjN+ZKVRrRElkoUiTpWqc1tBP4t3vaRard/sMfod2EBea6viO38uDWO0u7Blp",
+	"VnZIrVwXG6wseQxiR18zDozMfifU1tmV3Km9cEEIX9L1nNOPUlbbJP0yxaZw5oMXmKlULj3DbTLv4lB5",
+	"rKaTEUAW5JQEsBEKP3gWAT4w80CVF/851DFRK6ahjQu6bUEXXyPFCVRmzEmrP3OcpSulkK0qmZHijl3x",
+	"pnCXEkmRFkUvhdVc725TdqWLqhzBjmL5YIRtDK5tF9IG2A5xWFXqekEixiKWLs7Zo7Gd6YrQwfTf9mNW",
+	"kUk/hupy459XO7bhJSuU1lCkPUacEgiqrdKwwCJdWQ3cC7Gy+FreCmsYVcZdM1UXqgRXAjxPQWNzNVJy",
+	"euxAEiiZRYGjHVyp75PQ8cQpURJ2oQELeiAdrJgZNv8V9nHJSNtE/W7RCxeeMpKEAoxPzO8x5BoP4SXC",
+	"cZms++6hI/p3cUN0Azp35FEP18Cc+RY0eoeE6ODj5bkVxjhQIi0FD5eVuGn5AcRYtDxqRx6r56SgvBIU",
+	"TtnNC0s98GlaQEyWm/KAizSTPbMbrZr1JqkZGOEM5k7deGNoOspPpqGIV0oKhlM8ZVtlrNcPuZHaJbdR",
+	"xB8XSlqtqqrrZ+ge1mvvPP0dvzkrCvtCqUvM7/qAtFFS2bjSch5SZvbjvduZdK9aRFdsXhANmMPV11w7",
+	"nCVwgckMssfiBn7Ohy72BMw3hznoYTfqs+HC+uvqMtO88gHFdqu2osifqd9XAPVo2HOOReVQ4Xq4g++I",
+	"mA57elnFeDlikUM0g+RZ35Qz5hmBjxsidoP/pXdzf9zWODhyUQ6Zi5eiFsWorNcDgCB12SxtoykCpCOJ",
+	"Ra6i1s5OQ1FPfUAn3ioUXHo32HCEewfKwp2AGgS0RwA/dirDuSsX4nyOMCGS//6grSdyK+Df7afyDvMY",
+	"i9q9aElLU5OYe3yEI+SrFu4NcX1FmUyXUwNdTQhKmHjDJwCMh752YJgUAHssGM53L+tccB41y/NEP+af",
+	"rsnowt/LNAsruLuw0U2Ai6rR4HNhOxFfd0Maam434erE5kP7D9oSwJAw8ytoRaFL5TxxqYfKWex6KjxV",
+	"Lyq4gk5EsKNl05CoiQ4Fvq+JnVkJUFOASV+znXsZp3d5T+fg175IgiWnYDer/3SIdTvFDig3s6rYG7lw",
+	"x8RMPUoI0ZUoG97BnzlW5Ogq7/EoZ1A1eCMswjty6jQ/uRF+DAOchf45USZg4s00PnQ0C8qjbh8DOhj6",
+	"3pixUy/zke9p9vloFqXZyhhb40i85Rum5tdy3IwwJPn2uTVxn4SSCWK/uoGCpBr/3oHSv3j2exAStTtX",
+	"PCc1rmXGRrYByaRqnz1kQwhPlbYsTvjBTUyNhPSv6Vt4grcB6nffWUaDMdOrjzH6kNCRTm9vVPtNTuLe",
+	"gzg6Xo5GDPiMbnv0X4G6/bODGqimKpnE/UTZn1zv/S3muficLZswEGorKElQ5x36HIL3gpKp4datKBSW",
+	"IF2zQ7e7wYaqDpGkINk63Sz+I5Vl/2x4JVY74jMO/NCNfP+xTIxzl3BBXj6wHyfeL14FT86obVFhKrdu",
+	"MXXMZLgdjpIAjRd5qNeu2JZfQroN5H7v+Cd5zDLTLElzgVd2bzuHWPCLD1m3t7xMX/pU+2fX4Q6hGhz2",
+	"/n/a9GbpVKFkR13xwu12rDrf5TNkpQjEZTew3Z//bsjXAgkkXtCBaHVImFreQmV6JOvKJZUZq6jdATt5",
+	"RnQLat/PMiZqfntlk/dkDpy0lPvehcmueH2gyeEm1E05AL6rd+XbfhD8Z8tyjS1jCvj/KniP9ezH4aUm",
+	"HwLLnaTKGVidtnqpbhYaVgc9HKk1At8CbKKKVchCAzfOznj+g394tlWnBFnKXZh/9ESIo5SwErJllkLW",
+	"jc28Y6j4lNwlCEuV/oTWERPamJSAwuQVr364Aq1FObZxeDrUKq2RhZAEQ4fvm1FhxDt1OIAw7RuOUu61",
+	"avS0GV7gpVitQDsDtrFcllyXaXMhWQHacoEeJztze4tSNA4csinxRJrpJoJNrEtE2g6QauddOe5o74kA",
+	"8ns0/Eww2LzagKf+rrHGqXasGrHPDGH4XRhstvwGbXyUGG7kQPhyY2Tho2YUDFZw6eSzaesO8xjxK+yf",
+	"hiqtekZkFc06ZYr95/4H2kp6Rv4khd178p2Osp+pz6VScAczIBXVoyGfiyOW4Xmsi/xkdTfBYvRS94Gx",
+	"gfYg2cSxUJKuXnxkF8kNwmfmTJXgZrp9qONpkblhvGZgQRoDsydjS+thQrg2XpU0cBLtqxocUuY+AeaR",
+	"mjannw/30gh4iGgw/qx3p42ObjjOdNkn8Q/JQ1SrelFM8dR2xZhLB0CAtAvjCH0kRoCRdUf3GBPLk6fU",
+	"2K1TTuOZ24jfvTrph6xddbHv0T+mJhrh6F0ThFoRL6Mj7JRjSqfKlHk/bVhXDRaZBONMQ9FoUhNf813W",
+	"TaZTa36kCODFX84+ffzkb08+/YxhAyx0CaYtJOkHiWwjevMK2df7fFj/3cHybH4TQkJZ+hztjyFPVtwU",
+	"f9YctzVtlajO6o81aWcugMxxpBzGbcqWW+8VjdNma/nX2q7cIu99x3IoeP97hm4a+UK+Ua7KGFByu5WY",
+	"UPAF0von9iygwrZxDGZD6kEq53blEoSr4IrZUoGwIy5XuYWMucETP8NPMRgUburK8ypn6dm3Lv9Ocxo6",
+	"EhrJKwa1WKr2or1YsRxElA1AJ8kSveKTNOKJZ3tkts7HPZ/tguJF8qSHPhv0ElYrtp/bt4bCwKgznB43",
+	"MSNehEN5C9Ics0+Mp6K9DSdpVfv/Mvwjk1v33rhGXO774BXZ98GeNJJnA7+HmFd2EmjDPKsZ8iAARhIo",
+	"dlLfJbm/ktpy2lkJyJ4QDMh98eO71rB8MJiLIAkdDoCXZkRs28X4Iw/Ob1yk7buIlGQpb8YoobP8Q0kW",
+	"A+uNF0myRV5pYi0Yx5YyeTiSDJrmy5iYcuRVMshfqZWyTEnUjWTyXjo9Dp2plHAo2tc7739YrvG10Mae",
+	"ET6g/HE8oDFNfpgi2aHS3K70ygs+ae6Kv4ep5UvKtflXwD3K3nN+KG+EH9xmpNzhlXOvXkVrNEh2TWPS",
+	"TrPHn7Glr59cayiE6Rv3r4NwEpMCgUbrWMxYtz+54KF1/qzsHch4FTxx2PeJeSva7D2E7RH9jZnKyMnN",
+	"UnmO+gZkkcFfjkdhzYtpBXfvWmv3dpm8k5ocR2byTldGNVMmL4/WQZdOY2C4zsm3dQe3mYu6XdvUNPST",
+	"S/ZiVfTllOzx+fK62J3S199Lnd2jquy+h8T1Dkd+DD9vjmJ+kngI+ylGzeHoHLQeYFfvVdjLLBr1kvlk",
+	"wj6SKavC/koWelfbsXGjL2zNjak3miO5euNJiF4ENwSUbOesaZ3WbRpO9/xM3pc0A9eQjEDzjSUdbUcd",
+	"0U23s44sZzjZCfurc6aiHKLzABfbcgta8MpljONJahTvkxK90EnhOthSj9d82pacUP/zWHIYV8ltpBJn",
+	"76hi0c6DBte0ripmUAAJRhiqHPo3Xyn+w4pZAQKX2WjIxR2sd0kO7xCTWWtn8mSqpGLqhGKpvlumwiWF",
+	"qReNFnZ3gfgPulXxt2z1hW9irlafCT6aWb1YZNUlyOAK1GZ2bUwQvL5RvCJRxVl/JTCrVHXCvnL1PD0P",
+	"/fNHy/+ET/70tHz0yeP/XP7p0aePCnj66eePHvHPn/LHn3/yGJ786dOnj+Dx6rPPl0/KJ0+fLJ8+efrZ",
+	"p58Xnzx9vHz62ef/+dFsPhMIsgM0FPJ9Nvv/FmfVWi3OXp4vXiGwLU54LTBZ+rt3pEZZKVw+IbUgzgdb",
+	"LqrZs/DT/xuY70mhtu3w4Vfkshqbb6ytzbPT0+vr65O0y+macrksrGqKzWmY5928h/Gzl+cxfMO5aNGO",
+	"toaFk1lLCmf07cevLl6xs5fnJ7MkpdPs0cmjk8c4vqpB8lrMns0+oZ/o9Gxo30+pmtap8YVyT9swvqxJ",
+	"90eKZgjvNo288+MYkPUf0ahvHoS4Lqx0i9IExvIgdHEV5yURl/URNvOZe4EbR45PHj0Ke+GF4EQWOcXB",
+	"8DfHP3Jlcd7NM8zYA5yFjDrQOoaL/kleSnUtGZX+cQeo2W653rkVdLCRDE7bxNeG7C9aXHELszfYu4/z",
+	"uvblicdQrgVcQfeUh85EILG+LZeh7K0vMmxyKB+WRr4j9veWghpMltkdavQSYQ5ZUgM8wVbocUbuBA5h",
+	"8Yy4y2uA6PmsbmxOukAnRbMPZ/Ok5K6DRlVlxPgAoy+b/0swiqTr76bZs7f41yZkosc/tkioRfiEEsrO",
+	"/99c8/Ua9IlfJ/509eQ0PFBP3/pEBu/2fTtNEIY/t38tRHmgZ3CGO9Tk9K3Pt3VgwFT3fdomxg4dJgK6",
+	"r9npUt0c0RTS1Y0vhWjenL4l3czo76dewZ7/SDoyd8OehnIMIy1dbqX8xw4K39obXMj+4bBNMl7BbbFp",
+	"6tO39B8i22RFro7fqb2Rp+RTdPpWlMPPA0R0f2+7py2utqqEAJxarQzYA59P37p/3w3buapGp8EqNIQI",
+	"sxxrsQVpedX+6rsZyWuzUXb4waIM1mzr4Zemrqvd8OedLLI/DiHqvGEOCApUl8gEJ77e0yd3NQ0eLXdl",
+	"pNMSn/VmzQjvQylt38rezWdP75Hjd2sOZoD5gpcsvNhp7scfbu5z6YIJUGx14jVB8PTDQdDZPvYt7Kj+",
+	"xtdItwjLpx9yJ86lBS15FYTFW4qV045P/4qez5Jmcu2EIOW0ON2jdlaWA6J371Mw9gtV7vZgbGvWtXcO",
+	"aJHWPs+FxCXMp4nkg2UxlxEzCClSlTBLH85oM393R57Qcyvk2p5nLBlkkqP4olVGd5OtPNF3unIjD7Vu",
+	"h0j4/HmYtA3L+YOn/MFTIk/59NEnH276C9BXogD2Cra10lyLasd+kjHe69Y87qwsszWpukf/II9DrTga",
+	"z9eA8Y1Er4ulKne+tv+sM8ElOE3cQJA5DZqrzmtkhHsGnVhOWmmjEGbPfsl544QiJ82yEgVzBh1SW6FO",
+	"JtEqJfU0UuY336P1mGfqz7JSVE1MImKvlQ/Sz6iiP05T65h/arp46CAKu2PXQpbq+sFJAPefDehdC2+Y",
+	"ZpYBMHEt70P4dWKnRgAHYI3NRwbuKdjZM/kLfru5K37s1G/et34rplf974sfvk+CYJ0Ww/mhUQimI11c",
+	"YK0VxYGgx5mxXKP/FvvS6ZeqHQVzW24bF4YaTvvJH/fQH7z/7rz/m1iMUZZMSGPR/SbDkpK74GSSwJvl",
+	"7VFNlX+rfh1SxQ3vIg+aKzsVIJn7U9Wrk5jW/RQ2ZMynugYu8i1WJepXWTSdak2j5RZzpZn2v6B9pcjf",
+	"4g3tp57wksZ3gNuhvKj9x4v6d/6KtVM2+NaH23khpMJaH8B9Xgqt6R3PHRjrMpnsMZ4zF5g6bx0RXEXI",
+	"xNTvPTcTe3xqv3f+CJT8sFTgshC3kIWExcgVRtwF3IpD2HP8uev44EZpkza5Tg5ongJLQeCtP0ZMpT3s",
+	"tm9NA1Y04lcyWctwHEUf8GIZ1T60WzZGmCezEXVDd7gfvv2DRf0eWdRe7nByH+9PHWkwx73edv705pqZ",
+	"C1DMFfDB3xmPLKcvryx37Pz54Cy6bv3T8cXu/PnwwZp5ivZBPOpN+mbK6Xk5WMha2Rim6Rb1h/7rD/3X",
+	"nY765MMzRa2efUh8QwPzgao4Phg6ofBUhIuCHgagTDGN/abH9142fmh2y5nZXLEwzMPQfsiVs/+DRfzB",
+	"Iu7KIr4Bm1UCrJRnGhmiO84MN5VhUHLRshN0FBQioXlTcZ2kyDlkXT+jEfNa6vfCNT60LTGLq7KMkbTC",
+	"hZBlNvB+zYt/sLw/WN7vh+WdHWY0XcHkzg+iS9hted2+h8CluEn/PI31wNoPZtPYUl0nDoQEM4GcceVy",
+	"tov+36fXXFiMnVmQh5YrHjXsbIFXtCmigt6vpTDcGNguh1/0TjcJeJ00ztlfT3nX76rzjVj0WMeB02Hu",
+	"q/erG2kUso+Fz21IQxoiQNdDDA745Q2ydgP6Ktwcrcf7s9NTSke5Ucaezt7N02+m9/FNJKO38b7x5PSO",
+	"6EdpsRYSyyE519FF69X+5OTR7N3/GQBsluN8xkEBAA==",
 }
 
 // GetSwagger returns the content of the embedded swagger specification file
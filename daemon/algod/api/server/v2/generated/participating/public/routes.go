@@ -177,238 +177,260 @@ func RegisterHandlersWithBaseURL(router EchoRouter, si ServerInterface, baseURL
 // Base64 encoded, gzipped, json marshaled Swagger object
 var swaggerSpec = []string{
 
-	"H4sIAAAAAAAC/+x9/XPctpLgv4Kb3Srb2qEkfyT74qvUnmInedrYsctSsvvW8iUYsmcGTyTAB4DzEZ//",
-	"9ys0ABIkwRmOpNjxbn6yNSSBRqPR6O9+P0lFUQoOXKvJ0/eTkkpagAaJf9E0FRXXCcvMXxmoVLJSM8En",
-	"T/0zorRkfDGZTpj5taR6OZlOOC2gecd8P51I+EfFJGSTp1pWMJ2odAkFNQPrbWnerkfaJAuRuCHO7BDn",
-	"zycfdjygWSZBqT6Ur3i+JYyneZUB0ZJyRVPzSJE100uil0wR9zFhnAgORMyJXrZeJnMGeaaO/SL/UYHc",
-	"Bqt0kw8v6UMDYiJFDn04n4lixjh4qKAGqt4QogXJYI4vLakmZgYDq39RC6KAynRJ5kLuAdUCEcILvCom",
-	"T99OFPAMJO5WCmyF/51LgN8g0VQuQE/eTWOLm2uQiWZFZGnnDvsSVJVrRfBdXOOCrYAT89UxeVkpTWZA",
-	"KCdvvntGHj9+/JVZSEG1hswR2eCqmtnDNdnPJ08nGdXgH/dpjeYLISnPkvr9N989w/kv3ALHvkWVgvhh",
-	"OTNPyPnzoQX4DyMkxLiGBe5Di/rNF5FD0fw8g7mQMHJP7Mt3uinh/J90V1Kq02UpGNeRfSH4lNjHUR4W",
-	"fL6Lh9UAtN4vDaakGfTtafLVu/cPpw9PP/zT27Pkv9yfXzz+MHL5z+px92Ag+mJaSQk83SYLCRRPy5Ly",
-	"Pj7eOHpQS1HlGVnSFW4+LZDVu2+J+dayzhXNK0MnLJXiLF8IRagjowzmtMo18ROTiueGTZnRHLUTpkgp",
-	"xYplkE0N910vWbokKVV2CHyPrFmeGxqsFGRDtBZf3Y7D9CFEiYHrRvjABf1xkdGsaw8mYIPcIElzoSDR",
-	"Ys/15G8cyjMSXijNXaUOu6zI5RIITm4e2MsWcccNTef5lmjc14xQRSjxV9OUsDnZioqscXNydo3fu9UY",
-	"rBXEIA03p3WPmsM7hL4eMiLImwmRA+WIPH/u+ijjc7aoJCiyXoJeujtPgioFV0DE7O+QarPt/37x6kci",
-	"JHkJStEFvKbpNQGeigyyY3I+J1zogDQcLSEOzZdD63BwxS75vythaKJQi5Km1/EbPWcFi6zqJd2woioI",
-	"r4oZSLOl/grRgkjQleRDANkR95BiQTf9SS9lxVPc/2balixnqI2pMqdbRFhBN1+fTh04itA8JyXwjPEF",
-	"0Rs+KMeZufeDl0hR8WyEmKPNngYXqyohZXMGGalH2QGJm2YfPIwfBk8jfAXg+EEGwaln2QMOh02EZszp",
-	"Nk9ISRcQkMwx+ckxN3yqxTXwmtDJbIuPSgkrJipVfzQAI069WwLnQkNSSpizCI1dOHQYBmPfcRy4cDJQ",
-	"KrimjENmmDMCLTRYZjUIUzDhbn2nf4vPqIIvnwzd8c3Tkbs/F91d37njo3YbX0rskYxcneapO7Bxyar1",
-	"/Qj9MJxbsUVif+5tJFtcmttmznK8if5u9s+joVLIBFqI8HeTYgtOdSXh6RU/Mn+RhFxoyjMqM/NLYX96",
-	"WeWaXbCF+Sm3P70QC5ZesMUAMmtYowoXflbYf8x4cXasN1G94oUQ11UZLihtKa6zLTl/PrTJdsxDCfOs",
-	"1nZDxeNy45WRQ7/Qm3ojB4AcxF1JzYvXsJVgoKXpHP/ZzJGe6Fz+Zv4py9x8rct5DLWGjt2VjOYDZ1Y4",
-	"K8ucpdQg8Y17bJ4aJgBWkaDNGyd4oT59H4BYSlGC1MwOSssyyUVK80RpqnGkf5Ywnzyd/NNJY385sZ+r",
-	"k2DyF+arC/zIiKxWDEpoWR4wxmsj+qgdzMIwaHyEbMKyPRSaGLebaEiJGRacw4pyfdyoLC1+UB/gt26m",
-	"Bt9W2rH47qhggwgn9sUZKCsB2xfvKRKgniBaCaIVBdJFLmb1D/fPyrLBID4/K0uLD5QegaFgBhumtHqA",
-	"y6fNSQrnOX9+TL4Px0ZRXPB8ay4HK2qYu2Hubi13i9W2JbeGZsR7iuB2CnlstsajwYj5d0FxqFYsRW6k",
-	"nr20Yl7+q3s3JDPz+6iPPw8SC3E7TFyoaDnMWR0HfwmUm/sdyukTjjP3HJOz7rc3Ixszyg6CUecNFu+a",
-	"ePAXpqFQeykhgCigJrc9VEq6nTghMUFhr08mPymwFFLSBeMI7dSoT5wU9Nruh0C8G0IAVetFlpasBFmb",
-	"UJ3M6VB/3LOzfAbUGttYL4kaSTVnSqNejS+TJeQoOFPuCToklRtRxogN37GIGua1pKWlZffEil2Moz5v",
-	"X7Kw3vLiHXknRmEO2H2w0QjVjdnyXtYZhQS5RgeGb3KRXv+VquUdnPCZH6tP+zgNWQLNQJIlVcvIwenQ",
-	"djPaGPo2LyLNklkw1XG9xBdioe5gibk4hHWV5TOa52bqPsvqrBYHHnWQ85yYlwkUDA3mTnG0Fnarf5Fv",
-	"abo0YgFJaZ5PG1ORKJMcVpAbpZ1xDnJK9JLq5vDjyF6vwXOkwDA7DSRYjTMzoYlN1rYICaSgeAMVRpsp",
-	"8/Y3NQdVtICOFIQ3oqjQihAoGufP/epgBRx5Uj00gl+vEa014eDHZm73CGfmwi7OWgC1d9/V+Kv5RQto",
-	"83Zzn/JmCiEza7PW5jcmSSqkHcLe8G5y8x+gsvnYUuf9UkLihpB0BVLR3Kyus6gHNfne1encczIzqmlw",
-	"Mh0VxhUwyznwOxTvQEasNK/wPzQn5rGRYgwlNdTDUBgRgTs1sxezQZWdybyA9lZBCmvKJCVNrw+C8lkz",
-	"eZzNjDp531rrqdtCt4h6hy43LFN3tU042NBetU+ItV15dtSTRXYynWCuMQi4FCWx7KMDguUUOJpFiNjc",
-	"+bX2jdjEYPpGbHpXmtjAneyEGWc0s/9GbJ47yITcj3kcewzSzQI5LUDh7cZDxmlmafxyZzMhbyZNdC4Y",
-	"ThpvI6Fm1ECYmnaQhK9WZeLOZsRjYV/oDNQEeOwWArrDxzDWwsKFpr8DFpQZ9S6w0B7orrEgipLlcAek",
-	"v4wKcTOq4PEjcvHXsy8ePvrl0RdfGpIspVhIWpDZVoMi951Zjii9zeFBVDtC6SI++pdPvI+qPW5sHCUq",
-	"mUJBy/5Q1vdltV/7GjHv9bHWRjOuugZwFEcEc7VZtBPr1jWgPYdZtbgArY2m+1qK+Z1zw94MMejwpdel",
-	"NIKFavsJnbR0kplXTmCjJT0p8U3gmY0zMOtgyuiAxexOiGpo47Nmlow4jGaw91Acuk3NNNtwq+RWVndh",
-	"3gAphYxewaUUWqQiT4ycx0TEQPHavUHcG367yu7vFlqypoqYudF7WfFswA6hN3z8/WWHvtzwBjc7bzC7",
-	"3sjq3Lxj9qWN/EYLKUEmesMJUmfLPDKXoiCUZPghyhrfg7byFyvgQtOifDWf3421U+BAETsOK0CZmYh9",
-	"w0g/ClLBbTDfHpONG3UMerqI8V4mPQyAw8jFlqfoKruLYztszSoYR7+92vI0MG0ZGHPIFi2yvL0Jawgd",
-	"dqp7KgKOQccLfIy2+ueQa/qdkJeN+Pq9FFV55+y5O+fY5VC3GOcNyMy33gzM+CJvB5AuDOzHsTV+kgU9",
-	"q40Idg0IPVLkC7ZY6kBffC3F73AnRmeJAYoPrLEoN9/0TUY/iswwE12pOxAlm8EaDmfoNuRrdCYqTSjh",
-	"IgPc/ErFhcyBkEOMdcIQLR3KrWifYIrMwFBXSiuz2qokGIDUuy+aDxOa2hOaIGrUQPhFHTdj37LT2XC2",
-	"XALNtmQGwImYuRgHF32Bi6QYPaW9mOZE3Ai/aMFVSpGCUpAlzhS9FzT/nr069A48IeAIcD0LUYLMqbw1",
-	"sNervXBewzbBWD9F7v/ws3rwCeDVQtN8D2LxnRh6u/a0PtTjpt9FcN3JQ7KzljpLtUa8NQwiBw1DKDwI",
-	"J4P714Wot4u3R8sKJIaU/K4U7ye5HQHVoP7O9H5baKtyIILdqelGwjMbxikXXrCKDZZTpZN9bNm81LIl",
-	"mBUEnDDGiXHgAcHrBVXahkExnqFN014nOI8VwswUwwAPqiFm5J+9BtIfOzX3IFeVqtURVZWlkBqy2BrQ",
-	"Izs414+wqecS82DsWufRglQK9o08hKVgfIcspwHjH1TX/lfn0e0vDn3q5p7fRlHZAqJBxC5ALvxbAXbD",
-	"KN4BQJhqEG0Jh6kO5dShw9OJ0qIsDbfQScXr74bQdGHfPtM/Ne/2ics6Oey9nQlQ6EBx7zvI1xazNn57",
-	"SRVxcHgXO5pzbLxWH2ZzGBPFeArJLspHFc+8FR6BvYe0KheSZpBkkNNtJDjAPib28a4BcMcbdVdoSGwg",
-	"bnzTG0r2cY87hhY4nooJjwSfkNQcQaMKNATivt4zcgY4dow5OTq6Vw+Fc0W3yI+Hy7ZbHRkRb8OV0GbH",
-	"HT0gyI6jjwF4AA/10DdHBX6cNLpnd4q/gXIT1HLE4ZNsQQ0toRn/oAUM2IJdjlNwXjrsvcOBo2xzkI3t",
-	"4SNDR3bAMP2aSs1SVqKu8wNs71z1604QdZyTDDRlOWQkeGDVwDL8ntgQ0u6YN1MFR9ne+uD3jG+R5fgw",
-	"nTbw17BFnfu1zU0ITB13octGRjX3E+UEAfURz0YED1+BDU11vjWCml7ClqxBAlHVzIYw9P0pWpRJOEDU",
-	"P7NjRuedjfpGd7qLL3CoYHmxWDOrE+yG77KjGLTQ4XSBUoh8hIWsh4woBKNiR0gpzK4zl/7kE2A8JbWA",
-	"dEwbXfP19X9PtdCMKyB/ExVJKUeVq9JQyzRCoqCAAqSZwYhg9ZwuOLHBEORQgNUk8cnRUXfhR0duz5ki",
-	"c1j7nEHzYhcdR0dox3ktlG4drjuwh5rjdh65PtBxZS4+p4V0ecr+iCc38pidfN0ZvPZ2mTOllCNcs/xb",
-	"M4DOydyMWXtII+OivXDcUb6cdnxQb9247xesqHKq78JrBSuaJ2IFUrIM9nJyNzET/NsVzV/Vn2E+JKSG",
-	"RlNIUsziGzkWXJpvbOKfGYdxZg6wDfofCxCc268u7Ed7VMwmUpUVBWSMasi3pJSQgs13M5Kjqpd6TGwk",
-	"fLqkfIEKgxTVwgW32nGQ4VfKmmZkxXtDRIUqveEJGrljF4ALU/Mpj0acAmpUuq6F3Cowa1rP57Jcx9zM",
-	"wR50PQZRJ9l0MqjxGqSuGo3XIqedtzniMmjJewF+molHulIQdUb26eMr3BZzmMzm/j4m+2boGJT9iYOI",
-	"3+bhUNCvUbfz7R0IPXYgIqGUoPCKCs1Uyj4V8zBH24cKbpWGom/Jt5/+MnD83gzqi4LnjENSCA7baFkS",
-	"xuElPoweJ7wmBz5GgWXo264O0oK/A1Z7njHUeFv84m53T2jXY6W+E/KuXKJ2wNHi/QgP5F53u5vypn5S",
-	"mucR16LL4OwyADWtg3WZJFQpkTKU2c4zNXVRwdYb6dI92+h/Xeel3MHZ647b8aGFxQHQRgx5SShJc4YW",
-	"ZMGVllWqrzhFG1Ww1EgQl1fGh62Wz/wrcTNpxIrphrriFAP4astVNGBjDhEzzXcA3nipqsUClO7oOnOA",
-	"K+7eYpxUnGmcqzDHJbHnpQSJkVTH9s2Cbsnc0IQW5DeQgswq3Zb+MUFZaZbnzqFnpiFifsWpJjlQpclL",
-	"xi83OJx3+vsjy0GvhbyusRC/3RfAQTGVxIPNvrdPMa7fLX/pYvwx3N0+9kGnTcWEiVlmq0jK/73/b0/f",
-	"niX/RZPfTpOv/uXk3fsnHx4c9X589OHrr/9f+6fHH75+8G//HNspD3ssfdZBfv7cacbnz1H9CUL1u7B/",
-	"NPt/wXgSJbIwmqNDW+Q+lopwBPSgbRzTS7jiesMNIa1ozjLDW25CDt0bpncW7enoUE1rIzrGML/WA5WK",
-	"W3AZEmEyHdZ4YymqH58ZT1RHp6TLPcfzMq+43Uovfds8TB9fJubTuhiBrVP2lGCm+pL6IE/356MvvpxM",
-	"mwzz+vlkOnFP30UomWWbWB2BDDYxXTFMkrinSEm3CnSceyDs0VA6G9sRDltAMQOplqz8+JxCaTaLczif",
-	"suRsTht+zm2Avzk/6OLcOs+JmH98uLUEyKDUy1j9opaghm81uwnQCTsppVgBnxJ2DMddm09m9EUX1JcD",
-	"nfvAVCnEGG2oPgeW0DxVBFgPFzLKsBKjn056g7v81Z2rQ27gGFzdOWMRvfe+//aSnDiGqe7ZkhZ26KAI",
-	"QUSVdsmTrYAkw83CnLIrfsWfwxytD4I/veIZ1fRkRhVL1UmlQH5Dc8pTOF4I8tTnYz6nml7xnqQ1WFgx",
-	"SJomZTXLWUquQ4WkIU9bLKs/wtXVW5ovxNXVu15sRl99cFNF+YudIDGCsKh04kr9JBLWVMZ8X6ou9YIj",
-	"21peu2a1QraorIHUlxJy48d5Hi1L1S350F9+WeZm+QEZKlfQwGwZUVrU+WhGQHEpvWZ/fxTuYpB07e0q",
-	"lQJFfi1o+ZZx/Y4kV9Xp6WPM7GtqIPzqrnxDk9sSRltXBktSdI0quHCrVmKselLSRczFdnX1VgMtcfdR",
-	"Xi7QxpHnBD9rZR36BAMcqllAneI8uAEWjoOTg3FxF/YrX9YxvgR8hFvYTsC+1X4F+fM33q49Ofi00svE",
-	"nO3oqpQhcb8zdbW3hRGyfDSGYgvUVl1hvBmQdAnptatYBkWpt9PW5z7gxwmannUwZWvZ2QxDrKaEDooZ",
-	"kKrMqBPFKd92y9oom1GBg76Ba9heiqYY0yF1bNplVdTQQUVKDaRLQ6zhsXVjdDffRZX5RFNXnQSTNz1Z",
-	"PK3pwn8zfJCtyHsHhzhGFK2yH0OIoDKCCEv8Ayi4wULNeLci/djyGE+Ba7aCBHK2YLNYGd7/6PvDPKyG",
-	"Kl3lQReFXA+oCJsTo8rP7MXq1HtJ+QLM9WyuVKFobquqRoM2UB9aApV6BlTvtPPzsCCFhw5VyjVmXqOF",
-	"b2qWABuz30yjxY7D2mgVaCiy77jo5ePh+DMLOGQ3hMd/3mgKx4O6rkNdpOKgv5Vr7NZqrQvNC+kM4bLP",
-	"C8CSpWJt9sVAIVy1TVvUJbhfKkUXMKC7hN67kfUwWh4/HGSfRBKVQcS8K2r0JIEoyPblxKw5eobBPDGH",
-	"GNXMTkCmn8k6iJ3PCItoO4TNchRg68hVu/dUtryotirwEGhx1gKSN6KgB6ONkfA4LqnyxxHrpXouO0o6",
-	"+x3LvuwqTXcexBIGRVHrwnP+Nuxy0J7e7wrU+ap0vhRdqPSPKCtndC9MX4hth+AommaQw8Iu3L7sCaUp",
-	"mNRskIHj1XyOvCWJhSUGBupAAHBzgNFcjgixvhEyeoQYGQdgY+ADDkx+FOHZ5ItDgOSu4BP1Y+MVEfwN",
-	"8cQ+G6hvhFFRmsuVDfgbU88BXCmKRrLoRFTjMITxKTFsbkVzw+acLt4M0quQhgpFpx6aC715MKRo7HBN",
-	"2Sv/oDVZIeEmqwmlWQ90XNTeAfFMbBKboRzVRWabmaH3aO4C5kvHDqatRXdPkZnYYDgXXi02Vn4PLMNw",
-	"eDAC28uGKaRX/G5IzrLA7Jp2t5wbo0KFJOMMrTW5DAl6Y6YekC2HyOV+UF7uRgB0zFBNrwZnlthrPmiL",
-	"J/3LvLnVpk3ZVJ8WFjv+Q0couksD+Ovbx9oF4f7aFP4bLi7mT9RHqYTXtyzdpkKh/bi0VQcPKVDYJYcW",
-	"EDuw+rorB0bR2o71auM1wFqMlRjm23dK9tGmIAdUgpOWaJpcxyIFjC4PeI9f+M8CYx3uHuXbB0EAoYQF",
-	"Uxoap5GPC/oU5niK5ZOFmA+vTpdybtb3Roj68rduc/ywtcyPvgKMwJ8zqXSCHrfoEsxL3yk0In1nXo1L",
-	"oO0QRdtsgGVxjovTXsM2yVhexenVzfvDczPtj/VFo6oZ3mKM2wCtGTbHiAYu75jaxrbvXPALu+AX9M7W",
-	"O+40mFfNxNKQS3uOz+RcdBjYLnYQIcAYcfR3bRClOxhkkHDe546BNBrEtBzv8jb0DlPmx94bpebT3odu",
-	"fjtSdC1BGcB4hqBYLCDz5c28P4wHReRywRdBF6ey3FUz75jY0nVYeW5H0ToXhg9DQfiBuJ8wnsEmDn2o",
-	"FSDkTWYdFtzDSRbAbbmSuFkoipowxB/fCGx1H9kX2k0AiAZBX3ac2U10st2lejtxA3KgmdNJFPj17T6W",
-	"/Q1xqJsOhU+3Kp/uPkI4INIU00Fjk34ZggEGTMuSZZuO48mOOmgEowdZlwekLWQtbrA9GGgHQUcJrlVK",
-	"24VaOwP7Ceq8J0Yrs7HXLrDY0DdNXQJ+Vkn0YLQim/t122tdbeTaf/j5QgtJF+C8UIkF6VZD4HIOQUNQ",
-	"FV0RzWw4Scbmcwi9L+omnoMWcD0bezaCdCNEFnfRVIzrL5/EyGgP9TQw7kdZnGIitDDkk7/se7m8TB+Y",
-	"kuorIdiaG7iqoun6P8A2+ZnmlVEymFRNeK5zO7Uv3wN2fVX8AFsceW/UqwFsz66g5ekNIA3GLP31IxUU",
-	"sL6nWiX+Ub1sbeEBO3UW36U72hrXlGGY+JtbptW0oL2U2xyMJkjCwDJmNy7isQnm9EAb8V1S3rcJLNsv",
-	"gwTyfjgVU76FZf8qqmtR7KPdS6C5J15czuTDdHK7SIDYbeZG3IPr1/UFGsUzRppaz3ArsOdAlNOylGJF",
-	"88TFSwxd/lKs3OWPr/vwio+sycQp+/LbsxevHfgfppM0ByqT2hIwuCp8r/xsVmXbOOy+Smy1b2fotJai",
-	"YPPrisxhjMUaK3t3jE29pihN/ExwFF3MxTwe8L6X97lQH7vEHSE/UNYRP43P0wb8tIN86Iqy3DsbPbQD",
-	"wem4uHGddaJcIRzg1sFCQcxXcqfspne646ejoa49PAnneoWlKeMaB3eFK5EVueAfeufS03dCtpi/y0yM",
-	"Bg/9fmKVEbItHgditX3/yq4wdUys4PXr4ldzGo+OwqN2dDQlv+buQQAg/j5zv6N+cXQU9R5GzViGSaCV",
-	"itMCHtRZFoMb8XEVcA7rcRf02aqoJUsxTIY1hdooII/utcPeWjKHz8z9kkEO5qfjMUp6uOkW3SEwY07Q",
-	"xVAmYh1kWtiWmYoI3o2pxiRYQ1rI7F1LBuuM7R8hXhXowExUztJ4aAefKcNeuQ2mNC8TfHnAWmtGrNhA",
-	"bC6vWDCWeW1MzdQOkMEcUWSqaNnWBncz4Y53xdk/KiAsM1rNnIHEe61z1XnlAEftCaRxu5gb2PqpmuFv",
-	"YwfZ4W/ytqBdRpCd/rvntU/JLzTW9OfACPBwxh7j3hG97ejDUbPNZlu2QzDH6TFjWqd7RuecdQNzRFuh",
-	"M5XMpfgN4o4Q9B9FCmF4xydDM+9vwGORe12WUjuVm47uzez7tnu8bjy08bfWhf2i665jN7lM46f6sI28",
-	"idKr4uWaHZKHlLAwwqCdGjDAWvB4BcGw2AbFRx9Rbs+TrQLRyjCLn8owl/PEjt+cSgdzL/81p+sZjfWI",
-	"MbqQgSnY3laclBbEf+w3QNU1DuzsJIjgrt9ltpJcCbLxQfSr0t5Qr7HTjtZoGgUGKSpUXaY2TCFXIjJM",
-	"xdeU2y7i5jvLr9zXCqwL3ny1FhLrQKp4SFcGKSui5tirq7dZ2g/fydiC2QbZlYKgA7MbiNhik0hFrot1",
-	"XbnDoeZ8Tk6nQRt4txsZWzHFZjngGw/tGzOq8Lqs3eH1J2Z5wPVS4euPRry+rHgmIdNLZRGrBKl1TxTy",
-	"6sDEGeg1ACen+N7Dr8h9DMlUbAUPDBadEDR5+vArDKixf5zGblnX4HwXy86QZ/tg7TgdY0yqHcMwSTdq",
-	"PPp6LgF+g+HbYcdpsp+OOUv4prtQ9p+lgnK6gHh+RrEHJvst7ia68zt44dYbAEpLsSVMx+cHTQ1/Gsj5",
-	"NuzPgkFSURRMFy5wT4nC0FPTXtlO6oezvf5dvygPl3+I8a+lD//r2Lo+shpDi4GcLYxS/hF9tCFap4Ta",
-	"4p85ayLTfb9Ocu5rC2MDrbpvlsWNmcssHWVJDFSfk1IyrtH+Uel58hejFkuaGvZ3PARuMvvySaQRVbtX",
-	"Cz8M8I+OdwkK5CqOejlA9l5mcd+S+1zwpDAcJXvQ1FgITuVgoG48JHMoLnT30GMlXzNKMkhuVYvcaMCp",
-	"b0V4fMeAtyTFej0H0ePBK/volFnJOHnQyuzQT29eOCmjEDLWMKA57k7ikKAlgxVmzMU3yYx5y72Q+ahd",
-	"uA30nzb+yYucgVjmz3JUEQg8mruS5Y0U//PLpvI5OlZtJmLHBihkxNrp7HYfOdrwMKtb139rA8bw2QDm",
-	"RqMNR+ljZSD63obX1998inihLkh2z1sGx4e/Eml0cJTjj44Q6KOjqRODf33UfmzZ+9FRvABx1ORmfm2w",
-	"cBuNGL+N7eE3ImIA810L64AiVx8hYoAcuqTMA8MEZ26oKWl3iPv4UsTd5HfFo03jp+Dq6i0+8XjAP7qI",
-	"+MTMEjewyVIYPuztDplRksnq50GcOyXfiM1YwuncQZ54/gAoGkDJSPMcrqTXATTqrt8bLxLQqBl1Brkw",
-	"SmbYFCi0538+eDaLn+7AdsXy7OemtlvnIpGUp8tolPDMfPiLldFbV7BlldE+I0vKOeTR4axu+4vXgSNa",
-	"+t/F2HkKxke+2+1Aa5fbWVwDeBtMD5Sf0KCX6dxMEGK1XTarLsuQL0RGcJ6mqUXDHPutnGMtNCP5zThs",
-	"UWkXt4q54K7g0JzlGIYZ9xvjm4mkeqCAFvY79/2FzDjYflxZM4MdHSShrMCLWdGizAFP5gokXeCngkPn",
-	"cyyhhiMHHSuIKs0jfBMLVgiiK8mJmM+DZQDXTEK+nZKSKmUHOTXLgg3OPXn68PQ0avZC7IxYqcWiX+ar",
-	"ZikPT/AV+8Q1WbKtAA4Cdj+sHxqKOmRj+4Tjekr+owKlYzwVH9jMVfSSmlvb9pOse58ek++x8pEh4lap",
-	"ezRX+iLC7YKaVZkLmk2xuPHlt2cviJ3VfmNbyNt+lgu01rXJP+peGV9g1Fd2GqicM36c3aU8zKqVTur2",
-	"k7HahOaNpkEm68TcoB0vxM4xeW5NqHUDfzsJwRLZsoAs6HZplXgkDvMfrWm6RNtkSwIa5pXjG7F6dtZ4",
-	"boLsw7r7ETJsA7frxWpbsU6J0EuQa6YAM/JhBe1yiHVtUGcb9+UR28uTFeeWUo4PEEbrXkeHot0DZyVZ",
-	"H1QQhayD+AMtU7Yf86F9aS/wq3guRqfJbcfr74vr+RLb5KVzLqSUC85SbIUQk6SxdNs4N+WIrhFx/6Ka",
-	"uBMaOVzR1rp1LrDD4mCzXc8IHeL6Lv/gqdlUSx32Tw0b13JtAVo5zgbZ1He6dg4xxhW4blaGiEI+KWQk",
-	"qCmaCFEHUBxIRliVacDC+Z159qOzf2NRjGvG0dLl0Ob0M+uyyhVDzzQnTJOFAOXW087mUW/NN8dYpTGD",
-	"zbvjF2LB0gu2wDFsGJ1Zto0Z7Q915iNIXcSmefeZedfVzq9/boWD2UnPytJNOtwHPSpI6g0fRHAsbskH",
-	"kgTIrccPR9tBbjtDv/E+NYQGK4xagxLv4R5h1L2026N8a3RLS1H4BrEZldECuoxHwHjBuHehxi+INHol",
-	"4MbgeR34TqWSaqs7jOJpl0DzgQQIzFC2PvjbDtXtHGBQgmv0cwxvY9MGfIBx1C80Ej/lW+IPhaHuQJh4",
-	"RvM6dDrS1BulKidEZZhc1GnzHWMchnEnPmWyha696Xv159iN49CbaKhG4azKFqATmmWx0lbf4FOCT32S",
-	"GGwgreomVHV2YLtGeZ/a3ESp4KoqdszlX7jldEHf/Ag1hL37/Q5jpZ3ZFv+NdWAa3hkXNH1wVq6PkM4O",
-	"K8zfzzKOSb2GphPFFsl4TOCdcnt0NFPfjNCb7++U0n267h8iG7fD5cI9ivG3b83FERbu7cWn26ulrquL",
-	"seACn/uCR3VFyDZXwqus12cMox5w8yJb1gHevxgFfEXzgUz40Fdi71frPxjKh08HyzdQ7cpzaUp2sqDB",
-	"kkc2Vrjjfem7EIfig2148N15LdxadyJ02Hf3Q8tTZ2PEGmYx6KG7mROt2eBDvWg/rIZKJPg+Hfg87Afi",
-	"onimrgw8rJiofPSVj4H2KqH91ZXgafX9GFh/NLPgU3stBn0sl65/rV2m08l/+Nl6YQlwLbd/AI9Lb9O7",
-	"TWUi0q41TzWvkLr14ahWiK1bcUwPm1i7FCcbeluZZS0tWuq1n+mR1fMx4kAPHx+mk/PsoAsz1nJnYkeJ",
-	"HbsXbLHUWLH/r0AzkK/3dCRouhDgESuFYk0H0twM5krALnG447HJBoaAWdhRoT+WD0JdQaqx7WwTXCcB",
-	"DumvYCbzTp8/OxMMq9N1ToZrSLCrC0G/1+yeO75XOCko/mX7dB6Pr7l/VodQ2wywNVVNuZZOzvTozM35",
-	"HFKsiryzUNV/LIEHRZCm3i6DsMyDulWszmPCut6HWx0bgHbVkdoJT9Bf59bgDOWxX8P2niItaog2Dq2T",
-	"+G5SOBgxYF1gvob0kCHZRY0xVVMGYsGHBLtSzE1zjMGaz0HZtRvO5UnSXBxNKbYdU8abno+ay3x6UNlH",
-	"TMkZqmXV75k8rH88xxbVygXI0brwcKilk/N+45y1K1yMZcVq34kvYQzK/+ZrCNpZcnbt+gcgVqynak1l",
-	"5t+4k6JQ9m5icaDn9cysSeDoBzlEWjFgLlSaCyNGJEMJZe2ciTrg8J6ykaFNAR+Eaw5SQla7RHKhINHC",
-	"J3zsgmMXKmz4642QoAbbH1ngBktfv2lqe2MbOIqlrqmLeg0XSCQU1EAngwrcw3PuQvYz+9wn4fs2YHst",
-	"TDW97u9H61N3mOohMaT6OXG35f7k/psYmxjnIBPveeqW4+btimxYdzOrUntBhwejNsiNrp2zg5VE7TRp",
-	"f5UdHSFIkr+G7YlVgnwjX7+DIdBWcrKgBwVHO5t8p+Y3FYN7cSfgfdo6cqUQeTLg7Djv1xDvUvw1S68B",
-	"awDWIe4DPdrJfbSx197s9XLra2aXJXDIHhwTcsZtUpF3bLfbC3Ym5/f0rvk3OGtW2bL+zqh2fMXj2RlY",
-	"cF/ekpv5YXbzMAWG1d1yKjvIngrVGz4UcrPG4vztLp7HY7Xyvqu520W+ISoLRUwmubAeq2d40GOGIyyB",
-	"ENTqQEcmJc7TRVQuYrG8NynTYIaKYyqcDAHSwMdUC6ihcINHERDtix45hbb0nSt6J+ZEQuNEvmn1v34L",
-	"95hG3525nqXN7+ZCQqsZu/naVvqsE1+wjCb+Z8a0pHJ7kxp9vRbyPevJIJb3hmPVkVjNQpporD4O81ys",
-	"E2RWSd3nIqbamvdU+zL2Tdea78ypnkEQ10WVE9S2ZEkzkgopIQ2/iOd7WqgKISHJBYZ5xTzQc23k7gKT",
-	"vDjJxYKIMhUZ2H4xcQoamqvinKLYBEFUTRQFlnYwW9h+E9DxyCnNnWr9SAmKWosDeuenYDPXm6pOdtGJ",
-	"9WUORCyDclWcHIbsy314d/T+j/PmOdsg3YCMHfk50bKCKXFvdHtku4NPJZCCKWVBqWlpzfIcE8fZJvC8",
-	"1oELcdQOiL3nGFa5Yhh70y4iYKXh0tx5dWWFkAdchGWPiF5KUS2WQYHpGk6v8srKKcThKD+pCsOjMIPM",
-	"TPGEFEJpp2nakZolNyFn91PBtRR53jZKWRF94SztL+nmLE31CyGuZzS9foB6LRe6Xmk29fnV3eDAZibZ",
-	"KS3WvoAT2858f6le+x6GyjmiHc0gOyzu4MbuAZjv9nPQ/Tb3s/7CuutqM9O4GnPGCdWiYGn8TH1e0XaD",
-	"MXIxFhWtWWZ7K9oqE/gaHvbwsqqDK5BF9tEMnEabw50RxwickxnZjfkvSuDdcckcHKMZuCj7zMVJUUk6",
-	"KOt1AEBIbeqzrqRtyBhKYjVXEQtbKgFd5F1AR94qGIl0O9jMCHcOlIZbAdWLfqwBvG+ND1NbW85GUs7E",
-	"xj9/0BSfuxHwH3ZTeYt5DIV4XTSkJW2Qly9UM8AR4iWud8ZDXWLa+2xsVFTdPHfkDR8AMBwn1YJhVLTU",
-	"oWDMKcshS2K9F89rG9U00LRdala3JTpTjpOntPKtD83YlQRXOMWK+LLt/yqpISVRv963JPMMNmDzOn4D",
-	"KWxPw2ngf4HctjzsGANEmeSwglb4mKvmUqGoyVbgv1X1xyQDKNEb2bWRxeKiwru8Yzhxa0+CyJox2I1a",
-	"Uixi7U6RPWaSqFFnwxN7TNTYo2QgWrGsoi38qUNFjrYZ0BzlCKp6OkLi9cix0/xkR3jjBzjz38dEGY+J",
-	"d+P40MEsKI66XQxob5xkpYZOPY+HSYalimoHC86W1Y5YS+IN31AlXfNhg2Sf5Bt1a+Q+McEDxH67gRSl",
-	"GqfvQOY0ngEnhat6gtTOATKrFZhPItb2JXDCRdBick1Vrao0NRT9D3ZifIlxp03fwKncRDPefmcJDkZU",
-	"p5jaoCIhazq9uXn+k5zEnQdxcLwYjShw6X877F+eup3agS9gK29u9tPI/tik0d1ijotPyazyA+W5WNue",
-	"kaEe+hy8H9RSn3cBObGc1deyj9qcuvKeXVMHC+LVC7olQuI/Ruv8R0VzNt8in7Hg+8+IWlJDQs7xaiMC",
-	"XBSomXi3eDX1gHlri/BT2XWzsWMGw23NKAHQ5iL3zX0EKeg1hNuAwQ6Wf6baME5VzdByYa7sznb2seAW",
-	"70u0FDQLNX0sFNluo+5LB5uv/3eTCxdO5eu7lTlNfYdQ16KozWewC7AnLr2EYneyZJ+veRKoOws3RCt9",
-	"dn12A5PpgawrloEw1H6lBXav42qv88ytljHS8tvpsbEjzXTUUu56F8ZG3fSADvs07gM/bFv5cfAfreE6",
-	"tIwx4P9R8D7QqDaE1/ak/QhYblXgiMBqrdUzsUkkzNW+ABNrrjbqvGxqd3gTK+OpBKpsxM35K6d4NiVK",
-	"GTeKsI0JrX2a9SgZzBlvmCXjZaUjegxWKuXbAGGh0R/ROuBCG5ISjDC5ovmrFUjJsqGNM6fDtnQMW0R4",
-	"R4f7NmLCqO/U/gBMNToc5mc2ZvTwNXOB2yZUNlxTacozKrPwdcZJCtLc+2RNt+rmHqXaObDPp0QDaaZd",
-	"NSDwLiFpW0DyrXMK39LfUwNI79DxM8Jhg3HBEWeNNe1oMeCf6cPwWThsCrpJcrHALMKBA+Fq06KHz6qA",
-	"gqMZ3Mpn49bt51HsN9g9DZbld4xIC5x1zBS7z/0r3EpUI3/iTO88+dZG2U3rtHG39mB6pPJFE/xviaV/",
-	"HmOZuK74SpiN64VNn6riaQ+CTYQB/1DbLj6wixgG4dK4QyP4+HZn7UiLWL6vtQwkaDFQO8L7QTWh7DR1",
-	"4Vl9U1rP1GCRMnXZ0gda2qx93t9LA+DZ3vTurLenrUNmzDiH9IjbnR+dlKJM0jExn7ZzR+bcBA7SNowD",
-	"9BE4AQbWXYfHqLqXTavuUaupzaFt8gab6uzzdpXpLqV/yEw0wNHbLggxR15mO7ejdQszeWpjyrSbY9Y2",
-	"g9VMglAiIa0kmonXdLu/7dhAxeiLv5598fDRL4+++JKYF0jGFqCaquOdtl1NXCDjXbvPx40E7C1PxzfB",
-	"Vx+wiPP+R59UVW+KO2uW26qmpGivadkh9uXIBRA5jpF2UTfaKxynCe3/Y21XbJF3vmMxFPz+eyZFnse7",
-	"PtRyVcSBEtutwIViNJASpGJKG0bY9oAy3UREqyWaB7H278pWkxE8BW8/dlTA9EDIVWwhQwG1yM8wt9t5",
-	"jQhsytzxKuvp2bUup6dZCx0KjRgVMwNSitKJ9mxOYhBhBpEMMmud4RMt4kGMbM1sbbRsjBBd5Hmc9MKG",
-	"2bu5fbuZq45zerOJEfHCH8obkOaQf2K4bsFNOElj2v/D8I9IIYY74xr1cn8PXhHVD27WlH8UaP2k/Ah5",
-	"IAAD2batPMkgUSwoRCytlwD9Cd6B3BU/XjaO5b1pIQiJ/2APeGH6bPNencngwPnEFX1f1kgJlvJuiBJa",
-	"y9+XketZb32RBFvkjCZag7JsSfTFwiDdWj2rs5gHtJJesrMUQhOjmeZ5JEna2nHwTIWEY1QCuaL5x+ca",
-	"3zGp9BniA7I3w6lRYaZsiGSLSnWzOn0v6Ki5g6zYu5uav8bE7P8As0fRe84N5ZzwvdsMjTvYsX7hbwWb",
-	"603WOKYNsnr4JZm5ZhulhJSprnN/7YWTOjEUJJu7gFbY6D2ZqPvW+bPQtyDjuY/EIT8G7q3aZ+8gbI7o",
-	"J2YqAyc3SuUx6uuRRQR/MR4VNufdc13csjHDzcq+BAXcDiz70m87PHZ5trSJuXQqBf11jr6tW7iNXNTN",
-	"2sbWLBrd3+Hq6q2ejSk1FO/FYD7HWkd30pThoJYMv0OVI4sjN4abN0YxPw/VvbW1XQdqc3f2o2L53oCV",
-	"VqX1D9PJAjgoprCW+C+ud8zHvUs9BLbyQv+oWlhvUy7GIiay1tbkwVRBDfUR5dPdZ5Ga15jVmFaS6S32",
-	"DfYGNPZLtB7T93VtD1cbpvalubtPi2uoe7c3lUAq5W/X7wXN8T6yLj5ubiGRH5NvbYVvd1C+vjf7V3j8",
-	"lyfZ6eOH/zr7y+kXpyk8+eKr01P61RP68KvHD+HRX754cgoP519+NXuUPXryaPbk0ZMvv/gqffzk4ezJ",
-	"l1/96z3DhwzIFlBf2v/p5D+Ts3whkrPX58mlAbbBCS3ZD2D2BnXlucC+lgapKZ5EKCjLJ0/9T//Hn7Dj",
-	"VBTN8P7XievPNFlqXaqnJyfr9fo4/ORkgan/iRZVujzx82C3wZa88vq8jtG3cTi4o431GDfVkcIZPnvz",
-	"7cUlOXt9ftwQzOTp5PT49Piha23NackmTyeP8Sc8PUvc9xOsr3miXOn8kzpX68O096wsbWF988jRqPtr",
-	"CTTHAjvmjwK0ZKl/JIFmW/d/taaLBchjzN6wP60enXhp5OS9q5zwYdezkzAy5OR9q8BEtudLH/mw75WT",
-	"97517u4BW21TXcyZQWrU5fk9aFduydoeIrU60NPgRp8ShXXzzU+lZMKc16m5fDPAuAAMb5NYQFzLiqfW",
-	"WWynAI7/fXn2n+gwf3n2n+Rrcjp1CQcKFZrY9Dbjuia088yC3Y9TVN9sz+pqJo1zffL0bczI5IJFy2qW",
-	"s5RYOQUPqqHC4BzVIzZ8Ei2KE1X3N2+4vuHkp8lX795/8ZcPMWmyJxvXSAoKfLS8vsJ3PkWkFXTz9RDK",
-	"Ni4C3Yz7jwrktllEQTeTEOC+BzVS9cwnCPkG0GFsYhC1+O8Xr34kQhKnPb+m6XWdHOWz4ZoMwDAZznw5",
-	"BLG7WEOggVeFuaNcllWhFmW7AHCN5nfYLREBRXby6PTU81CnoQQH9MSd+2CmjlmrT2gYphMYKvup8IrA",
-	"hqY63xKqgjgJjFr0nU07KWyiTFqB9DtNo/0Z3ZZEsxAOzcaPVKgXmuZ74LvsdIFsocOF/JTmkt2f/t5D",
-	"RhSCdzExItxaTyN/7u5/j93tSyWkFOZMM4zLbq4cf521gHSyaL714A4UGjkmfxMVyo5GK6g0xHrg4wzW",
-	"J+LmdHWRgkC6JnUInxwddRd+dNSE/c1hjUyWcnyxi46jo2OzU08OZGU77dStMsKjzs4hw/U26yXd1FHT",
-	"lHDBEw4LqtkKSKBwPjl9+Nmu8JzbOHUjLFuh/sN08sVnvGXn3Ag2NCf4pl3N4892NRcgVywFcglFKSSV",
-	"LN+Sn3idCBC0WO+zv5/4NRdr7hFh9NWqKKjcOiGa1jyn4kHfn538p1fhqBG0kYvShcJYGBRRrUzrqyDy",
-	"xeTdB68DjNQ9dr12MsMOmGNfhVBhGdZO0DOhTt6jbX3w9xPnII0/RB+HVZ5PfO3FgTdtla34w5ZW9F5v",
-	"zEJ2D2feCcZLqU6XVXnyHv+DenCwIlu0/0Rv+AnGhJ68byHCPe4hov1783n4xqoQGXjgxHyuUI/b9fjk",
-	"vf03mAg2JUhmriMslOl+tQWNT7CT9Lb/85an0R/762gVcx34+cSbYWIqdfvN960/2zSllpXOxDqYBR0Y",
-	"1vvWh8w8rFT375M1ZdoISa6GKJ1rkP2PNdD8xDUM6vza1OjvPcHGA8GPHbGqFLaIUFujfUPXl61cUGmL",
-	"ZXwj0FAxxHA3yYxx5EIhl2zMkvZhX0Xq8cbLJdj4W+/ZjcigWpCZFDRLqdLmD9daq6cbf7il/tWt7XEe",
-	"8dshmGhu6JejNPzkeK8zB8cdI2QG+0LOn/sJmwS0310w60H0Dc2IrzqVkJc0NxsOGTlz4n8LG7+3UPXp",
-	"paBPLLZ8NDnjG3/4FKFYgq+lIMp40ZygB94YocJokYYBLIAnjgUlM5FtXZuyiaRrvbE1OrrM7YS2b4y2",
-	"IZJKWqihh3dgpfxjmyb3WST/NAT+aQj801T0pyHwz9390xA40hD4p5nsTzPZ/0gz2SG2sZiY6cw/w9Im",
-	"9k2nrXmt3keb/hQ1i29XD2O6lslaaaTYCoPpY0IusfQLNbcErEDSnKRUWenKlSkqMLoTa5BB9vSKJy1I",
-	"bAylmfh+818bvHpVnZ4+BnL6oPuN0izPQ97c/xblXXxk80u+JleTq0lvJAmFWEFmk2HD+uj2q73D/q96",
-	"3Fe9xgqYBY+1dXypMqKq+ZylzKI8F3xB6EI0gddYkJULfALSAGfbUxGmpy5RhbnsaNe9vl3GvS259yWA",
-	"82YL94YUdMglHk1gCO/AUIJ/GRNH8D9aSr9pNavbMtKdY/e46p9c5WNwlU/OVz53J21gWvxvKWY+OX3y",
-	"2S4oNET/KDT5DpMKbieOuUKhabRL100FLV8oxpv7msDkMNAXb9E6xPftO3MRKJArf8E2catPT06wcthS",
-	"KH0yMddfO6Y1fPiuhvm9v51KyVbYBhqtm0KyBeM0T1zgZ9LEpj46Pp18+P8BAAD//3e/DWz5IQEA",
+	"H4sIAAAAAAAC/+y9e3cbt5I4+FWwnDnHtoYtyY9kbrwnZ1aJ89DEjn0sJ3dmY28CdoMkrppAXwAtkfHq",
+	"u/9OFR6N7kaTTYm2k5n8ZYuNR6FQKBTq+X6Sy1UlBRNGT56+n1RU0RUzTOFfNM9lLUzGC/irYDpXvDJc",
+	"islT/41oo7hYTKYTDr9W1Cwn04mgKzZ5GvefThT7Z80VKyZPjarZdKLzJVtRGNhsKmgdRlpnC5m5Ic7s",
+	"EOfPJjdbPtCiUEzrPpQvRbkhXORlXTBiFBWa5vBJk2tulsQsuSauM+GCSMGInBOzbDUmc87KQh/7Rf6z",
+	"ZmoTrdJNPrykmwbETMmS9eH8Wq5mXDAPFQtAhQ0hRpKCzbHRkhoCMwCsvqGRRDOq8iWZS7UDVAtEDC8T",
+	"9Wry9JeJZqJgCncrZ/wK/ztXjP3OMkPVgpnJu2lqcXPDVGb4KrG0c4d9xXRdGk2wLa5xwa+YINDrmLyo",
+	"tSEzRqggr7/9mjx+/PgLWMiKGsMKR2SDq2pmj9dku0+eTgpqmP/cpzVaLqSioshC+9fffo3zX7gFjm1F",
+	"tWbpw3IGX8j5s6EF+I4JEuLCsAXuQ4v6oUfiUDQ/z9hcKjZyT2zjg25KPP8n3ZWcmnxZSS5MYl8IfiX2",
+	"c5KHRd238bAAQKt9BZhSMOgvp9kX794/nD48vfmXX86y/9f9+dnjm5HL/zqMuwMDyYZ5rRQT+SZbKEbx",
+	"tCyp6OPjtaMHvZR1WZAlvcLNpytk9a4vgb6WdV7RsgY64bmSZ+VCakIdGRVsTuvSED8xqUXJtMbRHLUT",
+	"rkml5BUvWDElXJDrJc+XJKfaDoHtyDUvS6DBWrNiiNbSq9tymG5ilABct8IHLuiPi4xmXTswwdbIDbK8",
+	"lJplRu64nvyNQ0VB4guluav0fpcVebNkBCeHD/ayRdwJoOmy3BCD+1oQqgkl/mqaEj4nG1mTa9yckl9i",
+	"f7cawNqKANJwc1r3KBzeIfT1kJFA3kzKklGByPPnro8yMeeLWjFNrpfMLN2dp5iupNCMyNk/WG5g2//z",
+	"4uWPRCrygmlNF+wVzS8JE7ksWHFMzudESBORhqMlxCH0HFqHgyt1yf9DS6CJlV5UNL9M3+glX/HEql7Q",
+	"NV/VKyLq1Ywp2FJ/hRhJFDO1EkMA2RF3kOKKrvuTvlG1yHH/m2lbshxQG9dVSTeIsBVdf3k6deBoQsuS",
+	"VEwUXCyIWYtBOQ7m3g1epmQtihFijoE9jS5WXbGczzkrSBhlCyRuml3wcLEfPI3wFYHDxQ5wuBgHjmDr",
+	"BM3A6YYvpKILFpHMMfnJMTf8auQlE4HQyWyDnyrFrrisdeg0ACNOvV0CF9KwrFJszhM0duHQoQklto3j",
+	"wCsnA+VSGMoFKwgXFmhpmGVWgzBFE25/7/Rv8RnV7PMnk5tdX0fu/lx2d33rjo/abWyU2SOZuDrhqzuw",
+	"acmq1X/E+zCeW/NFZn/ubSRfvIHbZs5LvIn+Afvn0VBrZAItRPi7SfOFoKZW7OlbcQR/kYxcGCoKqgr4",
+	"ZWV/elGXhl/wBfxU2p+eywXPL/hiAJkB1uSDC7ut7D8wXpodm3XyXfFcysu6iheUtx6usw05fza0yXbM",
+	"fQnzLLx244fHm7V/jOzbw6zDRg4AOYi7ikLDS7ZRDKCl+Rz/Wc+Rnuhc/Q7/VFUJvU01T6EW6Nhdyag+",
+	"cGqFs6oqeU4Bia/dZ/gKTIDZhwRtWpzghfr0fQRipWTFlOF2UFpVWSlzWmbaUIMj/ati88nTyb+cNPqX",
+	"E9tdn0STP4deF9gJRFYrBmW0qvYY4xWIPnoLswAGjZ+QTVi2h0ITF3YTgZQ4sOCSXVFhjifT1JlsDvAv",
+	"bqYG31basfjuPMEGEU5swxnTVgK2De9pEqGeIFoJohUF0kUpZ+GH+2dV1WAQv59VlcUHSo+Mo2DG1lwb",
+	"/QCXT5uTFM9z/uyYfBePjaK4BPXSjDlRA+6Gubu13C0WdEtuDc2I9zTB7QRlzc00oEFrZg5BcfisWMoS",
+	"pJ6dtAKNv3dtYzKD30d1/nOQWIzbYeKCVsRhzr5x8JfocXO/Qzl9wnHqnmNy1u17O7KBUbYQjD5vsHho",
+	"4sFfuGErvZMSIogianLbQ5Wim4kTEjMU9vpk8pNmlkIquuACoZ3C80mQFb20+yER70AITId3kaUlHLRR",
+	"oTqZ06H+uKdn+RNQa2pjvSSqCSUl1wbf1diYLFmJgjMVnqBjUrkVZYzY8C2LCDBfK1pZWnZfrNjFBb7n",
+	"bSML6x0v3pF3YhLm5nO80QjVrdnyTtaZhAQ+dGH4qpT55fdULw9wwmd+rD7t4zRkyWjBFFlSvUwcnA5t",
+	"N6ONoW9oiDRLZtFUx2GJz+VCH2CJpdyHdVXV17QsYeo+y+qsFgcedZDLkkBjwlbcmObhaDXs9v1FvqH5",
+	"EsQCktOynDaqIlllJbtiJZGKcCFA22WW1DSHH0f27xo8R5oBszOMRKtxaiZUsamgi1CMrCjeQCt4zVRl",
+	"u0/goJquWEcKwhtR1qhFiB4a58/86tgVE8iTwtAIflgjamviwY/JWfiEMwtpF2c1gMab7wL+Ar9oAQ2t",
+	"m/tUNFNIVVidtYHfuCK5VHYIe8O7yeE/jKqms6XO+5VimRtC0SumNC1hdZ1FPQjke6jTueNkFtTQ6GQ6",
+	"Kkw/wCznwH4o3jGV0NK8xP/QksBnkGKAkhrq4SiMyMicWtiLGVBlZ4IGqG+VZGVVmQT0i3tB+XUzeZrN",
+	"jDp531jtqdtCt4iwQ2/WvNCH2iYcbGiv2ifE6q48O+rJIluZTjTXGAS8kRWx7KMDguUUOJpFiFwf/Fr7",
+	"Sq5TMH0l170rTa7ZQXZCru1/RjH7r+T6mYNMqt2Yx7HHIB0WKOiKabzdRMw4YZbGLnc2k+p20kTnghGk",
+	"sTYSCqNGwtS0gyRsWleZO5sJi4Vt0BmocfDYLgR0h09hrIWFC0M/ABa0oRHwd8BCe6BDY0GuKl6yA5D+",
+	"MinEgX748SNy8f3ZZw8f/fros8+BJCslF4quyGxjmCb3nVqOaLMp2YPk6wili/Tonz/xNqr2uKlxtKxV",
+	"zla06g9lbV/29WubEWjXx1obzbjqAOAojsjgarNoJ9asC6A9Y7N6ccGMgZfuKyXnB+eGvRlS0GGjV5UC",
+	"wUK37YROWjopoMkJWxtFTypsyUSBNI/r4JpqzVazgxDV0MYXzSwFcRgt2M5Dse82NdNs4q3iOpdCsNy8",
+	"YkwdYJVFGJAN6AAac2PFmNIk7jHizd+aYNTqd84JeFAbVR9CzcOUkiopilRKGpnLMgN5l8uEouaVa0Fc",
+	"C0+2Vfd3Cy25pprA3GjFrUUxoI8B8+zoe9wO/WYtGhrZepPb9SZW5+Yds0Nt5DevsYqpzKwFwVPaUhPN",
+	"lVwRSgrsiBv4HTNWDuUrdmHoqno5nx9G6ytxoAQt8xXTMBOxLQgXRLNcCuvUuIOM3ahj0NNFjLe2mWEA",
+	"HEYuNiJHk+Eh2NewVm/FBfov6I3IIxUfwFiyYsHUCHyMV+UNocNOdU8nwAF0PMfPaLN4xkpDv5XqTSPG",
+	"f6dkXR38murOOXY51C3GWUUK6OvV4VwsyrYj7QJgP06t8ZMs6OugTLFrQOiRIp/zxdJE7+ZXSn4A2SA5",
+	"SwpQ/GCVZiX06avOfpQFMBNT6wOI1M1gDYcDuo35Gp3J2hBKhCwYbn6t08L2gOsl+nyhq5qJ5XfU03BN",
+	"ZgyoK6c1rBZM3DJ1XzQdM5rbE5ohavSuC922stNZt75SMVqAUowJImfO18N5oeAiKXqRGS+uOlE/wS9a",
+	"cFVK5kxrMKdZzfdO0Hw7e3WYLXhCwBHgMAvRksypujOwl1c74bxkmwx9HjW5/8PP+sEngNdIQ8sdiMU2",
+	"KfR29Yp9qMdNv43gupPHZGc1lpZqiZH4OimZYUMo3Asng/vXhai3i3dHyxVT6FrzQSneT3I3AgqgfmB6",
+	"vyu0dTXgye/UFSDhwYYJKqQXrFKDlVSbbBdbhkbxWjSsIOKEKU6MAw8IXs+pNtYdjIsCdbv2OsF5sA9O",
+	"MQzw4DMERv7Zv0D6Y+dSaCZ0rcNzRNdVJZVhRWoNaJkenOtHtg5zyXk0dnjzGElqzXaNPISlaHyHLLsS",
+	"iyBqgh3aWbb7i0PfArjnN0lUtoBoELENkAvfKsJu7M08AAjXDaIt4XDdoZzgQj2daCOrCriFyWoR+g2h",
+	"6cK2PjM/NW37xGWNPTgnKSTTaEhy7R3k1xaz1o99STVxcHhXA1RrWb+1PsxwGDPNRc6ybZSPTzxoFR+B",
+	"nYe0rhaKFiwrWEk3CScJ+5nYz9sGwB1vnrvSsMw6JKc3vaFk7/+5ZWiJ4yWY5o+S4BeSwxGEp0BDIK73",
+	"jpELhmOnmJOjo3thKJwruUV+PFy23erEiHgbXknQznl6QJAdRx8D8AAewtC3RwV2zpq3Z3eK/2baTeDb",
+	"3GKSDdNDS2jG32sBAzpxF+sVnZcOe+9w4CTbHGRjO/jI0JEdUNC/osrwnFf41vme0dLczu1ilCKrNdkP",
+	"bGPnS+izkgrDJbb2kkQVjwUiCz5nuzMc/CXbnSDpD0EKZigH3XH0wb5q22Bbz+DumPrj7cAY3J8F76sB",
+	"nDOmDmHcRDXw+JUwttumaYccq5EO6merkT62i0PviUgtdQi9Q2JUwm2YHKzDe+nDcyluwtY0N+WGUBSY",
+	"NuSaKUZ0PbNuN30boJFVFg+QtClumdF5FCTt+VtdHC5wqGh5Kf9I+37bDt+bziOuhQ73bqukLEdoM3vI",
+	"SEIwyt+JVBJ2nbuQPR+05Y9JC0h3wZYbD6671mM04wrIf8ua5FTg87g2LMifUqFQB31xBq6jOZ1DbYMh",
+	"VrIVs69+/HJ01F340ZHbc67JnF37ONejoz46jo7sIVhKwWZSHsLnhwmj+B5ODGHub4RRm922Dzf82DNf",
+	"+eGJ62kXLLVpscpDsDeqzHlCtkHrMkhlyYvNRrVsNzu6kccs+VVncD8pMhGt3UmF5d+Z43VY0XrM2uND",
+	"Mc4l06xHrvxN24mvt27c9wu+qktqDmFaZle0zOQVU4oXbCeVu4m5FN9c0fJl6IZByyyHQ5mzLMdQ25Fj",
+	"sTfQx0bnwjhccMN9ZM5YgNi57XVhO+3QfzTu5Hy1YgWnhpUbUimWs8KahLgmOiz1mOCwJF9SscDXrJL1",
+	"wnmg23HwhoMgcAy7rUVviKTEb9YiQwtM6sZzvqQ+LhlkfUZB39A139jX9TUN87lQ9DFcK9qDrjkracGd",
+	"TgbVMYDUq0YdY5HTDq4ecfu1HiMRfpqJR9r5EHUgyfbxFW8LHCbY3A9jT2qGTkHZnzhyy28+Dnnmgy6o",
+	"3BxAyrMDEcUqxTTeybEOVduvch4nUvD+vBtt2KpvZrJdfx04fq8HlRlSlFywbCUF2yRzB3HBXuDHVG8r",
+	"Fwx0RgltqG/3gdyCvwNWe54x1HhX/OJud09o15yqv5XqUPZ6O+Bo0WeEeXynPOSmvK0RH/zF+3ZvF2bd",
+	"ZQB6GjzquSJUa5lzFFLPCz21B82Zyl1Mdhv9r0Lw2AHOXnfcjoE3zuCBBgxWVoSSvORo3pBCG1Xn5q2g",
+	"qECNlprwtPSaomGV+te+SVqHn1Cxu6HeCopetkGtmvQmmrOEDvFbxrxmXdeLBdOm87ibM/ZWuFZckFpw",
+	"g3Ot4Lhk9rxUTKG747FtCcEUc6AJI8nvTEkyq037uYNZBLQBBb21NsM0RM7fCmpIyag25AUHXyYYznuk",
+	"+CMrmLmW6jJgIX27L5hgmuss7RH6nf2KwTdu+UsXiAP/d529Z3iT1mQCy2xlMvr/7v/HU8hgRLPfT7Mv",
+	"/u3k3fsnNw+Oej8+uvnyy/+//dPjmy8f/Me/pnbKw86LQcjPnzlVwPkzfO9F8TRd2D+acWrFRZYkstjV",
+	"qENb5D7mc3EE9KCtuTVL9laAH5mRkE6IF9Tcjhy6N0zvLNrT0aGa1kZ0NLV+rXs+Ku7AZUiCyXRY462l",
+	"qL4TdTqbBGykTxABrci8FnYrvfRtg6W986OcT0PGEJtM8CnBdBJL6j2x3Z+PPvt8Mm3SQITvk+nEfX2X",
+	"oGRerFPJPgq2Tr0V40ime5pUdKOZSXMPhD3p52kdj+JhVwy0KnrJq4/PKbThszSH83GFTsm2FufCRuHA",
+	"+UH7+8aZ9eT848NtFGMFq8wylWSsJahhq2Y3Gev4REHIMxNTwo/ZcVfJVcB70XmclozOvfe4knLMayic",
+	"A0tonioirMcLGaVYSdFPJwbJXf764M8hN3AKru6cKbf7e99984acOIap7yG23NBRppDEU9p+aHvLGUJb",
+	"gZ9vxVvxjM1R+yDF07eioIaezKjmuT6pNVNf0ZKKnB0vJHnqg6afUUPfip6kNZj9NMpsQKp6VvIcrBMp",
+	"8rQZ7fojvH37C6ix375913Mc6j8f3FRJ/mInyEAQlrXJXD6uTLFrqlKGWR3yMeHI2HvrrFbIlrXVCLvx",
+	"iRs/zfNoVeluXpb+8quqhOVHZKhd1hHYMqKNDEGjXIe4e9jfH6W7GBS99nqVWjNNflvR6hcuzDuSva1P",
+	"Tx8z0kpU8pu78oEmNxUbrV0ZzBvTVargwu2zEgNKsoouUvbft29/MYxWuPsoL69gC0DQxW4xTkIUEA7V",
+	"LMDjY3gDLBx7R/Dj4i5sL597Nb0E/IRb2M6ScKf9ipJc3Hq7diTKoLVZZnC2k6vSQOJ+Z0JKxgXlQntX",
+	"IbBcwSFw2StnoFJk+aVLK8hWldlMW93lvCVoetbBtU04acOAMeUZWmQgEWVVUCeKU7Hp5p7SNuwJB33N",
+	"LtnmjWwypu2TbKqd+0gPHVSk1Ei6BGKNj60bo7v5zuXRR4O7FEIYYe3J4mmgC99n+CBbkfcAhzhFFK3c",
+	"PEOIoCqBCOwwhIJbLBTGuxPpp5bHRc6E4VcsYyVf8FkqV/bf+wZADytQpUsP6lzkw4AabILcaDKzF6t7",
+	"3ivQsROKvk+V1LS0qY+THkX4HloyqsyMUbNVzy/irDEeOuhPruFkWQ3fFJbA1rDf3KDGTrBrVjhFkW3j",
+	"XOuPh50jLeCsuCU8vnvzUjgefOs61CXSgvpbOWA3PGud32hMZ2+W4fuKYV5heQ37AlBIlxLXZl6K7pda",
+	"0wUbeLvE1ruRSWtaFj8cZJdEkpRBMEyvJWr0JIEkyLZxBmtOnmEGX+AQ4zOz4y3sZ7IWcWczwkz3DmGz",
+	"EgXY4FZt956qlhVVLLaBlmYtTIlGFPRgtDESH8cl1f44FtOIy46Szj5gbqZt+SPPI0fXKHNxyA7pb8Mu",
+	"B+29+10WSZ860ueLjB/9I3I/TieWASS3QwoUTQtWsoVduG3sCaXJatZsEMDxcj5H3pKlfGYjBXUkALg5",
+	"GLxcjgixthEyeoQUGUdgo6cHDkx+lPHZFIt9gBQuKxv1Y+MVEf3N0lGnNooEhFFZweXKB+yNuecALl9M",
+	"I1l03P1xGMLFlACbu6IlE8a/xZtBemkM8UHRSVrofI0eDD00tpim7JW/15qwx61WE0uzHui0qL0F4plc",
+	"ZzaNQPItMlvPgN6TgTXQK3kwbcLIe5rM5Bqd8/BqsYEcO2AZhsOD0QCAmQBh7dhvSM6ywGybdrucm6JC",
+	"Te4HqbMhlyFBb8zUA7LlELncj3JA3gqAjhqqKaji1BI71Qdt8aR/mTe32rTJbexjFlPHf+gIJXdpAH99",
+	"/Vg7a+P3TXbO4QyArtHHSVfZ1yzdJY2o7YyA6L2yiHbJoQXEFqy+6sqBSbS2WnXwGmEtxUoIFwmjZB9t",
+	"mpUMH8FZSzTNLtkm/ZZneI9f+G6Rsg53j4rNg8hjUrEF14Y1RiPvF/Qp1PEUc5xLOR9enanUHNb3Wspw",
+	"+WNHq4xvLfOjrwDDQ+ZcQRwCWNySS4BG32pUIn0LTdMSaGuzia0Iwos0x8VpIaKw4GWdplc37w/PYNof",
+	"w0Wj6xneYlxYB60ZVrBJuqFvmdoGXmxd8HO74Of0YOsddxqgKUysgFzac/xJzkWHgW1jBwkCTBFHf9cG",
+	"UbqFQUbZEPrcMZJGI5+W423Wht5hKvzYO73UfE6GoZvfjpRcS5SrMx2+KhcLCOOzKbi8PUxEmR5LKRZR",
+	"qbWq2pbY8hjy+2uXHnJLZkkXd8CGog4icT/jYLFNQx81s5A3YZ+YFRMnATM95tJJq4XkYkdMA7aIdHUf",
+	"2RbajXhIOkG/6RizG+9ku0thO3EDSkYL9ybRzK9v+7Hsb4hD3XTIfbqVnnj7EcIBkaa4iaoP9XNkDDBg",
+	"WlW8WHcMT3bUQSUY3Uu7PCBtIWtxg+3AQNsJOklwrXz3ztXaKdhP8M17Aq8y63vtHIuBvmnuskMUtUIL",
+	"RsuzuV9cIbzVRq79h58vjFR0wZwVKrMg3WkIXM4+aIhKF2hiuHUnKfh8zmLri76N5aAFXE/HXowg3QSR",
+	"pU00NRfm8ycpMtpBPQ2Mu1GWppgELQzZ5N/0rVyubaxKCldCtDW3MFUlc0n8wDbZz6B0IBXlSjfuuc7s",
+	"1L5899j1q9UPbIMj7/R6BcB27Apqnl4zpMGUpj980lGW+Xs6xph9Xra2cI+dOkvv0oG2xlVOGSb+5paJ",
+	"V9RZyl0ORuMkAbCM2Y2LtG8CnB7WRnyXlHdtAi92yyCRvB9PxbWvM9u/ikKilF20C9kePfHiciY308nd",
+	"PAFSt5kbcQeuX4ULNIln9DS1luGWY8+eKKcV+G/RMnP+EkOXv5JX7vLH5t694iO/ZNKU/eabs+evHPhg",
+	"ki4ZVVnQBAyuCttVf5pV2Vor268Sm5LfKTqtpija/JA2PfaxuMb0+x1lU69yUeM/04znfS7maYf3nbzP",
+	"ufrYJW5x+WFV8PhpbJ7YuePkQ68oL72x0UM74JyOixtX/irJFeIB7uwsFPl8ZQdlN73TnT4dDXXt4Ek4",
+	"10vMm5p+cQiXVRVZkXP+oQeXnr6VqsX8XWRi0nnow4lVIGRbPA74avsis11h6phYweu3xW9wGo+O4qN2",
+	"dDQlv5XuQwQg/j5zv+P74uioD7S97dJMArVUgq7YgxBlMbgRH/cBLtj1uAv67GoVJEs5TIaBQq0XkEf3",
+	"tcPeteIOn4X7Bcyx8NPxmEd6vOkW3TEwY07QxVAkYnAyXdm6tppI0fWpxiBYIC1k9q5uijXG9o+QqFdo",
+	"wMx0yfO0a4eYaWCvwjpTQmOCjQe0tTBizQd8c0XNo7Gg2ZiEvh0gozmSyNTJnMIN7mbSHe9a8H/WjPCC",
+	"CQOfFN5rnavOPw5w1J5AmtaLuYGxTzT8XfQgW+xNXhe0TQmy1X73LNiU/EJTlbn29ACPZ+wx7i3e244+",
+	"HDXbaLZl2wVz3DvGG/SS6gNnQfSMzhnrBuZoioBiP5vth+tsruTvLG0IQftRIvOHmwifI9g75bnXZSnB",
+	"qOzXE8++a7vHv42HNv7Ob2G/6FAa8DaXafpU77eRt3n06nQu8ekkPpJpuOxH0g4NGGAteLwiZ1isVeS9",
+	"j6iw58lmgWhFmKVPZdRCn9jxm1PpYO7ual7S6xnNL9NvIYAp2t6Wn5SRxHf2G6BDjgM7O4k8uENbbtMc",
+	"Vkw1Noh+yuRbvmvstKNfNM0DBjq2ni5T66ZQapkYphbXVBjm3Rgsv3K9NbMmeOh1LRUmKdVpl66C5XyV",
+	"VMe+fftLkffddwq+4LaKfa1ZVCbdDURsJlSkIldqPmTucKg5n5PTaXMm/W4U/IprcGTGFg9tixnVeF0G",
+	"c3joAstjwiw1Nn80ovmyFoVihVlqi1gtSXh7opAXHBNnzFwzJsgptnv4BbmPLpmaX7EHgEUnBE2ePvwC",
+	"HWrsH6epW7Zgc1qXZhvLLpBne2ftNB2jT6odA5ikGzXtfT1XjP3Ohm+HLafJdh1zlrClu1B2n6UVFXTB",
+	"0vEZqx0w2b64m2jO7+BFYKOCaaPkhnCTnp8ZCvxpIOYb2J8Fg+RyteJm5Rz3tFwBPTU10O2kfrhjPBuW",
+	"pwe4/Ef0f628+19H1/WRnzF0laYHil7KP6KNNkbrlFCbmbbkjWe6L6pLzn3ia6xyF4rbWdzAXLB0lCVh",
+	"C7GgEhcG9R+1mWd/g2exornBHHkD4Gazz58kqsW1CyqJ/QD/6HhXTDN1lUa9GiB7L7O4vhAFL7IVB1b/",
+	"oMmxEJ3KQUfd5LRmyC90+9BjJV8YJRskt7pFbjTi1HciPLFlwDuSYljPXvS498o+OmXWKk0etIYd+un1",
+	"cydlrKRKVbNojruTOBQzirMrVgxuEox5x71Q5ahduAv0n9b/yYuckVjmz3LyIRBZNLcFy4MU//OLJi0/",
+	"GlZtJGJHByhVQtvp9HYf2dtwP61b135rHcbw2wDmRqMNR+ljZcD7Hn9u+nwKf6EuSHbPWwrHh78RBW9w",
+	"lOOPjhBo0Dvapr89an+27P3oKJ0dO6lyg18bLNzlRYx9U3sI1VOfvh8oLRocilx+hP7+DV5S8AGY4MwN",
+	"NSXtMo4fX4o4THxX2ts0fQrAuRS+eDzgH11EfGJmiRvYRCkMH/Z2GdskyRThe+TnTslXcj2WcDp3kCee",
+	"PwCKBlAyUj2HK+mV6U2a63f6i0Q0CqPOGLiX6lbFqlif/+fBMyx+ugXbNS+Ln5vcbp2LRFGRL5NewjPo",
+	"+KuV0VtXsGWVKayBxVGwMjmcfdv+6t/AiVf6P+TYeVZcjGzbLRNtl9tZXAN4G0wPlJ8Q0MtNCRPEWG2n",
+	"zQppGcqFLAjO01RcaZhjv956qs5tnwTtsKvaOL9VjAV3CYfmvIT/DdiNsWWmqBlIoKUwjnHejMiuGFiq",
+	"8MFmR2eKUL7Ci1lTKIOFJ/OKgX8gdJWCdbpjCjUcOSqnQnQFn7AlJqyQxNRKQNXJaBlMGK5YuZmSimpt",
+	"BzmFZbE1zj15+vD0NKn2QuyMWKnFol/my2YpD0+wif3iKoDZOhV7Absb1puGovbZ2D7huIKn/6yZNime",
+	"ih9s5Cp0xlvbFjsNBYqPyXeY+QiIuJXbH6AJSYTbCTXrqpS0mGJyY/DMIXZW20cxRBQWW10A/B3yT5pX",
+	"xicY9ZmdBjLnjB9neyoPWLU2WaiNmspNCC2a6q2843ODerwYO8fkmVWhaq+gs5MQTJGtVqyISrHaRzwS",
+	"B/zHGJovoYFsSUDDvHJ8lWDPzhrLTRR9eOU/IsMGuF2hYFsneEokKJCvOaQrXlLDrlg7HaIHw+vGfXrE",
+	"9vJULYSllOM9hNFQiGtftHvgcNzgVJCErIP4PTVTtmj6vkWTL7BXOhajU4G5Y/X3yfV8im3ywhkXciqk",
+	"4DnWfkhJ0pi6bZyZckSZjLR9UU/cCU0crmTd5xAL7LA4WAl6Omkhrm/yj77CplrqsH8atnb1ABfMaMfZ",
+	"WDH15eidQYwLzVypNSCimE9KlXBqSgZCBAeKPckIszINaDi/hW8/Ov03HEFyyQVquhza3PvMmqwgjwVQ",
+	"uyDckIVk2q2nHc2jf4E+x5ilsWDrd8fP5YLnF3yBY1g3Oli29RntD3XmPUidxya0/Rrautz54eeWO5id",
+	"9Kyq3KTJiNaww6nq5IMITvkteUeSCLlh/Hi0LeS21fUb71MgNCiqQLRhFd7DPcIIhd7bo0BJhdpSFLYg",
+	"NqIyhZSSiwQYz7nwJtT0BZEnrwTcGDyvA/10rqjJly02tMthdCAAAiOU88tDDNXZYEQJrtHPMbyNTY36",
+	"AcYRGjQSPxUb4g8FUHckTED4Y3DF7VecR6nKCVEFBhd1atCnGAcw7syHTLbQtTN8L3THahz73kRDOQpn",
+	"dbFgBvLfpVJbfYVfCX71QWJQEaQOJcVCdGA7R3mf2txEuRS6Xm2Zyze443QF11RrtpqVCbfRZ+EjK8IO",
+	"A6WBZQX+TZWcGt4Z5zS9d1Su95Au9kvM348yTkm9QNMZ5F8ajwm8U+6Ojmbq2xF60/+glO7Ddf8Q0bgd",
+	"LhfvUYq/fQMXR5y4t+efbq+WkFcXfcElfvcJj0JGyDZXgm/9wmro9YCbl9iyDvC+YRLwK1oORMLHthJ7",
+	"v1r7wVA8fD6YvoEal57LULKVBQ2mPLK+wh3rS9+EOOQfbN2DD2e1cGvditBh290PLUud9RFrmMWghe52",
+	"RrRmg/e1ov1wNZQiwdfpwO9xPRDnxWO9tSrFrris3YYFH2j/JLS/uhQ8rbofA+tPRhZ8aqvFoI3ljSuu",
+	"bJfp3uQ//GytsFhNbvMHsLj0Nr1bVCYh7WKLiGDdE7inNRt41LZuxTE1bFLlUpxs6HVllrW0aKlXfqZH",
+	"Vs/GiAM9fNxMJ+fFXhdmquTOxI6SOnbP+WJpMGP/94wWTL3aUZGgqUKAR6ySmjf1ZEsYzKWAXeJwx2OD",
+	"DYCAeVxRoT+Wd0K9YrnBmsiNc51ibJ/6CjCZN/r8VZlg+DkdYjJcQYJtVQj6lYN33PG9xElR8i9bmPR4",
+	"fM79s+BCbSPAoFBeSNfSiZkeHbk5n7McsyJvTVT19yUTURKkqdfLICzzKG8VD3FMmNd7f61jA1BJbwlP",
+	"SQ8HzlAc+yXb3NOkRQ3JwqEhiO82iYMRA9YE5nNIDymSndcY14EyEAveJdh2Z01xjMGcz1HatVvO5UmS",
+	"0DgV25Yp0xX5R80FXfdK+4ghOUO5rAaqpaeOuFSY/Nh6oMcRNIma4wB5O6eZWFjFrB+AG6uuJ4rrS7Rn",
+	"ItfTNabiT6Z8mmk2mI2kSVtrm7nJeFnCSQijBjYEWmRHIcom8ofZ+YC3vB0zw0CGNADRAYxRAydxIUMx",
+	"DTsjzCQVCbnP4e8Zm6MfYrQEXIE7yg1aIKUvQZ/VeTdZu5BoO7C9b3HwaW5qWoZzN5AQLMoSC+eqnaM6",
+	"5D+X3rCEiAGrqsWOvg1cH/V2WFdYnv1OaOCa+HHgyGLy392ombqaNtxoopdUBYG/SV5/yW6Bv0BomYB7",
+	"vth+hOYUrCitR1a+pGXJxILZU4URMGFQT7oGhS+0ZMACFormjFRMcVmkD1UDlkswm3ojNtPgkfDV2zyw",
+	"kVQBSLc1AbuJE6M57363jamp0OFIviFCH/q7ACNghhaBrpwItrJnmDGuV1uqJ3zke3JHsYYBFhh6oWkO",
+	"U9hLZV2/gbC4uAVFjyjVMACN7+SXe1iQPsC1Pp00Mt8g7XoxUArW5UNNb89G4DJKE5W9ZTSXInPEuPu2",
+	"XUmnsXGju2/a1/DDG1Zb4h5D2qmUQ40sE+EieRSTa/D39yTBClNsKHkJJK7HpDzFUhLCGakYUy1WlUsh",
+	"/AWxx4OoHVfK7LhcuwA4kG9szZlqKQWbSXkZZxShgsjaLCQIHNdspsGvy+AQVtem2EoaRpZS+8BeLnK5",
+	"gubSFVgJc9qoZkpePXqFPySZJapaM1+0YudFCq39nRcSjvre9r3r57/FCbXADMuQuwDRUaL+W4IQNj0b",
+	"YqKGr5gv1ytc/mrgFUxDEAzXS1v7gwQXPTgE2IFVMl+mOcicYbGNAXLyXwkVQtYib6Rkv8rxRh2HLLB1",
+	"0QF1q2IlhUMbvPgqprwJiWC/LqlaAuUi9k1Evr1iVNdRWZwIZxVTiH/AzkoKbkPDkGVJG4NKamF4GcZI",
+	"Y26lF5mcZ/CLYtq0TF3bKMivB4Qh1/cDUDT6co2GCVt/CCjcPg3Xlmr4nuCGuzD4eLvS1xHAkw1JbCWf",
+	"VY+qPXhRpbhU3Gy2g+n5KSW+fXfEGEB3FYzeAt+BVHQDfksfYDfAEWYsOO0ytIeGZNCR0Tkqt2qQh2p5",
+	"KZro5Jcfuzy6UMzWxIJuB1/hoMIlHIgOw29QErHkiDA7m7eF+6RIr4ucHnto3YC9uzktzKBAFNlSho3T",
+	"z5ihvNQuepKGqlSxCwd4o3WrKl+7qlaYcz441vr6Vkz733yBCTtLyS9dcUmUra0bM9Qk8S0OkjEcmxGe",
+	"BnoeZuZNdo9+BEz/TrGJcvISFUHZULahzkn10aj3tA0bbrI7I1xzphQrgr9sKTXLjPSy3zY4tqFCo57p",
+	"VkjQg7WxLXCDddFeN4XfVjxXkmIdNOpCouMFgrhKAToVlWcbnnMbsr+2332GRq9l2Ol+FOg12xl/5/O6",
+	"cN1DYkz1c+JMKbszP97GE4kLwVTm3ZK7tdpEO10/FmUpaicQxgcjeGuNTqy8hZUknXjy/io7BuQog+Il",
+	"25xYC7nLpRh2MAbaKpgt6FE1ms4mH9Q3S6fgXhwEvE9bZKCSsswGPGHP+wXmuhR/yVHTBTeFz38A8uG9",
+	"9tmASch9dMAMoQ7Xy40vqFZVTLDiwTEhZ8JmnPFRD3GJu97k4p7ZNv8aZy1qW/PReVwdvxXp1B14eao7",
+	"cjM/zHYeppko7jyVHWT7RGYthuKxrrFyIytinB6PddnoxyF0JKiIqCwUSZnEKza+AeeXdLb7dqK3oAq5",
+	"nZrlNmoPKgQrMnxgbnnm4/eoIomHm2tiR9j/pe+CYvSQJ5H92pqtibDd76lfMaW5NkyYTMlySBrHT1HJ",
+	"VCFNKHON4vezHy/2m1cxX6Z1YMbwnehcNkaUSBYKNFnI2moN3STO/R5nMWqzzeC3awdhobGOb/+93InV",
+	"9sKeomZlA9RKVb6EypL7IHbwNWPBSOx3RG2tXUmd2gsbhPA1Xs8p/ShmtY3SL2NsCiUueIHoUqbSM9wm",
+	"8y4MlcZqPBkCZJgYkwA2QOEGTyLABWbuqPLiPvs6JnJOFGvigm5b0MXVSLEClR5y0urOHGZpSyloq4pm",
+	"xLhjW7zJ36VIUqhFUTNuFFWb25RdaaMqRbCDWN4ZYRuCa5uFNAG2fRyWpbzOUMTIQunilD0a2um2CO1N",
+	"/00/YiSa9EOoLtXuebUhS1qQXCrF8rjHgFMCQrWSimVQpCupgXvO5wZeyytuNMHKuAsiq1wWzJYAT1PQ",
+	"0Fy1EBQfOywKlEyiwNIOrNT1ieh45JQgCdvQgAwfSDsrZvrNfwN9bDLSJlG/XXRmw1MGklAw7RLzOwzZ",
+	"xn14kXBsJuuue+iA/p2vkW6YSh150MPVbEpcCxy9RUJ48OHyXHGtLSiBlryHy5yvG37AQixaGrUDj9Vz",
+	"VFBecQynbOeFxR7wNM1ZSJYb84CLOJM9MUsl68UyqhkY4PTmTlU7Y2g8yk+6xohXTAoGUzwhK6mN0w/Z",
+	"kZolN1HE93MpjJJl2fYztA/rhXOefkHXZ3lunkt5CfldH6A2SkgTVlpMfcrMbrx3M5PqVItoi80Z0oDe",
+	"XX3NtoNZPBcYzSA7LK7n57zrYo/AfLebg+52oz7rL6y7rjYzTSsfQGw3csXz9Jn6cwVQD4Y9p1hUChW2",
+	"hz34lojxsMeXVYiXQxbZRzMTNOmbckYcI3BxQ8hu4L/4bu6O2xgHBy7KPnNxUlSWD8p6HQAQUpvN0tQK",
+	"I0BakljgKnJh7TQY9dQFdOStgsGld4MNRjg4UIbdCaheQHsA8L5VGU5tuRDrcwQJkdz3B009kVsBf7Od",
+	"ylvMYyhq96IhLYVNQu7xAY6Qrlq4NcT1DWYynY0NdNU+KGHkDR8BMBz62oJhVADsvmBY372kc8F50CxP",
+	"I/2Ye7pGo3N3L+MsJKf2wgY3AcrLWjGXC9uK+Kod0lBRs/RXJzTv23/AlsA0CjO/MyUxdKmYRi71rLQW",
+	"u44KT1ZZya5YKyLY0rKuUdQEhwLXV4fOpGCswgCTrmY79TKO7/KOzsGtPYuCJcdgN6n/tIi1O0V2KDeT",
+	"qti1yOwx0WOPEkB0xYuatvCn9xU52sp7OMoJVPXeCJl/R46d5ic7wms/wJnvnxJlPCbejeNDe7OgNOq2",
+	"MaCdoe+1Hjr1Ih35HmefD2ZRnK0IsTWWxBu+oSt6LYbNCH2Sb55bI/eJSxEh9ps1y1Gqce8dVrgXz3YP",
+	"QqR264pnpcaFSNjIlkwQIZtnD9oQ/FOlKYvjf7ATYyMu3Gv6Fp7gTYD63XeW4GBEd+pjDD4kVKDT2xvV",
+	"PslJ3HoQB8dL0YhmLqPbFv2Xp2737MAGsi4LImA/QfZH13t3izkuPiWz2g8E2gpMEtR6hz5j3ntBithw",
+	"a1fkC0ugrtmi295gfVUHj1KQrKxuFv4R0pB/1rTk8w3yGQu+74a+/1AmxrpL2CAvF9gPE28Xr7wnZ9C2",
+	"SD+VXTcfO2Y03AZGiYCGi9zXa5dkRS9ZvA3ofm/5J3rMEl3PUHMBV3ZnO/tYcIv3WbdXtIhf+lj7Z9Pi",
+	"Dr4aHPT+v5v0ZvFUvmRHVdLc7naoOt/mM2il8MRllmy1Pf9dn695Eoi8oD3RKp8wtbiFynRP1pVKKjNU",
+	"UbsFdvSMaBfUPswyRmp+O2WTt2QOHLWUQ+/CaFe8LtDocOPrpuwA39a7cm0/Cv6TZbmGljEG/D8K3kM9",
+	"+2F4scnHwHIrqXICVqutnsl1pth8p4cjtgbgG4B1ULFykStGtbUznr90D8+m6hRHS7kN8w+eCGGUgs25",
+	"aJglF1VtEu8YLD4lNhHCYqU/onXAhDYkJYAweUXLl1dMKV4MbRycDjmPa2QBJN7Q4fomVBjhTu0PwHXz",
+	"hsOUe40aPW4GF3jB53OmrAFbGyoKqoq4ORckZ8pQDh4nG317i1IwDuyyKdFImmkngo2sS0jaFpBy41w5",
+	"7mjvCQDSAxp+Rhhs3iyZo/62scaqdowcsM/0YfhTGGxWdA02PkwMN3AgXLkxtPBhMwwGy6mw8tm4dft5",
+	"NP+dbZ8GK606RmQkzjpmiu3n/iVuJT4jfxLcbD35VkfZzdRnUynYg+mRCupRn8/FEkv/PFZ5erKqnWAx",
+	"eKm7wFhPeyzaxKFQkrZefGAX0Q3CZeaMleB6vH2o5WmRuGGcZiBDjYHekrGl8TBBXGunSuo5iXZVDRYp",
+	"U5cAc09Nm9XP+3tpADxANNPurLenDY5uMM542SfyD0lDVMkqy8d4attizIUFwEPahnGAPiIjwMC6g3uM",
+	"DuXJY2ps1ynH8fRtxO9OnfRd1q4q3/boH1ITDXD0tglCzpGX4RG2yjGpYmXKtJs2rK0GC0yCUKJYXitU",
+	"E1/TTdJNplVrfqAI4MX3Z589fPTro88+J9AACl0y3RSSdIMEthG8ebno6n0+rv9ub3kmvQk+oSx+DvZH",
+	"nycrbIo7a5bb6qZKVGv1+5q0ExdA4jhiDuMmZcut9wrHabK1/LG2K7XIg+9YCgUffs/ATSNdyDfIVQkD",
+	"Smq3IhMKvEAa/8SOBZSbJo5BL1E9iOXcrmyCcOldMRsq4GbA5Sq1kCE3eORn8CkEg7J1VTpeZS0929bl",
+	"3mlWQ4dCI3rFgBZLVk6053OSgoig/jxKlugUn6gRjzzbA7O1Pu7pbBcYL5ImPfDZwJewnJPt3L4xFHpG",
+	"neD0sIkJ8cIfyluQ5pB9YjgV7W04SaPa/8Pwj0Ru3YNxjbDcD8Erku+DLWkkz3p+DyGv7CjQ+nlWE+SB",
+	"AAwkUGylvotyf0W15ZS1EqA9wRuQu+LHi8awvDOYCyHxHXaAF2dEbNqF+CMHzicu0vYiICVayrshSmgt",
+	"f1eSRc96w0USbZFTmhjDtGVLiTwcUQZN/XVITDnwKunlr1RSGiIF6EYSeS+tHgfPVEw4GO3rnPc/Ltf4",
+	"littzhAfrHg9HNAYJz+MkWxRqW9XeuU5HTV3ST/A1OIV5tr8O4M9St5zbihnhO/dZqjcoaV1r54HazQT",
+	"5BrHxJ0mDz8nM1c/uVIs57pr3L/2wklICsQUWMdCxrrtyQV3rfNnae5AxnPviUN+jMxbwWbvIGyO6Cdm",
+	"KgMnN0nlKerrkUUCfykeBTUvxhXcvWut3dtl8o5qcuyZyTteGdZMGb08XAdeOrVm/XWOvq1buE1c1M3a",
+	"xqahH12yF6qiz8Zkj0+X14XumL7+IHV296qy+wES11scuTHcvCmK+UnAIeymGNW7o3MkqbGr8yrsZBYN",
+	"esl0MmEXyZRUYX8jcrWpzNC4wRe2olpXS0WBXJ3xxEcvMjsEK8jGWtNarZs0nPb5Gb0vcQYYpRkB5xtK",
+	"OtqMOqCbbmYdWE5/smPyd+tMZQiWm3dwkRU1THFa2oxxNEqN4nxSghc6Klx7W+rwmk7bkhLqfx5KDmMr",
+	"uQ1U4uwcVSjaudPgGtdVhQwKTDDNNVYO/dVViv+4YpaHwGY26nNxC+tdksNbxCTW2po8miqqmDqiWKrr",
+	"lqhwiWHqea242VwA/r1ulf+arL7wXcjV6jLBBzOrE4uMvGTCuwI1mV1r7QWv7yQtUVSx1l/BiJGyPCbf",
+	"2Hqejod+eW/27+zx354Up48f/vvsb6efnebsyWdfnJ7SL57Qh188fsge/e2zJ6fs4fzzL2aPikdPHs2e",
+	"PHry+Wdf5I+fPJw9+fyLf78HVxSAbAH1hXyfTv4rOysXMjt7dZ69AWAbnNCKQ7L0mxtUo8wlLB+RmiPn",
+	"YyvKy8lT/9P/45nvcS5XzfD+V+CyCpovjan005OT6+vr47jLyQJzuWRG1vnyxM9zM+1g/OzVeQjfsC5a",
+	"uKONYeF40pDCGX57/c3FG3L26vx4EqV0mpwenx4/hPFlxQSt+OTp5DH+hKdnift+gtW0TrQrlHsSwvhu",
+	"pr1vVWXL6MInR6Pur6XPSA1/rJhRPPefgFNt3P/1NV0smDrGwB7709WjEy+onrx3Ac03276dxE5DJ++j",
+	"vzJe7OjpnWJ2NTl5j//uHDDWgZ1ECXKT1vDvmHHFFaxaKpF8CY1QIaWBxiq58JPPPoXx6QVDlxGOCaqx",
+	"XKhRtcjx0FETUvxSQ16c/Rf6Urw4+y/yJTmdulgUjW/d1PQ2hUYgtPPCgt13YdVfbc5C8HnjdzF5+ktK",
+	"/+jTutazkufEirB4UIEKo3MURmz4JCqbJ/aeQBtw4PrAyU+zL969/+xvN6nrrHcbByQlc6uh5FZwXZV0",
+	"g0hb0fWXQyhb2zOIa/hnzdSmWcSKricxwH3jeqLGiY8dixO3h/pRjUPrf168/JFIRZxi5RWokX3cnA+U",
+	"bIJD4zhJ6DkEsbtYY6CZqFdwR7kAvJVeVO1yfwHN76YTDyiyk0enp56HusdrdEBP3LmPZupoPPuEBuum",
+	"kQ67n9sEtM80h8xkVEcuNOjQakwqST1EqsQDbNea92d0W5IMUNk3vUr/gWSkoeUO+GyU0gAhO2+wCi7Z",
+	"3ZkReshIQvAuJUbEW+tp5K/d/Z+xu32phFQSzjRHl/3myvHXWQtIJ4uWGw/uQOaoY/LfskbZEV4FtWGB",
+	"BUqF7CxcmFxHc7pEdw2Goqgy/HJ01F340VHjETpn18hkqcCGXXQcHR3DTj3Zk5VtNWG0igaOOjv7DNfb",
+	"rBd0HRzqKRFSZIItbEbfSBfx5PThn3aF58KGMICwbIX6m+nksz/xlp0Lw5SgJcGWdjWP/7SruWDqiueM",
+	"vGGrSiqqeLkhP4kQI2IfPSif9NnfT+JSyGvhEYE571crqjZOiKaB59QiqvK/lf90GU8kaCMXpQuNblIo",
+	"olqZtimKM3l3498AI98e25qdzOR6j6YsfrAMv05sgZOT92h2Gfz9xNnO0x/R/GUfzye+0tJAS5s2Mf2x",
+	"9Sp6b9awkO3DQZtovJyafFlXJ+/xP/gOjlZkS/SemLU4QXfhk/e86H/uIaL9e9M9bnG1kgXzwMn5XDOz",
+	"4/PJe/vvTb+dLVh44h0++hBBAQPF4d6iZfOr66YFrfRSmv4HA1dgvar6X+qqKjf9nzciT/7Yh6ilnhz4",
+	"+cSreFLP9XbLllag99Vqj4e+vm/92ab1ilmn2PjPk5BBsPmgl7Up5HW0EkSeNSz3Vw8fa939++SacgNC",
+	"nqvkgunm+p0NoyVySl6yzq9NReHeFyyTHP3YEQsraTXw7Rf5a3r9phXmrKxK/itZbLZcGOtsxgW16Rgb",
+	"Lt+oVe3H/hPvZprQa6NruXdaSMjQRpKZkrTIqTUVuCx0vbf9zR3fj920NecJkzSCieqSfn5k4IfHO+2U",
+	"OO4YITnaF5eF0izdY0VbdewHFix7EH1FC+JNNhl5QUvYcFaQM/d8aWHjQwuFn16K+8Ri10eTk77yh08T",
+	"ijlhWw9clc4HZZr0o2OEIngFAwNYMJE5FpTNZLHJnGJJ0WuztulnuszthLZvpdY31CfqoY8H0LL+sVWr",
+	"uzSqfyky/1Jk/qXq+kuR+dfu/qXIHKnI/EvN95ea73+lmm8f3V5KzHTqq2FpE2sBU2J67z7aFEwKLL6d",
+	"GI+bIJO1IqSxNhM3xwTc1pRNsKTZFVO0JDnVVrpyGbhW6LiM6fVY8fStyFqQWPdgmPh+81/rl/22Pj19",
+	"zMjpg24fW5034s39vijv4icbOvUleTt5O+mNpNhKhgJeccEO22vnsP9XGPdlr9IPJnjAtFE+Cx/R9XzO",
+	"c25RXkqxIHQhm5gC4NtESPzCFADHgOdqwo2v8ctd4L/dlU5dkbbk3pcAzpst3OkS0SGXtDcEEN6erhD/",
+	"NsYP4n+1lH7bRG13ZaRbx76Z/sVVPgFX+eR85c9uZI5Ui/8jxcwnp0/+tAuKFdE/SkO+hcNwR3HM5cDN",
+	"k2Ujbyto+RxIXt3XOFbHjsp4iwYX5V/ewUWgmbryF2zjd/v05AST4i2lNieTm2n8TXc+vgswv/e3U6X4",
+	"FUBzg9pNqfiCCyjKYh1Xs8a39tHx6eTm/wwAB0o4QUw+AQA=",
 }
 
 // GetSwagger returns the content of the embedded swagger specification file
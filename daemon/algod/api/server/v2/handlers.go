@@ -53,8 +53,11 @@ import (
 	"github.com/algorand/go-algorand/ledger/eval"
 	"github.com/algorand/go-algorand/ledger/ledgercore"
 	"github.com/algorand/go-algorand/ledger/simulation"
+	"github.com/algorand/go-algorand/ledger/store/trackerdb"
 	"github.com/algorand/go-algorand/libgoal/participation"
 	"github.com/algorand/go-algorand/logging"
+	"github.com/algorand/go-algorand/network"
+	"github.com/algorand/go-algorand/network/phonebook"
 	"github.com/algorand/go-algorand/node"
 	"github.com/algorand/go-algorand/protocol"
 	"github.com/algorand/go-algorand/rpcs"
@@ -72,6 +75,9 @@ const MaxTealSourceBytes = 512 * 1024
 // become quite large, so we allow up to 1MB
 const MaxTealDryrunBytes = 1_000_000
 
+// MaxUnlockParticipationKeysBytes sets the maximum size of the body of the participation keys unlock request.
+const MaxUnlockParticipationKeysBytes = 64 * 1024
+
 // MaxAssetResults sets a size limit for the number of assets returned in a single request to the
 // /v2/accounts/{address}/assets endpoint
 const MaxAssetResults = 1000
@@ -120,6 +126,7 @@ type LedgerForAPI interface {
 	AddressTxns(id basics.Address, r basics.Round) ([]transactions.SignedTxnWithAD, error)
 	GetStateDeltaForRound(rnd basics.Round) (ledgercore.StateDelta, error)
 	GetTracer() logic.EvalTracer
+	DumpState(ctx context.Context, w io.Writer) (basics.Round, error)
 }
 
 // NodeInterface represents node fns used by the handlers.
@@ -139,6 +146,8 @@ type NodeInterface interface {
 	Config() config.Local
 	InstallParticipationKey(partKeyBinary []byte) (account.ParticipationID, error)
 	ListParticipationKeys() ([]account.ParticipationRecord, error)
+	ParticipationHealth() ([]node.ParticipationKeyHealth, error)
+	UnlockParticipationKeys(passphrase []byte, initialize bool) error
 	GetParticipationKey(account.ParticipationID) (account.ParticipationRecord, error)
 	RemoveParticipationKey(account.ParticipationID) error
 	AppendParticipationKeys(id account.ParticipationID, keys account.StateProofKeys) error
@@ -147,6 +156,9 @@ type NodeInterface interface {
 	UnsetSyncRound()
 	GetBlockTimeStampOffset() (*int64, error)
 	SetBlockTimeStampOffset(int64) error
+	RollbackLedger(rnd basics.Round) error
+	ExportLedgerSnapshot(ctx context.Context, w io.Writer) error
+	PeerAdmin() network.PeerAdmin
 }
 
 func roundToPtrOrNil(value basics.Round) *uint64 {
@@ -198,6 +210,26 @@ func convertParticipationRecord(record account.ParticipationRecord) model.Partic
 	return participationKey
 }
 
+func convertParticipationKeyHealth(health node.ParticipationKeyHealth) model.ParticipationKeyHealth {
+	return model.ParticipationKeyHealth{
+		Id:                health.Record.ParticipationID.String(),
+		Address:           health.Record.Account.String(),
+		Registered:        health.Registered,
+		IncentiveEligible: health.IncentiveEligible,
+		LastVote:          roundToPtrOrNil(health.Record.LastVote),
+		LastBlockProposal: roundToPtrOrNil(health.Record.LastBlockProposal),
+		LastProposed:      roundToPtrOrNil(health.LastProposed),
+		LastHeartbeat:     roundToPtrOrNil(health.LastHeartbeat),
+		SuspensionChecked: health.SuspensionChecked,
+		AbsentAfter:       roundToPtrOrNil(health.AbsentAfter),
+		Absent:            health.Absent,
+		HeartbeatNeeded:   health.HeartbeatNeeded,
+		HeartbeatPending:  health.HeartbeatPending,
+		ExpectedProposals: health.ExpectedProposals,
+		ActualProposals:   health.ActualProposals,
+	}
+}
+
 // ErrNoStateProofForRound returned when a state proof transaction could not be found
 var ErrNoStateProofForRound = errors.New("no state proof can be found for that round")
 
@@ -264,6 +296,56 @@ func (v2 *Handlers) GetParticipationKeys(ctx echo.Context) error {
 	return ctx.JSON(http.StatusOK, response)
 }
 
+// GetParticipationHealth returns the health of the participation keys of the node.
+// (GET /v2/participation/health)
+func (v2 *Handlers) GetParticipationHealth(ctx echo.Context) error {
+	health, err := v2.Node.ParticipationHealth()
+	if err != nil {
+		return internalError(ctx, err, err.Error(), v2.Log)
+	}
+
+	response := make(model.ParticipationHealthResponse, 0, len(health))
+	for _, h := range health {
+		response = append(response, convertParticipationKeyHealth(h))
+	}
+	return ctx.JSON(http.StatusOK, response)
+}
+
+// UnlockParticipationKeys unlocks the participation keys encrypted at rest.
+// (POST /v2/participation/unlock)
+func (v2 *Handlers) UnlockParticipationKeys(ctx echo.Context) error {
+	req := ctx.Request()
+	buf := new(bytes.Buffer)
+	req.Body = http.MaxBytesReader(nil, req.Body, MaxUnlockParticipationKeysBytes)
+	_, err := buf.ReadFrom(ctx.Request().Body)
+	if err != nil {
+		return badRequest(ctx, err, err.Error(), v2.Log)
+	}
+
+	var request model.UnlockParticipationKeysRequest
+	if buf.Len() != 0 {
+		err = decode(protocol.JSONStrictHandle, buf.Bytes(), &request)
+		if err != nil {
+			return badRequest(ctx, err, err.Error(), v2.Log)
+		}
+	}
+	var passphrase []byte
+	if request.Passphrase != nil {
+		passphrase = []byte(*request.Passphrase)
+	}
+
+	initialize := request.Initialize != nil && *request.Initialize
+
+	err = v2.Node.UnlockParticipationKeys(passphrase, initialize)
+	if err != nil {
+		if errors.Is(err, account.ErrWrongKeyMaterial) || errors.Is(err, account.ErrPassphraseRequired) || errors.Is(err, account.ErrRegistryNotEncrypted) {
+			return badRequest(ctx, err, err.Error(), v2.Log)
+		}
+		return internalError(ctx, err, err.Error(), v2.Log)
+	}
+	return ctx.NoContent(http.StatusOK)
+}
+
 func (v2 *Handlers) generateKeyHandler(address string, params model.GenerateParticipationKeysParams) error {
 	installFunc := func(path string) error {
 		bytes, err := os.ReadFile(path)
@@ -413,6 +495,187 @@ func (v2 *Handlers) ShutdownNode(ctx echo.Context, params model.ShutdownNodePara
 	return ctx.String(http.StatusNotImplemented, "Endpoint not implemented.")
 }
 
+// GetLedgerSnapshot streams a consistent snapshot of the ledger databases as a tar archive.
+// (GET /v2/ledger/snapshot)
+func (v2 *Handlers) GetLedgerSnapshot(ctx echo.Context) error {
+	stat, err := v2.Node.Status()
+	if err != nil {
+		return internalError(ctx, err, errFailedRetrievingNodeStatus, v2.Log)
+	}
+	if stat.Catchpoint != "" {
+		// node is currently catching up to the requested catchpoint.
+		return serviceUnavailable(ctx, fmt.Errorf("GetLedgerSnapshot failed as the node was catchpoint catchuping"), errOperationNotAvailableDuringCatchup, v2.Log)
+	}
+
+	// a snapshot of a large ledger takes far longer than the configured write timeout to transfer
+	err = http.NewResponseController(ctx.Response().Writer).SetWriteDeadline(time.Time{})
+	if err != nil {
+		v2.Log.Debugf("GetLedgerSnapshot unable to clear the write deadline: %v", err)
+	}
+	ctx.Response().Header().Set(echo.HeaderContentType, echo.MIMEOctetStream)
+	err = v2.Node.ExportLedgerSnapshot(ctx.Request().Context(), ctx.Response())
+	if err != nil {
+		if ctx.Response().Committed {
+			// the archive is already partially written; the client would fail to read its trailer.
+			v2.Log.Warnf("GetLedgerSnapshot failed after the response was committed: %v", err)
+			return nil
+		}
+		return internalError(ctx, err, fmt.Sprintf(errFailedToExportLedgerSnapshot, err), v2.Log)
+	}
+	return nil
+}
+
+// GetLedgerStateDump streams a canonical dump of the ledger state.
+// (GET /v2/ledger/statedump)
+func (v2 *Handlers) GetLedgerStateDump(ctx echo.Context) error {
+	stat, err := v2.Node.Status()
+	if err != nil {
+		return internalError(ctx, err, errFailedRetrievingNodeStatus, v2.Log)
+	}
+	if stat.Catchpoint != "" {
+		// node is currently catching up to the requested catchpoint.
+		return serviceUnavailable(ctx, fmt.Errorf("GetLedgerStateDump failed as the node was catchpoint catchuping"), errOperationNotAvailableDuringCatchup, v2.Log)
+	}
+
+	// a dump of a large ledger takes far longer than the configured write timeout to transfer
+	err = http.NewResponseController(ctx.Response().Writer).SetWriteDeadline(time.Time{})
+	if err != nil {
+		v2.Log.Debugf("GetLedgerStateDump unable to clear the write deadline: %v", err)
+	}
+	ctx.Response().Header().Set(echo.HeaderContentType, echo.MIMETextPlainCharsetUTF8)
+	_, err = v2.Node.LedgerForAPI().DumpState(ctx.Request().Context(), ctx.Response())
+	if err != nil {
+		if ctx.Response().Committed {
+			// the dump is already partially written; the client would fail to verify its trailer.
+			v2.Log.Warnf("GetLedgerStateDump failed after the response was committed: %v", err)
+			return nil
+		}
+		if errors.Is(err, trackerdb.ErrNotSupported) {
+			// the node's tracker database cannot produce a dump
+			return serviceUnavailable(ctx, err, fmt.Sprintf(errFailedToDumpLedgerState, err), v2.Log)
+		}
+		return internalError(ctx, err, fmt.Sprintf(errFailedToDumpLedgerState, err), v2.Log)
+	}
+	return nil
+}
+
+// peerAdmin returns the peer management of the node's network, or an error if it has none.
+func (v2 *Handlers) peerAdmin() (network.PeerAdmin, error) {
+	admin := v2.Node.PeerAdmin()
+	if admin == nil {
+		return nil, errors.New(errPeerManagementUnavailable)
+	}
+	return admin, nil
+}
+
+// GetPeers lists the connected peers.
+// (GET /v2/peers)
+func (v2 *Handlers) GetPeers(ctx echo.Context) error {
+	admin, err := v2.peerAdmin()
+	if err != nil {
+		return serviceUnavailable(ctx, err, errPeerManagementUnavailable, v2.Log)
+	}
+	infos := admin.PeersInfo()
+	response := model.PeersResponse{Peers: make([]model.Peer, len(infos))}
+	for i, info := range infos {
+		peer := model.Peer{
+			Address:               info.Address,
+			PeerId:                omitEmpty(info.PeerID),
+			Outgoing:              info.Outgoing,
+			ConnectedAt:           uint64(info.ConnectedAt.Unix()),
+			Version:               info.Version,
+			Features:              info.Features,
+			Priority:              info.Priority,
+			TxnMessages:           info.TxnMessages,
+			MsgOfInterestMessages: info.MsgOfInterestMessages,
+			ProposalMessages:      info.ProposalMessages,
+			VoteMessages:          info.VoteMessages,
+			OtherMessages:         info.OtherMessages,
+			BytesSent:             info.BytesSent,
+			BytesReceived:         info.BytesReceived,
+		}
+		if info.MessageDelay != 0 {
+			delay := uint64(info.MessageDelay.Nanoseconds())
+			peer.MessageDelay = &delay
+		}
+		response.Peers[i] = peer
+	}
+	return ctx.JSON(http.StatusOK, response)
+}
+
+// AddPriorityPeer connects to a relay and keeps it connected for the given duration.
+// (POST /v2/peers)
+func (v2 *Handlers) AddPriorityPeer(ctx echo.Context, params model.AddPriorityPeerParams) error {
+	admin, err := v2.peerAdmin()
+	if err != nil {
+		return serviceUnavailable(ctx, err, errPeerManagementUnavailable, v2.Log)
+	}
+	err = admin.AddPriorityPeer(params.Address, time.Duration(params.Duration)*time.Second)
+	if err != nil {
+		return badRequest(ctx, err, fmt.Sprintf(errFailedToAddPriorityPeer, err), v2.Log)
+	}
+	return ctx.NoContent(http.StatusOK)
+}
+
+// DisconnectPeer disconnects the peers with the given address, and optionally bans it.
+// (DELETE /v2/peers)
+func (v2 *Handlers) DisconnectPeer(ctx echo.Context, params model.DisconnectPeerParams) error {
+	admin, err := v2.peerAdmin()
+	if err != nil {
+		return serviceUnavailable(ctx, err, errPeerManagementUnavailable, v2.Log)
+	}
+	var ban time.Duration
+	if params.Ban != nil {
+		ban = time.Duration(*params.Ban) * time.Second
+	}
+	disconnected := admin.DisconnectPeer(params.Address, ban)
+	return ctx.JSON(http.StatusOK, model.DisconnectPeerResponse{Disconnected: uint64(disconnected)})
+}
+
+// GetPhonebook dumps the phonebook.
+// (GET /v2/peers/phonebook)
+func (v2 *Handlers) GetPhonebook(ctx echo.Context) error {
+	admin, err := v2.peerAdmin()
+	if err != nil {
+		return serviceUnavailable(ctx, err, errPeerManagementUnavailable, v2.Log)
+	}
+	entries := admin.PhonebookEntries()
+	response := model.PhonebookResponse{Entries: make([]model.PhonebookEntry, len(entries))}
+	for i, entry := range entries {
+		e := model.PhonebookEntry{
+			Address:         entry.Address,
+			Roles:           []string{},
+			PersistentRoles: []string{},
+			Networks:        entry.Networks,
+			Reputation:      entry.Reputation,
+		}
+		if e.Networks == nil {
+			e.Networks = []string{}
+		}
+		for _, role := range []struct {
+			role phonebook.Role
+			name string
+		}{{phonebook.RelayRole, "relay"}, {phonebook.ArchivalRole, "archival"}} {
+			if entry.Roles.Has(role.role) {
+				e.Roles = append(e.Roles, role.name)
+			}
+			if entry.Roles.IsPersistent(role.role) {
+				e.PersistentRoles = append(e.PersistentRoles, role.name)
+			}
+		}
+		if !entry.RetryAfter.IsZero() {
+			retryAfter := uint64(entry.RetryAfter.Unix())
+			e.RetryAfter = &retryAfter
+		}
+		if !entry.BannedUntil.IsZero() {
+			bannedUntil := uint64(entry.BannedUntil.Unix())
+			e.BannedUntil = &bannedUntil
+		}
+		response.Entries[i] = e
+	}
+	return ctx.JSON(http.StatusOK, response)
+}
+
 // AccountInformation gets account information for a given account.
 // (GET /v2/accounts/{address})
 func (v2 *Handlers) AccountInformation(ctx echo.Context, address string, params model.AccountInformationParams) error {
@@ -2146,6 +2409,17 @@ func (v2 *Handlers) SetBlockTimeStampOffset(ctx echo.Context, offset uint64) err
 	return ctx.NoContent(http.StatusOK)
 }
 
+// RollbackLedger rewinds the ledger to an earlier round.
+// This is only available in dev mode.
+// (POST /v2/devmode/ledger/rollback/{round})
+func (v2 *Handlers) RollbackLedger(ctx echo.Context, round uint64) error {
+	err := v2.Node.RollbackLedger(basics.Round(round))
+	if err != nil {
+		return badRequest(ctx, err, fmt.Sprintf(errFailedRollingBackLedger, err), v2.Log)
+	}
+	return ctx.NoContent(http.StatusOK)
+}
+
 // savedBlockingRate is the current blocking rate
 var savedBlockingRate atomic.Int32
 
@@ -17,8 +17,10 @@
 package test
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
+	"io"
 	"net/http"
 	"net/http/httptest"
 	"strconv"
@@ -58,6 +60,10 @@ func (l *mockLedger) GetTracer() logic.EvalTracer {
 	return l.tracer
 }
 
+func (l *mockLedger) DumpState(ctx context.Context, w io.Writer) (basics.Round, error) {
+	panic("not implemented")
+}
+
 func (l *mockLedger) GetStateDeltaForRound(rnd basics.Round) (ledgercore.StateDelta, error) {
 	args := l.Called(rnd)
 	return args.Get(0).(ledgercore.StateDelta), args.Error(1)
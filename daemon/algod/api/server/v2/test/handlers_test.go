@@ -36,8 +36,10 @@ import (
 	"golang.org/x/sync/semaphore"
 
 	"github.com/algorand/go-algorand/daemon/algod/api/server"
+	"github.com/algorand/go-algorand/ledger"
 	"github.com/algorand/go-algorand/ledger/eval"
 	"github.com/algorand/go-algorand/ledger/ledgercore"
+	"github.com/algorand/go-algorand/ledger/store/trackerdb"
 
 	"github.com/labstack/echo/v4"
 	"github.com/stretchr/testify/assert"
@@ -65,6 +67,8 @@ import (
 	"github.com/algorand/go-algorand/data/txntest"
 	simulationtesting "github.com/algorand/go-algorand/ledger/simulation/testing"
 	"github.com/algorand/go-algorand/logging"
+	"github.com/algorand/go-algorand/network"
+	"github.com/algorand/go-algorand/network/phonebook"
 	"github.com/algorand/go-algorand/node"
 	"github.com/algorand/go-algorand/protocol"
 	"github.com/algorand/go-algorand/stateproof"
@@ -1495,6 +1499,159 @@ func TestAbortCatchup(t *testing.T) {
 	abortCatchupTest(t, badCatchPoint, 400)
 }
 
+func TestGetLedgerSnapshot(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	mockLedger, _, _, _, releasefunc := testingenv(t, 1, 1, true)
+	defer releasefunc()
+
+	for _, nodeErr := range []error{nil, errors.New("snapshot failed")} {
+		mockNode := makeMockNode(mockLedger, t.Name(), nodeErr, cannedStatusReportGolden, false)
+		handler := v2.Handlers{
+			Node:     mockNode,
+			Log:      logging.Base(),
+			Shutdown: make(chan struct{}),
+		}
+		e := echo.New()
+		req := httptest.NewRequest(http.MethodGet, "/", nil)
+		rec := httptest.NewRecorder()
+		c := e.NewContext(req, rec)
+		err := handler.GetLedgerSnapshot(c)
+		require.NoError(t, err)
+		if nodeErr != nil {
+			require.Equal(t, http.StatusInternalServerError, rec.Code)
+			continue
+		}
+		require.Equal(t, http.StatusOK, rec.Code)
+		require.Equal(t, echo.MIMEOctetStream, rec.Header().Get(echo.HeaderContentType))
+		require.Equal(t, "snapshot", rec.Body.String())
+	}
+}
+
+func TestGetLedgerStateDump(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	handler, c, rec, _, _, releasefunc := setupTestForMethodGet(t, cannedStatusReportGolden)
+	defer releasefunc()
+
+	err := handler.GetLedgerStateDump(c)
+	require.NoError(t, err)
+	require.Equal(t, http.StatusOK, rec.Code)
+	reader, err := ledger.MakeStateDumpReader(bytes.NewReader(rec.Body.Bytes()))
+	require.NoError(t, err)
+	for err == nil {
+		_, err = reader.Next()
+	}
+	require.ErrorIs(t, err, io.EOF)
+}
+
+// unsupportedDumpLedger is a ledger whose tracker database cannot produce a state dump
+type unsupportedDumpLedger struct {
+	v2.LedgerForAPI
+}
+
+func (l unsupportedDumpLedger) DumpState(context.Context, io.Writer) (basics.Round, error) {
+	return 0, fmt.Errorf("state dumps are not supported by this database: %w", trackerdb.ErrNotSupported)
+}
+
+func TestGetLedgerStateDumpNotSupported(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	handler, c, rec, _, _, releasefunc := setupTestForMethodGet(t, cannedStatusReportGolden)
+	defer releasefunc()
+	mn := handler.Node.(*mockNode)
+	mn.ledger = unsupportedDumpLedger{mn.ledger}
+
+	err := handler.GetLedgerStateDump(c)
+	require.NoError(t, err)
+	require.Equal(t, http.StatusServiceUnavailable, rec.Code)
+}
+
+func TestPeerManagement(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	admin := &mockPeerAdmin{
+		peers: []network.PeerInfo{
+			{Address: "r1.algorand.network:4160", Outgoing: true, ConnectedAt: time.Unix(1000, 0), Version: "2.2", MessageDelay: time.Millisecond, Priority: true, TxnMessages: 3, BytesSent: 100, BytesReceived: 200},
+			{Address: "10.0.0.1", ConnectedAt: time.Unix(2000, 0), Version: "2.1", VoteMessages: 5},
+		},
+		entries: []phonebook.Entry{
+			{Address: "r1.algorand.network:4160", Roles: phonebook.MakeRoleSet(phonebook.RelayRole, false), Networks: []string{"testnet"}, RetryAfter: time.Unix(3000, 0)},
+			{Address: "10.0.0.2", BannedUntil: time.Unix(4000, 0)},
+		},
+		priorityPeers: make(map[string]time.Duration),
+		bans:          make(map[string]time.Duration),
+	}
+	handler := v2.Handlers{
+		Node: &mockNode{peerAdmin: admin},
+		Log:  logging.Base(),
+	}
+	newContext := func() (echo.Context, *httptest.ResponseRecorder) {
+		e := echo.New()
+		req := httptest.NewRequest(http.MethodGet, "/", nil)
+		rec := httptest.NewRecorder()
+		return e.NewContext(req, rec), rec
+	}
+
+	c, rec := newContext()
+	require.NoError(t, handler.GetPeers(c))
+	require.Equal(t, http.StatusOK, rec.Code)
+	var peers model.PeersResponse
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &peers))
+	require.Len(t, peers.Peers, 2)
+	require.Equal(t, "r1.algorand.network:4160", peers.Peers[0].Address)
+	require.True(t, peers.Peers[0].Outgoing)
+	require.True(t, peers.Peers[0].Priority)
+	require.Equal(t, uint64(1000), peers.Peers[0].ConnectedAt)
+	require.Equal(t, uint64(time.Millisecond), *peers.Peers[0].MessageDelay)
+	require.Equal(t, uint64(3), peers.Peers[0].TxnMessages)
+	require.Equal(t, uint64(100), peers.Peers[0].BytesSent)
+	require.Equal(t, uint64(200), peers.Peers[0].BytesReceived)
+	require.Nil(t, peers.Peers[0].PeerId)
+	require.Nil(t, peers.Peers[1].MessageDelay)
+	require.Equal(t, uint64(5), peers.Peers[1].VoteMessages)
+
+	c, rec = newContext()
+	require.NoError(t, handler.GetPhonebook(c))
+	require.Equal(t, http.StatusOK, rec.Code)
+	var book model.PhonebookResponse
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &book))
+	require.Len(t, book.Entries, 2)
+	require.Equal(t, []string{"relay"}, book.Entries[0].Roles)
+	require.Empty(t, book.Entries[0].PersistentRoles)
+	require.Equal(t, []string{"testnet"}, book.Entries[0].Networks)
+	require.Equal(t, uint64(3000), *book.Entries[0].RetryAfter)
+	require.Nil(t, book.Entries[0].BannedUntil)
+	require.Empty(t, book.Entries[1].Roles)
+	require.Equal(t, uint64(4000), *book.Entries[1].BannedUntil)
+
+	c, rec = newContext()
+	ban := uint64(60)
+	require.NoError(t, handler.DisconnectPeer(c, model.DisconnectPeerParams{Address: "10.0.0.1", Ban: &ban}))
+	require.Equal(t, http.StatusOK, rec.Code)
+	require.Equal(t, "{\"disconnected\":1}\n", rec.Body.String())
+	require.Equal(t, time.Minute, admin.bans["10.0.0.1"])
+
+	c, rec = newContext()
+	require.NoError(t, handler.AddPriorityPeer(c, model.AddPriorityPeerParams{Address: "r2.algorand.network:4160", Duration: 3600}))
+	require.Equal(t, http.StatusOK, rec.Code)
+	require.Equal(t, time.Hour, admin.priorityPeers["r2.algorand.network:4160"])
+
+	c, rec = newContext()
+	require.NoError(t, handler.AddPriorityPeer(c, model.AddPriorityPeerParams{Duration: 3600}))
+	require.Equal(t, http.StatusBadRequest, rec.Code)
+
+	// a network without peer management
+	handler.Node = &mockNode{}
+	c, rec = newContext()
+	require.NoError(t, handler.GetPeers(c))
+	require.Equal(t, http.StatusServiceUnavailable, rec.Code)
+}
+
 func tealCompileTest(t *testing.T, bytesToUse []byte, expectedCode int,
 	enableDeveloperAPI bool, params model.TealCompileParams,
 	expectedSourcemap *logic.SourceMap,
@@ -2308,6 +2465,33 @@ func TestTimestampOffsetInDevMode(t *testing.T) {
 	require.Equal(t, "{\"message\":\"failed to set timestamp offset on the node: block timestamp offset cannot be larger than max int64 value\"}\n", rec.Body.String())
 }
 
+func TestRollbackLedgerNotInDevMode(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	handler, c, rec, _, _, releasefunc := setupTestForMethodGet(t, cannedStatusReportGolden)
+	defer releasefunc()
+
+	err := handler.RollbackLedger(c, 1)
+	require.NoError(t, err)
+	require.Equal(t, 400, rec.Code)
+	require.Equal(t, "{\"message\":\"failed to roll back the ledger: cannot roll back the ledger when not in dev mode\"}\n", rec.Body.String())
+	require.Nil(t, handler.Node.(*mockNode).rollbackRound)
+}
+
+func TestRollbackLedgerInDevMode(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	handler, c, rec, _, _, releasefunc := setupMockNodeForMethodGet(t, cannedStatusReportGolden, true)
+	defer releasefunc()
+
+	err := handler.RollbackLedger(c, 1)
+	require.NoError(t, err)
+	require.Equal(t, 200, rec.Code)
+	require.Equal(t, basics.Round(1), *handler.Node.(*mockNode).rollbackRound)
+}
+
 func TestDeltasForTxnGroup(t *testing.T) {
 	partitiontest.PartitionTest(t)
 	t.Parallel()
@@ -2611,3 +2795,85 @@ func TestGetConfigEndpoint(t *testing.T) {
 
 	require.Equal(t, handler.Node.Config(), responseConfig)
 }
+
+func TestGetParticipationHealth(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	addr := basics.Address{0x01}
+	handler := v2.Handlers{
+		Node: &mockNode{health: []node.ParticipationKeyHealth{{
+			Record:            account.ParticipationRecord{Account: addr, LastVote: 90},
+			Registered:        true,
+			IncentiveEligible: true,
+			LastProposed:      80,
+			SuspensionChecked: true,
+			AbsentAfter:       120,
+			HeartbeatNeeded:   true,
+			HeartbeatPending:  true,
+			ExpectedProposals: 10,
+			ActualProposals:   7,
+		}}},
+		Log: logging.Base(),
+	}
+	e := echo.New()
+	req := httptest.NewRequest(http.MethodGet, "/", nil)
+	rec := httptest.NewRecorder()
+	c := e.NewContext(req, rec)
+
+	require.NoError(t, handler.GetParticipationHealth(c))
+	require.Equal(t, http.StatusOK, rec.Code)
+	var health model.ParticipationHealthResponse
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &health))
+	require.Len(t, health, 1)
+	require.Equal(t, addr.String(), health[0].Address)
+	require.True(t, health[0].Registered)
+	require.Equal(t, uint64(90), *health[0].LastVote)
+	require.Nil(t, health[0].LastBlockProposal)
+	require.Equal(t, uint64(80), *health[0].LastProposed)
+	require.Nil(t, health[0].LastHeartbeat)
+	require.Equal(t, uint64(120), *health[0].AbsentAfter)
+	require.False(t, health[0].Absent)
+	require.True(t, health[0].HeartbeatNeeded)
+	require.True(t, health[0].HeartbeatPending)
+	require.Equal(t, uint64(10), health[0].ExpectedProposals)
+	require.Equal(t, uint64(7), health[0].ActualProposals)
+}
+
+func TestUnlockParticipationKeys(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	mock := &mockNode{}
+	handler := v2.Handlers{Node: mock, Log: logging.Base()}
+	e := echo.New()
+	unlock := func(body string) int {
+		req := httptest.NewRequest(http.MethodPost, "/", strings.NewReader(body))
+		rec := httptest.NewRecorder()
+		require.NoError(t, handler.UnlockParticipationKeys(e.NewContext(req, rec)))
+		return rec.Code
+	}
+
+	require.Equal(t, http.StatusOK, unlock(`{"passphrase":"secret"}`))
+	require.Equal(t, []byte("secret"), mock.passphrase)
+	require.False(t, mock.initialize)
+
+	// the keys are only encrypted when the request initializes them
+	require.Equal(t, http.StatusOK, unlock(`{"passphrase":"secret","initialize":true}`))
+	require.True(t, mock.initialize)
+	mock.err = account.ErrRegistryNotEncrypted
+	require.Equal(t, http.StatusBadRequest, unlock(`{"passphrase":"secret"}`))
+	mock.err = nil
+
+	// the key material is read from the configured source without a passphrase
+	require.Equal(t, http.StatusOK, unlock(""))
+	require.Nil(t, mock.passphrase)
+
+	require.Equal(t, http.StatusBadRequest, unlock(`{"passphrase":`))
+
+	mock.err = account.ErrWrongKeyMaterial
+	require.Equal(t, http.StatusBadRequest, unlock(`{"passphrase":"wrong"}`))
+
+	mock.err = errors.New("database is closed")
+	require.Equal(t, http.StatusInternalServerError, unlock(`{"passphrase":"secret"}`))
+}
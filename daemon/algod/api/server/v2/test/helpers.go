@@ -17,10 +17,13 @@
 package test
 
 import (
+	"context"
 	"fmt"
+	"io"
 	"math/rand"
 	"strconv"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/mock"
 
@@ -37,6 +40,8 @@ import (
 	"github.com/algorand/go-algorand/data/transactions/logic"
 	"github.com/algorand/go-algorand/ledger/simulation"
 	"github.com/algorand/go-algorand/logging"
+	"github.com/algorand/go-algorand/network"
+	"github.com/algorand/go-algorand/network/phonebook"
 	"github.com/algorand/go-algorand/node"
 	"github.com/algorand/go-algorand/protocol"
 	"github.com/algorand/go-algorand/util/db"
@@ -96,7 +101,12 @@ type mockNode struct {
 	status          node.StatusReport
 	devmode         bool
 	timestampOffset *int64
+	rollbackRound   *basics.Round
 	PartKeyBinary   []byte
+	peerAdmin       network.PeerAdmin
+	health          []node.ParticipationKeyHealth
+	passphrase      []byte
+	initialize      bool
 }
 
 func (m *mockNode) InstallParticipationKey(partKeyBinary []byte) (account.ParticipationID, error) {
@@ -108,6 +118,16 @@ func (m *mockNode) ListParticipationKeys() ([]account.ParticipationRecord, error
 	panic("implement me")
 }
 
+func (m *mockNode) ParticipationHealth() ([]node.ParticipationKeyHealth, error) {
+	return m.health, m.err
+}
+
+func (m *mockNode) UnlockParticipationKeys(passphrase []byte, initialize bool) error {
+	m.passphrase = passphrase
+	m.initialize = initialize
+	return m.err
+}
+
 func (m *mockNode) GetParticipationKey(id account.ParticipationID) (account.ParticipationRecord, error) {
 	panic("implement me")
 }
@@ -204,6 +224,14 @@ func (m *mockNode) AbortCatchup(catchpoint string) error {
 	return m.err
 }
 
+func (m *mockNode) ExportLedgerSnapshot(ctx context.Context, w io.Writer) error {
+	if m.err != nil {
+		return m.err
+	}
+	_, err := w.Write([]byte("snapshot"))
+	return err
+}
+
 func (m *mockNode) SetBlockTimeStampOffset(offset int64) error {
 	if !m.devmode {
 		return fmt.Errorf("cannot set block timestamp when not in dev mode")
@@ -212,6 +240,53 @@ func (m *mockNode) SetBlockTimeStampOffset(offset int64) error {
 	return nil
 }
 
+func (m *mockNode) RollbackLedger(rnd basics.Round) error {
+	if !m.devmode {
+		return fmt.Errorf("cannot roll back the ledger when not in dev mode")
+	}
+	m.rollbackRound = &rnd
+	return m.err
+}
+
+func (m *mockNode) PeerAdmin() network.PeerAdmin {
+	return m.peerAdmin
+}
+
+// mockPeerAdmin records the peer management requests.
+type mockPeerAdmin struct {
+	peers         []network.PeerInfo
+	entries       []phonebook.Entry
+	priorityPeers map[string]time.Duration
+	bans          map[string]time.Duration
+}
+
+func (m *mockPeerAdmin) PeersInfo() []network.PeerInfo {
+	return m.peers
+}
+
+func (m *mockPeerAdmin) DisconnectPeer(address string, banDuration time.Duration) int {
+	m.bans[address] = banDuration
+	disconnected := 0
+	for _, peer := range m.peers {
+		if peer.Address == address {
+			disconnected++
+		}
+	}
+	return disconnected
+}
+
+func (m *mockPeerAdmin) AddPriorityPeer(address string, duration time.Duration) error {
+	if address == "" {
+		return fmt.Errorf("invalid address")
+	}
+	m.priorityPeers[address] = duration
+	return nil
+}
+
+func (m *mockPeerAdmin) PhonebookEntries() []phonebook.Entry {
+	return m.entries
+}
+
 func (m *mockNode) GetBlockTimeStampOffset() (*int64, error) {
 	if !m.devmode {
 		return nil, fmt.Errorf("cannot get block timestamp when not in dev mode")
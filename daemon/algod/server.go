@@ -34,6 +34,7 @@ import (
 	"time"
 
 	"github.com/algorand/go-deadlock"
+	"go.opentelemetry.io/otel/attribute"
 
 	"github.com/algorand/go-algorand/config"
 	apiServer "github.com/algorand/go-algorand/daemon/algod/api/server"
@@ -48,6 +49,7 @@ import (
 	"github.com/algorand/go-algorand/util"
 	"github.com/algorand/go-algorand/util/metrics"
 	"github.com/algorand/go-algorand/util/tokens"
+	"github.com/algorand/go-algorand/util/tracing"
 )
 
 var server http.Server
@@ -74,6 +76,7 @@ type Server struct {
 	node                 ServerNode
 	metricCollector      *metrics.MetricService
 	metricServiceStarted bool
+	tracingShutdown      func(context.Context) error
 	stopping             chan struct{}
 }
 
@@ -257,6 +260,17 @@ func (s *Server) Initialize(cfg config.Local, phonebookAddresses []string, genes
 		"channel": currentVersion.Channel,
 	})
 
+	if cfg.EnableTracing {
+		s.tracingShutdown, err = tracing.Setup(cfg,
+			attribute.String("service.version", currentVersion.String()),
+			attribute.String("algorand.genesis", s.Genesis.ID()),
+			attribute.String("algorand.node", s.RootPath))
+		if err != nil {
+			return fmt.Errorf("Initialize() failed to set up tracing: %w", err)
+		}
+		s.log.Infof("Exporting traces to %s", cfg.TracingEndpoint)
+	}
+
 	var serverNode ServerNode
 	if cfg.EnableFollowMode {
 		var followerNode *node.AlgorandFollowerNode
@@ -476,6 +490,13 @@ func (s *Server) Stop() {
 		s.metricServiceStarted = false
 	}
 
+	if s.tracingShutdown != nil {
+		if err := s.tracingShutdown(context.Background()); err != nil {
+			s.log.Infof("Unable to flush the traces : %v", err)
+		}
+		s.tracingShutdown = nil
+	}
+
 	s.log.CloseTelemetry()
 
 	os.Remove(s.pidFile)
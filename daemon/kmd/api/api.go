@@ -155,6 +155,11 @@ func Handler(sm *session.Manager, log logging.Logger, allowedOrigins []string, a
 	rootRouter.HandleFunc("/versions", versionsHandler)
 	rootRouter.HandleFunc("/swagger.json", SwaggerHandler)
 
+	// The threshold wallets of kmd instances talk to each other with their
+	// own credentials, so their routes are registered before the /v1
+	// subrouter, which requires the API token
+	v1.RegisterThresholdHandlers(rootRouter, reqCB)
+
 	// Handle API V1 routes at /v1/<...>
 	v1Router := rootRouter.PathPrefix(fmt.Sprintf("/%s", apiV1Tag)).Subrouter()
 	v1.RegisterHandlers(v1Router, sm, log, apiToken, reqCB)
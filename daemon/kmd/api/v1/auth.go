@@ -17,9 +17,13 @@
 package v1
 
 import (
+	"context"
 	"crypto/subtle"
 	"net/http"
 
+	"github.com/gorilla/mux"
+
+	"github.com/algorand/go-algorand/daemon/kmd/session"
 	"github.com/algorand/go-algorand/logging"
 	"github.com/algorand/go-algorand/util/tokens"
 )
@@ -29,7 +33,43 @@ const (
 	KMDTokenHeader = "X-KMD-API-Token"
 )
 
-func authMiddleware(log logging.Logger, apiToken string) func(http.Handler) http.Handler {
+// scopedRoutes maps the requests which may be made with a scoped token, as
+// "<method> <path template>", to the scope they require
+var scopedRoutes = map[string]string{
+	"POST /v1/wallet/info":          session.ScopeList,
+	"POST /v1/key/list":             session.ScopeList,
+	"POST /v1/multisig/list":        session.ScopeList,
+	"POST /v1/multisig/export":      session.ScopeList,
+	"POST /v1/transaction/sign":     session.ScopeSign,
+	"POST /v1/program/sign":         session.ScopeSign,
+	"POST /v1/multisig/sign":        session.ScopeSign,
+	"POST /v1/multisig/signprogram": session.ScopeSign,
+	"POST /v1/key":                  session.ScopeManage,
+	"DELETE /v1/key":                session.ScopeManage,
+	"POST /v1/key/import":           session.ScopeManage,
+	"POST /v1/multisig/import":      session.ScopeManage,
+	"DELETE /v1/multisig":           session.ScopeManage,
+}
+
+// scopedTokenKey is the key of the scoped token a request was made with in
+// the request context
+type scopedTokenKey struct{}
+
+// routeScope returns the scope required to make the request with a scoped
+// token, or an empty string if it cannot be made with one
+func routeScope(r *http.Request) string {
+	route := mux.CurrentRoute(r)
+	if route == nil {
+		return ""
+	}
+	path, err := route.GetPathTemplate()
+	if err != nil {
+		return ""
+	}
+	return scopedRoutes[r.Method+" "+path]
+}
+
+func authMiddleware(log logging.Logger, apiToken string, sm *session.Manager) func(http.Handler) http.Handler {
 	// Make sure no one is trying to call us with an invalid token
 	err := tokens.ValidateAPIToken(apiToken)
 	if err != nil {
@@ -51,6 +91,18 @@ func authMiddleware(log logging.Logger, apiToken string) func(http.Handler) http
 				return
 			}
 
+			// Otherwise, check if it is a scoped token allowing the request
+			st, err := sm.AuthWithScopedToken(providedToken)
+			if err == nil {
+				scope := routeScope(r)
+				if scope == "" || !st.HasScope(scope) {
+					errorResponse(w, http.StatusForbidden, errTokenScope)
+					return
+				}
+				next.ServeHTTP(w, r.WithContext(context.WithValue(r.Context(), scopedTokenKey{}, st)))
+				return
+			}
+
 			// Token was incorrect, return an error
 			errorResponse(w, http.StatusUnauthorized, errInvalidAPIToken)
 		})
@@ -0,0 +1,123 @@
+// Copyright (C) 2019-2025 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package v1
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/gorilla/mux"
+	"github.com/stretchr/testify/require"
+
+	"github.com/algorand/go-algorand/crypto"
+	"github.com/algorand/go-algorand/daemon/kmd/config"
+	"github.com/algorand/go-algorand/daemon/kmd/session"
+	"github.com/algorand/go-algorand/daemon/kmd/wallet"
+	"github.com/algorand/go-algorand/logging"
+	"github.com/algorand/go-algorand/test/partitiontest"
+)
+
+const testAPIToken = "330b2e4fc9b20f4f89812cf87f1dabeb716d23e3f11aec97a61ff5f750563b78"
+
+// testWallet holds no keys, and fails the operations it does not implement
+type testWallet struct {
+	wallet.Wallet
+}
+
+func (w testWallet) Init(pw []byte) error {
+	return nil
+}
+
+func (w testWallet) ListKeys() ([]crypto.Digest, error) {
+	return nil, nil
+}
+
+func makeTestRouter(t *testing.T) (*mux.Router, *session.Manager) {
+	sm := session.MakeManager(config.KMDConfig{SessionLifetimeSecs: 60}, nil)
+	t.Cleanup(sm.Kill)
+
+	router := mux.NewRouter()
+	RegisterHandlers(router.PathPrefix("/v1").Subrouter(), sm, logging.TestingLog(t), testAPIToken, func() {})
+	return router, sm
+}
+
+func testRequest(router *mux.Router, method string, path string, token string) int {
+	r := httptest.NewRequest(method, path, strings.NewReader("{}"))
+	r.Header.Set(KMDTokenHeader, token)
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, r)
+	return w.Code
+}
+
+func TestScopedRoutes(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	router, _ := makeTestRouter(t)
+
+	// every scoped route is registered, and requires a known scope
+	for route, scope := range scopedRoutes {
+		method, path, ok := strings.Cut(route, " ")
+		require.True(t, ok, route)
+		var match mux.RouteMatch
+		require.True(t, router.Match(httptest.NewRequest(method, path, nil), &match), route)
+		require.Contains(t, []string{session.ScopeList, session.ScopeSign, session.ScopeManage}, scope, route)
+	}
+
+	// the requests revealing secrets, or managing the wallets and tokens, cannot be made with a scoped token
+	for _, route := range []string{
+		"POST /v1/key/export",
+		"POST /v1/master-key/export",
+		"POST /v1/wallet/backup",
+		"POST /v1/wallet",
+		"POST /v1/wallet/init",
+		"POST /v1/wallet/rename",
+		"POST /v1/token",
+		"DELETE /v1/token",
+	} {
+		require.NotContains(t, scopedRoutes, route)
+	}
+}
+
+func TestAuthMiddlewareScopedToken(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	router, sm := makeTestRouter(t)
+
+	allScopes, _, err := sm.CreateScopedToken(testWallet{}, []byte("id"), nil, []string{session.ScopeList, session.ScopeSign, session.ScopeManage}, 0)
+	require.NoError(t, err)
+	listScope, _, err := sm.CreateScopedToken(testWallet{}, []byte("id"), nil, []string{session.ScopeList}, 0)
+	require.NoError(t, err)
+
+	// a scoped token is refused on the requests outside of its scopes
+	require.Equal(t, http.StatusForbidden, testRequest(router, "POST", "/v1/key/export", string(allScopes)))
+	require.Equal(t, http.StatusForbidden, testRequest(router, "POST", "/v1/master-key/export", string(allScopes)))
+	require.Equal(t, http.StatusForbidden, testRequest(router, "POST", "/v1/token", string(allScopes)))
+	require.Equal(t, http.StatusForbidden, testRequest(router, "POST", "/v1/transaction/sign", string(listScope)))
+	require.Equal(t, http.StatusForbidden, testRequest(router, "DELETE", "/v1/key", string(listScope)))
+
+	// and allowed on the requests within its scopes, on the wallet of the token
+	require.Equal(t, http.StatusOK, testRequest(router, "POST", "/v1/key/list", string(listScope)))
+
+	// the API token is allowed on every request, and any other token is refused
+	require.Equal(t, http.StatusBadRequest, testRequest(router, "POST", "/v1/key/export", testAPIToken))
+	require.Equal(t, http.StatusUnauthorized, testRequest(router, "POST", "/v1/key/list", "bad"))
+	require.Equal(t, http.StatusUnauthorized, testRequest(router, "POST", "/v1/key/list", ""))
+}
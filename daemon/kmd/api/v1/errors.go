@@ -26,3 +26,4 @@ var errCouldNotDecode = fmt.Errorf("could not decode request body")
 var errCouldNotDecodeAddress = fmt.Errorf("could not decode address")
 var errCouldNotDecodeTx = fmt.Errorf("could not decode transaction")
 var errInvalidAPIToken = fmt.Errorf("invalid API token")
+var errTokenScope = fmt.Errorf("API token scope does not allow this request")
@@ -18,6 +18,7 @@ package v1
 
 import (
 	"net/http"
+	"time"
 
 	"github.com/gorilla/mux"
 
@@ -38,6 +39,16 @@ type reqContext struct {
 	sm *session.Manager
 }
 
+// authWallet returns the wallet of the scoped API token the request was made
+// with, if any, or else the wallet of the passed wallet handle token, along
+// with the seconds until the token expires
+func authWallet(ctx reqContext, r *http.Request, walletHandleToken string) (wallet.Wallet, int64, error) {
+	if st, ok := r.Context().Value(scopedTokenKey{}).(*session.ScopedToken); ok {
+		return st.Wallet(), st.ExpiresSeconds(), nil
+	}
+	return ctx.sm.AuthWithWalletHandleToken([]byte(walletHandleToken))
+}
+
 // errorResponse sets the specified status code (should != 200), and fills in the
 // the response envelope by setting Error to true and a Message to the passed
 // user-readable error message.
@@ -73,6 +84,15 @@ func encodeAddresses(addrs []crypto.Digest) (userAddrs []string) {
 
 // apiWalletFromMetadata is a helper to convert our internal wallet metadata
 // format into the APIV1 representation of a wallet
+func apiTokenFromScopedToken(st session.ScopedToken) kmdapi.APIV1Token {
+	return kmdapi.APIV1Token{
+		ID:       st.ID,
+		WalletID: string(st.WalletID),
+		Scopes:   st.Scopes,
+		Expires:  st.Expires.Unix(),
+	}
+}
+
 func apiWalletFromMetadata(metadata wallet.Metadata) kmdapi.APIV1Wallet {
 	return kmdapi.APIV1Wallet{
 		ID:                    string(metadata.ID),
@@ -171,6 +191,15 @@ func postWalletHandler(ctx reqContext, w http.ResponseWriter, r *http.Request) {
 		walletName = walletID
 	}
 
+	// Configured wallets keep the ID derived from their name
+	if configuredDriver, ok := walletDriver.(driver.ConfiguredWalletDriver); ok {
+		walletID, err = configuredDriver.ConfiguredWalletID(walletName)
+		if err != nil {
+			errorResponse(w, http.StatusBadRequest, err)
+			return
+		}
+	}
+
 	// Create the wallet via its driver
 	err = walletDriver.CreateWallet(walletName, walletID, []byte(req.WalletPassword), req.MasterDerivationKey)
 	if err != nil {
@@ -285,7 +314,7 @@ func postWalletInfoHandler(ctx reqContext, w http.ResponseWriter, r *http.Reques
 	}
 
 	// Fetch the wallet from the WalletHandleToken
-	wallet, expiresSeconds, err := ctx.sm.AuthWithWalletHandleToken([]byte(req.WalletHandleToken))
+	wallet, expiresSeconds, err := authWallet(ctx, r, req.WalletHandleToken)
 	if err != nil {
 		errorResponse(w, http.StatusUnauthorized, err)
 		return
@@ -342,7 +371,7 @@ func postMasterKeyExportHandler(ctx reqContext, w http.ResponseWriter, r *http.R
 	}
 
 	// Fetch the wallet from the WalletHandleToken
-	wallet, _, err := ctx.sm.AuthWithWalletHandleToken([]byte(req.WalletHandleToken))
+	wallet, _, err := authWallet(ctx, r, req.WalletHandleToken)
 	if err != nil {
 		errorResponse(w, http.StatusUnauthorized, err)
 		return
@@ -364,6 +393,126 @@ func postMasterKeyExportHandler(ctx reqContext, w http.ResponseWriter, r *http.R
 	successResponse(w, resp)
 }
 
+// postWalletBackupHandler handles `POST /v1/wallet/backup`
+func postWalletBackupHandler(ctx reqContext, w http.ResponseWriter, r *http.Request) {
+	// swagger:operation POST /v1/wallet/backup BackupWallet
+	//---
+	//    Summary: Back up a wallet
+	//    Description: >
+	//      Export the full contents of the wallet (its master derivation key, derived and imported
+	//      keys, and multisig preimages) as an archive encrypted with the backup password, which
+	//      may be restored with `POST /v1/wallet/restore`.
+	//    Produces:
+	//    - application/json
+	//    Parameters:
+	//      - name: Backup Wallet Request
+	//        in: body
+	//        required: true
+	//        schema:
+	//          "$ref": "#/definitions/BackupWalletRequest"
+	//    Responses:
+	//      "200":
+	//        "$ref": "#/responses/BackupWalletResponse"
+	var req kmdapi.APIV1POSTWalletBackupRequest
+
+	// Decode the request
+	decoder := protocol.NewJSONDecoder(r.Body)
+	err := decoder.Decode(&req)
+	if err != nil {
+		errorResponse(w, http.StatusBadRequest, errCouldNotDecode)
+		return
+	}
+
+	// Fetch the wallet from the WalletHandleToken
+	wallet, _, err := authWallet(ctx, r, req.WalletHandleToken)
+	if err != nil {
+		errorResponse(w, http.StatusUnauthorized, err)
+		return
+	}
+
+	// Back up the wallet
+	backup, err := wallet.ExportBackup([]byte(req.WalletPassword), []byte(req.BackupPassword))
+	if err != nil {
+		errorResponse(w, http.StatusBadRequest, err)
+		return
+	}
+
+	// Build the response
+	resp := kmdapi.APIV1POSTWalletBackupResponse{
+		Backup: backup,
+	}
+
+	// Return and encode the response
+	successResponse(w, resp)
+}
+
+// postWalletRestoreHandler handles `POST /v1/wallet/restore`
+func postWalletRestoreHandler(ctx reqContext, w http.ResponseWriter, r *http.Request) {
+	// swagger:operation POST /v1/wallet/restore RestoreWallet
+	//---
+	//    Summary: Restore a wallet from a backup
+	//    Description: >
+	//      Create a new wallet from an archive made by `POST /v1/wallet/backup`, after checking its
+	//      integrity. The wallet gets a new ID, and the name of the backed up wallet if no name is
+	//      given.
+	//    Produces:
+	//    - application/json
+	//    Parameters:
+	//      - name: Restore Wallet Request
+	//        in: body
+	//        required: true
+	//        schema:
+	//          "$ref": "#/definitions/RestoreWalletRequest"
+	//    Responses:
+	//      "200":
+	//        "$ref": "#/responses/RestoreWalletResponse"
+	var req kmdapi.APIV1POSTWalletRestoreRequest
+
+	// Decode the request
+	decoder := protocol.NewJSONDecoder(r.Body)
+	err := decoder.Decode(&req)
+	if err != nil {
+		errorResponse(w, http.StatusBadRequest, errCouldNotDecode)
+		return
+	}
+
+	// Generate a wallet ID
+	walletID, err := wallet.GenerateWalletID()
+	if err != nil {
+		errorResponse(w, http.StatusInternalServerError, err)
+		return
+	}
+
+	// Restore the wallet via the driver which made the backup
+	err = driver.RestoreWalletBackup([]byte(req.WalletName), walletID, []byte(req.WalletPassword), req.Backup, []byte(req.BackupPassword))
+	if err != nil {
+		errorResponse(w, http.StatusBadRequest, err)
+		return
+	}
+
+	// Fetch the wallet
+	wallet, err := driver.FetchWalletByID(walletID)
+	if err != nil {
+		errorResponse(w, http.StatusInternalServerError, err)
+		return
+	}
+
+	// Fetch metadata about the wallet we just restored
+	metadata, err := wallet.Metadata()
+	if err != nil {
+		errorResponse(w, http.StatusInternalServerError, err)
+		return
+	}
+
+	// Build the response
+	resp := kmdapi.APIV1POSTWalletRestoreResponse{
+		Wallet: apiWalletFromMetadata(metadata),
+	}
+
+	// Return and encode the response
+	successResponse(w, resp)
+}
+
 // postWalletReleaseHandler handles `POST /v1/wallet/release`
 func postWalletReleaseHandler(ctx reqContext, w http.ResponseWriter, r *http.Request) {
 	// swagger:operation POST /v1/wallet/release ReleaseWalletHandleToken
@@ -560,7 +709,7 @@ func postKeyImportHandler(ctx reqContext, w http.ResponseWriter, r *http.Request
 	}
 
 	// Fetch the wallet from the WalletHandleToken
-	wallet, _, err := ctx.sm.AuthWithWalletHandleToken([]byte(req.WalletHandleToken))
+	wallet, _, err := authWallet(ctx, r, req.WalletHandleToken)
 	if err != nil {
 		errorResponse(w, http.StatusUnauthorized, err)
 		return
@@ -617,7 +766,7 @@ func postKeyExportHandler(ctx reqContext, w http.ResponseWriter, r *http.Request
 	}
 
 	// Fetch the wallet from the WalletHandleToken
-	wallet, _, err := ctx.sm.AuthWithWalletHandleToken([]byte(req.WalletHandleToken))
+	wallet, _, err := authWallet(ctx, r, req.WalletHandleToken)
 	if err != nil {
 		errorResponse(w, http.StatusUnauthorized, err)
 		return
@@ -669,7 +818,7 @@ func postKeyHandler(ctx reqContext, w http.ResponseWriter, r *http.Request) {
 	}
 
 	// Fetch the wallet from the WalletHandleToken
-	wallet, _, err := ctx.sm.AuthWithWalletHandleToken([]byte(req.WalletHandleToken))
+	wallet, _, err := authWallet(ctx, r, req.WalletHandleToken)
 	if err != nil {
 		errorResponse(w, http.StatusUnauthorized, err)
 		return
@@ -726,7 +875,7 @@ func deleteKeyHandler(ctx reqContext, w http.ResponseWriter, r *http.Request) {
 	}
 
 	// Fetch the wallet from the WalletHandleToken
-	wallet, _, err := ctx.sm.AuthWithWalletHandleToken([]byte(req.WalletHandleToken))
+	wallet, _, err := authWallet(ctx, r, req.WalletHandleToken)
 	if err != nil {
 		errorResponse(w, http.StatusUnauthorized, err)
 		return
@@ -774,7 +923,7 @@ func postKeyListHandler(ctx reqContext, w http.ResponseWriter, r *http.Request)
 	}
 
 	// Fetch the wallet from the WalletHandleToken
-	wallet, _, err := ctx.sm.AuthWithWalletHandleToken([]byte(req.WalletHandleToken))
+	wallet, _, err := authWallet(ctx, r, req.WalletHandleToken)
 	if err != nil {
 		errorResponse(w, http.StatusUnauthorized, err)
 		return
@@ -826,7 +975,7 @@ func postTransactionSignHandler(ctx reqContext, w http.ResponseWriter, r *http.R
 	}
 
 	// Fetch the wallet from the WalletHandleToken
-	wallet, _, err := ctx.sm.AuthWithWalletHandleToken([]byte(req.WalletHandleToken))
+	wallet, _, err := authWallet(ctx, r, req.WalletHandleToken)
 	if err != nil {
 		errorResponse(w, http.StatusUnauthorized, err)
 		return
@@ -888,7 +1037,7 @@ func postProgramSignHandler(ctx reqContext, w http.ResponseWriter, r *http.Reque
 	}
 
 	// Fetch the wallet from the WalletHandleToken
-	wallet, _, err := ctx.sm.AuthWithWalletHandleToken([]byte(req.WalletHandleToken))
+	wallet, _, err := authWallet(ctx, r, req.WalletHandleToken)
 	if err != nil {
 		errorResponse(w, http.StatusUnauthorized, err)
 		return
@@ -944,7 +1093,7 @@ func postMultisigListHandler(ctx reqContext, w http.ResponseWriter, r *http.Requ
 	}
 
 	// Fetch the wallet from the WalletHandleToken
-	wallet, _, err := ctx.sm.AuthWithWalletHandleToken([]byte(req.WalletHandleToken))
+	wallet, _, err := authWallet(ctx, r, req.WalletHandleToken)
 	if err != nil {
 		errorResponse(w, http.StatusUnauthorized, err)
 		return
@@ -996,7 +1145,7 @@ func postMultisigImportHandler(ctx reqContext, w http.ResponseWriter, r *http.Re
 	}
 
 	// Fetch the wallet from the WalletHandleToken
-	wallet, _, err := ctx.sm.AuthWithWalletHandleToken([]byte(req.WalletHandleToken))
+	wallet, _, err := authWallet(ctx, r, req.WalletHandleToken)
 	if err != nil {
 		errorResponse(w, http.StatusUnauthorized, err)
 		return
@@ -1056,7 +1205,7 @@ func postMultisigExportHandler(ctx reqContext, w http.ResponseWriter, r *http.Re
 	}
 
 	// Fetch the wallet from the WalletHandleToken
-	wallet, _, err := ctx.sm.AuthWithWalletHandleToken([]byte(req.WalletHandleToken))
+	wallet, _, err := authWallet(ctx, r, req.WalletHandleToken)
 	if err != nil {
 		errorResponse(w, http.StatusUnauthorized, err)
 		return
@@ -1110,7 +1259,7 @@ func postMultisigTransactionSignHandler(ctx reqContext, w http.ResponseWriter, r
 	}
 
 	// Fetch the wallet from the WalletHandleToken
-	wallet, _, err := ctx.sm.AuthWithWalletHandleToken([]byte(req.WalletHandleToken))
+	wallet, _, err := authWallet(ctx, r, req.WalletHandleToken)
 	if err != nil {
 		errorResponse(w, http.StatusUnauthorized, err)
 		return
@@ -1172,7 +1321,7 @@ func postMultisigProgramSignHandler(ctx reqContext, w http.ResponseWriter, r *ht
 	}
 
 	// Fetch the wallet from the WalletHandleToken
-	wallet, _, err := ctx.sm.AuthWithWalletHandleToken([]byte(req.WalletHandleToken))
+	wallet, _, err := authWallet(ctx, r, req.WalletHandleToken)
 	if err != nil {
 		errorResponse(w, http.StatusUnauthorized, err)
 		return
@@ -1237,7 +1386,7 @@ func deleteMultisigHandler(ctx reqContext, w http.ResponseWriter, r *http.Reques
 	}
 
 	// Fetch the wallet from the WalletHandleToken
-	wallet, _, err := ctx.sm.AuthWithWalletHandleToken([]byte(req.WalletHandleToken))
+	wallet, _, err := authWallet(ctx, r, req.WalletHandleToken)
 	if err != nil {
 		errorResponse(w, http.StatusUnauthorized, err)
 		return
@@ -1257,6 +1406,146 @@ func deleteMultisigHandler(ctx reqContext, w http.ResponseWriter, r *http.Reques
 	successResponse(w, resp)
 }
 
+// postTokenHandler handles `POST /v1/token`
+func postTokenHandler(ctx reqContext, w http.ResponseWriter, r *http.Request) {
+	// swagger:operation POST /v1/token CreateToken
+	//---
+	//    Summary: Create a scoped API token
+	//    Description: >
+	//      Unlock the wallet and return an API token which can be used in place of the kmd API token
+	//      to call the operations of its scopes on the wallet, without a wallet handle token nor the
+	//      wallet password. Scope `list` allows listing keys and multisig addresses, `sign` allows
+	//      signing transactions and programs, and `manage` allows adding and deleting keys and multisig
+	//      addresses. Scoped tokens expire after `lifetime_secs`, one day by default, and do not survive
+	//      a restart of kmd.
+	//    Produces:
+	//    - application/json
+	//    Parameters:
+	//      - name: Create Token Request
+	//        in: body
+	//        required: true
+	//        schema:
+	//          "$ref": "#/definitions/CreateTokenRequest"
+	//    Responses:
+	//      "200":
+	//        "$ref": "#/responses/CreateTokenResponse"
+	var req kmdapi.APIV1POSTTokenRequest
+
+	// Decode the request
+	decoder := protocol.NewJSONDecoder(r.Body)
+	err := decoder.Decode(&req)
+	if err != nil {
+		errorResponse(w, http.StatusBadRequest, errCouldNotDecode)
+		return
+	}
+
+	// Fetch the wallet
+	wallet, err := driver.FetchWalletByID([]byte(req.WalletID))
+	if err != nil {
+		errorResponse(w, http.StatusBadRequest, err)
+		return
+	}
+
+	// Attempt to auth and create the token
+	lifetime := time.Duration(req.LifetimeSecs) * time.Second
+	token, st, err := ctx.sm.CreateScopedToken(wallet, []byte(req.WalletID), []byte(req.WalletPassword), req.Scopes, lifetime)
+	if err != nil {
+		errorResponse(w, http.StatusUnauthorized, err)
+		return
+	}
+
+	// Build the response
+	resp := kmdapi.APIV1POSTTokenResponse{
+		Token:     string(token),
+		TokenInfo: apiTokenFromScopedToken(st),
+	}
+
+	// Return and encode the response
+	successResponse(w, resp)
+}
+
+// postTokenListHandler handles `POST /v1/token/list`
+func postTokenListHandler(ctx reqContext, w http.ResponseWriter, r *http.Request) {
+	// swagger:operation POST /v1/token/list ListTokens
+	//---
+	//    Summary: List scoped API tokens
+	//    Description: >
+	//      Lists the scoped API tokens which have not expired, without their secrets.
+	//    Produces:
+	//    - application/json
+	//    Parameters:
+	//      - name: List Tokens Request
+	//        in: body
+	//        required: true
+	//        schema:
+	//          "$ref": "#/definitions/ListTokensRequest"
+	//    Responses:
+	//      "200":
+	//        "$ref": "#/responses/ListTokensResponse"
+	var req kmdapi.APIV1POSTTokenListRequest
+
+	// Decode the request
+	decoder := protocol.NewJSONDecoder(r.Body)
+	err := decoder.Decode(&req)
+	if err != nil {
+		errorResponse(w, http.StatusBadRequest, errCouldNotDecode)
+		return
+	}
+
+	// Build the response
+	resp := kmdapi.APIV1POSTTokenListResponse{
+		Tokens: []kmdapi.APIV1Token{},
+	}
+	for _, st := range ctx.sm.ListScopedTokens() {
+		resp.Tokens = append(resp.Tokens, apiTokenFromScopedToken(st))
+	}
+
+	// Return and encode the response
+	successResponse(w, resp)
+}
+
+// deleteTokenHandler handles `DELETE /v1/token`
+func deleteTokenHandler(ctx reqContext, w http.ResponseWriter, r *http.Request) {
+	// swagger:operation DELETE /v1/token RevokeToken
+	//---
+	//    Summary: Revoke a scoped API token
+	//    Description: >
+	//      Revokes the scoped API token with the passed ID.
+	//    Produces:
+	//    - application/json
+	//    Parameters:
+	//      - name: Revoke Token Request
+	//        in: body
+	//        required: true
+	//        schema:
+	//          "$ref": "#/definitions/RevokeTokenRequest"
+	//    Responses:
+	//      "200":
+	//        "$ref": "#/responses/RevokeTokenResponse"
+	var req kmdapi.APIV1DELETETokenRequest
+
+	// Decode the request
+	decoder := protocol.NewJSONDecoder(r.Body)
+	err := decoder.Decode(&req)
+	if err != nil {
+		errorResponse(w, http.StatusBadRequest, errCouldNotDecode)
+		return
+	}
+
+	// Revoke the token
+	err = ctx.sm.RevokeScopedToken(req.TokenID)
+	if err != nil {
+		errorResponse(w, http.StatusBadRequest, err)
+		return
+	}
+
+	// Build the response
+	resp := kmdapi.APIV1DELETETokenResponse{}
+
+	// Return and encode the response
+	successResponse(w, resp)
+}
+
 // wrapCtx is used to pass common context to each request without using any
 // global variables.
 func wrapCtx(ctx reqContext, handler func(reqContext, http.ResponseWriter, *http.Request)) func(http.ResponseWriter, *http.Request) {
@@ -1281,8 +1570,9 @@ func reqCallbackMiddleware(reqCB func()) func(http.Handler) http.Handler {
 
 // RegisterHandlers sets up the API handlers on the passed router
 func RegisterHandlers(router *mux.Router, sm *session.Manager, log logging.Logger, apiToken string, reqCB func()) {
-	// All /v1 requests require a valid auth token
-	router.Use(authMiddleware(log, apiToken))
+	// All /v1 requests require a valid auth token, or a scoped token for the
+	// requests in scopedRoutes
+	router.Use(authMiddleware(log, apiToken, sm))
 
 	// reqCB gets called each time a request matches a route
 	router.Use(reqCallbackMiddleware(reqCB))
@@ -1299,6 +1589,8 @@ func RegisterHandlers(router *mux.Router, sm *session.Manager, log logging.Logge
 	router.HandleFunc("/wallet/renew", wrapCtx(ctx, postWalletRenewHandler)).Methods("POST")
 	router.HandleFunc("/wallet/rename", wrapCtx(ctx, postWalletRenameHandler)).Methods("POST")
 	router.HandleFunc("/wallet/info", wrapCtx(ctx, postWalletInfoHandler)).Methods("POST")
+	router.HandleFunc("/wallet/backup", wrapCtx(ctx, postWalletBackupHandler)).Methods("POST")
+	router.HandleFunc("/wallet/restore", wrapCtx(ctx, postWalletRestoreHandler)).Methods("POST")
 	router.HandleFunc("/master-key/export", wrapCtx(ctx, postMasterKeyExportHandler)).Methods("POST")
 
 	router.HandleFunc("/key/list", wrapCtx(ctx, postKeyListHandler)).Methods("POST")
@@ -1316,4 +1608,8 @@ func RegisterHandlers(router *mux.Router, sm *session.Manager, log logging.Logge
 
 	router.HandleFunc("/transaction/sign", wrapCtx(ctx, postTransactionSignHandler)).Methods("POST")
 	router.HandleFunc("/program/sign", wrapCtx(ctx, postProgramSignHandler)).Methods("POST")
+
+	router.HandleFunc("/token", wrapCtx(ctx, postTokenHandler)).Methods("POST")
+	router.HandleFunc("/token/list", wrapCtx(ctx, postTokenListHandler)).Methods("POST")
+	router.HandleFunc("/token", wrapCtx(ctx, deleteTokenHandler)).Methods("DELETE")
 }
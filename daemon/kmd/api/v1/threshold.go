@@ -0,0 +1,42 @@
+// Copyright (C) 2019-2025 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package v1
+
+import (
+	"github.com/gorilla/mux"
+
+	"github.com/algorand/go-algorand/daemon/kmd/threshold"
+	"github.com/algorand/go-algorand/daemon/kmd/wallet/driver"
+)
+
+// RegisterThresholdHandlers sets up the handlers of the threshold protocol on
+// the passed router. They must be registered outside of the /v1 subrouter,
+// since they are authenticated by the participants of the threshold wallets
+// instead of the API token.
+func RegisterThresholdHandlers(router *mux.Router, reqCB func()) {
+	handler := reqCallbackMiddleware(reqCB)(driver.ThresholdPeerHandler())
+	for _, path := range []string{
+		threshold.DKGCommitPath,
+		threshold.DKGSharesPath,
+		threshold.DKGDeliverPath,
+		threshold.DKGFinishPath,
+		threshold.SignCommitPath,
+		threshold.SignSharePath,
+	} {
+		router.Handle(path, handler).Methods("POST")
+	}
+}
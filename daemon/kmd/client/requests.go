@@ -96,6 +96,12 @@ func getPathAndMethod(req kmdapi.APIV1Request) (reqPath string, reqMethod string
 	case kmdapi.APIV1POSTWalletRenameRequest:
 		reqPath = "v1/wallet/rename"
 		reqMethod = "POST"
+	case kmdapi.APIV1POSTWalletBackupRequest:
+		reqPath = "v1/wallet/backup"
+		reqMethod = "POST"
+	case kmdapi.APIV1POSTWalletRestoreRequest:
+		reqPath = "v1/wallet/restore"
+		reqMethod = "POST"
 	case kmdapi.APIV1POSTWalletInfoRequest:
 		reqPath = "v1/wallet/info"
 		reqMethod = "POST"
@@ -141,6 +147,15 @@ func getPathAndMethod(req kmdapi.APIV1Request) (reqPath string, reqMethod string
 	case kmdapi.APIV1DELETEMultisigRequest:
 		reqPath = "v1/multisig"
 		reqMethod = "DELETE"
+	case kmdapi.APIV1POSTTokenRequest:
+		reqPath = "v1/token"
+		reqMethod = "POST"
+	case kmdapi.APIV1POSTTokenListRequest:
+		reqPath = "v1/token/list"
+		reqMethod = "POST"
+	case kmdapi.APIV1DELETETokenRequest:
+		reqPath = "v1/token"
+		reqMethod = "DELETE"
 	}
 	return
 }
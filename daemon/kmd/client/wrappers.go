@@ -208,6 +208,29 @@ func (kcl KMDClient) ExportMasterDerivationKey(walletHandle []byte, walletPasswo
 	return
 }
 
+// BackupWallet wraps kmdapi.APIV1POSTWalletBackupRequest
+func (kcl KMDClient) BackupWallet(walletHandle []byte, walletPassword []byte, backupPassword []byte) (resp kmdapi.APIV1POSTWalletBackupResponse, err error) {
+	req := kmdapi.APIV1POSTWalletBackupRequest{
+		WalletHandleToken: string(walletHandle),
+		WalletPassword:    string(walletPassword),
+		BackupPassword:    string(backupPassword),
+	}
+	err = kcl.DoV1Request(req, &resp)
+	return
+}
+
+// RestoreWallet wraps kmdapi.APIV1POSTWalletRestoreRequest
+func (kcl KMDClient) RestoreWallet(walletName []byte, walletPassword []byte, backup []byte, backupPassword []byte) (resp kmdapi.APIV1POSTWalletRestoreResponse, err error) {
+	req := kmdapi.APIV1POSTWalletRestoreRequest{
+		WalletName:     string(walletName),
+		WalletPassword: string(walletPassword),
+		Backup:         backup,
+		BackupPassword: string(backupPassword),
+	}
+	err = kcl.DoV1Request(req, &resp)
+	return
+}
+
 // SignTransaction wraps kmdapi.APIV1POSTTransactionSignRequest
 func (kcl KMDClient) SignTransaction(walletHandle, pw []byte, pk crypto.PublicKey, tx transactions.Transaction) (resp kmdapi.APIV1POSTTransactionSignResponse, err error) {
 	txBytes := protocol.Encode(&tx)
@@ -232,3 +255,31 @@ func (kcl KMDClient) SignProgram(walletHandle, pw []byte, addr string, data []by
 	err = kcl.DoV1Request(req, &resp)
 	return
 }
+
+// CreateToken wraps kmdapi.APIV1POSTTokenRequest
+func (kcl KMDClient) CreateToken(walletID []byte, walletPassword []byte, scopes []string, lifetimeSecs uint64) (resp kmdapi.APIV1POSTTokenResponse, err error) {
+	req := kmdapi.APIV1POSTTokenRequest{
+		WalletID:       string(walletID),
+		WalletPassword: string(walletPassword),
+		Scopes:         scopes,
+		LifetimeSecs:   lifetimeSecs,
+	}
+	err = kcl.DoV1Request(req, &resp)
+	return
+}
+
+// ListTokens wraps kmdapi.APIV1POSTTokenListRequest
+func (kcl KMDClient) ListTokens() (resp kmdapi.APIV1POSTTokenListResponse, err error) {
+	req := kmdapi.APIV1POSTTokenListRequest{}
+	err = kcl.DoV1Request(req, &resp)
+	return
+}
+
+// RevokeToken wraps kmdapi.APIV1DELETETokenRequest
+func (kcl KMDClient) RevokeToken(tokenID string) (resp kmdapi.APIV1DELETETokenResponse, err error) {
+	req := kmdapi.APIV1DELETETokenRequest{
+		TokenID: tokenID,
+	}
+	err = kcl.DoV1Request(req, &resp)
+	return
+}
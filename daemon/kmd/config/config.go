@@ -41,12 +41,18 @@ type KMDConfig struct {
 	Address             string       `json:"address"`
 	AllowedOrigins      []string     `json:"allowed_origins"`
 	AllowHeaderPNA      bool         `json:"allow_header_pna"`
+	// Policies restrict the signing requests kmd serves
+	Policies []SigningPolicyConfig `json:"policies"`
+	// AuditLog enables logging every approved and denied signing request to kmd-audit.log in the data directory
+	AuditLog bool `json:"audit_log"`
 }
 
 // DriverConfig contains config info specific to each wallet driver
 type DriverConfig struct {
-	SQLiteWalletDriverConfig SQLiteWalletDriverConfig `json:"sqlite"`
-	LedgerWalletDriverConfig LedgerWalletDriverConfig `json:"ledger"`
+	SQLiteWalletDriverConfig    SQLiteWalletDriverConfig    `json:"sqlite"`
+	LedgerWalletDriverConfig    LedgerWalletDriverConfig    `json:"ledger"`
+	RemoteWalletDriverConfig    RemoteWalletDriverConfig    `json:"remote"`
+	ThresholdWalletDriverConfig ThresholdWalletDriverConfig `json:"threshold"`
 }
 
 // SQLiteWalletDriverConfig is configuration specific to the SQLiteWalletDriver
@@ -61,6 +67,95 @@ type LedgerWalletDriverConfig struct {
 	Disable bool `json:"disable"`
 }
 
+// RemoteWalletDriverConfig is configuration specific to the RemoteWalletDriver
+type RemoteWalletDriverConfig struct {
+	Signers []RemoteSignerConfig `json:"signers"`
+}
+
+// RemoteSignerConfig describes a signing service holding the keys of a remote wallet
+type RemoteSignerConfig struct {
+	// Name is the name of the wallet
+	Name string `json:"name"`
+	// Socket is the absolute path of the Unix socket the signing service listens on
+	Socket string `json:"socket"`
+	// TimeoutSecs bounds the time the signing service may take to answer, e.g. to get
+	// a signature approved. Defaults to 60 seconds when zero.
+	TimeoutSecs uint64 `json:"timeout_secs"`
+}
+
+// ThresholdWalletDriverConfig is configuration specific to the ThresholdWalletDriver
+type ThresholdWalletDriverConfig struct {
+	Wallets []ThresholdWalletConfig `json:"wallets"`
+}
+
+// ThresholdWalletConfig describes a wallet which keys are shared among several kmd instances, a threshold of
+// which are needed to sign. Every participant configures the wallet with the same name, participants, and
+// participant keys.
+type ThresholdWalletConfig struct {
+	// Name is the name of the wallet
+	Name string `json:"name"`
+	// Threshold is the number of participants needed to sign
+	Threshold uint64 `json:"threshold"`
+	// Participants lists the URLs of the kmd instances holding the shares of the keys, e.g.
+	// "http://127.0.0.1:7833", including this one
+	Participants []string `json:"participants"`
+	// Index is the position of this kmd in Participants, starting at 1
+	Index uint64 `json:"index"`
+	// ParticipantKeys lists the public keys the participants authenticate themselves with, in the order of
+	// Participants. Every kmd generates its own key, and logs it at startup: leave ParticipantKeys empty until
+	// the keys of all of the participants are known.
+	ParticipantKeys []string `json:"participant_keys"`
+	// TimeoutSecs bounds the time a participant may take to answer. Defaults to 10 seconds when zero.
+	TimeoutSecs uint64 `json:"timeout_secs"`
+}
+
+// SigningPolicyConfig restricts the signing requests for the keys of the wallets it applies to.
+// A signing request must satisfy every policy applying to its wallet and key.
+type SigningPolicyConfig struct {
+	// Wallet is the name of the wallet the policy applies to, or empty for every wallet
+	Wallet string `json:"wallet"`
+	// Keys are the addresses of the keys the policy applies to, or empty for every key
+	Keys []string `json:"keys"`
+
+	// AllowedTxTypes lists the transaction types which may be signed, e.g. "pay" or "axfer", or
+	// is empty to allow every type
+	AllowedTxTypes []string `json:"allowed_tx_types"`
+	// MaxPaymentAmount is the largest amount of microalgos a payment may send, or 0 for no limit
+	MaxPaymentAmount uint64 `json:"max_payment_amount"`
+	// AllowedReceivers lists the addresses payments and asset transfers may send to, or is empty to
+	// allow every receiver
+	AllowedReceivers []string `json:"allowed_receivers"`
+	// AllowedAssets lists the assets which may be transferred, configured or frozen, or is empty to
+	// allow every asset
+	AllowedAssets []uint64 `json:"allowed_assets"`
+	// AllowedApps lists the applications which may be called, 0 allowing to create applications, or
+	// is empty to allow every application
+	AllowedApps []uint64 `json:"allowed_apps"`
+	// ForbidRekey denies the transactions setting RekeyTo
+	ForbidRekey bool `json:"forbid_rekey"`
+	// ForbidClose denies the transactions setting CloseRemainderTo or AssetCloseTo
+	ForbidClose bool `json:"forbid_close"`
+	// ForbidPrograms denies signing programs, which delegates the signing authority to them
+	ForbidPrograms bool `json:"forbid_programs"`
+
+	// RateLimit is the number of signatures each key may make per RateLimitWindowSecs, or 0 for no limit
+	RateLimit uint64 `json:"rate_limit"`
+	// RateLimitWindowSecs defaults to 60 seconds when zero
+	RateLimitWindowSecs uint64 `json:"rate_limit_window_secs"`
+	// TimeWindows lists when signing is allowed, or is empty to allow it at any time
+	TimeWindows []TimeWindowConfig `json:"time_windows"`
+}
+
+// TimeWindowConfig is a daily time window, in UTC
+type TimeWindowConfig struct {
+	// Days lists the days of the window, e.g. "mon", or is empty for every day
+	Days []string `json:"days"`
+	// Start is the start of the window, as HH:MM
+	Start string `json:"start"`
+	// End is the end of the window, as HH:MM, excluded. Windows ending before they start span midnight.
+	End string `json:"end"`
+}
+
 // ScryptParams stores the parameters used for key derivation. This allows
 // upgrading security parameters over time
 type ScryptParams struct {
@@ -96,6 +191,26 @@ func (k KMDConfig) Validate() error {
 			return ErrSQLiteWalletNotAbsolute
 		}
 	}
+	for _, signer := range k.DriverConfig.RemoteWalletDriverConfig.Signers {
+		if signer.Name == "" {
+			return ErrRemoteSignerNoName
+		}
+		if !filepath.IsAbs(signer.Socket) {
+			return ErrRemoteSignerNotAbsolute
+		}
+	}
+	for _, tw := range k.DriverConfig.ThresholdWalletDriverConfig.Wallets {
+		if tw.Name == "" {
+			return ErrThresholdWalletNoName
+		}
+		n := uint64(len(tw.Participants))
+		if tw.Threshold == 0 || tw.Threshold > n || tw.Index == 0 || tw.Index > n {
+			return ErrThresholdWalletParticipants
+		}
+		if len(tw.ParticipantKeys) != 0 && len(tw.ParticipantKeys) != len(tw.Participants) {
+			return ErrThresholdWalletParticipantKeys
+		}
+	}
 	return nil
 }
 
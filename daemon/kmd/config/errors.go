@@ -22,3 +22,18 @@ import (
 
 // ErrSQLiteWalletNotAbsolute is returned when the passed sqlite wallet directory is relative
 var ErrSQLiteWalletNotAbsolute = fmt.Errorf("sqlite wallets path must be absolute path")
+
+// ErrRemoteSignerNoName is returned when a remote signer has no wallet name
+var ErrRemoteSignerNoName = fmt.Errorf("remote signer must have a wallet name")
+
+// ErrRemoteSignerNotAbsolute is returned when the socket path of a remote signer is relative
+var ErrRemoteSignerNotAbsolute = fmt.Errorf("remote signer socket path must be absolute path")
+
+// ErrThresholdWalletNoName is returned when a threshold wallet has no name
+var ErrThresholdWalletNoName = fmt.Errorf("threshold wallet must have a name")
+
+// ErrThresholdWalletParticipants is returned when the threshold or index of a threshold wallet do not match its participants
+var ErrThresholdWalletParticipants = fmt.Errorf("threshold wallet threshold and index must be between 1 and the number of participants")
+
+// ErrThresholdWalletParticipantKeys is returned when the participant keys of a threshold wallet do not match its participants
+var ErrThresholdWalletParticipantKeys = fmt.Errorf("threshold wallet must have a participant key for each participant")
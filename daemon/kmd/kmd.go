@@ -21,6 +21,7 @@ import (
 	"time"
 
 	"github.com/algorand/go-algorand/daemon/kmd/config"
+	"github.com/algorand/go-algorand/daemon/kmd/policy"
 	"github.com/algorand/go-algorand/daemon/kmd/server"
 	"github.com/algorand/go-algorand/daemon/kmd/session"
 	"github.com/algorand/go-algorand/daemon/kmd/wallet/driver"
@@ -57,6 +58,12 @@ func Start(startConfig StartConfig) (died chan error, sock string, err error) {
 		return
 	}
 
+	// Load the signing policies
+	policies, err := policy.MakeEngine(kmdCfg, startConfig.Log)
+	if err != nil {
+		return
+	}
+
 	// Make or read the API token + check that it's reasonable
 	apiToken, _, err := tokens.ValidateOrGenerateAPIToken(startConfig.DataDir, tokens.KmdTokenFilename)
 	if err != nil {
@@ -70,7 +77,7 @@ func Start(startConfig StartConfig) (died chan error, sock string, err error) {
 		Address:        kmdCfg.Address,
 		AllowedOrigins: kmdCfg.AllowedOrigins,
 		AllowHeaderPNA: kmdCfg.AllowHeaderPNA,
-		SessionManager: session.MakeManager(kmdCfg),
+		SessionManager: session.MakeManager(kmdCfg, policies),
 		Log:            startConfig.Log,
 		Timeout:        startConfig.Timeout,
 	}
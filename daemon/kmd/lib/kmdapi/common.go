@@ -53,3 +53,12 @@ type APIV1WalletHandle struct {
 	Wallet         APIV1Wallet `json:"wallet"`
 	ExpiresSeconds int64       `json:"expires_seconds"`
 }
+
+// APIV1Token is the API's representation of a scoped API token
+type APIV1Token struct {
+	ID       string   `json:"id"`
+	WalletID string   `json:"wallet_id"`
+	Scopes   []string `json:"scopes"`
+	// Expires is the Unix time at which the token expires
+	Expires int64 `json:"expires"`
+}
@@ -109,6 +109,30 @@ type APIV1POSTMasterKeyExportRequest struct {
 	WalletPassword    string `json:"wallet_password"`
 }
 
+// APIV1POSTWalletBackupRequest is the request for `POST /v1/wallet/backup`
+//
+// swagger:model BackupWalletRequest
+type APIV1POSTWalletBackupRequest struct {
+	_struct struct{} `codec:",omitempty,omitemptyarray"`
+
+	WalletHandleToken string `json:"wallet_handle_token"`
+	WalletPassword    string `json:"wallet_password"`
+	BackupPassword    string `json:"backup_password"`
+}
+
+// APIV1POSTWalletRestoreRequest is the request for `POST /v1/wallet/restore`
+//
+// swagger:model RestoreWalletRequest
+type APIV1POSTWalletRestoreRequest struct {
+	_struct struct{} `codec:",omitempty,omitemptyarray"`
+
+	WalletName     string `json:"wallet_name"`
+	WalletPassword string `json:"wallet_password"`
+	// swagger:strfmt byte
+	Backup         []byte `json:"backup"`
+	BackupPassword string `json:"backup_password"`
+}
+
 // APIV1POSTKeyImportRequest is the request for `POST /v1/key/import`
 //
 // swagger:model ImportKeyRequest
@@ -262,3 +286,31 @@ type APIV1POSTMultisigProgramSignRequest struct {
 	PartialMsig    crypto.MultisigSig `json:"partial_multisig"`
 	WalletPassword string             `json:"wallet_password"`
 }
+
+// APIV1POSTTokenRequest is the request for `POST /v1/token`
+//
+// swagger:model CreateTokenRequest
+type APIV1POSTTokenRequest struct {
+	_struct struct{} `codec:",omitempty,omitemptyarray"`
+
+	WalletID       string   `json:"wallet_id"`
+	WalletPassword string   `json:"wallet_password"`
+	Scopes         []string `json:"scopes"`
+	LifetimeSecs   uint64   `json:"lifetime_secs"`
+}
+
+// APIV1POSTTokenListRequest is the request for `POST /v1/token/list`
+//
+// swagger:model ListTokensRequest
+type APIV1POSTTokenListRequest struct {
+	_struct struct{} `codec:",omitempty,omitemptyarray"`
+}
+
+// APIV1DELETETokenRequest is the request for `DELETE /v1/token`
+//
+// swagger:model RevokeTokenRequest
+type APIV1DELETETokenRequest struct {
+	_struct struct{} `codec:",omitempty,omitemptyarray"`
+
+	TokenID string `json:"token_id"`
+}
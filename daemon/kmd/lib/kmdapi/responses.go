@@ -173,6 +173,36 @@ type exportMasterKeyResponse struct {
 	Body *APIV1POSTMasterKeyExportResponse
 }
 
+// APIV1POSTWalletBackupResponse is the response to `POST /v1/wallet/backup`
+// friendly:BackupWalletResponse
+type APIV1POSTWalletBackupResponse struct {
+	APIV1ResponseEnvelope
+
+	// swagger:strfmt byte
+	Backup []byte `json:"backup"`
+}
+
+// Response to `POST /v1/wallet/backup`
+// swagger:response BackupWalletResponse
+type backupWalletResponse struct {
+	//	in:body
+	Body *APIV1POSTWalletBackupResponse
+}
+
+// APIV1POSTWalletRestoreResponse is the response to `POST /v1/wallet/restore`
+// friendly:RestoreWalletResponse
+type APIV1POSTWalletRestoreResponse struct {
+	APIV1ResponseEnvelope
+	Wallet APIV1Wallet `json:"wallet"`
+}
+
+// Response to `POST /v1/wallet/restore`
+// swagger:response RestoreWalletResponse
+type restoreWalletResponse struct {
+	//	in:body
+	Body *APIV1POSTWalletRestoreResponse
+}
+
 // APIV1POSTKeyImportResponse is the response to `POST /v1/key/import`
 // friendly:ImportKeyResponse
 type APIV1POSTKeyImportResponse struct {
@@ -362,3 +392,45 @@ type signProgramMultisigResponse struct {
 	//	in:body
 	Body *APIV1POSTMultisigProgramSignResponse
 }
+
+// APIV1POSTTokenResponse is the response to `POST /v1/token`
+// friendly:CreateTokenResponse
+type APIV1POSTTokenResponse struct {
+	APIV1ResponseEnvelope
+	Token     string     `json:"token"`
+	TokenInfo APIV1Token `json:"token_info"`
+}
+
+// Response to `POST /v1/token`
+// swagger:response CreateTokenResponse
+type createTokenResponse struct {
+	//	in:body
+	Body *APIV1POSTTokenResponse
+}
+
+// APIV1POSTTokenListResponse is the response to `POST /v1/token/list`
+// friendly:ListTokensResponse
+type APIV1POSTTokenListResponse struct {
+	APIV1ResponseEnvelope
+	Tokens []APIV1Token `json:"tokens"`
+}
+
+// Response to `POST /v1/token/list`
+// swagger:response ListTokensResponse
+type listTokensResponse struct {
+	//	in:body
+	Body *APIV1POSTTokenListResponse
+}
+
+// APIV1DELETETokenResponse is the response to `DELETE /v1/token`
+// friendly:RevokeTokenResponse
+type APIV1DELETETokenResponse struct {
+	APIV1ResponseEnvelope
+}
+
+// Response to `DELETE /v1/token`
+// swagger:response RevokeTokenResponse
+type revokeTokenResponse struct {
+	//	in:body
+	Body *APIV1DELETETokenResponse
+}
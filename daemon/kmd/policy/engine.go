@@ -0,0 +1,242 @@
+// Copyright (C) 2019-2025 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package policy
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/algorand/go-deadlock"
+
+	"github.com/algorand/go-algorand/crypto"
+	"github.com/algorand/go-algorand/daemon/kmd/config"
+	"github.com/algorand/go-algorand/daemon/kmd/wallet"
+	"github.com/algorand/go-algorand/data/basics"
+	"github.com/algorand/go-algorand/data/transactions"
+	"github.com/algorand/go-algorand/data/transactions/logic"
+	"github.com/algorand/go-algorand/logging"
+)
+
+const (
+	auditLogFilename    = "kmd-audit.log"
+	auditLogPermissions = 0600
+)
+
+// signingRequest describes a request to sign a transaction or a program with key
+type signingRequest struct {
+	// kind is the kind of request, as written in the audit log
+	kind string
+	key  crypto.Digest
+	// txn is the transaction to sign, or nil when signing program
+	txn     *transactions.Transaction
+	program []byte
+}
+
+// auditRecord is the entry of the audit log for a signing request
+type auditRecord struct {
+	Time        time.Time `json:"time"`
+	Wallet      string    `json:"wallet"`
+	Key         string    `json:"key"`
+	Request     string    `json:"request"`
+	TxID        string    `json:"txid,omitempty"`
+	TxType      string    `json:"tx_type,omitempty"`
+	ProgramAddr string    `json:"program_address,omitempty"`
+	Approved    bool      `json:"approved"`
+	// Error is why the request was denied, or the error signing an approved request
+	Error string `json:"error,omitempty"`
+}
+
+// Engine checks the signing requests against the policies of the kmd config, and writes them to the audit log.
+type Engine struct {
+	log logging.Logger
+
+	mu       deadlock.Mutex
+	policies []*policy
+	auditLog *os.File
+}
+
+// MakeEngine returns the Engine enforcing the policies of cfg, or nil if cfg has neither policies nor audit log.
+func MakeEngine(cfg config.KMDConfig, log logging.Logger) (*Engine, error) {
+	if len(cfg.Policies) == 0 && !cfg.AuditLog {
+		return nil, nil
+	}
+	e := &Engine{log: log}
+	for i, policyCfg := range cfg.Policies {
+		p, err := parsePolicy(i, policyCfg)
+		if err != nil {
+			return nil, fmt.Errorf("policy %d: %w", i, err)
+		}
+		e.policies = append(e.policies, p)
+	}
+	if cfg.AuditLog {
+		f, err := os.OpenFile(filepath.Join(cfg.DataDir, auditLogFilename), os.O_WRONLY|os.O_APPEND|os.O_CREATE, auditLogPermissions)
+		if err != nil {
+			return nil, err
+		}
+		e.auditLog = f
+	}
+	return e, nil
+}
+
+// Wrap returns w, checking its signing requests with e. It returns w itself if e is nil.
+func (e *Engine) Wrap(w wallet.Wallet) wallet.Wallet {
+	if e == nil {
+		return w
+	}
+	return &policyWallet{Wallet: w, engine: e}
+}
+
+// check returns an error wrapping ErrDenied if a policy denies req, and otherwise reserves req in the rate limits
+// of the policies applying to it, which it returns so that the reservation is released once req is signed.
+func (e *Engine) check(now time.Time, walletName string, req signingRequest) ([]*policy, error) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	var applying []*policy
+	for _, p := range e.policies {
+		if !p.appliesTo(walletName, req.key) {
+			continue
+		}
+		if reason := p.deny(now, req); reason != "" {
+			return nil, fmt.Errorf("%w by policy %d: %s", ErrDenied, p.index, reason)
+		}
+		applying = append(applying, p)
+	}
+	for _, p := range applying {
+		p.reserve(req.key)
+	}
+	return applying, nil
+}
+
+// release releases the reservation of req in the rate limits of applying, accounting it only if it was signed.
+func (e *Engine) release(now time.Time, applying []*policy, req signingRequest, signed bool) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	for _, p := range applying {
+		p.release(now, req.key, signed)
+	}
+}
+
+// authorize checks req against the policies and calls sign if they all allow it, auditing the outcome.
+// A failed signature does not count toward the rate limits.
+func (e *Engine) authorize(w wallet.Wallet, req signingRequest, sign func() error) error {
+	md, err := w.Metadata()
+	if err != nil {
+		return err
+	}
+	walletName := string(md.Name)
+
+	now := time.Now()
+	applying, err := e.check(now, walletName, req)
+	if err != nil {
+		e.log.Warnf("wallet %s: %v", walletName, err)
+		e.audit(now, walletName, req, false, err)
+		return err
+	}
+	err = sign()
+	e.release(now, applying, req, err == nil)
+	e.audit(now, walletName, req, true, err)
+	return err
+}
+
+// audit appends the outcome of req to the audit log, if enabled.
+func (e *Engine) audit(now time.Time, walletName string, req signingRequest, approved bool, err error) {
+	if e.auditLog == nil {
+		return
+	}
+	record := auditRecord{
+		Time:     now.UTC(),
+		Wallet:   walletName,
+		Key:      basics.Address(req.key).String(),
+		Request:  req.kind,
+		Approved: approved,
+	}
+	if req.txn != nil {
+		record.TxID = req.txn.ID().String()
+		record.TxType = string(req.txn.Type)
+	} else {
+		record.ProgramAddr = basics.Address(logic.HashProgram(req.program)).String()
+	}
+	if err != nil {
+		record.Error = err.Error()
+	}
+	line, err := json.Marshal(record)
+	if err != nil {
+		e.log.Warnf("could not encode audit record: %v", err)
+		return
+	}
+
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	_, err = e.auditLog.Write(append(line, '\n'))
+	if err != nil {
+		e.log.Warnf("could not write audit record: %v", err)
+	}
+}
+
+// policyWallet is a wallet.Wallet which signing requests are checked by an Engine
+type policyWallet struct {
+	wallet.Wallet
+	engine *Engine
+}
+
+// SignTransaction implements the Wallet interface.
+func (pw *policyWallet) SignTransaction(tx transactions.Transaction, pk crypto.PublicKey, pass []byte) (stx []byte, err error) {
+	key := crypto.Digest(pk)
+	if (pk == crypto.PublicKey{}) {
+		key = crypto.Digest(tx.Src())
+	}
+	req := signingRequest{kind: "transaction", key: key, txn: &tx}
+	err = pw.engine.authorize(pw.Wallet, req, func() (err error) {
+		stx, err = pw.Wallet.SignTransaction(tx, pk, pass)
+		return
+	})
+	return
+}
+
+// SignProgram implements the Wallet interface.
+func (pw *policyWallet) SignProgram(program []byte, src crypto.Digest, pass []byte) (sig []byte, err error) {
+	req := signingRequest{kind: "program", key: src, program: program}
+	err = pw.engine.authorize(pw.Wallet, req, func() (err error) {
+		sig, err = pw.Wallet.SignProgram(program, src, pass)
+		return
+	})
+	return
+}
+
+// MultisigSignTransaction implements the Wallet interface.
+func (pw *policyWallet) MultisigSignTransaction(tx transactions.Transaction, pk crypto.PublicKey, partial crypto.MultisigSig, pass []byte, signer crypto.Digest) (msig crypto.MultisigSig, err error) {
+	req := signingRequest{kind: "multisig-transaction", key: crypto.Digest(pk), txn: &tx}
+	err = pw.engine.authorize(pw.Wallet, req, func() (err error) {
+		msig, err = pw.Wallet.MultisigSignTransaction(tx, pk, partial, pass, signer)
+		return
+	})
+	return
+}
+
+// MultisigSignProgram implements the Wallet interface.
+func (pw *policyWallet) MultisigSignProgram(program []byte, src crypto.Digest, pk crypto.PublicKey, partial crypto.MultisigSig, pass []byte) (msig crypto.MultisigSig, err error) {
+	req := signingRequest{kind: "multisig-program", key: crypto.Digest(pk), program: program}
+	err = pw.engine.authorize(pw.Wallet, req, func() (err error) {
+		msig, err = pw.Wallet.MultisigSignProgram(program, src, pk, partial, pass)
+		return
+	})
+	return
+}
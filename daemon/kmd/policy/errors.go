@@ -0,0 +1,30 @@
+// Copyright (C) 2019-2025 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package policy
+
+import (
+	"fmt"
+)
+
+// ErrDenied is returned when a signing request is denied by a policy
+var ErrDenied = fmt.Errorf("signing request denied")
+
+var errBadKey = fmt.Errorf("invalid key address in policy")
+var errBadReceiver = fmt.Errorf("invalid receiver address in policy")
+var errBadTxType = fmt.Errorf("unknown transaction type in policy")
+var errBadDay = fmt.Errorf("unknown day in policy time window")
+var errBadTime = fmt.Errorf("policy time window must be given as HH:MM")
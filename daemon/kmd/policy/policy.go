@@ -0,0 +1,309 @@
+// Copyright (C) 2019-2025 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package policy
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/algorand/go-algorand/crypto"
+	"github.com/algorand/go-algorand/daemon/kmd/config"
+	"github.com/algorand/go-algorand/data/basics"
+	"github.com/algorand/go-algorand/data/transactions"
+	"github.com/algorand/go-algorand/protocol"
+)
+
+const defaultRateLimitWindow = 60 * time.Second
+
+var knownTxTypes = []protocol.TxType{
+	protocol.PaymentTx,
+	protocol.KeyRegistrationTx,
+	protocol.AssetConfigTx,
+	protocol.AssetTransferTx,
+	protocol.AssetFreezeTx,
+	protocol.ApplicationCallTx,
+	protocol.HeartbeatTx,
+}
+
+var dayNames = map[string]time.Weekday{
+	"sun": time.Sunday,
+	"mon": time.Monday,
+	"tue": time.Tuesday,
+	"wed": time.Wednesday,
+	"thu": time.Thursday,
+	"fri": time.Friday,
+	"sat": time.Saturday,
+}
+
+// timeWindow is a daily time window, in UTC
+type timeWindow struct {
+	days       [7]bool
+	start, end time.Duration
+}
+
+// contains returns whether t is in the window.
+func (w timeWindow) contains(t time.Time) bool {
+	t = t.UTC()
+	day := t.Weekday()
+	sinceMidnight := time.Duration(t.Hour())*time.Hour + time.Duration(t.Minute())*time.Minute + time.Duration(t.Second())*time.Second
+	if w.start <= w.end {
+		return w.days[day] && w.start <= sinceMidnight && sinceMidnight < w.end
+	}
+	// the window spans midnight, and belongs to the day it starts
+	previousDay := (day + 6) % 7
+	return (w.days[day] && sinceMidnight >= w.start) || (w.days[previousDay] && sinceMidnight < w.end)
+}
+
+func parseTimeOfDay(s string) (time.Duration, error) {
+	t, err := time.Parse("15:04", s)
+	if err != nil {
+		return 0, errBadTime
+	}
+	return time.Duration(t.Hour())*time.Hour + time.Duration(t.Minute())*time.Minute, nil
+}
+
+func parseTimeWindow(cfg config.TimeWindowConfig) (w timeWindow, err error) {
+	if len(cfg.Days) == 0 {
+		for i := range w.days {
+			w.days[i] = true
+		}
+	}
+	for _, name := range cfg.Days {
+		day, ok := dayNames[strings.ToLower(name)]
+		if !ok {
+			return w, errBadDay
+		}
+		w.days[day] = true
+	}
+	w.start, err = parseTimeOfDay(cfg.Start)
+	if err != nil {
+		return
+	}
+	w.end, err = parseTimeOfDay(cfg.End)
+	return
+}
+
+// policy is a parsed config.SigningPolicyConfig. Its fields restricting the requests are nil when they allow everything.
+type policy struct {
+	index  int
+	wallet string
+	keys   map[crypto.Digest]bool
+
+	txTypes          map[protocol.TxType]bool
+	maxPaymentAmount uint64
+	receivers        map[basics.Address]bool
+	assets           map[basics.AssetIndex]bool
+	apps             map[basics.AppIndex]bool
+	forbidRekey      bool
+	forbidClose      bool
+	forbidPrograms   bool
+
+	rateLimit       int
+	rateLimitWindow time.Duration
+	windows         []timeWindow
+
+	// signatures holds the times of the recent signatures of each key, to enforce the rate limit
+	signatures map[crypto.Digest][]time.Time
+	// reserved holds the number of signatures of each key being made, which count toward the rate limit until they end
+	reserved map[crypto.Digest]int
+}
+
+func parsePolicy(index int, cfg config.SigningPolicyConfig) (*policy, error) {
+	p := &policy{
+		index:            index,
+		wallet:           cfg.Wallet,
+		maxPaymentAmount: cfg.MaxPaymentAmount,
+		forbidRekey:      cfg.ForbidRekey,
+		forbidClose:      cfg.ForbidClose,
+		forbidPrograms:   cfg.ForbidPrograms,
+		rateLimit:        int(cfg.RateLimit),
+		rateLimitWindow:  time.Duration(cfg.RateLimitWindowSecs) * time.Second,
+		signatures:       make(map[crypto.Digest][]time.Time),
+		reserved:         make(map[crypto.Digest]int),
+	}
+	if p.rateLimitWindow == 0 {
+		p.rateLimitWindow = defaultRateLimitWindow
+	}
+	if len(cfg.Keys) > 0 {
+		p.keys = make(map[crypto.Digest]bool, len(cfg.Keys))
+		for _, key := range cfg.Keys {
+			addr, err := basics.UnmarshalChecksumAddress(key)
+			if err != nil {
+				return nil, errBadKey
+			}
+			p.keys[crypto.Digest(addr)] = true
+		}
+	}
+	if len(cfg.AllowedTxTypes) > 0 {
+		p.txTypes = make(map[protocol.TxType]bool, len(cfg.AllowedTxTypes))
+		for _, txType := range cfg.AllowedTxTypes {
+			if !slices.Contains(knownTxTypes, protocol.TxType(txType)) {
+				return nil, errBadTxType
+			}
+			p.txTypes[protocol.TxType(txType)] = true
+		}
+	}
+	if len(cfg.AllowedReceivers) > 0 {
+		p.receivers = make(map[basics.Address]bool, len(cfg.AllowedReceivers))
+		for _, receiver := range cfg.AllowedReceivers {
+			addr, err := basics.UnmarshalChecksumAddress(receiver)
+			if err != nil {
+				return nil, errBadReceiver
+			}
+			p.receivers[addr] = true
+		}
+	}
+	if len(cfg.AllowedAssets) > 0 {
+		p.assets = make(map[basics.AssetIndex]bool, len(cfg.AllowedAssets))
+		for _, asset := range cfg.AllowedAssets {
+			p.assets[basics.AssetIndex(asset)] = true
+		}
+	}
+	if len(cfg.AllowedApps) > 0 {
+		p.apps = make(map[basics.AppIndex]bool, len(cfg.AllowedApps))
+		for _, app := range cfg.AllowedApps {
+			p.apps[basics.AppIndex(app)] = true
+		}
+	}
+	for _, windowCfg := range cfg.TimeWindows {
+		window, err := parseTimeWindow(windowCfg)
+		if err != nil {
+			return nil, err
+		}
+		p.windows = append(p.windows, window)
+	}
+	return p, nil
+}
+
+// appliesTo returns whether the policy applies to the signing requests for key of the wallet named walletName.
+func (p *policy) appliesTo(walletName string, key crypto.Digest) bool {
+	return (p.wallet == "" || p.wallet == walletName) && (p.keys == nil || p.keys[key])
+}
+
+// deny returns why the policy denies the signing request at now, or an empty string if it allows it.
+func (p *policy) deny(now time.Time, req signingRequest) string {
+	if len(p.windows) > 0 && !slices.ContainsFunc(p.windows, func(w timeWindow) bool { return w.contains(now) }) {
+		return "outside of the allowed time windows"
+	}
+	if p.rateLimit > 0 && len(p.recentSignatures(now, req.key))+p.reserved[req.key] >= p.rateLimit {
+		return fmt.Sprintf("more than %d signatures in %v", p.rateLimit, p.rateLimitWindow)
+	}
+	if req.txn == nil {
+		if p.forbidPrograms {
+			return "program signing is forbidden"
+		}
+		return ""
+	}
+	return p.denyTxn(req.txn)
+}
+
+func (p *policy) denyTxn(tx *transactions.Transaction) string {
+	if p.txTypes != nil && !p.txTypes[tx.Type] {
+		return fmt.Sprintf("transaction type %s is not allowed", tx.Type)
+	}
+	if p.forbidRekey && !tx.RekeyTo.IsZero() {
+		return "rekeying is forbidden"
+	}
+	switch tx.Type {
+	case protocol.PaymentTx:
+		if p.maxPaymentAmount > 0 && tx.Amount.Raw > p.maxPaymentAmount {
+			return fmt.Sprintf("payment amount %d exceeds %d", tx.Amount.Raw, p.maxPaymentAmount)
+		}
+		if p.forbidClose && !tx.CloseRemainderTo.IsZero() {
+			return "closing the account is forbidden"
+		}
+		if denied := p.denyReceiver(tx.Receiver); denied != "" {
+			return denied
+		}
+		if !tx.CloseRemainderTo.IsZero() {
+			return p.denyReceiver(tx.CloseRemainderTo)
+		}
+	case protocol.AssetTransferTx:
+		if p.assets != nil && !p.assets[tx.XferAsset] {
+			return fmt.Sprintf("asset %d is not allowed", tx.XferAsset)
+		}
+		if p.forbidClose && !tx.AssetCloseTo.IsZero() {
+			return "closing the asset holding is forbidden"
+		}
+		if denied := p.denyReceiver(tx.AssetReceiver); denied != "" {
+			return denied
+		}
+		if !tx.AssetCloseTo.IsZero() {
+			return p.denyReceiver(tx.AssetCloseTo)
+		}
+	case protocol.AssetConfigTx:
+		if p.assets != nil && !p.assets[tx.ConfigAsset] {
+			return fmt.Sprintf("asset %d is not allowed", tx.ConfigAsset)
+		}
+	case protocol.AssetFreezeTx:
+		if p.assets != nil && !p.assets[tx.FreezeAsset] {
+			return fmt.Sprintf("asset %d is not allowed", tx.FreezeAsset)
+		}
+	case protocol.ApplicationCallTx:
+		if p.apps != nil && !p.apps[tx.ApplicationID] {
+			return fmt.Sprintf("application %d is not allowed", tx.ApplicationID)
+		}
+	}
+	return ""
+}
+
+func (p *policy) denyReceiver(receiver basics.Address) string {
+	if p.receivers != nil && !p.receivers[receiver] {
+		return fmt.Sprintf("receiver %s is not allowed", receiver)
+	}
+	return ""
+}
+
+// recentSignatures returns the times of the signatures of key in the rate limit window ending at now,
+// forgetting the older ones.
+func (p *policy) recentSignatures(now time.Time, key crypto.Digest) []time.Time {
+	times := p.signatures[key]
+	i := 0
+	for i < len(times) && now.Sub(times[i]) >= p.rateLimitWindow {
+		i++
+	}
+	times = times[i:]
+	if len(times) == 0 {
+		delete(p.signatures, key)
+	} else {
+		p.signatures[key] = times
+	}
+	return times
+}
+
+// reserve accounts a signature of key being made for the rate limit, until it is released.
+func (p *policy) reserve(key crypto.Digest) {
+	if p.rateLimit > 0 {
+		p.reserved[key]++
+	}
+}
+
+// release ends the reservation of a signature of key, and accounts it at now for the rate limit if it was made.
+func (p *policy) release(now time.Time, key crypto.Digest, signed bool) {
+	if p.rateLimit == 0 {
+		return
+	}
+	p.reserved[key]--
+	if p.reserved[key] == 0 {
+		delete(p.reserved, key)
+	}
+	if signed {
+		p.signatures[key] = append(p.signatures[key], now)
+	}
+}
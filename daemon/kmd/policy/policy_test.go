@@ -0,0 +1,248 @@
+// Copyright (C) 2019-2025 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package policy
+
+import (
+	"bufio"
+	"encoding/json"
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/algorand/go-algorand/crypto"
+	"github.com/algorand/go-algorand/daemon/kmd/config"
+	"github.com/algorand/go-algorand/daemon/kmd/wallet"
+	"github.com/algorand/go-algorand/data/basics"
+	"github.com/algorand/go-algorand/data/transactions"
+	"github.com/algorand/go-algorand/logging"
+	"github.com/algorand/go-algorand/protocol"
+	"github.com/algorand/go-algorand/test/partitiontest"
+)
+
+// testWallet counts the signatures it is asked for
+type testWallet struct {
+	wallet.Wallet
+	name       string
+	signatures int
+}
+
+func (w *testWallet) Metadata() (wallet.Metadata, error) {
+	return wallet.Metadata{Name: []byte(w.name)}, nil
+}
+
+func (w *testWallet) SignTransaction(tx transactions.Transaction, pk crypto.PublicKey, pw []byte) ([]byte, error) {
+	w.signatures++
+	return []byte("stx"), nil
+}
+
+func (w *testWallet) SignProgram(program []byte, src crypto.Digest, pw []byte) ([]byte, error) {
+	w.signatures++
+	return []byte("sig"), nil
+}
+
+func testAddress(b byte) basics.Address {
+	var addr basics.Address
+	addr[0] = b
+	return addr
+}
+
+func TestPolicyTxn(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	sender, receiver, other := testAddress(1), testAddress(2), testAddress(3)
+	p, err := parsePolicy(0, config.SigningPolicyConfig{
+		AllowedTxTypes:   []string{"pay", "axfer", "appl"},
+		MaxPaymentAmount: 1000,
+		AllowedReceivers: []string{receiver.String()},
+		AllowedAssets:    []uint64{5},
+		AllowedApps:      []uint64{0, 7},
+		ForbidRekey:      true,
+		ForbidClose:      true,
+	})
+	require.NoError(t, err)
+
+	pay := func(amount uint64, to basics.Address) *transactions.Transaction {
+		return &transactions.Transaction{
+			Type:             protocol.PaymentTx,
+			Header:           transactions.Header{Sender: sender},
+			PaymentTxnFields: transactions.PaymentTxnFields{Receiver: to, Amount: basics.MicroAlgos{Raw: amount}},
+		}
+	}
+	require.Empty(t, p.denyTxn(pay(1000, receiver)))
+	require.Contains(t, p.denyTxn(pay(1001, receiver)), "amount")
+	require.Contains(t, p.denyTxn(pay(1, other)), "receiver")
+
+	tx := pay(1, receiver)
+	tx.RekeyTo = other
+	require.Contains(t, p.denyTxn(tx), "rekeying")
+	tx = pay(1, receiver)
+	tx.CloseRemainderTo = receiver
+	require.Contains(t, p.denyTxn(tx), "closing")
+
+	axfer := &transactions.Transaction{
+		Type:                   protocol.AssetTransferTx,
+		AssetTransferTxnFields: transactions.AssetTransferTxnFields{XferAsset: 5, AssetReceiver: receiver},
+	}
+	require.Empty(t, p.denyTxn(axfer))
+	axfer.XferAsset = 6
+	require.Contains(t, p.denyTxn(axfer), "asset 6")
+
+	appl := &transactions.Transaction{Type: protocol.ApplicationCallTx}
+	require.Empty(t, p.denyTxn(appl))
+	appl.ApplicationID = 8
+	require.Contains(t, p.denyTxn(appl), "application 8")
+
+	require.Contains(t, p.denyTxn(&transactions.Transaction{Type: protocol.KeyRegistrationTx}), "type keyreg")
+
+	_, err = parsePolicy(0, config.SigningPolicyConfig{AllowedTxTypes: []string{"stpf"}})
+	require.ErrorIs(t, err, errBadTxType)
+	_, err = parsePolicy(0, config.SigningPolicyConfig{Keys: []string{"nope"}})
+	require.ErrorIs(t, err, errBadKey)
+}
+
+func TestTimeWindow(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	// 2024-01-01 is a Monday
+	at := func(day, hour, minute int) time.Time { return time.Date(2024, 1, day, hour, minute, 0, 0, time.UTC) }
+
+	w, err := parseTimeWindow(config.TimeWindowConfig{Days: []string{"mon", "Tue"}, Start: "09:00", End: "17:30"})
+	require.NoError(t, err)
+	require.True(t, w.contains(at(1, 9, 0)))
+	require.True(t, w.contains(at(2, 17, 29)))
+	require.False(t, w.contains(at(2, 17, 30)))
+	require.False(t, w.contains(at(1, 8, 59)))
+	require.False(t, w.contains(at(3, 12, 0)))
+
+	// a window spanning midnight belongs to the day it starts
+	w, err = parseTimeWindow(config.TimeWindowConfig{Days: []string{"sun"}, Start: "22:00", End: "02:00"})
+	require.NoError(t, err)
+	require.True(t, w.contains(at(7, 23, 0)))
+	require.True(t, w.contains(at(8, 1, 0)))
+	require.False(t, w.contains(at(2, 1, 0)))
+	require.False(t, w.contains(at(8, 23, 0)))
+
+	_, err = parseTimeWindow(config.TimeWindowConfig{Days: []string{"someday"}, Start: "09:00", End: "10:00"})
+	require.ErrorIs(t, err, errBadDay)
+	_, err = parseTimeWindow(config.TimeWindowConfig{Start: "9h", End: "10:00"})
+	require.ErrorIs(t, err, errBadTime)
+}
+
+func TestEngine(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	dataDir := t.TempDir()
+	key, other := testAddress(1), testAddress(2)
+	cfg := config.KMDConfig{
+		DataDir:  dataDir,
+		AuditLog: true,
+		Policies: []config.SigningPolicyConfig{
+			{Wallet: "hot", RateLimit: 2, RateLimitWindowSecs: 3600},
+			{Keys: []string{key.String()}, ForbidPrograms: true},
+		},
+	}
+	e, err := MakeEngine(cfg, logging.TestingLog(t))
+	require.NoError(t, err)
+
+	hot := &testWallet{name: "hot"}
+	cold := &testWallet{name: "cold"}
+	hotW, coldW := e.Wrap(hot), e.Wrap(cold)
+
+	tx := transactions.Transaction{Type: protocol.PaymentTx, Header: transactions.Header{Sender: key}}
+	// the rate limit applies to the hot wallet only
+	for i := 0; i < 2; i++ {
+		_, err = hotW.SignTransaction(tx, crypto.PublicKey{}, nil)
+		require.NoError(t, err)
+	}
+	_, err = hotW.SignTransaction(tx, crypto.PublicKey{}, nil)
+	require.ErrorIs(t, err, ErrDenied)
+	require.Equal(t, 2, hot.signatures)
+	// and counts the signatures of each key
+	_, err = hotW.SignTransaction(tx, crypto.PublicKey(other), nil)
+	require.NoError(t, err)
+	for i := 0; i < 3; i++ {
+		_, err = coldW.SignTransaction(tx, crypto.PublicKey{}, nil)
+		require.NoError(t, err)
+	}
+
+	// programs are forbidden for key only
+	_, err = coldW.SignProgram([]byte{1}, crypto.Digest(key), nil)
+	require.ErrorIs(t, err, ErrDenied)
+	_, err = coldW.SignProgram([]byte{1}, crypto.Digest(other), nil)
+	require.NoError(t, err)
+	require.Equal(t, 4, cold.signatures)
+
+	f, err := os.Open(filepath.Join(dataDir, auditLogFilename))
+	require.NoError(t, err)
+	defer f.Close()
+	var records []auditRecord
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		var record auditRecord
+		require.NoError(t, json.Unmarshal(scanner.Bytes(), &record))
+		records = append(records, record)
+	}
+	require.Len(t, records, 9)
+	require.Equal(t, "hot", records[2].Wallet)
+	require.False(t, records[2].Approved)
+	require.Equal(t, tx.ID().String(), records[2].TxID)
+	require.Contains(t, records[2].Error, "policy 0")
+	require.True(t, records[3].Approved)
+	require.Equal(t, other.String(), records[3].Key)
+	require.Equal(t, "program", records[7].Request)
+	require.Contains(t, records[7].Error, "policy 1")
+	require.NotEmpty(t, records[8].ProgramAddr)
+
+	e, err = MakeEngine(config.KMDConfig{}, logging.TestingLog(t))
+	require.NoError(t, err)
+	require.Nil(t, e)
+	require.Equal(t, wallet.Wallet(hot), e.Wrap(hot))
+
+	_, err = MakeEngine(config.KMDConfig{Policies: []config.SigningPolicyConfig{{AllowedReceivers: []string{"x"}}}}, logging.TestingLog(t))
+	require.True(t, errors.Is(err, errBadReceiver))
+}
+
+func TestEngineRateLimitReservation(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	cfg := config.KMDConfig{Policies: []config.SigningPolicyConfig{{RateLimit: 1, RateLimitWindowSecs: 3600}}}
+	e, err := MakeEngine(cfg, logging.TestingLog(t))
+	require.NoError(t, err)
+	w := &testWallet{name: "hot"}
+	req := signingRequest{kind: "program", key: crypto.Digest(testAddress(1)), program: []byte{1}}
+
+	// a failed signature does not count toward the rate limit
+	errSign := errors.New("wrong password")
+	for i := 0; i < 3; i++ {
+		err = e.authorize(w, req, func() error { return errSign })
+		require.ErrorIs(t, err, errSign)
+	}
+
+	// a signature being made does
+	err = e.authorize(w, req, func() error {
+		return e.authorize(w, req, func() error { return nil })
+	})
+	require.ErrorIs(t, err, ErrDenied)
+	err = e.authorize(w, req, func() error { return nil })
+	require.NoError(t, err)
+	err = e.authorize(w, req, func() error { return nil })
+	require.ErrorIs(t, err, ErrDenied)
+}
@@ -112,7 +112,8 @@ func (sm *Manager) cleanUpExpiredHandles() {
 }
 
 // deleteExpiredHandles is a helper for cleanUpExpiredHandles. It periodically
-// iterates over the walletHandles map and deletes ones that have expired
+// iterates over the walletHandles and scopedTokens maps and deletes ones that
+// have expired
 func (sm *Manager) deleteExpiredHandles() {
 	sm.mux.Lock()
 	defer sm.mux.Unlock()
@@ -121,6 +122,11 @@ func (sm *Manager) deleteExpiredHandles() {
 			delete(sm.walletHandles, handleID)
 		}
 	}
+	for tokenID, st := range sm.scopedTokens {
+		if checkHandleNotExpired(st.Expires) != nil {
+			delete(sm.scopedTokens, tokenID)
+		}
+	}
 }
 
 // InitWalletHandle attempts to init the wallet using the passed password,
@@ -142,7 +148,7 @@ func (sm *Manager) InitWalletHandle(w wallet.Wallet, pw []byte) ([]byte, error)
 	handle := walletHandle{
 		secret:  handleSecret,
 		expires: time.Now().Add(sm.sessionLifetime),
-		wallet:  w,
+		wallet:  sm.policies.Wrap(w),
 	}
 
 	// Insert the handle into the walletHandles map
@@ -21,6 +21,7 @@ import (
 	"time"
 
 	"github.com/algorand/go-algorand/daemon/kmd/config"
+	"github.com/algorand/go-algorand/daemon/kmd/policy"
 	"github.com/algorand/go-algorand/daemon/kmd/wallet"
 	"github.com/algorand/go-deadlock"
 )
@@ -37,20 +38,25 @@ type walletHandle struct {
 type Manager struct {
 	Initialized     bool
 	walletHandles   map[string]walletHandle
+	scopedTokens    map[string]*ScopedToken
 	sessionLifetime time.Duration
+	policies        *policy.Engine
 	Kill            context.CancelFunc
 	ctx             context.Context
 	mux             deadlock.Mutex
 }
 
 // MakeManager initializes and returns a *Manager using the kmd global
-// configuration
-func MakeManager(cfg config.KMDConfig) *Manager {
+// configuration. The signing requests of the wallets are checked by policies,
+// if not nil.
+func MakeManager(cfg config.KMDConfig, policies *policy.Engine) *Manager {
 	ctx, cancel := context.WithCancel(context.Background())
 	sm := &Manager{
 		Initialized:     true,
 		walletHandles:   make(map[string]walletHandle),
+		scopedTokens:    make(map[string]*ScopedToken),
 		sessionLifetime: time.Duration(cfg.SessionLifetimeSecs * uint64(time.Second)),
+		policies:        policies,
 		Kill:            cancel,
 		ctx:             ctx,
 	}
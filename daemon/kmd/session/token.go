@@ -0,0 +1,209 @@
+// Copyright (C) 2019-2025 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package session
+
+import (
+	"crypto/subtle"
+	"fmt"
+	"slices"
+	"sort"
+	"time"
+
+	"github.com/algorand/go-algorand/crypto"
+	"github.com/algorand/go-algorand/daemon/kmd/wallet"
+	"github.com/algorand/go-algorand/data/transactions"
+)
+
+// Scopes of the scoped API tokens
+const (
+	// ScopeList allows listing the keys and multisig addresses of the wallet
+	ScopeList = "list"
+	// ScopeSign allows signing transactions and programs with the keys of the wallet
+	ScopeSign = "sign"
+	// ScopeManage allows adding and deleting the keys and multisig addresses of the wallet
+	ScopeManage = "manage"
+)
+
+const defaultScopedTokenLifetime = 24 * time.Hour
+
+var knownScopes = []string{ScopeList, ScopeSign, ScopeManage}
+
+var errNoScopes = fmt.Errorf("scoped token must have at least one scope")
+var errUnknownScope = fmt.Errorf("unknown token scope")
+var errTokenNotFound = fmt.Errorf("token does not exist")
+
+// ScopedToken is an API token giving access to some operations on a wallet, without its password.
+// Scoped tokens are only held in memory, and don't survive a restart of kmd.
+type ScopedToken struct {
+	ID       string
+	WalletID []byte
+	Scopes   []string
+	Expires  time.Time
+
+	secret []byte
+	wallet wallet.Wallet
+}
+
+// HasScope returns whether the token has scope
+func (st *ScopedToken) HasScope(scope string) bool {
+	return slices.Contains(st.Scopes, scope)
+}
+
+// Wallet returns the wallet of the token, which operations requiring the wallet password are made with the password
+// given when the token was created
+func (st *ScopedToken) Wallet() wallet.Wallet {
+	return st.wallet
+}
+
+// ExpiresSeconds returns the number of seconds until the token expires
+func (st *ScopedToken) ExpiresSeconds() int64 {
+	return int64(time.Until(st.Expires).Seconds())
+}
+
+// CreateScopedToken initializes the wallet with ID walletID using the passed password,
+// and returns a new API token with scopes on it, expiring after lifetime, or one day if zero.
+func (sm *Manager) CreateScopedToken(w wallet.Wallet, walletID []byte, pw []byte, scopes []string, lifetime time.Duration) ([]byte, ScopedToken, error) {
+	if len(scopes) == 0 {
+		return nil, ScopedToken{}, errNoScopes
+	}
+	for _, scope := range scopes {
+		if !slices.Contains(knownScopes, scope) {
+			return nil, ScopedToken{}, errUnknownScope
+		}
+	}
+	if lifetime == 0 {
+		lifetime = defaultScopedTokenLifetime
+	}
+
+	// Attempt to initialize the wallet with the password
+	err := w.Init(pw)
+	if err != nil {
+		return nil, ScopedToken{}, err
+	}
+
+	tokenID, tokenSecret, err := generateHandleIDAndSecret()
+	if err != nil {
+		return nil, ScopedToken{}, err
+	}
+	st := &ScopedToken{
+		ID:       string(tokenID),
+		WalletID: walletID,
+		Scopes:   slices.Clone(scopes),
+		Expires:  time.Now().Add(lifetime),
+		secret:   tokenSecret,
+		wallet:   sm.policies.Wrap(passwordWallet{Wallet: w, pw: pw}),
+	}
+
+	sm.mux.Lock()
+	defer sm.mux.Unlock()
+	sm.scopedTokens[st.ID] = st
+	token := []byte(fmt.Sprintf("%s%s%s", tokenID, wHandleTokenSplitChar, tokenSecret))
+	return token, *st, nil
+}
+
+// ListScopedTokens returns the scoped tokens which have not expired, sorted by ID
+func (sm *Manager) ListScopedTokens() []ScopedToken {
+	sm.mux.Lock()
+	defer sm.mux.Unlock()
+
+	var tokens []ScopedToken
+	for _, st := range sm.scopedTokens {
+		if checkHandleNotExpired(st.Expires) == nil {
+			tokens = append(tokens, *st)
+		}
+	}
+	sort.Slice(tokens, func(i, j int) bool { return tokens[i].ID < tokens[j].ID })
+	return tokens
+}
+
+// RevokeScopedToken deletes the scoped token with ID id
+func (sm *Manager) RevokeScopedToken(id string) error {
+	sm.mux.Lock()
+	defer sm.mux.Unlock()
+
+	if _, ok := sm.scopedTokens[id]; !ok {
+		return errTokenNotFound
+	}
+	delete(sm.scopedTokens, id)
+	return nil
+}
+
+// AuthWithScopedToken parses an untrusted API token and returns the scoped
+// token it corresponds to if and only if it is valid and has not expired.
+func (sm *Manager) AuthWithScopedToken(token []byte) (*ScopedToken, error) {
+	tokenID, tokenSecret, err := splitHandle(token)
+	if err != nil {
+		return nil, err
+	}
+
+	sm.mux.Lock()
+	defer sm.mux.Unlock()
+
+	st, ok := sm.scopedTokens[string(tokenID)]
+	if !ok {
+		return nil, errTokenNotFound
+	}
+
+	// Check that the token is correct in constant time
+	if subtle.ConstantTimeCompare(tokenSecret, st.secret) != 1 {
+		return nil, fmt.Errorf("invalid token")
+	}
+
+	err = checkHandleNotExpired(st.Expires)
+	if err != nil {
+		delete(sm.scopedTokens, st.ID)
+		return nil, err
+	}
+	return st, nil
+}
+
+// passwordWallet is a wallet which operations requiring the wallet password are made with pw, ignoring the
+// password they are passed.
+type passwordWallet struct {
+	wallet.Wallet
+	pw []byte
+}
+
+// DeleteKey implements the Wallet interface.
+func (w passwordWallet) DeleteKey(pk crypto.Digest, pw []byte) error {
+	return w.Wallet.DeleteKey(pk, w.pw)
+}
+
+// DeleteMultisigAddr implements the Wallet interface.
+func (w passwordWallet) DeleteMultisigAddr(addr crypto.Digest, pw []byte) error {
+	return w.Wallet.DeleteMultisigAddr(addr, w.pw)
+}
+
+// SignTransaction implements the Wallet interface.
+func (w passwordWallet) SignTransaction(tx transactions.Transaction, pk crypto.PublicKey, pw []byte) ([]byte, error) {
+	return w.Wallet.SignTransaction(tx, pk, w.pw)
+}
+
+// SignProgram implements the Wallet interface.
+func (w passwordWallet) SignProgram(program []byte, src crypto.Digest, pw []byte) ([]byte, error) {
+	return w.Wallet.SignProgram(program, src, w.pw)
+}
+
+// MultisigSignTransaction implements the Wallet interface.
+func (w passwordWallet) MultisigSignTransaction(tx transactions.Transaction, pk crypto.PublicKey, partial crypto.MultisigSig, pw []byte, signer crypto.Digest) (crypto.MultisigSig, error) {
+	return w.Wallet.MultisigSignTransaction(tx, pk, partial, w.pw, signer)
+}
+
+// MultisigSignProgram implements the Wallet interface.
+func (w passwordWallet) MultisigSignProgram(program []byte, src crypto.Digest, pk crypto.PublicKey, partial crypto.MultisigSig, pw []byte) (crypto.MultisigSig, error) {
+	return w.Wallet.MultisigSignProgram(program, src, pk, partial, w.pw)
+}
@@ -0,0 +1,145 @@
+// Copyright (C) 2019-2025 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package session
+
+import (
+	"bytes"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/algorand/go-algorand/crypto"
+	"github.com/algorand/go-algorand/daemon/kmd/config"
+	"github.com/algorand/go-algorand/daemon/kmd/wallet"
+	"github.com/algorand/go-algorand/data/transactions"
+	"github.com/algorand/go-algorand/test/partitiontest"
+)
+
+var errTestWrongPassword = errors.New("wrong password")
+
+// testWallet accepts a single password, and records the password it is asked to sign with
+type testWallet struct {
+	wallet.Wallet
+	pw       []byte
+	signedPw []byte
+}
+
+func (w *testWallet) Init(pw []byte) error {
+	if !bytes.Equal(pw, w.pw) {
+		return errTestWrongPassword
+	}
+	return nil
+}
+
+func (w *testWallet) SignTransaction(tx transactions.Transaction, pk crypto.PublicKey, pw []byte) ([]byte, error) {
+	w.signedPw = pw
+	return []byte("stx"), nil
+}
+
+func makeTestManager(t *testing.T) *Manager {
+	sm := MakeManager(config.KMDConfig{SessionLifetimeSecs: 60}, nil)
+	t.Cleanup(sm.Kill)
+	return sm
+}
+
+func TestScopedTokenCreate(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	sm := makeTestManager(t)
+	w := &testWallet{pw: []byte("pw")}
+
+	_, _, err := sm.CreateScopedToken(w, []byte("id"), []byte("pw"), nil, 0)
+	require.ErrorIs(t, err, errNoScopes)
+	_, _, err = sm.CreateScopedToken(w, []byte("id"), []byte("pw"), []string{ScopeList, "export"}, 0)
+	require.ErrorIs(t, err, errUnknownScope)
+	_, _, err = sm.CreateScopedToken(w, []byte("id"), []byte("bad"), []string{ScopeList}, 0)
+	require.ErrorIs(t, err, errTestWrongPassword)
+	require.Empty(t, sm.ListScopedTokens())
+
+	token, st, err := sm.CreateScopedToken(w, []byte("id"), []byte("pw"), []string{ScopeList, ScopeSign}, 0)
+	require.NoError(t, err)
+	require.True(t, st.HasScope(ScopeList))
+	require.True(t, st.HasScope(ScopeSign))
+	require.False(t, st.HasScope(ScopeManage))
+	require.WithinDuration(t, time.Now().Add(defaultScopedTokenLifetime), st.Expires, time.Minute)
+
+	authed, err := sm.AuthWithScopedToken(token)
+	require.NoError(t, err)
+	require.Equal(t, st.ID, authed.ID)
+	require.Equal(t, []byte("id"), authed.WalletID)
+
+	// the wallet of the token signs with the password the token was created with
+	_, err = authed.Wallet().SignTransaction(transactions.Transaction{}, crypto.PublicKey{}, nil)
+	require.NoError(t, err)
+	require.Equal(t, []byte("pw"), w.signedPw)
+
+	tokens := sm.ListScopedTokens()
+	require.Len(t, tokens, 1)
+	require.Equal(t, st.ID, tokens[0].ID)
+}
+
+func TestScopedTokenAuth(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	sm := makeTestManager(t)
+	w := &testWallet{pw: []byte("pw")}
+
+	token, st, err := sm.CreateScopedToken(w, []byte("id"), []byte("pw"), []string{ScopeList}, 0)
+	require.NoError(t, err)
+
+	// a token with the right ID but the wrong secret is refused
+	tokenID, _, err := splitHandle(token)
+	require.NoError(t, err)
+	_, otherSecret, err := generateHandleIDAndSecret()
+	require.NoError(t, err)
+	_, err = sm.AuthWithScopedToken([]byte(string(tokenID) + string(wHandleTokenSplitChar) + string(otherSecret)))
+	require.ErrorContains(t, err, "invalid token")
+
+	_, err = sm.AuthWithScopedToken([]byte("garbage"))
+	require.Error(t, err)
+
+	// a revoked token is refused
+	require.NoError(t, sm.RevokeScopedToken(st.ID))
+	_, err = sm.AuthWithScopedToken(token)
+	require.ErrorIs(t, err, errTokenNotFound)
+	require.ErrorIs(t, sm.RevokeScopedToken(st.ID), errTokenNotFound)
+	require.Empty(t, sm.ListScopedTokens())
+}
+
+func TestScopedTokenExpiry(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	sm := makeTestManager(t)
+	w := &testWallet{pw: []byte("pw")}
+
+	token, st, err := sm.CreateScopedToken(w, []byte("id"), []byte("pw"), []string{ScopeSign}, 10*time.Millisecond)
+	require.NoError(t, err)
+	time.Sleep(20 * time.Millisecond)
+
+	// an expired token is no longer listed, and is deleted when used
+	require.Empty(t, sm.ListScopedTokens())
+	_, err = sm.AuthWithScopedToken(token)
+	require.Error(t, err)
+	_, err = sm.AuthWithScopedToken(token)
+	require.ErrorIs(t, err, errTokenNotFound)
+	require.ErrorIs(t, sm.RevokeScopedToken(st.ID), errTokenNotFound)
+}
@@ -0,0 +1,44 @@
+// Copyright (C) 2019-2025 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package threshold
+
+import (
+	"fmt"
+)
+
+var errBadParameters = fmt.Errorf("threshold must be between 1 and the number of participants")
+var errMalformedCommitment = fmt.Errorf("malformed threshold commitment")
+var errInvalidProof = fmt.Errorf("invalid proof of knowledge in threshold key generation")
+var errMissingParticipants = fmt.Errorf("threshold key generation is missing participants")
+var errCommitmentMismatch = fmt.Errorf("threshold commitment does not match the secrets of the participant")
+var errDKGNotStarted = fmt.Errorf("threshold key generation has not received the commitments of the participants")
+var errNotEnoughSigners = fmt.Errorf("not enough signers to reach the threshold")
+var errNotASigner = fmt.Errorf("participant is not one of the signers")
+var errInvalidSignature = fmt.Errorf("threshold signature does not verify")
+var errMalformedIdentity = fmt.Errorf("malformed threshold participant key")
+var errMalformedShare = fmt.Errorf("threshold key generation share cannot be decrypted")
+var errUnauthenticatedResponse = fmt.Errorf("threshold response is not authenticated by the participant")
+
+// errInvalidShare identifies the participant which sent an invalid share,
+// either in the key generation or in a signature
+type errInvalidShare struct {
+	index uint64
+}
+
+func (e errInvalidShare) Error() string {
+	return fmt.Sprintf("participant %d sent an invalid threshold share", e.index)
+}
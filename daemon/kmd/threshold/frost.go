@@ -0,0 +1,524 @@
+// Copyright (C) 2019-2025 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+// Package threshold implements threshold ed25519 signing with FROST
+// (RFC 9591, FROST(Ed25519, SHA-512)), and the distributed generation of its
+// keys, so that several kmd instances each holding a share of a key produce
+// ordinary ed25519 signatures, without the key ever existing in one place.
+package threshold
+
+import (
+	"cmp"
+	"crypto/sha512"
+	"slices"
+
+	"github.com/algorand/go-algorand/crypto"
+)
+
+const contextString = "FROST-ED25519-SHA512-v1"
+
+// KeyShare is the share of a threshold key held by a participant
+type KeyShare struct {
+	// Index of the participant, starting at 1
+	Index     uint64 `codec:"index"`
+	Threshold uint64 `codec:"threshold"`
+	// SecretShare is the value of the secret polynomial of the key at Index
+	SecretShare crypto.Ed25519Scalar `codec:"secret_share"`
+	GroupKey    crypto.PublicKey     `codec:"group_key"`
+	// VerificationShares are the public shares of the participants, the
+	// share of participant i being at i-1
+	VerificationShares []crypto.Ed25519Point `codec:"verification_shares"`
+}
+
+// hashToScalar hashes the parts prefixed with the context string and tag to a scalar
+func hashToScalar(tag string, parts ...[]byte) crypto.Ed25519Scalar {
+	h := sha512.New()
+	h.Write([]byte(contextString))
+	h.Write([]byte(tag))
+	for _, part := range parts {
+		h.Write(part)
+	}
+	var digest [64]byte
+	h.Sum(digest[:0])
+	return crypto.Ed25519ScalarFromHash(digest)
+}
+
+// hashWithTag hashes the parts prefixed with the context string and tag
+func hashWithTag(tag string, parts ...[]byte) []byte {
+	h := sha512.New()
+	h.Write([]byte(contextString))
+	h.Write([]byte(tag))
+	for _, part := range parts {
+		h.Write(part)
+	}
+	return h.Sum(nil)
+}
+
+func encodeIndex(index uint64) []byte {
+	s := crypto.Ed25519ScalarFromUint64(index)
+	return s[:]
+}
+
+// evaluatePolynomial returns the value at x of the polynomial of coefficients
+func evaluatePolynomial(coefficients []crypto.Ed25519Scalar, x uint64) crypto.Ed25519Scalar {
+	xs := crypto.Ed25519ScalarFromUint64(x)
+	var value crypto.Ed25519Scalar
+	for i := len(coefficients) - 1; i >= 0; i-- {
+		value = value.Mul(xs).Add(coefficients[i])
+	}
+	return value
+}
+
+// evaluateCommitment returns the value at x of the polynomial which
+// coefficients are committed to, times the base point
+func evaluateCommitment(commitments []crypto.Ed25519Point, x uint64) (value crypto.Ed25519Point, err error) {
+	xs := crypto.Ed25519ScalarFromUint64(x)
+	value = commitments[len(commitments)-1]
+	for i := len(commitments) - 2; i >= 0; i-- {
+		value, err = value.ScalarMult(xs)
+		if err != nil {
+			return
+		}
+		value, err = value.Add(commitments[i])
+		if err != nil {
+			return
+		}
+	}
+	return value, nil
+}
+
+// DKGCommitment is what a participant broadcasts in the first round of the
+// key generation: the commitments to the coefficients of its secret
+// polynomial, and a proof of knowledge of its secret
+type DKGCommitment struct {
+	Index       uint64                `codec:"index"`
+	Commitments []crypto.Ed25519Point `codec:"commitments"`
+	ProofR      crypto.Ed25519Point   `codec:"proof_r"`
+	ProofZ      crypto.Ed25519Scalar  `codec:"proof_z"`
+}
+
+func dkgChallenge(index uint64, secretCommitment crypto.Ed25519Point, r crypto.Ed25519Point) crypto.Ed25519Scalar {
+	return hashToScalar("dkg", encodeIndex(index), secretCommitment[:], r[:])
+}
+
+// verify checks the proof of knowledge of the commitment of a key generation
+// of n participants with threshold
+func (c *DKGCommitment) verify(threshold uint64, n uint64) error {
+	if c.Index == 0 || c.Index > n || uint64(len(c.Commitments)) != threshold {
+		return errMalformedCommitment
+	}
+	for _, commitment := range c.Commitments {
+		if !commitment.IsValid() {
+			return errMalformedCommitment
+		}
+	}
+	if !c.ProofR.IsValid() || !c.ProofZ.IsCanonical() {
+		return errMalformedCommitment
+	}
+
+	// The proof is valid if z*G - c*C_0 == R
+	challenge := dkgChallenge(c.Index, c.Commitments[0], c.ProofR)
+	zG, err := crypto.Ed25519ScalarBaseMult(c.ProofZ)
+	if err != nil {
+		return errInvalidProof
+	}
+	cC, err := c.Commitments[0].ScalarMult(challenge)
+	if err != nil {
+		return errInvalidProof
+	}
+	r, err := zG.Sub(cC)
+	if err != nil || r != c.ProofR {
+		return errInvalidProof
+	}
+	return nil
+}
+
+// DKG is the state of a participant in a key generation, which follows the
+// Pedersen key generation with proofs of knowledge of the FROST paper. Every
+// participant broadcasts its commitment, sends the value of its secret
+// polynomial at j to participant j, and finally checks the values it received
+// against the commitments of their senders.
+type DKG struct {
+	index        uint64
+	threshold    uint64
+	n            uint64
+	coefficients []crypto.Ed25519Scalar
+	commitments  []DKGCommitment
+}
+
+// NewDKG starts the key generation of a key shared by n participants, with
+// threshold of them needed to sign, by participant index
+func NewDKG(index uint64, threshold uint64, n uint64) (*DKG, DKGCommitment, error) {
+	if threshold == 0 || threshold > n || index == 0 || index > n {
+		return nil, DKGCommitment{}, errBadParameters
+	}
+
+	d := &DKG{index: index, threshold: threshold, n: n}
+	commitment := DKGCommitment{Index: index}
+	for i := uint64(0); i < threshold; i++ {
+		coefficient := crypto.RandomEd25519Scalar()
+		point, err := crypto.Ed25519ScalarBaseMult(coefficient)
+		if err != nil {
+			return nil, DKGCommitment{}, err
+		}
+		d.coefficients = append(d.coefficients, coefficient)
+		commitment.Commitments = append(commitment.Commitments, point)
+	}
+
+	// Prove the knowledge of the secret, the first coefficient
+	k := crypto.RandomEd25519Scalar()
+	r, err := crypto.Ed25519ScalarBaseMult(k)
+	if err != nil {
+		return nil, DKGCommitment{}, err
+	}
+	commitment.ProofR = r
+	commitment.ProofZ = k.Add(d.coefficients[0].Mul(dkgChallenge(index, commitment.Commitments[0], r)))
+	return d, commitment, nil
+}
+
+// Shares checks the commitments of every participant, and returns the shares
+// to send to them, the share of participant j being at j-1
+func (d *DKG) Shares(commitments []DKGCommitment) ([]crypto.Ed25519Scalar, error) {
+	if uint64(len(commitments)) != d.n {
+		return nil, errMissingParticipants
+	}
+	sorted := slices.Clone(commitments)
+	slices.SortFunc(sorted, func(a, b DKGCommitment) int { return cmp.Compare(a.Index, b.Index) })
+	for i := range sorted {
+		if sorted[i].Index != uint64(i)+1 {
+			return nil, errMalformedCommitment
+		}
+		err := sorted[i].verify(d.threshold, d.n)
+		if err != nil {
+			return nil, err
+		}
+	}
+	if !slices.Equal(sorted[d.index-1].Commitments, commitmentOf(d.coefficients)) {
+		return nil, errCommitmentMismatch
+	}
+	d.commitments = sorted
+
+	shares := make([]crypto.Ed25519Scalar, d.n)
+	for j := uint64(1); j <= d.n; j++ {
+		shares[j-1] = evaluatePolynomial(d.coefficients, j)
+	}
+	return shares, nil
+}
+
+func commitmentOf(coefficients []crypto.Ed25519Scalar) []crypto.Ed25519Point {
+	points := make([]crypto.Ed25519Point, len(coefficients))
+	for i, coefficient := range coefficients {
+		points[i], _ = crypto.Ed25519ScalarBaseMult(coefficient)
+	}
+	return points
+}
+
+// Finish checks the shares received from every participant, the share of
+// participant j being at j-1, and returns the key share of the participant
+func (d *DKG) Finish(received []crypto.Ed25519Scalar) (KeyShare, error) {
+	if d.commitments == nil {
+		return KeyShare{}, errDKGNotStarted
+	}
+	if uint64(len(received)) != d.n {
+		return KeyShare{}, errMissingParticipants
+	}
+
+	var secret crypto.Ed25519Scalar
+	for i, share := range received {
+		// The share from participant i must be the value of its polynomial at our index
+		if !share.IsCanonical() {
+			return KeyShare{}, errInvalidShare{index: uint64(i) + 1}
+		}
+		expected, err := evaluateCommitment(d.commitments[i].Commitments, d.index)
+		if err != nil {
+			return KeyShare{}, errInvalidShare{index: uint64(i) + 1}
+		}
+		actual, err := crypto.Ed25519ScalarBaseMult(share)
+		if err != nil || actual != expected {
+			return KeyShare{}, errInvalidShare{index: uint64(i) + 1}
+		}
+		secret = secret.Add(share)
+	}
+
+	// The commitments to the group polynomial are the sums of the commitments
+	// of every participant
+	groupCommitments := slices.Clone(d.commitments[0].Commitments)
+	for _, c := range d.commitments[1:] {
+		for k := range groupCommitments {
+			var err error
+			groupCommitments[k], err = groupCommitments[k].Add(c.Commitments[k])
+			if err != nil {
+				return KeyShare{}, err
+			}
+		}
+	}
+	if !groupCommitments[0].IsValid() {
+		return KeyShare{}, errMalformedCommitment
+	}
+
+	ks := KeyShare{
+		Index:       d.index,
+		Threshold:   d.threshold,
+		SecretShare: secret,
+		GroupKey:    crypto.PublicKey(groupCommitments[0]),
+	}
+	for j := uint64(1); j <= d.n; j++ {
+		share, err := evaluateCommitment(groupCommitments, j)
+		if err != nil {
+			return KeyShare{}, err
+		}
+		ks.VerificationShares = append(ks.VerificationShares, share)
+	}
+	if ks.VerificationShares[d.index-1] != mustBaseMult(secret) {
+		return KeyShare{}, errInvalidShare{index: d.index}
+	}
+	return ks, nil
+}
+
+func mustBaseMult(s crypto.Ed25519Scalar) crypto.Ed25519Point {
+	p, _ := crypto.Ed25519ScalarBaseMult(s)
+	return p
+}
+
+// SigningNonces are the secret nonces of a participant for one signature.
+// They must never be used twice.
+type SigningNonces struct {
+	Hiding  crypto.Ed25519Scalar
+	Binding crypto.Ed25519Scalar
+}
+
+// SigningCommitment is the commitment of a participant to its nonces for one
+// signature
+type SigningCommitment struct {
+	Index   uint64              `codec:"index"`
+	Hiding  crypto.Ed25519Point `codec:"hiding"`
+	Binding crypto.Ed25519Point `codec:"binding"`
+}
+
+// nonceGenerate derives a nonce from fresh randomness and the secret share,
+// so that a weak random number generator does not leak the secret share
+func nonceGenerate(secret crypto.Ed25519Scalar) crypto.Ed25519Scalar {
+	var random [32]byte
+	crypto.RandBytes(random[:])
+	return hashToScalar("nonce", random[:], secret[:])
+}
+
+// Commit generates the nonces of the participant for a signature, and its
+// commitment to them
+func (ks *KeyShare) Commit() (nonces SigningNonces, commitment SigningCommitment, err error) {
+	nonces.Hiding = nonceGenerate(ks.SecretShare)
+	nonces.Binding = nonceGenerate(ks.SecretShare)
+	commitment.Index = ks.Index
+	commitment.Hiding, err = crypto.Ed25519ScalarBaseMult(nonces.Hiding)
+	if err != nil {
+		return
+	}
+	commitment.Binding, err = crypto.Ed25519ScalarBaseMult(nonces.Binding)
+	return
+}
+
+// signingPackage holds what the participants in a signature compute from
+// the message and the commitments of the signers
+type signingPackage struct {
+	commitments    []SigningCommitment
+	bindingFactors []crypto.Ed25519Scalar
+	groupR         crypto.Ed25519Point
+	challenge      crypto.Ed25519Scalar
+	signers        []uint64
+}
+
+// makeSigningPackage checks the commitments of the signers of msg, and
+// computes their binding factors, the group commitment, and the challenge
+func (ks *KeyShare) makeSigningPackage(msg []byte, commitments []SigningCommitment) (*signingPackage, error) {
+	if uint64(len(commitments)) < ks.Threshold {
+		return nil, errNotEnoughSigners
+	}
+	sp := &signingPackage{commitments: slices.Clone(commitments)}
+	slices.SortFunc(sp.commitments, func(a, b SigningCommitment) int { return cmp.Compare(a.Index, b.Index) })
+
+	var encodedCommitments []byte
+	for i, c := range sp.commitments {
+		if c.Index == 0 || c.Index > uint64(len(ks.VerificationShares)) || (i > 0 && sp.commitments[i-1].Index == c.Index) {
+			return nil, errMalformedCommitment
+		}
+		if !c.Hiding.IsValid() || !c.Binding.IsValid() {
+			return nil, errMalformedCommitment
+		}
+		encodedCommitments = append(encodedCommitments, encodeIndex(c.Index)...)
+		encodedCommitments = append(encodedCommitments, c.Hiding[:]...)
+		encodedCommitments = append(encodedCommitments, c.Binding[:]...)
+		sp.signers = append(sp.signers, c.Index)
+	}
+
+	// Bind the nonces of every signer to the message and the commitments of
+	// the other signers
+	rhoInput := slices.Concat(ks.GroupKey[:], hashWithTag("msg", msg), hashWithTag("com", encodedCommitments))
+	for i, c := range sp.commitments {
+		sp.bindingFactors = append(sp.bindingFactors, hashToScalar("rho", rhoInput, encodeIndex(c.Index)))
+		bE, err := c.Binding.ScalarMult(sp.bindingFactors[i])
+		if err != nil {
+			return nil, err
+		}
+		r, err := c.Hiding.Add(bE)
+		if err != nil {
+			return nil, err
+		}
+		if i == 0 {
+			sp.groupR = r
+		} else {
+			sp.groupR, err = sp.groupR.Add(r)
+			if err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	// The challenge of an ordinary ed25519 signature by the group key
+	h := sha512.New()
+	h.Write(sp.groupR[:])
+	h.Write(ks.GroupKey[:])
+	h.Write(msg)
+	var digest [64]byte
+	h.Sum(digest[:0])
+	sp.challenge = crypto.Ed25519ScalarFromHash(digest)
+	return sp, nil
+}
+
+// lagrangeCoefficient returns the coefficient of the share of index when
+// interpolating the secret from the shares of signers
+func lagrangeCoefficient(index uint64, signers []uint64) (crypto.Ed25519Scalar, error) {
+	num := crypto.Ed25519ScalarFromUint64(1)
+	den := crypto.Ed25519ScalarFromUint64(1)
+	x := crypto.Ed25519ScalarFromUint64(index)
+	for _, signer := range signers {
+		if signer == index {
+			continue
+		}
+		xj := crypto.Ed25519ScalarFromUint64(signer)
+		num = num.Mul(xj)
+		den = den.Mul(xj.Sub(x))
+	}
+	inv, err := den.Invert()
+	if err != nil {
+		return crypto.Ed25519Scalar{}, err
+	}
+	return num.Mul(inv), nil
+}
+
+// signerPosition returns the position of index among the signers of sp
+func (sp *signingPackage) signerPosition(index uint64) (int, bool) {
+	return slices.BinarySearch(sp.signers, index)
+}
+
+// Sign returns the signature share of the participant on msg, given its
+// nonces and the commitments of every signer, including its own
+func (ks *KeyShare) Sign(msg []byte, nonces SigningNonces, commitments []SigningCommitment) (crypto.Ed25519Scalar, error) {
+	sp, err := ks.makeSigningPackage(msg, commitments)
+	if err != nil {
+		return crypto.Ed25519Scalar{}, err
+	}
+	pos, ok := sp.signerPosition(ks.Index)
+	if !ok {
+		return crypto.Ed25519Scalar{}, errNotASigner
+	}
+	hiding, err := crypto.Ed25519ScalarBaseMult(nonces.Hiding)
+	if err != nil || hiding != sp.commitments[pos].Hiding {
+		return crypto.Ed25519Scalar{}, errCommitmentMismatch
+	}
+	binding, err := crypto.Ed25519ScalarBaseMult(nonces.Binding)
+	if err != nil || binding != sp.commitments[pos].Binding {
+		return crypto.Ed25519Scalar{}, errCommitmentMismatch
+	}
+
+	lambda, err := lagrangeCoefficient(ks.Index, sp.signers)
+	if err != nil {
+		return crypto.Ed25519Scalar{}, err
+	}
+	// z_i = d_i + e_i * rho_i + lambda_i * s_i * c
+	z := nonces.Hiding.Add(nonces.Binding.Mul(sp.bindingFactors[pos]))
+	z = z.Add(lambda.Mul(ks.SecretShare).Mul(sp.challenge))
+	return z, nil
+}
+
+// Aggregate checks the signature shares of the signers of msg, the share of
+// the signer of the commitment at i being at i, and returns the ed25519
+// signature of msg by the group key
+func (ks *KeyShare) Aggregate(msg []byte, commitments []SigningCommitment, shares []crypto.Ed25519Scalar) (sig crypto.Signature, err error) {
+	if len(shares) != len(commitments) {
+		return sig, errNotEnoughSigners
+	}
+	byIndex := make(map[uint64]crypto.Ed25519Scalar, len(shares))
+	for i, c := range commitments {
+		byIndex[c.Index] = shares[i]
+	}
+	sp, err := ks.makeSigningPackage(msg, commitments)
+	if err != nil {
+		return
+	}
+
+	var z crypto.Ed25519Scalar
+	for i, c := range sp.commitments {
+		share := byIndex[c.Index]
+		err = ks.verifySignatureShare(sp, i, share)
+		if err != nil {
+			return
+		}
+		z = z.Add(share)
+	}
+
+	copy(sig[:32], sp.groupR[:])
+	copy(sig[32:], z[:])
+	if !crypto.SignatureVerifier(ks.GroupKey).VerifyBytes(msg, sig) {
+		return crypto.Signature{}, errInvalidSignature
+	}
+	return sig, nil
+}
+
+// verifySignatureShare checks the share of the signer at pos in sp, so that a
+// misbehaving signer is identified
+func (ks *KeyShare) verifySignatureShare(sp *signingPackage, pos int, share crypto.Ed25519Scalar) error {
+	c := sp.commitments[pos]
+	if !share.IsCanonical() {
+		return errInvalidShare{index: c.Index}
+	}
+	lambda, err := lagrangeCoefficient(c.Index, sp.signers)
+	if err != nil {
+		return err
+	}
+
+	// z_i * G must be D_i + rho_i * E_i + lambda_i * c * Y_i
+	zG, err := crypto.Ed25519ScalarBaseMult(share)
+	if err != nil {
+		return errInvalidShare{index: c.Index}
+	}
+	bE, err := c.Binding.ScalarMult(sp.bindingFactors[pos])
+	if err != nil {
+		return errInvalidShare{index: c.Index}
+	}
+	expected, err := c.Hiding.Add(bE)
+	if err != nil {
+		return errInvalidShare{index: c.Index}
+	}
+	cY, err := ks.VerificationShares[c.Index-1].ScalarMult(lambda.Mul(sp.challenge))
+	if err != nil {
+		return errInvalidShare{index: c.Index}
+	}
+	expected, err = expected.Add(cY)
+	if err != nil || expected != zG {
+		return errInvalidShare{index: c.Index}
+	}
+	return nil
+}
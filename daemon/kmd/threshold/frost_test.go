@@ -0,0 +1,193 @@
+// Copyright (C) 2019-2025 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package threshold
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/algorand/go-algorand/crypto"
+	"github.com/algorand/go-algorand/data/basics"
+	"github.com/algorand/go-algorand/data/transactions"
+	"github.com/algorand/go-algorand/protocol"
+	"github.com/algorand/go-algorand/test/partitiontest"
+)
+
+// runDKG runs a key generation among n participants, exchanging the messages
+// directly
+func runDKG(t *testing.T, threshold uint64, n uint64) []KeyShare {
+	dkgs := make([]*DKG, n)
+	commitments := make([]DKGCommitment, n)
+	for i := range dkgs {
+		var err error
+		dkgs[i], commitments[i], err = NewDKG(uint64(i)+1, threshold, n)
+		require.NoError(t, err)
+	}
+
+	// received[j][i] is the share sent by participant i+1 to participant j+1
+	received := make([][]crypto.Ed25519Scalar, n)
+	for j := range received {
+		received[j] = make([]crypto.Ed25519Scalar, n)
+	}
+	for i, d := range dkgs {
+		shares, err := d.Shares(commitments)
+		require.NoError(t, err)
+		for j, share := range shares {
+			received[j][i] = share
+		}
+	}
+
+	keyShares := make([]KeyShare, n)
+	for j, d := range dkgs {
+		var err error
+		keyShares[j], err = d.Finish(received[j])
+		require.NoError(t, err)
+	}
+	for _, ks := range keyShares[1:] {
+		require.Equal(t, keyShares[0].GroupKey, ks.GroupKey)
+		require.Equal(t, keyShares[0].VerificationShares, ks.VerificationShares)
+	}
+	return keyShares
+}
+
+// sign runs a signature of msg by the participants at positions signers
+func sign(t *testing.T, keyShares []KeyShare, signers []int, msg []byte) (crypto.Signature, error) {
+	nonces := make([]SigningNonces, len(signers))
+	commitments := make([]SigningCommitment, len(signers))
+	for i, s := range signers {
+		var err error
+		nonces[i], commitments[i], err = keyShares[s].Commit()
+		require.NoError(t, err)
+	}
+	shares := make([]crypto.Ed25519Scalar, len(signers))
+	for i, s := range signers {
+		var err error
+		shares[i], err = keyShares[s].Sign(msg, nonces[i], commitments)
+		if err != nil {
+			return crypto.Signature{}, err
+		}
+	}
+	return keyShares[signers[0]].Aggregate(msg, commitments, shares)
+}
+
+func TestThresholdSignature(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	keyShares := runDKG(t, 2, 3)
+	groupKey := keyShares[0].GroupKey
+
+	tx := transactions.Transaction{
+		Type: protocol.PaymentTx,
+		Header: transactions.Header{
+			Sender:     basics.Address(groupKey),
+			FirstValid: 1,
+			LastValid:  1000,
+		},
+	}
+	msg := crypto.HashRep(tx)
+
+	// Any two of the three participants produce an ordinary signature
+	for _, signers := range [][]int{{0, 1}, {0, 2}, {2, 1}, {0, 1, 2}} {
+		sig, err := sign(t, keyShares, signers, msg)
+		require.NoError(t, err)
+		require.True(t, crypto.SignatureVerifier(groupKey).Verify(tx, sig))
+	}
+
+	// One participant is not enough
+	_, err := sign(t, keyShares, []int{1}, msg)
+	require.ErrorIs(t, err, errNotEnoughSigners)
+}
+
+func TestThresholdInvalidSignatureShare(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	keyShares := runDKG(t, 2, 3)
+	msg := []byte("message")
+
+	nonces := make([]SigningNonces, 2)
+	commitments := make([]SigningCommitment, 2)
+	for i := range nonces {
+		var err error
+		nonces[i], commitments[i], err = keyShares[i].Commit()
+		require.NoError(t, err)
+	}
+	shares := make([]crypto.Ed25519Scalar, 2)
+	for i := range shares {
+		var err error
+		shares[i], err = keyShares[i].Sign(msg, nonces[i], commitments)
+		require.NoError(t, err)
+	}
+
+	// A wrong share is attributed to its signer
+	bad := append([]crypto.Ed25519Scalar{}, shares...)
+	bad[1] = bad[1].Add(crypto.Ed25519ScalarFromUint64(1))
+	_, err := keyShares[0].Aggregate(msg, commitments, bad)
+	require.Equal(t, errInvalidShare{index: 2}, err)
+
+	// Nonces must match the commitment of the signer
+	_, err = keyShares[0].Sign(msg, nonces[1], commitments)
+	require.ErrorIs(t, err, errCommitmentMismatch)
+
+	// A signer must be in the commitments
+	_, err = keyShares[2].Sign(msg, nonces[0], commitments)
+	require.ErrorIs(t, err, errNotASigner)
+
+	sig, err := keyShares[0].Aggregate(msg, commitments, shares)
+	require.NoError(t, err)
+	require.True(t, crypto.SignatureVerifier(keyShares[0].GroupKey).VerifyBytes(msg, sig))
+}
+
+func TestThresholdDKGInvalidShare(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	const n = 3
+	dkgs := make([]*DKG, n)
+	commitments := make([]DKGCommitment, n)
+	for i := range dkgs {
+		var err error
+		dkgs[i], commitments[i], err = NewDKG(uint64(i)+1, 2, n)
+		require.NoError(t, err)
+	}
+
+	// A commitment with a wrong proof of knowledge is rejected
+	forged := append([]DKGCommitment{}, commitments...)
+	forged[2].ProofZ = forged[2].ProofZ.Add(crypto.Ed25519ScalarFromUint64(1))
+	_, err := dkgs[0].Shares(forged)
+	require.ErrorIs(t, err, errInvalidProof)
+	_, err = dkgs[0].Shares(commitments[:2])
+	require.ErrorIs(t, err, errMissingParticipants)
+
+	received := make([]crypto.Ed25519Scalar, n)
+	for i, d := range dkgs {
+		shares, err := d.Shares(commitments)
+		require.NoError(t, err)
+		received[i] = shares[0]
+	}
+
+	// A share which does not match the commitment of its sender is attributed to it
+	bad := append([]crypto.Ed25519Scalar{}, received...)
+	bad[2] = bad[2].Add(crypto.Ed25519ScalarFromUint64(1))
+	_, err = dkgs[0].Finish(bad)
+	require.Equal(t, errInvalidShare{index: 3}, err)
+
+	_, err = dkgs[0].Finish(received)
+	require.NoError(t, err)
+
+	_, _, err = NewDKG(1, 3, 2)
+	require.ErrorIs(t, err, errBadParameters)
+}
@@ -0,0 +1,120 @@
+// Copyright (C) 2019-2025 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package threshold
+
+import (
+	"crypto/hmac"
+	"crypto/sha512"
+
+	"golang.org/x/crypto/nacl/secretbox"
+
+	"github.com/algorand/go-algorand/crypto"
+)
+
+// PeerKeyLen is the length of the keys shared by two participants
+const PeerKeyLen = 32
+
+const shareNonceLen = 24
+
+// Identity is the secret key a participant authenticates itself with to the
+// other participants. Every participant has its own, and only shares its
+// public key.
+type Identity struct {
+	Secret crypto.Ed25519Scalar
+	Public crypto.Ed25519Point
+}
+
+// NewIdentity generates a new identity
+func NewIdentity() (Identity, error) {
+	return IdentityFromSecret(crypto.RandomEd25519Scalar())
+}
+
+// IdentityFromSecret returns the identity of secret key s
+func IdentityFromSecret(s crypto.Ed25519Scalar) (id Identity, err error) {
+	if !s.IsCanonical() || s.IsZero() {
+		return id, errMalformedIdentity
+	}
+	id.Secret = s
+	id.Public, err = crypto.Ed25519ScalarBaseMult(s)
+	return
+}
+
+// PeerKey returns the key participants i and j of a wallet share, which
+// authenticates their requests to each other and encrypts the key generation
+// shares they send each other. Each of them derives it with Diffie-Hellman
+// from its own identity and the public key of the other.
+func (id Identity) PeerKey(wallet string, i uint64, peerPublic crypto.Ed25519Point, j uint64) ([]byte, error) {
+	if !peerPublic.IsValid() {
+		return nil, errMalformedIdentity
+	}
+	shared, err := peerPublic.ScalarMult(id.Secret)
+	if err != nil {
+		return nil, err
+	}
+	return hashWithTag("peer key", []byte(wallet), encodeIndex(min(i, j)), encodeIndex(max(i, j)), shared[:])[:PeerKeyLen], nil
+}
+
+// peerMAC authenticates the parts with key
+func peerMAC(key []byte, tag string, parts ...[]byte) []byte {
+	mac := hmac.New(sha512.New512_256, key)
+	mac.Write([]byte(contextString))
+	mac.Write([]byte(tag))
+	for _, part := range parts {
+		mac.Write(part)
+	}
+	return mac.Sum(nil)
+}
+
+// RequestMAC authenticates the request for path with body
+func RequestMAC(key []byte, path string, body []byte) []byte {
+	return peerMAC(key, "request", []byte(path), body)
+}
+
+// ResponseMAC authenticates body as the response to the request of MAC requestMAC
+func ResponseMAC(key []byte, requestMAC []byte, body []byte) []byte {
+	return peerMAC(key, "response", requestMAC, body)
+}
+
+// shareKey is the key encrypting the key generation share sent by participant from to participant to
+func shareKey(key []byte, session string, from uint64, to uint64) *[PeerKeyLen]byte {
+	var k [PeerKeyLen]byte
+	copy(k[:], peerMAC(key, "share", []byte(session), encodeIndex(from), encodeIndex(to)))
+	return &k
+}
+
+// SealShare encrypts the key generation share sent by participant from to participant to in session, with their
+// peer key
+func SealShare(key []byte, session string, from uint64, to uint64, share crypto.Ed25519Scalar) []byte {
+	var nonce [shareNonceLen]byte
+	crypto.RandBytes(nonce[:])
+	return secretbox.Seal(nonce[:], share[:], &nonce, shareKey(key, session, from, to))
+}
+
+// OpenShare decrypts a share sealed by SealShare
+func OpenShare(key []byte, session string, from uint64, to uint64, sealed []byte) (share crypto.Ed25519Scalar, err error) {
+	if len(sealed) < shareNonceLen {
+		return share, errMalformedShare
+	}
+	var nonce [shareNonceLen]byte
+	copy(nonce[:], sealed)
+	plaintext, ok := secretbox.Open(nil, sealed[shareNonceLen:], &nonce, shareKey(key, session, from, to))
+	if !ok || len(plaintext) != len(share) {
+		return share, errMalformedShare
+	}
+	copy(share[:], plaintext)
+	return share, nil
+}
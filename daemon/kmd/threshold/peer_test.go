@@ -0,0 +1,86 @@
+// Copyright (C) 2019-2025 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package threshold
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/algorand/go-algorand/crypto"
+	"github.com/algorand/go-algorand/test/partitiontest"
+)
+
+func TestPeerKey(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	a, err := NewIdentity()
+	require.NoError(t, err)
+	b, err := NewIdentity()
+	require.NoError(t, err)
+	c, err := NewIdentity()
+	require.NoError(t, err)
+
+	// both participants derive the same key, which differs for other participants and wallets
+	ab, err := a.PeerKey("w", 1, b.Public, 2)
+	require.NoError(t, err)
+	ba, err := b.PeerKey("w", 2, a.Public, 1)
+	require.NoError(t, err)
+	require.Equal(t, ab, ba)
+	ac, err := a.PeerKey("w", 1, c.Public, 2)
+	require.NoError(t, err)
+	require.NotEqual(t, ab, ac)
+	abOther, err := a.PeerKey("other", 1, b.Public, 2)
+	require.NoError(t, err)
+	require.NotEqual(t, ab, abOther)
+
+	_, err = a.PeerKey("w", 1, crypto.Ed25519Point{}, 2)
+	require.ErrorIs(t, err, errMalformedIdentity)
+	_, err = IdentityFromSecret(crypto.Ed25519Scalar{})
+	require.ErrorIs(t, err, errMalformedIdentity)
+	restored, err := IdentityFromSecret(a.Secret)
+	require.NoError(t, err)
+	require.Equal(t, a, restored)
+}
+
+func TestSealShare(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	key := make([]byte, PeerKeyLen)
+	crypto.RandBytes(key)
+	share := crypto.RandomEd25519Scalar()
+
+	sealed := SealShare(key, "session", 1, 2, share)
+	require.NotContains(t, string(sealed), string(share[:]))
+	opened, err := OpenShare(key, "session", 1, 2, sealed)
+	require.NoError(t, err)
+	require.Equal(t, share, opened)
+
+	// the share only opens with the key, session and participants it was sealed for
+	otherKey := make([]byte, PeerKeyLen)
+	for _, open := range []func() (crypto.Ed25519Scalar, error){
+		func() (crypto.Ed25519Scalar, error) { return OpenShare(otherKey, "session", 1, 2, sealed) },
+		func() (crypto.Ed25519Scalar, error) { return OpenShare(key, "other", 1, 2, sealed) },
+		func() (crypto.Ed25519Scalar, error) { return OpenShare(key, "session", 2, 1, sealed) },
+		func() (crypto.Ed25519Scalar, error) { return OpenShare(key, "session", 1, 2, sealed[:10]) },
+	} {
+		_, err = open()
+		require.ErrorIs(t, err, errMalformedShare)
+	}
+}
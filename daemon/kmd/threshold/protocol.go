@@ -0,0 +1,209 @@
+// Copyright (C) 2019-2025 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package threshold
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/algorand/go-algorand/crypto"
+	"github.com/algorand/go-algorand/protocol"
+)
+
+// The threshold protocol: the kmd instances holding the shares of the keys of
+// a threshold wallet make the requests below to each other over HTTP. Every
+// participant has its own Identity, and the requests and their responses are
+// authenticated with the PeerKey of the two participants, in PeerAuthHeader.
+// The kmd asked to generate a key or to sign coordinates the others:
+//
+//   - To generate a key, the coordinator requests the DKGCommitment of every
+//     participant, then sends all of them to every participant, which then
+//     sends its shares directly to the other participants, encrypted with
+//     SealShare. Finally, every participant checks the shares it received and
+//     stores its key share.
+//   - To sign, the coordinator requests the SigningCommitment of enough
+//     participants to reach the threshold, then sends the message and the
+//     commitments to them, and aggregates their signature shares. Each
+//     participant only commits to, and signs, a message its own user approved.
+const (
+	// DKGCommitPath starts a key generation
+	DKGCommitPath = "/v1/threshold/dkg/commit"
+	// DKGSharesPath sends the commitments of every participant to a participant
+	DKGSharesPath = "/v1/threshold/dkg/shares"
+	// DKGDeliverPath sends the share of a participant to another
+	DKGDeliverPath = "/v1/threshold/dkg/deliver"
+	// DKGFinishPath ends a key generation
+	DKGFinishPath = "/v1/threshold/dkg/finish"
+	// SignCommitPath starts a signature
+	SignCommitPath = "/v1/threshold/sign/commit"
+	// SignSharePath requests the signature share of a participant
+	SignSharePath = "/v1/threshold/sign/share"
+
+	// PeerIndexHeader is the HTTP header holding the index of the participant making the request
+	PeerIndexHeader = "X-KMD-Threshold-From"
+	// PeerAuthHeader is the HTTP header holding the hex encoded RequestMAC of a request, or ResponseMAC of a
+	// response
+	PeerAuthHeader = "X-KMD-Threshold-Auth"
+)
+
+// MaxMessageSize bounds the size of the requests and responses of the threshold protocol
+const MaxMessageSize = 1 << 20
+
+// PeerRequest is embedded in every request of the threshold protocol
+type PeerRequest struct {
+	// Wallet is the name of the threshold wallet, the same for every participant
+	Wallet string `json:"wallet"`
+	// Session identifies the key generation or the signature
+	Session string `json:"session"`
+}
+
+// WalletName returns the name of the wallet the request is for
+func (r PeerRequest) WalletName() string {
+	return r.Wallet
+}
+
+// DKGCommitRequest is the request for DKGCommitPath
+type DKGCommitRequest struct {
+	PeerRequest
+}
+
+// DKGCommitResponse is the response to DKGCommitPath
+type DKGCommitResponse struct {
+	Commitment DKGCommitment `json:"commitment"`
+}
+
+// DKGSharesRequest is the request for DKGSharesPath
+type DKGSharesRequest struct {
+	PeerRequest
+	Commitments []DKGCommitment `json:"commitments"`
+}
+
+// DKGDeliverRequest is the request for DKGDeliverPath
+type DKGDeliverRequest struct {
+	PeerRequest
+	// Share is sealed with SealShare
+	Share []byte `json:"share"`
+}
+
+// DKGFinishRequest is the request for DKGFinishPath
+type DKGFinishRequest struct {
+	PeerRequest
+}
+
+// DKGFinishResponse is the response to DKGFinishPath. The coordinator checks
+// that every participant computed the same key.
+type DKGFinishResponse struct {
+	GroupKey           crypto.PublicKey      `json:"group_key"`
+	VerificationShares []crypto.Ed25519Point `json:"verification_shares"`
+}
+
+// SignCommitRequest is the request for SignCommitPath
+type SignCommitRequest struct {
+	PeerRequest
+	GroupKey crypto.PublicKey `json:"group_key"`
+	// Message is the data to sign, which the participant must have approved
+	Message []byte `json:"message"`
+}
+
+// SignCommitResponse is the response to SignCommitPath
+type SignCommitResponse struct {
+	Commitment SigningCommitment `json:"commitment"`
+}
+
+// SignShareRequest is the request for SignSharePath
+type SignShareRequest struct {
+	PeerRequest
+	GroupKey crypto.PublicKey `json:"group_key"`
+	// Message is the data to sign, already domain separated
+	Message     []byte              `json:"message"`
+	Commitments []SigningCommitment `json:"commitments"`
+}
+
+// SignShareResponse is the response to SignSharePath
+type SignShareResponse struct {
+	Share crypto.Ed25519Scalar `json:"share"`
+}
+
+// EmptyResponse is the response to the requests returning nothing
+type EmptyResponse struct{}
+
+// ErrorResponse is returned with a non-200 status when a request fails
+type ErrorResponse struct {
+	Error string `json:"error"`
+}
+
+// Client makes the requests of the threshold protocol to a participant
+type Client struct {
+	URL string
+	// Index is the index of the participant making the requests
+	Index uint64
+	// Key is the PeerKey of the participant making the requests and of the participant at URL
+	Key     []byte
+	Timeout time.Duration
+}
+
+// Call makes the request for path and decodes its response into resp
+func (c Client) Call(ctx context.Context, path string, req interface{}, resp interface{}) error {
+	ctx, cancel := context.WithTimeout(ctx, c.Timeout)
+	defer cancel()
+
+	url := strings.TrimSuffix(c.URL, "/") + path
+	body := protocol.EncodeJSON(req)
+	httpReq, err := http.NewRequestWithContext(ctx, http.MethodPost, url, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	reqMAC := RequestMAC(c.Key, path, body)
+	httpReq.Header.Set(PeerIndexHeader, strconv.FormatUint(c.Index, 10))
+	httpReq.Header.Set(PeerAuthHeader, hex.EncodeToString(reqMAC))
+	httpReq.Header.Set("Content-Type", "application/json")
+
+	httpResp, err := http.DefaultClient.Do(httpReq)
+	if err != nil {
+		return fmt.Errorf("threshold participant %s: %w", c.URL, err)
+	}
+	defer httpResp.Body.Close()
+	respBody, err := io.ReadAll(io.LimitReader(httpResp.Body, MaxMessageSize))
+	if err != nil {
+		return fmt.Errorf("threshold participant %s: %w", c.URL, err)
+	}
+
+	if httpResp.StatusCode != http.StatusOK {
+		var errResp ErrorResponse
+		if protocol.DecodeJSON(respBody, &errResp) != nil || errResp.Error == "" {
+			return fmt.Errorf("threshold participant %s: %s", c.URL, httpResp.Status)
+		}
+		return fmt.Errorf("threshold participant %s: %s", c.URL, errResp.Error)
+	}
+	respMAC, err := hex.DecodeString(httpResp.Header.Get(PeerAuthHeader))
+	if err != nil || !hmac.Equal(respMAC, ResponseMAC(c.Key, reqMAC, respBody)) {
+		return fmt.Errorf("threshold participant %s: %w", c.URL, errUnauthenticatedResponse)
+	}
+	err = protocol.DecodeJSON(respBody, resp)
+	if err != nil {
+		return fmt.Errorf("threshold participant %s: %w", c.URL, err)
+	}
+	return nil
+}
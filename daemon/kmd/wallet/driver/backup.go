@@ -0,0 +1,63 @@
+// Copyright (C) 2019-2025 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package driver
+
+const (
+	walletBackupMagic   = "algorand-kmd-wallet-backup"
+	walletBackupVersion = 1
+)
+
+// walletBackupArchive is the envelope of a wallet backup. Contents is
+// encrypted by the driver which produced the backup, with the backup
+// password, and holds everything needed to recreate the wallet.
+type walletBackupArchive struct {
+	Magic      string `codec:"magic"`
+	Version    uint64 `codec:"version"`
+	DriverName string `codec:"driver"`
+	Contents   []byte `codec:"contents"`
+}
+
+// encodeWalletBackup wraps the encrypted contents produced by a driver in a
+// wallet backup archive
+func encodeWalletBackup(driverName string, contents []byte) []byte {
+	return msgpackEncode(walletBackupArchive{
+		Magic:      walletBackupMagic,
+		Version:    walletBackupVersion,
+		DriverName: driverName,
+		Contents:   contents,
+	})
+}
+
+// RestoreWalletBackup creates a new wallet with the given name, ID, and
+// password from a wallet backup encrypted with backupPw. If name is blank, the
+// name of the backed up wallet is used.
+func RestoreWalletBackup(name []byte, id []byte, pw []byte, backup []byte, backupPw []byte) error {
+	var archive walletBackupArchive
+	err := msgpackDecode(backup, &archive)
+	if err != nil || archive.Magic != walletBackupMagic {
+		return errBackupCorrupted
+	}
+	if archive.Version != walletBackupVersion {
+		return errBackupVersion
+	}
+
+	driver, err := FetchWalletDriver(archive.DriverName)
+	if err != nil {
+		return err
+	}
+	return driver.RestoreWallet(name, id, pw, archive.Contents, backupPw)
+}
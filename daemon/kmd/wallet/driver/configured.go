@@ -0,0 +1,150 @@
+// Copyright (C) 2019-2025 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package driver
+
+import (
+	"crypto/subtle"
+	"os"
+	"path/filepath"
+
+	"github.com/algorand/go-deadlock"
+
+	"github.com/algorand/go-algorand/crypto"
+	"github.com/algorand/go-algorand/daemon/kmd/config"
+)
+
+const configuredWalletPasswordFilePermissions = 0600
+
+// ConfiguredWalletDriver is implemented by the drivers which wallets are listed in the kmd config rather than
+// created through kmd. The ID of such a wallet is derived from its name, and CreateWallet sets the password of
+// the configured wallet of the given name.
+type ConfiguredWalletDriver interface {
+	Driver
+	ConfiguredWalletID(name []byte) ([]byte, error)
+}
+
+// configuredWalletPassword protects a configured wallet with a password. Like the master key of a sqlite wallet,
+// a random master key is stored encrypted with a key derived from the password, and is available to the wallet
+// once it's initialized with that password.
+type configuredWalletPassword struct {
+	path   string
+	scrypt config.ScryptParams
+
+	mu deadlock.Mutex
+	// masterKey, passwordSalt and passwordHash are set once the wallet is initialized, so that the password can be
+	// checked without running scrypt again.
+	masterKey    []byte
+	passwordSalt [saltLen]byte
+	passwordHash crypto.Digest
+}
+
+func makeConfiguredWalletPassword(path string, scrypt config.ScryptParams) *configuredWalletPassword {
+	return &configuredWalletPassword{path: path, scrypt: scrypt}
+}
+
+// set sets the password of the wallet, which can only be done once.
+func (cp *configuredWalletPassword) set(pw []byte) error {
+	cp.mu.Lock()
+	defer cp.mu.Unlock()
+
+	masterKey := make([]byte, masterKeyLen)
+	err := fillRandomBytes(masterKey)
+	if err != nil {
+		return err
+	}
+	blob, err := encryptBlobWithPasswordBlankOK(masterKey, PTMasterKey, pw, &cp.scrypt)
+	if err != nil {
+		return err
+	}
+	err = os.MkdirAll(filepath.Dir(cp.path), 0700)
+	if err != nil {
+		return err
+	}
+	f, err := os.OpenFile(cp.path, os.O_WRONLY|os.O_CREATE|os.O_EXCL, configuredWalletPasswordFilePermissions)
+	if os.IsExist(err) {
+		return errConfiguredWalletPasswordSet
+	}
+	if err != nil {
+		return err
+	}
+	_, err = f.Write(blob)
+	if err == nil {
+		err = f.Sync()
+	}
+	if closeErr := f.Close(); err == nil {
+		err = closeErr
+	}
+	if err != nil {
+		os.Remove(cp.path)
+	}
+	return err
+}
+
+// decryptMasterKey returns the master key of the wallet, decrypted with pw.
+func (cp *configuredWalletPassword) decryptMasterKey(pw []byte) ([]byte, error) {
+	blob, err := os.ReadFile(cp.path)
+	if os.IsNotExist(err) {
+		return nil, errConfiguredWalletNoPassword
+	}
+	if err != nil {
+		return nil, err
+	}
+	return decryptBlobWithPassword(blob, PTMasterKey, pw)
+}
+
+// init checks pw, and makes the master key of the wallet available.
+func (cp *configuredWalletPassword) init(pw []byte) error {
+	masterKey, err := cp.decryptMasterKey(pw)
+	if err != nil {
+		return err
+	}
+	cp.mu.Lock()
+	defer cp.mu.Unlock()
+	err = fillRandomBytes(cp.passwordSalt[:])
+	if err != nil {
+		return err
+	}
+	cp.passwordHash = fastHashWithSalt(pw, cp.passwordSalt[:])
+	cp.masterKey = masterKey
+	return nil
+}
+
+// key returns the master key of the wallet, once it's initialized.
+func (cp *configuredWalletPassword) key() ([]byte, error) {
+	cp.mu.Lock()
+	defer cp.mu.Unlock()
+	if cp.masterKey == nil {
+		return nil, errConfiguredWalletLocked
+	}
+	return cp.masterKey, nil
+}
+
+// check returns nil if pw is the password of the wallet.
+func (cp *configuredWalletPassword) check(pw []byte) error {
+	cp.mu.Lock()
+	if cp.masterKey != nil {
+		defer cp.mu.Unlock()
+		pwhash := fastHashWithSalt(pw, cp.passwordSalt[:])
+		if subtle.ConstantTimeCompare(pwhash[:], cp.passwordHash[:]) == 1 {
+			return nil
+		}
+		return errDecrypt
+	}
+	cp.mu.Unlock()
+	_, err := cp.decryptMasterKey(pw)
+	return err
+}
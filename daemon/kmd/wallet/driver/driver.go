@@ -26,8 +26,10 @@ import (
 )
 
 var walletDrivers = map[string]Driver{
-	sqliteWalletDriverName: &SQLiteWalletDriver{},
-	ledgerWalletDriverName: &LedgerWalletDriver{},
+	sqliteWalletDriverName:    &SQLiteWalletDriver{},
+	ledgerWalletDriverName:    &LedgerWalletDriver{},
+	remoteWalletDriverName:    &RemoteWalletDriver{},
+	thresholdWalletDriverName: &ThresholdWalletDriver{},
 }
 
 // Driver is the interface that all wallet drivers must expose in order to be
@@ -40,6 +42,7 @@ type Driver interface {
 	CreateWallet(name []byte, id []byte, pw []byte, mdk crypto.MasterDerivationKey) error
 	RenameWallet(newName []byte, id []byte, pw []byte) error
 	FetchWallet(id []byte) (wallet.Wallet, error)
+	RestoreWallet(name []byte, id []byte, pw []byte, contents []byte, backupPw []byte) error
 }
 
 // InitWalletDrivers accepts a KMDConfig and uses it to initialize each driver
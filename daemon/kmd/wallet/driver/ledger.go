@@ -224,6 +224,11 @@ func (lwd *LedgerWalletDriver) RenameWallet(newName []byte, id []byte, pw []byte
 	return errNotSupported
 }
 
+// RestoreWallet implements the Driver interface.
+func (lwd *LedgerWalletDriver) RestoreWallet(name []byte, id []byte, pw []byte, contents []byte, backupPw []byte) error {
+	return errNotSupported
+}
+
 // Init implements the wallet interface.
 func (lw *LedgerWallet) Init(pw []byte) error {
 	return nil
@@ -239,6 +244,12 @@ func (lw *LedgerWallet) ExportMasterDerivationKey(pw []byte) (crypto.MasterDeriv
 	return crypto.MasterDerivationKey{}, errNotSupported
 }
 
+// ExportBackup implements the Wallet interface. The keys of a hardware
+// wallet never leave the device.
+func (lw *LedgerWallet) ExportBackup(pw []byte, backupPw []byte) ([]byte, error) {
+	return nil, errNotSupported
+}
+
 func pathToID(path string) string {
 	// The Path USB info field is platform-dependent and sometimes
 	// very long. We hash it to make the wallet name/ID less unwieldy
@@ -0,0 +1,583 @@
+// Copyright (C) 2019-2025 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package driver
+
+import (
+	"bufio"
+	"bytes"
+	"crypto/sha512"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net"
+	"os"
+	"path/filepath"
+	"sort"
+	"time"
+
+	"github.com/algorand/go-deadlock"
+
+	"github.com/algorand/go-algorand/crypto"
+	"github.com/algorand/go-algorand/daemon/kmd/config"
+	"github.com/algorand/go-algorand/daemon/kmd/wallet"
+	"github.com/algorand/go-algorand/data/basics"
+	"github.com/algorand/go-algorand/data/transactions"
+	"github.com/algorand/go-algorand/data/transactions/logic"
+	"github.com/algorand/go-algorand/logging"
+	"github.com/algorand/go-algorand/protocol"
+	"github.com/algorand/go-algorand/util/codecs"
+)
+
+const (
+	remoteWalletDriverName      = "remote"
+	remoteWalletDriverVersion   = 1
+	remoteWalletsDirName        = "remote_wallets"
+	remoteWalletsDirPermissions = 0700
+	remoteIDLen                 = 16
+	remoteDefaultTimeout        = 60 * time.Second
+	remoteMaxResponseSize       = 1 << 20
+)
+
+var remoteWalletSupportedTxs = []protocol.TxType{protocol.PaymentTx, protocol.KeyRegistrationTx}
+
+// The remote signer protocol: for every request, kmd connects to the Unix socket of the signing service,
+// writes a RemoteSignerRequest encoded as JSON on a single line, and reads a RemoteSignerResponse encoded
+// the same way. The signing service holds the keys, e.g. in an HSM, and may apply its own policies before
+// signing.
+const (
+	// RemoteSignerMethodKeys lists the public keys of the signing service
+	RemoteSignerMethodKeys = "keys"
+	// RemoteSignerMethodSign signs Data with the private key of Key
+	RemoteSignerMethodSign = "sign"
+)
+
+// RemoteSignerRequest is a request to a remote signing service
+type RemoteSignerRequest struct {
+	Method string `json:"method"`
+	// Key is the public key to sign with
+	Key []byte `json:"key,omitempty"`
+	// Data is the message to sign with ed25519, already domain separated: "TX" followed by the msgpack
+	// encoding of a transaction, or "Program" followed by a TEAL program.
+	Data []byte `json:"data,omitempty"`
+}
+
+// RemoteSignerResponse is the response of a remote signing service
+type RemoteSignerResponse struct {
+	Keys      [][]byte `json:"keys,omitempty"`
+	Signature []byte   `json:"signature,omitempty"`
+	// Error is set when the request failed, e.g. when the signing service refused to sign
+	Error string `json:"error,omitempty"`
+}
+
+// RemoteWalletDriver provides access to the wallets which keys are held by signing services kmd delegates the
+// signatures to, listed in the kmd config, so that kmd never holds their private keys.
+type RemoteWalletDriver struct {
+	mu      deadlock.Mutex
+	wallets map[string]*RemoteWallet
+	log     logging.Logger
+}
+
+// RemoteWallet represents a wallet under the RemoteWalletDriver. It only holds
+// the multisig preimages of the wallet, in its file of the remote wallets directory.
+type RemoteWallet struct {
+	name     string
+	id       string
+	socket   string
+	timeout  time.Duration
+	msigPath string
+	password *configuredWalletPassword
+	log      logging.Logger
+
+	// mu serializes the accesses to the multisig preimages file
+	mu deadlock.Mutex
+}
+
+// remoteMultisigPreimage is the preimage of a multisig address stored by a RemoteWallet
+type remoteMultisigPreimage struct {
+	Version   uint8              `json:"version"`
+	Threshold uint8              `json:"threshold"`
+	PKs       []crypto.PublicKey `json:"pks"`
+}
+
+func nameToRemoteID(name string) string {
+	nameHash := sha512.Sum512_256([]byte(name))
+	return fmt.Sprintf("%x", nameHash[:remoteIDLen])
+}
+
+// InitWithConfig creates a wallet for each signing service of the config.
+func (rwd *RemoteWalletDriver) InitWithConfig(cfg config.KMDConfig, log logging.Logger) error {
+	rwd.mu.Lock()
+	defer rwd.mu.Unlock()
+
+	rwd.log = log
+	rwd.wallets = make(map[string]*RemoteWallet)
+	walletsDir := filepath.Join(cfg.DataDir, remoteWalletsDirName)
+	for _, signer := range cfg.DriverConfig.RemoteWalletDriverConfig.Signers {
+		id := nameToRemoteID(signer.Name)
+		if _, ok := rwd.wallets[id]; ok {
+			return errRemoteWalletExists
+		}
+		timeout := time.Duration(signer.TimeoutSecs) * time.Second
+		if timeout == 0 {
+			timeout = remoteDefaultTimeout
+		}
+		rwd.wallets[id] = &RemoteWallet{
+			name:     signer.Name,
+			id:       id,
+			socket:   signer.Socket,
+			timeout:  timeout,
+			msigPath: filepath.Join(walletsDir, id+".json"),
+			password: makeConfiguredWalletPassword(filepath.Join(walletsDir, id+".pw"), cfg.DriverConfig.SQLiteWalletDriverConfig.ScryptParams),
+			log:      log,
+		}
+	}
+	return nil
+}
+
+// ListWalletMetadatas returns the metadata of the configured wallets.
+func (rwd *RemoteWalletDriver) ListWalletMetadatas() (metadatas []wallet.Metadata, err error) {
+	rwd.mu.Lock()
+	defer rwd.mu.Unlock()
+
+	for _, w := range rwd.wallets {
+		md, err := w.Metadata()
+		if err != nil {
+			return nil, err
+		}
+		metadatas = append(metadatas, md)
+	}
+
+	// Sort metadatas by ID
+	sort.Slice(metadatas, func(i, j int) bool {
+		return bytes.Compare(metadatas[i].ID, metadatas[j].ID) < 0
+	})
+	return metadatas, nil
+}
+
+// ConfiguredWalletID implements the ConfiguredWalletDriver interface.
+func (rwd *RemoteWalletDriver) ConfiguredWalletID(name []byte) ([]byte, error) {
+	rwd.mu.Lock()
+	defer rwd.mu.Unlock()
+
+	id := nameToRemoteID(string(name))
+	if _, ok := rwd.wallets[id]; !ok {
+		return nil, errConfiguredWalletNotFound
+	}
+	return []byte(id), nil
+}
+
+// CreateWallet implements the Driver interface. Remote wallets are configured
+// in the kmd config rather than created, so this only sets the password of
+// the configured wallet, which is required to initialize it.
+func (rwd *RemoteWalletDriver) CreateWallet(name []byte, id []byte, pw []byte, mdk crypto.MasterDerivationKey) error {
+	if mdk != (crypto.MasterDerivationKey{}) {
+		return errNotSupported
+	}
+	rwd.mu.Lock()
+	rw, ok := rwd.wallets[string(id)]
+	rwd.mu.Unlock()
+	if !ok || rw.name != string(name) {
+		return errConfiguredWalletNotFound
+	}
+	return rw.password.set(pw)
+}
+
+// RenameWallet implements the Driver interface.
+func (rwd *RemoteWalletDriver) RenameWallet(newName []byte, id []byte, pw []byte) error {
+	return errNotSupported
+}
+
+// RestoreWallet implements the Driver interface.
+func (rwd *RemoteWalletDriver) RestoreWallet(name []byte, id []byte, pw []byte, contents []byte, backupPw []byte) error {
+	return errNotSupported
+}
+
+// FetchWallet looks up a wallet by ID and returns it
+func (rwd *RemoteWalletDriver) FetchWallet(id []byte) (wallet.Wallet, error) {
+	rwd.mu.Lock()
+	defer rwd.mu.Unlock()
+
+	rw, ok := rwd.wallets[string(id)]
+	if !ok {
+		return nil, errWalletNotFound
+	}
+	return rw, nil
+}
+
+// call sends req to the signing service and returns its response.
+func (rw *RemoteWallet) call(req RemoteSignerRequest) (resp RemoteSignerResponse, err error) {
+	conn, err := net.DialTimeout("unix", rw.socket, rw.timeout)
+	if err != nil {
+		rw.log.Warnf("remote wallet %s: %v", rw.name, err)
+		return resp, errRemoteSignerConnect
+	}
+	defer conn.Close()
+	err = conn.SetDeadline(time.Now().Add(rw.timeout))
+	if err != nil {
+		return
+	}
+
+	enc, err := json.Marshal(req)
+	if err != nil {
+		return
+	}
+	_, err = conn.Write(append(enc, '\n'))
+	if err != nil {
+		return resp, fmt.Errorf("remote signer: %w", err)
+	}
+
+	reader := bufio.NewReader(&limitedReader{r: conn, n: remoteMaxResponseSize})
+	line, err := reader.ReadBytes('\n')
+	if err != nil {
+		return resp, fmt.Errorf("remote signer: %w", err)
+	}
+	err = json.Unmarshal(line, &resp)
+	if err != nil {
+		return resp, fmt.Errorf("remote signer: %w", err)
+	}
+	if resp.Error != "" {
+		return resp, fmt.Errorf("remote signer: %s", resp.Error)
+	}
+	return resp, nil
+}
+
+// limitedReader fails once more than n bytes are read, so that the signing service cannot
+// make kmd buffer a response of unbounded size.
+type limitedReader struct {
+	r net.Conn
+	n int
+}
+
+func (l *limitedReader) Read(p []byte) (int, error) {
+	if l.n <= 0 {
+		return 0, errors.New("response too large")
+	}
+	if len(p) > l.n {
+		p = p[:l.n]
+	}
+	n, err := l.r.Read(p)
+	l.n -= n
+	return n, err
+}
+
+// sign returns the signature of msg by pk, and checks it.
+func (rw *RemoteWallet) sign(pk crypto.PublicKey, msg []byte) (sig crypto.Signature, err error) {
+	resp, err := rw.call(RemoteSignerRequest{Method: RemoteSignerMethodSign, Key: pk[:], Data: msg})
+	if err != nil {
+		return
+	}
+	if len(resp.Signature) != len(sig) {
+		return sig, errRemoteSignerBadSignature
+	}
+	copy(sig[:], resp.Signature)
+	if !crypto.SignatureVerifier(pk).VerifyBytes(msg, sig) {
+		return crypto.Signature{}, errRemoteSignerBadSignature
+	}
+	return sig, nil
+}
+
+// Init implements the Wallet interface. The wallet password is checked by kmd,
+// while the signing service may further authorize each of the signatures.
+func (rw *RemoteWallet) Init(pw []byte) error {
+	return rw.password.init(pw)
+}
+
+// CheckPassword implements the Wallet interface.
+func (rw *RemoteWallet) CheckPassword(pw []byte) error {
+	return rw.password.check(pw)
+}
+
+// ExportMasterDerivationKey implements the Wallet interface.
+func (rw *RemoteWallet) ExportMasterDerivationKey(pw []byte) (crypto.MasterDerivationKey, error) {
+	return crypto.MasterDerivationKey{}, errNotSupported
+}
+
+// ExportBackup implements the Wallet interface. The keys are held by the
+// signing service.
+func (rw *RemoteWallet) ExportBackup(pw []byte, backupPw []byte) ([]byte, error) {
+	return nil, errNotSupported
+}
+
+// Metadata implements the Wallet interface.
+func (rw *RemoteWallet) Metadata() (wallet.Metadata, error) {
+	return wallet.Metadata{
+		ID:                    []byte(rw.id),
+		Name:                  []byte(rw.name),
+		DriverName:            remoteWalletDriverName,
+		DriverVersion:         remoteWalletDriverVersion,
+		SupportedTransactions: remoteWalletSupportedTxs,
+	}, nil
+}
+
+// ListKeys implements the Wallet interface, returning the keys of the signing service.
+func (rw *RemoteWallet) ListKeys() ([]crypto.Digest, error) {
+	resp, err := rw.call(RemoteSignerRequest{Method: RemoteSignerMethodKeys})
+	if err != nil {
+		return nil, err
+	}
+	addrs := make([]crypto.Digest, len(resp.Keys))
+	for i, key := range resp.Keys {
+		if len(key) != len(addrs[i]) {
+			return nil, errRemoteSignerBadKey
+		}
+		copy(addrs[i][:], key)
+	}
+	return addrs, nil
+}
+
+// ImportKey implements the Wallet interface.
+func (rw *RemoteWallet) ImportKey(sk crypto.PrivateKey) (crypto.Digest, error) {
+	return crypto.Digest{}, errNotSupported
+}
+
+// ExportKey implements the Wallet interface.
+func (rw *RemoteWallet) ExportKey(pk crypto.Digest, pw []byte) (crypto.PrivateKey, error) {
+	return crypto.PrivateKey{}, errNotSupported
+}
+
+// GenerateKey implements the Wallet interface.
+func (rw *RemoteWallet) GenerateKey(displayMnemonic bool) (crypto.Digest, error) {
+	return crypto.Digest{}, errNotSupported
+}
+
+// DeleteKey implements the Wallet interface.
+func (rw *RemoteWallet) DeleteKey(pk crypto.Digest, pw []byte) error {
+	return errNotSupported
+}
+
+// loadMultisigPreimagesLocked reads the multisig preimages of the wallet. rw.mu must be held
+func (rw *RemoteWallet) loadMultisigPreimagesLocked() (map[crypto.Digest]remoteMultisigPreimage, error) {
+	var preimages []remoteMultisigPreimage
+	err := codecs.LoadObjectFromFile(rw.msigPath, &preimages)
+	if err != nil && !os.IsNotExist(err) {
+		return nil, err
+	}
+	byAddr := make(map[crypto.Digest]remoteMultisigPreimage, len(preimages))
+	for _, preimage := range preimages {
+		addr, err := crypto.MultisigAddrGen(preimage.Version, preimage.Threshold, preimage.PKs)
+		if err != nil {
+			return nil, err
+		}
+		byAddr[addr] = preimage
+	}
+	return byAddr, nil
+}
+
+// saveMultisigPreimagesLocked writes the multisig preimages of the wallet. rw.mu must be held
+func (rw *RemoteWallet) saveMultisigPreimagesLocked(byAddr map[crypto.Digest]remoteMultisigPreimage) error {
+	addrs := make([]crypto.Digest, 0, len(byAddr))
+	for addr := range byAddr {
+		addrs = append(addrs, addr)
+	}
+	sort.Slice(addrs, func(i, j int) bool { return bytes.Compare(addrs[i][:], addrs[j][:]) < 0 })
+	preimages := make([]remoteMultisigPreimage, len(addrs))
+	for i, addr := range addrs {
+		preimages[i] = byAddr[addr]
+	}
+	err := os.MkdirAll(filepath.Dir(rw.msigPath), remoteWalletsDirPermissions)
+	if err != nil {
+		return err
+	}
+	return codecs.SaveObjectToFile(rw.msigPath, preimages, true)
+}
+
+// ImportMultisigAddr implements the Wallet interface, storing the preimage in kmd.
+func (rw *RemoteWallet) ImportMultisigAddr(version, threshold uint8, pks []crypto.PublicKey) (addr crypto.Digest, err error) {
+	addr, err = crypto.MultisigAddrGen(version, threshold, pks)
+	if err != nil {
+		return
+	}
+
+	rw.mu.Lock()
+	defer rw.mu.Unlock()
+	preimages, err := rw.loadMultisigPreimagesLocked()
+	if err != nil {
+		return
+	}
+	if _, ok := preimages[addr]; ok {
+		return addr, errKeyExists
+	}
+	preimages[addr] = remoteMultisigPreimage{Version: version, Threshold: threshold, PKs: pks}
+	err = rw.saveMultisigPreimagesLocked(preimages)
+	return
+}
+
+// LookupMultisigPreimage implements the Wallet interface.
+func (rw *RemoteWallet) LookupMultisigPreimage(addr crypto.Digest) (version, threshold uint8, pks []crypto.PublicKey, err error) {
+	rw.mu.Lock()
+	defer rw.mu.Unlock()
+	preimages, err := rw.loadMultisigPreimagesLocked()
+	if err != nil {
+		return
+	}
+	preimage, ok := preimages[addr]
+	if !ok {
+		err = errMsigDataNotFound
+		return
+	}
+	return preimage.Version, preimage.Threshold, preimage.PKs, nil
+}
+
+// ListMultisigAddrs implements the Wallet interface.
+func (rw *RemoteWallet) ListMultisigAddrs() (addrs []crypto.Digest, err error) {
+	rw.mu.Lock()
+	defer rw.mu.Unlock()
+	preimages, err := rw.loadMultisigPreimagesLocked()
+	if err != nil {
+		return
+	}
+	for addr := range preimages {
+		addrs = append(addrs, addr)
+	}
+	sort.Slice(addrs, func(i, j int) bool { return bytes.Compare(addrs[i][:], addrs[j][:]) < 0 })
+	return
+}
+
+// DeleteMultisigAddr implements the Wallet interface.
+func (rw *RemoteWallet) DeleteMultisigAddr(addr crypto.Digest, pw []byte) error {
+	err := rw.password.check(pw)
+	if err != nil {
+		return err
+	}
+
+	rw.mu.Lock()
+	defer rw.mu.Unlock()
+	preimages, err := rw.loadMultisigPreimagesLocked()
+	if err != nil {
+		return err
+	}
+	if _, ok := preimages[addr]; !ok {
+		return errMsigDataNotFound
+	}
+	delete(preimages, addr)
+	return rw.saveMultisigPreimagesLocked(preimages)
+}
+
+// SignTransaction implements the Wallet interface, signing with the key of the transaction
+// sender if pk is empty.
+func (rw *RemoteWallet) SignTransaction(tx transactions.Transaction, pk crypto.PublicKey, pw []byte) ([]byte, error) {
+	err := rw.password.check(pw)
+	if err != nil {
+		return nil, err
+	}
+
+	if (pk == crypto.PublicKey{}) {
+		pk = crypto.PublicKey(tx.Src())
+	}
+	sig, err := rw.sign(pk, crypto.HashRep(tx))
+	if err != nil {
+		return nil, err
+	}
+
+	stxn := transactions.SignedTxn{
+		Txn: tx,
+		Sig: sig,
+	}
+	// Set the AuthAddr if the key we signed with doesn't match the txn sender
+	if basics.Address(pk) != tx.Sender {
+		stxn.AuthAddr = basics.Address(pk)
+	}
+	return protocol.Encode(&stxn), nil
+}
+
+// SignProgram implements the Wallet interface.
+func (rw *RemoteWallet) SignProgram(data []byte, src crypto.Digest, pw []byte) ([]byte, error) {
+	err := rw.password.check(pw)
+	if err != nil {
+		return nil, err
+	}
+
+	sig, err := rw.sign(crypto.PublicKey(src), crypto.HashRep(logic.Program(data)))
+	if err != nil {
+		return nil, err
+	}
+	return sig[:], nil
+}
+
+// multisigSign adds the signature of msg by pk to partial, or starts a new multisig signature of
+// the multisig address from if partial is empty. A partial signature must be of one of addrs.
+func (rw *RemoteWallet) multisigSign(msg []byte, from crypto.Digest, addrs []crypto.Digest, pk crypto.PublicKey, partial crypto.MultisigSig) (crypto.MultisigSig, error) {
+	if partial.Version == 0 && partial.Threshold == 0 && len(partial.Subsigs) == 0 {
+		// We weren't given a partial multisig, so create a new one from the preimage
+		version, threshold, pks, err := rw.LookupMultisigPreimage(from)
+		if err != nil {
+			return partial, err
+		}
+		partial = crypto.MultisigSig{Version: version, Threshold: threshold, Subsigs: make([]crypto.MultisigSubsig, len(pks))}
+		for i, key := range pks {
+			partial.Subsigs[i].Key = key
+		}
+	} else {
+		// Check that the partial multisig is of one of the expected addresses
+		addr, err := crypto.MultisigAddrGenWithSubsigs(partial.Version, partial.Threshold, partial.Subsigs)
+		if err != nil {
+			return partial, err
+		}
+		found := false
+		for _, a := range addrs {
+			found = found || addr == a
+		}
+		if !found {
+			return partial, errMsigWrongAddr
+		}
+	}
+
+	// Check that key is one of the ones in the preimage
+	isValidKey := false
+	for _, subsig := range partial.Subsigs {
+		isValidKey = isValidKey || subsig.Key == pk
+	}
+	if !isValidKey {
+		return partial, errMsigWrongKey
+	}
+
+	sig, err := rw.sign(pk, msg)
+	if err != nil {
+		return partial, err
+	}
+
+	// Fill in the signature of the key, without modifying the partial multisig of the caller
+	msig := crypto.MultisigSig{Version: partial.Version, Threshold: partial.Threshold, Subsigs: make([]crypto.MultisigSubsig, len(partial.Subsigs))}
+	copy(msig.Subsigs, partial.Subsigs)
+	for i := range msig.Subsigs {
+		if msig.Subsigs[i].Key == pk {
+			msig.Subsigs[i].Sig = sig
+		}
+	}
+	return msig, nil
+}
+
+// MultisigSignTransaction implements the Wallet interface.
+func (rw *RemoteWallet) MultisigSignTransaction(tx transactions.Transaction, pk crypto.PublicKey, partial crypto.MultisigSig, pw []byte, signer crypto.Digest) (crypto.MultisigSig, error) {
+	err := rw.password.check(pw)
+	if err != nil {
+		return partial, err
+	}
+
+	src := crypto.Digest(tx.Src())
+	return rw.multisigSign(crypto.HashRep(tx), src, []crypto.Digest{src, signer}, pk, partial)
+}
+
+// MultisigSignProgram implements the Wallet interface.
+func (rw *RemoteWallet) MultisigSignProgram(data []byte, src crypto.Digest, pk crypto.PublicKey, partial crypto.MultisigSig, pw []byte) (crypto.MultisigSig, error) {
+	err := rw.password.check(pw)
+	if err != nil {
+		return partial, err
+	}
+
+	return rw.multisigSign(crypto.HashRep(logic.Program(data)), src, []crypto.Digest{src}, pk, partial)
+}
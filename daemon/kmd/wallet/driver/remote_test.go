@@ -0,0 +1,225 @@
+// Copyright (C) 2019-2025 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package driver
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"net"
+	"path/filepath"
+	"sync/atomic"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/algorand/go-algorand/crypto"
+	"github.com/algorand/go-algorand/daemon/kmd/config"
+	"github.com/algorand/go-algorand/data/basics"
+	"github.com/algorand/go-algorand/data/transactions"
+	"github.com/algorand/go-algorand/data/transactions/logic"
+	"github.com/algorand/go-algorand/logging"
+	"github.com/algorand/go-algorand/protocol"
+	"github.com/algorand/go-algorand/test/partitiontest"
+)
+
+// fakeRemoteSigner is a signing service holding a single key, listening on a Unix socket
+type fakeRemoteSigner struct {
+	secrets  *crypto.SignatureSecrets
+	listener net.Listener
+	refuse   atomic.Bool
+	badSig   atomic.Bool
+}
+
+func startFakeRemoteSigner(t *testing.T, socket string) *fakeRemoteSigner {
+	var seed crypto.Seed
+	crypto.RandBytes(seed[:])
+	listener, err := net.Listen("unix", socket)
+	require.NoError(t, err)
+	s := &fakeRemoteSigner{secrets: crypto.GenerateSignatureSecrets(seed), listener: listener}
+	t.Cleanup(func() { listener.Close() })
+	go func() {
+		for {
+			conn, err := listener.Accept()
+			if err != nil {
+				return
+			}
+			go s.serve(conn)
+		}
+	}()
+	return s
+}
+
+func (s *fakeRemoteSigner) serve(conn net.Conn) {
+	defer conn.Close()
+	line, err := bufio.NewReader(conn).ReadBytes('\n')
+	if err != nil {
+		return
+	}
+	var req RemoteSignerRequest
+	var resp RemoteSignerResponse
+	switch {
+	case json.Unmarshal(line, &req) != nil:
+		resp.Error = "malformed request"
+	case s.refuse.Load():
+		resp.Error = "refused by policy"
+	case req.Method == RemoteSignerMethodKeys:
+		resp.Keys = [][]byte{s.secrets.SignatureVerifier[:]}
+	case req.Method == RemoteSignerMethodSign && !bytes.Equal(req.Key, s.secrets.SignatureVerifier[:]):
+		resp.Error = "unknown key"
+	case req.Method == RemoteSignerMethodSign:
+		sig := s.secrets.SignBytes(req.Data)
+		if s.badSig.Load() {
+			sig[0]++
+		}
+		resp.Signature = sig[:]
+	default:
+		resp.Error = "unknown method"
+	}
+	enc, _ := json.Marshal(resp)
+	conn.Write(append(enc, '\n'))
+}
+
+func makeTestRemoteWalletDriver(t *testing.T, dataDir string, socket string) *RemoteWalletDriver {
+	cfg := config.KMDConfig{DataDir: dataDir}
+	cfg.DriverConfig.SQLiteWalletDriverConfig.ScryptParams = config.ScryptParams{ScryptN: 2, ScryptR: 1, ScryptP: 1}
+	cfg.DriverConfig.RemoteWalletDriverConfig.Signers = []config.RemoteSignerConfig{{Name: "hsm", Socket: socket, TimeoutSecs: 5}}
+	rwd := &RemoteWalletDriver{}
+	require.NoError(t, rwd.InitWithConfig(cfg, logging.TestingLog(t)))
+	return rwd
+}
+
+func TestRemoteWalletPassword(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	dir := t.TempDir()
+	rwd := makeTestRemoteWalletDriver(t, dir, filepath.Join(dir, "signer.sock"))
+	_, err := rwd.ConfiguredWalletID([]byte("other"))
+	require.ErrorIs(t, err, errConfiguredWalletNotFound)
+	id, err := rwd.ConfiguredWalletID([]byte("hsm"))
+	require.NoError(t, err)
+	w, err := rwd.FetchWallet(id)
+	require.NoError(t, err)
+
+	// the wallet can't be used before its password is set
+	require.ErrorIs(t, w.Init([]byte("pw")), errConfiguredWalletNoPassword)
+	require.ErrorIs(t, w.CheckPassword([]byte("pw")), errConfiguredWalletNoPassword)
+
+	// the password is set once, by creating the configured wallet
+	require.ErrorIs(t, rwd.CreateWallet([]byte("other"), id, []byte("pw"), crypto.MasterDerivationKey{}), errConfiguredWalletNotFound)
+	require.ErrorIs(t, rwd.CreateWallet([]byte("hsm"), id, []byte("pw"), crypto.MasterDerivationKey{1}), errNotSupported)
+	require.NoError(t, rwd.CreateWallet([]byte("hsm"), id, []byte("pw"), crypto.MasterDerivationKey{}))
+	require.ErrorIs(t, rwd.CreateWallet([]byte("hsm"), id, []byte("other"), crypto.MasterDerivationKey{}), errConfiguredWalletPasswordSet)
+
+	require.ErrorIs(t, w.Init([]byte("wrong")), errDecrypt)
+	require.ErrorIs(t, w.CheckPassword([]byte("wrong")), errDecrypt)
+	require.NoError(t, w.CheckPassword([]byte("pw")))
+	require.NoError(t, w.Init([]byte("pw")))
+	require.NoError(t, w.CheckPassword([]byte("pw")))
+	require.ErrorIs(t, w.CheckPassword([]byte("wrong")), errDecrypt)
+
+	// the password persists across restarts
+	rwd = makeTestRemoteWalletDriver(t, dir, filepath.Join(dir, "signer.sock"))
+	w, err = rwd.FetchWallet(id)
+	require.NoError(t, err)
+	require.ErrorIs(t, w.Init([]byte("wrong")), errDecrypt)
+	require.NoError(t, w.Init([]byte("pw")))
+}
+
+func TestRemoteWalletSign(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	dir := t.TempDir()
+	socket := filepath.Join(dir, "signer.sock")
+	signer := startFakeRemoteSigner(t, socket)
+	rwd := makeTestRemoteWalletDriver(t, dir, socket)
+	id, err := rwd.ConfiguredWalletID([]byte("hsm"))
+	require.NoError(t, err)
+	w, err := rwd.FetchWallet(id)
+	require.NoError(t, err)
+	pw := []byte("pw")
+	require.NoError(t, rwd.CreateWallet([]byte("hsm"), id, pw, crypto.MasterDerivationKey{}))
+	require.NoError(t, w.Init(pw))
+
+	keys, err := w.ListKeys()
+	require.NoError(t, err)
+	require.Equal(t, []crypto.Digest{crypto.Digest(signer.secrets.SignatureVerifier)}, keys)
+
+	tx := transactions.Transaction{
+		Type:   protocol.PaymentTx,
+		Header: transactions.Header{Sender: basics.Address(signer.secrets.SignatureVerifier), Fee: basics.MicroAlgos{Raw: 1000}},
+	}
+	encoded, err := w.SignTransaction(tx, crypto.PublicKey{}, pw)
+	require.NoError(t, err)
+	var stxn transactions.SignedTxn
+	require.NoError(t, protocol.Decode(encoded, &stxn))
+	require.True(t, signer.secrets.SignatureVerifier.Verify(tx, stxn.Sig))
+	require.True(t, stxn.AuthAddr.IsZero())
+
+	program := []byte{0x06, 0x81, 0x01}
+	sig, err := w.SignProgram(program, crypto.Digest(signer.secrets.SignatureVerifier), pw)
+	require.NoError(t, err)
+	var programSig crypto.Signature
+	copy(programSig[:], sig)
+	require.True(t, signer.secrets.SignatureVerifier.Verify(logic.Program(program), programSig))
+
+	// multisig signatures are added to the preimage stored in kmd
+	other := crypto.GenerateSignatureSecrets(crypto.Seed{1})
+	pks := []crypto.PublicKey{crypto.PublicKey(signer.secrets.SignatureVerifier), crypto.PublicKey(other.SignatureVerifier)}
+	msigAddr, err := w.ImportMultisigAddr(1, 1, pks)
+	require.NoError(t, err)
+	tx.Sender = basics.Address(msigAddr)
+	msig, err := w.MultisigSignTransaction(tx, pks[0], crypto.MultisigSig{}, pw, crypto.Digest{})
+	require.NoError(t, err)
+	require.True(t, signer.secrets.SignatureVerifier.Verify(tx, msig.Subsigs[0].Sig))
+	require.True(t, msig.Subsigs[1].Sig.Blank())
+	_, err = w.MultisigSignTransaction(tx, crypto.PublicKey(other.SignatureVerifier), crypto.MultisigSig{}, pw, crypto.Digest{})
+	require.ErrorContains(t, err, "unknown key")
+	_, err = w.MultisigSignTransaction(tx, crypto.PublicKey{1}, crypto.MultisigSig{}, pw, crypto.Digest{})
+	require.ErrorIs(t, err, errMsigWrongKey)
+
+	// the signing service may refuse to sign, and its signatures are verified
+	tx.Sender = basics.Address(signer.secrets.SignatureVerifier)
+	signer.refuse.Store(true)
+	_, err = w.SignTransaction(tx, crypto.PublicKey{}, pw)
+	require.ErrorContains(t, err, "refused by policy")
+	signer.refuse.Store(false)
+	signer.badSig.Store(true)
+	_, err = w.SignTransaction(tx, crypto.PublicKey{}, pw)
+	require.ErrorIs(t, err, errRemoteSignerBadSignature)
+
+	signer.badSig.Store(false)
+
+	// nothing is signed, nor deleted, without the password of the wallet
+	wrong := []byte("wrong")
+	_, err = w.SignTransaction(tx, crypto.PublicKey{}, wrong)
+	require.ErrorIs(t, err, errDecrypt)
+	_, err = w.SignProgram(program, crypto.Digest(signer.secrets.SignatureVerifier), wrong)
+	require.ErrorIs(t, err, errDecrypt)
+	_, err = w.MultisigSignTransaction(tx, pks[0], crypto.MultisigSig{}, wrong, crypto.Digest{})
+	require.ErrorIs(t, err, errDecrypt)
+	_, err = w.MultisigSignProgram(program, msigAddr, pks[0], crypto.MultisigSig{}, wrong)
+	require.ErrorIs(t, err, errDecrypt)
+	require.ErrorIs(t, w.DeleteMultisigAddr(msigAddr, wrong), errDecrypt)
+	require.NoError(t, w.DeleteMultisigAddr(msigAddr, pw))
+
+	signer.listener.Close()
+	_, err = w.ListKeys()
+	require.ErrorIs(t, err, errRemoteSignerConnect)
+}
@@ -412,39 +412,47 @@ func (swd *SQLiteWalletDriver) claimWalletNameID(name []byte, id []byte) (dbPath
 // CreateWallet ensures that a wallet of the given name/id combo doesn't exist,
 // and initializes a database with the appropriate name.
 func (swd *SQLiteWalletDriver) CreateWallet(name []byte, id []byte, pw []byte, mdk crypto.MasterDerivationKey) error {
+	_, _, err := swd.createWallet(name, id, pw, mdk, 0)
+	return err
+}
+
+// createWallet is the guts of CreateWallet. It also sets the max key index of
+// the new wallet, and returns the path to its database along with its master
+// encryption key so that callers may populate it.
+func (swd *SQLiteWalletDriver) createWallet(name []byte, id []byte, pw []byte, mdk crypto.MasterDerivationKey, maxKeyIdx uint64) (dbPath string, masterKey []byte, err error) {
 	if len(name) > sqliteMaxWalletNameLen {
-		return errNameTooLong
+		return "", nil, errNameTooLong
 	}
 
 	if len(id) > sqliteMaxWalletIDLen {
-		return errIDTooLong
+		return "", nil, errIDTooLong
 	}
 
-	dbPath, err := swd.claimWalletNameID(name, id)
+	dbPath, err = swd.claimWalletNameID(name, id)
 	if err != nil {
-		return err
+		return "", nil, err
 	}
 	// TODO? drop the entry in swd.claimedWallets on exit?
 
 	// Create the database
 	db, err := sqlx.Connect("sqlite3", dbConnectionURL(dbPath))
 	if err != nil {
-		return errDatabaseConnect
+		return "", nil, errDatabaseConnect
 	}
 	defer db.Close()
 
 	// Run the schema
 	_, err = db.Exec(walletSchema)
 	if err != nil {
-		return errDatabase
+		return "", nil, errDatabase
 	}
 
 	// Generate the master encryption password, used to encrypt the master
 	// derivation key, generated keys, and imported keys
-	var masterKey [masterKeyLen]byte
-	err = fillRandomBytes(masterKey[:])
+	masterKey = make([]byte, masterKeyLen)
+	err = fillRandomBytes(masterKey)
 	if err != nil {
-		return err
+		return "", nil, err
 	}
 
 	// If we were passed a blank master derivation key, generate one here
@@ -452,40 +460,39 @@ func (swd *SQLiteWalletDriver) CreateWallet(name []byte, id []byte, pw []byte, m
 	if masterDerivationKey == (crypto.MasterDerivationKey{}) {
 		err = fillRandomBytes(masterDerivationKey[:])
 		if err != nil {
-			return err
+			return "", nil, err
 		}
 	}
 
 	// Encrypt the master encryption password using the user's password (which
 	// may be blank)
-	encryptedMEPBlob, err := encryptBlobWithPasswordBlankOK(masterKey[:], PTMasterKey, pw, &swd.sqliteCfg.ScryptParams)
+	encryptedMEPBlob, err := encryptBlobWithPasswordBlankOK(masterKey, PTMasterKey, pw, &swd.sqliteCfg.ScryptParams)
 	if err != nil {
-		return err
+		return "", nil, err
 	}
 
 	// Encrypt the master derivation key using the master encryption password
 	// (which may not be blank)
-	encryptedMDKBlob, err := encryptBlobWithKey(masterDerivationKey[:], PTMasterDerivationKey, masterKey[:])
+	encryptedMDKBlob, err := encryptBlobWithKey(masterDerivationKey[:], PTMasterDerivationKey, masterKey)
 	if err != nil {
-		return err
+		return "", nil, err
 	}
 
 	// Encrypt the max key index using the master encryption password. We encrypt
 	// this for integrity reasons, so that someone with access to the file can't
 	// make the index enormous.
-	maxKeyIdx := 0
-	encryptedIdxBlob, err := encryptBlobWithKey(msgpackEncode(maxKeyIdx), PTMaxKeyIdx, masterKey[:])
+	encryptedIdxBlob, err := encryptBlobWithKey(msgpackEncode(maxKeyIdx), PTMaxKeyIdx, masterKey)
 	if err != nil {
-		return err
+		return "", nil, err
 	}
 
 	// Store the metadata row in the database
 	_, err = db.Exec("INSERT INTO metadata (driver_name, driver_version, wallet_id, wallet_name, mep_encrypted, mdk_encrypted, max_key_idx_encrypted) VALUES(?, ?, ?, ?, ?, ?, ?)", sqliteWalletDriverName, sqliteWalletDriverVersion, id, name, encryptedMEPBlob, encryptedMDKBlob, encryptedIdxBlob)
 	if err != nil {
-		return errDatabase
+		return "", nil, errDatabase
 	}
 
-	return nil
+	return dbPath, masterKey, nil
 }
 
 // FetchWallet looks up a wallet by ID and returns it, failing if there's more
@@ -1180,12 +1187,17 @@ func (sw *SQLiteWallet) MultisigSignTransaction(tx transactions.Transaction, pk
 	}
 
 	if partial.Version == 0 && partial.Threshold == 0 && len(partial.Subsigs) == 0 {
-		// We weren't given a partial multisig, so create a new one
+		// We weren't given a partial multisig, so create a new one. If the
+		// sender was rekeyed to a multisig address, signer is that address
+		from := crypto.Digest(tx.Src())
+		if (signer != crypto.Digest{}) {
+			from = signer
+		}
 
 		// Look up the preimage in the database
 		var pks []crypto.PublicKey
 		var version, threshold uint8
-		version, threshold, pks, err = sw.LookupMultisigPreimage(crypto.Digest(tx.Src()))
+		version, threshold, pks, err = sw.LookupMultisigPreimage(from)
 		if err != nil {
 			return
 		}
@@ -1206,7 +1218,6 @@ func (sw *SQLiteWallet) MultisigSignTransaction(tx transactions.Transaction, pk
 		}
 
 		// Sign the transaction
-		from := crypto.Digest(tx.Src())
 		sig, err = crypto.MultisigSign(tx, from, version, threshold, pks, *secrets)
 		return
 	}
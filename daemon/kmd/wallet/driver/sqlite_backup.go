@@ -0,0 +1,314 @@
+// Copyright (C) 2019-2025 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package driver
+
+import (
+	"bytes"
+	"os"
+
+	"github.com/jmoiron/sqlx"
+
+	"github.com/algorand/go-algorand/crypto"
+)
+
+// sqliteWalletBackup holds the full contents of a sqlite wallet, and is
+// encrypted with the backup password in a wallet backup
+type sqliteWalletBackup struct {
+	Name                []byte                     `codec:"name"`
+	ID                  []byte                     `codec:"id"`
+	MasterDerivationKey crypto.MasterDerivationKey `codec:"mdk"`
+	MaxKeyIdx           uint64                     `codec:"max_key_idx"`
+	Keys                []sqliteBackupKey          `codec:"keys"`
+	MultisigAddrs       []sqliteBackupMultisigAddr `codec:"msig_addrs"`
+}
+
+// sqliteBackupKey is a key of a backed up wallet. KeyIdx is the index the key
+// was derived with, or 0 for an imported key.
+type sqliteBackupKey struct {
+	SecretKey crypto.PrivateKey `codec:"sk"`
+	KeyIdx    uint64            `codec:"key_idx"`
+}
+
+// sqliteBackupMultisigAddr is the preimage of a multisig address of a backed
+// up wallet
+type sqliteBackupMultisigAddr struct {
+	Version   uint8              `codec:"version"`
+	Threshold uint8              `codec:"threshold"`
+	PKs       []crypto.PublicKey `codec:"pks"`
+}
+
+// ExportBackup returns a backup of the full contents of the wallet, encrypted
+// with backupPw
+func (sw *SQLiteWallet) ExportBackup(pw []byte, backupPw []byte) (backup []byte, err error) {
+	// Check the password
+	err = sw.CheckPassword(pw)
+	if err != nil {
+		return
+	}
+
+	if len(backupPw) == 0 {
+		err = errBlankBackupPassword
+		return
+	}
+
+	// Connect to the database
+	db, err := sqlx.Connect("sqlite3", dbConnectionURL(sw.dbPath))
+	if err != nil {
+		err = errDatabaseConnect
+		return
+	}
+	defer db.Close()
+
+	// Read everything within one transaction, so that the backup is consistent
+	tx, err := db.Beginx()
+	if err != nil {
+		err = errDatabase
+		return
+	}
+	defer tx.Rollback()
+
+	var contents sqliteWalletBackup
+	var encryptedIdxBlob []byte
+	row := tx.QueryRow("SELECT wallet_name, wallet_id, max_key_idx_encrypted FROM metadata LIMIT 1")
+	err = row.Scan(&contents.Name, &contents.ID, &encryptedIdxBlob)
+	if err != nil {
+		err = errDatabase
+		return
+	}
+	copy(contents.MasterDerivationKey[:], sw.masterDerivationKey)
+
+	// Decrypt and decode the max key index
+	idxBlob, err := decryptBlobWithPassword(encryptedIdxBlob, PTMaxKeyIdx, sw.masterEncryptionKey)
+	if err != nil {
+		return
+	}
+	err = msgpackDecode(idxBlob, &contents.MaxKeyIdx)
+	if err != nil {
+		return
+	}
+
+	// Decrypt the keys, checking them as fetchSecretKey does
+	var keyRows []struct {
+		Address            []byte `db:"address"`
+		SecretKeyEncrypted []byte `db:"secret_key_encrypted"`
+		KeyIdx             *int64 `db:"key_idx"`
+	}
+	err = tx.Select(&keyRows, "SELECT address, secret_key_encrypted, key_idx FROM keys")
+	if err != nil {
+		err = errDatabase
+		return
+	}
+	for _, kr := range keyRows {
+		var key sqliteBackupKey
+		var skEncoded []byte
+		skEncoded, err = decryptBlobWithPassword(kr.SecretKeyEncrypted, PTSecretKey, sw.masterEncryptionKey)
+		if err != nil {
+			return
+		}
+		err = msgpackDecode(skEncoded, &key.SecretKey)
+		if err != nil {
+			return
+		}
+		var pk crypto.PublicKey
+		pk, err = crypto.SecretKeyToPublicKey(key.SecretKey)
+		if err != nil {
+			err = errSKToPK
+			return
+		}
+		addr := publicKeyToAddress(pk)
+		if !bytes.Equal(kr.Address, addr[:]) {
+			err = errTampering
+			return
+		}
+		if kr.KeyIdx != nil {
+			key.KeyIdx = uint64(*kr.KeyIdx)
+		}
+		contents.Keys = append(contents.Keys, key)
+	}
+
+	// Read the multisig preimages
+	rows, err := tx.Query("SELECT version, threshold, pks FROM msig_addrs")
+	if err != nil {
+		err = errDatabase
+		return
+	}
+	defer rows.Close()
+	for rows.Next() {
+		var msig sqliteBackupMultisigAddr
+		var pksBlob []byte
+		err = rows.Scan(&msig.Version, &msig.Threshold, &pksBlob)
+		if err != nil {
+			err = errDatabase
+			return
+		}
+		err = msgpackDecode(pksBlob, &msig.PKs)
+		if err != nil {
+			return
+		}
+		contents.MultisigAddrs = append(contents.MultisigAddrs, msig)
+	}
+	if rows.Err() != nil {
+		err = errDatabase
+		return
+	}
+
+	// Encrypt the contents with the backup password
+	encryptedContents, err := encryptBlobWithPasswordBlankOK(msgpackEncode(contents), PTWalletBackup, backupPw, &sw.cfg.ScryptParams)
+	if err != nil {
+		return
+	}
+	return encodeWalletBackup(sqliteWalletDriverName, encryptedContents), nil
+}
+
+// check verifies that the backed up keys match their addresses and the master
+// derivation key they were derived with, and that the multisig preimages are
+// valid, so that a tampered backup is never restored
+func (contents *sqliteWalletBackup) check() error {
+	seenKeys := make(map[crypto.Digest]bool)
+	for _, key := range contents.Keys {
+		seed, err := crypto.SecretKeyToSeed(key.SecretKey)
+		if err != nil {
+			return errBackupCorrupted
+		}
+		secrets := crypto.GenerateSignatureSecrets(seed)
+		if crypto.PrivateKey(secrets.SK) != key.SecretKey {
+			return errBackupCorrupted
+		}
+		if key.KeyIdx != 0 {
+			if key.KeyIdx > contents.MaxKeyIdx {
+				return errBackupCorrupted
+			}
+			_, sk, err := extractKeyWithIndex(contents.MasterDerivationKey[:], key.KeyIdx)
+			if err != nil || sk != key.SecretKey {
+				return errBackupCorrupted
+			}
+		}
+		addr := publicKeyToAddress(secrets.SignatureVerifier)
+		if seenKeys[addr] {
+			return errBackupCorrupted
+		}
+		seenKeys[addr] = true
+	}
+
+	seenMsigs := make(map[crypto.Digest]bool)
+	for _, msig := range contents.MultisigAddrs {
+		addr, err := crypto.MultisigAddrGen(msig.Version, msig.Threshold, msig.PKs)
+		if err != nil || seenMsigs[addr] {
+			return errBackupCorrupted
+		}
+		seenMsigs[addr] = true
+	}
+	return nil
+}
+
+// RestoreWallet creates a wallet from the contents of a backup made by
+// ExportBackup
+func (swd *SQLiteWalletDriver) RestoreWallet(name []byte, id []byte, pw []byte, contents []byte, backupPw []byte) error {
+	// Decrypt the contents with the backup password
+	plaintext, err := decryptBlobWithPassword(contents, PTWalletBackup, backupPw)
+	if err != nil {
+		return err
+	}
+
+	var backup sqliteWalletBackup
+	err = msgpackDecode(plaintext, &backup)
+	if err != nil {
+		return errBackupCorrupted
+	}
+	err = backup.check()
+	if err != nil {
+		return err
+	}
+
+	if len(name) == 0 {
+		name = backup.Name
+	}
+
+	dbPath, masterKey, err := swd.createWallet(name, id, pw, backup.MasterDerivationKey, backup.MaxKeyIdx)
+	if err != nil {
+		return err
+	}
+
+	err = restoreSQLiteWalletContents(dbPath, masterKey, &backup)
+	if err != nil {
+		// Don't leave a partially restored wallet behind
+		os.Remove(dbPath)
+		return err
+	}
+	return nil
+}
+
+// restoreSQLiteWalletContents inserts the keys and multisig preimages of a
+// backup into a freshly created wallet database
+func restoreSQLiteWalletContents(dbPath string, masterKey []byte, backup *sqliteWalletBackup) error {
+	// Connect to the database
+	db, err := sqlx.Connect("sqlite3", dbConnectionURL(dbPath))
+	if err != nil {
+		return errDatabaseConnect
+	}
+	defer db.Close()
+
+	tx, err := db.Beginx()
+	if err != nil {
+		return errDatabase
+	}
+
+	for _, key := range backup.Keys {
+		pk, err := crypto.SecretKeyToPublicKey(key.SecretKey)
+		if err != nil {
+			tx.Rollback()
+			return errSKToPK
+		}
+		addr := publicKeyToAddress(pk)
+
+		skEncrypted, err := encryptBlobWithKey(msgpackEncode(key.SecretKey), PTSecretKey, masterKey)
+		if err != nil {
+			tx.Rollback()
+			return err
+		}
+
+		// Imported keys have no index
+		var keyIdx interface{}
+		if key.KeyIdx != 0 {
+			keyIdx = key.KeyIdx
+		}
+		_, err = tx.Exec("INSERT INTO keys (address, secret_key_encrypted, key_idx) VALUES(?, ?, ?)", addr[:], skEncrypted, keyIdx)
+		if err != nil {
+			tx.Rollback()
+			return errDatabase
+		}
+	}
+
+	for _, msig := range backup.MultisigAddrs {
+		addr, err := crypto.MultisigAddrGen(msig.Version, msig.Threshold, msig.PKs)
+		if err != nil {
+			tx.Rollback()
+			return err
+		}
+		_, err = tx.Exec("INSERT INTO msig_addrs (address, version, threshold, pks) VALUES (?, ?, ?, ?)", addr[:], msig.Version, msig.Threshold, msgpackEncode(msig.PKs))
+		if err != nil {
+			tx.Rollback()
+			return errDatabase
+		}
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		return errDatabase
+	}
+	return nil
+}
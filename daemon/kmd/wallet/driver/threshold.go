@@ -0,0 +1,950 @@
+// Copyright (C) 2019-2025 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package driver
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha512"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"slices"
+	"sort"
+	"strconv"
+	"time"
+
+	"github.com/algorand/go-deadlock"
+
+	"github.com/algorand/go-algorand/crypto"
+	"github.com/algorand/go-algorand/daemon/kmd/config"
+	"github.com/algorand/go-algorand/daemon/kmd/threshold"
+	"github.com/algorand/go-algorand/daemon/kmd/wallet"
+	"github.com/algorand/go-algorand/data/basics"
+	"github.com/algorand/go-algorand/data/transactions"
+	"github.com/algorand/go-algorand/data/transactions/logic"
+	"github.com/algorand/go-algorand/logging"
+	"github.com/algorand/go-algorand/protocol"
+)
+
+const (
+	thresholdWalletDriverName      = "threshold"
+	thresholdWalletDriverVersion   = 1
+	thresholdWalletsDirName        = "threshold_wallets"
+	thresholdWalletsDirPermissions = 0700
+	thresholdKeysFilePermissions   = 0600
+	thresholdIDLen                 = 16
+	thresholdSessionIDLen          = 16
+	thresholdDefaultTimeout        = 10 * time.Second
+	thresholdSessionLifetime       = 5 * time.Minute
+	thresholdApprovalLifetime      = 15 * time.Minute
+)
+
+// PTThresholdKeyShare is the plaintext type for the key share of a threshold wallet
+var PTThresholdKeyShare plaintextType = "threshold_key_share"
+
+var thresholdWalletSupportedTxs = []protocol.TxType{protocol.PaymentTx, protocol.KeyRegistrationTx}
+
+// ThresholdWalletDriver provides access to the wallets which keys are shared among several kmd instances, listed
+// in the kmd config. Every kmd holds a share of each key, and a threshold of them produce ordinary ed25519
+// signatures together with FROST, so that no kmd ever holds a private key. The driver serves the requests the
+// other participants make to this kmd.
+type ThresholdWalletDriver struct {
+	mu      deadlock.Mutex
+	wallets map[string]*ThresholdWallet
+	log     logging.Logger
+}
+
+// ThresholdWallet represents a wallet under the ThresholdWalletDriver. It holds the key shares of this kmd in its
+// file of the threshold wallets directory, encrypted with the master key of the wallet, and the state of the key
+// generations and signatures in progress.
+//
+// A participant only takes part in a signature once its own user has approved the message, by asking its kmd to
+// sign it with the password of the wallet, which makes the requests of the users go through the signing policies
+// of their kmd. The signature is made by the last participant of the threshold to approve it.
+type ThresholdWallet struct {
+	name      string
+	id        string
+	index     uint64
+	threshold uint64
+	identity  threshold.Identity
+	// participants are the clients of the participants, with the peer keys this participant shares with each.
+	// It is empty until the participant keys are configured.
+	participants []threshold.Client
+	keysPath     string
+	password     *configuredWalletPassword
+	log          logging.Logger
+
+	// mu serializes the accesses to the key shares file and to the sessions
+	mu        deadlock.Mutex
+	dkgs      map[string]*thresholdDKGSession
+	nonces    map[string]*thresholdNonceSession
+	approvals map[crypto.Digest]*thresholdApproval
+}
+
+// thresholdStoredKey is a key share stored by a ThresholdWallet
+type thresholdStoredKey struct {
+	GroupKey crypto.PublicKey `json:"group_key"`
+	// Share is the KeyShare of this participant, encrypted with the master key of the wallet
+	Share []byte `json:"share"`
+}
+
+// thresholdDKGSession is the state of a key generation this kmd participates in
+type thresholdDKGSession struct {
+	coordinator uint64
+	dkg         *threshold.DKG
+	received    []crypto.Ed25519Scalar
+	from        []bool
+	expires     time.Time
+}
+
+// thresholdNonceSession holds the nonces of this kmd for a signature, which
+// are deleted as soon as they are used
+type thresholdNonceSession struct {
+	coordinator uint64
+	approval    crypto.Digest
+	nonces      threshold.SigningNonces
+	commitment  threshold.SigningCommitment
+	expires     time.Time
+}
+
+// thresholdApproval allows a single signature of a message by a group key,
+// with the decrypted key share of this participant
+type thresholdApproval struct {
+	keyShare threshold.KeyShare
+	expires  time.Time
+}
+
+func nameToThresholdID(name string) string {
+	nameHash := sha512.Sum512_256([]byte(thresholdWalletDriverName + ":" + name))
+	return fmt.Sprintf("%x", nameHash[:thresholdIDLen])
+}
+
+func thresholdApprovalID(groupKey crypto.PublicKey, msg []byte) crypto.Digest {
+	return crypto.Hash(append(groupKey[:], msg...))
+}
+
+func newThresholdSessionID() string {
+	var id [thresholdSessionIDLen]byte
+	crypto.RandBytes(id[:])
+	return hex.EncodeToString(id[:])
+}
+
+// InitWithConfig creates a wallet for each threshold wallet of the config.
+func (twd *ThresholdWalletDriver) InitWithConfig(cfg config.KMDConfig, log logging.Logger) error {
+	twd.mu.Lock()
+	defer twd.mu.Unlock()
+
+	twd.log = log
+	twd.wallets = make(map[string]*ThresholdWallet)
+	walletsDir := filepath.Join(cfg.DataDir, thresholdWalletsDirName)
+	for _, twc := range cfg.DriverConfig.ThresholdWalletDriverConfig.Wallets {
+		id := nameToThresholdID(twc.Name)
+		if _, ok := twd.wallets[id]; ok {
+			return errThresholdWalletExists
+		}
+		timeout := time.Duration(twc.TimeoutSecs) * time.Second
+		if timeout == 0 {
+			timeout = thresholdDefaultTimeout
+		}
+		identity, err := loadThresholdIdentity(filepath.Join(walletsDir, id+".identity"))
+		if err != nil {
+			return err
+		}
+		log.Infof("threshold wallet %s: participant key %s", twc.Name, basics.Address(identity.Public))
+		tw := &ThresholdWallet{
+			name:      twc.Name,
+			id:        id,
+			index:     twc.Index,
+			threshold: twc.Threshold,
+			identity:  identity,
+			keysPath:  filepath.Join(walletsDir, id+".json"),
+			password:  makeConfiguredWalletPassword(filepath.Join(walletsDir, id+".pw"), cfg.DriverConfig.SQLiteWalletDriverConfig.ScryptParams),
+			log:       log,
+			dkgs:      make(map[string]*thresholdDKGSession),
+			nonces:    make(map[string]*thresholdNonceSession),
+			approvals: make(map[crypto.Digest]*thresholdApproval),
+		}
+		for i, key := range twc.ParticipantKeys {
+			j := uint64(i) + 1
+			addr, err := basics.UnmarshalChecksumAddress(key)
+			if err != nil {
+				return fmt.Errorf("threshold wallet %s: participant key %d: %w", twc.Name, j, err)
+			}
+			public := crypto.Ed25519Point(addr)
+			if j == tw.index && public != identity.Public {
+				return fmt.Errorf("threshold wallet %s: %w", twc.Name, errThresholdIdentityMismatch)
+			}
+			peerKey, err := identity.PeerKey(twc.Name, tw.index, public, j)
+			if err != nil {
+				return fmt.Errorf("threshold wallet %s: participant key %d: %w", twc.Name, j, err)
+			}
+			tw.participants = append(tw.participants, threshold.Client{URL: twc.Participants[i], Index: tw.index, Key: peerKey, Timeout: timeout})
+		}
+		twd.wallets[id] = tw
+	}
+	return nil
+}
+
+// loadThresholdIdentity reads the identity of this participant, generating it
+// the first time
+func loadThresholdIdentity(path string) (threshold.Identity, error) {
+	data, err := os.ReadFile(path)
+	if os.IsNotExist(err) {
+		identity, err := threshold.NewIdentity()
+		if err != nil {
+			return threshold.Identity{}, err
+		}
+		err = os.MkdirAll(filepath.Dir(path), thresholdWalletsDirPermissions)
+		if err != nil {
+			return threshold.Identity{}, err
+		}
+		err = os.WriteFile(path, identity.Secret[:], thresholdKeysFilePermissions)
+		return identity, err
+	}
+	if err != nil {
+		return threshold.Identity{}, err
+	}
+	var secret crypto.Ed25519Scalar
+	if len(data) != len(secret) {
+		return threshold.Identity{}, errThresholdMalformedIdentity
+	}
+	copy(secret[:], data)
+	return threshold.IdentityFromSecret(secret)
+}
+
+// ListWalletMetadatas returns the metadata of the configured wallets.
+func (twd *ThresholdWalletDriver) ListWalletMetadatas() (metadatas []wallet.Metadata, err error) {
+	twd.mu.Lock()
+	defer twd.mu.Unlock()
+
+	for _, w := range twd.wallets {
+		md, err := w.Metadata()
+		if err != nil {
+			return nil, err
+		}
+		metadatas = append(metadatas, md)
+	}
+
+	// Sort metadatas by ID
+	sort.Slice(metadatas, func(i, j int) bool {
+		return bytes.Compare(metadatas[i].ID, metadatas[j].ID) < 0
+	})
+	return metadatas, nil
+}
+
+// ConfiguredWalletID implements the ConfiguredWalletDriver interface.
+func (twd *ThresholdWalletDriver) ConfiguredWalletID(name []byte) ([]byte, error) {
+	twd.mu.Lock()
+	defer twd.mu.Unlock()
+
+	id := nameToThresholdID(string(name))
+	if _, ok := twd.wallets[id]; !ok {
+		return nil, errConfiguredWalletNotFound
+	}
+	return []byte(id), nil
+}
+
+// CreateWallet implements the Driver interface. Threshold wallets are
+// configured in the kmd config rather than created, so this only sets the
+// password of the configured wallet, which encrypts its key shares.
+func (twd *ThresholdWalletDriver) CreateWallet(name []byte, id []byte, pw []byte, mdk crypto.MasterDerivationKey) error {
+	if mdk != (crypto.MasterDerivationKey{}) {
+		return errNotSupported
+	}
+	twd.mu.Lock()
+	tw, ok := twd.wallets[string(id)]
+	twd.mu.Unlock()
+	if !ok || tw.name != string(name) {
+		return errConfiguredWalletNotFound
+	}
+	return tw.password.set(pw)
+}
+
+// RenameWallet implements the Driver interface.
+func (twd *ThresholdWalletDriver) RenameWallet(newName []byte, id []byte, pw []byte) error {
+	return errNotSupported
+}
+
+// RestoreWallet implements the Driver interface.
+func (twd *ThresholdWalletDriver) RestoreWallet(name []byte, id []byte, pw []byte, contents []byte, backupPw []byte) error {
+	return errNotSupported
+}
+
+// FetchWallet looks up a wallet by ID and returns it
+func (twd *ThresholdWalletDriver) FetchWallet(id []byte) (wallet.Wallet, error) {
+	twd.mu.Lock()
+	defer twd.mu.Unlock()
+
+	tw, ok := twd.wallets[string(id)]
+	if !ok {
+		return nil, errWalletNotFound
+	}
+	return tw, nil
+}
+
+// ThresholdPeerHandler returns the handler of the requests of the threshold
+// protocol, which the participants of the threshold wallets make to this kmd
+func ThresholdPeerHandler() http.Handler {
+	return walletDrivers[thresholdWalletDriverName].(*ThresholdWalletDriver)
+}
+
+// thresholdErrorResponse writes err in the format threshold.Client expects
+func thresholdErrorResponse(w http.ResponseWriter, status int, err error) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	w.Write(protocol.EncodeJSON(threshold.ErrorResponse{Error: err.Error()}))
+}
+
+// ServeHTTP serves a request of the threshold protocol, after authenticating
+// the participant making it. These requests come from the other kmd instances
+// sharing the wallet, which do not hold our API token.
+func (twd *ThresholdWalletDriver) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	body, err := io.ReadAll(io.LimitReader(r.Body, threshold.MaxMessageSize))
+	if err != nil {
+		thresholdErrorResponse(w, http.StatusBadRequest, err)
+		return
+	}
+	// Only decode the wallet name for now, the handler of the request decodes
+	// the rest once it's authenticated
+	var peerReq threshold.PeerRequest
+	err = json.Unmarshal(body, &peerReq)
+	if err != nil {
+		thresholdErrorResponse(w, http.StatusBadRequest, errThresholdMalformedRequest)
+		return
+	}
+
+	twd.mu.Lock()
+	tw, ok := twd.wallets[nameToThresholdID(peerReq.Wallet)]
+	twd.mu.Unlock()
+	var from uint64
+	var reqMAC []byte
+	err = errWalletNotFound
+	if ok {
+		from, reqMAC, err = tw.authenticatePeer(r.URL.Path, r.Header, body)
+	}
+	if err != nil {
+		// Don't reveal which threshold wallets exist
+		twd.log.Warnf("rejected threshold request %s from %s: %v", r.URL.Path, r.RemoteAddr, err)
+		thresholdErrorResponse(w, http.StatusUnauthorized, errThresholdUnauthenticated)
+		return
+	}
+
+	resp, err := tw.handlePeerRequest(r.URL.Path, from, body)
+	if err != nil {
+		thresholdErrorResponse(w, http.StatusBadRequest, err)
+		return
+	}
+	respBody := protocol.EncodeJSON(resp)
+	w.Header().Set(threshold.PeerAuthHeader, hex.EncodeToString(threshold.ResponseMAC(tw.participants[from-1].Key, reqMAC, respBody)))
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	w.Write(respBody)
+}
+
+// authenticatePeer returns the index of the participant which made the request
+// for path with body, and the MAC of the request
+func (tw *ThresholdWallet) authenticatePeer(path string, header http.Header, body []byte) (uint64, []byte, error) {
+	from, err := strconv.ParseUint(header.Get(threshold.PeerIndexHeader), 10, 64)
+	if err != nil || from == 0 || from > uint64(len(tw.participants)) {
+		return 0, nil, errThresholdUnauthenticated
+	}
+	reqMAC, err := hex.DecodeString(header.Get(threshold.PeerAuthHeader))
+	if err != nil || !hmac.Equal(reqMAC, threshold.RequestMAC(tw.participants[from-1].Key, path, body)) {
+		return 0, nil, errThresholdUnauthenticated
+	}
+	return from, reqMAC, nil
+}
+
+// handlePeerRequest serves the request for path made by participant from
+func (tw *ThresholdWallet) handlePeerRequest(path string, from uint64, body []byte) (interface{}, error) {
+	switch path {
+	case threshold.DKGCommitPath:
+		return handleThresholdPeerRequest(tw.dkgCommit, from, body)
+	case threshold.DKGSharesPath:
+		return handleThresholdPeerRequest(tw.dkgShares, from, body)
+	case threshold.DKGDeliverPath:
+		return handleThresholdPeerRequest(tw.dkgDeliver, from, body)
+	case threshold.DKGFinishPath:
+		return handleThresholdPeerRequest(tw.dkgFinish, from, body)
+	case threshold.SignCommitPath:
+		return handleThresholdPeerRequest(tw.signCommit, from, body)
+	case threshold.SignSharePath:
+		return handleThresholdPeerRequest(tw.signShare, from, body)
+	}
+	return nil, errThresholdMalformedRequest
+}
+
+func handleThresholdPeerRequest[Req any, Resp any](handle func(uint64, Req) (Resp, error), from uint64, body []byte) (interface{}, error) {
+	var req Req
+	err := protocol.DecodeJSON(body, &req)
+	if err != nil {
+		return nil, errThresholdMalformedRequest
+	}
+	return handle(from, req)
+}
+
+// checkParticipantsLocked returns an error if the participant keys of the wallet are not configured. tw.mu must
+// be held
+func (tw *ThresholdWallet) checkParticipantsLocked() error {
+	if len(tw.participants) == 0 {
+		return errThresholdNoParticipantKeys
+	}
+	return nil
+}
+
+// loadKeysLocked reads the encrypted key shares of the wallet. tw.mu must be held
+func (tw *ThresholdWallet) loadKeysLocked() ([]thresholdStoredKey, error) {
+	var keys []thresholdStoredKey
+	data, err := os.ReadFile(tw.keysPath)
+	if os.IsNotExist(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	err = protocol.DecodeJSON(data, &keys)
+	if err != nil {
+		return nil, err
+	}
+	return keys, nil
+}
+
+// saveKeysLocked writes the encrypted key shares of the wallet. tw.mu must be held
+func (tw *ThresholdWallet) saveKeysLocked(keys []thresholdStoredKey) error {
+	err := os.MkdirAll(filepath.Dir(tw.keysPath), thresholdWalletsDirPermissions)
+	if err != nil {
+		return err
+	}
+	// Write to a temporary file first, so that a crash never loses the shares
+	tmpPath := tw.keysPath + ".tmp"
+	err = os.WriteFile(tmpPath, protocol.EncodeJSON(keys), thresholdKeysFilePermissions)
+	if err != nil {
+		return err
+	}
+	return os.Rename(tmpPath, tw.keysPath)
+}
+
+// decryptKeyLocked returns the key share of groupKey, decrypted with the master key of the wallet. tw.mu must be
+// held
+func (tw *ThresholdWallet) decryptKeyLocked(groupKey crypto.PublicKey) (threshold.KeyShare, error) {
+	masterKey, err := tw.password.key()
+	if err != nil {
+		return threshold.KeyShare{}, err
+	}
+	keys, err := tw.loadKeysLocked()
+	if err != nil {
+		return threshold.KeyShare{}, err
+	}
+	for _, key := range keys {
+		if key.GroupKey != groupKey {
+			continue
+		}
+		data, err := decryptBlobWithPassword(key.Share, PTThresholdKeyShare, masterKey)
+		if err != nil {
+			return threshold.KeyShare{}, err
+		}
+		var ks threshold.KeyShare
+		err = protocol.DecodeJSON(data, &ks)
+		if err != nil {
+			return threshold.KeyShare{}, err
+		}
+		if ks.GroupKey != groupKey || ks.Index != tw.index || len(ks.VerificationShares) != len(tw.participants) {
+			return threshold.KeyShare{}, errThresholdConfigMismatch
+		}
+		return ks, nil
+	}
+	return threshold.KeyShare{}, errKeyNotFound
+}
+
+// pruneSessionsLocked forgets the sessions which did not complete in time. tw.mu must be held
+func (tw *ThresholdWallet) pruneSessionsLocked() {
+	now := time.Now()
+	for id, s := range tw.dkgs {
+		if now.After(s.expires) {
+			delete(tw.dkgs, id)
+		}
+	}
+	for id, s := range tw.nonces {
+		if now.After(s.expires) {
+			delete(tw.nonces, id)
+		}
+	}
+	for id, a := range tw.approvals {
+		if now.After(a.expires) {
+			delete(tw.approvals, id)
+		}
+	}
+}
+
+// dkgCommit starts a key generation, for the coordinator. This participant
+// only takes part once the wallet is initialized with its password, which the
+// key share is encrypted with.
+func (tw *ThresholdWallet) dkgCommit(from uint64, req threshold.DKGCommitRequest) (resp threshold.DKGCommitResponse, err error) {
+	tw.mu.Lock()
+	defer tw.mu.Unlock()
+
+	tw.pruneSessionsLocked()
+	if _, ok := tw.dkgs[req.Session]; ok {
+		return resp, errThresholdSessionExists
+	}
+	_, err = tw.password.key()
+	if err != nil {
+		return
+	}
+	n := uint64(len(tw.participants))
+	dkg, commitment, err := threshold.NewDKG(tw.index, tw.threshold, n)
+	if err != nil {
+		return
+	}
+	tw.dkgs[req.Session] = &thresholdDKGSession{
+		coordinator: from,
+		dkg:         dkg,
+		received:    make([]crypto.Ed25519Scalar, n),
+		from:        make([]bool, n),
+		expires:     time.Now().Add(thresholdSessionLifetime),
+	}
+	resp.Commitment = commitment
+	return resp, nil
+}
+
+// dkgShares checks the commitments of every participant of a key generation,
+// and sends our shares to the other participants.
+func (tw *ThresholdWallet) dkgShares(from uint64, req threshold.DKGSharesRequest) (resp threshold.EmptyResponse, err error) {
+	tw.mu.Lock()
+	s, ok := tw.dkgs[req.Session]
+	if !ok || s.coordinator != from {
+		tw.mu.Unlock()
+		return resp, errThresholdSessionNotFound
+	}
+	shares, err := s.dkg.Shares(req.Commitments)
+	if err == nil {
+		s.received[tw.index-1] = shares[tw.index-1]
+		s.from[tw.index-1] = true
+	}
+	tw.mu.Unlock()
+	if err != nil {
+		return
+	}
+
+	// Send the shares without holding the lock, since the other participants
+	// send theirs to us at the same time. Each share is encrypted with the
+	// peer key of its recipient.
+	for i, participant := range tw.participants {
+		j := uint64(i) + 1
+		if j == tw.index {
+			continue
+		}
+		deliver := threshold.DKGDeliverRequest{
+			PeerRequest: threshold.PeerRequest{Wallet: tw.name, Session: req.Session},
+			Share:       threshold.SealShare(participant.Key, req.Session, tw.index, j, shares[i]),
+		}
+		err = participant.Call(context.Background(), threshold.DKGDeliverPath, deliver, &threshold.EmptyResponse{})
+		if err != nil {
+			return
+		}
+	}
+	return resp, nil
+}
+
+// dkgDeliver receives the share of another participant of a key generation.
+func (tw *ThresholdWallet) dkgDeliver(from uint64, req threshold.DKGDeliverRequest) (resp threshold.EmptyResponse, err error) {
+	tw.mu.Lock()
+	defer tw.mu.Unlock()
+
+	s, ok := tw.dkgs[req.Session]
+	if !ok {
+		return resp, errThresholdSessionNotFound
+	}
+	if from == tw.index || s.from[from-1] {
+		return resp, errThresholdUnexpectedShare
+	}
+	share, err := threshold.OpenShare(tw.participants[from-1].Key, req.Session, from, tw.index, req.Share)
+	if err != nil {
+		return
+	}
+	s.received[from-1] = share
+	s.from[from-1] = true
+	return resp, nil
+}
+
+// dkgFinish checks the shares received in a key generation, and stores our
+// share of the new key, encrypted with the master key of the wallet.
+func (tw *ThresholdWallet) dkgFinish(from uint64, req threshold.DKGFinishRequest) (resp threshold.DKGFinishResponse, err error) {
+	tw.mu.Lock()
+	defer tw.mu.Unlock()
+
+	s, ok := tw.dkgs[req.Session]
+	if !ok || s.coordinator != from {
+		return resp, errThresholdSessionNotFound
+	}
+	delete(tw.dkgs, req.Session)
+	if slices.Contains(s.from, false) {
+		return resp, errThresholdMissingShares
+	}
+	ks, err := s.dkg.Finish(s.received)
+	if err != nil {
+		return
+	}
+
+	masterKey, err := tw.password.key()
+	if err != nil {
+		return
+	}
+	keys, err := tw.loadKeysLocked()
+	if err != nil {
+		return
+	}
+	for _, key := range keys {
+		if key.GroupKey == ks.GroupKey {
+			return resp, errKeyExists
+		}
+	}
+	share, err := encryptBlobWithKey(protocol.EncodeJSON(ks), PTThresholdKeyShare, masterKey)
+	if err != nil {
+		return
+	}
+	err = tw.saveKeysLocked(append(keys, thresholdStoredKey{GroupKey: ks.GroupKey, Share: share}))
+	if err != nil {
+		return
+	}
+	tw.log.Infof("threshold wallet %s: generated key %s", tw.name, basics.Address(ks.GroupKey))
+	resp.GroupKey = ks.GroupKey
+	resp.VerificationShares = ks.VerificationShares
+	return resp, nil
+}
+
+// signCommit generates our nonces for a signature of a message by groupKey,
+// for the coordinator, if our user approved that signature.
+func (tw *ThresholdWallet) signCommit(from uint64, req threshold.SignCommitRequest) (resp threshold.SignCommitResponse, err error) {
+	tw.mu.Lock()
+	defer tw.mu.Unlock()
+
+	tw.pruneSessionsLocked()
+	if _, ok := tw.nonces[req.Session]; ok {
+		return resp, errThresholdSessionExists
+	}
+	approvalID := thresholdApprovalID(req.GroupKey, req.Message)
+	a, ok := tw.approvals[approvalID]
+	if !ok {
+		return resp, errThresholdNotApproved
+	}
+	nonces, commitment, err := a.keyShare.Commit()
+	if err != nil {
+		return
+	}
+	tw.nonces[req.Session] = &thresholdNonceSession{
+		coordinator: from,
+		approval:    approvalID,
+		nonces:      nonces,
+		commitment:  commitment,
+		expires:     time.Now().Add(thresholdSessionLifetime),
+	}
+	resp.Commitment = commitment
+	return resp, nil
+}
+
+// signShare returns our signature share of the message we committed to, given
+// the commitments of the signers. Our nonces for the session are deleted
+// whether or not we sign, so that they are never used twice, and the approval
+// of our user is used up by the signature.
+func (tw *ThresholdWallet) signShare(from uint64, req threshold.SignShareRequest) (resp threshold.SignShareResponse, err error) {
+	tw.mu.Lock()
+	defer tw.mu.Unlock()
+
+	s, ok := tw.nonces[req.Session]
+	if !ok {
+		return resp, errThresholdSessionNotFound
+	}
+	delete(tw.nonces, req.Session)
+	if s.coordinator != from || s.approval != thresholdApprovalID(req.GroupKey, req.Message) {
+		return resp, errThresholdSessionNotFound
+	}
+	if !slices.Contains(req.Commitments, s.commitment) {
+		return resp, errThresholdCommitmentMismatch
+	}
+	a, ok := tw.approvals[s.approval]
+	if !ok {
+		return resp, errThresholdNotApproved
+	}
+	resp.Share, err = a.keyShare.Sign(req.Message, s.nonces, req.Commitments)
+	if err != nil {
+		return
+	}
+	delete(tw.approvals, s.approval)
+	tw.log.Infof("threshold wallet %s: signed share for key %s in session %s", tw.name, basics.Address(req.GroupKey), req.Session)
+	return resp, nil
+}
+
+// Init implements the Wallet interface, making the key shares of the wallet
+// available to the key generations and the signatures this kmd takes part in.
+func (tw *ThresholdWallet) Init(pw []byte) error {
+	return tw.password.init(pw)
+}
+
+// CheckPassword implements the Wallet interface.
+func (tw *ThresholdWallet) CheckPassword(pw []byte) error {
+	return tw.password.check(pw)
+}
+
+// ExportMasterDerivationKey implements the Wallet interface.
+func (tw *ThresholdWallet) ExportMasterDerivationKey(pw []byte) (crypto.MasterDerivationKey, error) {
+	return crypto.MasterDerivationKey{}, errNotSupported
+}
+
+// ExportBackup implements the Wallet interface. Each participant only holds a
+// share of the keys.
+func (tw *ThresholdWallet) ExportBackup(pw []byte, backupPw []byte) ([]byte, error) {
+	return nil, errNotSupported
+}
+
+// Metadata implements the Wallet interface.
+func (tw *ThresholdWallet) Metadata() (wallet.Metadata, error) {
+	return wallet.Metadata{
+		ID:                    []byte(tw.id),
+		Name:                  []byte(tw.name),
+		DriverName:            thresholdWalletDriverName,
+		DriverVersion:         thresholdWalletDriverVersion,
+		SupportedTransactions: thresholdWalletSupportedTxs,
+	}, nil
+}
+
+// ListKeys implements the Wallet interface, returning the group keys of the
+// wallet.
+func (tw *ThresholdWallet) ListKeys() ([]crypto.Digest, error) {
+	tw.mu.Lock()
+	defer tw.mu.Unlock()
+
+	keys, err := tw.loadKeysLocked()
+	if err != nil {
+		return nil, err
+	}
+	addrs := make([]crypto.Digest, len(keys))
+	for i, ks := range keys {
+		addrs[i] = crypto.Digest(ks.GroupKey)
+	}
+	return addrs, nil
+}
+
+// ImportKey implements the Wallet interface.
+func (tw *ThresholdWallet) ImportKey(sk crypto.PrivateKey) (crypto.Digest, error) {
+	return crypto.Digest{}, errNotSupported
+}
+
+// ExportKey implements the Wallet interface.
+func (tw *ThresholdWallet) ExportKey(pk crypto.Digest, pw []byte) (crypto.PrivateKey, error) {
+	return crypto.PrivateKey{}, errNotSupported
+}
+
+// GenerateKey implements the Wallet interface, running a distributed key
+// generation with every participant of the wallet. Every participant must
+// have initialized the wallet with its password.
+func (tw *ThresholdWallet) GenerateKey(displayMnemonic bool) (crypto.Digest, error) {
+	tw.mu.Lock()
+	err := tw.checkParticipantsLocked()
+	tw.mu.Unlock()
+	if err != nil {
+		return crypto.Digest{}, err
+	}
+
+	ctx := context.Background()
+	peerReq := threshold.PeerRequest{Wallet: tw.name, Session: newThresholdSessionID()}
+
+	// Collect the commitments of every participant
+	var commitments []threshold.DKGCommitment
+	for _, participant := range tw.participants {
+		var resp threshold.DKGCommitResponse
+		err := participant.Call(ctx, threshold.DKGCommitPath, threshold.DKGCommitRequest{PeerRequest: peerReq}, &resp)
+		if err != nil {
+			return crypto.Digest{}, err
+		}
+		commitments = append(commitments, resp.Commitment)
+	}
+
+	// Every participant checks them and sends its shares to the others
+	for _, participant := range tw.participants {
+		req := threshold.DKGSharesRequest{PeerRequest: peerReq, Commitments: commitments}
+		err := participant.Call(ctx, threshold.DKGSharesPath, req, &threshold.EmptyResponse{})
+		if err != nil {
+			return crypto.Digest{}, err
+		}
+	}
+
+	// Every participant checks the shares it received, and must have computed
+	// the same key
+	var first threshold.DKGFinishResponse
+	for i, participant := range tw.participants {
+		var resp threshold.DKGFinishResponse
+		err := participant.Call(ctx, threshold.DKGFinishPath, threshold.DKGFinishRequest{PeerRequest: peerReq}, &resp)
+		if err != nil {
+			return crypto.Digest{}, err
+		}
+		if i == 0 {
+			first = resp
+		} else if resp.GroupKey != first.GroupKey || !slices.Equal(resp.VerificationShares, first.VerificationShares) {
+			return crypto.Digest{}, errThresholdKeyMismatch
+		}
+	}
+	return crypto.Digest(first.GroupKey), nil
+}
+
+// DeleteKey implements the Wallet interface.
+func (tw *ThresholdWallet) DeleteKey(pk crypto.Digest, pw []byte) error {
+	return errNotSupported
+}
+
+// ImportMultisigAddr implements the Wallet interface.
+func (tw *ThresholdWallet) ImportMultisigAddr(version, threshold uint8, pks []crypto.PublicKey) (crypto.Digest, error) {
+	return crypto.Digest{}, errNotSupported
+}
+
+// LookupMultisigPreimage implements the Wallet interface.
+func (tw *ThresholdWallet) LookupMultisigPreimage(crypto.Digest) (version, threshold uint8, pks []crypto.PublicKey, err error) {
+	err = errNotSupported
+	return
+}
+
+// ListMultisigAddrs implements the Wallet interface.
+func (tw *ThresholdWallet) ListMultisigAddrs() (addrs []crypto.Digest, err error) {
+	return nil, nil
+}
+
+// DeleteMultisigAddr implements the Wallet interface.
+func (tw *ThresholdWallet) DeleteMultisigAddr(addr crypto.Digest, pw []byte) error {
+	return errNotSupported
+}
+
+// sign approves the signature of msg by groupKey, and coordinates it with
+// enough participants to reach the threshold, starting with this kmd. The
+// other participants only take part if their users approved it too.
+func (tw *ThresholdWallet) sign(groupKey crypto.PublicKey, msg []byte, pw []byte) (sig crypto.Signature, err error) {
+	err = tw.password.check(pw)
+	if err != nil {
+		return
+	}
+	tw.mu.Lock()
+	err = tw.checkParticipantsLocked()
+	if err != nil {
+		tw.mu.Unlock()
+		return
+	}
+	ks, err := tw.decryptKeyLocked(groupKey)
+	if err != nil {
+		tw.mu.Unlock()
+		return
+	}
+	tw.pruneSessionsLocked()
+	tw.approvals[thresholdApprovalID(groupKey, msg)] = &thresholdApproval{
+		keyShare: ks,
+		expires:  time.Now().Add(thresholdApprovalLifetime),
+	}
+	tw.mu.Unlock()
+	tw.log.Infof("threshold wallet %s: approved signature by key %s", tw.name, basics.Address(groupKey))
+
+	ctx := context.Background()
+	peerReq := threshold.PeerRequest{Wallet: tw.name, Session: newThresholdSessionID()}
+	order := []uint64{tw.index}
+	for i := range tw.participants {
+		if uint64(i)+1 != tw.index {
+			order = append(order, uint64(i)+1)
+		}
+	}
+
+	// Collect the commitments of the first participants which answer
+	var signers []uint64
+	var commitments []threshold.SigningCommitment
+	for _, j := range order {
+		if uint64(len(signers)) == ks.Threshold {
+			break
+		}
+		var resp threshold.SignCommitResponse
+		req := threshold.SignCommitRequest{PeerRequest: peerReq, GroupKey: groupKey, Message: msg}
+		err = tw.participants[j-1].Call(ctx, threshold.SignCommitPath, req, &resp)
+		if err != nil {
+			tw.log.Warnf("threshold wallet %s: participant %d: %v", tw.name, j, err)
+			continue
+		}
+		if resp.Commitment.Index != j {
+			tw.log.Warnf("threshold wallet %s: participant %d answered with index %d", tw.name, j, resp.Commitment.Index)
+			continue
+		}
+		signers = append(signers, j)
+		commitments = append(commitments, resp.Commitment)
+	}
+	if uint64(len(signers)) < ks.Threshold {
+		return sig, errThresholdNotEnoughApprovals
+	}
+
+	// Collect their signature shares, which Aggregate checks
+	shares := make([]crypto.Ed25519Scalar, len(signers))
+	for i, j := range signers {
+		var resp threshold.SignShareResponse
+		req := threshold.SignShareRequest{PeerRequest: peerReq, GroupKey: groupKey, Message: msg, Commitments: commitments}
+		err = tw.participants[j-1].Call(ctx, threshold.SignSharePath, req, &resp)
+		if err != nil {
+			return
+		}
+		shares[i] = resp.Share
+	}
+	return ks.Aggregate(msg, commitments, shares)
+}
+
+// SignTransaction implements the Wallet interface, signing with the key of the transaction
+// sender if pk is empty.
+func (tw *ThresholdWallet) SignTransaction(tx transactions.Transaction, pk crypto.PublicKey, pw []byte) ([]byte, error) {
+	if (pk == crypto.PublicKey{}) {
+		pk = crypto.PublicKey(tx.Src())
+	}
+	sig, err := tw.sign(pk, crypto.HashRep(tx), pw)
+	if err != nil {
+		return nil, err
+	}
+
+	stxn := transactions.SignedTxn{
+		Txn: tx,
+		Sig: sig,
+	}
+	// Set the AuthAddr if the key we signed with doesn't match the txn sender
+	if basics.Address(pk) != tx.Sender {
+		stxn.AuthAddr = basics.Address(pk)
+	}
+	return protocol.Encode(&stxn), nil
+}
+
+// SignProgram implements the Wallet interface.
+func (tw *ThresholdWallet) SignProgram(data []byte, src crypto.Digest, pw []byte) ([]byte, error) {
+	sig, err := tw.sign(crypto.PublicKey(src), crypto.HashRep(logic.Program(data)), pw)
+	if err != nil {
+		return nil, err
+	}
+	return sig[:], nil
+}
+
+// MultisigSignTransaction implements the Wallet interface.
+func (tw *ThresholdWallet) MultisigSignTransaction(tx transactions.Transaction, pk crypto.PublicKey, partial crypto.MultisigSig, pw []byte, signer crypto.Digest) (crypto.MultisigSig, error) {
+	return partial, errNotSupported
+}
+
+// MultisigSignProgram implements the Wallet interface.
+func (tw *ThresholdWallet) MultisigSignProgram(data []byte, src crypto.Digest, pk crypto.PublicKey, partial crypto.MultisigSig, pw []byte) (crypto.MultisigSig, error) {
+	return partial, errNotSupported
+}
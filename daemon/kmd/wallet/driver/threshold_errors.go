@@ -0,0 +1,37 @@
+// Copyright (C) 2019-2025 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package driver
+
+import (
+	"fmt"
+)
+
+var errThresholdWalletExists = fmt.Errorf("threshold wallets with the same name are configured")
+var errThresholdSessionExists = fmt.Errorf("threshold session already exists")
+var errThresholdSessionNotFound = fmt.Errorf("threshold session not found or expired")
+var errThresholdUnexpectedShare = fmt.Errorf("unexpected threshold key generation share")
+var errThresholdMissingShares = fmt.Errorf("threshold key generation is missing the shares of some participants")
+var errThresholdKeyMismatch = fmt.Errorf("threshold participants computed different keys")
+var errThresholdConfigMismatch = fmt.Errorf("threshold key share does not match the participants of the wallet")
+var errThresholdNotEnoughApprovals = fmt.Errorf("not enough threshold participants approved the signature yet; it is made by the last participant of the threshold to sign")
+var errThresholdNotApproved = fmt.Errorf("threshold signature was not approved by this participant")
+var errThresholdCommitmentMismatch = fmt.Errorf("threshold commitments do not include the commitment of this participant")
+var errThresholdNoParticipantKeys = fmt.Errorf("threshold wallet participant keys are not configured")
+var errThresholdIdentityMismatch = fmt.Errorf("threshold participant key of this kmd does not match its identity")
+var errThresholdMalformedIdentity = fmt.Errorf("malformed threshold participant identity file")
+var errThresholdMalformedRequest = fmt.Errorf("malformed threshold request")
+var errThresholdUnauthenticated = fmt.Errorf("threshold request is not authenticated by a participant")
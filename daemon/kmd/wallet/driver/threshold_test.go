@@ -0,0 +1,211 @@
+// Copyright (C) 2019-2025 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package driver
+
+import (
+	"bytes"
+	"context"
+	"encoding/base64"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"sync/atomic"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/algorand/go-algorand/crypto"
+	"github.com/algorand/go-algorand/daemon/kmd/config"
+	"github.com/algorand/go-algorand/daemon/kmd/threshold"
+	"github.com/algorand/go-algorand/data/basics"
+	"github.com/algorand/go-algorand/data/transactions"
+	"github.com/algorand/go-algorand/logging"
+	"github.com/algorand/go-algorand/protocol"
+	"github.com/algorand/go-algorand/test/partitiontest"
+)
+
+const testThresholdWalletName = "treasury"
+
+// testThresholdParticipant is a kmd taking part in a threshold wallet, serving
+// the threshold protocol over HTTP
+type testThresholdParticipant struct {
+	dataDir string
+	server  *httptest.Server
+	driver  atomic.Pointer[ThresholdWalletDriver]
+	cfg     config.ThresholdWalletConfig
+}
+
+func (p *testThresholdParticipant) wallet(t *testing.T) *ThresholdWallet {
+	w, err := p.driver.Load().FetchWallet([]byte(nameToThresholdID(testThresholdWalletName)))
+	require.NoError(t, err)
+	return w.(*ThresholdWallet)
+}
+
+// makeTestThresholdParticipants starts n participants of a wallet with threshold t, and configures them with
+// the participant keys they generate
+func makeTestThresholdParticipants(t *testing.T, thresh uint64, n int) []*testThresholdParticipant {
+	participants := make([]*testThresholdParticipant, n)
+	var urls []string
+	for i := range participants {
+		p := &testThresholdParticipant{dataDir: t.TempDir()}
+		p.server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			p.driver.Load().ServeHTTP(w, r)
+		}))
+		t.Cleanup(p.server.Close)
+		participants[i] = p
+		urls = append(urls, p.server.URL)
+	}
+
+	// The participants generate their keys when they first start, before they are configured
+	var keys []string
+	for i, p := range participants {
+		p.cfg = config.ThresholdWalletConfig{
+			Name:         testThresholdWalletName,
+			Threshold:    thresh,
+			Participants: urls,
+			Index:        uint64(i) + 1,
+			TimeoutSecs:  5,
+		}
+		p.restart(t)
+		_, err := p.wallet(t).GenerateKey(false)
+		require.ErrorIs(t, err, errThresholdNoParticipantKeys)
+		keys = append(keys, basics.Address(p.wallet(t).identity.Public).String())
+	}
+	for _, p := range participants {
+		p.cfg.ParticipantKeys = keys
+		p.restart(t)
+	}
+	return participants
+}
+
+// restart initializes a new driver for the participant
+func (p *testThresholdParticipant) restart(t *testing.T) {
+	cfg := config.KMDConfig{DataDir: p.dataDir}
+	cfg.DriverConfig.SQLiteWalletDriverConfig.ScryptParams = config.ScryptParams{ScryptN: 2, ScryptR: 1, ScryptP: 1}
+	cfg.DriverConfig.ThresholdWalletDriverConfig.Wallets = []config.ThresholdWalletConfig{p.cfg}
+	twd := &ThresholdWalletDriver{}
+	require.NoError(t, twd.InitWithConfig(cfg, logging.TestingLog(t)))
+	p.driver.Store(twd)
+}
+
+func TestThresholdWalletSign(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	participants := makeTestThresholdParticipants(t, 2, 3)
+	for i, p := range participants {
+		pw := []byte{byte('a' + i)}
+		id, err := p.driver.Load().ConfiguredWalletID([]byte(testThresholdWalletName))
+		require.NoError(t, err)
+		require.NoError(t, p.driver.Load().CreateWallet([]byte(testThresholdWalletName), id, pw, crypto.MasterDerivationKey{}))
+		require.NoError(t, p.wallet(t).Init(pw))
+	}
+
+	addr, err := participants[0].wallet(t).GenerateKey(false)
+	require.NoError(t, err)
+	groupKey := crypto.PublicKey(addr)
+	for _, p := range participants {
+		keys, err := p.wallet(t).ListKeys()
+		require.NoError(t, err)
+		require.Equal(t, []crypto.Digest{addr}, keys)
+
+		// the key shares are encrypted at rest
+		tw := p.wallet(t)
+		tw.mu.Lock()
+		ks, err := tw.decryptKeyLocked(groupKey)
+		tw.mu.Unlock()
+		require.NoError(t, err)
+		data, err := os.ReadFile(tw.keysPath)
+		require.NoError(t, err)
+		require.False(t, bytes.Contains(data, ks.SecretShare[:]))
+		require.False(t, bytes.Contains(data, []byte(base64.StdEncoding.EncodeToString(ks.SecretShare[:]))))
+	}
+
+	tx := transactions.Transaction{
+		Type:             protocol.PaymentTx,
+		Header:           transactions.Header{Sender: basics.Address(addr), FirstValid: 1, LastValid: 10},
+		PaymentTxnFields: transactions.PaymentTxnFields{Receiver: basics.Address{1}, Amount: basics.MicroAlgos{Raw: 7}},
+	}
+
+	// every participant approves the transaction with its own password, and the
+	// transaction is signed once a threshold of them approved it
+	_, err = participants[0].wallet(t).SignTransaction(tx, crypto.PublicKey{}, []byte("wrong"))
+	require.ErrorIs(t, err, errDecrypt)
+	_, err = participants[0].wallet(t).SignTransaction(tx, crypto.PublicKey{}, []byte("a"))
+	require.ErrorIs(t, err, errThresholdNotEnoughApprovals)
+	stxBytes, err := participants[1].wallet(t).SignTransaction(tx, crypto.PublicKey{}, []byte("b"))
+	require.NoError(t, err)
+	var stx transactions.SignedTxn
+	require.NoError(t, protocol.Decode(stxBytes, &stx))
+	require.True(t, crypto.SignatureVerifier(groupKey).Verify(tx, stx.Sig))
+
+	// an approval allows a single signature
+	_, err = participants[1].wallet(t).SignTransaction(tx, crypto.PublicKey{}, []byte("b"))
+	require.ErrorIs(t, err, errThresholdNotEnoughApprovals)
+
+	// a participant does not sign a message its user did not approve
+	other := tx
+	other.Amount.Raw = 1000000
+	_, err = participants[2].wallet(t).SignTransaction(other, crypto.PublicKey{}, []byte("c"))
+	require.ErrorIs(t, err, errThresholdNotEnoughApprovals)
+	client := participants[2].wallet(t).participants[0]
+	req := threshold.SignCommitRequest{
+		PeerRequest: threshold.PeerRequest{Wallet: testThresholdWalletName, Session: newThresholdSessionID()},
+		GroupKey:    groupKey,
+		Message:     crypto.HashRep(other),
+	}
+	err = client.Call(context.Background(), threshold.SignCommitPath, req, &threshold.SignCommitResponse{})
+	require.ErrorContains(t, err, errThresholdNotApproved.Error())
+
+	// the participants only answer the requests authenticated by another participant
+	client.Key = make([]byte, threshold.PeerKeyLen)
+	err = client.Call(context.Background(), threshold.SignCommitPath, req, &threshold.SignCommitResponse{})
+	require.ErrorContains(t, err, errThresholdUnauthenticated.Error())
+}
+
+func TestThresholdWalletLocked(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	participants := makeTestThresholdParticipants(t, 2, 2)
+	for _, p := range participants {
+		id, err := p.driver.Load().ConfiguredWalletID([]byte(testThresholdWalletName))
+		require.NoError(t, err)
+		require.ErrorIs(t, p.wallet(t).Init([]byte("pw")), errConfiguredWalletNoPassword)
+		require.NoError(t, p.driver.Load().CreateWallet([]byte(testThresholdWalletName), id, []byte("pw"), crypto.MasterDerivationKey{}))
+	}
+
+	// a participant only takes part in a key generation once its wallet is
+	// initialized with its password
+	require.NoError(t, participants[0].wallet(t).Init([]byte("pw")))
+	_, err := participants[0].wallet(t).GenerateKey(false)
+	require.ErrorContains(t, err, errConfiguredWalletLocked.Error())
+	require.NoError(t, participants[1].wallet(t).Init([]byte("pw")))
+	addr, err := participants[0].wallet(t).GenerateKey(false)
+	require.NoError(t, err)
+
+	// nor in a signature, until it is initialized again after a restart
+	participants[1].restart(t)
+	_, err = participants[1].wallet(t).SignProgram([]byte{1}, addr, []byte("pw"))
+	require.ErrorIs(t, err, errConfiguredWalletLocked)
+	require.NoError(t, participants[1].wallet(t).Init([]byte("pw")))
+	_, err = participants[1].wallet(t).SignProgram([]byte{1}, addr, []byte("pw"))
+	require.ErrorIs(t, err, errThresholdNotEnoughApprovals)
+	sig, err := participants[0].wallet(t).SignProgram([]byte{1}, addr, []byte("pw"))
+	require.NoError(t, err)
+	require.Len(t, sig, len(crypto.Signature{}))
+}
@@ -36,6 +36,7 @@ type Wallet interface {
 	Init(pw []byte) error
 	CheckPassword(pw []byte) error
 	ExportMasterDerivationKey(pw []byte) (crypto.MasterDerivationKey, error)
+	ExportBackup(pw []byte, backupPw []byte) ([]byte, error)
 
 	Metadata() (Metadata, error)
 
@@ -0,0 +1,236 @@
+// Copyright (C) 2019-2025 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package account
+
+import (
+	"bufio"
+	"bytes"
+	"crypto/rand"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"golang.org/x/crypto/nacl/secretbox"
+	"golang.org/x/crypto/scrypt"
+)
+
+// The secrets of the participation keys (the VRF and voting secrets, and the state proof keys) can be
+// encrypted in the registry database with secretbox, under a key derived with scrypt from key material
+// supplied by the operator: a passphrase, the contents of a file, or a key fetched from a local key
+// management service. The salt of the key and a verifier of the material are stored in the Encryption
+// table, whose presence marks the registry as encrypted.
+
+const (
+	secretsKeyLen   = 32
+	secretsSaltLen  = 32
+	secretsNonceLen = 24
+
+	secretsScryptN = 32768
+	secretsScryptR = 8
+	secretsScryptP = 1
+
+	// sealedSecretsMarker starts every encrypted blob. It is never used by msgpack, so that encrypted
+	// blobs are told apart from the plaintext ones of a registry written before it was encrypted.
+	sealedSecretsMarker = 0xc1
+
+	// Key material sources, see config.Local.ParticipationKeysEncryption
+	secretsSourcePassphrase = "passphrase"
+	secretsSourceFile       = "file:"
+	secretsSourceKMS        = "kms:"
+
+	// kmsKeyID is the key the local key management service is asked for
+	kmsKeyID       = "algod-participation"
+	kmsTimeout     = 30 * time.Second
+	kmsMaxResponse = 1 << 16
+)
+
+// secretsVerifierPlaintext is sealed in the Encryption table to check the key material on Unlock
+var secretsVerifierPlaintext = []byte("algod participation registry")
+
+// ErrRegistryLocked is returned when the secrets of the registry are needed while it is locked.
+var ErrRegistryLocked = errors.New("the participation registry is locked")
+
+// ErrRegistryNotEncrypted is returned when unlocking a registry which is not encrypted yet, which is
+// encrypted by Initialize.
+var ErrRegistryNotEncrypted = errors.New("the participation registry is not encrypted yet, it must be initialized")
+
+// ErrWrongKeyMaterial is returned when unlocking the registry with the wrong key material.
+var ErrWrongKeyMaterial = errors.New("wrong key material for the participation registry")
+
+// ErrPassphraseRequired is returned when the key material of the registry must be given as a passphrase.
+var ErrPassphraseRequired = errors.New("a passphrase is required to unlock the participation registry")
+
+// ErrInvalidKeySource is returned for an unknown key material source.
+var ErrInvalidKeySource = errors.New("participation key encryption must be passphrase, file:<path> or kms:<socket path>")
+
+type secretsKey [secretsKeyLen]byte
+
+func deriveSecretsKey(material []byte, salt []byte) (*secretsKey, error) {
+	if len(material) == 0 {
+		return nil, ErrWrongKeyMaterial
+	}
+	keySlice, err := scrypt.Key(material, salt, secretsScryptN, secretsScryptR, secretsScryptP, secretsKeyLen)
+	if err != nil {
+		return nil, fmt.Errorf("unable to derive the registry key: %w", err)
+	}
+	var key secretsKey
+	copy(key[:], keySlice)
+	return &key, nil
+}
+
+func isSealed(blob []byte) bool {
+	return len(blob) > 0 && blob[0] == sealedSecretsMarker
+}
+
+// sealSecrets encrypts plaintext with key, or returns it unchanged if key is nil.
+func sealSecrets(key *secretsKey, plaintext []byte) ([]byte, error) {
+	if key == nil || len(plaintext) == 0 {
+		return plaintext, nil
+	}
+	var nonce [secretsNonceLen]byte
+	_, err := rand.Read(nonce[:])
+	if err != nil {
+		return nil, err
+	}
+	out := make([]byte, 1+secretsNonceLen, 1+secretsNonceLen+len(plaintext)+secretbox.Overhead)
+	out[0] = sealedSecretsMarker
+	copy(out[1:], nonce[:])
+	return secretbox.Seal(out, plaintext, &nonce, (*[secretsKeyLen]byte)(key)), nil
+}
+
+// openSecrets decrypts a blob sealed with key. Blobs that were not sealed are returned unchanged, and
+// sealed blobs cannot be opened without key.
+func openSecrets(key *secretsKey, blob []byte) ([]byte, error) {
+	if !isSealed(blob) {
+		return blob, nil
+	}
+	if key == nil {
+		return nil, ErrRegistryLocked
+	}
+	if len(blob) < 1+secretsNonceLen {
+		return nil, errors.New("sealed secrets are too short")
+	}
+	var nonce [secretsNonceLen]byte
+	copy(nonce[:], blob[1:])
+	plaintext, ok := secretbox.Open(nil, blob[1+secretsNonceLen:], &nonce, (*[secretsKeyLen]byte)(key))
+	if !ok {
+		return nil, ErrWrongKeyMaterial
+	}
+	return plaintext, nil
+}
+
+// ReadKeyMaterial fetches the key material of the registry from source, the ParticipationKeysEncryption
+// setting: the contents of a file for file:<path>, or the key returned by the local key management
+// service listening on the Unix socket of kms:<socket path>. A passphrase source cannot be read, and
+// ErrPassphraseRequired is returned.
+func ReadKeyMaterial(source string) ([]byte, error) {
+	kind, arg, err := parseKeySource(source)
+	if err != nil {
+		return nil, err
+	}
+	switch kind {
+	case secretsSourceFile:
+		material, err := os.ReadFile(arg)
+		if err != nil {
+			return nil, err
+		}
+		return bytes.TrimSpace(material), nil
+	case secretsSourceKMS:
+		return fetchKMSKey(arg)
+	default:
+		return nil, ErrPassphraseRequired
+	}
+}
+
+// ValidateKeySource returns an error if source is not a valid ParticipationKeysEncryption setting.
+func ValidateKeySource(source string) error {
+	_, _, err := parseKeySource(source)
+	return err
+}
+
+func parseKeySource(source string) (kind string, arg string, err error) {
+	switch {
+	case source == secretsSourcePassphrase:
+		return secretsSourcePassphrase, "", nil
+	case strings.HasPrefix(source, secretsSourceFile):
+		kind, arg = secretsSourceFile, strings.TrimPrefix(source, secretsSourceFile)
+	case strings.HasPrefix(source, secretsSourceKMS):
+		kind, arg = secretsSourceKMS, strings.TrimPrefix(source, secretsSourceKMS)
+	default:
+		return "", "", ErrInvalidKeySource
+	}
+	if !filepath.IsAbs(arg) {
+		return "", "", ErrInvalidKeySource
+	}
+	return kind, arg, nil
+}
+
+// KMSRequest is a request to the local key management service: one JSON line on its Unix socket,
+// answered by one KMSResponse line.
+type KMSRequest struct {
+	Method string `json:"method"`
+	KeyID  string `json:"key_id"`
+}
+
+// KMSResponse is the response of the local key management service
+type KMSResponse struct {
+	Key   []byte `json:"key,omitempty"`
+	Error string `json:"error,omitempty"`
+}
+
+func fetchKMSKey(socket string) ([]byte, error) {
+	conn, err := net.DialTimeout("unix", socket, kmsTimeout)
+	if err != nil {
+		return nil, fmt.Errorf("key management service: %w", err)
+	}
+	defer conn.Close()
+	err = conn.SetDeadline(time.Now().Add(kmsTimeout))
+	if err != nil {
+		return nil, err
+	}
+
+	enc, err := json.Marshal(KMSRequest{Method: "get_key", KeyID: kmsKeyID})
+	if err != nil {
+		return nil, err
+	}
+	_, err = conn.Write(append(enc, '\n'))
+	if err != nil {
+		return nil, fmt.Errorf("key management service: %w", err)
+	}
+	line, err := bufio.NewReader(io.LimitReader(conn, kmsMaxResponse)).ReadBytes('\n')
+	if err != nil {
+		return nil, fmt.Errorf("key management service: %w", err)
+	}
+	var resp KMSResponse
+	err = json.Unmarshal(line, &resp)
+	if err != nil {
+		return nil, fmt.Errorf("key management service: %w", err)
+	}
+	if resp.Error != "" {
+		return nil, fmt.Errorf("key management service: %s", resp.Error)
+	}
+	if len(resp.Key) == 0 {
+		return nil, errors.New("key management service returned no key")
+	}
+	return resp.Key, nil
+}
@@ -0,0 +1,228 @@
+// Copyright (C) 2019-2025 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package account
+
+import (
+	"bufio"
+	"context"
+	"database/sql"
+	"encoding/json"
+	"net"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/algorand/go-algorand/config"
+	"github.com/algorand/go-algorand/data/basics"
+	"github.com/algorand/go-algorand/logging"
+	"github.com/algorand/go-algorand/protocol"
+	"github.com/algorand/go-algorand/test/partitiontest"
+	"github.com/algorand/go-algorand/util/db"
+)
+
+func openTestRegistry(t *testing.T, dbName string) *participationDB {
+	rootDB, err := db.OpenPair(dbName, false)
+	require.NoError(t, err)
+	registry, err := makeParticipationRegistry(rootDB, logging.TestingLog(t))
+	require.NoError(t, err)
+	return registry
+}
+
+// requireSealed checks that every secret stored in the registry is encrypted, or not.
+func requireSealed(t *testing.T, registry *participationDB, sealed bool) {
+	err := registry.store.Rdb.Atomic(func(ctx context.Context, tx *sql.Tx) error {
+		for _, query := range []string{`SELECT vrf FROM Keysets`, `SELECT voting FROM Rolling`, `SELECT key FROM StateProofKeys`} {
+			rows, err := tx.Query(query)
+			require.NoError(t, err)
+			count := 0
+			for rows.Next() {
+				var blob []byte
+				require.NoError(t, rows.Scan(&blob))
+				require.Equal(t, sealed, isSealed(blob), query)
+				count++
+			}
+			require.NoError(t, rows.Close())
+			require.NotZero(t, count, query)
+		}
+		return nil
+	})
+	require.NoError(t, err)
+}
+
+func TestParticipationRegistryEncryption(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	a := require.New(t)
+
+	dbName := filepath.Join(t.TempDir(), "registry")
+	registry := openTestRegistry(t, dbName)
+	a.False(registry.Locked())
+
+	p := makeTestParticipation(a, 1, 1, 2000, 3)
+	id, err := registry.Insert(p)
+	a.NoError(err)
+	a.NoError(registry.AppendKeys(id, p.StateProofSecrets.GetAllKeys()))
+	a.NoError(registry.Register(id, 1))
+	a.NoError(registry.Flush(defaultTimeout))
+	requireSealed(t, registry, false)
+	expected, err := registry.GetForRound(id, 10)
+	a.NoError(err)
+
+	// The secrets are only encrypted when initialized, and no plaintext is left in the database
+	a.ErrorIs(registry.Unlock([]byte("secret")), ErrRegistryNotEncrypted)
+	requireSealed(t, registry, false)
+	a.NoError(registry.Initialize([]byte("secret")))
+	a.False(registry.Locked())
+	requireSealed(t, registry, true)
+	for _, file := range []string{dbName, dbName + "-wal"} {
+		data, err := os.ReadFile(file)
+		if os.IsNotExist(err) {
+			continue
+		}
+		a.NoError(err)
+		a.NotContains(string(data), string(p.VRF.SK[:]), file)
+	}
+	a.NoError(registry.Record(p.Parent, 10, Vote))
+	a.NoError(registry.Flush(defaultTimeout))
+	requireSealed(t, registry, true)
+	registry.Close()
+
+	// The registry is locked when reopened
+	registry = openTestRegistry(t, dbName)
+	defer registry.Close()
+	a.True(registry.Locked())
+	record := registry.Get(id)
+	a.True(record.VRF.MsgIsZero())
+	a.True(record.Voting.MsgIsZero())
+	a.Equal(p.Voting.OneTimeSignatureVerifier, record.VoteID)
+	a.Equal(basics.Round(10), record.LastVote)
+	_, err = registry.GetForRound(id, 10)
+	a.ErrorIs(err, ErrRegistryLocked)
+	_, err = registry.GetStateProofSecretsForRound(id, 1000)
+	a.ErrorIs(err, ErrRegistryLocked)
+	_, err = registry.Insert(makeTestParticipation(a, 2, 1, 2000, 3))
+	a.ErrorIs(err, ErrRegistryLocked)
+
+	// The rolling fields are still updated while locked, without losing the secrets
+	a.NoError(registry.Record(p.Parent, 11, Vote))
+	a.NoError(registry.DeleteExpired(11, config.Consensus[protocol.ConsensusCurrentVersion]))
+	a.NoError(registry.Register(id, 12))
+	a.NoError(registry.Flush(defaultTimeout))
+	requireSealed(t, registry, true)
+
+	a.ErrorIs(registry.Unlock([]byte("wrong")), ErrWrongKeyMaterial)
+	a.ErrorIs(registry.Initialize([]byte("wrong")), ErrWrongKeyMaterial)
+	a.True(registry.Locked())
+
+	a.NoError(registry.Unlock([]byte("secret")))
+	a.False(registry.Locked())
+	actual, err := registry.GetForRound(id, 10)
+	a.NoError(err)
+	a.Equal(expected.VRF, actual.VRF)
+	a.Equal(expected.Voting.OneTimeSignatureVerifier, actual.Voting.OneTimeSignatureVerifier)
+	a.Equal(basics.Round(11), actual.LastVote)
+	secrets, err := registry.GetStateProofSecretsForRound(id, 1000)
+	a.NoError(err)
+	a.NotNil(secrets.StateProofSecrets)
+
+	// Keys inserted once unlocked are encrypted
+	_, err = registry.Insert(makeTestParticipation(a, 2, 1, 2000, 3))
+	a.NoError(err)
+	a.NoError(registry.Flush(defaultTimeout))
+	requireSealed(t, registry, true)
+
+	a.NoError(registry.Lock())
+	a.True(registry.Locked())
+	a.True(registry.Get(id).Voting.MsgIsZero())
+}
+
+func TestParticipationRegistryFillVoteID(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	a := require.New(t)
+
+	dbName := filepath.Join(t.TempDir(), "registry")
+	registry := openTestRegistry(t, dbName)
+	p := makeTestParticipation(a, 1, 1, 2000, 3)
+	id, err := registry.Insert(p)
+	a.NoError(err)
+	a.NoError(registry.Flush(defaultTimeout))
+	a.NoError(registry.Initialize([]byte("secret")))
+	a.Equal(p.Voting.OneTimeSignatureVerifier, registry.Get(id).VoteID)
+
+	// The public voting keys of a registry encrypted before they were stored are only known once unlocked
+	err = registry.store.Wdb.Atomic(func(ctx context.Context, tx *sql.Tx) error {
+		_, err := tx.Exec(`UPDATE Keysets SET voteID=NULL`)
+		return err
+	})
+	a.NoError(err)
+	registry.Close()
+
+	registry = openTestRegistry(t, dbName)
+	a.Zero(registry.Get(id).VoteID)
+	a.NoError(registry.Unlock([]byte("secret")))
+	a.Equal(p.Voting.OneTimeSignatureVerifier, registry.Get(id).VoteID)
+	registry.Close()
+
+	registry = openTestRegistry(t, dbName)
+	defer registry.Close()
+	a.True(registry.Locked())
+	a.Equal(p.Voting.OneTimeSignatureVerifier, registry.Get(id).VoteID)
+}
+
+func TestReadKeyMaterial(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	a := require.New(t)
+
+	_, err := ReadKeyMaterial("passphrase")
+	a.ErrorIs(err, ErrPassphraseRequired)
+	a.ErrorIs(ValidateKeySource("file:relative"), ErrInvalidKeySource)
+	a.ErrorIs(ValidateKeySource("vault:/run/vault.sock"), ErrInvalidKeySource)
+
+	keyFile := filepath.Join(t.TempDir(), "key")
+	a.NoError(os.WriteFile(keyFile, []byte("secret\n"), 0600))
+	material, err := ReadKeyMaterial("file:" + keyFile)
+	a.NoError(err)
+	a.Equal([]byte("secret"), material)
+
+	socket := filepath.Join(t.TempDir(), "kms.sock")
+	l, err := net.Listen("unix", socket)
+	a.NoError(err)
+	defer l.Close()
+	go func() {
+		for {
+			conn, err := l.Accept()
+			if err != nil {
+				return
+			}
+			line, _ := bufio.NewReader(conn).ReadBytes('\n')
+			var req KMSRequest
+			var resp KMSResponse
+			if json.Unmarshal(line, &req) != nil || req.KeyID != kmsKeyID {
+				resp.Error = "unknown key"
+			} else {
+				resp.Key = []byte("kms secret")
+			}
+			enc, _ := json.Marshal(resp)
+			conn.Write(append(enc, '\n'))
+			conn.Close()
+		}
+	}()
+	material, err = ReadKeyMaterial("kms:" + socket)
+	a.NoError(err)
+	a.Equal([]byte("kms secret"), material)
+}
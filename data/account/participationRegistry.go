@@ -81,8 +81,10 @@ type (
 		EffectiveLast     basics.Round
 
 		StateProof *merklesignature.Verifier
-		VRF        *crypto.VRFSecrets
-		Voting     *crypto.OneTimeSignatureSecrets
+		// VoteID is the public voting key, which is known while the registry is locked
+		VoteID crypto.OneTimeSignatureVerifier
+		VRF    *crypto.VRFSecrets
+		Voting *crypto.OneTimeSignatureSecrets
 	}
 
 	// StateProofKeys represents a set of ephemeral stateproof keys with their corresponding round
@@ -162,6 +164,7 @@ func (r ParticipationRecord) Duplicate() ParticipationRecord {
 		EffectiveFirst:    r.EffectiveFirst,
 		EffectiveLast:     r.EffectiveLast,
 		StateProof:        stateProof,
+		VoteID:            r.VoteID,
 		VRF:               &vrf,
 		Voting:            &voting,
 	}
@@ -268,6 +271,21 @@ type ParticipationRegistry interface {
 	// Flush ensures that all changes have been written to the underlying data store.
 	Flush(timeout time.Duration) error
 
+	// Locked tells whether the secrets of the registry are unavailable until Unlock. The records of a
+	// locked registry have no VRF and Voting secrets, and no key can be inserted or used.
+	Locked() bool
+
+	// Lock forgets the secrets of the registry until Unlock.
+	Lock() error
+
+	// Unlock decrypts the secrets of the registry with the key derived from material. It fails with
+	// ErrRegistryNotEncrypted if the registry is not encrypted yet.
+	Unlock(material []byte) error
+
+	// Initialize encrypts the secrets of the registry with the key derived from material from then on if
+	// it is not encrypted yet, and unlocks it. The key cannot be changed once the registry is encrypted.
+	Initialize(material []byte) error
+
 	// Close any resources used to implement the interface.
 	Close()
 }
@@ -285,6 +303,8 @@ func makeParticipationRegistry(accessor db.Pair, log logging.Logger) (*participa
 
 	migrations := []db.Migration{
 		dbSchemaUpgrade0,
+		dbSchemaUpgrade1,
+		dbSchemaUpgrade2,
 	}
 
 	err := db.Initialize(accessor.Wdb, migrations)
@@ -323,10 +343,13 @@ const (
 			lastValidRound  INTEGER NOT NULL,
 			keyDilution     INTEGER NOT NULL,
 
-			vrf BLOB,       --*  msgpack encoding of ParticipationAccount.vrf
+			vrf BLOB,       --*  msgpack encoding of ParticipationAccount.vrf, may be encrypted
 			stateProof BLOB --*  msgpack encoding of merklesignature.SignerContext
 		)`
 
+	// voteID is the public voting key, added in the clear to know the key while the registry is locked
+	addKeysetsVoteID = `ALTER TABLE Keysets ADD COLUMN voteID BLOB`
+
 	// Rolling maintains a 1-to-1 relationship with Keysets by primary key
 	createRolling = `CREATE TABLE Rolling (
 			pk INTEGER PRIMARY KEY NOT NULL,
@@ -337,26 +360,43 @@ const (
 			effectiveFirstRound         INTEGER,
 			effectiveLastRound          INTEGER,
 
-			voting BLOB --*  msgpack encoding of ParticipationAccount.voting
+			voting BLOB --*  msgpack encoding of ParticipationAccount.voting, may be encrypted
 		)`
 
 	createStateProof = `CREATE TABLE StateProofKeys (
 			pk    INTEGER NOT NULL, --* join with keyset to find key for a particular participation id
 			round INTEGER NOT NULL, --*  committed round for this key
-			key   BLOB    NOT NULL, --*  msgpack encoding of ParticipationAccount.BlockProof.SignatureAlgorithm
+			key   BLOB    NOT NULL, --*  msgpack encoding of ParticipationAccount.BlockProof.SignatureAlgorithm, may be encrypted
 			PRIMARY KEY (pk, round)
 		)`
-	insertKeysetQuery         = `INSERT INTO Keysets (participationID, account, firstValidRound, lastValidRound, keyDilution, vrf, stateProof) VALUES (?, ?, ?, ?, ?, ?, ?)`
+
+	// Encryption has a row once the secrets are encrypted
+	createEncryption = `CREATE TABLE Encryption (
+			id       INTEGER PRIMARY KEY CHECK (id = 0),
+			salt     BLOB NOT NULL, --*  salt of the key derived from the key material
+			verifier BLOB NOT NULL  --*  secretsVerifierPlaintext sealed with the key
+		)`
+	insertKeysetQuery         = `INSERT INTO Keysets (participationID, account, firstValidRound, lastValidRound, keyDilution, vrf, stateProof, voteID) VALUES (?, ?, ?, ?, ?, ?, ?, ?)`
 	insertRollingQuery        = `INSERT INTO Rolling (pk, voting) VALUES (?, ?)`
 	appendStateProofKeysQuery = `INSERT INTO StateProofKeys (pk, round, key) VALUES(?, ?, ?)`
 	deleteStateProofKeysQuery = `DELETE FROM StateProofKeys WHERE pk=? AND round<?`
+	selectEncryption          = `SELECT salt, verifier FROM Encryption WHERE id=0`
+	insertEncryption          = `INSERT INTO Encryption (id, salt, verifier) VALUES (0, ?, ?)`
+	selectAllVRF              = `SELECT pk, vrf FROM Keysets`
+	updateVRF                 = `UPDATE Keysets SET vrf=? WHERE pk=?`
+	selectAllVoting           = `SELECT pk, voting FROM Rolling`
+	updateVoting              = `UPDATE Rolling SET voting=? WHERE pk=?`
+	selectAllStateProofKeys   = `SELECT pk, round, key FROM StateProofKeys`
+	updateStateProofKey       = `UPDATE StateProofKeys SET key=? WHERE pk=? AND round=?`
+	selectVotingNoVoteID      = `SELECT r.pk, r.voting FROM Rolling r INNER JOIN Keysets k ON k.pk = r.pk WHERE k.voteID IS NULL`
+	updateVoteID              = `UPDATE Keysets SET voteID=? WHERE pk=?`
 
 	// SELECT pk FROM Keysets WHERE participationID = ?
 	selectPK      = `SELECT pk FROM Keysets WHERE participationID = ? LIMIT 1`
 	selectLastPK  = `SELECT pk FROM Keysets ORDER BY pk DESC LIMIT 1`
 	selectRecords = `SELECT
 			k.participationID, k.account, k.firstValidRound,
-       		k.lastValidRound, k.keyDilution, k.vrf, k.stateProof,
+       		k.lastValidRound, k.keyDilution, k.vrf, k.stateProof, k.voteID,
 			r.lastVoteRound, r.lastBlockProposalRound, r.lastStateProofRound,
 			r.effectiveFirstRound, r.effectiveLastRound, r.voting
 		FROM Keysets k
@@ -378,6 +418,13 @@ const (
 		     effectiveLastRound=?,
 		     voting=?
 		 WHERE pk IN (SELECT pk FROM Keysets WHERE participationID=?)`
+	updateRollingFieldsNoVotingSQL = `UPDATE Rolling
+		 SET lastVoteRound=?,
+		     lastBlockProposalRound=?,
+		     lastStateProofRound=?,
+		     effectiveFirstRound=?,
+		     effectiveLastRound=?
+		 WHERE pk IN (SELECT pk FROM Keysets WHERE participationID=?)`
 )
 
 // dbSchemaUpgrade0 initialize the tables.
@@ -403,6 +450,69 @@ func dbSchemaUpgrade0(ctx context.Context, tx *sql.Tx, newDatabase bool) error {
 	return nil
 }
 
+// dbSchemaUpgrade1 adds the encryption table.
+func dbSchemaUpgrade1(ctx context.Context, tx *sql.Tx, newDatabase bool) error {
+	_, err := tx.Exec(createEncryption)
+	return err
+}
+
+// dbSchemaUpgrade2 adds the public voting keys, filled from the voting secrets which are not encrypted.
+// The others are filled when the registry is unlocked.
+func dbSchemaUpgrade2(ctx context.Context, tx *sql.Tx, newDatabase bool) error {
+	_, err := tx.Exec(addKeysetsVoteID)
+	if err != nil {
+		return err
+	}
+	return fillVoteIDs(tx, nil)
+}
+
+// fillVoteIDs fills the public voting keys missing from the keysets, from the voting secrets
+// which can be decrypted with key.
+func fillVoteIDs(tx *sql.Tx, key *secretsKey) error {
+	rows, err := tx.Query(selectVotingNoVoteID)
+	if err != nil {
+		return err
+	}
+	voteIDs := make(map[int64]crypto.OneTimeSignatureVerifier)
+	for rows.Next() {
+		var pk int64
+		var rawVoting []byte
+		err = rows.Scan(&pk, &rawVoting)
+		if err != nil {
+			rows.Close()
+			return err
+		}
+		rawVoting, err = openSecrets(key, rawVoting)
+		if errors.Is(err, ErrRegistryLocked) || len(rawVoting) == 0 {
+			continue
+		}
+		if err != nil {
+			rows.Close()
+			return fmt.Errorf("unable to decrypt Voting: %w", err)
+		}
+		var voting crypto.OneTimeSignatureSecrets
+		err = protocol.Decode(rawVoting, &voting)
+		if err != nil {
+			rows.Close()
+			return fmt.Errorf("unable to decode Voting: %w", err)
+		}
+		voteIDs[pk] = voting.OneTimeSignatureVerifier
+	}
+	err = rows.Err()
+	rows.Close()
+	if err != nil {
+		return err
+	}
+
+	for pk, voteID := range voteIDs {
+		result, err := tx.Exec(updateVoteID, voteID[:], pk)
+		if err = verifyExecWithOneRowEffected(err, result, "update voteID"); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 // participationDB provides a concrete implementation of the ParticipationRegistry interface.
 type participationDB struct {
 	cache map[ParticipationID]ParticipationRecord
@@ -418,6 +528,16 @@ type participationDB struct {
 	writeQueueDone chan struct{}
 
 	flushTimeout time.Duration
+
+	// locked is set while the secrets are unavailable, and key is the key the
+	// secrets are encrypted with once unlocked, nil if they are not encrypted.
+	locked bool
+	key    *secretsKey
+
+	// writeKey is the key the secrets are written with, and writeLocked is set when
+	// they must not be written. They are only used by the write thread.
+	writeKey    *secretsKey
+	writeLocked bool
 }
 
 // DeleteStateProofKeys is a non-blocking operation, responsible for removing state-proof keys from the DB.
@@ -446,7 +566,14 @@ func (db *participationDB) initializeCache() error {
 	db.mutex.Lock()
 	defer db.mutex.Unlock()
 
-	records, err := db.getAllFromDB()
+	encrypted, err := db.isEncrypted()
+	if err != nil {
+		return err
+	}
+	db.locked = encrypted
+	db.writeLocked = encrypted
+
+	records, err := db.getAllFromDB(nil)
 	if err != nil {
 		return err
 	}
@@ -500,6 +627,10 @@ func (db *participationDB) Insert(record Participation) (id ParticipationID, err
 	db.mutex.Lock()
 	defer db.mutex.Unlock()
 
+	if db.locked {
+		return ParticipationID{}, ErrRegistryLocked
+	}
+
 	id = record.ID()
 	if _, ok := db.cache[id]; ok {
 		// PKI TODO: Add a special case to set the StateProof public key if it is in the input
@@ -521,9 +652,11 @@ func (db *participationDB) Insert(record Participation) (id ParticipationID, err
 	}
 
 	var voting *crypto.OneTimeSignatureSecrets
+	var voteID crypto.OneTimeSignatureVerifier
 	if record.Voting != nil {
 		voting = new(crypto.OneTimeSignatureSecrets)
 		*voting = record.Voting.Snapshot()
+		voteID = record.Voting.OneTimeSignatureVerifier
 	}
 
 	var stateProofVerifierPtr *merklesignature.Verifier
@@ -546,6 +679,7 @@ func (db *participationDB) Insert(record Participation) (id ParticipationID, err
 		EffectiveFirst:    0,
 		EffectiveLast:     0,
 		StateProof:        stateProofVerifierPtr,
+		VoteID:            voteID,
 		Voting:            voting,
 		VRF:               vrf,
 	}
@@ -557,6 +691,10 @@ func (db *participationDB) AppendKeys(id ParticipationID, keys StateProofKeys) e
 	db.mutex.Lock()
 	defer db.mutex.Unlock()
 
+	if db.locked {
+		return ErrRegistryLocked
+	}
+
 	if _, ok := db.cache[id]; !ok {
 		return ErrParticipationIDNotFound
 	}
@@ -591,6 +729,8 @@ func (db *participationDB) DeleteExpired(latestRound basics.Round, agreementProt
 	// We need a key for round r+1 for agreement.
 	nextRound := latestRound + 1
 	var updated []ParticipationRecord
+	// The voting secrets of a locked registry are not available
+	locked := db.Locked()
 
 	for _, v := range db.GetAll() {
 		if v.LastValid < latestRound { // this participation key is no longer valid; delete it
@@ -599,7 +739,7 @@ func (db *participationDB) DeleteExpired(latestRound basics.Round, agreementProt
 			if err != nil {
 				return err
 			}
-		} else if v.FirstValid <= latestRound { // this key is valid; update it
+		} else if v.FirstValid <= latestRound && !locked { // this key is valid; update it
 			keyDilution := v.KeyDilution
 			if keyDilution == 0 {
 				keyDilution = agreementProto.DefaultKeyDilution
@@ -612,6 +752,9 @@ func (db *participationDB) DeleteExpired(latestRound basics.Round, agreementProt
 	// mark updated records as dirty, so they will be flushed by a call to FlushRegistry after each round
 	db.mutex.Lock()
 	for _, r := range updated {
+		if db.locked {
+			break
+		}
 		db.dirty[r.ParticipationID] = struct{}{}
 		db.cache[r.ParticipationID] = r
 	}
@@ -619,8 +762,9 @@ func (db *participationDB) DeleteExpired(latestRound basics.Round, agreementProt
 	return nil
 }
 
-// scanRecords is a helper to manage scanning participation records.
-func scanRecords(rows *sql.Rows) ([]ParticipationRecord, error) {
+// scanRecords is a helper to manage scanning participation records. The secrets are decrypted with key,
+// and left nil if they are encrypted and key is nil.
+func scanRecords(rows *sql.Rows, key *secretsKey) ([]ParticipationRecord, error) {
 	results := make([]ParticipationRecord, 0)
 	for rows.Next() {
 		var record ParticipationRecord
@@ -629,6 +773,7 @@ func scanRecords(rows *sql.Rows) ([]ParticipationRecord, error) {
 		var rawVRF []byte
 		var rawVoting []byte
 		var rawStateProof []byte
+		var rawVoteID []byte
 
 		var lastVote sql.NullInt64
 		var lastBlockProposal sql.NullInt64
@@ -644,6 +789,7 @@ func scanRecords(rows *sql.Rows) ([]ParticipationRecord, error) {
 			&record.KeyDilution,
 			&rawVRF,
 			&rawStateProof,
+			&rawVoteID,
 			&lastVote,
 			&lastBlockProposal,
 			&lastStateProof,
@@ -658,6 +804,19 @@ func scanRecords(rows *sql.Rows) ([]ParticipationRecord, error) {
 		copy(record.ParticipationID[:], rawParticipation)
 		copy(record.Account[:], rawAccount)
 
+		rawVRF, err = openSecrets(key, rawVRF)
+		if errors.Is(err, ErrRegistryLocked) {
+			rawVRF = nil
+		} else if err != nil {
+			return nil, fmt.Errorf("unable to decrypt VRF: %w", err)
+		}
+		rawVoting, err = openSecrets(key, rawVoting)
+		if errors.Is(err, ErrRegistryLocked) {
+			rawVoting = nil
+		} else if err != nil {
+			return nil, fmt.Errorf("unable to decrypt Voting: %w", err)
+		}
+
 		if len(rawVRF) > 0 {
 			record.VRF = &crypto.VRFSecrets{}
 			err = protocol.Decode(rawVRF, record.VRF)
@@ -686,6 +845,12 @@ func scanRecords(rows *sql.Rows) ([]ParticipationRecord, error) {
 			}
 		}
 
+		if len(rawVoteID) == len(record.VoteID) {
+			copy(record.VoteID[:], rawVoteID)
+		} else if record.Voting != nil {
+			record.VoteID = record.Voting.OneTimeSignatureVerifier
+		}
+
 		// Check optional values.
 		if lastVote.Valid {
 			record.LastVote = basics.Round(lastVote.Int64)
@@ -713,14 +878,14 @@ func scanRecords(rows *sql.Rows) ([]ParticipationRecord, error) {
 	return results, nil
 }
 
-func (db *participationDB) getAllFromDB() (records []ParticipationRecord, err error) {
+func (db *participationDB) getAllFromDB(key *secretsKey) (records []ParticipationRecord, err error) {
 	err = db.store.Rdb.Atomic(func(ctx context.Context, tx *sql.Tx) error {
 		rows, err := tx.Query(selectRecords)
 		if err != nil {
 			return fmt.Errorf("unable to query records: %w", err)
 		}
 
-		records, err = scanRecords(rows)
+		records, err = scanRecords(rows, key)
 		if err != nil {
 			records = nil
 			return fmt.Errorf("problem scanning records: %w", err)
@@ -732,6 +897,23 @@ func (db *participationDB) getAllFromDB() (records []ParticipationRecord, err er
 	return
 }
 
+// isEncrypted tells whether the secrets of the registry are encrypted.
+func (db *participationDB) isEncrypted() (encrypted bool, err error) {
+	err = db.store.Rdb.Atomic(func(ctx context.Context, tx *sql.Tx) error {
+		var salt, verifier []byte
+		err := tx.QueryRow(selectEncryption).Scan(&salt, &verifier)
+		if err == sql.ErrNoRows {
+			return nil
+		}
+		if err != nil {
+			return fmt.Errorf("unable to scan encryption: %w", err)
+		}
+		encrypted = true
+		return nil
+	})
+	return
+}
+
 func (db *participationDB) Get(id ParticipationID) ParticipationRecord {
 	db.mutex.RLock()
 	defer db.mutex.RUnlock()
@@ -802,6 +984,14 @@ func (db *participationDB) GetStateProofSecretsForRound(id ParticipationID, roun
 		return StateProofSecretsForRound{}, fmt.Errorf("failed to fetch state proof for round %d: %w", round, err)
 	}
 
+	db.mutex.RLock()
+	key := db.key
+	db.mutex.RUnlock()
+	rawStateProofKey, err = openSecrets(key, rawStateProofKey)
+	if err != nil {
+		return StateProofSecretsForRound{}, err
+	}
+
 	// Init stateproof fields after being able to retrieve key from database
 	result.StateProofSecrets = &merklesignature.Signer{}
 	result.StateProofSecrets.SigningKey = &crypto.FalconSigner{}
@@ -836,6 +1026,10 @@ func (db *participationDB) GetStateProofSecretsForRound(id ParticipationID, roun
 func (db *participationDB) GetForRound(id ParticipationID, round basics.Round) (ParticipationRecordForRound, error) {
 	var result ParticipationRecordForRound
 
+	if db.Locked() {
+		return ParticipationRecordForRound{}, ErrRegistryLocked
+	}
+
 	result.ParticipationRecord = db.Get(id)
 	if result.ParticipationRecord.IsZero() {
 		return ParticipationRecordForRound{}, ErrParticipationIDNotFound
@@ -848,19 +1042,35 @@ func (db *participationDB) GetForRound(id ParticipationID, round basics.Round) (
 	return result, nil
 }
 
-// updateRollingFields sets all of the rolling fields according to the record object.
-func updateRollingFields(ctx context.Context, tx *sql.Tx, record ParticipationRecord) error {
-	voting := record.Voting.Snapshot()
-	encodedVotingSecrets := protocol.Encode(&voting)
-
-	result, err := tx.ExecContext(ctx, updateRollingFieldsSQL,
-		record.LastVote,
-		record.LastBlockProposal,
-		record.LastStateProof,
-		record.EffectiveFirst,
-		record.EffectiveLast,
-		encodedVotingSecrets,
-		record.ParticipationID[:])
+// updateRollingFields sets all of the rolling fields according to the record object, sealing the voting
+// secrets with key. The voting secrets are left unchanged if the record has none, when the registry is locked.
+func updateRollingFields(ctx context.Context, tx *sql.Tx, record ParticipationRecord, key *secretsKey) error {
+	var result sql.Result
+	var err error
+	if record.Voting == nil {
+		result, err = tx.ExecContext(ctx, updateRollingFieldsNoVotingSQL,
+			record.LastVote,
+			record.LastBlockProposal,
+			record.LastStateProof,
+			record.EffectiveFirst,
+			record.EffectiveLast,
+			record.ParticipationID[:])
+	} else {
+		voting := record.Voting.Snapshot()
+		var encodedVotingSecrets []byte
+		encodedVotingSecrets, err = sealSecrets(key, protocol.Encode(&voting))
+		if err != nil {
+			return err
+		}
+		result, err = tx.ExecContext(ctx, updateRollingFieldsSQL,
+			record.LastVote,
+			record.LastBlockProposal,
+			record.LastStateProof,
+			record.EffectiveFirst,
+			record.EffectiveLast,
+			encodedVotingSecrets,
+			record.ParticipationID[:])
+	}
 
 	if err != nil {
 		return err
@@ -1015,6 +1225,36 @@ func (db *participationDB) Flush(timeout time.Duration) error {
 	}
 }
 
+func (db *participationDB) Locked() bool {
+	db.mutex.RLock()
+	defer db.mutex.RUnlock()
+	return db.locked
+}
+
+func (db *participationDB) Lock() error {
+	return db.applyAndWait(&lockOp{})
+}
+
+func (db *participationDB) Unlock(material []byte) error {
+	return db.applyAndWait(&unlockOp{material: material})
+}
+
+func (db *participationDB) Initialize(material []byte) error {
+	return db.applyAndWait(&unlockOp{material: material, initialize: true})
+}
+
+// applyAndWait applies the operation on the write thread, and returns its error.
+func (db *participationDB) applyAndWait(operation dbOp) error {
+	// Do not report the errors of earlier operations as errors of this one
+	if err := db.Flush(db.flushTimeout); err != nil {
+		db.log.Warnf("participationDB unhandled error before %T: %v", operation, err)
+	}
+
+	resultCh := make(chan error, 1)
+	db.writeQueue <- makeOpRequestWithError(operation, resultCh)
+	return <-resultCh
+}
+
 // Close attempts to flush with db.flushTimeout, then waits for the write queue for another db.flushTimeout.
 func (db *participationDB) Close() {
 	if err := db.Flush(db.flushTimeout); err != nil {
@@ -627,6 +627,7 @@ func TestParticipation_RecordMultipleUpdates_DB(t *testing.T) {
 				record.LastValid,
 				record.KeyDilution,
 				nil,
+				nil,
 				nil)
 			if err != nil {
 				return fmt.Errorf("unable to insert keyset: %w", err)
@@ -724,7 +725,7 @@ func TestParticipation_NoKeyToUpdate(t *testing.T) {
 			EffectiveFirst:  4,
 			EffectiveLast:   5,
 		}
-		err := updateRollingFields(ctx, tx, record)
+		err := updateRollingFields(ctx, tx, record, nil)
 		a.EqualError(err, ErrNoKeyForID.Error())
 		return nil
 	})
@@ -17,7 +17,9 @@
 package account
 
 import (
+	"bytes"
 	"context"
+	"crypto/rand"
 	"database/sql"
 	"errors"
 	"fmt"
@@ -60,6 +62,13 @@ type deleteStateProofKeysOp struct {
 	round           basics.Round
 }
 
+type unlockOp struct {
+	material   []byte
+	initialize bool
+}
+
+type lockOp struct{}
+
 func (d deleteStateProofKeysOp) apply(db *participationDB) error {
 	err := db.store.Wdb.Atomic(func(ctx context.Context, tx *sql.Tx) error {
 
@@ -103,7 +112,10 @@ func (r *registerOp) apply(db *participationDB) error {
 	err := db.store.Wdb.Atomic(func(ctx context.Context, tx *sql.Tx) error {
 		// Disable active key if there is one
 		for id, record := range r.updated {
-			err := updateRollingFields(ctx, tx, record.ParticipationRecord)
+			if db.writeLocked {
+				record.Voting = nil
+			}
+			err := updateRollingFields(ctx, tx, record.ParticipationRecord, db.writeKey)
 			// Repair the case when no keys were updated
 			if err == ErrNoKeyForID {
 				db.log.Warn("participationDB unable to update key in cache. Removing from cache.")
@@ -134,14 +146,22 @@ func (r *registerOp) apply(db *participationDB) error {
 func (i *insertOp) apply(db *participationDB) (err error) {
 	var rawVRF []byte
 	var rawVoting []byte
+	var rawVoteID []byte
 	var rawStateProofContext []byte
 
 	if i.record.VRF != nil {
-		rawVRF = protocol.Encode(i.record.VRF)
+		rawVRF, err = sealSecrets(db.writeKey, protocol.Encode(i.record.VRF))
+		if err != nil {
+			return err
+		}
 	}
 	if i.record.Voting != nil {
 		voting := i.record.Voting.Snapshot()
-		rawVoting = protocol.Encode(&voting)
+		rawVoting, err = sealSecrets(db.writeKey, protocol.Encode(&voting))
+		if err != nil {
+			return err
+		}
+		rawVoteID = voting.OneTimeSignatureVerifier[:]
 	}
 
 	// This contains all the state proof data except for the actual secret keys (stored in a different table)
@@ -158,7 +178,8 @@ func (i *insertOp) apply(db *participationDB) (err error) {
 			i.record.LastValid,
 			i.record.KeyDilution,
 			rawVRF,
-			rawStateProofContext)
+			rawStateProofContext,
+			rawVoteID)
 		if err2 = verifyExecWithOneRowEffected(err2, result, "insert keyset"); err2 != nil {
 			return err2
 		}
@@ -239,7 +260,10 @@ func (f *flushOp) apply(db *participationDB) error {
 	err := db.store.Wdb.Atomic(func(ctx context.Context, tx *sql.Tx) error {
 		var errorStr strings.Builder
 		for _, record := range needsUpdate {
-			err := updateRollingFields(ctx, tx, record)
+			if db.writeLocked {
+				record.Voting = nil
+			}
+			err := updateRollingFields(ctx, tx, record, db.writeKey)
 			// This should only be updating key usage so ignoring missing keys is not a problem.
 			if err != nil && err != ErrNoKeyForID {
 				if errorStr.Len() > 0 {
@@ -286,7 +310,11 @@ func (a *appendKeysOp) apply(db *participationDB) error {
 		}
 
 		for _, key := range a.keys {
-			result, err := stmt.Exec(pk, key.Round, protocol.Encode(key.Key))
+			rawKey, err := sealSecrets(db.writeKey, protocol.Encode(key.Key))
+			if err != nil {
+				return err
+			}
+			result, err := stmt.Exec(pk, key.Round, rawKey)
 			if err = verifyExecWithOneRowEffected(err, result, "append keys"); err != nil {
 				return err
 			}
@@ -296,3 +324,188 @@ func (a *appendKeysOp) apply(db *participationDB) error {
 	})
 	return err
 }
+
+// unlock derives the key of the registry from the key material, encrypting the secrets if initialize is
+// set and they are not encrypted yet, then reloads the secrets into the cache.
+func (u *unlockOp) apply(db *participationDB) error {
+	var key *secretsKey
+	var sealed bool
+	err := db.store.Wdb.Atomic(func(ctx context.Context, tx *sql.Tx) error {
+		var salt, verifier []byte
+		err := tx.QueryRow(selectEncryption).Scan(&salt, &verifier)
+		if err == nil {
+			key, err = deriveSecretsKey(u.material, salt)
+			if err != nil {
+				return err
+			}
+			plaintext, err := openSecrets(key, verifier)
+			if err != nil || !bytes.Equal(plaintext, secretsVerifierPlaintext) {
+				return ErrWrongKeyMaterial
+			}
+			return fillVoteIDs(tx, key)
+		}
+		if err != sql.ErrNoRows {
+			return fmt.Errorf("unable to scan encryption: %w", err)
+		}
+		if !u.initialize {
+			return ErrRegistryNotEncrypted
+		}
+
+		// Not encrypted yet: encrypt the secrets written so far, zeroing the plaintext they replace
+		_, err = tx.Exec(`PRAGMA secure_delete=ON`)
+		if err != nil {
+			return err
+		}
+		salt = make([]byte, secretsSaltLen)
+		_, err = rand.Read(salt)
+		if err != nil {
+			return err
+		}
+		key, err = deriveSecretsKey(u.material, salt)
+		if err != nil {
+			return err
+		}
+		verifier, err = sealSecrets(key, secretsVerifierPlaintext)
+		if err != nil {
+			return err
+		}
+		result, err := tx.Exec(insertEncryption, salt, verifier)
+		if err = verifyExecWithOneRowEffected(err, result, "insert encryption"); err != nil {
+			return err
+		}
+		err = sealColumn(tx, key, selectAllVRF, updateVRF, 1)
+		if err != nil {
+			return fmt.Errorf("unable to encrypt VRF: %w", err)
+		}
+		err = sealColumn(tx, key, selectAllVoting, updateVoting, 1)
+		if err != nil {
+			return fmt.Errorf("unable to encrypt voting: %w", err)
+		}
+		err = sealColumn(tx, key, selectAllStateProofKeys, updateStateProofKey, 2)
+		if err != nil {
+			return fmt.Errorf("unable to encrypt state proof keys: %w", err)
+		}
+		sealed = true
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+	if sealed {
+		// The secrets are encrypted, but may still be found in the clear in the free pages and the WAL
+		err = eraseFreedSecrets(db)
+		if err != nil {
+			db.log.Errorf("participationDB encrypted the secrets, but was unable to erase their plaintext: %v", err)
+		}
+	}
+
+	records, err := db.getAllFromDB(key)
+	if err != nil {
+		return err
+	}
+
+	db.writeKey = key
+	db.writeLocked = false
+	db.mutex.Lock()
+	defer db.mutex.Unlock()
+	for _, record := range records {
+		// keep the rolling fields of the cache, which may not be flushed yet
+		if cached, ok := db.cache[record.ParticipationID]; ok {
+			cached.VRF = record.VRF
+			cached.Voting = record.Voting
+			cached.VoteID = record.VoteID
+			db.cache[record.ParticipationID] = cached
+		}
+	}
+	db.key = key
+	db.locked = false
+	return nil
+}
+
+// lock flushes the cache, then forgets the key and the secrets of the registry.
+func (l *lockOp) apply(db *participationDB) error {
+	err := (&flushOp{}).apply(db)
+	if err != nil {
+		return err
+	}
+
+	db.writeKey = nil
+	db.writeLocked = true
+	db.mutex.Lock()
+	defer db.mutex.Unlock()
+	for id, record := range db.cache {
+		record.VRF = nil
+		record.Voting = nil
+		db.cache[id] = record
+	}
+	db.key = nil
+	db.locked = true
+	return nil
+}
+
+// eraseFreedSecrets rewrites the database without its free pages, and empties its WAL, so that no
+// secret replaced by its encryption is left in the files of the database.
+func eraseFreedSecrets(db *participationDB) error {
+	ctx := context.Background()
+	_, err := db.store.Wdb.Vacuum(ctx)
+	if err != nil {
+		return fmt.Errorf("unable to vacuum: %w", err)
+	}
+	var busy, walFrames, checkpointed int
+	err = db.store.Wdb.Handle.QueryRowContext(ctx, `PRAGMA wal_checkpoint(TRUNCATE)`).Scan(&busy, &walFrames, &checkpointed)
+	if err != nil {
+		return fmt.Errorf("unable to checkpoint: %w", err)
+	}
+	if busy != 0 {
+		return errors.New("unable to checkpoint: the database is busy")
+	}
+	return nil
+}
+
+// sealColumn encrypts the secrets of the rows selected by query which are not encrypted yet. The secrets
+// are the last column of the rows, after numKeys primary key columns which are passed to update after
+// the encrypted secrets.
+func sealColumn(tx *sql.Tx, key *secretsKey, query string, update string, numKeys int) error {
+	rows, err := tx.Query(query)
+	if err != nil {
+		return err
+	}
+	type row struct {
+		keys    []any
+		secrets []byte
+	}
+	var toSeal []row
+	for rows.Next() {
+		r := row{keys: make([]any, numKeys)}
+		dest := make([]any, 0, numKeys+1)
+		for i := range r.keys {
+			dest = append(dest, &r.keys[i])
+		}
+		dest = append(dest, &r.secrets)
+		err = rows.Scan(dest...)
+		if err != nil {
+			rows.Close()
+			return err
+		}
+		if len(r.secrets) != 0 && !isSealed(r.secrets) {
+			toSeal = append(toSeal, r)
+		}
+	}
+	err = rows.Err()
+	rows.Close()
+	if err != nil {
+		return err
+	}
+
+	for _, r := range toSeal {
+		sealed, err := sealSecrets(key, r.secrets)
+		if err != nil {
+			return err
+		}
+		result, err := tx.Exec(update, append([]any{sealed}, r.keys...)...)
+		if err = verifyExecWithOneRowEffected(err, result, "encrypt secrets"); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,95 @@
+// Copyright (C) 2019-2025 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package account
+
+import (
+	"github.com/algorand/go-algorand/crypto"
+	"github.com/algorand/go-algorand/data/basics"
+	"github.com/algorand/go-algorand/data/transactions"
+	"github.com/algorand/go-algorand/protocol"
+)
+
+// KeyRenewalAction is the next step of renewing the participation key of an account.
+type KeyRenewalAction int
+
+const (
+	// KeyRenewalNone means there is nothing to do: the account is not online with
+	// a key of this node, or its key does not expire soon, or its next key is not
+	// valid yet.
+	KeyRenewalNone KeyRenewalAction = iota
+	// KeyRenewalGenerate means the next key of the account must be generated.
+	KeyRenewalGenerate
+	// KeyRenewalRegister means the next key of the account is installed and must
+	// be registered online.
+	KeyRenewalRegister
+)
+
+// PlanKeyRenewal decides the next step of renewing the participation key of an account, given the
+// records installed for the account, the key the account is registered online with, and the number
+// of rounds before the expiration of that key when it must be renewed. For KeyRenewalRegister, the
+// record of the key to register is returned.
+func PlanKeyRenewal(records []ParticipationRecord, voteID crypto.OneTimeSignatureVerifier, voteLast basics.Round, current basics.Round, lead basics.Round) (KeyRenewalAction, ParticipationRecord) {
+	var registered, next ParticipationRecord
+	for _, record := range records {
+		if record.Voting != nil && record.Voting.OneTimeSignatureVerifier == voteID {
+			registered = record
+		}
+	}
+	if registered.IsZero() || voteLast > current+lead {
+		return KeyRenewalNone, ParticipationRecord{}
+	}
+
+	for _, record := range records {
+		if record.LastValid > registered.LastValid && record.LastValid > next.LastValid {
+			next = record
+		}
+	}
+	switch {
+	case next.IsZero():
+		return KeyRenewalGenerate, ParticipationRecord{}
+	case next.FirstValid > current+1 || next.LastValid <= current:
+		// A keyreg would be rejected, see ledger/apply/keyreg.go
+		return KeyRenewalNone, ParticipationRecord{}
+	default:
+		return KeyRenewalRegister, next
+	}
+}
+
+// GenerateRegistrationTransaction returns a transaction object for registering the keys of the
+// record, like Participation.GenerateRegistrationTransaction.
+func (r ParticipationRecord) GenerateRegistrationTransaction(fee basics.MicroAlgos, txnFirstValid, txnLastValid basics.Round, includeStateProofKeys bool) transactions.Transaction {
+	t := transactions.Transaction{
+		Type: protocol.KeyRegistrationTx,
+		Header: transactions.Header{
+			Sender:     r.Account,
+			Fee:        fee,
+			FirstValid: txnFirstValid,
+			LastValid:  txnLastValid,
+		},
+		KeyregTxnFields: transactions.KeyregTxnFields{
+			VotePK:          r.Voting.OneTimeSignatureVerifier,
+			SelectionPK:     r.VRF.PK,
+			VoteFirst:       r.FirstValid,
+			VoteLast:        r.LastValid,
+			VoteKeyDilution: r.KeyDilution,
+		},
+	}
+	if r.StateProof != nil && includeStateProofKeys {
+		t.KeyregTxnFields.StateProofPK = r.StateProof.Commitment
+	}
+	return t
+}
@@ -0,0 +1,75 @@
+// Copyright (C) 2019-2025 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package account
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/algorand/go-algorand/crypto"
+	"github.com/algorand/go-algorand/data/basics"
+	"github.com/algorand/go-algorand/protocol"
+	"github.com/algorand/go-algorand/test/partitiontest"
+)
+
+func TestPlanKeyRenewal(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	a := require.New(t)
+	registry, dbfile := getRegistry(t)
+	defer registryCloseTest(t, registry, dbfile)
+
+	current := makeTestParticipation(a, 1, 1, 1000, 10)
+	_, err := registry.Insert(current)
+	a.NoError(err)
+	records := registry.GetAll()
+	voteID := current.Voting.OneTimeSignatureVerifier
+
+	// Far from the expiration of the registered key
+	action, _ := PlanKeyRenewal(records, voteID, 1000, 500, 100)
+	a.Equal(KeyRenewalNone, action)
+
+	// Not registered with a key of this node
+	action, _ = PlanKeyRenewal(records, crypto.OneTimeSignatureVerifier{1}, 1000, 950, 100)
+	a.Equal(KeyRenewalNone, action)
+
+	// Close to the expiration, without a next key
+	action, _ = PlanKeyRenewal(records, voteID, 1000, 950, 100)
+	a.Equal(KeyRenewalGenerate, action)
+
+	// The next key is installed, but not valid yet
+	next := makeTestParticipation(a, 1, 960, 2000, 10)
+	_, err = registry.Insert(next)
+	a.NoError(err)
+	records = registry.GetAll()
+	action, _ = PlanKeyRenewal(records, voteID, 1000, 950, 100)
+	a.Equal(KeyRenewalNone, action)
+
+	// It is registered as soon as it is valid
+	action, record := PlanKeyRenewal(records, voteID, 1000, 959, 100)
+	a.Equal(KeyRenewalRegister, action)
+	a.Equal(next.ID(), record.ParticipationID)
+
+	// Once registered, there is nothing left to do
+	action, _ = PlanKeyRenewal(records, next.Voting.OneTimeSignatureVerifier, 2000, 970, 100)
+	a.Equal(KeyRenewalNone, action)
+
+	tx := record.GenerateRegistrationTransaction(basics.MicroAlgos{Raw: 1000}, 959, 1059, true)
+	expected := next.GenerateRegistrationTransaction(basics.MicroAlgos{Raw: 1000}, 959, 1059, [32]byte{}, true)
+	a.Equal(protocol.KeyRegistrationTx, tx.Type)
+	a.Equal(expected, tx)
+}
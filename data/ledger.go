@@ -240,6 +240,15 @@ func (l *Ledger) Seed(r basics.Round) (committee.Seed, error) {
 	return blockhdr.Seed, nil
 }
 
+// Rollback rewinds the ledger to the given round, see ledger.Ledger.Rollback
+func (l *Ledger) Rollback(rnd basics.Round) error {
+	err := l.Ledger.Rollback(rnd)
+	// the caches might hold the values of discarded rounds, which could be assembled differently next time
+	l.lastRoundCirculation.Store(roundCirculation{})
+	l.lastRoundSeed.Store(roundSeed{})
+	return err
+}
+
 // LookupDigest gives the block hash that was agreed on in a given round,
 // returning an error if we don't have that round or we have an
 // I/O error.
@@ -26,6 +26,7 @@ import (
 	"github.com/algorand/go-deadlock"
 
 	"github.com/algorand/go-algorand/config"
+	"github.com/algorand/go-algorand/crypto"
 	"github.com/algorand/go-algorand/data/basics"
 	"github.com/algorand/go-algorand/data/bookkeeping"
 	"github.com/algorand/go-algorand/data/transactions"
@@ -242,6 +243,38 @@ func (pool *TransactionPool) PendingTxGroups() [][]transactions.SignedTxn {
 	return pool.pendingTxGroups
 }
 
+// PendingTxGroupsOf returns the pending transaction groups containing any of the given transactions,
+// each group once. Transactions which are not pending are skipped.
+func (pool *TransactionPool) PendingTxGroupsOf(txids []transactions.Txid) [][]transactions.SignedTxn {
+	pool.pendingMu.RLock()
+	defer pool.pendingMu.RUnlock()
+
+	var txgroups [][]transactions.SignedTxn
+	groupIDs := make(map[crypto.Digest]bool)
+	for _, txid := range txids {
+		txn, ok := pool.pendingTxids[txid]
+		if !ok {
+			continue
+		}
+		if txn.Txn.Group.IsZero() {
+			txgroups = append(txgroups, []transactions.SignedTxn{txn})
+			continue
+		}
+		groupIDs[txn.Txn.Group] = true
+	}
+	// grouped transactions all carry the id of their group, which is looked up in the pending groups
+	for _, txgroup := range pool.pendingTxGroups {
+		if len(groupIDs) == 0 {
+			break
+		}
+		if group := txgroup[0].Txn.Group; !group.IsZero() && groupIDs[group] {
+			txgroups = append(txgroups, txgroup)
+			delete(groupIDs, group)
+		}
+	}
+	return txgroups
+}
+
 // pendingTxIDsCount returns the number of pending transaction ids that are still waiting
 // in the transaction pool. This is identical to the number of transaction ids that would
 // be retrieved by a call to PendingTxIDs()
@@ -587,6 +587,62 @@ func TestRememberForget(t *testing.T) {
 	require.Len(t, pending, 0)
 }
 
+func TestPendingTxGroupsOf(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	numOfAccounts := 3
+	secrets := make([]*crypto.SignatureSecrets, numOfAccounts)
+	addresses := make([]basics.Address, numOfAccounts)
+	for i := 0; i < numOfAccounts; i++ {
+		secret := keypair()
+		secrets[i] = secret
+		addresses[i] = basics.Address(secret.SignatureVerifier)
+	}
+
+	mockLedger := makeMockLedger(t, initAccFixed(addresses, 1<<32))
+	cfg := config.GetDefaultLocal()
+	cfg.TxPoolSize = testPoolSize
+	cfg.EnableProcessBlockStats = false
+	transactionPool := MakeTransactionPool(mockLedger, cfg, logging.Base(), nil)
+
+	makeTxn := func(sender int, note byte) transactions.Transaction {
+		return transactions.Transaction{
+			Type: protocol.PaymentTx,
+			Header: transactions.Header{
+				Sender:      addresses[sender],
+				Fee:         basics.MicroAlgos{Raw: proto.MinTxnFee},
+				FirstValid:  0,
+				LastValid:   basics.Round(proto.MaxTxnLife),
+				Note:        []byte{note},
+				GenesisHash: mockLedger.GenesisHash(),
+			},
+			PaymentTxnFields: transactions.PaymentTxnFields{
+				Receiver: addresses[(sender+1)%numOfAccounts],
+				Amount:   basics.MicroAlgos{Raw: 1},
+			},
+		}
+	}
+
+	single := makeTxn(0, 1).Sign(secrets[0])
+	require.NoError(t, transactionPool.RememberOne(single))
+
+	txn1, txn2 := makeTxn(1, 2), makeTxn(2, 3)
+	var group transactions.TxGroup
+	group.TxGroupHashes = []crypto.Digest{crypto.Digest(txn1.ID()), crypto.Digest(txn2.ID())}
+	txn1.Group = crypto.HashObj(group)
+	txn2.Group = txn1.Group
+	txgroup := []transactions.SignedTxn{txn1.Sign(secrets[1]), txn2.Sign(secrets[2])}
+	require.NoError(t, transactionPool.Remember(txgroup))
+	require.Len(t, transactionPool.PendingTxIDs(), 3)
+
+	require.Empty(t, transactionPool.PendingTxGroupsOf(nil))
+	require.Empty(t, transactionPool.PendingTxGroupsOf([]transactions.Txid{makeTxn(0, 4).ID()}))
+	require.Equal(t, [][]transactions.SignedTxn{{single}}, transactionPool.PendingTxGroupsOf([]transactions.Txid{single.ID()}))
+	// the group is returned once, even when several of its transactions are requested
+	require.Equal(t, [][]transactions.SignedTxn{txgroup}, transactionPool.PendingTxGroupsOf([]transactions.Txid{txgroup[1].ID(), txgroup[0].ID()}))
+	require.ElementsMatch(t, [][]transactions.SignedTxn{{single}, txgroup}, transactionPool.PendingTxGroupsOf(transactionPool.PendingTxIDs()))
+}
+
 // Test that clean up works
 func TestCleanUp(t *testing.T) {
 	partitiontest.PartitionTest(t)
@@ -25,6 +25,9 @@ import (
 	"sync"
 	"time"
 
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+
 	"github.com/algorand/go-algorand/config"
 	"github.com/algorand/go-algorand/crypto"
 	"github.com/algorand/go-algorand/data/bookkeeping"
@@ -38,6 +41,7 @@ import (
 	"github.com/algorand/go-algorand/util"
 	"github.com/algorand/go-algorand/util/execpool"
 	"github.com/algorand/go-algorand/util/metrics"
+	"github.com/algorand/go-algorand/util/tracing"
 	"github.com/algorand/go-deadlock"
 )
 
@@ -110,6 +114,24 @@ type txBacklogMsg struct {
 	verificationErr       error                         // The verification error generated by the verification function, if any.
 	capguard              *util.ErlCapacityGuard        // the structure returned from the elastic rate limiter, to be released when dequeued
 	syncCh                chan network.ForwardingPolicy // channel to signal the synchronous mode and its ops completion
+	span                  trace.Span                    // the tracing span of the processing of the group, from its dequeuing from the backlog
+}
+
+// startSpan starts the tracing span of the processing of the group, child of the span of the reception of its message.
+func (wi *txBacklogMsg) startSpan() {
+	if !tracing.Enabled() {
+		return
+	}
+	_, wi.span = tracing.StartWithParent(wi.rawmsg.SpanContext, "txhandler.process", attribute.Int("algorand.group_size", len(wi.unverifiedTxGroup)))
+}
+
+// endSpan records the outcome of the processing of the group and ends its span, if any.
+func (wi *txBacklogMsg) endSpan(outcome string) {
+	if wi.span == nil {
+		return
+	}
+	wi.span.SetAttributes(attribute.String("algorand.outcome", outcome))
+	wi.span.End()
 }
 
 // TxHandler handles transaction messages
@@ -348,7 +370,9 @@ func (handler *TxHandler) backlogWorker() {
 					logging.Base().Warnf("Failed to release capacity to ElasticRateLimiter: %v", err)
 				}
 			}
+			wi.startSpan()
 			if handler.checkAlreadyCommitted(wi) {
+				wi.endSpan("rejected")
 				transactionMessagesAlreadyCommitted.Inc(nil)
 				if wi.capguard != nil {
 					wi.capguard.Served()
@@ -363,6 +387,7 @@ func (handler *TxHandler) backlogWorker() {
 			select {
 			case handler.streamVerifierChan <- &verify.UnverifiedTxnSigJob{TxnGroup: wi.unverifiedTxGroup, BacklogMessage: wi}:
 			case <-handler.ctx.Done():
+				wi.endSpan("dropped")
 				transactionMessagesDroppedFromBacklog.Inc(nil)
 				// if in synchronous mode, signal the completion of the operation
 				if wi.syncCh != nil {
@@ -518,9 +543,13 @@ func (handler *TxHandler) rememberReportErrors(err error) {
 }
 
 func (handler *TxHandler) postProcessCheckedTxn(wi *txBacklogMsg) {
+	outcome := "relayed"
+	defer func() { wi.endSpan(outcome) }()
 	if wi.verificationErr != nil {
+		outcome = "invalid"
 		// disconnect from peer.
 		handler.postProcessReportErrors(wi.verificationErr)
+		handler.net.ReportPeerEvent(wi.rawmsg.Sender, network.PeerEventInvalidTxn)
 		logging.Base().Warnf("Received a malformed tx group %v: %v", wi.unverifiedTxGroup, wi.verificationErr)
 		// if in synchronous mode, signal the completion of the operation
 		if wi.syncCh != nil {
@@ -540,6 +569,7 @@ func (handler *TxHandler) postProcessCheckedTxn(wi *txBacklogMsg) {
 	// save the transaction, if it has high enough fee and not already in the cache
 	err := handler.txPool.Remember(verifiedTxGroup)
 	if err != nil {
+		outcome = "not_remembered"
 		handler.rememberReportErrors(err)
 		logging.Base().Debugf("could not remember tx: %v", err)
 		// if in synchronous mode, signal the completion of the operation
@@ -558,12 +588,18 @@ func (handler *TxHandler) postProcessCheckedTxn(wi *txBacklogMsg) {
 	}
 	// if in synchronous mode, signal the completion of the operation
 	if wi.syncCh != nil {
+		outcome = "accepted"
 		wi.syncCh <- network.Accept
 		return
 	}
 
-	// We reencode here instead of using rawmsg.Data to avoid broadcasting non-canonical encodings
-	handler.net.Relay(handler.ctx, protocol.TxnTag, reencode(verifiedTxGroup), false, wi.rawmsg.Sender)
+	// We reencode here instead of using rawmsg.Data to avoid broadcasting non-canonical encodings.
+	// The span of the group is the parent of the spans of its relay on the peers propagating the trace.
+	ctx := handler.ctx
+	if wi.span != nil {
+		ctx = trace.ContextWithSpan(ctx, wi.span)
+	}
+	handler.net.Relay(ctx, protocol.TxnTag, reencode(verifiedTxGroup), false, wi.rawmsg.Sender)
 }
 
 func (handler *TxHandler) deleteFromCaches(msgKey crypto.Digest, canonicalKey crypto.Digest) {
@@ -20,6 +20,8 @@ import (
 	"context"
 	"sync"
 
+	"github.com/algorand/go-deadlock"
+
 	"github.com/algorand/go-algorand/config"
 	"github.com/algorand/go-algorand/data/account"
 	"github.com/algorand/go-algorand/data/basics"
@@ -41,6 +43,11 @@ type Service struct {
 	// where to send the heartbeats
 	bcast txnBroadcaster
 
+	// sent holds the last valid round of the last heartbeat sent for each
+	// account, so that no other is sent before it expires
+	mu   deadlock.Mutex
+	sent map[basics.Address]basics.Round
+
 	// infrastructure
 	ctx      context.Context
 	shutdown context.CancelFunc
@@ -55,6 +62,7 @@ func NewService(accts participants, ledger ledger, bcast txnBroadcaster, log log
 		accts:  accts,
 		ledger: ledger,
 		bcast:  bcast,
+		sent:   make(map[basics.Address]basics.Round),
 		log:    log.With("Context", "heartbeat"),
 	}
 }
@@ -75,6 +83,14 @@ func (s *Service) Stop() {
 	s.wg.Wait()
 }
 
+// PendingHeartbeat tells whether the last heartbeat sent for addr is still
+// valid in round rnd.
+func (s *Service) PendingHeartbeat(addr basics.Address, rnd basics.Round) bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.sent[addr] >= rnd
+}
+
 // findChallenged() returns a list of accounts that need a heartbeat because
 // they have been challenged.
 func (s *Service) findChallenged(rules config.ProposerPayoutRules, current basics.Round) []account.ParticipationRecordForRound {
@@ -123,7 +139,6 @@ func (s *Service) findChallenged(rules config.ProposerPayoutRules, current basic
 // designed to be extremely unlikely anyway.)
 func (s *Service) loop() {
 	defer s.wg.Done()
-	suppress := make(map[basics.Address]basics.Round)
 	latest := s.ledger.LastRound()
 	for {
 		// exit if Done, else wait for next round
@@ -143,7 +158,10 @@ func (s *Service) loop() {
 		proto := config.Consensus[lastHdr.CurrentProtocol]
 
 		for _, pr := range s.findChallenged(proto.Payouts, latest) {
-			if suppress[pr.Account] > latest {
+			s.mu.Lock()
+			suppressed := s.sent[pr.Account] > latest
+			s.mu.Unlock()
+			if suppressed {
 				continue
 			}
 			stxn := s.prepareHeartbeat(pr, lastHdr)
@@ -155,7 +173,9 @@ func (s *Service) loop() {
 				// Don't bother heartbeating again until the last one expires.
 				// If it is accepted, we won't need to (because we won't be
 				// under challenge any more).
-				suppress[pr.Account] = stxn.Txn.LastValid
+				s.mu.Lock()
+				s.sent[pr.Account] = stxn.Txn.LastValid
+				s.mu.Unlock()
 			}
 		}
 	}
@@ -111,6 +111,23 @@ func (bq *blockQueue) stop() {
 	}
 }
 
+// forgetAfter discards the queued and the stored blocks past the given round.
+// It must only be called while the block queue is stopped.
+func (bq *blockQueue) forgetAfter(rnd basics.Round) error {
+	bq.mu.Lock()
+	defer bq.mu.Unlock()
+
+	for i, e := range bq.q {
+		if e.block.Round() > rnd {
+			bq.q = bq.q[:i]
+			break
+		}
+	}
+	return bq.l.blockDBs.Wdb.Atomic(func(ctx context.Context, tx *sql.Tx) error {
+		return blockdb.BlockForgetAfter(tx, rnd)
+	})
+}
+
 const maxDeletionBatchSize = 10_000
 
 func (bq *blockQueue) syncer() {
@@ -30,6 +30,7 @@ import (
 	"os"
 	"path/filepath"
 	"strconv"
+	"strings"
 	"sync/atomic"
 	"time"
 
@@ -77,8 +78,16 @@ const (
 	catchpointBalancesFileNameTemplate = "balances.%d.msgpack"
 	catchpointBalancesFileNamePrefix   = "balances."
 	catchpointBalancesFileNameSuffix   = ".msgpack"
+
+	// CatchpointDownloadDirName is the name of the directory where the catchpoint file chunks are persisted during fast catchup
+	CatchpointDownloadDirName = "catchpointdownload"
 )
 
+// IsCatchpointBalancesFileName returns true if the given name is the name of a balances chunk within a catchpoint file
+func IsCatchpointBalancesFileName(name string) bool {
+	return strings.HasPrefix(name, catchpointBalancesFileNamePrefix) && strings.HasSuffix(name, catchpointBalancesFileNameSuffix)
+}
+
 func catchpointStage1Encoder(w io.Writer) (io.WriteCloser, error) {
 	return snappy.NewBufferedWriter(w), nil
 }
@@ -22,7 +22,7 @@ import (
 	"encoding/hex"
 	"errors"
 	"fmt"
-	"strings"
+	"path/filepath"
 	"sync"
 	"time"
 
@@ -96,6 +96,10 @@ type CatchpointCatchupAccessor interface {
 
 	// Ledger returns a narrow subset of Ledger methods needed by CatchpointCatchupAccessor clients
 	Ledger() (l CatchupAccessorClientLedger)
+
+	// DownloadDir returns the directory where the catchpoint file chunks are persisted while being downloaded.
+	// An empty string is returned if the ledger has no such directory.
+	DownloadDir() string
 }
 
 type stagingWriter interface {
@@ -403,7 +407,7 @@ func (c *catchpointCatchupAccessorImpl) ProcessStagingBalances(ctx context.Conte
 	if sectionName == catchpointSPVerificationFileName {
 		return c.processStagingStateProofVerificationContext(bytes)
 	}
-	if strings.HasPrefix(sectionName, catchpointBalancesFileNamePrefix) && strings.HasSuffix(sectionName, catchpointBalancesFileNameSuffix) {
+	if IsCatchpointBalancesFileName(sectionName) {
 		return c.processStagingBalances(ctx, bytes, progress)
 	}
 	// we want to allow undefined sections to support backward compatibility.
@@ -1414,3 +1418,11 @@ var ledgerCatchpointEnsureblock1Count = metrics.NewCounter("ledger_catchup_catch
 var ledgerCatchpointEnsureblock1Micros = metrics.NewCounter("ledger_catchup_catchpoint_ensureblock1_micros", "µs spent")
 var ledgerCatchpointFinishBalsCount = metrics.NewCounter("ledger_catchup_catchpoint_finish_bals_count", "calls")
 var ledgerCatchpointFinishBalsMicros = metrics.NewCounter("ledger_catchup_catchpoint_finish_bals_micros", "µs spent")
+
+// DownloadDir returns the directory where the catchpoint file chunks are persisted while being downloaded.
+func (c *catchpointCatchupAccessorImpl) DownloadDir() string {
+	if c.ledger.catchpoint.tmpDir == "" {
+		return ""
+	}
+	return filepath.Join(c.ledger.catchpoint.tmpDir, CatchpointDownloadDirName)
+}
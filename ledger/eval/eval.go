@@ -23,6 +23,8 @@ import (
 	"math"
 	"sync"
 
+	"go.opentelemetry.io/otel/attribute"
+
 	"github.com/algorand/go-algorand/agreement"
 	"github.com/algorand/go-algorand/config"
 	"github.com/algorand/go-algorand/crypto"
@@ -38,6 +40,7 @@ import (
 	"github.com/algorand/go-algorand/protocol"
 	"github.com/algorand/go-algorand/util"
 	"github.com/algorand/go-algorand/util/execpool"
+	"github.com/algorand/go-algorand/util/tracing"
 )
 
 // LedgerForCowBase represents subset of Ledger functionality needed for cow business
@@ -1774,19 +1777,33 @@ func isAbsent(totalOnlineStake basics.MicroAlgos, acctStake basics.MicroAlgos, l
 	// Don't consider accounts that were online when payouts went into effect as
 	// absent.  They get noticed the next time they propose or keyreg, which
 	// ought to be soon, if they are high stake or want to earn incentives.
-	if lastSeen == 0 || acctStake.Raw == 0 {
+	if lastSeen == 0 {
+		return false
+	}
+	allowableLag, ok := AllowableAbsence(totalOnlineStake, acctStake)
+	if !ok {
 		return false
 	}
 	// See if the account has exceeded their expected observation interval.
+	return lastSeen+allowableLag < current
+}
+
+// AllowableAbsence returns the number of rounds an online account with stake
+// acctStake may go without proposing or heartbeating before it is considered
+// absent, its expected observation interval scaled by absentFactor. It returns
+// false if the account cannot be absent.
+func AllowableAbsence(totalOnlineStake basics.MicroAlgos, acctStake basics.MicroAlgos) (basics.Round, bool) {
+	if acctStake.Raw == 0 {
+		return 0, false
+	}
 	allowableLag, o := basics.Muldiv(absentFactor, totalOnlineStake.Raw, acctStake.Raw)
 	// just return false for overflow or a huge allowableLag. It implies the lag
 	// is longer that any network could be around, and computing with wraparound
 	// is annoying.
 	if o || allowableLag > math.MaxUint32 {
-		return false
+		return 0, false
 	}
-
-	return lastSeen+basics.Round(allowableLag) < current
+	return basics.Round(allowableLag), true
 }
 
 // validateExpiredOnlineAccounts tests the expired online accounts specified in ExpiredParticipationAccounts, and verify
@@ -2057,7 +2074,13 @@ func (validator *evalTxValidator) run() {
 // Validate: Eval(ctx, l, blk, true, txcache, executionPool)
 // AddBlock: Eval(context.Background(), l, blk, false, txcache, nil)
 // tracker:  Eval(context.Background(), l, blk, false, txcache, nil)
-func Eval(ctx context.Context, l LedgerForEvaluator, blk bookkeeping.Block, validate bool, txcache verify.VerifiedTransactionCache, executionPool execpool.BacklogPool, tracer logic.EvalTracer) (ledgercore.StateDelta, error) {
+func Eval(ctx context.Context, l LedgerForEvaluator, blk bookkeeping.Block, validate bool, txcache verify.VerifiedTransactionCache, executionPool execpool.BacklogPool, tracer logic.EvalTracer) (_ ledgercore.StateDelta, err error) {
+	ctx, span := tracing.Start(ctx, "ledger.eval",
+		attribute.Int64("algorand.round", int64(blk.Round())),
+		attribute.Int("algorand.txns", len(blk.Payset)),
+		attribute.Bool("algorand.validate", validate))
+	defer func() { tracing.End(span, err) }()
+
 	// flush the pending writes in the cache to make everything read so far available during eval
 	l.FlushCaches()
 
@@ -24,6 +24,7 @@ import (
 	"time"
 
 	"github.com/algorand/go-deadlock"
+	"go.opentelemetry.io/otel/attribute"
 
 	"github.com/algorand/go-algorand/agreement"
 	"github.com/algorand/go-algorand/config"
@@ -45,6 +46,7 @@ import (
 	"github.com/algorand/go-algorand/util/db"
 	"github.com/algorand/go-algorand/util/execpool"
 	"github.com/algorand/go-algorand/util/metrics"
+	"github.com/algorand/go-algorand/util/tracing"
 )
 
 // Ledger is a database storing the contents of the ledger.
@@ -199,6 +201,13 @@ func OpenLedger[T string | DirsAndPrefix](
 }
 
 func (l *Ledger) reloadLedger() error {
+	return l.reloadLedgerWithRewind(nil)
+}
+
+// reloadLedgerWithRewind reloads the ledger, calling rewind once both the block queue and the trackers
+// are stopped, and before the trackers are reloaded from the databases. An error returned by rewind is
+// returned only after the ledger was successfully reloaded, so that the ledger remains usable either way.
+func (l *Ledger) reloadLedgerWithRewind(rewind func() error) error {
 	// similar to the Close function, we want to start by closing the blockQ first. The
 	// blockQ is having a sync goroutine which indirectly calls other trackers. We want to eliminate that go-routine first,
 	// and follow up by taking the trackers lock.
@@ -220,6 +229,11 @@ func (l *Ledger) reloadLedger() error {
 		l.trackers.close()
 	}
 
+	var rewindErr error
+	if rewind != nil {
+		rewindErr = rewind()
+	}
+
 	// init block queue
 	var err error
 	err = l.blockQ.start()
@@ -279,7 +293,7 @@ func (l *Ledger) reloadLedger() error {
 	if err != nil {
 		return err
 	}
-	return nil
+	return rewindErr
 }
 
 // verifyMatchingGenesisHash tests to see that the latest block header pointing to the same genesis hash provided in genesisHash.
@@ -814,7 +828,10 @@ func (l *Ledger) AddBlock(blk bookkeeping.Block, cert agreement.Certificate) err
 // having to re-compute the effect of the block on the ledger state, if
 // the block has previously been validated.  Otherwise, AddValidatedBlock
 // behaves like AddBlock.
-func (l *Ledger) AddValidatedBlock(vb ledgercore.ValidatedBlock, cert agreement.Certificate) error {
+func (l *Ledger) AddValidatedBlock(vb ledgercore.ValidatedBlock, cert agreement.Certificate) (err error) {
+	_, span := tracing.Start(context.Background(), "ledger.add_block", attribute.Int64("algorand.round", int64(vb.Block().Round())))
+	defer func() { tracing.End(span, err) }()
+
 	// Grab the tracker lock first, to ensure newBlock() is notified before committedUpTo().
 	t0 := time.Now()
 	l.trackerMu.Lock()
@@ -825,7 +842,7 @@ func (l *Ledger) AddValidatedBlock(vb ledgercore.ValidatedBlock, cert agreement.
 	}()
 
 	blk := vb.Block()
-	err := l.blockQ.putBlock(blk, cert)
+	err = l.blockQ.putBlock(blk, cert)
 	if err != nil {
 		return err
 	}
@@ -0,0 +1,69 @@
+// Copyright (C) 2019-2025 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package ledger
+
+import (
+	"fmt"
+
+	"github.com/algorand/go-algorand/data/basics"
+)
+
+// RollbackRoundError is returned when the ledger cannot be rolled back to the requested round
+type RollbackRoundError struct {
+	Round   basics.Round
+	Latest  basics.Round
+	DbRound basics.Round
+}
+
+// Error satisfies builtin interface `error`
+func (e *RollbackRoundError) Error() string {
+	if e.Round > e.Latest {
+		return fmt.Sprintf("cannot roll back to round %d: latest round is %d", e.Round, e.Latest)
+	}
+	return fmt.Sprintf("cannot roll back to round %d: rounds up to %d are already committed to the tracker database; consider increasing MaxAcctLookback", e.Round, e.DbRound)
+}
+
+// Rollback rewinds the ledger to the given round, discarding all the blocks past it along with their state changes.
+// Only the rounds which were not yet committed to the tracker database can be rolled back; these are the last
+// MaxAcctLookback rounds, give or take the commit scheduling. The trackers ( accounts, online accounts, transaction
+// tail, catchpoints and state proof verification ) are reloaded from the tracker database and replay the remaining
+// blocks, which makes them consistent with the truncated block database.
+//
+// Rollback is meant to be used on development networks only: the rest of the node ( transaction pool, agreement )
+// is not aware of the rewind, and is expected to be reset by the caller.
+func (l *Ledger) Rollback(rnd basics.Round) error {
+	latest := l.Latest()
+	if rnd == latest {
+		return nil
+	}
+	if rnd > latest {
+		return &RollbackRoundError{Round: rnd, Latest: latest}
+	}
+	if dbRound := l.trackers.getDbRound(); rnd < dbRound {
+		return &RollbackRoundError{Round: rnd, Latest: latest, DbRound: dbRound}
+	}
+
+	l.log.Infof("rolling back the ledger from round %d to round %d", latest, rnd)
+	return l.reloadLedgerWithRewind(func() error {
+		// a commit might have completed since the check above; now that the trackers are closed the
+		// tracker database round can no longer change.
+		if dbRound := l.trackers.getDbRound(); rnd < dbRound {
+			return &RollbackRoundError{Round: rnd, Latest: latest, DbRound: dbRound}
+		}
+		return l.blockQ.forgetAfter(rnd)
+	})
+}
@@ -0,0 +1,95 @@
+// Copyright (C) 2019-2025 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package ledger
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/algorand/go-algorand/config"
+	"github.com/algorand/go-algorand/data/txntest"
+	ledgertesting "github.com/algorand/go-algorand/ledger/testing"
+	"github.com/algorand/go-algorand/protocol"
+	"github.com/algorand/go-algorand/test/partitiontest"
+)
+
+func TestLedgerRollback(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	genBalances, addrs, _ := ledgertesting.NewTestGenesis()
+	cfg := config.GetDefaultLocal()
+	cfg.MaxAcctLookback = 16
+	l := newSimpleLedgerWithConsensusVersion(t, genBalances, protocol.ConsensusFuture, cfg, simpleLedgerOnDisk())
+	defer l.Close()
+
+	pay := txntest.Txn{
+		Type:     "pay",
+		Sender:   addrs[0],
+		Receiver: addrs[1],
+		Amount:   1000,
+	}
+
+	eval := nextBlock(t, l)
+	txn(t, l, eval, &pay)
+	endBlock(t, l, eval)
+	target := l.Latest()
+	expected := micros(t, l, addrs[1])
+
+	discarded := pay
+	discarded.Note = []byte("discarded")
+	eval = nextBlock(t, l)
+	txn(t, l, eval, &discarded)
+	endBlock(t, l, eval)
+	for i := 0; i < 3; i++ {
+		eval = nextBlock(t, l)
+		endBlock(t, l, eval)
+	}
+	require.Equal(t, expected+1000, micros(t, l, addrs[1]))
+
+	require.NoError(t, l.Rollback(target))
+	require.Equal(t, target, l.Latest())
+	require.Equal(t, expected, micros(t, l, addrs[1]))
+	_, err := l.Block(target + 1)
+	require.Error(t, err)
+
+	// the discarded transaction is no longer known to the transaction tail, and can be included again
+	eval = nextBlock(t, l)
+	txn(t, l, eval, &discarded)
+	endBlock(t, l, eval)
+	require.Equal(t, target+1, l.Latest())
+	require.Equal(t, expected+1000, micros(t, l, addrs[1]))
+
+	// rolling forward is not possible
+	var rollbackErr *RollbackRoundError
+	require.ErrorAs(t, l.Rollback(l.Latest()+1), &rollbackErr)
+
+	// rounds committed to the tracker database cannot be rolled back
+	for i := 0; i < int(cfg.MaxAcctLookback)*2; i++ {
+		eval = nextBlock(t, l)
+		endBlock(t, l, eval)
+	}
+	triggerTrackerFlush(t, l)
+	dbRound := l.trackers.getDbRound()
+	require.Greater(t, dbRound, target)
+	require.ErrorAs(t, l.Rollback(target), &rollbackErr)
+	require.Equal(t, dbRound, rollbackErr.DbRound)
+
+	require.NoError(t, l.Rollback(dbRound))
+	require.Equal(t, dbRound, l.Latest())
+}
@@ -0,0 +1,270 @@
+// Copyright (C) 2019-2025 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package ledger
+
+import (
+	"archive/tar"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+
+	"github.com/algorand/go-algorand/config"
+	"github.com/algorand/go-algorand/crypto"
+	"github.com/algorand/go-algorand/data/basics"
+)
+
+const (
+	// SnapshotManifestFilename is the name of the first entry of a snapshot archive, describing the rest of it
+	SnapshotManifestFilename = "snapshot.json"
+
+	// SnapshotTrackerDBFilename is the name of the tracker database within a snapshot archive
+	SnapshotTrackerDBFilename = config.LedgerFilenamePrefix + ".tracker.sqlite"
+
+	// SnapshotBlockDBFilename is the name of the block database within a snapshot archive
+	SnapshotBlockDBFilename = config.LedgerFilenamePrefix + ".block.sqlite"
+
+	// maxSnapshotManifestSize is the maximum size of the manifest entry we would be willing to read
+	maxSnapshotManifestSize = 1 << 20
+)
+
+var errSnapshotManifestMissing = errors.New("snapshot archive does not start with a manifest")
+
+// SnapshotFile describes a single database file within a ledger snapshot
+//
+//msgp:ignore SnapshotFile
+type SnapshotFile struct {
+	Name   string        `json:"name"`
+	Size   uint64        `json:"size"`
+	Digest crypto.Digest `json:"sha512_256"`
+}
+
+// SnapshotManifest describes a ledger snapshot: the network it belongs to, the round at which
+// the tracker database was captured, and the checksums of the included database files.
+//
+//msgp:ignore SnapshotManifest
+type SnapshotManifest struct {
+	GenesisID   string         `json:"genesis-id"`
+	GenesisHash crypto.Digest  `json:"genesis-hash"`
+	Round       basics.Round   `json:"round"`
+	Files       []SnapshotFile `json:"files"`
+}
+
+// SnapshotDestinations returns the location of each of the snapshot archive files within the given genesis directories.
+// Besides the ledger databases, a snapshot taken from a participating node also carries the agreement crash database.
+func SnapshotDestinations(dirs config.ResolvedGenesisDirs) map[string]string {
+	return map[string]string{
+		SnapshotTrackerDBFilename: filepath.Join(dirs.TrackerGenesisDir, SnapshotTrackerDBFilename),
+		SnapshotBlockDBFilename:   filepath.Join(dirs.BlockGenesisDir, SnapshotBlockDBFilename),
+		config.CrashFilename:      filepath.Join(dirs.CrashGenesisDir, config.CrashFilename),
+	}
+}
+
+// ExportSnapshot writes consistent copies of the tracker and block databases into the given directory,
+// and returns the round of the tracker database copy. The tracker commit lock is held while copying, so
+// that the copied block database is guaranteed to contain all the blocks up to the returned round.
+// The ledger keeps serving requests and accepting blocks while the export is in progress.
+func (l *Ledger) ExportSnapshot(ctx context.Context, dir string) (basics.Round, error) {
+	l.trackers.commitMu.Lock()
+	defer l.trackers.commitMu.Unlock()
+
+	rnd := l.trackers.getDbRound()
+	err := l.trackerDBs.VacuumInto(ctx, filepath.Join(dir, SnapshotTrackerDBFilename))
+	if err != nil {
+		return 0, fmt.Errorf("unable to copy tracker database: %w", err)
+	}
+	err = l.blockDBs.Rdb.VacuumInto(ctx, filepath.Join(dir, SnapshotBlockDBFilename))
+	if err != nil {
+		return 0, fmt.Errorf("unable to copy block database: %w", err)
+	}
+	return rnd, nil
+}
+
+// WriteSnapshotArchive writes a tar archive containing the manifest followed by the named files from the
+// given directory. The manifest Files list is populated with the size and digest of each of the files.
+func WriteSnapshotArchive(w io.Writer, manifest SnapshotManifest, dir string, names []string) error {
+	manifest.Files = make([]SnapshotFile, 0, len(names))
+	for _, name := range names {
+		file, err := describeSnapshotFile(filepath.Join(dir, name))
+		if err != nil {
+			return err
+		}
+		file.Name = name
+		manifest.Files = append(manifest.Files, file)
+	}
+	manifestBytes, err := json.MarshalIndent(manifest, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	tarWriter := tar.NewWriter(w)
+	err = tarWriter.WriteHeader(&tar.Header{
+		Name: SnapshotManifestFilename,
+		Mode: 0600,
+		Size: int64(len(manifestBytes)),
+	})
+	if err != nil {
+		return err
+	}
+	if _, err = tarWriter.Write(manifestBytes); err != nil {
+		return err
+	}
+	for _, file := range manifest.Files {
+		err = writeSnapshotArchiveFile(tarWriter, filepath.Join(dir, file.Name), file)
+		if err != nil {
+			return err
+		}
+	}
+	return tarWriter.Close()
+}
+
+func describeSnapshotFile(path string) (file SnapshotFile, err error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return SnapshotFile{}, err
+	}
+	defer f.Close()
+	hasher := crypto.NewHash()
+	size, err := io.Copy(hasher, f)
+	if err != nil {
+		return SnapshotFile{}, err
+	}
+	file.Size = uint64(size)
+	copy(file.Digest[:], hasher.Sum(nil))
+	return file, nil
+}
+
+func writeSnapshotArchiveFile(tarWriter *tar.Writer, path string, file SnapshotFile) error {
+	f, err := os.Open(path)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+	err = tarWriter.WriteHeader(&tar.Header{
+		Name: file.Name,
+		Mode: 0600,
+		Size: int64(file.Size),
+	})
+	if err != nil {
+		return err
+	}
+	_, err = io.CopyN(tarWriter, f, int64(file.Size))
+	return err
+}
+
+// ReadSnapshotArchive reads a snapshot archive, validating it against the expected genesis hash and the
+// checksums listed in its manifest. Each of the files is restored into the path provided by destinations;
+// the files are first written next to their destinations, and moved into place only once the entire archive
+// was verified, so that a corrupted archive never leaves a partially restored data directory behind.
+func ReadSnapshotArchive(r io.Reader, genesisHash crypto.Digest, destinations map[string]string) (manifest SnapshotManifest, err error) {
+	tarReader := tar.NewReader(r)
+	header, err := tarReader.Next()
+	if err != nil {
+		if err == io.EOF {
+			err = errSnapshotManifestMissing
+		}
+		return SnapshotManifest{}, err
+	}
+	if header.Name != SnapshotManifestFilename || header.Size > maxSnapshotManifestSize {
+		return SnapshotManifest{}, errSnapshotManifestMissing
+	}
+	manifestBytes, err := io.ReadAll(tarReader)
+	if err != nil {
+		return SnapshotManifest{}, err
+	}
+	if err = json.Unmarshal(manifestBytes, &manifest); err != nil {
+		return SnapshotManifest{}, fmt.Errorf("unable to decode snapshot manifest: %w", err)
+	}
+	if manifest.GenesisHash != genesisHash {
+		return SnapshotManifest{}, fmt.Errorf("snapshot genesis hash %v (%s) does not match expected genesis hash %v", manifest.GenesisHash, manifest.GenesisID, genesisHash)
+	}
+
+	expected := make(map[string]SnapshotFile, len(manifest.Files))
+	for _, file := range manifest.Files {
+		if _, ok := destinations[file.Name]; !ok {
+			return SnapshotManifest{}, fmt.Errorf("snapshot contains an unexpected file %s", file.Name)
+		}
+		expected[file.Name] = file
+	}
+
+	var staged []string
+	defer func() {
+		if err != nil {
+			for _, path := range staged {
+				os.Remove(path)
+			}
+		}
+	}()
+	for {
+		header, err = tarReader.Next()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return SnapshotManifest{}, err
+		}
+		file, ok := expected[header.Name]
+		if !ok {
+			return SnapshotManifest{}, fmt.Errorf("snapshot file %s is not listed in the manifest or appears more than once", header.Name)
+		}
+		delete(expected, header.Name)
+		stagingPath := destinations[file.Name] + ".snapshot"
+		staged = append(staged, stagingPath)
+		if err = stageSnapshotFile(tarReader, stagingPath, file); err != nil {
+			return SnapshotManifest{}, err
+		}
+	}
+	for name := range expected {
+		return SnapshotManifest{}, fmt.Errorf("snapshot file %s is listed in the manifest but missing from the archive", name)
+	}
+
+	for _, file := range manifest.Files {
+		dest := destinations[file.Name]
+		// remove any stale write-ahead log which would otherwise be applied on top of the restored database
+		for _, suffix := range []string{"-shm", "-wal"} {
+			if err = os.Remove(dest + suffix); err != nil && !os.IsNotExist(err) {
+				return SnapshotManifest{}, err
+			}
+		}
+		if err = os.Rename(dest+".snapshot", dest); err != nil {
+			return SnapshotManifest{}, err
+		}
+	}
+	return manifest, nil
+}
+
+func stageSnapshotFile(r io.Reader, path string, file SnapshotFile) error {
+	f, err := os.OpenFile(path, os.O_CREATE|os.O_TRUNC|os.O_WRONLY, 0600)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+	hasher := crypto.NewHash()
+	size, err := io.Copy(io.MultiWriter(f, hasher), r)
+	if err != nil {
+		return err
+	}
+	var digest crypto.Digest
+	copy(digest[:], hasher.Sum(nil))
+	if uint64(size) != file.Size || digest != file.Digest {
+		return fmt.Errorf("snapshot file %s failed verification: expected %d bytes with digest %v, got %d bytes with digest %v", file.Name, file.Size, file.Digest, size, digest)
+	}
+	return f.Sync()
+}
@@ -0,0 +1,107 @@
+// Copyright (C) 2019-2025 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package ledger
+
+import (
+	"bytes"
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/algorand/go-algorand/agreement"
+	"github.com/algorand/go-algorand/config"
+	"github.com/algorand/go-algorand/crypto"
+	"github.com/algorand/go-algorand/logging"
+	"github.com/algorand/go-algorand/test/partitiontest"
+)
+
+func TestLedgerSnapshotExportImport(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	genesisInitState := getInitState()
+	const inMem = false
+	cfg := config.GetDefaultLocal()
+	cfg.Archival = true
+	log := logging.TestingLog(t)
+
+	l, err := OpenLedger(log, filepath.Join(t.TempDir(), config.LedgerFilenamePrefix), inMem, genesisInitState, cfg)
+	require.NoError(t, err)
+	defer l.Close()
+
+	blk := genesisInitState.Block
+	for i := 0; i < 50; i++ {
+		blk.BlockHeader.Round++
+		blk.BlockHeader.TimeStamp += 1000
+		require.NoError(t, l.AddBlock(blk, agreement.Certificate{}))
+	}
+	l.WaitForCommit(blk.Round())
+
+	stagingDir := t.TempDir()
+	rnd, err := l.ExportSnapshot(context.Background(), stagingDir)
+	require.NoError(t, err)
+	require.Equal(t, l.trackers.getDbRound(), rnd)
+
+	// keep adding blocks after the export; these must not affect the snapshot
+	for i := 0; i < 10; i++ {
+		blk.BlockHeader.Round++
+		blk.BlockHeader.TimeStamp += 1000
+		require.NoError(t, l.AddBlock(blk, agreement.Certificate{}))
+	}
+
+	var archive bytes.Buffer
+	manifest := SnapshotManifest{GenesisID: "test", GenesisHash: genesisInitState.GenesisHash, Round: rnd}
+	names := []string{SnapshotTrackerDBFilename, SnapshotBlockDBFilename}
+	require.NoError(t, WriteSnapshotArchive(&archive, manifest, stagingDir, names))
+
+	restoreDir := t.TempDir()
+	destinations := map[string]string{
+		SnapshotTrackerDBFilename: filepath.Join(restoreDir, SnapshotTrackerDBFilename),
+		SnapshotBlockDBFilename:   filepath.Join(restoreDir, SnapshotBlockDBFilename),
+	}
+
+	// wrong genesis hash
+	_, err = ReadSnapshotArchive(bytes.NewReader(archive.Bytes()), crypto.Digest{1}, destinations)
+	require.ErrorContains(t, err, "genesis hash")
+
+	// corrupted database file; nothing should be moved into place
+	corrupted := bytes.Clone(archive.Bytes())
+	corrupted[len(corrupted)-2048] ^= 0xff
+	_, err = ReadSnapshotArchive(bytes.NewReader(corrupted), genesisInitState.GenesisHash, destinations)
+	require.ErrorContains(t, err, "failed verification")
+	entries, err := os.ReadDir(restoreDir)
+	require.NoError(t, err)
+	require.Empty(t, entries)
+
+	restored, err := ReadSnapshotArchive(bytes.NewReader(archive.Bytes()), genesisInitState.GenesisHash, destinations)
+	require.NoError(t, err)
+	require.Equal(t, rnd, restored.Round)
+	require.Len(t, restored.Files, 2)
+
+	l2, err := OpenLedger(log, filepath.Join(restoreDir, config.LedgerFilenamePrefix), inMem, genesisInitState, cfg)
+	require.NoError(t, err)
+	defer l2.Close()
+	require.GreaterOrEqual(t, l2.Latest(), rnd)
+	require.Less(t, l2.Latest(), blk.Round())
+	_, totals, err := l2.LatestTotals()
+	require.NoError(t, err)
+	_, expectedTotals, err := l.LatestTotals()
+	require.NoError(t, err)
+	require.Equal(t, expectedTotals.All(), totals.All())
+}
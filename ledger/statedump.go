@@ -0,0 +1,543 @@
+// Copyright (C) 2019-2025 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package ledger
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/base64"
+	"encoding/binary"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/algorand/avm-abi/apps"
+	"github.com/algorand/msgp/msgp"
+
+	"github.com/algorand/go-algorand/crypto"
+	"github.com/algorand/go-algorand/data/basics"
+	"github.com/algorand/go-algorand/ledger/encoded"
+	"github.com/algorand/go-algorand/ledger/ledgercore"
+	"github.com/algorand/go-algorand/ledger/store/trackerdb"
+	"github.com/algorand/go-algorand/protocol"
+)
+
+// A state dump is a text stream describing the entire state held by the tracker database at a single round.
+// Each line holds a single record made of space separated fields, the last of which is the hash chain digest:
+// the hash of the previous line digest concatenated with the rest of the line. The first line is the header,
+// holding the dump version and round, and the last line holds the number of records. Records are grouped by
+// kind, in the order of stateDumpKinds, and are sorted by their hex encoded key within each kind, so that two
+// databases holding the same state always produce the same dump, and the final digest identifies the state.
+//
+//	statedump <version> <round> <digest>
+//	<kind> <hex key> <base64 msgpack value> <digest>
+//	...
+//	end <records count> <digest>
+
+const (
+	stateDumpMagic   = "statedump"
+	stateDumpVersion = 1
+	stateDumpEnd     = "end"
+
+	// maxStateDumpLineSize is the maximum size of a single line of a state dump, large enough for
+	// a maximum size box value or account record.
+	maxStateDumpLineSize = 4 * 1024 * 1024
+)
+
+// State dump record kinds
+const (
+	StateDumpAccount       = "account"
+	StateDumpResource      = "resource"
+	StateDumpKV            = "kv"
+	StateDumpOnlineAccount = "onlineaccount"
+	StateDumpTotals        = "totals"
+)
+
+// stateDumpKinds lists the record kinds in the order they appear in a state dump.
+// The totals come last, so that a diff points at the diverging account before the totals it affects.
+var stateDumpKinds = []string{StateDumpAccount, StateDumpResource, StateDumpKV, StateDumpOnlineAccount, StateDumpTotals}
+
+var errStateDumpTruncated = errors.New("state dump is truncated")
+
+// ErrStateDumpRoundMismatch is returned when comparing state dumps of different rounds, which are expected to differ
+var ErrStateDumpRoundMismatch = errors.New("state dumps are of different rounds")
+
+// StateDumpRecord is a single record of a state dump
+type StateDumpRecord struct {
+	Kind  string
+	Key   []byte
+	Value []byte
+}
+
+// String returns a human readable description of the record key
+func (r StateDumpRecord) String() string {
+	switch r.Kind {
+	case StateDumpAccount:
+		var addr basics.Address
+		if len(r.Key) == len(addr) {
+			copy(addr[:], r.Key)
+			return fmt.Sprintf("account %s", addr)
+		}
+	case StateDumpResource, StateDumpOnlineAccount:
+		var addr basics.Address
+		if len(r.Key) == len(addr)+8 {
+			copy(addr[:], r.Key)
+			if r.Kind == StateDumpResource {
+				return fmt.Sprintf("resource %d of account %s", binary.BigEndian.Uint64(r.Key[len(addr):]), addr)
+			}
+			return fmt.Sprintf("online account %s updated at round %d", addr, binary.BigEndian.Uint64(r.Key[len(addr):]))
+		}
+	case StateDumpKV:
+		if app, name, err := apps.SplitBoxKey(string(r.Key)); err == nil {
+			return fmt.Sprintf("box %s of application %d", base64.StdEncoding.EncodeToString([]byte(name)), app)
+		}
+		return fmt.Sprintf("kv %s", base64.StdEncoding.EncodeToString(r.Key))
+	case StateDumpTotals:
+		return "account totals"
+	}
+	return fmt.Sprintf("%s %x", r.Kind, r.Key)
+}
+
+// DecodeValue decodes the record value into a JSON document, for display purposes
+func (r StateDumpRecord) DecodeValue() (string, error) {
+	var obj msgp.Unmarshaler
+	switch r.Kind {
+	case StateDumpAccount:
+		obj = &trackerdb.BaseAccountData{}
+	case StateDumpResource:
+		obj = &trackerdb.ResourcesData{}
+	case StateDumpOnlineAccount:
+		obj = &trackerdb.BaseOnlineAccountData{}
+	case StateDumpTotals:
+		obj = &ledgercore.AccountTotals{}
+	default:
+		return strconv.Quote(base64.StdEncoding.EncodeToString(r.Value)), nil
+	}
+	err := protocol.Decode(r.Value, obj)
+	if err != nil {
+		return "", err
+	}
+	data, err := json.Marshal(obj)
+	return string(data), err
+}
+
+// stateDumpWriter writes a hash chained state dump
+type stateDumpWriter struct {
+	w       *bufio.Writer
+	digest  crypto.Digest
+	kind    int
+	lastKey []byte
+	records uint64
+	line    bytes.Buffer
+}
+
+func makeStateDumpWriter(w io.Writer, rnd basics.Round) (*stateDumpWriter, error) {
+	dw := &stateDumpWriter{w: bufio.NewWriterSize(w, 1024*1024)}
+	err := dw.writeLine(fmt.Sprintf("%s %d %d", stateDumpMagic, stateDumpVersion, rnd))
+	return dw, err
+}
+
+func (dw *stateDumpWriter) writeLine(line string) error {
+	dw.digest = chainStateDumpDigest(dw.digest, line)
+	_, err := fmt.Fprintf(dw.w, "%s %s\n", line, hex.EncodeToString(dw.digest[:]))
+	return err
+}
+
+// write adds a record; records must be written in the state dump order
+func (dw *stateDumpWriter) write(kind string, key []byte, value []byte) error {
+	for dw.kind < len(stateDumpKinds) && stateDumpKinds[dw.kind] != kind {
+		dw.kind++
+		dw.lastKey = nil
+	}
+	if dw.kind == len(stateDumpKinds) {
+		return fmt.Errorf("state dump record of kind %s is out of order", kind)
+	}
+	if dw.lastKey != nil && bytes.Compare(dw.lastKey, key) >= 0 {
+		return fmt.Errorf("state dump %s key %x is out of order", kind, key)
+	}
+	dw.lastKey = append(dw.lastKey[:0], key...)
+
+	dw.line.Reset()
+	dw.line.WriteString(kind)
+	dw.line.WriteByte(' ')
+	dw.line.WriteString(hex.EncodeToString(key))
+	dw.line.WriteByte(' ')
+	dw.line.WriteString(base64.StdEncoding.EncodeToString(value))
+	dw.records++
+	return dw.writeLine(dw.line.String())
+}
+
+func (dw *stateDumpWriter) finish() error {
+	err := dw.writeLine(fmt.Sprintf("%s %d", stateDumpEnd, dw.records))
+	if err != nil {
+		return err
+	}
+	return dw.w.Flush()
+}
+
+func chainStateDumpDigest(prev crypto.Digest, line string) crypto.Digest {
+	buf := make([]byte, 0, len(prev)+len(line))
+	buf = append(buf, prev[:]...)
+	buf = append(buf, line...)
+	return crypto.Hash(buf)
+}
+
+// DumpTrackerState writes a canonical state dump of the given tracker database, and returns the round of the dumped state.
+// The whole dump is read from a single database snapshot, so the database may keep being written to in the meantime.
+func DumpTrackerState(ctx context.Context, store trackerdb.Store, w io.Writer) (rnd basics.Round, err error) {
+	err = store.SnapshotContext(ctx, func(ctx context.Context, tx trackerdb.SnapshotScope) error {
+		ar, err := tx.MakeAccountsReader()
+		if err != nil {
+			return err
+		}
+		rnd, err = ar.AccountsRound()
+		if err != nil {
+			return err
+		}
+		totals, err := ar.AccountsTotals(ctx, false)
+		if err != nil {
+			return err
+		}
+
+		dw, err := makeStateDumpWriter(w, rnd)
+		if err != nil {
+			return err
+		}
+
+		accounts, err := tx.MakeAccountsByAddressIter(ctx)
+		if err != nil {
+			return err
+		}
+		defer accounts.Close()
+		for accounts.Next() {
+			acct, err := accounts.GetItem()
+			if err != nil {
+				return err
+			}
+			var data trackerdb.BaseAccountData
+			if err = protocol.Decode(acct.Data, &data); err != nil {
+				return fmt.Errorf("unable to decode account %s: %w", acct.Address, err)
+			}
+			if err = dw.write(StateDumpAccount, acct.Address[:], protocol.Encode(&data)); err != nil {
+				return err
+			}
+		}
+		if err = resetStateDumpDeadline(ctx, tx); err != nil {
+			return err
+		}
+
+		resources, err := tx.MakeResourcesByAddressIter(ctx)
+		if err != nil {
+			return err
+		}
+		defer resources.Close()
+		for resources.Next() {
+			res, err := resources.GetItem()
+			if err != nil {
+				return err
+			}
+			var data trackerdb.ResourcesData
+			if err = protocol.Decode(res.Data, &data); err != nil {
+				return fmt.Errorf("unable to decode resource %d of account %s: %w", res.Aidx, res.Address, err)
+			}
+			if err = dw.write(StateDumpResource, binary.BigEndian.AppendUint64(res.Address[:], uint64(res.Aidx)), protocol.Encode(&data)); err != nil {
+				return err
+			}
+		}
+		if err = resetStateDumpDeadline(ctx, tx); err != nil {
+			return err
+		}
+
+		kvs, err := tx.MakeKVsByKeyIter(ctx)
+		if err != nil {
+			return err
+		}
+		defer kvs.Close()
+		for kvs.Next() {
+			key, value, err := kvs.KeyValue()
+			if err != nil {
+				return err
+			}
+			if err = dw.write(StateDumpKV, key, value); err != nil {
+				return err
+			}
+		}
+		if err = resetStateDumpDeadline(ctx, tx); err != nil {
+			return err
+		}
+
+		// the onlineaccounts table also holds the history of each account, which is retained for as long as the
+		// voters and online accounts trackers need it; that depends on when each node happened to prune it, so only
+		// the latest record of the accounts which are online at the dump round is part of the state.
+		onlineAccounts, err := tx.MakeOrderedOnlineAccountsIter(ctx, false, 0)
+		if err != nil {
+			return err
+		}
+		defer onlineAccounts.Close()
+		var latest *encoded.OnlineAccountRecordV6
+		writeLatestOnlineAccount := func() error {
+			if latest == nil {
+				return nil
+			}
+			var data trackerdb.BaseOnlineAccountData
+			if err := protocol.Decode(latest.Data, &data); err != nil {
+				return fmt.Errorf("unable to decode online account %s: %w", latest.Address, err)
+			}
+			if data.IsVotingEmpty() {
+				return nil
+			}
+			return dw.write(StateDumpOnlineAccount, binary.BigEndian.AppendUint64(latest.Address[:], uint64(latest.UpdateRound)), protocol.Encode(&data))
+		}
+		for onlineAccounts.Next() {
+			oa, err := onlineAccounts.GetItem()
+			if err != nil {
+				return err
+			}
+			if latest != nil && latest.Address != oa.Address {
+				if err = writeLatestOnlineAccount(); err != nil {
+					return err
+				}
+			}
+			latest = oa
+		}
+		if err = writeLatestOnlineAccount(); err != nil {
+			return err
+		}
+
+		if err = dw.write(StateDumpTotals, nil, protocol.Encode(&totals)); err != nil {
+			return err
+		}
+		return dw.finish()
+	})
+	return rnd, err
+}
+
+// resetStateDumpDeadline extends the transaction deadline, avoiding the warning for the long lived dump transaction.
+func resetStateDumpDeadline(ctx context.Context, tx trackerdb.SnapshotScope) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+	_, err := tx.ResetTransactionWarnDeadline(ctx, time.Now().Add(time.Minute))
+	return err
+}
+
+// DumpState writes a canonical state dump of the ledger at the latest round committed to the tracker database.
+func (l *Ledger) DumpState(ctx context.Context, w io.Writer) (basics.Round, error) {
+	return DumpTrackerState(ctx, l.trackerDBs, w)
+}
+
+// StateDumpReader reads a state dump, verifying its hash chain and ordering as it goes
+type StateDumpReader struct {
+	scanner *bufio.Scanner
+	line    uint64
+	digest  crypto.Digest
+	kind    int
+	lastKey []byte
+	records uint64
+	done    bool
+
+	// Round is the round of the dumped state
+	Round basics.Round
+}
+
+// MakeStateDumpReader creates a StateDumpReader and reads the state dump header
+func MakeStateDumpReader(r io.Reader) (*StateDumpReader, error) {
+	sr := &StateDumpReader{scanner: bufio.NewScanner(r)}
+	sr.scanner.Buffer(make([]byte, 0, 64*1024), maxStateDumpLineSize)
+	fields, err := sr.readLine()
+	if err != nil {
+		return nil, err
+	}
+	if len(fields) != 3 || fields[0] != stateDumpMagic {
+		return nil, fmt.Errorf("not a state dump")
+	}
+	if fields[1] != strconv.Itoa(stateDumpVersion) {
+		return nil, fmt.Errorf("unsupported state dump version %s", fields[1])
+	}
+	rnd, err := strconv.ParseUint(fields[2], 10, 64)
+	if err != nil {
+		return nil, fmt.Errorf("invalid state dump round: %w", err)
+	}
+	sr.Round = basics.Round(rnd)
+	return sr, nil
+}
+
+// readLine reads the next line, verifies its digest and returns the rest of its fields
+func (sr *StateDumpReader) readLine() ([]string, error) {
+	if !sr.scanner.Scan() {
+		if err := sr.scanner.Err(); err != nil {
+			return nil, err
+		}
+		return nil, errStateDumpTruncated
+	}
+	sr.line++
+	line := sr.scanner.Text()
+	sep := strings.LastIndexByte(line, ' ')
+	if sep < 0 {
+		return nil, fmt.Errorf("state dump line %d is malformed", sr.line)
+	}
+	expected := chainStateDumpDigest(sr.digest, line[:sep])
+	if line[sep+1:] != hex.EncodeToString(expected[:]) {
+		return nil, fmt.Errorf("state dump line %d does not match the hash chain", sr.line)
+	}
+	sr.digest = expected
+	return strings.Split(line[:sep], " "), nil
+}
+
+// Next returns the next record of the state dump, or io.EOF once the end of the dump was reached and verified
+func (sr *StateDumpReader) Next() (StateDumpRecord, error) {
+	if sr.done {
+		return StateDumpRecord{}, io.EOF
+	}
+	fields, err := sr.readLine()
+	if err != nil {
+		return StateDumpRecord{}, err
+	}
+	if len(fields) == 2 && fields[0] == stateDumpEnd {
+		if fields[1] != strconv.FormatUint(sr.records, 10) {
+			return StateDumpRecord{}, fmt.Errorf("state dump holds %d records but its trailer says %s", sr.records, fields[1])
+		}
+		sr.done = true
+		return StateDumpRecord{}, io.EOF
+	}
+	if len(fields) != 3 {
+		return StateDumpRecord{}, fmt.Errorf("state dump line %d is malformed", sr.line)
+	}
+	rec := StateDumpRecord{Kind: fields[0]}
+	if rec.Key, err = hex.DecodeString(fields[1]); err != nil {
+		return StateDumpRecord{}, fmt.Errorf("state dump line %d has an invalid key: %w", sr.line, err)
+	}
+	if rec.Value, err = base64.StdEncoding.DecodeString(fields[2]); err != nil {
+		return StateDumpRecord{}, fmt.Errorf("state dump line %d has an invalid value: %w", sr.line, err)
+	}
+
+	kind := sr.kind
+	for kind < len(stateDumpKinds) && stateDumpKinds[kind] != rec.Kind {
+		kind++
+	}
+	if kind == len(stateDumpKinds) {
+		return StateDumpRecord{}, fmt.Errorf("state dump line %d has an unknown or out of order kind %s", sr.line, rec.Kind)
+	}
+	if kind == sr.kind && sr.lastKey != nil && bytes.Compare(sr.lastKey, rec.Key) >= 0 {
+		return StateDumpRecord{}, fmt.Errorf("state dump line %d key is out of order", sr.line)
+	}
+	sr.kind = kind
+	sr.lastKey = rec.Key
+	sr.records++
+	return rec, nil
+}
+
+// Digest returns the hash chain digest of the records read so far; once Next returned io.EOF, this identifies the dumped state.
+func (sr *StateDumpReader) Digest() crypto.Digest {
+	return sr.digest
+}
+
+// StateDumpDiff is the result of comparing two state dumps
+type StateDumpDiff struct {
+	// Round is the round of both of the dumped states
+	Round basics.Round
+	// Compared is the number of records which were found identical before the first divergence
+	Compared uint64
+	// A and B are the first divergent records of each dump; one of them is nil when the record is missing from that dump.
+	// Both are nil when the dumps are identical.
+	A *StateDumpRecord
+	B *StateDumpRecord
+}
+
+// Identical returns true if no divergent record was found
+func (d StateDumpDiff) Identical() bool {
+	return d.A == nil && d.B == nil
+}
+
+// compareStateDumpRecords orders records the same way they appear in a state dump
+func compareStateDumpRecords(a, b *StateDumpRecord) int {
+	if a.Kind != b.Kind {
+		for _, kind := range stateDumpKinds {
+			if kind == a.Kind {
+				return -1
+			}
+			if kind == b.Kind {
+				return 1
+			}
+		}
+	}
+	return bytes.Compare(a.Key, b.Key)
+}
+
+// DiffStateDumps compares two state dumps of the same round, and stops at the first divergent record.
+// ErrStateDumpRoundMismatch is returned for dumps of different rounds.
+func DiffStateDumps(a, b io.Reader) (diff StateDumpDiff, err error) {
+	ra, err := MakeStateDumpReader(a)
+	if err != nil {
+		return StateDumpDiff{}, fmt.Errorf("first dump: %w", err)
+	}
+	rb, err := MakeStateDumpReader(b)
+	if err != nil {
+		return StateDumpDiff{}, fmt.Errorf("second dump: %w", err)
+	}
+	if ra.Round != rb.Round {
+		return StateDumpDiff{}, fmt.Errorf("%w: %d and %d", ErrStateDumpRoundMismatch, ra.Round, rb.Round)
+	}
+	diff.Round = ra.Round
+
+	next := func(r *StateDumpReader, name string) (*StateDumpRecord, error) {
+		rec, err := r.Next()
+		if err == io.EOF {
+			return nil, nil
+		}
+		if err != nil {
+			return nil, fmt.Errorf("%s dump: %w", name, err)
+		}
+		return &rec, nil
+	}
+	for {
+		recA, err := next(ra, "first")
+		if err != nil {
+			return StateDumpDiff{}, err
+		}
+		recB, err := next(rb, "second")
+		if err != nil {
+			return StateDumpDiff{}, err
+		}
+		switch {
+		case recA == nil && recB == nil:
+			return diff, nil
+		case recA == nil || recB == nil:
+			diff.A, diff.B = recA, recB
+			return diff, nil
+		}
+		switch c := compareStateDumpRecords(recA, recB); {
+		case c < 0:
+			diff.A = recA
+			return diff, nil
+		case c > 0:
+			diff.B = recB
+			return diff, nil
+		}
+		if !bytes.Equal(recA.Value, recB.Value) {
+			diff.A, diff.B = recA, recB
+			return diff, nil
+		}
+		diff.Compared++
+	}
+}
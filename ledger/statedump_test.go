@@ -0,0 +1,166 @@
+// Copyright (C) 2019-2025 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package ledger
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/algorand/go-algorand/config"
+	"github.com/algorand/go-algorand/data/basics"
+	"github.com/algorand/go-algorand/data/txntest"
+	ledgertesting "github.com/algorand/go-algorand/ledger/testing"
+	"github.com/algorand/go-algorand/protocol"
+	"github.com/algorand/go-algorand/test/partitiontest"
+)
+
+func TestLedgerDumpState(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	genBalances, addrs, _ := ledgertesting.NewTestGenesis(func(c *ledgertesting.GenesisCfg) {
+		c.OnlineCount = 2 // addrs[0] and addrs[1] are online
+	})
+	cfg := config.GetDefaultLocal()
+	l := newSimpleLedgerWithConsensusVersion(t, genBalances, protocol.ConsensusFuture, cfg, simpleLedgerOnDisk())
+	defer l.Close()
+
+	// both online accounts get a history record, and addrs[1] goes offline
+	eval := nextBlock(t, l)
+	txn(t, l, eval, &txntest.Txn{Type: "pay", Sender: addrs[0], Receiver: addrs[1], Amount: 1000})
+	txn(t, l, eval, &txntest.Txn{Type: "acfg", Sender: addrs[0], AssetParams: basics.AssetParams{Total: 10, UnitName: "x"}})
+	txn(t, l, eval, &txntest.Txn{Type: "keyreg", Sender: addrs[1]})
+	endBlock(t, l, eval)
+	for i := 0; i < int(cfg.MaxAcctLookback)+1; i++ {
+		eval = nextBlock(t, l)
+		endBlock(t, l, eval)
+	}
+	triggerTrackerFlush(t, l)
+
+	var dump1, dump2 bytes.Buffer
+	rnd, err := l.DumpState(context.Background(), &dump1)
+	require.NoError(t, err)
+	require.Equal(t, l.trackers.getDbRound(), rnd)
+	_, err = l.DumpState(context.Background(), &dump2)
+	require.NoError(t, err)
+	require.Equal(t, dump1.Bytes(), dump2.Bytes())
+
+	reader, err := MakeStateDumpReader(bytes.NewReader(dump1.Bytes()))
+	require.NoError(t, err)
+	require.Equal(t, rnd, reader.Round)
+	kinds := make(map[string]int)
+	for {
+		rec, err := reader.Next()
+		if err == io.EOF {
+			break
+		}
+		require.NoError(t, err)
+		kinds[rec.Kind]++
+		if rec.Kind == StateDumpOnlineAccount {
+			// only the latest record of the online account is dumped
+			require.Equal(t, append(addrs[0][:], 0, 0, 0, 0, 0, 0, 0, 1), rec.Key)
+		}
+		_, err = rec.DecodeValue()
+		require.NoError(t, err)
+	}
+	require.Equal(t, len(genBalances.Balances), kinds[StateDumpAccount])
+	require.Equal(t, 1, kinds[StateDumpResource])
+	require.Equal(t, 1, kinds[StateDumpOnlineAccount])
+	require.Equal(t, 1, kinds[StateDumpTotals])
+
+	diff, err := DiffStateDumps(bytes.NewReader(dump1.Bytes()), bytes.NewReader(dump2.Bytes()))
+	require.NoError(t, err)
+	require.True(t, diff.Identical())
+	require.Equal(t, rnd, diff.Round)
+	require.Equal(t, uint64(len(genBalances.Balances)+1+1+1), diff.Compared)
+
+	// any alteration breaks the hash chain
+	tampered := bytes.Replace(dump1.Bytes(), []byte("\n"+StateDumpResource+" "), []byte("\n"+StateDumpKV+" "), 1)
+	_, err = DiffStateDumps(bytes.NewReader(dump1.Bytes()), bytes.NewReader(tampered))
+	require.ErrorContains(t, err, "hash chain")
+	_, err = DiffStateDumps(bytes.NewReader(dump1.Bytes()), bytes.NewReader(dump1.Bytes()[:dump1.Len()-10]))
+	require.Error(t, err)
+}
+
+func TestStateDumpDiff(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	type record struct {
+		kind       string
+		key, value string
+	}
+	makeDump := func(records ...record) []byte {
+		var buf bytes.Buffer
+		dw, err := makeStateDumpWriter(&buf, 7)
+		require.NoError(t, err)
+		for _, r := range records {
+			require.NoError(t, dw.write(r.kind, []byte(r.key), []byte(r.value)))
+		}
+		require.NoError(t, dw.finish())
+		return buf.Bytes()
+	}
+	diff := func(a, b []byte) StateDumpDiff {
+		d, err := DiffStateDumps(bytes.NewReader(a), bytes.NewReader(b))
+		require.NoError(t, err)
+		return d
+	}
+
+	base := makeDump(record{StateDumpAccount, "a", "1"}, record{StateDumpAccount, "b", "2"}, record{StateDumpKV, "c", "3"})
+	require.True(t, diff(base, base).Identical())
+
+	// divergent value
+	d := diff(base, makeDump(record{StateDumpAccount, "a", "1"}, record{StateDumpAccount, "b", "9"}, record{StateDumpKV, "c", "3"}))
+	require.Equal(t, uint64(1), d.Compared)
+	require.Equal(t, []byte("b"), d.A.Key)
+	require.Equal(t, []byte("9"), d.B.Value)
+
+	// missing record in the second dump
+	d = diff(base, makeDump(record{StateDumpAccount, "a", "1"}, record{StateDumpKV, "c", "3"}))
+	require.Equal(t, []byte("b"), d.A.Key)
+	require.Nil(t, d.B)
+
+	// extra record in the second dump, of a later kind
+	d = diff(base, makeDump(record{StateDumpAccount, "a", "1"}, record{StateDumpAccount, "b", "2"}, record{StateDumpResource, "z", "0"}, record{StateDumpKV, "c", "3"}))
+	require.Nil(t, d.A)
+	require.Equal(t, StateDumpResource, d.B.Kind)
+
+	// trailing record
+	d = diff(base, makeDump(record{StateDumpAccount, "a", "1"}, record{StateDumpAccount, "b", "2"}, record{StateDumpKV, "c", "3"}, record{StateDumpKV, "d", "4"}))
+	require.Equal(t, uint64(3), d.Compared)
+	require.Nil(t, d.A)
+	require.Equal(t, []byte("d"), d.B.Key)
+
+	// dumps of different rounds are not compared
+	var other bytes.Buffer
+	dw, err := makeStateDumpWriter(&other, 8)
+	require.NoError(t, err)
+	require.NoError(t, dw.finish())
+	_, err = DiffStateDumps(bytes.NewReader(base), bytes.NewReader(other.Bytes()))
+	require.ErrorIs(t, err, ErrStateDumpRoundMismatch)
+
+	// records must be written in order
+	dw, err = makeStateDumpWriter(io.Discard, 7)
+	require.NoError(t, err)
+	require.NoError(t, dw.write(StateDumpKV, []byte("b"), nil))
+	require.Error(t, dw.write(StateDumpKV, []byte("a"), nil))
+	require.Error(t, dw.write(StateDumpAccount, []byte("c"), nil))
+}
@@ -199,6 +199,11 @@ func (s *trackerStore) Vacuum(ctx context.Context) (stats db.VacuumStats, err er
 	return
 }
 
+func (s *trackerStore) VacuumInto(ctx context.Context, filename string) error {
+	// only the primary is copied; the secondary is a shadow of it
+	return s.primary.VacuumInto(ctx, filename)
+}
+
 func (s *trackerStore) ResetToV6Test(ctx context.Context) error {
 	// TODO
 	return nil
@@ -290,6 +295,21 @@ func (*reader) MakeOnlineRoundParamsIter(context.Context, bool, basics.Round) (t
 	return nil, nil
 }
 
+// MakeAccountsByAddressIter implements trackerdb.Reader
+func (r *reader) MakeAccountsByAddressIter(ctx context.Context) (trackerdb.TableIterator[*trackerdb.AccountRecord], error) {
+	return r.primary.MakeAccountsByAddressIter(ctx)
+}
+
+// MakeResourcesByAddressIter implements trackerdb.Reader
+func (r *reader) MakeResourcesByAddressIter(ctx context.Context) (trackerdb.TableIterator[*trackerdb.ResourceRecord], error) {
+	return r.primary.MakeResourcesByAddressIter(ctx)
+}
+
+// MakeKVsByKeyIter implements trackerdb.Reader
+func (r *reader) MakeKVsByKeyIter(ctx context.Context) (trackerdb.KVsIter, error) {
+	return r.primary.MakeKVsByKeyIter(ctx)
+}
+
 type writer struct {
 	primary   trackerdb.Writer
 	secondary trackerdb.Writer
@@ -18,6 +18,7 @@ package generickv
 
 import (
 	"context"
+	"fmt"
 
 	"github.com/algorand/go-algorand/config"
 	"github.com/algorand/go-algorand/data/basics"
@@ -90,3 +91,22 @@ func (r *reader) MakeOnlineRoundParamsIter(context.Context, bool, basics.Round)
 	// TODO: catchpoint
 	panic("unimplemented")
 }
+
+// errStateDumpNotSupported is returned by the state dump iterators, which have
+// no key-value implementation yet.
+var errStateDumpNotSupported = fmt.Errorf("state dumps are not supported by this database: %w", trackerdb.ErrNotSupported)
+
+// MakeAccountsByAddressIter implements trackerdb.Reader
+func (r *reader) MakeAccountsByAddressIter(context.Context) (trackerdb.TableIterator[*trackerdb.AccountRecord], error) {
+	return nil, errStateDumpNotSupported
+}
+
+// MakeResourcesByAddressIter implements trackerdb.Reader
+func (r *reader) MakeResourcesByAddressIter(context.Context) (trackerdb.TableIterator[*trackerdb.ResourceRecord], error) {
+	return nil, errStateDumpNotSupported
+}
+
+// MakeKVsByKeyIter implements trackerdb.Reader
+func (r *reader) MakeKVsByKeyIter(context.Context) (trackerdb.KVsIter, error) {
+	return nil, errStateDumpNotSupported
+}
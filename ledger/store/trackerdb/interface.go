@@ -31,6 +31,9 @@ import (
 // ErrNotFound is returned when a record is not found.
 var ErrNotFound = errors.New("trackerdb: not found")
 
+// ErrNotSupported is returned when an operation is not supported by the database.
+var ErrNotSupported = errors.New("trackerdb: not supported")
+
 // ErrIoErr is returned when a Disk/IO error is encountered
 type ErrIoErr struct {
 	InnerError error
@@ -247,6 +250,23 @@ type TableIterator[T any] interface {
 	Close()
 }
 
+// AccountRecord is a single account, as stored in the accounts table.
+//
+//msgp:ignore AccountRecord
+type AccountRecord struct {
+	Address basics.Address
+	Data    []byte // encoding of BaseAccountData
+}
+
+// ResourceRecord is a single resource of an account, as stored in the resources table.
+//
+//msgp:ignore ResourceRecord
+type ResourceRecord struct {
+	Address basics.Address
+	Aidx    basics.CreatableIndex
+	Data    []byte // encoding of ResourcesData
+}
+
 // EncodedAccountsBatchIter is an iterator for a accounts.
 type EncodedAccountsBatchIter interface {
 	Next(ctx context.Context, accountCount int, resourceCount int) (bals []encoded.BalanceRecordV6, numAccountsProcessed uint64, err error)
@@ -20,6 +20,7 @@ package pebbledbdriver
 
 import (
 	"context"
+	"fmt"
 	"io"
 	"runtime"
 	"time"
@@ -381,6 +382,11 @@ func (s *trackerStore) Vacuum(ctx context.Context) (stats db.VacuumStats, err er
 	return db.VacuumStats{}, nil
 }
 
+// VacuumInto implements trackerdb.Store
+func (s *trackerStore) VacuumInto(ctx context.Context, filename string) error {
+	return fmt.Errorf("copying the tracker database into %s is not supported by pebbledb", filename)
+}
+
 // ResetToV6Test implements trackerdb.Store
 func (s *trackerStore) ResetToV6Test(ctx context.Context) error {
 	// TODO
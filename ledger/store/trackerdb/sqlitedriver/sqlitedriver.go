@@ -143,6 +143,11 @@ func (s *trackerSQLStore) Vacuum(ctx context.Context) (stats db.VacuumStats, err
 	return
 }
 
+// VacuumInto writes a consistent copy of the tracker database into the given file
+func (s *trackerSQLStore) VacuumInto(ctx context.Context, filename string) error {
+	return s.pair.Rdb.VacuumInto(ctx, filename)
+}
+
 func (s *trackerSQLStore) ResetToV6Test(ctx context.Context) error {
 	var resetExprs = []string{
 		`DROP TABLE IF EXISTS onlineaccounts`,
@@ -221,6 +226,21 @@ func (r *sqlReader) MakeOnlineRoundParamsIter(ctx context.Context, useStaging bo
 	return MakeOnlineRoundParamsIter(ctx, r.q, useStaging, excludeBefore)
 }
 
+// MakeAccountsByAddressIter implements trackerdb.Reader
+func (r *sqlReader) MakeAccountsByAddressIter(ctx context.Context) (trackerdb.TableIterator[*trackerdb.AccountRecord], error) {
+	return MakeAccountsByAddressIter(ctx, r.q)
+}
+
+// MakeResourcesByAddressIter implements trackerdb.Reader
+func (r *sqlReader) MakeResourcesByAddressIter(ctx context.Context) (trackerdb.TableIterator[*trackerdb.ResourceRecord], error) {
+	return MakeResourcesByAddressIter(ctx, r.q)
+}
+
+// MakeKVsByKeyIter implements trackerdb.Reader
+func (r *sqlReader) MakeKVsByKeyIter(ctx context.Context) (trackerdb.KVsIter, error) {
+	return MakeKVsByKeyIter(ctx, r.q)
+}
+
 type sqlWriter struct {
 	e db.Executable
 }
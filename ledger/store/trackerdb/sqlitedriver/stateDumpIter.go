@@ -0,0 +1,95 @@
+// Copyright (C) 2019-2025 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package sqlitedriver
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	"github.com/algorand/go-algorand/data/basics"
+	"github.com/algorand/go-algorand/ledger/store/trackerdb"
+	"github.com/algorand/go-algorand/util/db"
+)
+
+// The iterators below are used to produce a canonical dump of the tracker database. Unlike the
+// catchpoint iterators, which follow the rowid order, they follow the order of the natural keys,
+// so that two databases holding the same state produce the same sequence of records.
+
+// MakeAccountsByAddressIter creates an accountbase iterator ordered by address.
+func MakeAccountsByAddressIter(ctx context.Context, q db.Queryable) (trackerdb.TableIterator[*trackerdb.AccountRecord], error) {
+	rows, err := q.QueryContext(ctx, "SELECT address, data FROM accountbase ORDER BY address")
+	if err != nil {
+		return nil, err
+	}
+	return &tableIterator[*trackerdb.AccountRecord]{rows: rows, scan: scanAccountRecord}, nil
+}
+
+func scanAccountRecord(rows *sql.Rows) (*trackerdb.AccountRecord, error) {
+	var ret trackerdb.AccountRecord
+	var addr []byte
+	err := rows.Scan(&addr, &ret.Data)
+	if err != nil {
+		return nil, err
+	}
+	if len(addr) != len(ret.Address) {
+		return nil, fmt.Errorf("account DB address length mismatch: %d != %d", len(addr), len(ret.Address))
+	}
+	copy(ret.Address[:], addr)
+	return &ret, nil
+}
+
+// MakeResourcesByAddressIter creates a resources iterator ordered by (address, aidx).
+func MakeResourcesByAddressIter(ctx context.Context, q db.Queryable) (trackerdb.TableIterator[*trackerdb.ResourceRecord], error) {
+	rows, err := q.QueryContext(ctx, "SELECT accountbase.address, resources.aidx, resources.data FROM resources JOIN accountbase ON accountbase.rowid = resources.addrid ORDER BY accountbase.address, resources.aidx")
+	if err != nil {
+		return nil, err
+	}
+	return &tableIterator[*trackerdb.ResourceRecord]{rows: rows, scan: scanResourceRecord}, nil
+}
+
+func scanResourceRecord(rows *sql.Rows) (*trackerdb.ResourceRecord, error) {
+	var ret trackerdb.ResourceRecord
+	var addr []byte
+	var aidx sql.NullInt64
+	err := rows.Scan(&addr, &aidx, &ret.Data)
+	if err != nil {
+		return nil, err
+	}
+	if len(addr) != len(ret.Address) {
+		return nil, fmt.Errorf("resources DB address length mismatch: %d != %d", len(addr), len(ret.Address))
+	}
+	copy(ret.Address[:], addr)
+	if !aidx.Valid || aidx.Int64 < 0 {
+		return nil, fmt.Errorf("invalid aidx (%v) for resource of account %s", aidx, ret.Address)
+	}
+	ret.Aidx = basics.CreatableIndex(aidx.Int64)
+	return &ret, nil
+}
+
+// MakeKVsByKeyIter creates a KV iterator ordered by key.
+func MakeKVsByKeyIter(ctx context.Context, q db.Queryable) (*kvsIter, error) {
+	rows, err := q.QueryContext(ctx, "SELECT key, value FROM kvstore ORDER BY key")
+	if err != nil {
+		return nil, err
+	}
+
+	return &kvsIter{
+		q:    q,
+		rows: rows,
+	}, nil
+}
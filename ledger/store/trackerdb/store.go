@@ -48,6 +48,7 @@ type Store interface {
 	BeginTransaction(ctx context.Context) (Transaction, error)
 	// maintenance
 	Vacuum(ctx context.Context) (stats db.VacuumStats, err error)
+	VacuumInto(ctx context.Context, filename string) error
 	// testing
 	ResetToV6Test(ctx context.Context) error
 	// cleanup
@@ -70,6 +71,13 @@ type Reader interface {
 	// MakeOrderedOnlineAccountsIter orders by (address, updround).
 	MakeOrderedOnlineAccountsIter(ctx context.Context, useStaging bool, excludeBefore basics.Round) (TableIterator[*encoded.OnlineAccountRecordV6], error)
 	MakeOnlineRoundParamsIter(ctx context.Context, useStaging bool, excludeBefore basics.Round) (TableIterator[*encoded.OnlineRoundParamsRecordV6], error)
+	// state dump
+	// MakeAccountsByAddressIter orders by address.
+	MakeAccountsByAddressIter(ctx context.Context) (TableIterator[*AccountRecord], error)
+	// MakeResourcesByAddressIter orders by (address, aidx).
+	MakeResourcesByAddressIter(ctx context.Context) (TableIterator[*ResourceRecord], error)
+	// MakeKVsByKeyIter orders by key.
+	MakeKVsByKeyIter(ctx context.Context) (KVsIter, error)
 }
 
 // Writer is the interface for the trackerdb write operations.
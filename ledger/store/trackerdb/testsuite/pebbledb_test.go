@@ -17,12 +17,15 @@
 package testsuite
 
 import (
+	"context"
 	"fmt"
 	"testing"
 
 	"github.com/algorand/go-algorand/config"
+	"github.com/algorand/go-algorand/ledger/store/trackerdb"
 	"github.com/algorand/go-algorand/ledger/store/trackerdb/pebbledbdriver"
 	"github.com/algorand/go-algorand/logging"
+	"github.com/algorand/go-algorand/protocol"
 	"github.com/stretchr/testify/require"
 )
 
@@ -42,3 +45,21 @@ func TestPebbleDB(t *testing.T) {
 	// run the suite
 	runGenericTestsWithDB(t, dbFactory)
 }
+
+func TestPebbleDBStateDumpNotSupported(t *testing.T) {
+	dir := fmt.Sprintf("%s/db", t.TempDir())
+	db, err := pebbledbdriver.Open(dir, false, config.Consensus[protocol.ConsensusCurrentVersion], logging.TestingLog(t))
+	require.NoError(t, err)
+	defer db.Close()
+
+	err = db.Snapshot(func(ctx context.Context, tx trackerdb.SnapshotScope) error {
+		_, err := tx.MakeAccountsByAddressIter(ctx)
+		require.ErrorIs(t, err, trackerdb.ErrNotSupported)
+		_, err = tx.MakeResourcesByAddressIter(ctx)
+		require.ErrorIs(t, err, trackerdb.ErrNotSupported)
+		_, err = tx.MakeKVsByKeyIter(ctx)
+		require.ErrorIs(t, err, trackerdb.ErrNotSupported)
+		return nil
+	})
+	require.NoError(t, err)
+}
@@ -25,6 +25,8 @@ import (
 	"sync/atomic"
 	"time"
 
+	"go.opentelemetry.io/otel/attribute"
+
 	"github.com/algorand/go-algorand/config"
 	"github.com/algorand/go-algorand/crypto"
 	"github.com/algorand/go-algorand/data/basics"
@@ -37,6 +39,7 @@ import (
 	"github.com/algorand/go-algorand/logging/telemetryspec"
 	"github.com/algorand/go-algorand/protocol"
 	"github.com/algorand/go-algorand/util/db"
+	"github.com/algorand/go-algorand/util/tracing"
 	"github.com/algorand/go-deadlock"
 )
 
@@ -192,6 +195,11 @@ type trackerRegistry struct {
 	// accountsCommitting is set when trackers registry writing accounts into DB.
 	accountsCommitting atomic.Bool
 
+	// commitMu is the tracker commit lock. It's held by commitRound for the entire duration of a commit, and allows
+	// other parties ( i.e. snapshot export ) to observe the tracker database at a fixed round. It's a plain mutex
+	// rather than a deadlock one since it could legitimately be held for a long time.
+	commitMu sync.Mutex
+
 	// dbRound is always exactly accountsRound(),
 	// cached to avoid SQL queries.
 	dbRound basics.Round
@@ -213,6 +221,9 @@ type trackerRegistry struct {
 
 	cfg config.Local
 
+	// metrics holds the per-tracker commit histograms; it is nil unless EnableLedgerTrackerMetrics is set
+	metrics *trackerCommitMetrics
+
 	// maxAccountDeltas is a maximum number of in-memory deltas stored by trackers.
 	// When exceeded trackerRegistry will attempt to flush, and its Available() method will return false.
 	// Too many in-memory deltas could cause the node to run out of memory.
@@ -335,6 +346,9 @@ func (tr *trackerRegistry) initialize(l ledgerForTracker, trackers []ledgerTrack
 	go tr.commitSyncer(tr.deferredCommits)
 
 	tr.trackers = append([]ledgerTracker{}, trackers...)
+	if cfg.EnableLedgerTrackerMetrics {
+		tr.metrics = makeTrackerCommitMetrics(tr.trackers)
+	}
 
 	// accountUpdates and onlineAccounts are needed for replaying (called in later in loadFromDisk)
 	for _, tracker := range tr.trackers {
@@ -469,6 +483,7 @@ func (tr *trackerRegistry) scheduleCommit(blockqRound, maxLookback basics.Round)
 		tr.accountsWriting.Add(1)
 		select {
 		case tr.deferredCommits <- dcc:
+			tr.metrics.setQueueDepth(len(tr.deferredCommits))
 		default:
 			// Do NOT block if deferredCommits cannot accept this task, skip it.
 			// Note: the next attempt will include these rounds plus some extra rounds.
@@ -525,6 +540,10 @@ func (tr *trackerRegistry) close() {
 	}
 	tr.trackers = nil
 	tr.accts = nil
+	if tr.metrics != nil {
+		tr.metrics.close()
+		tr.metrics = nil
+	}
 	tr.log.Debugf("trackerRegistry has closed")
 }
 
@@ -538,6 +557,7 @@ func (tr *trackerRegistry) commitSyncer(deferredCommits chan *deferredCommitCont
 			if !ok {
 				return
 			}
+			tr.metrics.setQueueDepth(len(deferredCommits))
 			err := tr.commitRound(commit)
 			if err != nil {
 				tr.log.Warnf("Could not commit round: %v", err)
@@ -563,6 +583,8 @@ func (tr *trackerRegistry) commitSyncer(deferredCommits chan *deferredCommitCont
 // commitRound commits the given deferredCommitContext via the trackers.
 func (tr *trackerRegistry) commitRound(dcc *deferredCommitContext) error {
 	defer tr.accountsWriting.Done()
+	tr.commitMu.Lock()
+	defer tr.commitMu.Unlock()
 	tr.mu.RLock()
 
 	offset := dcc.offset
@@ -602,8 +624,10 @@ func (tr *trackerRegistry) commitRound(dcc *deferredCommitContext) error {
 	tr.log.Debugf("commitRound advancing tracker db snapshot (%d-%d)", dbRound, dbRound+basics.Round(offset))
 
 	var err error
-	for _, lt := range tr.trackers {
+	for i, lt := range tr.trackers {
+		stageStart := time.Now()
 		err = lt.prepareCommit(dcc)
+		tr.metrics.observeStage(trackerStagePrepareCommit, i, stageStart)
 		if err != nil {
 			tr.log.Error(err.Error())
 			break
@@ -621,6 +645,9 @@ func (tr *trackerRegistry) commitRound(dcc *deferredCommitContext) error {
 
 	tr.mu.RUnlock()
 
+	_, span := tracing.Start(tr.ctx, "ledger.commit",
+		attribute.Int64("algorand.round", int64(newBase)),
+		attribute.Int64("algorand.rounds", int64(offset)))
 	start := time.Now()
 	ledgerCommitroundCount.Inc(nil)
 	err = tr.dbs.TransactionWithRetryClearFn(func(ctx context.Context, tx trackerdb.TransactionScope) (err error) { // TransactionFn
@@ -634,8 +661,10 @@ func (tr *trackerRegistry) commitRound(dcc *deferredCommitContext) error {
 			return err
 		}
 
-		for _, lt := range tr.trackers {
+		for i, lt := range tr.trackers {
+			stageStart := time.Now()
 			err0 := lt.commitRound(ctx, tx, dcc)
+			tr.metrics.observeStage(trackerStageCommitRound, i, stageStart)
 			if err0 != nil {
 				return err0
 			}
@@ -650,6 +679,7 @@ func (tr *trackerRegistry) commitRound(dcc *deferredCommitContext) error {
 		}
 	})
 	ledgerCommitroundMicros.AddMicrosecondsSince(start, nil)
+	tracing.End(span, err)
 
 	if err != nil {
 
@@ -669,17 +699,23 @@ func (tr *trackerRegistry) commitRound(dcc *deferredCommitContext) error {
 		return err
 	}
 
+	tr.metrics.observeCommittedRows(tr.trackers, dcc)
+
 	tr.mu.Lock()
 	tr.dbRound = newBase
-	for _, lt := range tr.trackers {
+	for i, lt := range tr.trackers {
+		stageStart := time.Now()
 		lt.postCommit(tr.ctx, dcc)
+		tr.metrics.observeStage(trackerStagePostCommit, i, stageStart)
 	}
 	tr.lastFlushTime = dcc.flushTime
 	tr.mu.Unlock()
 
-	for _, lt := range tr.trackers {
+	for i, lt := range tr.trackers {
 		if lt, ok := lt.(trackerCommitLifetimeHandlers); ok {
+			stageStart := time.Now()
 			lt.postCommitUnlocked(tr.ctx, dcc)
+			tr.metrics.observeStage(trackerStagePostCommitUnlocked, i, stageStart)
 		}
 	}
 
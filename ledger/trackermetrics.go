@@ -0,0 +1,130 @@
+// Copyright (C) 2019-2025 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package ledger
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/algorand/go-algorand/util/metrics"
+)
+
+// trackerCommitStage identifies one of the stages each of the trackers goes through when committing
+type trackerCommitStage int
+
+const (
+	trackerStagePrepareCommit trackerCommitStage = iota
+	trackerStageCommitRound
+	trackerStagePostCommit
+	trackerStagePostCommitUnlocked
+	trackerCommitStagesCount
+)
+
+// trackerCommitMetrics holds the histograms describing how long each of the trackers spends in each of
+// the commit stages and how many rows it writes, along with the depth of the deferred commits queue.
+// It is only created when EnableLedgerTrackerMetrics is set.
+type trackerCommitMetrics struct {
+	stages     [trackerCommitStagesCount]*metrics.Histogram
+	commitRows *metrics.Histogram
+	queueDepth *metrics.Gauge
+
+	// labels holds the labels of each of the trackers, in the trackerRegistry.trackers order
+	labels []map[string]string
+}
+
+func makeTrackerCommitMetrics(trackers []ledgerTracker) *trackerCommitMetrics {
+	m := &trackerCommitMetrics{
+		stages: [trackerCommitStagesCount]*metrics.Histogram{
+			trackerStagePrepareCommit:      metrics.MakeHistogram(metrics.LedgerTrackerPrepareCommitSeconds, metrics.DurationBuckets),
+			trackerStageCommitRound:        metrics.MakeHistogram(metrics.LedgerTrackerCommitRoundSeconds, metrics.DurationBuckets),
+			trackerStagePostCommit:         metrics.MakeHistogram(metrics.LedgerTrackerPostCommitSeconds, metrics.DurationBuckets),
+			trackerStagePostCommitUnlocked: metrics.MakeHistogram(metrics.LedgerTrackerPostCommitUnlockedSeconds, metrics.DurationBuckets),
+		},
+		commitRows: metrics.MakeHistogram(metrics.LedgerTrackerCommitRows, metrics.SizeBuckets),
+		queueDepth: metrics.MakeGauge(metrics.LedgerDeferredCommitsQueueDepth),
+		labels:     make([]map[string]string, len(trackers)),
+	}
+	m.queueDepth.Set(0)
+	for i, lt := range trackers {
+		m.labels[i] = map[string]string{"tracker": trackerName(lt)}
+	}
+	return m
+}
+
+// trackerName returns the name of the tracker type, as used for the tracker metrics label
+func trackerName(lt ledgerTracker) string {
+	name := fmt.Sprintf("%T", lt)
+	return name[strings.LastIndex(name, ".")+1:]
+}
+
+func (m *trackerCommitMetrics) close() {
+	for _, h := range m.stages {
+		h.Deregister(nil)
+	}
+	m.commitRows.Deregister(nil)
+	m.queueDepth.Deregister(nil)
+}
+
+// observeStage records the time the i-th tracker spent in the given commit stage, which began at start.
+// Like the other observe methods, it is a no-op when the tracker metrics are disabled.
+func (m *trackerCommitMetrics) observeStage(stage trackerCommitStage, i int, start time.Time) {
+	if m == nil {
+		return
+	}
+	m.stages[stage].ObserveSecondsSince(start, m.labels[i])
+}
+
+// observeCommittedRows records the number of rows written by each of the trackers in a successful commit
+func (m *trackerCommitMetrics) observeCommittedRows(trackers []ledgerTracker, dcc *deferredCommitContext) {
+	if m == nil {
+		return
+	}
+	for i, lt := range trackers {
+		if rows, ok := trackerCommittedRows(lt, dcc); ok {
+			m.commitRows.Observe(float64(rows), m.labels[i])
+		}
+	}
+}
+
+// setQueueDepth updates the number of deferred commits pending in the commit queue
+func (m *trackerCommitMetrics) setQueueDepth(depth int) {
+	if m == nil {
+		return
+	}
+	m.queueDepth.Set(uint64(depth))
+}
+
+// trackerCommittedRows returns the number of rows the tracker wrote to the tracker database during
+// the commitRound of dcc, if the tracker writes any.
+func trackerCommittedRows(lt ledgerTracker, dcc *deferredCommitContext) (int, bool) {
+	switch lt.(type) {
+	case *accountUpdates:
+		rows := len(dcc.updatedPersistedAccounts) + len(dcc.updatedPersistedKVs) + len(dcc.compactCreatableDeltas)
+		for _, resources := range dcc.updatedPersistedResources {
+			rows += len(resources)
+		}
+		return rows, true
+	case *onlineAccounts:
+		return len(dcc.updatedPersistedOnlineAccounts) + len(dcc.onlineRoundParams), true
+	case *txTail:
+		return len(dcc.txTailDeltas), true
+	case *spVerificationTracker:
+		return len(dcc.spVerification.commitContext), true
+	}
+	return 0, false
+}
@@ -0,0 +1,73 @@
+// Copyright (C) 2019-2025 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package ledger
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/algorand/go-algorand/config"
+	"github.com/algorand/go-algorand/data/txntest"
+	ledgertesting "github.com/algorand/go-algorand/ledger/testing"
+	"github.com/algorand/go-algorand/protocol"
+	"github.com/algorand/go-algorand/test/partitiontest"
+	"github.com/algorand/go-algorand/util/metrics"
+)
+
+func TestTrackerCommitMetrics(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	genBalances, addrs, _ := ledgertesting.NewTestGenesis()
+	cfg := config.GetDefaultLocal()
+	cfg.EnableLedgerTrackerMetrics = true
+	l := newSimpleLedgerWithConsensusVersion(t, genBalances, protocol.ConsensusFuture, cfg, simpleLedgerOnDisk())
+	require.NotNil(t, l.trackers.metrics)
+	require.Len(t, l.trackers.metrics.labels, len(l.trackers.trackers))
+
+	eval := nextBlock(t, l)
+	txn(t, l, eval, &txntest.Txn{Type: "pay", Sender: addrs[0], Receiver: addrs[1], Amount: 1000})
+	endBlock(t, l, eval)
+	for i := 0; i < int(cfg.MaxAcctLookback)+1; i++ {
+		eval = nextBlock(t, l)
+		endBlock(t, l, eval)
+	}
+	triggerTrackerFlush(t, l)
+
+	var buf strings.Builder
+	l.trackers.metrics.stages[trackerStageCommitRound].WriteMetric(&buf, "")
+	require.Contains(t, buf.String(), `algod_ledger_tracker_commit_round_seconds_count{tracker="accountUpdates"}`)
+	require.Contains(t, buf.String(), `algod_ledger_tracker_commit_round_seconds_count{tracker="catchpointTracker"}`)
+
+	buf.Reset()
+	l.trackers.metrics.commitRows.WriteMetric(&buf, "")
+	require.Contains(t, buf.String(), `algod_ledger_tracker_commit_rows_count{tracker="txTail"}`)
+	require.NotContains(t, buf.String(), `tracker="bulletin"`)
+
+	buf.Reset()
+	l.trackers.metrics.queueDepth.WriteMetric(&buf, "")
+	require.Contains(t, buf.String(), "# TYPE algod_ledger_deferred_commits_queue_depth gauge")
+	require.Contains(t, buf.String(), "algod_ledger_deferred_commits_queue_depth 0")
+
+	// the metrics are removed from the registry once the ledger is closed
+	l.Close()
+	require.Nil(t, l.trackers.metrics)
+	buf.Reset()
+	metrics.DefaultRegistry().WriteMetrics(&buf, "")
+	require.NotContains(t, buf.String(), "algod_ledger_tracker_")
+}
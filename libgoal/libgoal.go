@@ -17,9 +17,11 @@
 package libgoal
 
 import (
+	"context"
 	"encoding/json"
 	"errors"
 	"fmt"
+	"io"
 	"os"
 	"path/filepath"
 	"time"
@@ -1064,6 +1066,25 @@ func (c *Client) GetParticipationKeys() (resp model.ParticipationKeysResponse, e
 	return
 }
 
+// GetParticipationHealth returns the health of the participation keys of the node.
+func (c *Client) GetParticipationHealth() (resp model.ParticipationHealthResponse, err error) {
+	algod, err := c.ensureAlgodClient()
+	if err == nil {
+		return algod.GetParticipationHealth()
+	}
+	return
+}
+
+// UnlockParticipationKeys unlocks the participation keys of the node encrypted at rest, encrypting them
+// first if they are not yet and initialize is set.
+func (c *Client) UnlockParticipationKeys(passphrase string, initialize bool) error {
+	algod, err := c.ensureAlgodClient()
+	if err != nil {
+		return err
+	}
+	return algod.UnlockParticipationKeys(passphrase, initialize)
+}
+
 // GetParticipationKeyByID looks up a specific participation key by its participationID.
 func (c *Client) GetParticipationKeyByID(id string) (resp model.ParticipationKeyResponse, err error) {
 	algod, err := c.ensureAlgodClient()
@@ -1138,6 +1159,51 @@ func (c *Client) Catchup(catchpointLabel string, min uint64) (model.CatchpointSt
 	return algod.Catchup(catchpointLabel, min)
 }
 
+// ExportLedgerSnapshot streams a snapshot archive of the node's ledger databases into w
+func (c *Client) ExportLedgerSnapshot(ctx context.Context, w io.Writer) error {
+	algod, err := c.ensureAlgodClient()
+	if err != nil {
+		return err
+	}
+	return algod.ExportLedgerSnapshot(ctx, w)
+}
+
+// GetPeers lists the peers the node is connected to
+func (c *Client) GetPeers() (model.PeersResponse, error) {
+	algod, err := c.ensureAlgodClient()
+	if err != nil {
+		return model.PeersResponse{}, err
+	}
+	return algod.GetPeers()
+}
+
+// AddPriorityPeer connects the node to the relay at address, and keeps it connected for duration seconds
+func (c *Client) AddPriorityPeer(address string, duration uint64) error {
+	algod, err := c.ensureAlgodClient()
+	if err != nil {
+		return err
+	}
+	return algod.AddPriorityPeer(address, duration)
+}
+
+// DisconnectPeer disconnects the node from the peers with address, and bans it for ban seconds if not zero
+func (c *Client) DisconnectPeer(address string, ban uint64) (model.DisconnectPeerResponse, error) {
+	algod, err := c.ensureAlgodClient()
+	if err != nil {
+		return model.DisconnectPeerResponse{}, err
+	}
+	return algod.DisconnectPeer(address, ban)
+}
+
+// GetPhonebook returns the entries of the node's phonebook
+func (c *Client) GetPhonebook() (model.PhonebookResponse, error) {
+	algod, err := c.ensureAlgodClient()
+	if err != nil {
+		return model.PhonebookResponse{}, err
+	}
+	return algod.GetPhonebook()
+}
+
 const defaultAppIdx = 1380011588
 
 // MakeDryrunStateBytes function creates DryrunRequest data structure in serialized form according to the format
@@ -17,8 +17,13 @@
 package libgoal
 
 import (
+	"net/http"
+	"net/http/httptest"
+	"net/url"
 	"testing"
 
+	algodclient "github.com/algorand/go-algorand/daemon/algod/api/client"
+	"github.com/algorand/go-algorand/data/basics"
 	"github.com/algorand/go-algorand/test/partitiontest"
 	"github.com/stretchr/testify/require"
 )
@@ -141,3 +146,26 @@ func TestValidRounds(t *testing.T) {
 	a.Equal(uint64(100), fv)
 	a.Equal(maxTxnLife, lv)
 }
+
+func TestIsAlgodUnreachable(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusInternalServerError)
+	}))
+	serverURL, err := url.Parse(server.URL)
+	require.NoError(t, err)
+	algod := algodclient.MakeRestClient(*serverURL, "")
+
+	// algod answering with an error is reachable
+	_, err = algod.AccountInformation(basics.Address{}.String(), false)
+	require.Error(t, err)
+	require.False(t, isAlgodUnreachable(err))
+
+	// algod not running is not
+	server.Close()
+	_, err = algod.AccountInformation(basics.Address{}.String(), false)
+	require.Error(t, err)
+	require.True(t, isAlgodUnreachable(err))
+}
@@ -0,0 +1,179 @@
+// Copyright (C) 2019-2025 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package libgoal
+
+import (
+	"errors"
+	"fmt"
+	"net/url"
+	"slices"
+
+	"github.com/algorand/go-algorand/crypto"
+	"github.com/algorand/go-algorand/data/basics"
+	"github.com/algorand/go-algorand/data/transactions"
+)
+
+// AuthAddr returns the address authorized to sign the transactions of
+// account: the address it was rekeyed to, or the account itself.
+func (c *Client) AuthAddr(account string) (basics.Address, error) {
+	addr, err := basics.UnmarshalChecksumAddress(account)
+	if err != nil {
+		return basics.Address{}, err
+	}
+	info, err := c.AccountInformation(account, false)
+	if err != nil {
+		return basics.Address{}, err
+	}
+	if info.AuthAddr == nil || *info.AuthAddr == "" {
+		return addr, nil
+	}
+	return basics.UnmarshalChecksumAddress(*info.AuthAddr)
+}
+
+// CheckWalletCanSign returns an error unless the wallet holds the key of
+// addr, or addr is a multisig address of the wallet and the wallet holds
+// enough of its keys to reach the threshold.
+func (c *Client) CheckWalletCanSign(walletHandle []byte, addr basics.Address) error {
+	keys, err := c.walletKeys(walletHandle)
+	if err != nil {
+		return err
+	}
+	if slices.Contains(keys, addr.String()) {
+		return nil
+	}
+
+	info, err := c.LookupMultisigAccount(walletHandle, addr.String())
+	if err != nil {
+		return fmt.Errorf("the wallet holds neither the key of %s nor its multisig preimage", addr)
+	}
+	held := 0
+	for _, pk := range info.PKs {
+		if slices.Contains(keys, pk) {
+			held++
+		}
+	}
+	if held < int(info.Threshold) {
+		return fmt.Errorf("the wallet holds %d of the %d keys required to sign for multisig address %s", held, info.Threshold, addr)
+	}
+	return nil
+}
+
+// isAlgodUnreachable returns whether err means that the request to algod
+// could not be made, rather than that algod answered it with an error
+func isAlgodUnreachable(err error) bool {
+	var urlErr *url.Error
+	return errors.As(err, &urlErr)
+}
+
+// SignTransactionWithWalletAndAuthAddr signs the passed transaction under a specific signer like
+// SignTransactionWithWalletAndSigner. If signerAddr is the empty string, the signer is the current
+// auth address of the sender looked up on the node, so that the transactions of rekeyed accounts are
+// signed without the user giving their signer. If that auth address is a multisig address of the
+// wallet, the transaction is signed with the keys of the wallet in its preimage. If there is no node
+// to reach, the transaction is signed with the key of the sender, e.g. for signing offline, but any
+// other failure to look up the auth address is returned.
+func (c *Client) SignTransactionWithWalletAndAuthAddr(walletHandle, pw []byte, signerAddr string, utx transactions.Transaction) (stx transactions.SignedTxn, err error) {
+	if signerAddr != "" {
+		return c.SignTransactionWithWalletAndSigner(walletHandle, pw, signerAddr, utx)
+	}
+
+	_, err = c.ensureAlgodClient()
+	if err != nil {
+		// No node is configured to look up the auth address with
+		return c.SignTransactionWithWallet(walletHandle, pw, utx)
+	}
+	authAddr, err := c.AuthAddr(utx.Sender.String())
+	if isAlgodUnreachable(err) {
+		return c.SignTransactionWithWallet(walletHandle, pw, utx)
+	}
+	if err != nil {
+		return stx, fmt.Errorf("cannot look up the auth address of %s: %w", utx.Sender, err)
+	}
+
+	info, err := c.LookupMultisigAccount(walletHandle, authAddr.String())
+	if err != nil {
+		// Not a multisig address of the wallet: sign with the key of authAddr
+		if authAddr == utx.Sender {
+			return c.SignTransactionWithWallet(walletHandle, pw, utx)
+		}
+		return c.SignTransactionWithWalletAndSigner(walletHandle, pw, authAddr.String(), utx)
+	}
+	return c.multisigSignWithWallet(walletHandle, pw, utx, authAddr, info)
+}
+
+// multisigSignWithWallet signs utx for the multisig address msigAddr with the
+// keys the wallet holds, until the threshold is reached
+func (c *Client) multisigSignWithWallet(walletHandle, pw []byte, utx transactions.Transaction, msigAddr basics.Address, info MultisigInfo) (stx transactions.SignedTxn, err error) {
+	keys, err := c.walletKeys(walletHandle)
+	if err != nil {
+		return
+	}
+
+	var msig crypto.MultisigSig
+	signed := 0
+	for _, pk := range info.PKs {
+		if signed == int(info.Threshold) {
+			break
+		}
+		if !slices.Contains(keys, pk) {
+			continue
+		}
+		msig, err = c.MultisigSignTransactionWithWalletAndSigner(walletHandle, pw, utx, pk, msig, msigAddr.String())
+		if err != nil {
+			return
+		}
+		signed++
+	}
+	if signed < int(info.Threshold) {
+		err = fmt.Errorf("the wallet holds %d of the %d keys required to sign for multisig address %s", signed, info.Threshold, msigAddr)
+		return
+	}
+
+	stx = transactions.SignedTxn{
+		Txn:  utx,
+		Msig: msig,
+	}
+	if msigAddr != utx.Sender {
+		stx.AuthAddr = msigAddr
+	}
+	return
+}
+
+// walletKeys lists the addresses of the keys of the wallet, without its
+// multisig addresses
+func (c *Client) walletKeys(walletHandle []byte) ([]string, error) {
+	kmd, err := c.ensureKmdClient()
+	if err != nil {
+		return nil, err
+	}
+	resp, err := kmd.ListKeys(walletHandle)
+	if err != nil {
+		return nil, err
+	}
+	return resp.Addresses, nil
+}
+
+// MakeUnsignedRekeyTx returns a transaction rekeying account to rekeyTo, a
+// payment of zero to itself.
+func (c *Client) MakeUnsignedRekeyTx(account string, rekeyTo basics.Address, fee uint64, firstValid, lastValid basics.Round) (transactions.Transaction, error) {
+	tx, err := c.ConstructPayment(account, account, fee, 0, nil, "", [32]byte{}, firstValid, lastValid)
+	if err != nil {
+		return transactions.Transaction{}, err
+	}
+	tx.RekeyTo = rekeyTo
+	return tx, nil
+}
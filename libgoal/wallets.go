@@ -238,3 +238,37 @@ func (c *Client) ExportMasterDerivationKey(wh []byte, pw []byte) (mdk crypto.Mas
 	// Return the mdk from the response
 	return resp.MasterDerivationKey, nil
 }
+
+// BackupWallet returns a backup of the full contents of the wallet, encrypted
+// with backupPw
+func (c *Client) BackupWallet(wh []byte, pw []byte, backupPw []byte) ([]byte, error) {
+	kmd, err := c.ensureKmdClient()
+	if err != nil {
+		return nil, err
+	}
+
+	// Back up the wallet
+	resp, err := kmd.BackupWallet(wh, pw, backupPw)
+	if err != nil {
+		return nil, err
+	}
+
+	return resp.Backup, nil
+}
+
+// RestoreWallet creates a kmd wallet from a backup made by BackupWallet. If
+// name is blank, the name of the backed up wallet is used.
+func (c *Client) RestoreWallet(name []byte, password []byte, backup []byte, backupPw []byte) (wallet kmdapi.APIV1Wallet, err error) {
+	kmd, err := c.ensureKmdClient()
+	if err != nil {
+		return
+	}
+
+	// Restore the wallet
+	resp, err := kmd.RestoreWallet(name, password, backup, backupPw)
+	if err != nil {
+		return
+	}
+
+	return resp.Wallet, nil
+}
@@ -299,6 +299,8 @@ type PeerConnectionDetails struct {
 	DuplicateFilterCount uint64
 	// These message counters count received messages from this peer.
 	TXCount, MICount, AVCount, PPCount, UNKCount uint64
+	// BytesSent and BytesReceived count the bytes of the messages exchanged with this peer.
+	BytesSent, BytesReceived uint64
 	// TCPInfo provides connection measurements from TCP.
 	TCP util.TCPInfo `json:",omitempty"`
 }
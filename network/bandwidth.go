@@ -0,0 +1,135 @@
+// Copyright (C) 2019-2025 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package network
+
+import (
+	"time"
+
+	"github.com/algorand/go-deadlock"
+
+	"github.com/algorand/go-algorand/config"
+	"github.com/algorand/go-algorand/protocol"
+	"github.com/algorand/go-algorand/util/metrics"
+)
+
+// egressClass groups the message tags sharing an egress quota.
+//
+//msgp:ignore egressClass
+type egressClass int
+
+const (
+	// egressClassOther holds the agreement messages and the network control messages, which are never throttled
+	egressClassOther egressClass = iota
+	// egressClassTxn holds the transaction messages, gossiped or reconciled
+	egressClassTxn
+	// egressClassCatchup holds the responses to the block requests made over the peer connections. Unlike the other
+	// classes, its quota is accounted when the response is made rather than when it's written, so that a request
+	// exceeding it is answered with an error instead of leaving the requesting peer waiting for its response.
+	egressClassCatchup
+
+	numEgressClasses
+)
+
+var egressClassNames = [numEgressClasses]string{"other", "txn", "catchup"}
+
+// tagEgressClass returns the egress class of the messages with tag, as accounted when they are written.
+func tagEgressClass(tag protocol.Tag) egressClass {
+	switch tag {
+	case protocol.TxnTag, protocol.TxnReconciliationTag:
+		return egressClassTxn
+	}
+	return egressClassOther
+}
+
+// errCatchupEgressQuotaExceeded is the error response sent in place of a response exceeding the catchup egress quota
+const errCatchupEgressQuotaExceeded = "catchup egress quota exceeded"
+
+var networkEgressQuotaDroppedMessages = metrics.MakeCounter(metrics.MetricName{Name: "algod_network_egress_quota_dropped_messages_total", Description: "Number of messages not sent to a peer since the egress quota of their class was exceeded"})
+var networkEgressQuotaDroppedBytes = metrics.MakeCounter(metrics.MetricName{Name: "algod_network_egress_quota_dropped_bytes_total", Description: "Number of bytes not sent to the peers since the egress quota of their class was exceeded"})
+
+// egressQuota is a token bucket limiting the bytes per second sent to all the peers, which allows bursts
+// of up to one second of traffic.
+type egressQuota struct {
+	bytesPerSecond int64
+
+	mu        deadlock.Mutex
+	available int64
+	updated   time.Time
+}
+
+func makeEgressQuota(bytesPerSecond uint64) *egressQuota {
+	return &egressQuota{bytesPerSecond: int64(bytesPerSecond), available: int64(bytesPerSecond), updated: time.Now()}
+}
+
+// take returns whether a message of size bytes can be sent at now, and takes its bytes from the bucket if so.
+// A message is sent as long as the bucket is not empty, so that messages larger than the bucket can still be sent.
+func (q *egressQuota) take(now time.Time, size int) bool {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	if elapsed := now.Sub(q.updated); elapsed >= time.Second {
+		q.available = q.bytesPerSecond
+		q.updated = now
+	} else if elapsed > 0 {
+		q.available = min(q.available+q.bytesPerSecond*int64(elapsed)/int64(time.Second), q.bytesPerSecond)
+		q.updated = now
+	}
+	if q.available <= 0 {
+		return false
+	}
+	q.available -= int64(size)
+	return true
+}
+
+// egressQuotas holds the egress quotas of the classes which have one, shared by all the peers of a network.
+//
+//msgp:ignore egressQuotas
+type egressQuotas [numEgressClasses]*egressQuota
+
+// makeEgressQuotas returns the egress quotas set by cfg, or nil if none is.
+func makeEgressQuotas(cfg config.Local) *egressQuotas {
+	var quotas egressQuotas
+	if cfg.TxnEgressQuota > 0 {
+		quotas[egressClassTxn] = makeEgressQuota(cfg.TxnEgressQuota)
+	}
+	if cfg.CatchupEgressQuota > 0 {
+		quotas[egressClassCatchup] = makeEgressQuota(cfg.CatchupEgressQuota)
+	}
+	if quotas == (egressQuotas{}) {
+		return nil
+	}
+	return &quotas
+}
+
+// allow returns whether a message with tag of size bytes can be sent, accounting it if so.
+func (q *egressQuotas) allow(tag protocol.Tag, size int) bool {
+	return q.allowClass(tagEgressClass(tag), size)
+}
+
+// allowClass returns whether a message of class of size bytes can be sent, accounting it if so.
+func (q *egressQuotas) allowClass(class egressClass, size int) bool {
+	if q == nil {
+		return true
+	}
+	quota := q[class]
+	if quota == nil || quota.take(time.Now(), size) {
+		return true
+	}
+	labels := map[string]string{"class": egressClassNames[class]}
+	networkEgressQuotaDroppedMessages.Inc(labels)
+	networkEgressQuotaDroppedBytes.AddUint64(uint64(size), labels)
+	return false
+}
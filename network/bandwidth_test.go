@@ -0,0 +1,174 @@
+// Copyright (C) 2019-2025 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package network
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/algorand/go-algorand/config"
+	"github.com/algorand/go-algorand/network/phonebook"
+	"github.com/algorand/go-algorand/protocol"
+	"github.com/algorand/go-algorand/test/partitiontest"
+)
+
+func TestEgressQuota(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	q := makeEgressQuota(1000)
+	now := q.updated
+	require.True(t, q.take(now, 600))
+	require.True(t, q.take(now, 600))
+	// the bucket is empty
+	require.False(t, q.take(now, 1))
+	require.False(t, q.take(now.Add(100*time.Millisecond), 1))
+	// and refills with time
+	require.True(t, q.take(now.Add(300*time.Millisecond), 50))
+	require.Equal(t, int64(50), q.available)
+	// up to one second of traffic
+	require.True(t, q.take(now.Add(10*time.Second), 1))
+	require.Equal(t, int64(999), q.available)
+
+	require.Nil(t, makeEgressQuotas(config.GetDefaultLocal()))
+	var nilQuotas *egressQuotas
+	require.True(t, nilQuotas.allow(protocol.TxnTag, 1000))
+
+	cfg := config.GetDefaultLocal()
+	cfg.TxnEgressQuota = 100
+	quotas := makeEgressQuotas(cfg)
+	require.True(t, quotas.allow(protocol.TxnTag, 200))
+	require.False(t, quotas.allow(protocol.TxnReconciliationTag, 1))
+	// the other classes are not limited
+	require.True(t, quotas.allow(protocol.TopicMsgRespTag, 200))
+	require.True(t, quotas.allow(protocol.AgreementVoteTag, 200))
+	require.True(t, quotas.allow(protocol.ProposalPayloadTag, 200))
+	require.True(t, quotas.allowClass(egressClassCatchup, 200))
+}
+
+func TestRespondCatchupEgressQuota(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	cfg := config.GetDefaultLocal()
+	cfg.CatchupEgressQuota = 100
+	wp := &wsPeer{
+		closing:        make(chan struct{}),
+		sendBufferBulk: make(chan sendMessages, 2),
+		conn:           &nopConnSingleton,
+		egressQuotas:   makeEgressQuotas(cfg),
+	}
+	reqMsg := IncomingMessage{Sender: wp, Tag: protocol.UniEnsBlockReqTag, Data: []byte("request")}
+	released := 0
+	respond := func() Topics {
+		outMsg := OutgoingMessage{OnRelease: func() { released++ }, Topics: Topics{MakeTopic("blk", make([]byte, 200))}}
+		require.NoError(t, wp.Respond(context.Background(), reqMsg, outMsg))
+		msgs := <-wp.sendBufferBulk
+		if msgs.onRelease != nil {
+			msgs.onRelease()
+		}
+		topics, err := UnmarshallTopics(msgs.msgs[0].data[len(protocol.TopicMsgRespTag):])
+		require.NoError(t, err)
+		_, found := topics.GetValue(requestHashKey)
+		require.True(t, found)
+		return topics
+	}
+
+	// the first response is sent, even though it exceeds the quota
+	topics := respond()
+	_, found := topics.GetValue("blk")
+	require.True(t, found)
+	require.Equal(t, 1, released)
+
+	// the following request is answered with an error, and its response is released right away
+	topics = respond()
+	_, found = topics.GetValue("blk")
+	require.False(t, found)
+	errMsg, found := topics.GetValue(ErrorKey)
+	require.True(t, found)
+	require.Equal(t, errCatchupEgressQuotaExceeded, string(errMsg))
+	require.Equal(t, 2, released)
+}
+
+func TestTagEgressClass(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	require.Equal(t, egressClassTxn, tagEgressClass(protocol.TxnTag))
+	require.Equal(t, egressClassTxn, tagEgressClass(protocol.TxnReconciliationTag))
+	// the responses are accounted when made rather than when written
+	require.Equal(t, egressClassOther, tagEgressClass(protocol.TopicMsgRespTag))
+	for _, tag := range []protocol.Tag{protocol.AgreementVoteTag, protocol.ProposalPayloadTag, protocol.VoteBundleTag,
+		protocol.MsgOfInterestTag, protocol.NetIDVerificationTag, protocol.StateProofSigTag} {
+		require.Equal(t, egressClassOther, tagEgressClass(tag), tag)
+	}
+}
+
+// TestEgressQuotaBroadcast checks that the transactions exceeding the quota are not sent, while the proposals are,
+// and that both ends account the bytes exchanged.
+func TestEgressQuotaBroadcast(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	cfg := defaultConfig
+	cfg.GossipFanout = 1
+	cfg.TxnEgressQuota = 1000
+	netA := makeTestWebsocketNodeWithConfig(t, cfg)
+	netA.Start()
+	t.Cleanup(func() { netStop(t, netA, "A") })
+	netB := makeTestWebsocketNode(t)
+	netB.config.GossipFanout = 1
+	addrA, postListen := netA.Address()
+	require.True(t, postListen)
+	netB.phonebook.ReplacePeerList([]string{addrA}, "default", phonebook.RelayRole)
+	txns := newMessageCounter(t, 0)
+	proposals := newMessageCounter(t, 10)
+	netB.RegisterHandlers([]TaggedMessageHandler{
+		{Tag: protocol.TxnTag, MessageHandler: txns},
+		{Tag: protocol.ProposalPayloadTag, MessageHandler: proposals},
+	})
+	netB.Start()
+	t.Cleanup(func() { netStop(t, netB, "B") })
+
+	readyTimeout := time.NewTimer(2 * time.Second)
+	waitReady(t, netA, readyTimeout.C)
+	waitReady(t, netB, readyTimeout.C)
+	require.Eventually(t, func() bool { return len(netA.GetPeers(PeersConnectedIn)) == 1 }, 5*time.Second, 10*time.Millisecond)
+
+	for i := 0; i < 10; i++ {
+		data := make([]byte, 300)
+		data[0] = byte(i)
+		require.NoError(t, netA.Broadcast(context.Background(), protocol.TxnTag, data, true, nil))
+		require.NoError(t, netA.Broadcast(context.Background(), protocol.ProposalPayloadTag, data, true, nil))
+	}
+	select {
+	case <-proposals.done:
+	case <-time.After(5 * time.Second):
+		require.Fail(t, "proposals not received", "received %d", proposals.Count())
+	}
+	// the bucket of 1000 bytes allows 4 transactions of 302 bytes, and refills by less than 300 bytes
+	// while the messages are sent
+	require.Eventually(t, func() bool { return txns.Count() >= 4 }, 5*time.Second, 10*time.Millisecond)
+
+	peerB := netA.GetPeers(PeersConnectedIn)[0].(*wsPeer)
+	peerA := netB.GetPeers(PeersConnectedOut)[0].(*wsPeer)
+	require.Eventually(t, func() bool {
+		return peerB.sentBytes.Load() > 0 && peerB.sentBytes.Load() == peerA.receivedBytes.Load()
+	}, 5*time.Second, 10*time.Millisecond)
+	require.Less(t, txns.Count(), 10)
+	require.Equal(t, peerA.receivedBytes.Load(), netB.PeersInfo()[0].BytesReceived)
+	require.Equal(t, peerB.sentBytes.Load(), netA.PeersInfo()[0].BytesSent)
+}
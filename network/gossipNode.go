@@ -21,6 +21,8 @@ import (
 	"net/http"
 	"strings"
 
+	"go.opentelemetry.io/otel/trace"
+
 	"github.com/algorand/go-algorand/config"
 	"github.com/algorand/go-algorand/protocol"
 )
@@ -113,6 +115,9 @@ type GossipNode interface {
 	// GetGenesisID returns the network-specific genesisID.
 	GetGenesisID() string
 
+	// ReportPeerEvent reports a misbehavior of peer, lowering its reputation.
+	ReportPeerEvent(peer Peer, event PeerEvent)
+
 	// called from wsPeer to report that it has closed
 	peerRemoteClose(peer *wsPeer, reason disconnectReason)
 }
@@ -138,6 +143,13 @@ type IncomingMessage struct {
 	// Received is time.Time.UnixNano()
 	Received int64
 
+	// SpanContext is the context of the tracing span of the reception of the message, if tracing is enabled.
+	// The spans of the processing of the message are its children.
+	SpanContext trace.SpanContext
+
+	// remoteSpanContext is the context of the span of the peer that sent the message, if it sent it.
+	remoteSpanContext trace.SpanContext
+
 	// processing is a channel that is used by messageHandlerThread
 	// to indicate that it has started processing this message.  It
 	// is used to ensure fairness across peers in terms of processing
@@ -21,10 +21,13 @@ import (
 	"fmt"
 	"net/http"
 	"sync"
+	"time"
 
 	"github.com/algorand/go-algorand/config"
 	"github.com/algorand/go-algorand/logging"
 	"github.com/algorand/go-algorand/network/addr"
+	"github.com/algorand/go-algorand/network/messagetracer"
+	"github.com/algorand/go-algorand/network/phonebook"
 	"github.com/algorand/go-algorand/protocol"
 )
 
@@ -61,6 +64,8 @@ func NewHybridP2PNetwork(log logging.Logger, cfg config.Local, datadir string, p
 	if err != nil {
 		return nil, err
 	}
+	// the quotas apply to the bandwidth of both networks
+	p2pnet.egressQuotas = wsnet.egressQuotas
 	return &HybridP2PNetwork{
 		p2pNetwork: p2pnet,
 		wsNetwork:  wsnet,
@@ -190,6 +195,12 @@ func (n *HybridP2PNetwork) Stop() {
 	})
 }
 
+// SetCapture records the gossip messages of both networks.
+func (n *HybridP2PNetwork) SetCapture(capture *messagetracer.Capture) {
+	n.p2pNetwork.SetCapture(capture)
+	n.wsNetwork.SetCapture(capture)
+}
+
 // RegisterHandlers adds to the set of given message handlers.
 func (n *HybridP2PNetwork) RegisterHandlers(dispatch []TaggedMessageHandler) {
 	n.p2pNetwork.RegisterHandlers(dispatch)
@@ -234,6 +245,43 @@ func (n *HybridP2PNetwork) OnNetworkAdvance() {
 	})
 }
 
+// ReportPeerEvent implements GossipNode
+func (n *HybridP2PNetwork) ReportPeerEvent(peer Peer, event PeerEvent) {
+	p, ok := peer.(DisconnectablePeer)
+	if !ok {
+		return
+	}
+	if net := p.GetNetwork(); net == n.p2pNetwork {
+		n.p2pNetwork.ReportPeerEvent(peer, event)
+	} else if net == n.wsNetwork {
+		n.wsNetwork.ReportPeerEvent(peer, event)
+	}
+}
+
+// PeersInfo describes the connected peers of both networks.
+func (n *HybridP2PNetwork) PeersInfo() []PeerInfo {
+	return append(n.wsNetwork.PeersInfo(), n.p2pNetwork.PeersInfo()...)
+}
+
+// DisconnectPeer disconnects the peers of both networks with the given address.
+func (n *HybridP2PNetwork) DisconnectPeer(address string, banDuration time.Duration) int {
+	return n.wsNetwork.DisconnectPeer(address, banDuration) + n.p2pNetwork.DisconnectPeer(address, banDuration)
+}
+
+// AddPriorityPeer adds a priority peer to the P2P network if address is a multiaddress, and to the
+// websocket network otherwise.
+func (n *HybridP2PNetwork) AddPriorityPeer(address string, duration time.Duration) error {
+	if addr.IsMultiaddr(address) {
+		return n.p2pNetwork.AddPriorityPeer(address, duration)
+	}
+	return n.wsNetwork.AddPriorityPeer(address, duration)
+}
+
+// PhonebookEntries returns the entries of the phonebooks of both networks.
+func (n *HybridP2PNetwork) PhonebookEntries() []phonebook.Entry {
+	return append(n.wsNetwork.PhonebookEntries(), n.p2pNetwork.PhonebookEntries()...)
+}
+
 // GetGenesisID returns the network-specific genesisID.
 func (n *HybridP2PNetwork) GetGenesisID() string {
 	return n.genesisID
@@ -0,0 +1,170 @@
+// Copyright (C) 2019-2025 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package messagetracer
+
+import (
+	"bufio"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"sync/atomic"
+	"time"
+
+	"github.com/algorand/go-algorand/logging"
+	"github.com/algorand/go-algorand/protocol"
+)
+
+// A capture is a sequence of records, each of them written with a single Write call so that
+// a rotating writer never splits a record across files:
+//
+//	uvarint  length of the rest of the record
+//	byte     direction
+//	int64    timestamp, in nanoseconds since the epoch (big endian)
+//	uvarint  length of the tag, followed by the tag
+//	uvarint  length of the peer address, followed by the address
+//	         payload, until the end of the record
+
+// maxCaptureRecordSize bounds the records accepted by a CaptureReader.
+const maxCaptureRecordSize = 64 * 1024 * 1024
+
+// ErrCorruptedCapture is returned when reading a malformed capture record.
+var ErrCorruptedCapture = errors.New("corrupted capture record")
+
+// Direction tells whether a captured message was received or sent.
+type Direction byte
+
+const (
+	// Incoming messages were received from a peer
+	Incoming Direction = iota
+	// Outgoing messages were sent to a peer
+	Outgoing
+)
+
+func (d Direction) String() string {
+	switch d {
+	case Incoming:
+		return "in"
+	case Outgoing:
+		return "out"
+	}
+	return fmt.Sprintf("Direction(%d)", byte(d))
+}
+
+// CaptureRecord is a gossip message recorded in a capture.
+type CaptureRecord struct {
+	Direction Direction
+	Timestamp time.Time
+	Tag       protocol.Tag
+	// Peer is the address of the peer the message was received from or sent to.
+	// It is empty for the messages published to pubsub topics.
+	Peer string
+	Data []byte
+}
+
+// Capture records gossip messages to a writer, typically a logging.CyclicFileWriter.
+// It is safe for concurrent use as long as the writer is.
+type Capture struct {
+	w      io.Writer
+	log    logging.Logger
+	failed atomic.Bool
+}
+
+// MakeCapture creates a Capture writing to w.
+func MakeCapture(w io.Writer, log logging.Logger) *Capture {
+	return &Capture{w: w, log: log}
+}
+
+// Record writes a message to the capture. Write errors are logged once and otherwise ignored,
+// since the capture must never interfere with the processing of the messages.
+func (c *Capture) Record(direction Direction, tag protocol.Tag, peer string, data []byte) {
+	rec := encodeCaptureRecord(CaptureRecord{Direction: direction, Timestamp: time.Now(), Tag: tag, Peer: peer, Data: data})
+	if _, err := c.w.Write(rec); err != nil && !c.failed.Swap(true) {
+		c.log.Warnf("unable to write to the network capture: %v", err)
+	}
+}
+
+func encodeCaptureRecord(rec CaptureRecord) []byte {
+	bodyLen := 1 + 8 + binary.MaxVarintLen64 + len(rec.Tag) + binary.MaxVarintLen64 + len(rec.Peer) + len(rec.Data)
+	body := make([]byte, 0, bodyLen)
+	body = append(body, byte(rec.Direction))
+	body = binary.BigEndian.AppendUint64(body, uint64(rec.Timestamp.UnixNano()))
+	body = binary.AppendUvarint(body, uint64(len(rec.Tag)))
+	body = append(body, rec.Tag...)
+	body = binary.AppendUvarint(body, uint64(len(rec.Peer)))
+	body = append(body, rec.Peer...)
+	body = append(body, rec.Data...)
+
+	out := make([]byte, 0, binary.MaxVarintLen64+len(body))
+	out = binary.AppendUvarint(out, uint64(len(body)))
+	return append(out, body...)
+}
+
+// CaptureReader reads the records of a capture.
+type CaptureReader struct {
+	r *bufio.Reader
+}
+
+// MakeCaptureReader creates a CaptureReader reading from r.
+func MakeCaptureReader(r io.Reader) *CaptureReader {
+	return &CaptureReader{r: bufio.NewReader(r)}
+}
+
+// Next returns the next record of the capture, or io.EOF at its end.
+func (c *CaptureReader) Next() (rec CaptureRecord, err error) {
+	size, err := binary.ReadUvarint(c.r)
+	if err != nil {
+		if err == io.ErrUnexpectedEOF {
+			err = ErrCorruptedCapture
+		}
+		return rec, err
+	}
+	if size > maxCaptureRecordSize {
+		return rec, fmt.Errorf("%w: record of %d bytes", ErrCorruptedCapture, size)
+	}
+	body := make([]byte, size)
+	if _, err = io.ReadFull(c.r, body); err != nil {
+		return rec, fmt.Errorf("%w: %v", ErrCorruptedCapture, err)
+	}
+
+	if len(body) < 9 {
+		return rec, ErrCorruptedCapture
+	}
+	rec.Direction = Direction(body[0])
+	rec.Timestamp = time.Unix(0, int64(binary.BigEndian.Uint64(body[1:9])))
+	body = body[9:]
+
+	var tag, peer []byte
+	if tag, body, err = readCaptureField(body); err != nil {
+		return rec, err
+	}
+	if peer, body, err = readCaptureField(body); err != nil {
+		return rec, err
+	}
+	rec.Tag = protocol.Tag(tag)
+	rec.Peer = string(peer)
+	rec.Data = body
+	return rec, nil
+}
+
+func readCaptureField(body []byte) (field, rest []byte, err error) {
+	length, n := binary.Uvarint(body)
+	if n <= 0 || length > uint64(len(body)-n) {
+		return nil, nil, ErrCorruptedCapture
+	}
+	return body[n : n+int(length)], body[n+int(length):], nil
+}
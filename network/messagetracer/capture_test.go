@@ -0,0 +1,121 @@
+// Copyright (C) 2019-2025 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package messagetracer
+
+import (
+	"bytes"
+	"errors"
+	"io"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/algorand/go-algorand/logging"
+	"github.com/algorand/go-algorand/protocol"
+	"github.com/algorand/go-algorand/test/partitiontest"
+)
+
+func TestCaptureRoundTrip(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	var buf bytes.Buffer
+	c := MakeCapture(&buf, logging.TestingLog(t))
+	before := time.Now()
+	c.Record(Incoming, protocol.AgreementVoteTag, "1.2.3.4:4160", []byte("vote"))
+	c.Record(Outgoing, protocol.TxnTag, "", nil)
+
+	r := MakeCaptureReader(&buf)
+	rec, err := r.Next()
+	require.NoError(t, err)
+	require.Equal(t, Incoming, rec.Direction)
+	require.Equal(t, protocol.AgreementVoteTag, rec.Tag)
+	require.Equal(t, "1.2.3.4:4160", rec.Peer)
+	require.Equal(t, []byte("vote"), rec.Data)
+	require.False(t, rec.Timestamp.Before(before.Truncate(time.Nanosecond)))
+
+	rec, err = r.Next()
+	require.NoError(t, err)
+	require.Equal(t, Outgoing, rec.Direction)
+	require.Equal(t, protocol.TxnTag, rec.Tag)
+	require.Empty(t, rec.Peer)
+	require.Empty(t, rec.Data)
+
+	_, err = r.Next()
+	require.Equal(t, io.EOF, err)
+}
+
+func TestCaptureCorrupted(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	rec := encodeCaptureRecord(CaptureRecord{Direction: Incoming, Timestamp: time.Now(), Tag: protocol.TxnTag, Peer: "peer", Data: []byte("data")})
+
+	// truncated record
+	_, err := MakeCaptureReader(bytes.NewReader(rec[:len(rec)-1])).Next()
+	require.ErrorIs(t, err, ErrCorruptedCapture)
+
+	// field longer than the record
+	bad := bytes.Clone(rec)
+	bad[1+1+8] = 100
+	_, err = MakeCaptureReader(bytes.NewReader(bad)).Next()
+	require.ErrorIs(t, err, ErrCorruptedCapture)
+
+	// record too large
+	_, err = MakeCaptureReader(bytes.NewReader([]byte{0xff, 0xff, 0xff, 0xff, 0x7f})).Next()
+	require.ErrorIs(t, err, ErrCorruptedCapture)
+}
+
+type failingWriter struct {
+	writes int
+}
+
+func (w *failingWriter) Write(p []byte) (int, error) {
+	w.writes++
+	return 0, errors.New("disk full")
+}
+
+func TestCaptureRotation(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	dir := t.TempDir()
+	w := logging.MakeCyclicFileWriter(filepath.Join(dir, "network.capture"), filepath.Join(dir, "network.archive.capture"), 100, 0)
+	c := MakeCapture(w, logging.TestingLog(t))
+	for i := 0; i < 3; i++ {
+		c.Record(Incoming, protocol.TxnTag, "peer", make([]byte, 40))
+	}
+
+	// records are never split across files
+	for _, name := range []string{"network.archive.capture", "network.capture"} {
+		f, err := os.Open(filepath.Join(dir, name))
+		require.NoError(t, err)
+		r := MakeCaptureReader(f)
+		_, err = r.Next()
+		require.NoError(t, err)
+		_, err = r.Next()
+		require.Equal(t, io.EOF, err)
+		f.Close()
+	}
+
+	// write errors do not interrupt the capture
+	fw := &failingWriter{}
+	c = MakeCapture(fw, logging.TestingLog(t))
+	c.Record(Incoming, protocol.TxnTag, "peer", nil)
+	c.Record(Incoming, protocol.TxnTag, "peer", nil)
+	require.Equal(t, 2, fw.writes)
+}
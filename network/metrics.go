@@ -97,6 +97,9 @@ var networkPeerIdentityError = metrics.MakeCounter(metrics.MetricName{Name: "alg
 var networkPeerAlreadyClosed = metrics.MakeCounter(metrics.MetricName{Name: "algod_network_peer_already_closed", Description: "number of times a peer would be added but the peer connection is already closed"})
 
 var networkSlowPeerDrops = metrics.MakeCounter(metrics.MetricName{Name: "algod_network_slow_drops_total", Description: "number of peers dropped for being slow to send to"})
+
+var networkVoteCompressionInputBytesTotal = metrics.MakeCounter(metrics.MetricName{Name: "algod_network_vote_compression_input_bytes_total", Description: "Total bytes of the votes sent to peers supporting vote compression, before compression"})
+var networkVoteCompressionOutputBytesTotal = metrics.MakeCounter(metrics.MetricName{Name: "algod_network_vote_compression_output_bytes_total", Description: "Total bytes of the votes sent to peers supporting vote compression, after compression"})
 var networkIdlePeerDrops = metrics.MakeCounter(metrics.MetricName{Name: "algod_network_idle_drops_total", Description: "number of peers dropped due to idle connection"})
 
 var peers = metrics.MakeGauge(metrics.MetricName{Name: "algod_network_peers", Description: "Number of active peers."})
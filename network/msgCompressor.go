@@ -18,10 +18,14 @@ package network
 
 import (
 	"bytes"
+	"container/list"
+	"encoding/binary"
+	"errors"
 	"fmt"
 	"io"
 
 	"github.com/DataDog/zstd"
+	"github.com/algorand/msgp/msgp"
 
 	"github.com/algorand/go-algorand/logging"
 	"github.com/algorand/go-algorand/protocol"
@@ -58,13 +62,15 @@ func zstdCompressMsg(tbytes []byte, d []byte) ([]byte, string) {
 const MaxDecompressedMessageSize = 20 * 1024 * 1024 // some large enough value
 
 // wsPeerMsgDataConverter performs optional incoming messages conversion.
-// At the moment it only supports zstd decompression for payload proposal
+// It supports zstd decompression for payload proposal, and the stateful decompression of agreement votes
 type wsPeerMsgDataConverter struct {
 	log    logging.Logger
 	origin string
 
 	// actual converter(s)
 	ppdec zstdProposalDecompressor
+	// avdec is created once the peer sends its first compressed vote
+	avdec *voteDecompressor
 }
 
 type zstdProposalDecompressor struct{}
@@ -109,6 +115,17 @@ func (c *wsPeerMsgDataConverter) convert(tag protocol.Tag, data []byte) ([]byte,
 		}
 		c.log.Warnf("peer %s supported zstd but sent non-compressed data", c.origin)
 	}
+	if tag == protocol.AgreementVoteTag && len(data) > 0 && data[0] == voteCompressedMarker {
+		// votes that could not be compressed are sent as is, and do not alter the dictionary
+		if c.avdec == nil {
+			c.avdec = makeVoteDecompressor()
+		}
+		res, err := c.avdec.convert(data)
+		if err != nil {
+			return nil, fmt.Errorf("peer %s: %w", c.origin, err)
+		}
+		return res, nil
+	}
 	return data, nil
 }
 
@@ -121,3 +138,309 @@ func makeWsPeerMsgDataConverter(wp *wsPeer) *wsPeerMsgDataConverter {
 	c.ppdec = zstdProposalDecompressor{}
 	return &c
 }
+
+// voteCompressedMarker prefixes agreement votes compressed by the voteCompressor. Plain votes are
+// msgpack encoded maps, and the marker is a msgpack type byte which is never used, making it
+// unambiguous. Within a compressed vote, the marker also replaces a dictionary value, and is then
+// followed by the uvarint encoded dictionary slot holding it.
+const voteCompressedMarker = 0xc1
+
+// voteDictionarySize is the number of values held by the dictionary of each direction of a connection.
+// It is large enough to retain the sender and keys of every voter of a round across the steps of the round.
+const voteDictionarySize = 2048
+
+// voteDictionaryMinValueSize and voteDictionaryMaxValueSize bound the size of the msgpack encoded
+// values kept in the dictionary; shorter values would not be shortened by a reference.
+const (
+	voteDictionaryMinValueSize = 4
+	voteDictionaryMaxValueSize = 80
+)
+
+// maxVoteMsgDepth bounds the nesting of the msgpack maps and arrays of a vote.
+const maxVoteMsgDepth = 8
+
+// voteDictionaryFields are the vote fields whose values are looked up in the dictionary: the sender,
+// the round, the proposal value, and the participation key credentials, all of which are shared by
+// the many votes of a round, or by the votes of a single sender across the steps of a round.
+// The VRF proof and the signature itself are unique to each vote, and are always sent as is.
+var voteDictionaryFields = map[string]bool{
+	"snd":    true,
+	"rnd":    true,
+	"dig":    true,
+	"encdig": true,
+	"oprop":  true,
+	"p":      true,
+	"p1s":    true,
+	"p2":     true,
+	"p2s":    true,
+}
+
+var errVoteDictionaryRef = errors.New("invalid vote dictionary reference")
+
+// voteDictionary is a fixed size dictionary of msgpack encoded values, evicting the least recently
+// used value once full. The compressor and the decompressor of a connection direction apply the exact
+// same operations to their dictionary, in the same order, so that the slots held by both match.
+type voteDictionary struct {
+	slots  []voteDictionaryEntry
+	lookup map[string]int
+	// lru holds the slots, the most recently used first
+	lru *list.List
+}
+
+type voteDictionaryEntry struct {
+	value string
+	elem  *list.Element
+}
+
+func makeVoteDictionary() *voteDictionary {
+	return &voteDictionary{
+		lookup: make(map[string]int),
+		lru:    list.New(),
+	}
+}
+
+// find returns the slot of the given value, marking it as the most recently used
+func (d *voteDictionary) find(value []byte) (int, bool) {
+	slot, ok := d.lookup[string(value)]
+	if ok {
+		d.lru.MoveToFront(d.slots[slot].elem)
+	}
+	return slot, ok
+}
+
+// get returns the value held by the given slot, marking it as the most recently used
+func (d *voteDictionary) get(slot uint64) (string, error) {
+	if slot >= uint64(len(d.slots)) {
+		return "", errVoteDictionaryRef
+	}
+	d.lru.MoveToFront(d.slots[slot].elem)
+	return d.slots[slot].value, nil
+}
+
+// add adds a value to the dictionary, evicting the least recently used one if it is full
+func (d *voteDictionary) add(value []byte) {
+	if _, ok := d.find(value); ok {
+		return
+	}
+	if len(d.slots) < voteDictionarySize {
+		slot := len(d.slots)
+		d.slots = append(d.slots, voteDictionaryEntry{value: string(value), elem: d.lru.PushFront(slot)})
+		d.lookup[d.slots[slot].value] = slot
+		return
+	}
+	elem := d.lru.Back()
+	slot := elem.Value.(int)
+	delete(d.lookup, d.slots[slot].value)
+	d.slots[slot].value = string(value)
+	d.lookup[d.slots[slot].value] = slot
+	d.lru.MoveToFront(elem)
+}
+
+// isVoteDictionaryValue tells whether the msgpack encoded value is held in the dictionary when found in a dictionary field
+func isVoteDictionaryValue(raw []byte) bool {
+	if len(raw) < voteDictionaryMinValueSize || len(raw) > voteDictionaryMaxValueSize {
+		return false
+	}
+	switch msgp.NextType(raw) {
+	case msgp.StrType, msgp.BinType, msgp.UintType, msgp.IntType:
+		return true
+	}
+	return false
+}
+
+// voteMsgSpan is the position of a dictionary value within a msgpack encoded vote
+type voteMsgSpan struct {
+	start, end int
+}
+
+// voteMsgSpans walks the msgpack encoded vote, and returns the positions of the values of the dictionary fields
+func voteMsgSpans(msg []byte) ([]voteMsgSpan, error) {
+	var spans []voteMsgSpan
+	var walk func(b []byte, depth int, dictField bool) ([]byte, error)
+	walk = func(b []byte, depth int, dictField bool) ([]byte, error) {
+		if depth > maxVoteMsgDepth {
+			return nil, fmt.Errorf("vote nested too deep")
+		}
+		switch msgp.NextType(b) {
+		case msgp.MapType:
+			sz, _, rest, err := msgp.ReadMapHeaderBytes(b)
+			if err != nil {
+				return nil, err
+			}
+			for i := 0; i < sz; i++ {
+				key, keyRest, err := msgp.ReadStringZC(rest)
+				if err != nil {
+					return nil, err
+				}
+				rest, err = walk(keyRest, depth+1, voteDictionaryFields[string(key)])
+				if err != nil {
+					return nil, err
+				}
+			}
+			return rest, nil
+		case msgp.ArrayType:
+			sz, _, rest, err := msgp.ReadArrayHeaderBytes(b)
+			if err != nil {
+				return nil, err
+			}
+			for i := 0; i < sz; i++ {
+				rest, err = walk(rest, depth+1, false)
+				if err != nil {
+					return nil, err
+				}
+			}
+			return rest, nil
+		}
+		rest, err := msgp.Skip(b)
+		if err != nil {
+			return nil, err
+		}
+		raw := b[:len(b)-len(rest)]
+		if dictField && isVoteDictionaryValue(raw) {
+			start := len(msg) - len(b)
+			spans = append(spans, voteMsgSpan{start: start, end: start + len(raw)})
+		}
+		return rest, nil
+	}
+	rest, err := walk(msg, 0, false)
+	if err != nil {
+		return nil, err
+	}
+	if len(rest) != 0 {
+		return nil, fmt.Errorf("%d trailing bytes after vote", len(rest))
+	}
+	return spans, nil
+}
+
+// voteCompressor compresses the agreement votes sent over a single connection, replacing the values
+// already sent over the connection with references into a dictionary of recently sent values.
+// It must only be used by the connection's write loop, so that the votes are compressed in the order
+// they are sent.
+type voteCompressor struct {
+	dict *voteDictionary
+}
+
+func makeVoteCompressor() *voteCompressor {
+	return &voteCompressor{dict: makeVoteDictionary()}
+}
+
+// compress returns the tagged vote compressed. A vote which cannot be parsed is returned as is,
+// leaving the dictionary untouched.
+func (c *voteCompressor) compress(tagged []byte) []byte {
+	tbytes, vote := tagged[:protocol.TagLength], tagged[protocol.TagLength:]
+	spans, err := voteMsgSpans(vote)
+	if err != nil {
+		return tagged
+	}
+	out := make([]byte, 0, len(tagged))
+	out = append(out, tbytes...)
+	out = append(out, voteCompressedMarker)
+	pos := 0
+	for _, span := range spans {
+		out = append(out, vote[pos:span.start]...)
+		value := vote[span.start:span.end]
+		if slot, ok := c.dict.find(value); ok {
+			out = append(out, voteCompressedMarker)
+			out = binary.AppendUvarint(out, uint64(slot))
+		} else {
+			out = append(out, value...)
+			c.dict.add(value)
+		}
+		pos = span.end
+	}
+	return append(out, vote[pos:]...)
+}
+
+// voteDecompressor restores the agreement votes compressed by the voteCompressor of the sending end
+// of a connection. It must only be used by the connection's read loop.
+type voteDecompressor struct {
+	dict *voteDictionary
+}
+
+func makeVoteDecompressor() *voteDecompressor {
+	return &voteDecompressor{dict: makeVoteDictionary()}
+}
+
+// convert decompresses a vote, which must start with the voteCompressedMarker
+func (dec *voteDecompressor) convert(data []byte) ([]byte, error) {
+	out := make([]byte, 0, 2*len(data))
+	var walk func(b []byte, depth int, dictField bool) ([]byte, error)
+	walk = func(b []byte, depth int, dictField bool) ([]byte, error) {
+		if depth > maxVoteMsgDepth {
+			return nil, fmt.Errorf("vote nested too deep")
+		}
+		if len(out) > protocol.AgreementVoteTagMaxSize {
+			return nil, fmt.Errorf("vote data is too large: %d", len(out))
+		}
+		if dictField && len(b) > 0 && b[0] == voteCompressedMarker {
+			slot, n := binary.Uvarint(b[1:])
+			if n <= 0 {
+				return nil, errVoteDictionaryRef
+			}
+			value, err := dec.dict.get(slot)
+			if err != nil {
+				return nil, err
+			}
+			out = append(out, value...)
+			return b[1+n:], nil
+		}
+		var rest []byte
+		var err error
+		switch msgp.NextType(b) {
+		case msgp.MapType:
+			var sz int
+			sz, _, rest, err = msgp.ReadMapHeaderBytes(b)
+			if err != nil {
+				return nil, err
+			}
+			out = append(out, b[:len(b)-len(rest)]...)
+			for i := 0; i < sz; i++ {
+				key, keyRest, err := msgp.ReadStringZC(rest)
+				if err != nil {
+					return nil, err
+				}
+				out = append(out, rest[:len(rest)-len(keyRest)]...)
+				rest, err = walk(keyRest, depth+1, voteDictionaryFields[string(key)])
+				if err != nil {
+					return nil, err
+				}
+			}
+			return rest, nil
+		case msgp.ArrayType:
+			var sz int
+			sz, _, rest, err = msgp.ReadArrayHeaderBytes(b)
+			if err != nil {
+				return nil, err
+			}
+			out = append(out, b[:len(b)-len(rest)]...)
+			for i := 0; i < sz; i++ {
+				rest, err = walk(rest, depth+1, false)
+				if err != nil {
+					return nil, err
+				}
+			}
+			return rest, nil
+		}
+		rest, err = msgp.Skip(b)
+		if err != nil {
+			return nil, err
+		}
+		raw := b[:len(b)-len(rest)]
+		out = append(out, raw...)
+		if dictField && isVoteDictionaryValue(raw) {
+			dec.dict.add(raw)
+		}
+		return rest, nil
+	}
+	rest, err := walk(data[1:], 0, false)
+	if err != nil {
+		return nil, err
+	}
+	if len(rest) != 0 {
+		return nil, fmt.Errorf("%d trailing bytes after vote", len(rest))
+	}
+	if len(out) > protocol.AgreementVoteTagMaxSize {
+		return nil, fmt.Errorf("vote data is too large: %d", len(out))
+	}
+	return out, nil
+}
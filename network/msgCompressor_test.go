@@ -17,10 +17,15 @@
 package network
 
 import (
+	"crypto/rand"
+	"encoding/binary"
+	"net"
 	"strings"
 	"testing"
 
 	"github.com/DataDog/zstd"
+	"github.com/algorand/msgp/msgp"
+
 	"github.com/algorand/go-algorand/logging"
 	"github.com/algorand/go-algorand/protocol"
 	"github.com/algorand/go-algorand/test/partitiontest"
@@ -105,3 +110,208 @@ func TestWsPeerMsgDataConverterConvert(t *testing.T) {
 	require.Equal(t, data, r)
 	require.Equal(t, 0, l.warnMsgCount)
 }
+
+// testVoter holds the values shared by the votes of a single sender within a round
+type testVoter struct {
+	sender          []byte
+	p, p1s, p2, p2s []byte
+}
+
+func randBytes(n int) []byte {
+	b := make([]byte, n)
+	rand.Read(b)
+	return b
+}
+
+func makeTestVoter() testVoter {
+	return testVoter{sender: randBytes(32), p: randBytes(32), p1s: randBytes(64), p2: randBytes(32), p2s: randBytes(64)}
+}
+
+// makeTestVote returns a msgpack encoded vote, laid out as agreement.unauthenticatedVote
+func makeTestVote(voter testVoter, rnd uint64, step uint64, proposal []byte) []byte {
+	b := msgp.AppendMapHeader(nil, 3)
+	b = msgp.AppendString(b, "cred")
+	b = msgp.AppendMapHeader(b, 1)
+	b = msgp.AppendString(b, "pf")
+	b = msgp.AppendBytes(b, randBytes(80))
+	b = msgp.AppendString(b, "r")
+	b = msgp.AppendMapHeader(b, 4)
+	b = msgp.AppendString(b, "prop")
+	b = msgp.AppendMapHeader(b, 2)
+	b = msgp.AppendString(b, "dig")
+	b = msgp.AppendBytes(b, proposal)
+	b = msgp.AppendString(b, "oprop")
+	b = msgp.AppendBytes(b, voter.sender)
+	b = msgp.AppendString(b, "rnd")
+	b = msgp.AppendUint64(b, rnd)
+	b = msgp.AppendString(b, "snd")
+	b = msgp.AppendBytes(b, voter.sender)
+	b = msgp.AppendString(b, "step")
+	b = msgp.AppendUint64(b, step)
+	b = msgp.AppendString(b, "sig")
+	b = msgp.AppendMapHeader(b, 5)
+	b = msgp.AppendString(b, "p")
+	b = msgp.AppendBytes(b, voter.p)
+	b = msgp.AppendString(b, "p1s")
+	b = msgp.AppendBytes(b, voter.p1s)
+	b = msgp.AppendString(b, "p2")
+	b = msgp.AppendBytes(b, voter.p2)
+	b = msgp.AppendString(b, "p2s")
+	b = msgp.AppendBytes(b, voter.p2s)
+	b = msgp.AppendString(b, "s")
+	b = msgp.AppendBytes(b, randBytes(64))
+	return b
+}
+
+func TestVoteCompressorRoundTrip(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	comp := makeVoteCompressor()
+	dec := makeVoteDecompressor()
+	tbytes := []byte(protocol.AgreementVoteTag)
+
+	voters := make([]testVoter, 50)
+	for i := range voters {
+		voters[i] = makeTestVoter()
+	}
+	var inputBytes, outputBytes int
+	for rnd := uint64(1000); rnd < 1003; rnd++ {
+		proposal := randBytes(32)
+		for step := uint64(0); step < 3; step++ {
+			for _, voter := range voters {
+				vote := makeTestVote(voter, rnd, step, proposal)
+				compressed := comp.compress(append(append([]byte{}, tbytes...), vote...))
+				require.Equal(t, tbytes, compressed[:len(tbytes)])
+				require.Equal(t, byte(voteCompressedMarker), compressed[len(tbytes)])
+				decompressed, err := dec.convert(compressed[len(tbytes):])
+				require.NoError(t, err)
+				require.Equal(t, vote, decompressed)
+
+				if step > 0 {
+					inputBytes += len(vote)
+					outputBytes += len(compressed) - len(tbytes)
+				}
+			}
+		}
+	}
+	// once a voter has been seen in a round, only the VRF proof and the signature remain
+	require.Less(t, outputBytes, inputBytes*2/3)
+
+	// votes which are not valid msgpack are sent as is
+	tagged := append(append([]byte{}, tbytes...), 0xc1, 0x01)
+	require.Equal(t, tagged, comp.compress(tagged))
+	tagged = append(append([]byte{}, tbytes...), makeTestVote(voters[0], 1, 1, randBytes(32))[:100]...)
+	require.Equal(t, tagged, comp.compress(tagged))
+
+	// and the dictionaries are still in sync
+	vote := makeTestVote(voters[0], 1002, 3, randBytes(32))
+	compressed := comp.compress(append(append([]byte{}, tbytes...), vote...))
+	decompressed, err := dec.convert(compressed[len(tbytes):])
+	require.NoError(t, err)
+	require.Equal(t, vote, decompressed)
+}
+
+func TestVoteCompressorEviction(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	comp := makeVoteCompressor()
+	dec := makeVoteDecompressor()
+	tbytes := []byte(protocol.AgreementVoteTag)
+
+	proposal := randBytes(32)
+	recent := makeTestVoter()
+	// each voter adds 5 values to the dictionary, so that it is fully recycled several times,
+	// while the values of the recent voter and the round and proposal are kept in use.
+	for i := 0; i < 3*voteDictionarySize/5; i++ {
+		for _, voter := range []testVoter{makeTestVoter(), recent} {
+			vote := makeTestVote(voter, 7, uint64(i), proposal)
+			compressed := comp.compress(append(append([]byte{}, tbytes...), vote...))
+			decompressed, err := dec.convert(compressed[len(tbytes):])
+			require.NoError(t, err)
+			require.Equal(t, vote, decompressed)
+		}
+	}
+	require.Len(t, comp.dict.slots, voteDictionarySize)
+	require.Len(t, comp.dict.lookup, voteDictionarySize)
+	require.Equal(t, voteDictionarySize, comp.dict.lru.Len())
+	for slot, entry := range comp.dict.slots {
+		require.Equal(t, entry.value, dec.dict.slots[slot].value)
+	}
+	_, ok := comp.dict.lookup[string(msgp.AppendBytes(nil, recent.p2s))]
+	require.True(t, ok)
+}
+
+func TestVoteDecompressorErrors(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	vote := makeTestVote(makeTestVoter(), 1<<40, 1, randBytes(32))
+
+	// a reference to a slot not yet filled, in place of the round
+	dec := makeVoteDecompressor()
+	compressed := makeVoteCompressor().compress(append([]byte(protocol.AgreementVoteTag), vote...))[len(protocol.AgreementVoteTag):]
+	rnd := msgp.AppendString(nil, "rnd")
+	pos := strings.Index(string(compressed), string(rnd)) + len(rnd)
+	require.Equal(t, msgp.AppendUint64(nil, 1<<40), compressed[pos:pos+9])
+	bad := append(append(append([]byte{}, compressed[:pos]...), voteCompressedMarker), binary.AppendUvarint(nil, 100)...)
+	bad = append(bad, compressed[pos+9:]...)
+	_, err := dec.convert(bad)
+	require.ErrorIs(t, err, errVoteDictionaryRef)
+
+	// truncated and trailing data
+	_, err = makeVoteDecompressor().convert(compressed[:len(compressed)-1])
+	require.Error(t, err)
+	_, err = makeVoteDecompressor().convert(append(append([]byte{}, compressed...), 0))
+	require.ErrorContains(t, err, "trailing")
+
+	// too deeply nested
+	nested := []byte{voteCompressedMarker}
+	for i := 0; i <= maxVoteMsgDepth; i++ {
+		nested = msgp.AppendArrayHeader(nested, 1)
+	}
+	nested = msgp.AppendNil(nested)
+	_, err = makeVoteDecompressor().convert(nested)
+	require.ErrorContains(t, err, "nested")
+
+	// the converter decompresses votes carrying the marker only
+	c := wsPeerMsgDataConverter{}
+	r, err := c.convert(protocol.AgreementVoteTag, vote)
+	require.NoError(t, err)
+	require.Equal(t, vote, r)
+	require.Nil(t, c.avdec)
+	r, err = c.convert(protocol.AgreementVoteTag, compressed)
+	require.NoError(t, err)
+	require.Equal(t, vote, r)
+	_, err = c.convert(protocol.AgreementVoteTag, bad)
+	require.Error(t, err)
+}
+
+func TestExchangePeerFeatures(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	dialer, listener := net.Pipe()
+	defer dialer.Close()
+	defer listener.Close()
+
+	type result struct {
+		features peerFeatureFlag
+		err      error
+	}
+	incoming := make(chan result, 1)
+	go func() {
+		f, err := exchangePeerFeatures(listener, PeerFeatureProposalCompression, true)
+		incoming <- result{f, err}
+	}()
+	f, err := exchangePeerFeatures(dialer, PeerFeatureProposalCompression+","+PeerFeatureVoteCompression, false)
+	require.NoError(t, err)
+	require.Equal(t, pfCompressedProposal, f)
+	res := <-incoming
+	require.NoError(t, res.err)
+	require.Equal(t, pfCompressedProposal|pfCompressedVotes, res.features)
+
+	_, err = exchangePeerFeatures(dialer, strings.Repeat("x", 256), false)
+	require.Error(t, err)
+}
@@ -0,0 +1,270 @@
+// Copyright (C) 2019-2025 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package network
+
+import (
+	"bytes"
+	"encoding/binary"
+	"fmt"
+	"io"
+	"net"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/algorand/go-deadlock"
+	"github.com/algorand/websocket"
+
+	"github.com/algorand/go-algorand/protocol"
+)
+
+// streamClass identifies the stream of a multiStreamConn a message is sent on.
+//
+//msgp:ignore streamClass
+type streamClass byte
+
+const (
+	// streamClassControl carries the handshake, and the messages of no other class: network control messages,
+	// requests and responses. It also carries the messages of the classes which stream is not opened yet.
+	streamClassControl streamClass = iota
+	// streamClassAgreement carries the agreement votes and bundles, which are small and urgent
+	streamClassAgreement
+	// streamClassProposal carries the proposal payloads, which are large
+	streamClassProposal
+	// streamClassTxn carries the transactions
+	streamClassTxn
+
+	numStreamClasses
+)
+
+// tagStreamClass returns the class of the stream the messages with tag are sent on.
+func tagStreamClass(tag protocol.Tag) streamClass {
+	switch tag {
+	case protocol.AgreementVoteTag, protocol.VoteBundleTag:
+		return streamClassAgreement
+	case protocol.ProposalPayloadTag:
+		return streamClassProposal
+	case protocol.TxnTag, protocol.TxnReconciliationTag:
+		return streamClassTxn
+	}
+	return streamClassControl
+}
+
+// multiStreamQueueSize is the number of messages queued for each stream of a multiStreamConn
+// before WriteMessage blocks.
+const multiStreamQueueSize = 64
+
+// multiStreamConn implements wsPeerWebsocketConn over one stream for every streamClass, so that the
+// messages of a class are never delayed behind the messages of another class, as they are on a
+// single websocket connection: a vote is sent while a proposal payload is still being written.
+// The messages are framed the same way as on wsPeerConnP2P, and are only ordered within a class.
+type multiStreamConn struct {
+	remoteAddr       net.Addr
+	remoteAddrString string
+	// closeConn closes the connection the streams belong to, if the streams are not closed individually
+	closeConn func() error
+
+	streams     [numStreamClasses]*multiStreamQueue
+	streamsLock deadlock.RWMutex
+
+	incoming  chan []byte
+	readLimit atomic.Int64
+
+	closed    chan struct{}
+	closeOnce sync.Once
+	// err is the reason the connection was closed; it is set before closed is closed.
+	err error
+}
+
+// multiStreamQueue holds the messages to be written on a stream.
+type multiStreamQueue struct {
+	messages chan []byte
+	closer   io.Closer
+}
+
+func makeMultiStreamConn(remoteAddr net.Addr, remoteAddrString string, closeConn func() error) *multiStreamConn {
+	c := &multiStreamConn{
+		remoteAddr:       remoteAddr,
+		remoteAddrString: remoteAddrString,
+		closeConn:        closeConn,
+		incoming:         make(chan []byte, numStreamClasses),
+		closed:           make(chan struct{}),
+	}
+	c.readLimit.Store(MaxMessageLength)
+	return c
+}
+
+// attach starts reading the messages of the stream of class from r, and writing its messages to w.
+// closer, if not nil, is closed with the connection. A class can only be attached once, and the
+// control stream must be attached before the connection is used.
+func (c *multiStreamConn) attach(class streamClass, r io.Reader, w io.Writer, closer io.Closer) error {
+	if class >= numStreamClasses {
+		return fmt.Errorf("invalid stream class %d", class)
+	}
+	c.streamsLock.Lock()
+	defer c.streamsLock.Unlock()
+	if c.streams[class] != nil {
+		return fmt.Errorf("stream class %d is already attached", class)
+	}
+	select {
+	case <-c.closed:
+		return c.err
+	default:
+	}
+	q := &multiStreamQueue{messages: make(chan []byte, multiStreamQueueSize), closer: closer}
+	c.streams[class] = q
+	go c.readStream(r)
+	go c.writeStream(q, w)
+	return nil
+}
+
+// attached returns the number of streams attached.
+func (c *multiStreamConn) attached() int {
+	c.streamsLock.RLock()
+	defer c.streamsLock.RUnlock()
+	n := 0
+	for _, q := range c.streams {
+		if q != nil {
+			n++
+		}
+	}
+	return n
+}
+
+// fail closes the connection for err, unless it was already closed.
+func (c *multiStreamConn) fail(err error) {
+	c.closeOnce.Do(func() {
+		c.err = err
+		close(c.closed)
+		if c.closeConn != nil {
+			c.closeConn()
+		}
+		c.streamsLock.RLock()
+		defer c.streamsLock.RUnlock()
+		for _, q := range c.streams {
+			if q != nil && q.closer != nil {
+				q.closer.Close()
+			}
+		}
+	})
+}
+
+func (c *multiStreamConn) readStream(r io.Reader) {
+	for {
+		var lenbuf [4]byte
+		if _, err := io.ReadFull(r, lenbuf[:]); err != nil {
+			c.fail(err)
+			return
+		}
+		msglen := binary.BigEndian.Uint32(lenbuf[:])
+		if int64(msglen) > c.readLimit.Load() {
+			c.fail(fmt.Errorf("message too long: %d", msglen))
+			return
+		}
+		msg := make([]byte, msglen)
+		if _, err := io.ReadFull(r, msg); err != nil {
+			c.fail(err)
+			return
+		}
+		select {
+		case c.incoming <- msg:
+		case <-c.closed:
+			return
+		}
+	}
+}
+
+func (c *multiStreamConn) writeStream(q *multiStreamQueue, w io.Writer) {
+	for {
+		select {
+		case msg := <-q.messages:
+			var lenbuf [4]byte
+			binary.BigEndian.PutUint32(lenbuf[:], uint32(len(msg)))
+			if _, err := w.Write(lenbuf[:]); err != nil {
+				c.fail(err)
+				return
+			}
+			if _, err := w.Write(msg); err != nil {
+				c.fail(err)
+				return
+			}
+		case <-c.closed:
+			return
+		}
+	}
+}
+
+func (c *multiStreamConn) RemoteAddr() net.Addr {
+	return c.remoteAddr
+}
+
+func (c *multiStreamConn) RemoteAddrString() string {
+	return c.remoteAddrString
+}
+
+// NextReader returns the next message received on any of the streams.
+func (c *multiStreamConn) NextReader() (int, io.Reader, error) {
+	select {
+	case msg := <-c.incoming:
+		return websocket.BinaryMessage, bytes.NewReader(msg), nil
+	case <-c.closed:
+		return 0, nil, c.err
+	}
+}
+
+// WriteMessage queues buf on the stream of its class. It only blocks when the queue of the stream is full.
+func (c *multiStreamConn) WriteMessage(_ int, buf []byte) error {
+	// the select below picks randomly among the ready cases, so a closed connection with room in
+	// its queue would otherwise accept the message.
+	select {
+	case <-c.closed:
+		return c.err
+	default:
+	}
+	class := streamClassControl
+	if len(buf) >= protocol.TagLength {
+		class = tagStreamClass(protocol.Tag(buf[:protocol.TagLength]))
+	}
+	c.streamsLock.RLock()
+	q := c.streams[class]
+	if q == nil {
+		q = c.streams[streamClassControl]
+	}
+	c.streamsLock.RUnlock()
+	select {
+	case q.messages <- buf:
+		return nil
+	case <-c.closed:
+		return c.err
+	}
+}
+
+// CloseWithMessage does nothing, since closing the connection is enough to let the peer know.
+func (c *multiStreamConn) CloseWithMessage([]byte, time.Time) error {
+	return nil
+}
+
+func (c *multiStreamConn) SetReadLimit(limit int64) {
+	c.readLimit.Store(limit)
+}
+
+func (c *multiStreamConn) CloseWithoutFlush() error {
+	c.fail(net.ErrClosed)
+	return nil
+}
+
+func (c *multiStreamConn) UnderlyingConn() net.Conn { return nil }
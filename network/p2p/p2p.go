@@ -44,6 +44,7 @@ import (
 	rcmgr "github.com/libp2p/go-libp2p/p2p/host/resource-manager"
 	"github.com/libp2p/go-libp2p/p2p/muxer/yamux"
 	"github.com/libp2p/go-libp2p/p2p/security/noise"
+	libp2pquic "github.com/libp2p/go-libp2p/p2p/transport/quic"
 	"github.com/libp2p/go-libp2p/p2p/transport/tcp"
 	"github.com/multiformats/go-multiaddr"
 	manet "github.com/multiformats/go-multiaddr/net"
@@ -64,6 +65,7 @@ type Service interface {
 	AddrInfo() peer.AddrInfo // return addrInfo for self
 
 	DialPeersUntilTargetCount(targetConnCount int)
+	DialNode(ctx context.Context, peer *peer.AddrInfo) error
 	ClosePeer(peer.ID) error
 
 	Conns() []network.Conn
@@ -79,6 +81,7 @@ type Service interface {
 type serviceImpl struct {
 	log        logging.Logger
 	listenAddr string
+	enableQUIC bool
 	host       host.Host
 	streams    *streamManager
 	pubsub     *pubsub.PubSub
@@ -92,6 +95,14 @@ type serviceImpl struct {
 // AlgorandWsProtocol defines a libp2p protocol name for algorand's websockets messages
 const AlgorandWsProtocol = "/algorand-ws/1.0.0"
 
+// AlgorandWsProtocolV11 defines a libp2p protocol name for algorand's websockets messages, where the
+// peers exchange the features they support once the stream is opened
+const AlgorandWsProtocolV11 = "/algorand-ws/1.1.0"
+
+// AlgorandWsClassProtocol defines a libp2p protocol name for the additional streams of an AlgorandWsProtocolV11
+// stream over QUIC, each carrying a class of messages. The class is the first byte of the stream.
+const AlgorandWsClassProtocol = "/algorand-ws-class/1.0.0"
+
 // algorandGUIDProtocolPrefix defines a libp2p protocol name for algorand node telemetry GUID exchange
 const algorandGUIDProtocolPrefix = "/algorand-telemetry/1.0.0/"
 const algorandGUIDProtocolTemplate = algorandGUIDProtocolPrefix + "%s/%s"
@@ -148,10 +159,15 @@ func MakeHost(cfg config.Local, datadir string, pstore *pstore.PeerStore) (host.
 		return nil, "", err
 	}
 
+	transports := libp2p.Transport(tcp.NewTCPTransport)
+	if cfg.EnableQUIC {
+		transports = libp2p.ChainOptions(transports, libp2p.Transport(libp2pquic.NewTransport))
+	}
+
 	host, err := libp2p.New(
 		libp2p.Identity(privKey),
 		libp2p.UserAgent(ua),
-		libp2p.Transport(tcp.NewTCPTransport),
+		transports,
 		libp2p.Muxer("/yamux/1.0.0", &ymx),
 		libp2p.Peerstore(pstore),
 		libp2p.NoListenAddrs,
@@ -186,6 +202,10 @@ func MakeService(ctx context.Context, log logging.Logger, cfg config.Local, h ho
 	sm := makeStreamManager(ctx, log, h, wsStreamHandler, cfg.EnableGossipService)
 	h.Network().Notify(sm)
 	h.SetStreamHandler(AlgorandWsProtocol, sm.streamHandler)
+	h.SetStreamHandler(AlgorandWsProtocolV11, sm.streamHandler)
+	if cfg.EnableQUIC {
+		h.SetStreamHandler(AlgorandWsClassProtocol, sm.classStreamHandler)
+	}
 
 	// set an empty handler for telemetryID/telemetryInstance protocol in order to allow other peers to know our telemetryID
 	telemetryID := log.GetTelemetryGUID()
@@ -200,6 +220,7 @@ func MakeService(ctx context.Context, log logging.Logger, cfg config.Local, h ho
 	return &serviceImpl{
 		log:        log,
 		listenAddr: listenAddr,
+		enableQUIC: cfg.EnableQUIC,
 		host:       h,
 		streams:    sm,
 		pubsub:     ps,
@@ -222,7 +243,16 @@ func (s *serviceImpl) Start() error {
 		return err
 	}
 
-	return s.host.Network().Listen(listenAddr)
+	listenAddrs := []multiaddr.Multiaddr{listenAddr}
+	if s.enableQUIC {
+		quicAddr, err := quicListenAddress(listenAddr)
+		if err != nil {
+			s.log.Errorf("failed to create QUIC multiaddress: %s", err)
+			return err
+		}
+		listenAddrs = append(listenAddrs, quicAddr)
+	}
+	return s.host.Network().Listen(listenAddrs...)
 }
 
 // Close shuts down the P2P service
@@ -260,15 +290,15 @@ func (s *serviceImpl) DialPeersUntilTargetCount(targetConnCount int) {
 		if len(s.host.Network().ConnsToPeer(peerInfo.ID)) > 0 {
 			continue
 		}
-		err := s.dialNode(context.Background(), peerInfo) // leaving the calls as blocking for now, to not over-connect beyond fanout
+		err := s.DialNode(context.Background(), peerInfo) // leaving the calls as blocking for now, to not over-connect beyond fanout
 		if err != nil {
 			s.log.Warnf("failed to connect to peer %s: %v", peerInfo.ID, err)
 		}
 	}
 }
 
-// dialNode attempts to establish a connection to the provided peer
-func (s *serviceImpl) dialNode(ctx context.Context, peer *peer.AddrInfo) error {
+// DialNode attempts to establish a connection to the provided peer
+func (s *serviceImpl) DialNode(ctx context.Context, peer *peer.AddrInfo) error {
 	// don't try connecting to ourselves
 	if peer.ID == s.host.ID() {
 		return nil
@@ -320,6 +350,23 @@ func netAddressToListenAddress(netAddress string) (string, error) {
 	return fmt.Sprintf("/ip4/%s/tcp/%s", ip, parts[1]), nil
 }
 
+// quicListenAddress converts a TCP listen address to the QUIC listen address on the same UDP port.
+func quicListenAddress(tcpAddr multiaddr.Multiaddr) (multiaddr.Multiaddr, error) {
+	netAddr, err := manet.ToNetAddr(tcpAddr)
+	if err != nil {
+		return nil, err
+	}
+	addr, ok := netAddr.(*net.TCPAddr)
+	if !ok {
+		return nil, fmt.Errorf("not a TCP address: %s", tcpAddr)
+	}
+	udpAddr, err := manet.FromNetAddr(&net.UDPAddr{IP: addr.IP, Port: addr.Port, Zone: addr.Zone})
+	if err != nil {
+		return nil, err
+	}
+	return udpAddr.Encapsulate(multiaddr.StringCast("/quic-v1")), nil
+}
+
 // GetPeerTelemetryInfo returns the telemetry ID of a peer by looking at its protocols
 func GetPeerTelemetryInfo(peerProtocols []protocol.ID) (telemetryID string, telemetryInstance string) {
 	for _, protocol := range peerProtocols {
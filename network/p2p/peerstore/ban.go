@@ -0,0 +1,99 @@
+// Copyright (C) 2019-2025 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package peerstore
+
+import (
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/libp2p/go-libp2p/core/peer"
+
+	"github.com/algorand/go-algorand/network/phonebook"
+)
+
+// Ban keeps peerID from being returned by GetAddresses, and reported by Banned, until the given time.
+// A zero time lifts the ban.
+func (ps *PeerStore) Ban(peerID peer.ID, until time.Time) {
+	ps.bansLock.Lock()
+	defer ps.bansLock.Unlock()
+	now := time.Now()
+	for id, t := range ps.bans {
+		if !now.Before(t) {
+			delete(ps.bans, id)
+		}
+	}
+	if !now.Before(until) {
+		delete(ps.bans, peerID)
+		return
+	}
+	if ps.bans == nil {
+		ps.bans = make(map[peer.ID]time.Time)
+	}
+	ps.bans[peerID] = until
+}
+
+// Banned returns whether peerID is banned.
+func (ps *PeerStore) Banned(peerID peer.ID) bool {
+	ps.bansLock.RLock()
+	defer ps.bansLock.RUnlock()
+	return ps.banned(peerID, time.Now())
+}
+
+func (ps *PeerStore) banned(peerID peer.ID, now time.Time) bool {
+	until, ok := ps.bans[peerID]
+	return ok && now.Before(until)
+}
+
+// Entries returns the peers of the phonebook, and the banned peers, sorted by peer ID. The address of
+// the entries is the peer ID.
+func (ps *PeerStore) Entries() []phonebook.Entry {
+	ps.bansLock.RLock()
+	defer ps.bansLock.RUnlock()
+	now := time.Now()
+	var entries []phonebook.Entry
+	inStore := make(map[peer.ID]bool)
+	for _, peerID := range ps.Peers() {
+		data, _ := ps.Get(peerID, addressDataKey)
+		ad, ok := data.(addressData)
+		if !ok {
+			continue
+		}
+		inStore[peerID] = true
+		entry := phonebook.Entry{
+			Address:    peerID.String(),
+			Roles:      ad.roles,
+			Networks:   make([]string, 0, len(ad.networkNames)),
+			RetryAfter: ad.retryAfter,
+		}
+		for name := range ad.networkNames {
+			entry.Networks = append(entry.Networks, name)
+		}
+		slices.Sort(entry.Networks)
+		if ps.banned(peerID, now) {
+			entry.BannedUntil = ps.bans[peerID]
+		}
+		entries = append(entries, entry)
+	}
+	for peerID, until := range ps.bans {
+		if !inStore[peerID] && now.Before(until) {
+			entries = append(entries, phonebook.Entry{Address: peerID.String(), BannedUntil: until})
+		}
+	}
+	slices.SortFunc(entries, func(a, b phonebook.Entry) int { return strings.Compare(a.Address, b.Address) })
+	return entries
+}
@@ -43,6 +43,8 @@ type PeerStore struct {
 	connectionsRateLimitingCount  uint
 	connectionsRateLimitingWindow time.Duration
 	lock                          deadlock.Mutex
+	bans                          map[peer.ID]time.Time
+	bansLock                      deadlock.RWMutex
 }
 
 // addressData: holds the information associated with each phonebook address.
@@ -339,8 +341,13 @@ func (ps *PeerStore) popNElements(n int, peerID peer.ID) {
 }
 
 func (ps *PeerStore) filterRetryTime(t time.Time, role phonebook.Role) []*peer.AddrInfo {
+	ps.bansLock.RLock()
+	defer ps.bansLock.RUnlock()
 	o := make([]*peer.AddrInfo, 0, len(ps.Peers()))
 	for _, peerID := range ps.Peers() {
+		if ps.banned(peerID, t) {
+			continue
+		}
 		data, _ := ps.Get(peerID, addressDataKey)
 		if data != nil {
 			ad := data.(addressData)
@@ -21,6 +21,7 @@ import (
 	"time"
 
 	"github.com/algorand/go-algorand/config"
+	"github.com/algorand/go-algorand/protocol"
 	pubsub "github.com/libp2p/go-libp2p-pubsub"
 	pubsub_pb "github.com/libp2p/go-libp2p-pubsub/pb"
 	"github.com/libp2p/go-libp2p/core/host"
@@ -56,11 +57,96 @@ const (
 // Naming convention: "algo" + 2 bytes protocol tag + 2 bytes version
 const TXTopicName = "algotx01"
 
+// AVTopicName defines a pubsub topic for agreement vote messages
+const AVTopicName = "algoav01"
+
+// PPTopicName defines a pubsub topic for proposal payload messages
+const PPTopicName = "algopp01"
+
+// VBTopicName defines a pubsub topic for vote bundle messages
+const VBTopicName = "algovb01"
+
+// consensusTopicScoreParams are the scoring parameters of the agreement topics, which are only joined
+// when EnableP2PConsensusTopics is set. Their messages are only propagated once the votes they carry
+// are verified, so that delivering them first is rewarded, and invalid ones are severely penalized.
+var consensusTopicScoreParams = map[string]*pubsub.TopicScoreParams{
+	AVTopicName: {
+		TopicWeight: 0.5,
+
+		TimeInMeshWeight:  0.0002778, // ~1/3600
+		TimeInMeshQuantum: time.Second,
+		TimeInMeshCap:     1,
+
+		FirstMessageDeliveriesWeight: 0.05, // max value is 50
+		FirstMessageDeliveriesDecay:  pubsub.ScoreParameterDecay(time.Minute),
+		FirstMessageDeliveriesCap:    1000, // votes are numerous: 1000 votes a minute
+
+		// invalid messages decay after 1 hour
+		InvalidMessageDeliveriesWeight: -1000,
+		InvalidMessageDeliveriesDecay:  pubsub.ScoreParameterDecay(time.Hour),
+	},
+	PPTopicName: {
+		TopicWeight: 0.5,
+
+		TimeInMeshWeight:  0.0002778, // ~1/3600
+		TimeInMeshQuantum: time.Second,
+		TimeInMeshCap:     1,
+
+		FirstMessageDeliveriesWeight: 1, // max value is 50
+		FirstMessageDeliveriesDecay:  pubsub.ScoreParameterDecay(10 * time.Minute),
+		FirstMessageDeliveriesCap:    50, // proposals are few: 50 proposals in 10 minutes
+
+		// invalid messages decay after 1 hour
+		InvalidMessageDeliveriesWeight: -1000,
+		InvalidMessageDeliveriesDecay:  pubsub.ScoreParameterDecay(time.Hour),
+	},
+	VBTopicName: {
+		TopicWeight: 0.1,
+
+		TimeInMeshWeight:  0.0002778, // ~1/3600
+		TimeInMeshQuantum: time.Second,
+		TimeInMeshCap:     1,
+
+		FirstMessageDeliveriesWeight: 5, // max value is 50
+		FirstMessageDeliveriesDecay:  pubsub.ScoreParameterDecay(time.Hour),
+		FirstMessageDeliveriesCap:    10, // bundles are only sent when the network stalls
+
+		// invalid messages decay after 1 hour
+		InvalidMessageDeliveriesWeight: -1000,
+		InvalidMessageDeliveriesDecay:  pubsub.ScoreParameterDecay(time.Hour),
+	},
+}
+
 const incomingThreads = 20 // matches to number wsNetwork workers
 
 func makePubSub(ctx context.Context, cfg config.Local, host host.Host, metricsTracer pubsub.RawTracer) (*pubsub.PubSub, error) {
 	//defaultParams := pubsub.DefaultGossipSubParams()
 
+	topics := map[string]*pubsub.TopicScoreParams{
+		TXTopicName: {
+			TopicWeight: 0.1,
+
+			TimeInMeshWeight:  0.0002778, // ~1/3600
+			TimeInMeshQuantum: time.Second,
+			TimeInMeshCap:     1,
+
+			FirstMessageDeliveriesWeight: 0.5, // max value is 50
+			FirstMessageDeliveriesDecay:  pubsub.ScoreParameterDecay(10 * time.Minute),
+			FirstMessageDeliveriesCap:    100, // 100 messages in 10 minutes
+
+			// invalid messages decay after 1 hour
+			InvalidMessageDeliveriesWeight: -1000,
+			InvalidMessageDeliveriesDecay:  pubsub.ScoreParameterDecay(time.Hour),
+		},
+	}
+	topicNames := []string{TXTopicName}
+	if cfg.EnableP2PConsensusTopics {
+		for name, params := range consensusTopicScoreParams {
+			topics[name] = params
+			topicNames = append(topicNames, name)
+		}
+	}
+
 	options := []pubsub.Option{
 		pubsub.WithPeerScore(&pubsub.PeerScoreParams{
 			DecayInterval: pubsub.DefaultDecayInterval,
@@ -68,23 +154,7 @@ func makePubSub(ctx context.Context, cfg config.Local, host host.Host, metricsTr
 
 			AppSpecificScore: func(p peer.ID) float64 { return 1000 },
 
-			Topics: map[string]*pubsub.TopicScoreParams{
-				TXTopicName: {
-					TopicWeight: 0.1,
-
-					TimeInMeshWeight:  0.0002778, // ~1/3600
-					TimeInMeshQuantum: time.Second,
-					TimeInMeshCap:     1,
-
-					FirstMessageDeliveriesWeight: 0.5, // max value is 50
-					FirstMessageDeliveriesDecay:  pubsub.ScoreParameterDecay(10 * time.Minute),
-					FirstMessageDeliveriesCap:    100, // 100 messages in 10 minutes
-
-					// invalid messages decay after 1 hour
-					InvalidMessageDeliveriesWeight: -1000,
-					InvalidMessageDeliveriesDecay:  pubsub.ScoreParameterDecay(time.Hour),
-				},
-			},
+			Topics: topics,
 		},
 			&pubsub.PeerScoreThresholds{
 				GossipThreshold:             gossipScoreThreshold,
@@ -95,7 +165,7 @@ func makePubSub(ctx context.Context, cfg config.Local, host host.Host, metricsTr
 			},
 		),
 		// pubsub.WithPeerGater(&pubsub.PeerGaterParams{}),
-		pubsub.WithSubscriptionFilter(pubsub.WrapLimitSubscriptionFilter(pubsub.NewAllowlistSubscriptionFilter(TXTopicName), 100)),
+		pubsub.WithSubscriptionFilter(pubsub.WrapLimitSubscriptionFilter(pubsub.NewAllowlistSubscriptionFilter(topicNames...), 100)),
 		// pubsub.WithEventTracer(jsonTracer),
 		pubsub.WithValidateQueueSize(256),
 		pubsub.WithMessageSignaturePolicy(pubsub.StrictNoSign),
@@ -103,6 +173,11 @@ func makePubSub(ctx context.Context, cfg config.Local, host host.Host, metricsTr
 		pubsub.WithValidateWorkers(incomingThreads),
 	}
 
+	if cfg.EnableP2PConsensusTopics {
+		// proposal payloads and vote bundles are much larger than the default maximum message size
+		options = append(options, pubsub.WithMaxMessageSize(max(protocol.ProposalPayloadTagMaxSize, protocol.VoteBundleTagMaxSize)))
+	}
+
 	if metricsTracer != nil {
 		options = append(options, pubsub.WithRawTracer(metricsTracer))
 	}
@@ -130,7 +205,7 @@ func (s *serviceImpl) getOrCreateTopic(topicName string) (*pubsub.Topic, error)
 	if _, ok := s.topics[topicName]; !ok {
 		var topt []pubsub.TopicOpt
 		switch topicName {
-		case TXTopicName:
+		case TXTopicName, AVTopicName, PPTopicName, VBTopicName:
 			topt = append(topt, pubsub.WithTopicMessageIdFn(txMsgID))
 		}
 
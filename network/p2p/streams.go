@@ -19,13 +19,16 @@ package p2p
 import (
 	"context"
 	"io"
+	"time"
 
 	"github.com/algorand/go-algorand/logging"
 	"github.com/algorand/go-deadlock"
 	"github.com/libp2p/go-libp2p/core/host"
 	"github.com/libp2p/go-libp2p/core/network"
 	"github.com/libp2p/go-libp2p/core/peer"
+	"github.com/libp2p/go-libp2p/core/protocol"
 	"github.com/multiformats/go-multiaddr"
+	"github.com/multiformats/go-multistream"
 )
 
 // streamManager implements network.Notifiee to create and manage streams for use with non-gossipsub protocols.
@@ -96,6 +99,40 @@ func (n *streamManager) streamHandler(stream network.Stream) {
 	n.handler(n.ctx, remotePeer, stream, incoming)
 }
 
+// classStreamHandler is called by libp2p when a new AlgorandWsClassProtocol stream is accepted. Unlike the
+// AlgorandWsProtocol streams, there are several of them for each peer, which the handler attaches to the peer.
+func (n *streamManager) classStreamHandler(stream network.Stream) {
+	if stream.Conn().Stat().Direction == network.DirInbound && !n.allowIncomingGossip {
+		n.log.Debugf("rejecting stream from incoming connection from %s", stream.Conn().RemotePeer().String())
+		stream.Close()
+		return
+	}
+	incoming := stream.Conn().Stat().Direction == network.DirInbound
+	n.handler(n.ctx, stream.Conn().RemotePeer(), stream, incoming)
+}
+
+// OpenClassStream opens an AlgorandWsClassProtocol stream on conn. Unlike host.NewStream, the stream is
+// opened on this connection rather than on any connection to the peer.
+func OpenClassStream(ctx context.Context, conn network.Conn) (network.Stream, error) {
+	stream, err := conn.NewStream(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if deadline, ok := ctx.Deadline(); ok {
+		stream.SetDeadline(deadline)
+	}
+	if err = multistream.SelectProtoOrFail(protocol.ID(AlgorandWsClassProtocol), stream); err != nil {
+		stream.Reset()
+		return nil, err
+	}
+	stream.SetDeadline(time.Time{})
+	if err = stream.SetProtocol(AlgorandWsClassProtocol); err != nil {
+		stream.Reset()
+		return nil, err
+	}
+	return stream, nil
+}
+
 // Connected is called when a connection is opened
 // for both incoming (listener -> addConn) and outgoing (dialer -> addConn) connections.
 func (n *streamManager) Connected(net network.Network, conn network.Conn) {
@@ -124,7 +161,8 @@ func (n *streamManager) Connected(net network.Network, conn network.Conn) {
 		return // there's already an active stream with this peer for our protocol
 	}
 
-	stream, err := n.host.NewStream(n.ctx, remotePeer, AlgorandWsProtocol)
+	// prefer the newest protocol version, falling back for peers not supporting it
+	stream, err := n.host.NewStream(n.ctx, remotePeer, AlgorandWsProtocolV11, AlgorandWsProtocol)
 	if err != nil {
 		n.log.Infof("Failed to open stream to %s (%s): %v", remotePeer, conn.RemoteMultiaddr().String(), err)
 		return
@@ -18,6 +18,9 @@ package network
 
 import (
 	"context"
+	"fmt"
+	"io"
+	"math"
 	"math/rand"
 	"net/http"
 	"strings"
@@ -30,6 +33,7 @@ import (
 	"github.com/algorand/go-algorand/logging"
 	"github.com/algorand/go-algorand/logging/telemetryspec"
 	"github.com/algorand/go-algorand/network/limitcaller"
+	"github.com/algorand/go-algorand/network/messagetracer"
 	"github.com/algorand/go-algorand/network/p2p"
 	"github.com/algorand/go-algorand/network/p2p/dnsaddr"
 	"github.com/algorand/go-algorand/network/p2p/peerstore"
@@ -66,12 +70,15 @@ type P2PNetwork struct {
 	topicTags map[protocol.Tag]string
 
 	// websockets message support
-	handler                        msgHandler
-	broadcaster                    msgBroadcaster
-	wsPeers                        map[peer.ID]*wsPeer
-	wsPeersToIDs                   map[*wsPeer]peer.ID
-	wsPeersLock                    deadlock.RWMutex
-	wsPeersChangeCounter           atomic.Int32
+	handler              msgHandler
+	broadcaster          msgBroadcaster
+	wsPeers              map[peer.ID]*wsPeer
+	wsPeersToIDs         map[*wsPeer]peer.ID
+	wsPeersLock          deadlock.RWMutex
+	wsPeersChangeCounter atomic.Int32
+	// pendingClassStreams holds the AlgorandWsClassProtocol streams accepted before the wsPeer they belong to
+	// is added; they are guarded by wsPeersLock.
+	pendingClassStreams            map[peer.ID][]pendingClassStream
 	wsPeersConnectivityCheckTicker *time.Ticker
 	peerStater                     peerConnectionStater
 
@@ -87,6 +94,22 @@ type P2PNetwork struct {
 	httpServer        *p2p.HTTPServer
 
 	identityTracker identityTracker
+
+	// capture records the gossip messages of the peers and topics, if set
+	capture *messagetracer.Capture
+
+	// egressQuotas limits the bandwidth of the messages sent to all the peers, if set
+	egressQuotas *egressQuotas
+
+	// priorityPeers holds the peers added by AddPriorityPeer, until when they are kept connected
+	priorityPeers     map[peer.ID]priorityPeerP2P
+	priorityPeersLock deadlock.Mutex
+}
+
+// priorityPeerP2P is a peer added by AddPriorityPeer
+type priorityPeerP2P struct {
+	info  *peer.AddrInfo
+	until time.Time
 }
 
 type bootstrapper struct {
@@ -202,6 +225,29 @@ var gossipSubTags = map[protocol.Tag]string{
 	protocol.TxnTag: p2p.TXTopicName,
 }
 
+// consensusGossipSubTags defines agreement protocol messages that are relayed using GossipSub
+// when EnableP2PConsensusTopics is set
+var consensusGossipSubTags = map[protocol.Tag]string{
+	protocol.AgreementVoteTag:   p2p.AVTopicName,
+	protocol.ProposalPayloadTag: p2p.PPTopicName,
+	protocol.VoteBundleTag:      p2p.VBTopicName,
+}
+
+// makeTopicTags returns the protocol messages relayed using GossipSub, according to the configuration
+func makeTopicTags(cfg config.Local) map[protocol.Tag]string {
+	if !cfg.EnableP2PConsensusTopics {
+		return gossipSubTags
+	}
+	topicTags := make(map[protocol.Tag]string, len(gossipSubTags)+len(consensusGossipSubTags))
+	for tag, topic := range gossipSubTags {
+		topicTags[tag] = topic
+	}
+	for tag, topic := range consensusGossipSubTags {
+		topicTags[tag] = topic
+	}
+	return topicTags
+}
+
 // NewP2PNetwork returns an instance of GossipNode that uses the p2p.Service
 func NewP2PNetwork(log logging.Logger, cfg config.Local, datadir string, phonebookAddresses []string, genesisID string, networkID protocol.NetworkID, node NodeInfo, identityOpts *identityOpts) (*P2PNetwork, error) {
 	const readBufferLen = 2048
@@ -218,17 +264,21 @@ func NewP2PNetwork(log logging.Logger, cfg config.Local, datadir string, phonebo
 
 	relayMessages := cfg.IsGossipServer() || cfg.ForceRelayMessages
 	net := &P2PNetwork{
-		log:           log,
-		config:        cfg,
-		genesisID:     genesisID,
-		networkID:     networkID,
-		topicTags:     gossipSubTags,
-		wsPeers:       make(map[peer.ID]*wsPeer),
-		wsPeersToIDs:  make(map[*wsPeer]peer.ID),
-		peerStats:     make(map[peer.ID]*p2pPeerStats),
-		nodeInfo:      node,
-		pstore:        pstore,
-		relayMessages: relayMessages,
+		log:          log,
+		config:       cfg,
+		genesisID:    genesisID,
+		networkID:    networkID,
+		topicTags:    makeTopicTags(cfg),
+		wsPeers:      make(map[peer.ID]*wsPeer),
+		wsPeersToIDs: make(map[*wsPeer]peer.ID),
+		peerStats:    make(map[peer.ID]*p2pPeerStats),
+
+		pendingClassStreams: make(map[peer.ID][]pendingClassStream),
+		priorityPeers:       make(map[peer.ID]priorityPeerP2P),
+		egressQuotas:        makeEgressQuotas(cfg),
+		nodeInfo:            node,
+		pstore:              pstore,
+		relayMessages:       relayMessages,
 		peerStater: peerConnectionStater{
 			log:                           log,
 			peerConnectionsUpdateInterval: time.Duration(cfg.PeerConnectionsUpdateInterval) * time.Second,
@@ -341,6 +391,13 @@ func (n *P2PNetwork) Start() error {
 		go n.txTopicHandleLoop()
 	}
 
+	if n.config.EnableP2PConsensusTopics {
+		for tag, topic := range consensusGossipSubTags {
+			n.wg.Add(1)
+			go n.consensusTopicHandleLoop(topic, tag)
+		}
+	}
+
 	if n.wsPeersConnectivityCheckTicker != nil {
 		n.wsPeersConnectivityCheckTicker.Stop()
 	}
@@ -424,6 +481,7 @@ func (n *P2PNetwork) innerStop() {
 // meshThreadInner fetches nodes from DHT and attempts to connect to them
 func (n *P2PNetwork) meshThreadInner() int {
 	defer n.service.DialPeersUntilTargetCount(n.config.GossipFanout)
+	defer n.connectPriorityPeers()
 
 	// fetch peers from DNS
 	var dnsPeers, dhtPeers []peer.AddrInfo
@@ -512,6 +570,116 @@ func (n *P2PNetwork) httpdThread() {
 	}
 }
 
+// ReportPeerEvent implements GossipNode. It is a no-op: the reputation of the libp2p peers is
+// scored by gossipsub.
+func (n *P2PNetwork) ReportPeerEvent(peer Peer, event PeerEvent) {}
+
+// PeersInfo describes the connected peers.
+func (n *P2PNetwork) PeersInfo() []PeerInfo {
+	n.wsPeersLock.RLock()
+	infos := make([]PeerInfo, 0, len(n.wsPeers))
+	for peerID, wsp := range n.wsPeers {
+		info := wsp.peerInfo()
+		info.Address = peerID.String()
+		info.PeerID = peerID.String()
+		infos = append(infos, info)
+	}
+	n.wsPeersLock.RUnlock()
+	n.priorityPeersLock.Lock()
+	defer n.priorityPeersLock.Unlock()
+	now := time.Now()
+	for i := range infos {
+		pp, ok := n.priorityPeers[peer.ID(infos[i].PeerID)]
+		infos[i].Priority = ok && now.Before(pp.until)
+	}
+	return infos
+}
+
+// DisconnectPeer disconnects the peer with the given libp2p peer ID, and bans it for banDuration if it is
+// positive: it is not dialed, and its streams are refused, until then.
+func (n *P2PNetwork) DisconnectPeer(address string, banDuration time.Duration) int {
+	peerID, err := peer.Decode(address)
+	if err != nil {
+		return 0
+	}
+	if banDuration > 0 {
+		n.pstore.Ban(peerID, time.Now().Add(banDuration))
+		n.priorityPeersLock.Lock()
+		delete(n.priorityPeers, peerID)
+		n.priorityPeersLock.Unlock()
+	}
+	n.wsPeersLock.Lock()
+	wsp, ok := n.wsPeers[peerID]
+	if ok {
+		wsp.CloseAndWait(time.Now().Add(peerDisconnectionAckDuration))
+		delete(n.wsPeers, peerID)
+		delete(n.wsPeersToIDs, wsp)
+	}
+	n.wsPeersLock.Unlock()
+	if !ok {
+		return 0
+	}
+	n.log.Infof("disconnecting from peer %s on request of the node operator", peerID)
+	if err := n.service.ClosePeer(peerID); err != nil {
+		n.log.Warnf("Error disconnecting from peer %s: %v", peerID, err)
+	}
+	return 1
+}
+
+// AddPriorityPeer connects to the peer at address, a multiaddress with a p2p component, and keeps it connected
+// in addition to the GossipFanout peers until duration elapses.
+func (n *P2PNetwork) AddPriorityPeer(address string, duration time.Duration) error {
+	if duration <= 0 {
+		return errInvalidPriorityPeerDuration
+	}
+	info, err := peerstore.PeerInfoFromAddr(address)
+	if err != nil {
+		return err
+	}
+	n.pstore.Ban(info.ID, time.Time{})
+	n.priorityPeersLock.Lock()
+	n.priorityPeers[info.ID] = priorityPeerP2P{info: info, until: time.Now().Add(duration)}
+	n.priorityPeersLock.Unlock()
+	n.wg.Add(1)
+	go func() {
+		defer n.wg.Done()
+		n.connectPriorityPeers()
+	}()
+	return nil
+}
+
+// PhonebookEntries returns the entries of the peerstore, which addresses are the peer IDs.
+func (n *P2PNetwork) PhonebookEntries() []phonebook.Entry {
+	return n.pstore.Entries()
+}
+
+// connectPriorityPeers dials the priority peers not connected, and forgets the expired ones.
+func (n *P2PNetwork) connectPriorityPeers() {
+	now := time.Now()
+	var infos []*peer.AddrInfo
+	n.priorityPeersLock.Lock()
+	for peerID, pp := range n.priorityPeers {
+		if now.Before(pp.until) {
+			infos = append(infos, pp.info)
+		} else {
+			delete(n.priorityPeers, peerID)
+		}
+	}
+	n.priorityPeersLock.Unlock()
+
+	for _, info := range infos {
+		n.wsPeersLock.RLock()
+		_, connected := n.wsPeers[info.ID]
+		n.wsPeersLock.RUnlock()
+		if connected {
+			continue
+		}
+		if err := n.service.DialNode(n.ctx, info); err != nil {
+			n.log.Warnf("failed to connect to priority peer %s: %v", info.ID, err)
+		}
+	}
+}
+
 // GetGenesisID implements GossipNode
 func (n *P2PNetwork) GetGenesisID() string {
 	return n.genesisID
@@ -548,12 +716,30 @@ func (n *P2PNetwork) Address() (string, bool) {
 func (n *P2PNetwork) Broadcast(ctx context.Context, tag protocol.Tag, data []byte, wait bool, except Peer) error {
 	// For tags using pubsub topics, publish to GossipSub
 	if topic, ok := n.topicTags[tag]; ok {
+		if !n.allowTopicMessage(tag, topic, len(data)) {
+			// the egress quota of the message class is exceeded: drop the message
+			return nil
+		}
+		if n.capture != nil {
+			n.capture.Record(messagetracer.Outgoing, tag, "", data)
+		}
 		return n.service.Publish(ctx, topic, data)
 	}
 	// Otherwise broadcast over websocket protocol stream
 	return n.broadcaster.BroadcastArray(ctx, []protocol.Tag{tag}, [][]byte{data}, wait, except)
 }
 
+// allowTopicMessage returns whether a message with tag of size bytes can be published or forwarded over the pubsub
+// topic, accounting it if so. Since gossipsub may send the message to every peer subscribed to the topic, it is
+// accounted once for each of them.
+func (n *P2PNetwork) allowTopicMessage(tag protocol.Tag, topic string, size int) bool {
+	if n.egressQuotas == nil || n.egressQuotas[tagEgressClass(tag)] == nil {
+		return true
+	}
+	peers := max(len(n.service.ListPeersForTopic(topic)), 1)
+	return n.egressQuotas.allow(tag, size*peers)
+}
+
 // Relay message
 func (n *P2PNetwork) Relay(ctx context.Context, tag protocol.Tag, data []byte, wait bool, except Peer) error {
 	if n.relayMessages {
@@ -706,6 +892,12 @@ func (n *P2PNetwork) GetPeers(options ...PeerOption) []Peer {
 	return peers
 }
 
+// SetCapture records the gossip messages sent and received by the peers connected from now on,
+// and by the pubsub topics.
+func (n *P2PNetwork) SetCapture(capture *messagetracer.Capture) {
+	n.capture = capture
+}
+
 // RegisterHandlers adds to the set of given message handlers.
 func (n *P2PNetwork) RegisterHandlers(dispatch []TaggedMessageHandler) {
 	n.handler.RegisterHandlers(dispatch)
@@ -754,27 +946,83 @@ func (n *P2PNetwork) OnNetworkAdvance() {
 	}
 }
 
+// exchangePeerFeatures exchanges the features supported by the two ends of an AlgorandWsProtocolV11 stream:
+// the dialing peer sends its features first, and the accepting peer replies with its own.
+// Each list of features is sent as a single length byte followed by the comma-separated features.
+func exchangePeerFeatures(stream io.ReadWriter, localFeatures string, incoming bool) (peerFeatureFlag, error) {
+	if len(localFeatures) > math.MaxUint8 {
+		return 0, fmt.Errorf("features list is too long: %d", len(localFeatures))
+	}
+	send := func() error {
+		_, err := stream.Write(append([]byte{byte(len(localFeatures))}, localFeatures...))
+		return err
+	}
+	if !incoming {
+		if err := send(); err != nil {
+			return 0, err
+		}
+	}
+	var length [1]byte
+	if _, err := io.ReadFull(stream, length[:]); err != nil {
+		return 0, err
+	}
+	remoteFeatures := make([]byte, length[0])
+	if _, err := io.ReadFull(stream, remoteFeatures); err != nil {
+		return 0, err
+	}
+	if incoming {
+		if err := send(); err != nil {
+			return 0, err
+		}
+	}
+	return parsePeerFeatures(string(remoteFeatures)), nil
+}
+
 // wsStreamHandler is a callback that the p2p package calls when a new peer connects and establishes a
 // stream for the websocket protocol.
 func (n *P2PNetwork) wsStreamHandler(ctx context.Context, p2pPeer peer.ID, stream network.Stream, incoming bool) {
-	if stream.Protocol() != p2p.AlgorandWsProtocol {
-		n.log.Warnf("unknown protocol %s from peer%s", stream.Protocol(), p2pPeer)
+	if n.pstore.Banned(p2pPeer) {
+		n.log.Infof("wsStreamHandler: refusing a stream of banned peer %s", p2pPeer)
+		stream.Reset()
+		if err := n.service.ClosePeer(p2pPeer); err != nil {
+			n.log.Debugf("Error disconnecting from banned peer %s: %v", p2pPeer, err)
+		}
 		return
 	}
-
-	if incoming {
-		var initMsg [1]byte
-		rn, err := stream.Read(initMsg[:])
-		if rn == 0 || err != nil {
-			n.log.Warnf("wsStreamHandler: error reading initial message: %s, peer %s (%s)", err, p2pPeer, stream.Conn().RemoteMultiaddr().String())
-			return
+	var features peerFeatureFlag
+	switch stream.Protocol() {
+	case p2p.AlgorandWsClassProtocol:
+		n.classStreamHandler(p2pPeer, stream)
+		return
+	case p2p.AlgorandWsProtocol:
+		if incoming {
+			var initMsg [1]byte
+			rn, err := stream.Read(initMsg[:])
+			if rn == 0 || err != nil {
+				n.log.Warnf("wsStreamHandler: error reading initial message: %s, peer %s (%s)", err, p2pPeer, stream.Conn().RemoteMultiaddr().String())
+				return
+			}
+		} else {
+			_, err := stream.Write([]byte("1"))
+			if err != nil {
+				n.log.Warnf("wsStreamHandler: error sending initial message: %s", err)
+				return
+			}
 		}
-	} else {
-		_, err := stream.Write([]byte("1"))
+	case p2p.AlgorandWsProtocolV11:
+		var err error
+		localFeatures := peerFeatures(n.config, false)
+		if n.config.EnableQUIC {
+			localFeatures += "," + PeerFeatureMultiStream
+		}
+		features, err = exchangePeerFeatures(stream, localFeatures, incoming)
 		if err != nil {
-			n.log.Warnf("wsStreamHandler: error sending initial message: %s", err)
+			n.log.Warnf("wsStreamHandler: error exchanging features: %s, peer %s (%s)", err, p2pPeer, stream.Conn().RemoteMultiaddr().String())
 			return
 		}
+	default:
+		n.log.Warnf("unknown protocol %s from peer%s", stream.Protocol(), p2pPeer)
+		return
 	}
 
 	// get address for peer ID
@@ -801,13 +1049,21 @@ func (n *P2PNetwork) wsStreamHandler(ctx context.Context, p2pPeer peer.ID, strea
 	} else {
 		n.log.Warnf("Cannot get pubkey for peer %s", p2pPeer)
 	}
+	var conn wsPeerWebsocketConn = &wsPeerConnP2P{stream: stream}
+	if features&pfMultiStream != 0 && n.config.EnableQUIC && stream.Conn().ConnState().Transport == p2pQUICTransport {
+		conn = n.makeMultiStreamConn(ctx, stream, incoming)
+	}
 	peerCore := makePeerCore(ctx, n, n.log, n.handler.readBuffer, addr, client, addr)
 	wsp := &wsPeer{
-		wsPeerCore: peerCore,
-		conn:       &wsPeerConnP2P{stream: stream},
-		outgoing:   !incoming,
-		identity:   netIdentPeerID,
-		peerType:   peerTypeP2P,
+		wsPeerCore:   peerCore,
+		conn:         conn,
+		outgoing:     !incoming,
+		identity:     netIdentPeerID,
+		peerType:     peerTypeP2P,
+		createTime:   time.Now(),
+		features:     features,
+		capture:      n.capture,
+		egressQuotas: n.egressQuotas,
 	}
 	protos, err := n.pstore.GetProtocols(p2pPeer)
 	if err != nil {
@@ -839,6 +1095,10 @@ func (n *P2PNetwork) wsStreamHandler(ctx context.Context, p2pPeer peer.ID, strea
 	}
 	n.wsPeers[p2pPeer] = wsp
 	n.wsPeersToIDs[wsp] = p2pPeer
+	for _, pending := range n.pendingClassStreams[p2pPeer] {
+		n.attachClassStream(wsp, pending.class, pending.stream)
+	}
+	delete(n.pendingClassStreams, p2pPeer)
 	n.wsPeersLock.Unlock()
 	n.wsPeersChangeCounter.Add(1)
 
@@ -865,9 +1125,104 @@ func (n *P2PNetwork) wsStreamHandler(ctx context.Context, p2pPeer peer.ID, strea
 		})
 }
 
+// p2pQUICTransport is the name of the libp2p QUIC transport in the network.ConnectionState of its connections
+const p2pQUICTransport = "quic-v1"
+
+// p2pClassStreamTimeout is the time allowed to open the AlgorandWsClassProtocol streams of a peer
+const p2pClassStreamTimeout = 5 * time.Second
+
+// pendingClassStream is an AlgorandWsClassProtocol stream accepted before the wsPeer it belongs to is added.
+type pendingClassStream struct {
+	class    streamClass
+	stream   network.Stream
+	accepted time.Time
+}
+
+// makeMultiStreamConn makes the connection of a peer which supports PeerFeatureMultiStream over QUIC: the messages of
+// each class are sent on their own AlgorandWsClassProtocol stream, which the dialing end of the connection opens.
+func (n *P2PNetwork) makeMultiStreamConn(ctx context.Context, stream network.Stream, incoming bool) *multiStreamConnP2P {
+	c := &multiStreamConnP2P{
+		multiStreamConn: makeMultiStreamConn((&wsPeerConnP2P{stream: stream}).RemoteAddr(), stream.Conn().RemoteMultiaddr().String(), nil),
+		stream:          stream,
+	}
+	// the control stream cannot already be attached
+	_ = c.attach(streamClassControl, stream, stream, stream)
+	if incoming {
+		return c
+	}
+	ctx, cancel := context.WithTimeout(ctx, p2pClassStreamTimeout)
+	defer cancel()
+	for class := streamClassControl + 1; class < numStreamClasses; class++ {
+		classStream, err := p2p.OpenClassStream(ctx, stream.Conn())
+		if err != nil {
+			// the messages of the classes without a stream are sent on the control stream
+			n.log.Infof("could not open stream of class %d to peer %s: %v", class, stream.Conn().RemotePeer(), err)
+			break
+		}
+		if _, err = classStream.Write([]byte{byte(class)}); err == nil {
+			err = c.attach(class, classStream, classStream, classStream)
+		}
+		if err != nil {
+			n.log.Infof("could not open stream of class %d to peer %s: %v", class, stream.Conn().RemotePeer(), err)
+			classStream.Reset()
+			break
+		}
+	}
+	return c
+}
+
+// classStreamHandler attaches an AlgorandWsClassProtocol stream to the connection of its peer, or keeps it
+// until the peer is added.
+func (n *P2PNetwork) classStreamHandler(p2pPeer peer.ID, stream network.Stream) {
+	var class [1]byte
+	stream.SetReadDeadline(time.Now().Add(p2pClassStreamTimeout))
+	_, err := io.ReadFull(stream, class[:])
+	if err != nil || streamClass(class[0]) == streamClassControl || streamClass(class[0]) >= numStreamClasses {
+		n.log.Infof("invalid class stream from peer %s: class %d, %v", p2pPeer, class[0], err)
+		stream.Reset()
+		return
+	}
+	stream.SetReadDeadline(time.Time{})
+
+	n.wsPeersLock.Lock()
+	defer n.wsPeersLock.Unlock()
+	if wsp, ok := n.wsPeers[p2pPeer]; ok {
+		n.attachClassStream(wsp, streamClass(class[0]), stream)
+		return
+	}
+	now := time.Now()
+	for id, pending := range n.pendingClassStreams {
+		if now.Sub(pending[0].accepted) > p2pClassStreamTimeout {
+			for _, p := range pending {
+				p.stream.Reset()
+			}
+			delete(n.pendingClassStreams, id)
+		}
+	}
+	if len(n.pendingClassStreams[p2pPeer]) >= int(numStreamClasses) {
+		stream.Reset()
+		return
+	}
+	n.pendingClassStreams[p2pPeer] = append(n.pendingClassStreams[p2pPeer], pendingClassStream{class: streamClass(class[0]), stream: stream, accepted: now})
+}
+
+// attachClassStream attaches a stream of class to the connection of wsp, if the stream belongs to it.
+// It must be called with wsPeersLock held.
+func (n *P2PNetwork) attachClassStream(wsp *wsPeer, class streamClass, stream network.Stream) {
+	c, ok := wsp.conn.(*multiStreamConnP2P)
+	if !ok || c.stream.Conn().ID() != stream.Conn().ID() {
+		stream.Reset()
+		return
+	}
+	if err := c.attach(class, stream, stream, stream); err != nil {
+		n.log.Infof("could not attach stream of class %d of peer %s: %v", class, stream.Conn().RemotePeer(), err)
+		stream.Reset()
+	}
+}
+
 // peerRemoteClose called from wsPeer to report that it has closed
 func (n *P2PNetwork) peerRemoteClose(peer *wsPeer, reason disconnectReason) {
-	remotePeerID := peer.conn.(*wsPeerConnP2P).stream.Conn().RemotePeer()
+	remotePeerID := p2pStream(peer.conn).Conn().RemotePeer()
 	n.wsPeersLock.Lock()
 	n.identityTracker.removeIdentity(peer)
 	delete(n.wsPeers, remotePeerID)
@@ -883,7 +1238,7 @@ func (n *P2PNetwork) peerRemoteClose(peer *wsPeer, reason disconnectReason) {
 	}
 	if peer.outgoing {
 		eventDetails.Endpoint = peer.GetAddress()
-		eventDetails.MessageDelay = peer.peerMessageDelay
+		eventDetails.MessageDelay = peer.peerMessageDelay.Load()
 	}
 
 	n.log.EventWithDetails(telemetryspec.Network, telemetryspec.DisconnectPeerEvent,
@@ -980,8 +1335,42 @@ func (p *gsPeer) RoutingAddr() []byte {
 	return []byte(p.peerID)
 }
 
+// consensusTopicHandleLoop reads messages from the pubsub topic for the agreement messages of the given tag.
+// Unlike transactions, agreement messages are always subscribed to since votes are needed to follow the chain.
+func (n *P2PNetwork) consensusTopicHandleLoop(topic string, tag protocol.Tag) {
+	defer n.wg.Done()
+	validator := func(ctx context.Context, peerID peer.ID, msg *pubsub.Message) pubsub.ValidationResult {
+		return n.validateTopicMessage(peerID, msg, tag)
+	}
+	sub, err := n.service.Subscribe(topic, validator)
+	if err != nil {
+		n.log.Errorf("Failed to subscribe to topic %s: %v", topic, err)
+		return
+	}
+	n.log.Debugf("Subscribed to topic %s", topic)
+
+	for {
+		// msg from sub.Next not used since all work done by the validator
+		_, err := sub.Next(n.ctx)
+		if err != nil {
+			if err != pubsub.ErrSubscriptionCancelled && err != context.Canceled {
+				n.log.Errorf("Error reading from subscription to topic %s: %v", topic, err)
+			}
+			n.log.Debugf("Cancelling subscription to topic %s due Subscription.Next error: %v", topic, err)
+			sub.Cancel()
+			return
+		}
+	}
+}
+
 // txTopicValidator calls txHandler to validate and process incoming transactions.
 func (n *P2PNetwork) txTopicValidator(ctx context.Context, peerID peer.ID, msg *pubsub.Message) pubsub.ValidationResult {
+	return n.validateTopicMessage(peerID, msg, protocol.TxnTag)
+}
+
+// validateTopicMessage calls the validator handler registered for the tag to validate and process
+// a message received over a pubsub topic, and maps its decision to a pubsub validation result.
+func (n *P2PNetwork) validateTopicMessage(peerID peer.ID, msg *pubsub.Message, tag protocol.Tag) pubsub.ValidationResult {
 	n.wsPeersLock.Lock()
 	var sender DisconnectableAddressablePeer
 	if wsp, ok := n.wsPeers[peerID]; ok {
@@ -989,14 +1378,14 @@ func (n *P2PNetwork) txTopicValidator(ctx context.Context, peerID peer.ID, msg *
 	} else {
 		// otherwise use the peerID to handle the case where this peer is not in the wsPeers map yet
 		// this can happen when pubsub receives new peer notifications before the wsStreamHandler is called:
-		// create a fake peer that is good enough for tx handler to work with.
+		// create a fake peer that is good enough for the handlers to work with.
 		sender = &gsPeer{peerID: peerID, net: n}
 	}
 	n.wsPeersLock.Unlock()
 
 	inmsg := IncomingMessage{
 		Sender:   sender,
-		Tag:      protocol.TxnTag,
+		Tag:      tag,
 		Data:     msg.Data,
 		Net:      n,
 		Received: time.Now().UnixNano(),
@@ -1006,17 +1395,24 @@ func (n *P2PNetwork) txTopicValidator(ctx context.Context, peerID peer.ID, msg *
 	if msg.ReceivedFrom == n.service.ID() {
 		return pubsub.ValidationAccept
 	}
+	if n.capture != nil {
+		n.capture.Record(messagetracer.Incoming, tag, peerID.String(), msg.Data)
+	}
 
-	n.peerStatsMu.Lock()
-	peerStats, ok := n.peerStats[peerID]
-	if !ok {
-		peerStats = &p2pPeerStats{}
-		n.peerStats[peerID] = peerStats
+	if tag == protocol.TxnTag {
+		n.peerStatsMu.Lock()
+		peerStats, ok := n.peerStats[peerID]
+		if !ok {
+			peerStats = &p2pPeerStats{}
+			n.peerStats[peerID] = peerStats
+		}
+		peerStats.txReceived.Add(1)
+		n.peerStatsMu.Unlock()
 	}
-	peerStats.txReceived.Add(1)
-	n.peerStatsMu.Unlock()
 
+	_, span := startMessageSpan(n.ctx, "network.validate", &inmsg)
 	outmsg := n.handler.ValidateHandle(inmsg)
+	endMessageSpan(span, outmsg.Action)
 	// there was a decision made in the handler about this message
 	switch outmsg.Action {
 	case Ignore:
@@ -1025,6 +1421,10 @@ func (n *P2PNetwork) txTopicValidator(ctx context.Context, peerID peer.ID, msg *
 		return pubsub.ValidationReject
 	case Accept:
 		msg.ValidatorData = outmsg
+		if !n.allowTopicMessage(tag, msg.GetTopic(), len(msg.Data)) {
+			// the message was handled, but the egress quota of its class is exceeded: do not forward it
+			return pubsub.ValidationIgnore
+		}
 		return pubsub.ValidationAccept
 	default:
 		n.log.Warnf("handler returned invalid action %d", outmsg.Action)
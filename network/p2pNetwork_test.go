@@ -17,6 +17,7 @@
 package network
 
 import (
+	"bytes"
 	"context"
 	"errors"
 	"fmt"
@@ -152,6 +153,103 @@ func TestP2PSubmitTX(t *testing.T) {
 	)
 }
 
+// TestP2PConsensusTopics tests agreement messages are gossiped over pubsub topics when enabled,
+// and only the ones accepted by the validator handlers are propagated
+func TestP2PConsensusTopics(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	cfg := config.GetDefaultLocal()
+	cfg.EnableP2PConsensusTopics = true
+	cfg.NetAddress = "127.0.0.1:0"
+	log := logging.TestingLog(t)
+
+	require.Equal(t, gossipSubTags, makeTopicTags(config.GetDefaultLocal()))
+	topicTags := makeTopicTags(cfg)
+	require.Len(t, topicTags, len(gossipSubTags)+len(consensusGossipSubTags))
+	require.Equal(t, p2p.AVTopicName, topicTags[protocol.AgreementVoteTag])
+
+	netA, err := NewP2PNetwork(log, cfg, "", nil, genesisID, config.Devtestnet, &nopeNodeInfo{}, nil)
+	require.NoError(t, err)
+	netA.Start()
+	defer netA.Stop()
+
+	peerInfoA := netA.service.AddrInfo()
+	addrsA, err := peer.AddrInfoToP2pAddrs(&peerInfoA)
+	require.NoError(t, err)
+	require.NotZero(t, addrsA[0])
+
+	phoneBookAddresses := []string{addrsA[0].String()}
+	netB, err := NewP2PNetwork(log, cfg, "", phoneBookAddresses, genesisID, config.Devtestnet, &nopeNodeInfo{}, nil)
+	require.NoError(t, err)
+	netB.Start()
+	defer netB.Stop()
+
+	netC, err := NewP2PNetwork(log, cfg, "", phoneBookAddresses, genesisID, config.Devtestnet, &nopeNodeInfo{}, nil)
+	require.NoError(t, err)
+	netC.Start()
+	defer netC.Stop()
+
+	require.Eventually(
+		t,
+		func() bool {
+			for _, topic := range []string{p2p.AVTopicName, p2p.PPTopicName, p2p.VBTopicName} {
+				if len(netA.service.ListPeersForTopic(topic)) != 2 ||
+					len(netB.service.ListPeersForTopic(topic)) != 1 ||
+					len(netC.service.ListPeersForTopic(topic)) != 1 {
+					return false
+				}
+			}
+			return true
+		},
+		2*time.Second,
+		50*time.Millisecond,
+	)
+	require.Eventually(t, func() bool {
+		return netA.hasPeers() && netB.hasPeers() && netC.hasPeers()
+	}, 2*time.Second, 50*time.Millisecond)
+
+	// for some reason the above check is not enough in race builds on CI
+	time.Sleep(time.Second) // give time for peers to connect.
+
+	// now we should be connected in a line: B <-> A <-> C, and A only propagates messages it accepts
+	var received atomic.Uint32
+	validatorHandlers := func(count bool) []TaggedMessageValidatorHandler {
+		var handlers []TaggedMessageValidatorHandler
+		for tag := range consensusGossipSubTags {
+			handlers = append(handlers, TaggedMessageValidatorHandler{
+				Tag: tag,
+				MessageHandler: ValidateHandleFunc(func(msg IncomingMessage) OutgoingMessage {
+					if bytes.HasPrefix(msg.Data, []byte("bad")) {
+						return OutgoingMessage{Action: Disconnect}
+					}
+					if count {
+						received.Add(1)
+					}
+					return OutgoingMessage{Action: Accept, Tag: msg.Tag}
+				}),
+			})
+		}
+		return handlers
+	}
+	netA.RegisterValidatorHandlers(validatorHandlers(false))
+	netB.RegisterValidatorHandlers(validatorHandlers(false))
+	netC.RegisterValidatorHandlers(validatorHandlers(true))
+
+	for tag := range consensusGossipSubTags {
+		err = netB.Broadcast(context.Background(), tag, []byte(fmt.Sprintf("bad %s", tag)), false, nil)
+		require.NoError(t, err)
+		err = netB.Broadcast(context.Background(), tag, []byte(fmt.Sprintf("good %s", tag)), false, nil)
+		require.NoError(t, err)
+	}
+
+	require.Eventually(t, func() bool {
+		return received.Load() == uint32(len(consensusGossipSubTags))
+	}, 2*time.Second, 50*time.Millisecond)
+	// the invalid messages are not propagated by A
+	time.Sleep(200 * time.Millisecond)
+	require.Equal(t, uint32(len(consensusGossipSubTags)), received.Load())
+}
+
 // TestP2PSubmitTXNoGossip tests nodes without gossip enabled cannot receive transactions
 func TestP2PSubmitTXNoGossip(t *testing.T) {
 	partitiontest.PartitionTest(t)
@@ -350,6 +448,10 @@ func (s *mockService) AddrInfo() peer.AddrInfo {
 func (s *mockService) DialPeersUntilTargetCount(targetConnCount int) {
 }
 
+func (s *mockService) DialNode(ctx context.Context, peer *peer.AddrInfo) error {
+	return nil
+}
+
 func (s *mockService) ClosePeer(peer peer.ID) error {
 	delete(s.peers, peer)
 	return nil
@@ -1408,6 +1510,63 @@ func TestP2PTxTopicValidator_NoWsPeer(t *testing.T) {
 	require.Equal(t, pubsub.ValidationAccept, res)
 }
 
+// publishCountingService is a mock service counting the messages published
+type publishCountingService struct {
+	*mockService
+	published int
+}
+
+func (s *publishCountingService) Publish(ctx context.Context, topic string, data []byte) error {
+	s.published++
+	return nil
+}
+
+// TestP2PTxnEgressQuota checks the transactions published or forwarded over the pubsub topic are limited by TxnEgressQuota
+func TestP2PTxnEgressQuota(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	log := logging.TestingLog(t)
+	cfg := config.GetDefaultLocal()
+	cfg.DNSBootstrapID = "" // disable DNS lookups since the test uses phonebook addresses
+	cfg.TxnEgressQuota = 100
+	net, err := NewP2PNetwork(log, cfg, "", nil, genesisID, config.Devtestnet, &nopeNodeInfo{}, nil)
+	require.NoError(t, err)
+	addrInfo := net.service.AddrInfo()
+	// close the real service since we will substitute a mock one
+	net.service.Close()
+	service := &publishCountingService{mockService: makeMockService(addrInfo.ID, nil)}
+	net.service = service
+
+	handled := 0
+	net.handler.RegisterValidatorHandlers([]TaggedMessageValidatorHandler{
+		{Tag: protocol.TxnTag, MessageHandler: ValidateHandleFunc(func(IncomingMessage) OutgoingMessage {
+			handled++
+			return OutgoingMessage{Action: Accept}
+		})},
+	})
+
+	// the first transaction is published even though it exceeds the quota, the next one is dropped
+	require.NoError(t, net.Broadcast(context.Background(), protocol.TxnTag, make([]byte, 200), false, nil))
+	require.Equal(t, 1, service.published)
+	require.NoError(t, net.Broadcast(context.Background(), protocol.TxnTag, make([]byte, 10), false, nil))
+	require.Equal(t, 1, service.published)
+
+	// a received transaction is still handled, but not forwarded
+	topic := p2p.TXTopicName
+	msg := pubsub.Message{Message: &pb.Message{Data: make([]byte, 10), Topic: &topic}}
+	res := net.txTopicValidator(context.Background(), peer.ID("12345678"), &msg)
+	require.Equal(t, pubsub.ValidationIgnore, res)
+	require.Equal(t, 1, handled)
+
+	// without a quota, it is forwarded
+	net.egressQuotas = nil
+	res = net.txTopicValidator(context.Background(), peer.ID("12345678"), &msg)
+	require.Equal(t, pubsub.ValidationAccept, res)
+	require.Equal(t, 2, handled)
+	require.NoError(t, net.Broadcast(context.Background(), protocol.TxnTag, make([]byte, 10), false, nil))
+	require.Equal(t, 2, service.published)
+}
+
 // TestGetPeersFiltersSelf checks that GetPeers does not return the node's own peer ID.
 // The test adds a self peer to the peerstore and another peer to the peerstore and verifies that
 // the self peer is not in the returned list.
@@ -1453,3 +1612,47 @@ func TestGetPeersFiltersSelf(t *testing.T) {
 		}
 	}
 }
+
+// TestP2PVoteCompressionNegotiation checks the peers exchange their features over the ws stream,
+// and only compress votes when both ends support it
+func TestP2PVoteCompressionNegotiation(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	for _, enableB := range []bool{true, false} {
+		t.Run(fmt.Sprintf("B_%v", enableB), func(t *testing.T) {
+			cfg := config.GetDefaultLocal()
+			cfg.DNSBootstrapID = "" // disable DNS lookups since the test uses phonebook addresses
+			cfg.NetAddress = "127.0.0.1:0"
+			cfg.EnableVoteCompression = true
+			log := logging.TestingLog(t)
+			netA, err := NewP2PNetwork(log, cfg, "", nil, genesisID, config.Devtestnet, &nopeNodeInfo{}, nil)
+			require.NoError(t, err)
+			err = netA.Start()
+			require.NoError(t, err)
+			defer netA.Stop()
+
+			peerInfoA := netA.service.AddrInfo()
+			addrsA, err := peer.AddrInfoToP2pAddrs(&peerInfoA)
+			require.NoError(t, err)
+
+			cfgB := cfg
+			cfgB.EnableVoteCompression = enableB
+			netB, err := NewP2PNetwork(log, cfgB, "", []string{addrsA[0].String()}, genesisID, config.Devtestnet, &nopeNodeInfo{}, nil)
+			require.NoError(t, err)
+			err = netB.Start()
+			require.NoError(t, err)
+			defer netB.Stop()
+
+			require.Eventually(t, func() bool {
+				return len(netA.GetPeers(PeersConnectedIn)) > 0 && len(netB.GetPeers(PeersConnectedOut)) > 0
+			}, 2*time.Second, 50*time.Millisecond)
+
+			for _, p := range append(netA.GetPeers(PeersConnectedIn), netB.GetPeers(PeersConnectedOut)...) {
+				wsp := p.(*wsPeer)
+				require.Equal(t, p2p.AlgorandWsProtocolV11, string(wsp.conn.(*wsPeerConnP2P).stream.Protocol()))
+				require.NotZero(t, wsp.features&pfCompressedProposal)
+				require.Equal(t, enableB, wsp.voteCompressor != nil)
+			}
+		})
+	}
+}
@@ -28,6 +28,7 @@ import (
 
 	"github.com/libp2p/go-libp2p/core/network"
 	yamux "github.com/libp2p/go-yamux/v4"
+	"github.com/multiformats/go-multiaddr"
 	mnet "github.com/multiformats/go-multiaddr/net"
 )
 
@@ -86,9 +87,29 @@ func (c *wsPeerConnP2P) CloseWithoutFlush() error {
 func (c *wsPeerConnP2P) UnderlyingConn() net.Conn { return nil }
 
 func (c *wsPeerConnP2P) RemoteAddr() net.Addr {
-	netaddr, err := mnet.ToNetAddr(c.stream.Conn().RemoteMultiaddr())
+	addr := c.stream.Conn().RemoteMultiaddr()
+	// the address of a QUIC connection is its UDP address
+	if _, err := addr.ValueForProtocol(multiaddr.P_QUIC_V1); err == nil {
+		addr, _ = multiaddr.SplitLast(addr)
+	}
+	netaddr, err := mnet.ToNetAddr(addr)
 	if err != nil {
 		logging.Base().Errorf("Error converting multiaddr to netaddr: %v", err)
 	}
 	return netaddr
 }
+
+// multiStreamConnP2P is the multiStreamConn of a peer over a libp2p QUIC connection, which stream is the
+// AlgorandWsProtocolV11 stream carrying the control class.
+type multiStreamConnP2P struct {
+	*multiStreamConn
+	stream network.Stream
+}
+
+// p2pStream returns the AlgorandWsProtocol stream of the connection of a P2PNetwork peer.
+func p2pStream(conn wsPeerWebsocketConn) network.Stream {
+	if c, ok := conn.(*multiStreamConnP2P); ok {
+		return c.stream
+	}
+	return conn.(*wsPeerConnP2P).stream
+}
@@ -0,0 +1,214 @@
+// Copyright (C) 2019-2025 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package network
+
+import (
+	"errors"
+	"time"
+
+	"github.com/algorand/go-algorand/network/phonebook"
+)
+
+// PeerInfo describes a connected peer to the node operators.
+type PeerInfo struct {
+	// Address identifies the peer to DisconnectPeer: the phonebook address of an outgoing websocket peer,
+	// the remote host of an incoming one, or the libp2p peer ID of a p2p peer.
+	Address string
+	// PeerID is the libp2p peer ID of a p2p peer
+	PeerID      string
+	Outgoing    bool
+	ConnectedAt time.Time
+	Version     string
+	Features    []string
+	// MessageDelay is the relative average per-message delay of an outgoing peer measured by the
+	// connection performance monitor, or zero until it is measured.
+	MessageDelay time.Duration
+	// Priority is set for the peers added by AddPriorityPeer, until their duration elapses
+	Priority bool
+
+	TxnMessages, MsgOfInterestMessages, ProposalMessages, VoteMessages, OtherMessages uint64
+
+	// BytesSent and BytesReceived count the bytes of the messages exchanged with the peer
+	BytesSent, BytesReceived uint64
+}
+
+// PeerAdmin is implemented by the networks which peers can be managed by the node operators.
+type PeerAdmin interface {
+	// PeersInfo describes the connected peers.
+	PeersInfo() []PeerInfo
+
+	// DisconnectPeer disconnects the peers with the PeerInfo address, and bans the address for banDuration
+	// if it is positive. It returns the number of peers disconnected.
+	DisconnectPeer(address string, banDuration time.Duration) int
+
+	// AddPriorityPeer connects to address, and keeps it connected beyond the GossipFanout until duration elapses.
+	AddPriorityPeer(address string, duration time.Duration) error
+
+	// PhonebookEntries returns the entries of the phonebook.
+	PhonebookEntries() []phonebook.Entry
+}
+
+// errInvalidPriorityPeerDuration is returned by AddPriorityPeer for a duration which is not positive.
+var errInvalidPriorityPeerDuration = errors.New("the duration of a priority peer must be positive")
+
+// featureNames returns the names of the features, as announced in PeerFeaturesHeader.
+func (f peerFeatureFlag) featureNames() []string {
+	names := []string{}
+	for _, feature := range []struct {
+		flag peerFeatureFlag
+		name string
+	}{
+		{pfCompressedProposal, PeerFeatureProposalCompression},
+		{pfCompressedVotes, PeerFeatureVoteCompression},
+		{pfTxReconciliation, PeerFeatureTxReconciliation},
+		{pfTraceContext, PeerFeatureTraceContext},
+		{pfMultiStream, PeerFeatureMultiStream},
+	} {
+		if f&feature.flag != 0 {
+			names = append(names, feature.name)
+		}
+	}
+	return names
+}
+
+// peerInfo describes the peer, which address is set by the caller.
+func (wp *wsPeer) peerInfo() PeerInfo {
+	return PeerInfo{
+		Outgoing:              wp.outgoing,
+		ConnectedAt:           wp.createTime,
+		Version:               wp.version,
+		Features:              wp.features.featureNames(),
+		MessageDelay:          time.Duration(wp.peerMessageDelay.Load()),
+		TxnMessages:           wp.txMessageCount.Load(),
+		MsgOfInterestMessages: wp.miMessageCount.Load(),
+		ProposalMessages:      wp.ppMessageCount.Load(),
+		VoteMessages:          wp.avMessageCount.Load(),
+		OtherMessages:         wp.unkMessageCount.Load(),
+		BytesSent:             wp.sentBytes.Load(),
+		BytesReceived:         wp.receivedBytes.Load(),
+	}
+}
+
+// PeersInfo describes the connected peers.
+func (wn *WebsocketNetwork) PeersInfo() []PeerInfo {
+	wn.peersLock.RLock()
+	infos := make([]PeerInfo, 0, len(wn.peers))
+	for _, peer := range wn.peers {
+		info := peer.peerInfo()
+		info.Address = reputationAddress(peer)
+		infos = append(infos, info)
+	}
+	wn.peersLock.RUnlock()
+	for i := range infos {
+		infos[i].Priority = infos[i].Outgoing && wn.isPriorityPeer(infos[i].Address)
+	}
+	return infos
+}
+
+// DisconnectPeer disconnects the peers connected to or from address, which is the phonebook address of an
+// outgoing peer or the remote host of an incoming one, and bans address for banDuration if it is positive:
+// the phonebook does not return it, and the incoming connections from it are refused, until then.
+func (wn *WebsocketNetwork) DisconnectPeer(address string, banDuration time.Duration) int {
+	if banDuration > 0 {
+		wn.phonebook.Ban(address, time.Now().Add(banDuration))
+		wn.removePriorityPeer(address)
+	}
+	var peers []*wsPeer
+	wn.peersLock.RLock()
+	for _, peer := range wn.peers {
+		if reputationAddress(peer) == address {
+			peers = append(peers, peer)
+		}
+	}
+	wn.peersLock.RUnlock()
+	for _, peer := range peers {
+		wn.log.Infof("disconnecting from peer %s on request of the node operator", peer.GetAddress())
+		wn.disconnect(peer, disconnectAdminRequest)
+	}
+	return len(peers)
+}
+
+// AddPriorityPeer connects to the relay at address, in the phonebook address format, and keeps it connected
+// in addition to the GossipFanout peers until duration elapses: it is reconnected if the connection is lost,
+// and is never the peer disconnected to find better performing ones.
+func (wn *WebsocketNetwork) AddPriorityPeer(address string, duration time.Duration) error {
+	if duration <= 0 {
+		return errInvalidPriorityPeerDuration
+	}
+	if _, err := wn.addrToGossipAddr(address); err != nil {
+		return err
+	}
+	wn.priorityPeersLock.Lock()
+	wn.priorityPeers[address] = time.Now().Add(duration)
+	wn.priorityPeersLock.Unlock()
+	wn.phonebook.Ban(address, time.Time{})
+	select {
+	case wn.meshUpdateRequests <- meshRequest{}:
+	default:
+		// a mesh update is already pending
+	}
+	return nil
+}
+
+// PhonebookEntries returns the entries of the phonebook.
+func (wn *WebsocketNetwork) PhonebookEntries() []phonebook.Entry {
+	return wn.phonebook.Entries()
+}
+
+// isPriorityPeer returns whether address is an unexpired priority peer.
+func (wn *WebsocketNetwork) isPriorityPeer(address string) bool {
+	wn.priorityPeersLock.Lock()
+	defer wn.priorityPeersLock.Unlock()
+	until, ok := wn.priorityPeers[address]
+	return ok && time.Now().Before(until)
+}
+
+func (wn *WebsocketNetwork) removePriorityPeer(address string) {
+	wn.priorityPeersLock.Lock()
+	defer wn.priorityPeersLock.Unlock()
+	delete(wn.priorityPeers, address)
+}
+
+// connectPriorityPeers connects to the priority peers not connected, and forgets the expired ones.
+// It returns whether any connection was started.
+func (wn *WebsocketNetwork) connectPriorityPeers() bool {
+	now := time.Now()
+	var addrs []string
+	wn.priorityPeersLock.Lock()
+	for addr, until := range wn.priorityPeers {
+		if now.Before(until) {
+			addrs = append(addrs, addr)
+		} else {
+			delete(wn.priorityPeers, addr)
+		}
+	}
+	wn.priorityPeersLock.Unlock()
+
+	started := false
+	for _, addr := range addrs {
+		if gossipAddr, ok := wn.tryConnectReserveAddr(addr); ok {
+			wn.wg.Add(1)
+			go wn.tryConnect(addr, gossipAddr)
+			started = true
+		}
+	}
+	return started
+}
+
+var _ PeerAdmin = (*WebsocketNetwork)(nil)
+var _ PeerAdmin = (*P2PNetwork)(nil)
+var _ PeerAdmin = (*HybridP2PNetwork)(nil)
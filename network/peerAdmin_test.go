@@ -0,0 +1,110 @@
+// Copyright (C) 2019-2025 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package network
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/algorand/go-algorand/network/phonebook"
+	"github.com/algorand/go-algorand/test/partitiontest"
+)
+
+func TestPeerAdminDisconnectAndBan(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	netA, netB, peerB := makeReputationTestNetworks(t)
+	addrA, _ := netA.Address()
+
+	infos := netB.PeersInfo()
+	require.Len(t, infos, 1)
+	require.Equal(t, addrA, infos[0].Address)
+	require.True(t, infos[0].Outgoing)
+	require.False(t, infos[0].Priority)
+	require.NotEmpty(t, infos[0].Version)
+
+	infos = netA.PeersInfo()
+	require.Len(t, infos, 1)
+	require.Equal(t, reputationAddress(peerB), infos[0].Address)
+	require.False(t, infos[0].Outgoing)
+
+	// an unknown address disconnects nothing
+	require.Zero(t, netB.DisconnectPeer("http://10.0.0.1:4160", 0))
+
+	require.Equal(t, 1, netB.DisconnectPeer(addrA, time.Hour))
+	require.Eventually(t, func() bool { return len(netB.GetPeers(PeersConnectedOut)) == 0 }, 5*time.Second, 10*time.Millisecond)
+	require.True(t, netB.phonebook.Banned(addrA))
+	require.Empty(t, netB.phonebook.GetAddresses(1, phonebook.RelayRole))
+	entries := netB.PhonebookEntries()
+	require.Len(t, entries, 1)
+	require.Equal(t, addrA, entries[0].Address)
+	require.False(t, entries[0].BannedUntil.IsZero())
+
+	// the banned address is not reconnected to
+	netB.RequestConnectOutgoing(false, nil)
+	time.Sleep(100 * time.Millisecond)
+	require.Empty(t, netB.GetPeers(PeersConnectedOut))
+
+	// and its incoming connections are refused
+	netA.DisconnectPeer("10.0.0.1", time.Hour)
+	require.Equal(t, http.StatusServiceUnavailable, netA.checkIncomingConnectionLimits(httptest.NewRecorder(), nil, "10.0.0.1", "", ""))
+	require.Equal(t, http.StatusOK, netA.checkIncomingConnectionLimits(httptest.NewRecorder(), nil, "10.0.0.2", "", ""))
+}
+
+func TestPeerAdminPriorityPeer(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	netA := makeTestWebsocketNode(t)
+	netA.config.GossipFanout = 1
+	netA.Start()
+	t.Cleanup(func() { netStop(t, netA, "A") })
+	addrA, postListen := netA.Address()
+	require.True(t, postListen)
+
+	// netB has no relays in its phonebook, and connects to netA only because it is a priority peer
+	netB := makeTestWebsocketNode(t)
+	netB.config.GossipFanout = 1
+	netB.Start()
+	t.Cleanup(func() { netStop(t, netB, "B") })
+
+	require.ErrorIs(t, netB.AddPriorityPeer(addrA, 0), errInvalidPriorityPeerDuration)
+	require.Error(t, netB.AddPriorityPeer("http://[::1", time.Minute))
+
+	netB.phonebook.Ban(addrA, time.Now().Add(time.Hour))
+	require.NoError(t, netB.AddPriorityPeer(addrA, time.Minute))
+	require.False(t, netB.phonebook.Banned(addrA))
+	require.Eventually(t, func() bool { return len(netB.GetPeers(PeersConnectedOut)) == 1 }, 5*time.Second, 10*time.Millisecond)
+	infos := netB.PeersInfo()
+	require.Len(t, infos, 1)
+	require.Equal(t, addrA, infos[0].Address)
+	require.True(t, infos[0].Priority)
+
+	// the priority peer is reconnected by the next mesh update when disconnected without a ban
+	require.Equal(t, 1, netB.DisconnectPeer(addrA, 0))
+	require.Eventually(t, func() bool { return len(netB.GetPeers(PeersConnectedOut)) == 0 }, 5*time.Second, 10*time.Millisecond)
+	netB.RequestConnectOutgoing(false, nil)
+	require.Eventually(t, func() bool { return len(netB.GetPeers(PeersConnectedOut)) == 1 }, 5*time.Second, 10*time.Millisecond)
+	require.True(t, netB.isPriorityPeer(addrA))
+
+	// and forgotten when banned
+	require.Equal(t, 1, netB.DisconnectPeer(addrA, time.Hour))
+	require.False(t, netB.isPriorityPeer(addrA))
+}
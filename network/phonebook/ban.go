@@ -0,0 +1,54 @@
+// Copyright (C) 2019-2025 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package phonebook
+
+import (
+	"time"
+)
+
+// Ban keeps addr from being returned by GetAddresses, and reported by Banned, until the given time.
+// A zero time lifts the ban.
+func (e *phonebookImpl) Ban(addr string, until time.Time) {
+	e.lock.Lock()
+	defer e.lock.Unlock()
+	now := time.Now()
+	for a, t := range e.bans {
+		if !now.Before(t) {
+			delete(e.bans, a)
+		}
+	}
+	if !now.Before(until) {
+		delete(e.bans, addr)
+		return
+	}
+	if e.bans == nil {
+		e.bans = make(map[string]time.Time)
+	}
+	e.bans[addr] = until
+}
+
+// Banned returns whether addr is banned.
+func (e *phonebookImpl) Banned(addr string) bool {
+	e.lock.RLock()
+	defer e.lock.RUnlock()
+	return e.banned(addr, time.Now())
+}
+
+func (e *phonebookImpl) banned(addr string, now time.Time) bool {
+	until, ok := e.bans[addr]
+	return ok && now.Before(until)
+}
@@ -20,6 +20,7 @@ import (
 	"math"
 	"math/rand"
 	"slices"
+	"strings"
 	"time"
 
 	"github.com/algorand/go-deadlock"
@@ -133,6 +134,34 @@ type Phonebook interface {
 	// i.e. they won't be replaced by ReplacePeerList calls.
 	// If a peer is already in the peerstore, its role will be updated.
 	AddPersistentPeers(dnsAddresses []string, networkName string, role Role)
+
+	// AddReputation adds delta, negative for a misbehaving peer, to the reputation score of addr
+	// and returns the new score. The scores are kept even for addresses that are not, or no longer,
+	// in the phonebook, and decay towards zero over time.
+	AddReputation(addr string, delta float64) float64
+
+	// GetReputation returns the reputation score of addr, or zero if it has none.
+	GetReputation(addr string) float64
+
+	// Ban keeps addr from being returned by GetAddresses until the given time, whether or not it is in
+	// the phonebook. A zero time lifts the ban.
+	Ban(addr string, until time.Time)
+
+	// Banned returns whether addr is banned.
+	Banned(addr string) bool
+
+	// Entries returns the addresses of the phonebook, and the banned addresses, sorted by address.
+	Entries() []Entry
+}
+
+// Entry describes an address of the phonebook.
+type Entry struct {
+	Address     string
+	Roles       RoleSet
+	Networks    []string
+	RetryAfter  time.Time
+	Reputation  float64
+	BannedUntil time.Time
 }
 
 // addressData: holds the information associated with each phonebook address.
@@ -169,6 +198,8 @@ type phonebookImpl struct {
 	connectionsRateLimitingCount  uint
 	connectionsRateLimitingWindow time.Duration
 	data                          map[string]addressData
+	reputations                   map[string]reputation
+	bans                          map[string]time.Time
 	lock                          deadlock.RWMutex
 }
 
@@ -210,7 +241,7 @@ func (e *phonebookImpl) appendTime(addr string, t time.Time) {
 func (e *phonebookImpl) filterRetryTime(t time.Time, role Role) []string {
 	o := make([]string, 0, len(e.data))
 	for addr, entry := range e.data {
-		if t.After(entry.retryAfter) && entry.roles.Has(role) {
+		if t.After(entry.retryAfter) && entry.roles.Has(role) && !e.banned(addr, t) {
 			o = append(o, addr)
 		}
 	}
@@ -402,11 +433,53 @@ func shuffleSelect(set []string, n int) []string {
 	return out
 }
 
-// GetAddresses returns up to N shuffled address
+// GetAddresses returns up to N shuffled address, preferring the addresses with the best reputation
 func (e *phonebookImpl) GetAddresses(n int, role Role) []string {
 	e.lock.RLock()
 	defer e.lock.RUnlock()
-	return shuffleSelect(e.filterRetryTime(time.Now(), role), n)
+	now := time.Now()
+	addrs := e.filterRetryTime(now, role)
+	if len(e.reputations) == 0 {
+		return shuffleSelect(addrs, n)
+	}
+	shuffleStrings(addrs)
+	e.sortByReputation(addrs, now)
+	if n < len(addrs) {
+		addrs = addrs[:n]
+	}
+	return addrs
+}
+
+// Entries returns the addresses of the phonebook, and the banned addresses, sorted by address.
+func (e *phonebookImpl) Entries() []Entry {
+	e.lock.RLock()
+	defer e.lock.RUnlock()
+	now := time.Now()
+	entries := make([]Entry, 0, len(e.data)+len(e.bans))
+	for addr, data := range e.data {
+		entry := Entry{
+			Address:    addr,
+			Roles:      data.roles,
+			Networks:   make([]string, 0, len(data.networkNames)),
+			RetryAfter: data.retryAfter,
+			Reputation: e.reputations[addr].at(now),
+		}
+		for name := range data.networkNames {
+			entry.Networks = append(entry.Networks, name)
+		}
+		slices.Sort(entry.Networks)
+		if e.banned(addr, now) {
+			entry.BannedUntil = e.bans[addr]
+		}
+		entries = append(entries, entry)
+	}
+	for addr, until := range e.bans {
+		if _, ok := e.data[addr]; !ok && now.Before(until) {
+			entries = append(entries, Entry{Address: addr, Reputation: e.reputations[addr].at(now), BannedUntil: until})
+		}
+	}
+	slices.SortFunc(entries, func(a, b Entry) int { return strings.Compare(a.Address, b.Address) })
+	return entries
 }
 
 // Length returns the number of addrs contained
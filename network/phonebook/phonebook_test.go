@@ -17,6 +17,7 @@
 package phonebook
 
 import (
+	"fmt"
 	"testing"
 	"time"
 
@@ -490,3 +491,99 @@ func TestRoleSetPersistence(t *testing.T) {
 		t.Run(test.name, test.fn)
 	}
 }
+
+func TestPhonebookReputation(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	pb := MakePhonebook(1, 1).(*phonebookImpl)
+	pb.ReplacePeerList([]string{"a", "b", "c"}, "default", RelayRole)
+	require.Zero(t, pb.GetReputation("a"))
+
+	require.Equal(t, float64(-10), pb.AddReputation("b", -10))
+	require.InDelta(t, -30, pb.AddReputation("b", -20), 0.1)
+	require.Equal(t, float64(-5), pb.AddReputation("c", -5))
+	require.InDelta(t, -30, pb.GetReputation("b"), 0.1)
+
+	// the addresses with the best reputation come first
+	for i := 0; i < 10; i++ {
+		require.Equal(t, []string{"a", "c", "b"}, pb.GetAddresses(3, RelayRole))
+		require.Equal(t, []string{"a"}, pb.GetAddresses(1, RelayRole))
+	}
+
+	// the scores outlive the entries of the phonebook
+	pb.ReplacePeerList([]string{"a"}, "default", RelayRole)
+	require.InDelta(t, -30, pb.GetReputation("b"), 0.1)
+	pb.ReplacePeerList([]string{"a", "b"}, "default", RelayRole)
+	require.Equal(t, []string{"a", "b"}, pb.GetAddresses(2, RelayRole))
+
+	// the scores decay
+	now := time.Now()
+	pb.reputations["b"] = reputation{score: -40, updated: now.Add(-reputationHalfLife)}
+	require.InDelta(t, -20, pb.GetReputation("b"), 0.1)
+	require.InDelta(t, -10, pb.addReputation("b", 0, now.Add(reputationHalfLife)), 0.1)
+}
+
+func TestPhonebookReputationPrune(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	pb := MakePhonebook(1, 1).(*phonebookImpl)
+	now := time.Now()
+	pb.addReputation("negligible", -0.1, now)
+	for i := 0; i < maxReputations-1; i++ {
+		pb.addReputation(fmt.Sprintf("addr%d", i), -float64(i+1), now)
+	}
+	require.Len(t, pb.reputations, maxReputations)
+
+	// the negligible scores are forgotten first
+	pb.addReputation("new", -100, now)
+	require.Len(t, pb.reputations, maxReputations)
+	require.NotContains(t, pb.reputations, "negligible")
+
+	// then the scores closest to zero
+	pb.addReputation("newer", -100, now)
+	require.Len(t, pb.reputations, maxReputations)
+	require.NotContains(t, pb.reputations, "addr0")
+	require.Contains(t, pb.reputations, "addr1")
+	require.Contains(t, pb.reputations, "newer")
+}
+
+func TestPhonebookBan(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	ph := MakePhonebook(1, 1).(*phonebookImpl)
+	ph.ReplacePeerList([]string{"relay1", "relay2"}, "default", RelayRole)
+
+	until := time.Now().Add(time.Hour)
+	ph.Ban("relay1", until)
+	ph.Ban("outsider", until)
+	require.True(t, ph.Banned("relay1"))
+	require.True(t, ph.Banned("outsider"))
+	require.False(t, ph.Banned("relay2"))
+	for k := 0; k < 10; k++ {
+		require.Equal(t, []string{"relay2"}, ph.GetAddresses(2, RelayRole))
+	}
+
+	entries := ph.Entries()
+	require.Len(t, entries, 3)
+	require.Equal(t, "outsider", entries[0].Address)
+	require.Equal(t, until, entries[0].BannedUntil)
+	require.False(t, entries[0].Roles.Has(RelayRole))
+	require.Equal(t, "relay1", entries[1].Address)
+	require.Equal(t, until, entries[1].BannedUntil)
+	require.True(t, entries[1].Roles.Has(RelayRole))
+	require.Equal(t, []string{"default"}, entries[1].Networks)
+	require.Equal(t, "relay2", entries[2].Address)
+	require.True(t, entries[2].BannedUntil.IsZero())
+
+	// lifting the ban
+	ph.Ban("relay1", time.Time{})
+	require.False(t, ph.Banned("relay1"))
+	require.ElementsMatch(t, []string{"relay1", "relay2"}, ph.GetAddresses(2, RelayRole))
+
+	// expired bans are forgotten
+	ph.Ban("outsider", time.Now().Add(-time.Second))
+	require.False(t, ph.Banned("outsider"))
+	require.Len(t, ph.Entries(), 2)
+}
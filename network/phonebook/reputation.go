@@ -0,0 +1,108 @@
+// Copyright (C) 2019-2025 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package phonebook
+
+import (
+	"cmp"
+	"math"
+	"slices"
+	"time"
+)
+
+// reputationHalfLife is the time it takes for a reputation score to decay to half of its value,
+// so that a peer that stops misbehaving eventually recovers.
+const reputationHalfLife = time.Hour
+
+// maxReputations is the number of scores kept before the scores closest to zero are forgotten.
+const maxReputations = 10000
+
+// negligibleReputation is the magnitude under which a score is forgotten when the scores are pruned.
+const negligibleReputation = 0.5
+
+// reputation is the score of an address at the time it was last updated.
+type reputation struct {
+	score   float64
+	updated time.Time
+}
+
+// at returns the score decayed to t.
+func (r reputation) at(t time.Time) float64 {
+	elapsed := t.Sub(r.updated)
+	if elapsed <= 0 {
+		return r.score
+	}
+	return r.score * math.Exp2(-float64(elapsed)/float64(reputationHalfLife))
+}
+
+// AddReputation adds delta to the reputation score of addr.
+func (e *phonebookImpl) AddReputation(addr string, delta float64) float64 {
+	e.lock.Lock()
+	defer e.lock.Unlock()
+	return e.addReputation(addr, delta, time.Now())
+}
+
+func (e *phonebookImpl) addReputation(addr string, delta float64, now time.Time) float64 {
+	if e.reputations == nil {
+		e.reputations = make(map[string]reputation)
+	}
+	r := reputation{score: e.reputations[addr].at(now) + delta, updated: now}
+	e.reputations[addr] = r
+	if len(e.reputations) > maxReputations {
+		e.pruneReputations(now)
+	}
+	return r.score
+}
+
+// GetReputation returns the reputation score of addr, or zero if it has none.
+func (e *phonebookImpl) GetReputation(addr string) float64 {
+	e.lock.RLock()
+	defer e.lock.RUnlock()
+	return e.reputations[addr].at(time.Now())
+}
+
+// pruneReputations forgets the negligible scores and, if there are still too many, the scores closest to zero.
+func (e *phonebookImpl) pruneReputations(now time.Time) {
+	for addr, r := range e.reputations {
+		if math.Abs(r.at(now)) < negligibleReputation {
+			delete(e.reputations, addr)
+		}
+	}
+	if len(e.reputations) <= maxReputations {
+		return
+	}
+	addrs := make([]string, 0, len(e.reputations))
+	for addr := range e.reputations {
+		addrs = append(addrs, addr)
+	}
+	slices.SortFunc(addrs, func(a, b string) int {
+		return cmp.Compare(math.Abs(e.reputations[a].at(now)), math.Abs(e.reputations[b].at(now)))
+	})
+	for _, addr := range addrs[:len(addrs)-maxReputations] {
+		delete(e.reputations, addr)
+	}
+}
+
+// sortByReputation stably sorts addrs by decreasing reputation score.
+func (e *phonebookImpl) sortByReputation(addrs []string, now time.Time) {
+	scores := make(map[string]float64, len(addrs))
+	for _, addr := range addrs {
+		scores[addr] = e.reputations[addr].at(now)
+	}
+	slices.SortStableFunc(addrs, func(a, b string) int {
+		return cmp.Compare(scores[b], scores[a])
+	})
+}
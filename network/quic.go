@@ -0,0 +1,455 @@
+// Copyright (C) 2019-2025 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package network
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"errors"
+	"fmt"
+	"io"
+	"math/big"
+	"net"
+	"net/http"
+	"net/url"
+	"time"
+
+	"github.com/algorand/websocket"
+	"github.com/gorilla/mux"
+	"github.com/quic-go/quic-go"
+)
+
+// The gossip connections over QUIC follow the websocket ones: the dialing relay opens the control stream and
+// sends the same HTTP request as to upgrade to websocket, which goes through the same request tracking, connection
+// limits and identity challenge. Once the accepting relay replied with http.StatusSwitchingProtocols, the dialing
+// relay opens a stream for each of the other classes. Every stream starts with its streamClass byte.
+
+// quicALPN is the application protocol negotiated by the gossip connections over QUIC
+const quicALPN = "algorand-gossip/1"
+
+// quicHandshakeTimeout is the time allowed to establish a QUIC connection and to open its streams
+const quicHandshakeTimeout = 5 * time.Second
+
+// quicUnreachableDuration is the time a relay which could not be reached over QUIC is only connected to over websocket
+const quicUnreachableDuration = 10 * time.Minute
+
+const (
+	// quicErrorNone closes a connection normally
+	quicErrorNone quic.ApplicationErrorCode = iota
+	// quicErrorRefused closes a connection refused for the incoming connections limit
+	quicErrorRefused
+	// quicErrorProtocol closes a connection which peer did not follow the handshake
+	quicErrorProtocol
+)
+
+func makeQUICConfig() *quic.Config {
+	return &quic.Config{
+		HandshakeIdleTimeout: quicHandshakeTimeout,
+		MaxIdleTimeout:       time.Minute,
+		KeepAlivePeriod:      20 * time.Second,
+		MaxIncomingStreams:   int64(numStreamClasses),
+	}
+}
+
+// makeQUICCertificate creates the self-signed certificate used when no TLSCertFile is configured. As for the
+// websocket connections over http, the relays are not authenticated by their certificates.
+func makeQUICCertificate() (tls.Certificate, error) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		return tls.Certificate{}, err
+	}
+	serial, err := rand.Int(rand.Reader, new(big.Int).Lsh(big.NewInt(1), 64))
+	if err != nil {
+		return tls.Certificate{}, err
+	}
+	template := x509.Certificate{
+		SerialNumber: serial,
+		Subject:      pkix.Name{CommonName: "algod"},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(10 * 365 * 24 * time.Hour),
+		KeyUsage:     x509.KeyUsageDigitalSignature,
+		ExtKeyUsage:  []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth},
+	}
+	der, err := x509.CreateCertificate(rand.Reader, &template, &template, &key.PublicKey, key)
+	if err != nil {
+		return tls.Certificate{}, err
+	}
+	return tls.Certificate{Certificate: [][]byte{der}, PrivateKey: key}, nil
+}
+
+// listenQUIC listens for QUIC connections on the UDP port matching the TCP port of the websocket listener.
+func (wn *WebsocketNetwork) listenQUIC() error {
+	var cert tls.Certificate
+	var err error
+	if wn.config.TLSCertFile != "" && wn.config.TLSKeyFile != "" {
+		cert, err = tls.LoadX509KeyPair(wn.config.TLSCertFile, wn.config.TLSKeyFile)
+	} else {
+		cert, err = makeQUICCertificate()
+	}
+	if err != nil {
+		return err
+	}
+	tlsConfig := &tls.Config{
+		Certificates: []tls.Certificate{cert},
+		NextProtos:   []string{quicALPN},
+		MinVersion:   tls.VersionTLS13,
+	}
+	listener, err := quic.ListenAddr(wn.listener.Addr().String(), tlsConfig, makeQUICConfig())
+	if err != nil {
+		return err
+	}
+	wn.quicListener = listener
+	wn.log.Debugf("listening for QUIC connections on %s", listener.Addr().String())
+	return nil
+}
+
+func (wn *WebsocketNetwork) quicAcceptThread() {
+	defer wn.wg.Done()
+	for {
+		conn, err := wn.quicListener.Accept(wn.ctx)
+		if err != nil {
+			if wn.ctx.Err() == nil && !errors.Is(err, quic.ErrServerClosed) {
+				wn.log.Infof("ws net QUIC listener exited: %v", err)
+			}
+			return
+		}
+		// limit the QUIC connections as the websocket listener limits the TCP connections
+		if int(wn.quicConnections.Load()) >= wn.config.IncomingConnectionsLimit {
+			networkConnectionsDroppedTotal.Inc(map[string]string{"reason": "quic_connection_limit"})
+			conn.CloseWithError(quicErrorRefused, "connection limit")
+			continue
+		}
+		wn.quicConnections.Add(1)
+		context.AfterFunc(conn.Context(), func() { wn.quicConnections.Add(-1) })
+		wn.wg.Add(1)
+		go wn.serveQUIC(conn)
+	}
+}
+
+// quicUpgraderKey is the request context key of the quicUpgrader of a request received over QUIC
+type quicUpgraderKey struct{}
+
+// quicUpgrader replies to a request received over QUIC with http.StatusSwitchingProtocols and responseHeader,
+// and returns the connection once the peer opened all its streams.
+type quicUpgrader func(responseHeader http.Header) (wsPeerWebsocketConn, error)
+
+// quicResponseWriter is the http.ResponseWriter of a request received over QUIC which is not upgraded.
+type quicResponseWriter struct {
+	header   http.Header
+	status   int
+	body     bytes.Buffer
+	upgraded bool
+}
+
+func (w *quicResponseWriter) Header() http.Header {
+	return w.header
+}
+
+func (w *quicResponseWriter) WriteHeader(status int) {
+	if w.status == 0 {
+		w.status = status
+	}
+}
+
+func (w *quicResponseWriter) Write(b []byte) (int, error) {
+	w.WriteHeader(http.StatusOK)
+	return w.body.Write(b)
+}
+
+// quicStreamReader reports a connection closed normally by the peer as the websocket normal closure wsPeer expects.
+type quicStreamReader struct {
+	io.Reader
+}
+
+func (r quicStreamReader) Read(b []byte) (int, error) {
+	n, err := r.Reader.Read(b)
+	var appErr *quic.ApplicationError
+	if errors.As(err, &appErr) && appErr.Remote && appErr.ErrorCode == quicErrorNone {
+		err = &websocket.CloseError{Code: websocket.CloseNormalClosure}
+	}
+	return n, err
+}
+
+func makeQUICStreamConn(conn quic.Connection) *multiStreamConn {
+	return makeMultiStreamConn(conn.RemoteAddr(), conn.RemoteAddr().String(), func() error {
+		return conn.CloseWithError(quicErrorNone, "")
+	})
+}
+
+// acceptQUICStream accepts the next stream of conn, and returns it with its class.
+func acceptQUICStream(ctx context.Context, conn quic.Connection) (quic.Stream, streamClass, error) {
+	stream, err := conn.AcceptStream(ctx)
+	if err != nil {
+		return nil, 0, err
+	}
+	var class [1]byte
+	if deadline, ok := ctx.Deadline(); ok {
+		stream.SetReadDeadline(deadline)
+	}
+	if _, err = io.ReadFull(stream, class[:]); err != nil {
+		return nil, 0, err
+	}
+	stream.SetReadDeadline(time.Time{})
+	if streamClass(class[0]) >= numStreamClasses {
+		return nil, 0, fmt.Errorf("invalid stream class %d", class[0])
+	}
+	return stream, streamClass(class[0]), nil
+}
+
+// openQUICStream opens a stream of class on conn.
+func openQUICStream(ctx context.Context, conn quic.Connection, class streamClass) (quic.Stream, error) {
+	stream, err := conn.OpenStreamSync(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if _, err = stream.Write([]byte{byte(class)}); err != nil {
+		return nil, err
+	}
+	return stream, nil
+}
+
+// serveQUIC reads the handshake request of an incoming QUIC connection, and passes it to the handler of
+// the http server, which upgrades the connection when the request is accepted.
+func (wn *WebsocketNetwork) serveQUIC(conn quic.Connection) {
+	defer wn.wg.Done()
+	ctx, cancel := context.WithTimeout(wn.ctx, httpServerReadHeaderTimeout)
+	defer cancel()
+	control, class, err := acceptQUICStream(ctx, conn)
+	if err != nil || class != streamClassControl {
+		networkConnectionsDroppedTotal.Inc(map[string]string{"reason": "quic handshake fail"})
+		conn.CloseWithError(quicErrorProtocol, "no control stream")
+		return
+	}
+	control.SetReadDeadline(time.Now().Add(httpServerReadHeaderTimeout))
+	reader := bufio.NewReader(io.LimitReader(control, httpServerMaxHeaderBytes))
+	request, err := http.ReadRequest(reader)
+	// the peer does not send anything else before the response
+	if err != nil || reader.Buffered() > 0 {
+		networkConnectionsDroppedTotal.Inc(map[string]string{"reason": "quic handshake fail"})
+		conn.CloseWithError(quicErrorProtocol, "bad request")
+		return
+	}
+	control.SetReadDeadline(time.Time{})
+	request.RemoteAddr = conn.RemoteAddr().String()
+
+	response := &quicResponseWriter{header: make(http.Header)}
+	var peerConn wsPeerWebsocketConn
+	var match mux.RouteMatch
+	if !wn.router.Match(request, &match) || match.Handler != http.Handler(wn) {
+		// only the gossip connections are served over QUIC
+		response.WriteHeader(http.StatusNotFound)
+	} else {
+		upgrade := func(responseHeader http.Header) (wsPeerWebsocketConn, error) {
+			response.upgraded = true
+			control.SetWriteDeadline(time.Now().Add(quicHandshakeTimeout))
+			upgradeResponse := http.Response{StatusCode: http.StatusSwitchingProtocols, ProtoMajor: 1, ProtoMinor: 1, Header: responseHeader}
+			if err := upgradeResponse.Write(control); err != nil {
+				return nil, err
+			}
+			control.SetWriteDeadline(time.Time{})
+			c := makeQUICStreamConn(conn)
+			if err := c.attach(streamClassControl, quicStreamReader{control}, control, nil); err != nil {
+				return nil, err
+			}
+			acceptCtx, acceptCancel := context.WithTimeout(wn.ctx, quicHandshakeTimeout)
+			defer acceptCancel()
+			for range numStreamClasses - 1 {
+				stream, class, err := acceptQUICStream(acceptCtx, conn)
+				if err == nil {
+					err = c.attach(class, quicStreamReader{stream}, stream, nil)
+				}
+				if err != nil {
+					c.fail(err)
+					return nil, err
+				}
+			}
+			peerConn = c
+			return c, nil
+		}
+		// the request tracker identifies the connection of a request by its local address, which is shared by all the
+		// QUIC connections: use a distinct address instead.
+		localAddr := &net.UDPAddr{}
+		if udpAddr, ok := conn.LocalAddr().(*net.UDPAddr); ok {
+			*localAddr = *udpAddr
+		}
+		requestCtx := context.WithValue(wn.ctx, http.LocalAddrContextKey, net.Addr(localAddr))
+		requestCtx = context.WithValue(requestCtx, quicUpgraderKey{}, quicUpgrader(upgrade))
+		wn.server.Handler.ServeHTTP(response, request.WithContext(requestCtx))
+	}
+	if response.upgraded {
+		if peerConn == nil {
+			conn.CloseWithError(quicErrorProtocol, "upgrade failed")
+		}
+		return
+	}
+
+	if response.status == 0 {
+		response.status = http.StatusOK
+	}
+	reply := http.Response{
+		StatusCode:    response.status,
+		ProtoMajor:    1,
+		ProtoMinor:    1,
+		Header:        response.header,
+		ContentLength: int64(response.body.Len()),
+		Body:          io.NopCloser(&response.body),
+	}
+	control.SetWriteDeadline(time.Now().Add(quicHandshakeTimeout))
+	if err := reply.Write(control); err == nil {
+		control.Close()
+		// let the peer read the response and close the connection
+		select {
+		case <-conn.Context().Done():
+		case <-time.After(quicHandshakeTimeout):
+		case <-wn.ctx.Done():
+		}
+	}
+	conn.CloseWithError(quicErrorNone, "")
+}
+
+// upgrade completes the handshake of an incoming gossip connection, over websocket or over the QUIC connection the request was received on.
+func (wn *WebsocketNetwork) upgrade(response http.ResponseWriter, request *http.Request, responseHeader http.Header) (wsPeerWebsocketConn, error) {
+	if upgrader, ok := request.Context().Value(quicUpgraderKey{}).(quicUpgrader); ok {
+		return upgrader(responseHeader)
+	}
+	conn, err := wn.upgrader.Upgrade(response, request, responseHeader)
+	if err != nil {
+		return nil, err
+	}
+	return wsPeerWebsocketConnImpl{conn}, nil
+}
+
+// dialQUIC opens a gossip connection over QUIC to gossipAddr. The response is returned whenever the peer
+// replied to the handshake, with websocket.ErrBadHandshake when the connection was not upgraded.
+func (wn *WebsocketNetwork) dialQUIC(gossipAddr string, requestHeader http.Header) (wsPeerWebsocketConn, *http.Response, error) {
+	requestURL, err := url.Parse(gossipAddr)
+	if err != nil {
+		return nil, nil, err
+	}
+	requestURL.Scheme = "http"
+	if wn.scheme == "https" {
+		requestURL.Scheme = "https"
+	}
+	ctx, cancel := context.WithTimeout(wn.ctx, quicHandshakeTimeout)
+	defer cancel()
+	tlsConfig := &tls.Config{
+		// the relays are authenticated by the identity challenge rather than by their certificates
+		InsecureSkipVerify: true, //nolint:gosec
+		NextProtos:         []string{quicALPN},
+		MinVersion:         tls.VersionTLS13,
+	}
+	conn, err := quic.DialAddr(ctx, requestURL.Host, tlsConfig, makeQUICConfig())
+	if err != nil {
+		return nil, nil, err
+	}
+	upgraded := false
+	defer func() {
+		if !upgraded {
+			conn.CloseWithError(quicErrorNone, "")
+		}
+	}()
+	control, err := openQUICStream(ctx, conn, streamClassControl)
+	if err != nil {
+		return nil, nil, err
+	}
+	request, err := http.NewRequestWithContext(ctx, http.MethodGet, requestURL.String(), nil)
+	if err != nil {
+		return nil, nil, err
+	}
+	request.Header = requestHeader
+	control.SetDeadline(time.Now().Add(quicHandshakeTimeout))
+	if err = request.Write(control); err != nil {
+		return nil, nil, err
+	}
+	reader := bufio.NewReader(control)
+	response, err := http.ReadResponse(reader, request)
+	if err != nil {
+		return nil, nil, err
+	}
+	if response.StatusCode != http.StatusSwitchingProtocols {
+		body, _ := io.ReadAll(io.LimitReader(response.Body, 1024))
+		response.Body = io.NopCloser(bytes.NewReader(body))
+		return nil, response, websocket.ErrBadHandshake
+	}
+	control.SetDeadline(time.Time{})
+	c := makeQUICStreamConn(conn)
+	if err = c.attach(streamClassControl, quicStreamReader{reader}, control, nil); err != nil {
+		return nil, response, err
+	}
+	for class := streamClassControl + 1; class < numStreamClasses; class++ {
+		var stream quic.Stream
+		stream, err = openQUICStream(ctx, conn, class)
+		if err == nil {
+			err = c.attach(class, quicStreamReader{stream}, stream, nil)
+		}
+		if err != nil {
+			c.fail(err)
+			return nil, response, err
+		}
+	}
+	upgraded = true
+	return c, response, nil
+}
+
+// dial opens a gossip connection to gossipAddr, over QUIC when both ends are relays supporting it, or over websocket.
+func (wn *WebsocketNetwork) dial(gossipAddr string, requestHeader http.Header) (wsPeerWebsocketConn, *http.Response, error) {
+	if wn.quicListener != nil && wn.quicReachable(gossipAddr) {
+		conn, response, err := wn.dialQUIC(gossipAddr, requestHeader)
+		if err == nil || response != nil {
+			return conn, response, err
+		}
+		wn.log.Infof("QUIC connect(%s) fail, connecting over websocket: %v", gossipAddr, err)
+		wn.quicUnreachableLock.Lock()
+		wn.quicUnreachable[gossipAddr] = time.Now().Add(quicUnreachableDuration)
+		wn.quicUnreachableLock.Unlock()
+	}
+
+	var websocketDialer = websocket.Dialer{
+		Proxy:             http.ProxyFromEnvironment,
+		HandshakeTimeout:  45 * time.Second,
+		EnableCompression: false,
+		NetDialContext:    wn.dialer.DialContext,
+		NetDial:           wn.dialer.Dial,
+		MaxHeaderSize:     wn.wsMaxHeaderBytes,
+	}
+	conn, response, err := websocketDialer.DialContext(wn.ctx, gossipAddr, requestHeader)
+	if err != nil {
+		return nil, response, err
+	}
+	return wsPeerWebsocketConnImpl{conn}, response, nil
+}
+
+// quicReachable returns false if gossipAddr recently could not be reached over QUIC.
+func (wn *WebsocketNetwork) quicReachable(gossipAddr string) bool {
+	wn.quicUnreachableLock.Lock()
+	defer wn.quicUnreachableLock.Unlock()
+	now := time.Now()
+	for addr, until := range wn.quicUnreachable {
+		if now.After(until) {
+			delete(wn.quicUnreachable, addr)
+		}
+	}
+	_, unreachable := wn.quicUnreachable[gossipAddr]
+	return !unreachable
+}
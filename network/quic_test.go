@@ -0,0 +1,295 @@
+// Copyright (C) 2019-2025 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package network
+
+import (
+	"context"
+	"go/version"
+	"io"
+	"net"
+	"net/http"
+	"runtime"
+	"testing"
+	"time"
+
+	"github.com/algorand/websocket"
+	"github.com/libp2p/go-libp2p/core/peer"
+	ma "github.com/multiformats/go-multiaddr"
+	"github.com/stretchr/testify/require"
+
+	"github.com/algorand/go-algorand/config"
+	"github.com/algorand/go-algorand/logging"
+	"github.com/algorand/go-algorand/network/phonebook"
+	"github.com/algorand/go-algorand/protocol"
+	"github.com/algorand/go-algorand/test/partitiontest"
+)
+
+func TestTagStreamClass(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	require.Equal(t, streamClassAgreement, tagStreamClass(protocol.AgreementVoteTag))
+	require.Equal(t, streamClassAgreement, tagStreamClass(protocol.VoteBundleTag))
+	require.Equal(t, streamClassProposal, tagStreamClass(protocol.ProposalPayloadTag))
+	require.Equal(t, streamClassTxn, tagStreamClass(protocol.TxnTag))
+	require.Equal(t, streamClassTxn, tagStreamClass(protocol.TxnReconciliationTag))
+	require.Equal(t, streamClassControl, tagStreamClass(protocol.MsgOfInterestTag))
+	require.Equal(t, streamClassControl, tagStreamClass(protocol.UniEnsBlockReqTag))
+}
+
+// gatedWriter blocks the writes until its gate is closed.
+type gatedWriter struct {
+	io.Writer
+	gate chan struct{}
+}
+
+func (w gatedWriter) Write(b []byte) (int, error) {
+	<-w.gate
+	return w.Writer.Write(b)
+}
+
+func nextMessage(t *testing.T, c *multiStreamConn) []byte {
+	mtype, reader, err := c.NextReader()
+	require.NoError(t, err)
+	require.Equal(t, websocket.BinaryMessage, mtype)
+	msg, err := io.ReadAll(reader)
+	require.NoError(t, err)
+	return msg
+}
+
+func TestMultiStreamConn(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	a := makeMultiStreamConn(nil, "a", nil)
+	b := makeMultiStreamConn(nil, "b", nil)
+	gate := make(chan struct{})
+	for class := streamClassControl; class < numStreamClasses; class++ {
+		endA, endB := net.Pipe()
+		var w io.Writer = endA
+		if class == streamClassProposal {
+			w = gatedWriter{Writer: endA, gate: gate}
+		}
+		require.NoError(t, a.attach(class, endA, w, endA))
+		require.NoError(t, b.attach(class, endB, endB, endB))
+	}
+	require.Equal(t, int(numStreamClasses), a.attached())
+	require.Error(t, a.attach(streamClassTxn, nil, nil, nil))
+	require.Error(t, a.attach(numStreamClasses, nil, nil, nil))
+
+	// a vote is not delayed by the proposal written before it
+	proposal := append([]byte(protocol.ProposalPayloadTag), make([]byte, 100000)...)
+	vote := append([]byte(protocol.AgreementVoteTag), "vote"...)
+	require.NoError(t, a.WriteMessage(websocket.BinaryMessage, proposal))
+	require.NoError(t, a.WriteMessage(websocket.BinaryMessage, vote))
+	require.Equal(t, vote, nextMessage(t, b))
+	close(gate)
+	require.Equal(t, proposal, nextMessage(t, b))
+
+	// messages are sent both ways, and too long messages close the connection
+	require.NoError(t, b.WriteMessage(websocket.BinaryMessage, []byte("MIfoo")))
+	require.Equal(t, []byte("MIfoo"), nextMessage(t, a))
+	a.SetReadLimit(4)
+	require.NoError(t, b.WriteMessage(websocket.BinaryMessage, []byte("TXfoo")))
+	_, _, err := a.NextReader()
+	require.ErrorContains(t, err, "message too long")
+
+	// closing one end closes the other one
+	_, _, err = b.NextReader()
+	require.Error(t, err)
+	require.Error(t, b.WriteMessage(websocket.BinaryMessage, vote))
+	require.Error(t, b.attach(streamClassTxn, nil, nil, nil))
+}
+
+func makeQUICTestNode(t *testing.T, enableQUIC bool) *WebsocketNetwork {
+	wn := makeTestWebsocketNode(t)
+	wn.config.EnableQUIC = enableQUIC
+	wn.config.PublicAddress = testingPublicAddress
+	wn.config.GossipFanout = 1
+	return wn
+}
+
+// connectQUICTestNodes connects netB to netA, and returns the peer of netB in netA and the one of netA in netB.
+func connectQUICTestNodes(t *testing.T, netA, netB *WebsocketNetwork) (peerB, peerA *wsPeer) {
+	require.NoError(t, netA.Start())
+	t.Cleanup(func() { netStop(t, netA, "A") })
+	addrA, postListen := netA.Address()
+	require.True(t, postListen)
+	// the identity challenge is only answered for the host:port address format of the phonebook
+	netB.phonebook.ReplacePeerList([]string{hostAndPort(addrA)}, "default", phonebook.RelayRole)
+	require.NoError(t, netB.Start())
+	t.Cleanup(func() { netStop(t, netB, "B") })
+
+	readyTimeout := time.NewTimer(2 * quicHandshakeTimeout)
+	waitReady(t, netA, readyTimeout.C)
+	waitReady(t, netB, readyTimeout.C)
+	peers := netA.GetPeers(PeersConnectedIn)
+	require.Len(t, peers, 1)
+	peerB = peers[0].(*wsPeer)
+	peers = netB.GetPeers(PeersConnectedOut)
+	require.Len(t, peers, 1)
+	peerA = peers[0].(*wsPeer)
+	return peerB, peerA
+}
+
+func TestQUICConnection(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	netA := makeQUICTestNode(t, true)
+	netB := makeQUICTestNode(t, true)
+	counter := newMessageCounter(t, 2)
+	counterDone := counter.done
+	netA.RegisterHandlers([]TaggedMessageHandler{
+		{Tag: protocol.AgreementVoteTag, MessageHandler: counter},
+		{Tag: protocol.ProposalPayloadTag, MessageHandler: counter},
+	})
+	peerB, peerA := connectQUICTestNodes(t, netA, netB)
+
+	for _, p := range []*wsPeer{peerA, peerB} {
+		conn, ok := p.conn.(*multiStreamConn)
+		require.True(t, ok)
+		require.Equal(t, int(numStreamClasses), conn.attached())
+	}
+	require.EqualValues(t, 1, netA.quicConnections.Load())
+
+	// the identity challenge is verified as over websocket
+	require.NotEqual(t, peerB.identity, peerA.identity)
+	require.Eventually(t, func() bool {
+		return peerA.identityVerified.Load() == 1 && peerB.identityVerified.Load() == 1
+	}, 5*time.Second, 10*time.Millisecond)
+
+	require.NoError(t, netB.Broadcast(context.Background(), protocol.ProposalPayloadTag, make([]byte, 1000000), true, nil))
+	require.NoError(t, netB.Broadcast(context.Background(), protocol.AgreementVoteTag, []byte("vote"), true, nil))
+	select {
+	case <-counterDone:
+	case <-time.After(5 * time.Second):
+		require.Fail(t, "timeout waiting for the messages")
+	}
+
+	// closing the peer closes the connection normally
+	netB.DisconnectPeers()
+	require.Eventually(t, func() bool {
+		return len(netA.GetPeers(PeersConnectedIn)) == 0 && netA.quicConnections.Load() == 0
+	}, 5*time.Second, 10*time.Millisecond)
+}
+
+func TestQUICFallback(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	netA := makeQUICTestNode(t, false)
+	netB := makeQUICTestNode(t, true)
+	peerB, peerA := connectQUICTestNodes(t, netA, netB)
+
+	require.IsType(t, wsPeerWebsocketConnImpl{}, peerA.conn)
+	require.IsType(t, wsPeerWebsocketConnImpl{}, peerB.conn)
+	addrA, _ := netA.Address()
+	gossipA, err := netB.addrToGossipAddr(addrA)
+	require.NoError(t, err)
+	require.False(t, netB.quicReachable(gossipA))
+}
+
+func TestQUICHandshakeRefused(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	netA := makeQUICTestNode(t, true)
+	netA.config.MaxConnectionsPerIP = 0
+	netB := makeQUICTestNode(t, true)
+	require.NoError(t, netA.Start())
+	defer netStop(t, netA, "A")
+	require.NoError(t, netB.Start())
+	defer netStop(t, netB, "B")
+
+	addrA, _ := netA.Address()
+	gossipA, err := netB.addrToGossipAddr(addrA)
+	require.NoError(t, err)
+
+	// the connection limits apply to the QUIC connections
+	conn, response, err := netB.dialQUIC(gossipA, make(http.Header))
+	require.ErrorIs(t, err, websocket.ErrBadHandshake)
+	require.Nil(t, conn)
+	require.Equal(t, http.StatusServiceUnavailable, response.StatusCode)
+
+	// and only the gossip connections are served over QUIC
+	_, response, err = netB.dialQUIC(addrA+"/v1/"+genesisID+"/block/1", make(http.Header))
+	require.ErrorIs(t, err, websocket.ErrBadHandshake)
+	require.Equal(t, http.StatusNotFound, response.StatusCode)
+
+	// a refused handshake does not make the peer unreachable over QUIC
+	require.True(t, netB.quicReachable(gossipA))
+	require.Eventually(t, func() bool { return netA.quicConnections.Load() == 0 }, 5*time.Second, 10*time.Millisecond)
+}
+
+func TestP2PMultiStreamQUIC(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	// crypto/tls stopped emitting an event for disabled session tickets in go1.24, which the quic-go version
+	// required by libp2p does not expect: it panics on the first connection accepted.
+	if version.Compare(runtime.Version(), "go1.24") >= 0 {
+		t.Skipf("libp2p QUIC transport is not supported by %s", runtime.Version())
+	}
+
+	cfg := config.GetDefaultLocal()
+	cfg.NetAddress = "127.0.0.1:0"
+	cfg.EnableQUIC = true
+	log := logging.TestingLog(t)
+	netA, err := NewP2PNetwork(log, cfg, "", nil, genesisID, config.Devtestnet, &nopeNodeInfo{}, nil)
+	require.NoError(t, err)
+	require.NoError(t, netA.Start())
+	defer netA.Stop()
+
+	// connect over the QUIC address of A only
+	peerInfoA := netA.service.AddrInfo()
+	var quicAddrs []ma.Multiaddr
+	for _, addr := range peerInfoA.Addrs {
+		if _, err := addr.ValueForProtocol(ma.P_QUIC_V1); err == nil {
+			quicAddrs = append(quicAddrs, addr)
+		}
+	}
+	require.NotEmpty(t, quicAddrs)
+	addrsA, err := peer.AddrInfoToP2pAddrs(&peer.AddrInfo{ID: peerInfoA.ID, Addrs: quicAddrs})
+	require.NoError(t, err)
+	netB, err := NewP2PNetwork(log, cfg, "", []string{addrsA[0].String()}, genesisID, config.Devtestnet, &nopeNodeInfo{}, nil)
+	require.NoError(t, err)
+	require.NoError(t, netB.Start())
+	defer netB.Stop()
+
+	attached := func(n *P2PNetwork) bool {
+		n.wsPeersLock.RLock()
+		defer n.wsPeersLock.RUnlock()
+		for _, wsp := range n.wsPeers {
+			if conn, ok := wsp.conn.(*multiStreamConnP2P); ok && conn.attached() == int(numStreamClasses) {
+				return true
+			}
+		}
+		return false
+	}
+	require.Eventually(t, func() bool { return attached(netA) && attached(netB) }, 5*time.Second, 50*time.Millisecond)
+	require.Empty(t, netA.pendingClassStreams)
+	peerA := netB.GetPeers(PeersConnectedOut, PeersConnectedIn)[0].(*wsPeer)
+	require.IsType(t, &net.UDPAddr{}, peerA.conn.RemoteAddr())
+
+	counter := newMessageCounter(t, 2)
+	counterDone := counter.done
+	netA.RegisterHandlers([]TaggedMessageHandler{
+		{Tag: protocol.AgreementVoteTag, MessageHandler: counter},
+		{Tag: protocol.UniEnsBlockReqTag, MessageHandler: counter},
+	})
+	require.NoError(t, peerA.Unicast(context.Background(), []byte("vote"), protocol.AgreementVoteTag))
+	require.NoError(t, peerA.Unicast(context.Background(), []byte("request"), protocol.UniEnsBlockReqTag))
+	select {
+	case <-counterDone:
+	case <-time.After(5 * time.Second):
+		require.Fail(t, "timeout waiting for the messages")
+	}
+}
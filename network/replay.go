@@ -0,0 +1,98 @@
+// Copyright (C) 2019-2025 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package network
+
+import (
+	"context"
+	"io"
+	"time"
+
+	"github.com/algorand/go-algorand/network/messagetracer"
+)
+
+// ReplayOptions configures ReplayCapture.
+type ReplayOptions struct {
+	// Speed is the factor by which the original pace of the messages is accelerated:
+	// 1 replays the messages at their original pace, 10 ten times faster, and 0 as fast as possible.
+	Speed float64
+	// Tags restricts the replay to the given tags, if not empty.
+	Tags map[Tag]bool
+}
+
+// replayPeer stands for the peer a captured message was received from.
+type replayPeer struct {
+	net     GossipNode
+	address string
+}
+
+func (p *replayPeer) GetNetwork() GossipNode { return p.net }
+
+func (p *replayPeer) RoutingAddr() []byte { return []byte(p.address) }
+
+// GetAddress returns the address of the captured peer.
+func (p *replayPeer) GetAddress() string { return p.address }
+
+// ReplayCapture dispatches the incoming messages of a capture to handler, typically the
+// Multiplexer of a network, as if they were received from the peers they were captured from.
+// The outgoing messages of the capture and the actions returned by the handlers are ignored,
+// except for releasing the messages. It returns the number of messages replayed.
+func ReplayCapture(ctx context.Context, r *messagetracer.CaptureReader, handler MessageHandler, net GossipNode, opts ReplayOptions) (int, error) {
+	peers := make(map[string]*replayPeer)
+	var replayed int
+	var first time.Time
+	start := time.Now()
+	for {
+		rec, err := r.Next()
+		if err == io.EOF {
+			return replayed, nil
+		}
+		if err != nil {
+			return replayed, err
+		}
+		if rec.Direction != messagetracer.Incoming || (len(opts.Tags) > 0 && !opts.Tags[rec.Tag]) {
+			continue
+		}
+
+		if opts.Speed > 0 {
+			if first.IsZero() {
+				first = rec.Timestamp
+			}
+			offset := time.Duration(float64(rec.Timestamp.Sub(first)) / opts.Speed)
+			if wait := time.Until(start.Add(offset)); wait > 0 {
+				select {
+				case <-time.After(wait):
+				case <-ctx.Done():
+					return replayed, ctx.Err()
+				}
+			}
+		}
+		if err := ctx.Err(); err != nil {
+			return replayed, err
+		}
+
+		peer, ok := peers[rec.Peer]
+		if !ok {
+			peer = &replayPeer{net: net, address: rec.Peer}
+			peers[rec.Peer] = peer
+		}
+		out := handler.Handle(IncomingMessage{Sender: peer, Tag: rec.Tag, Data: rec.Data, Net: net, Received: time.Now().UnixNano()})
+		if out.OnRelease != nil {
+			out.OnRelease()
+		}
+		replayed++
+	}
+}
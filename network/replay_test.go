@@ -0,0 +1,163 @@
+// Copyright (C) 2019-2025 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package network
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/algorand/go-algorand/logging"
+	"github.com/algorand/go-algorand/network/messagetracer"
+	"github.com/algorand/go-algorand/network/phonebook"
+	"github.com/algorand/go-algorand/protocol"
+	"github.com/algorand/go-algorand/test/partitiontest"
+)
+
+type lockedBuffer struct {
+	mu  sync.Mutex
+	buf bytes.Buffer
+}
+
+func (b *lockedBuffer) Write(p []byte) (int, error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return b.buf.Write(p)
+}
+
+func (b *lockedBuffer) records(t *testing.T) []messagetracer.CaptureRecord {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	var out []messagetracer.CaptureRecord
+	r := messagetracer.MakeCaptureReader(bytes.NewReader(b.buf.Bytes()))
+	for {
+		rec, err := r.Next()
+		if err == io.EOF {
+			return out
+		}
+		require.NoError(t, err)
+		out = append(out, rec)
+	}
+}
+
+func TestNetworkCaptureReplay(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	var capA, capB lockedBuffer
+	netA := makeTestWebsocketNode(t)
+	netA.config.GossipFanout = 1
+	netA.SetCapture(messagetracer.MakeCapture(&capA, logging.TestingLog(t)))
+	netA.Start()
+	defer netStop(t, netA, "A")
+	netB := makeTestWebsocketNode(t)
+	netB.config.GossipFanout = 1
+	netB.SetCapture(messagetracer.MakeCapture(&capB, logging.TestingLog(t)))
+	addrA, postListen := netA.Address()
+	require.True(t, postListen)
+	netB.phonebook.ReplacePeerList([]string{addrA}, "default", phonebook.RelayRole)
+	netB.Start()
+	defer netStop(t, netB, "B")
+	counter := newMessageCounter(t, 2)
+	counterDone := counter.done
+	netB.RegisterHandlers([]TaggedMessageHandler{{Tag: protocol.TxnTag, MessageHandler: counter}})
+
+	readyTimeout := time.NewTimer(2 * time.Second)
+	waitReady(t, netA, readyTimeout.C)
+	waitReady(t, netB, readyTimeout.C)
+
+	netA.Broadcast(context.Background(), protocol.TxnTag, []byte("foo"), false, nil)
+	netA.Broadcast(context.Background(), protocol.TxnTag, []byte("bar"), false, nil)
+	select {
+	case <-counterDone:
+	case <-time.After(2 * time.Second):
+		require.Fail(t, "timeout waiting for the messages")
+	}
+
+	filter := func(recs []messagetracer.CaptureRecord, direction messagetracer.Direction) (out []messagetracer.CaptureRecord) {
+		for _, rec := range recs {
+			if rec.Direction == direction && rec.Tag == protocol.TxnTag {
+				out = append(out, rec)
+			}
+		}
+		return out
+	}
+	sent := filter(capA.records(t), messagetracer.Outgoing)
+	require.Len(t, sent, 2)
+	require.Equal(t, []byte("foo"), sent[0].Data)
+	require.Equal(t, []byte("bar"), sent[1].Data)
+	var received []messagetracer.CaptureRecord
+	require.Eventually(t, func() bool {
+		received = filter(capB.records(t), messagetracer.Incoming)
+		return len(received) == 2
+	}, 2*time.Second, 10*time.Millisecond)
+	require.Equal(t, []byte("foo"), received[0].Data)
+	require.Equal(t, []byte("bar"), received[1].Data)
+	require.NotEmpty(t, received[0].Peer)
+
+	// replay the capture of B, with a message from another peer and a vote in the middle
+	var replayCapture lockedBuffer
+	c := messagetracer.MakeCapture(&replayCapture, logging.TestingLog(t))
+	c.Record(messagetracer.Incoming, protocol.TxnTag, received[0].Peer, received[0].Data)
+	c.Record(messagetracer.Outgoing, protocol.TxnTag, received[0].Peer, []byte("out"))
+	c.Record(messagetracer.Incoming, protocol.AgreementVoteTag, received[0].Peer, []byte("vote"))
+	c.Record(messagetracer.Incoming, protocol.TxnTag, "other", []byte("baz"))
+	c.Record(messagetracer.Incoming, protocol.TxnTag, received[1].Peer, received[1].Data)
+
+	replay := func(opts ReplayOptions) (int, []IncomingMessage) {
+		var mux Multiplexer
+		var msgs []IncomingMessage
+		handler := HandlerFunc(func(msg IncomingMessage) OutgoingMessage {
+			msgs = append(msgs, msg)
+			return OutgoingMessage{Action: Ignore}
+		})
+		mux.RegisterHandlers([]TaggedMessageHandler{{Tag: protocol.TxnTag, MessageHandler: handler}, {Tag: protocol.AgreementVoteTag, MessageHandler: handler}})
+		r := messagetracer.MakeCaptureReader(bytes.NewReader(replayCapture.buf.Bytes()))
+		count, err := ReplayCapture(context.Background(), r, &mux, netB, opts)
+		require.NoError(t, err)
+		return count, msgs
+	}
+
+	count, msgs := replay(ReplayOptions{})
+	require.Equal(t, 4, count)
+	require.Len(t, msgs, 4)
+	require.Equal(t, "foo", string(msgs[0].Data))
+	require.Equal(t, protocol.AgreementVoteTag, msgs[1].Tag)
+	require.Equal(t, "baz", string(msgs[2].Data))
+	require.Equal(t, "bar", string(msgs[3].Data))
+	// messages from the same peer share their sender
+	require.Same(t, msgs[0].Sender, msgs[1].Sender)
+	require.Same(t, msgs[0].Sender, msgs[3].Sender)
+	require.NotSame(t, msgs[0].Sender, msgs[2].Sender)
+	require.Equal(t, "other", msgs[2].Sender.(*replayPeer).GetAddress())
+
+	count, msgs = replay(ReplayOptions{Speed: 1000, Tags: map[Tag]bool{protocol.AgreementVoteTag: true}})
+	require.Equal(t, 1, count)
+	require.Equal(t, "vote", string(msgs[0].Data))
+
+	// a canceled replay stops before dispatching anything
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	r := messagetracer.MakeCaptureReader(bytes.NewReader(replayCapture.buf.Bytes()))
+	count, err := ReplayCapture(ctx, r, &Multiplexer{}, netB, ReplayOptions{})
+	require.ErrorIs(t, err, context.Canceled)
+	require.Zero(t, count)
+}
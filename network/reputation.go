@@ -0,0 +1,182 @@
+// Copyright (C) 2019-2025 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package network
+
+import (
+	"hash/maphash"
+	"time"
+
+	"github.com/algorand/go-algorand/util/metrics"
+)
+
+// PeerEvent is a misbehavior of a peer which lowers its reputation.
+//
+//msgp:ignore PeerEvent
+type PeerEvent int
+
+const (
+	// PeerEventInvalidTxn is reported when a peer sends a transaction group which fails verification
+	PeerEventInvalidTxn PeerEvent = iota
+	// PeerEventInvalidVote is reported when a peer sends an invalid agreement vote or bundle
+	PeerEventInvalidVote
+	// PeerEventSlowBlockResponse is reported when a peer is slow to serve a block
+	PeerEventSlowBlockResponse
+	// PeerEventDuplicateFlood is reported when a peer keeps sending the same messages
+	PeerEventDuplicateFlood
+)
+
+var peerEventNames = [...]string{
+	PeerEventInvalidTxn:        "invalid_txn",
+	PeerEventInvalidVote:       "invalid_vote",
+	PeerEventSlowBlockResponse: "slow_block_response",
+	PeerEventDuplicateFlood:    "duplicate_flood",
+}
+
+// peerEventPenalties are the amounts by which each event lowers the reputation of a peer. An invalid
+// transaction or vote already gets the peer disconnected, and the penalty keeps it from coming right back.
+var peerEventPenalties = [...]float64{
+	PeerEventInvalidTxn:        10,
+	PeerEventInvalidVote:       20,
+	PeerEventSlowBlockResponse: 2,
+	PeerEventDuplicateFlood:    10,
+}
+
+func (e PeerEvent) String() string {
+	if e < 0 || int(e) >= len(peerEventNames) {
+		return "unknown"
+	}
+	return peerEventNames[e]
+}
+
+var networkPeerReputationEvents = metrics.NewTagCounter("algod_network_peer_reputation_{TAG}", "Number of {TAG} events lowering the reputation of a peer")
+var networkPeerReputationDrops = metrics.MakeCounter(metrics.MetricName{Name: "algod_network_reputation_drops_total", Description: "number of peers dropped or refused for their low reputation"})
+
+// reputationAddress returns the address the reputation of peer is kept for: the remote host of an incoming
+// connection, since its port differs on every connection, or the phonebook address of an outgoing one.
+// All the incoming peers sharing a remote host, such as the ones behind a NAT, therefore share a reputation.
+func reputationAddress(peer Peer) string {
+	switch p := peer.(type) {
+	case *wsPeer:
+		if p.originAddress != "" {
+			return p.originAddress
+		}
+		return p.GetAddress()
+	case *wsPeerCore:
+		return p.GetAddress()
+	}
+	return ""
+}
+
+// ReportPeerEvent lowers the reputation of peer for event, and disconnects the peer if its reputation
+// fell below PeerReputationDisconnectThreshold.
+func (wn *WebsocketNetwork) ReportPeerEvent(peer Peer, event PeerEvent) {
+	if !wn.config.EnablePeerReputation || event < 0 || int(event) >= len(peerEventPenalties) {
+		return
+	}
+	addr := reputationAddress(peer)
+	if addr == "" {
+		return
+	}
+	networkPeerReputationEvents.Add(event.String(), 1)
+	score := wn.phonebook.AddReputation(addr, -peerEventPenalties[event])
+	if score >= float64(wn.config.PeerReputationDisconnectThreshold) {
+		return
+	}
+
+	var bad []*wsPeer
+	wn.peersLock.RLock()
+	for _, p := range wn.peers {
+		if reputationAddress(p) == addr {
+			bad = append(bad, p)
+		}
+	}
+	wn.peersLock.RUnlock()
+	for _, p := range bad {
+		wn.log.Infof("disconnecting from peer %s: reputation %.1f after %s", p.GetAddress(), score, event)
+		networkPeerReputationDrops.Inc(nil)
+		// the event may be reported from the read loop of the peer, which disconnecting waits for
+		wn.wg.Add(1)
+		go wn.disconnectThread(p, disconnectBadReputation)
+	}
+}
+
+// badReputation returns whether the reputation of addr is below PeerReputationDisconnectThreshold.
+func (wn *WebsocketNetwork) badReputation(addr string) bool {
+	return wn.config.EnablePeerReputation && wn.phonebook.GetReputation(addr) < float64(wn.config.PeerReputationDisconnectThreshold)
+}
+
+// evictIncomingPeer disconnects the incoming peer with the lowest reputation, if it is negative and lower
+// than the one of remoteHost, to make room for remoteHost. It returns false if no peer was evicted.
+func (wn *WebsocketNetwork) evictIncomingPeer(remoteHost string) bool {
+	if !wn.config.EnablePeerReputation {
+		return false
+	}
+	var victim *wsPeer
+	lowest := min(0, wn.phonebook.GetReputation(remoteHost))
+	wn.peersLock.RLock()
+	for _, peer := range wn.peers {
+		if peer.outgoing {
+			continue
+		}
+		if score := wn.phonebook.GetReputation(reputationAddress(peer)); score < lowest {
+			victim, lowest = peer, score
+		}
+	}
+	wn.peersLock.RUnlock()
+	if victim == nil {
+		return false
+	}
+	wn.log.Infof("evicting peer %s with reputation %.1f to accept a connection from %s", victim.GetAddress(), lowest, remoteHost)
+	networkPeerReputationDrops.Inc(nil)
+	victim.CloseAndWait(time.Now().Add(peerShutdownDisconnectionAckDuration))
+	wn.removePeer(victim, disconnectBadReputation)
+	return true
+}
+
+// recentMessagesSize is the number of messages of a peer remembered to detect repeated messages.
+const recentMessagesSize = 64
+
+// duplicateFloodThreshold is the number of repeated messages from a peer for which a PeerEventDuplicateFlood is reported.
+const duplicateFloodThreshold = 100
+
+// recentMessages remembers the hashes of the last messages received from a peer. It is only used by the read loop of the peer.
+type recentMessages struct {
+	seed   maphash.Seed
+	hashes [recentMessagesSize]uint64
+	next   int
+}
+
+func makeRecentMessages() recentMessages {
+	return recentMessages{seed: maphash.MakeSeed()}
+}
+
+// repeated returns whether the peer recently sent the same message, and remembers it otherwise.
+func (r *recentMessages) repeated(tag Tag, data []byte) bool {
+	var h maphash.Hash
+	h.SetSeed(r.seed)
+	h.WriteString(string(tag))
+	h.Write(data)
+	sum := h.Sum64()
+	for _, s := range r.hashes {
+		if s == sum {
+			return true
+		}
+	}
+	r.hashes[r.next] = sum
+	r.next = (r.next + 1) % recentMessagesSize
+	return false
+}
@@ -0,0 +1,151 @@
+// Copyright (C) 2019-2025 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package network
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/algorand/go-algorand/network/phonebook"
+	"github.com/algorand/go-algorand/protocol"
+	"github.com/algorand/go-algorand/test/partitiontest"
+)
+
+func TestRecentMessages(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	r := makeRecentMessages()
+	require.False(t, r.repeated(protocol.TxnTag, []byte("foo")))
+	require.True(t, r.repeated(protocol.TxnTag, []byte("foo")))
+	require.False(t, r.repeated(protocol.AgreementVoteTag, []byte("foo")))
+
+	// only the last messages are remembered
+	for i := 0; i < recentMessagesSize; i++ {
+		require.False(t, r.repeated(protocol.TxnTag, []byte{byte(i)}))
+	}
+	require.False(t, r.repeated(protocol.TxnTag, []byte("foo")))
+}
+
+// makeReputationTestNetworks connects netB to netA, and returns the peer of netB in netA.
+func makeReputationTestNetworks(t *testing.T) (netA, netB *WebsocketNetwork, peerB *wsPeer) {
+	netA = makeTestWebsocketNode(t)
+	netA.config.GossipFanout = 1
+	netA.config.EnablePeerReputation = true
+	netA.Start()
+	t.Cleanup(func() { netStop(t, netA, "A") })
+	netB = makeTestWebsocketNode(t)
+	netB.config.GossipFanout = 1
+	netB.config.EnablePeerReputation = true
+	addrA, postListen := netA.Address()
+	require.True(t, postListen)
+	netB.phonebook.ReplacePeerList([]string{addrA}, "default", phonebook.RelayRole)
+	netB.Start()
+	t.Cleanup(func() { netStop(t, netB, "B") })
+
+	readyTimeout := time.NewTimer(2 * time.Second)
+	waitReady(t, netA, readyTimeout.C)
+	waitReady(t, netB, readyTimeout.C)
+	peers := netA.GetPeers(PeersConnectedIn)
+	require.Len(t, peers, 1)
+	return netA, netB, peers[0].(*wsPeer)
+}
+
+func TestPeerReputationDisconnect(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	netA, _, peerB := makeReputationTestNetworks(t)
+	addrB := reputationAddress(peerB)
+	require.Equal(t, peerB.OriginAddress(), addrB)
+
+	// the peer is disconnected once its score falls below the threshold
+	for i := 0; i < 5; i++ {
+		netA.ReportPeerEvent(peerB, PeerEventInvalidVote)
+	}
+	require.InDelta(t, -100, netA.phonebook.GetReputation(addrB), 0.1)
+	require.Len(t, netA.GetPeers(PeersConnectedIn), 1)
+	netA.ReportPeerEvent(peerB, PeerEventSlowBlockResponse)
+	require.Eventually(t, func() bool {
+		for _, p := range netA.GetPeers(PeersConnectedIn) {
+			if p == peerB {
+				return false
+			}
+		}
+		return true
+	}, 5*time.Second, 10*time.Millisecond)
+
+	// and refused until its score recovers
+	response := httptest.NewRecorder()
+	require.Equal(t, http.StatusServiceUnavailable, netA.checkIncomingConnectionLimits(response, nil, addrB, "", ""))
+	require.Equal(t, http.StatusOK, netA.checkIncomingConnectionLimits(httptest.NewRecorder(), nil, "10.0.0.1", "", ""))
+
+	// events are ignored when the reputation is disabled, or for the peers of other networks
+	netA.config.EnablePeerReputation = false
+	netA.ReportPeerEvent(peerB, PeerEventInvalidVote)
+	require.False(t, netA.badReputation(addrB))
+	netA.config.EnablePeerReputation = true
+	netA.ReportPeerEvent(nil, PeerEventInvalidVote)
+	netA.ReportPeerEvent(&wsPeerCore{rootURL: "http://other"}, PeerEventInvalidTxn)
+	require.InDelta(t, -10, netA.phonebook.GetReputation("http://other"), 0.1)
+}
+
+func TestPeerReputationEviction(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	netA, _, peerB := makeReputationTestNetworks(t)
+	netA.config.IncomingConnectionsLimit = 1
+	addrB := reputationAddress(peerB)
+
+	// a peer without negative reputation is not evicted
+	require.Equal(t, http.StatusServiceUnavailable, netA.checkIncomingConnectionLimits(httptest.NewRecorder(), nil, "10.0.0.1", "", ""))
+	require.Len(t, netA.GetPeers(PeersConnectedIn), 1)
+
+	// nor is it for a peer with a worse reputation
+	netA.ReportPeerEvent(peerB, PeerEventInvalidTxn)
+	netA.phonebook.AddReputation("10.0.0.1", -20)
+	require.Equal(t, http.StatusServiceUnavailable, netA.checkIncomingConnectionLimits(httptest.NewRecorder(), nil, "10.0.0.1", "", ""))
+	require.Len(t, netA.GetPeers(PeersConnectedIn), 1)
+
+	require.Equal(t, http.StatusOK, netA.checkIncomingConnectionLimits(httptest.NewRecorder(), nil, "10.0.0.2", "", ""))
+	for _, p := range netA.GetPeers(PeersConnectedIn) {
+		require.NotEqual(t, addrB, reputationAddress(p))
+	}
+}
+
+func TestPeerReputationDuplicateFlood(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	netA, netB, peerB := makeReputationTestNetworks(t)
+	counter := newMessageCounter(t, duplicateFloodThreshold+1)
+	counterDone := counter.done
+	netA.RegisterHandlers([]TaggedMessageHandler{{Tag: protocol.TxnTag, MessageHandler: counter}})
+
+	for i := 0; i <= duplicateFloodThreshold; i++ {
+		require.NoError(t, netB.Broadcast(context.Background(), protocol.TxnTag, []byte("foo"), true, nil))
+	}
+	select {
+	case <-counterDone:
+	case <-time.After(5 * time.Second):
+		require.Fail(t, "timeout waiting for the messages")
+	}
+	require.Equal(t, uint64(duplicateFloodThreshold), peerB.repeatedMessageCount.Load())
+	require.InDelta(t, -peerEventPenalties[PeerEventDuplicateFlood], netA.phonebook.GetReputation(reputationAddress(peerB)), 0.1)
+}
@@ -0,0 +1,843 @@
+// Copyright (C) 2019-2025 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package network
+
+import (
+	"bytes"
+	"container/heap"
+	"context"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"hash/fnv"
+	"io"
+	"math/rand"
+	"net/http"
+	"net/url"
+	"sort"
+	"strings"
+	"sync/atomic"
+	"time"
+
+	"github.com/algorand/go-deadlock"
+	"github.com/gorilla/mux"
+
+	"github.com/algorand/go-algorand/config"
+	"github.com/algorand/go-algorand/logging"
+	"github.com/algorand/go-algorand/protocol"
+	"github.com/algorand/go-algorand/util/timers"
+)
+
+// The network simulator runs many GossipNode instances in a single process over a virtual clock.
+// Messages sent between the simulated nodes are queued with a delivery time computed from the
+// latency, jitter and bandwidth of the link they travel on, and are only delivered when the
+// simulation is advanced past that time. Every directed link draws its loss and jitter from its
+// own random source derived from the simulation seed, so the fate of the n-th message on a link
+// does not depend on the traffic of the other links, and a simulation replays identically from
+// the same seed as long as the nodes send the same messages at the same virtual times.
+//
+// Only the network is reproducible: the nodes using it still handle the delivered messages on their
+// own goroutines, and the services of a full node other than the agreement timeouts run on the
+// wall clock. A simulation of full nodes therefore depends on the scheduling of the process, and
+// the same seed does not guarantee the same messages, blocks or statistics from one run to the next.
+//
+// HTTP requests between simulated nodes are served in-memory by the router of the target node,
+// without any virtual delay; they fail when the two nodes are partitioned. As with the websocket
+// network, the phonebook peers are HTTP-only peers: the nodes a node connects to in the topology.
+
+var errSimPeerDisconnected = errors.New("simulated peer is disconnected")
+
+// simStart is the default start time of the virtual clock.
+var simStart = time.Unix(1_700_000_000, 0)
+
+// LinkConfig describes the characteristics of a simulated link.
+type LinkConfig struct {
+	// Latency is the fixed delay of every message on the link.
+	Latency time.Duration
+	// Jitter is the upper bound of a random delay added to the latency.
+	Jitter time.Duration
+	// LossRate is the probability of a message being dropped, between 0 and 1.
+	LossRate float64
+	// Bandwidth is the throughput of the link in bytes per second; 0 means unlimited.
+	// Messages queue behind each other when the link is saturated.
+	Bandwidth uint64
+}
+
+// SimulatorConfig is the configuration of a network Simulator.
+type SimulatorConfig struct {
+	// Seed drives all the random decisions of the simulation.
+	Seed int64
+	// Start is the initial virtual time. The zero value selects a fixed default.
+	Start time.Time
+	// Link is the configuration of the links without a specific configuration.
+	Link LinkConfig
+}
+
+type simLinkKey struct {
+	from, to string
+}
+
+// simLink holds the state of a directed link.
+type simLink struct {
+	cfg       LinkConfig
+	rng       *rand.Rand
+	busyUntil time.Time
+}
+
+// simConnection is an established connection between two simulated nodes.
+// The outgoing peer lives on the initiating node and the incoming peer on the accepting one.
+type simConnection struct {
+	from, to string
+	out, in  *simPeer
+	// closed is closed when the connection is torn down
+	closed chan struct{}
+}
+
+type simEvent struct {
+	at   time.Time
+	seq  uint64
+	conn *simConnection
+	// to is the name of the receiving node
+	to   string
+	tag  protocol.Tag
+	data []byte
+}
+
+// simEvents is a min-heap of events ordered by delivery time, and by send order for equal times.
+type simEvents []simEvent
+
+func (e simEvents) Len() int { return len(e) }
+func (e simEvents) Less(i, j int) bool {
+	if e[i].at.Equal(e[j].at) {
+		return e[i].seq < e[j].seq
+	}
+	return e[i].at.Before(e[j].at)
+}
+func (e simEvents) Swap(i, j int) { e[i], e[j] = e[j], e[i] }
+func (e *simEvents) Push(x any)   { *e = append(*e, x.(simEvent)) }
+func (e *simEvents) Pop() any {
+	old := *e
+	n := len(old)
+	x := old[n-1]
+	*e = old[:n-1]
+	return x
+}
+
+// Simulator is an in-process network connecting SimulatedNetwork instances over a virtual clock.
+type Simulator struct {
+	cfg  SimulatorConfig
+	time *timers.VirtualTime
+
+	mu          deadlock.Mutex
+	nodes       map[string]*SimulatedNetwork
+	links       map[simLinkKey]*simLink
+	linkConfigs map[simLinkKey]LinkConfig
+	// topology is the set of outgoing connections each node establishes when it starts
+	topology    map[simLinkKey]bool
+	connections map[simLinkKey]*simConnection
+	partition   map[string]int
+	events      simEvents
+	seq         uint64
+
+	// stats
+	delivered uint64
+	dropped   uint64
+}
+
+// MakeSimulator creates a network simulator.
+func MakeSimulator(cfg SimulatorConfig) *Simulator {
+	if cfg.Start.IsZero() {
+		cfg.Start = simStart
+	}
+	return &Simulator{
+		cfg:         cfg,
+		time:        timers.MakeVirtualTime(cfg.Start),
+		nodes:       make(map[string]*SimulatedNetwork),
+		links:       make(map[simLinkKey]*simLink),
+		linkConfigs: make(map[simLinkKey]LinkConfig),
+		topology:    make(map[simLinkKey]bool),
+		connections: make(map[simLinkKey]*simConnection),
+	}
+}
+
+// Time returns the virtual time source of the simulation, which can drive the clocks of the simulated nodes.
+func (s *Simulator) Time() *timers.VirtualTime {
+	return s.time
+}
+
+// Now returns the current virtual time.
+func (s *Simulator) Now() time.Time {
+	return s.time.Now()
+}
+
+// MakeNetwork creates the GossipNode of a simulated node. Like the websocket network,
+// the node relays messages if it is configured as a gossip server or with ForceRelayMessages.
+func (s *Simulator) MakeNetwork(name string, cfg config.Local, genesisID string, log logging.Logger) (*SimulatedNetwork, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if _, has := s.nodes[name]; has {
+		return nil, fmt.Errorf("simulated node %s already exists", name)
+	}
+	n := &SimulatedNetwork{
+		sim:           s,
+		name:          name,
+		log:           log,
+		genesisID:     genesisID,
+		relayMessages: cfg.IsGossipServer() || cfg.ForceRelayMessages,
+		handler:       MakeMultiplexer(),
+		router:        mux.NewRouter(),
+		outgoing:      make(map[string]*simPeer),
+		incoming:      make(map[string]*simPeer),
+	}
+	s.nodes[name] = n
+	return n, nil
+}
+
+// Connect makes the node from establish an outgoing connection to the node to once both are started.
+func (s *Simulator) Connect(from, to string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	key := simLinkKey{from, to}
+	s.topology[key] = true
+	s.establish(key)
+}
+
+// SetLink overrides the configuration of the links between a and b, in both directions.
+func (s *Simulator) SetLink(a, b string, cfg LinkConfig) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for _, key := range []simLinkKey{{a, b}, {b, a}} {
+		s.linkConfigs[key] = cfg
+		if link, ok := s.links[key]; ok {
+			link.cfg = cfg
+		}
+	}
+}
+
+// Partition splits the nodes into the given groups. Messages and HTTP requests between nodes of different
+// groups are dropped; the nodes which are not listed form an additional group. Connections are kept open,
+// as with a network failure which does not reset the connections.
+func (s *Simulator) Partition(groups ...[]string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.partition = make(map[string]int)
+	for i, group := range groups {
+		for _, name := range group {
+			s.partition[name] = i + 1
+		}
+	}
+}
+
+// Heal removes any partition.
+func (s *Simulator) Heal() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.partition = nil
+}
+
+// Stats returns the number of delivered and dropped messages.
+func (s *Simulator) Stats() (delivered, dropped uint64) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.delivered, s.dropped
+}
+
+// Pending returns the number of messages in flight.
+func (s *Simulator) Pending() int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return len(s.events)
+}
+
+// Advance runs the simulation for d of virtual time, delivering the messages and firing the
+// virtual timers in chronological order. Message handlers are invoked synchronously from the
+// calling goroutine.
+func (s *Simulator) Advance(d time.Duration) {
+	target := s.time.Now().Add(d)
+	for {
+		s.mu.Lock()
+		if len(s.events) == 0 || s.events[0].at.After(target) {
+			s.mu.Unlock()
+			break
+		}
+		ev := heap.Pop(&s.events).(simEvent)
+		s.mu.Unlock()
+
+		s.time.AdvanceTo(ev.at)
+		s.deliver(ev)
+	}
+	s.time.AdvanceTo(target)
+}
+
+func (s *Simulator) partitioned(a, b string) bool {
+	return s.partition != nil && s.partition[a] != s.partition[b]
+}
+
+// establish connects the two ends of key if both are started. The caller holds s.mu.
+func (s *Simulator) establish(key simLinkKey) {
+	if _, has := s.connections[key]; has {
+		return
+	}
+	from, to := s.nodes[key.from], s.nodes[key.to]
+	if from == nil || to == nil || !from.started || !to.started {
+		return
+	}
+	conn := &simConnection{from: key.from, to: key.to, closed: make(chan struct{})}
+	conn.out = &simPeer{net: from, conn: conn, remote: key.to, outgoing: true, responseChannels: make(map[uint64]chan *Response)}
+	conn.in = &simPeer{net: to, conn: conn, remote: key.from, responseChannels: make(map[uint64]chan *Response)}
+	from.outgoing[key.to] = conn.out
+	to.incoming[key.from] = conn.in
+	s.connections[key] = conn
+}
+
+// teardown closes the given connection. The caller holds s.mu.
+func (s *Simulator) teardown(conn *simConnection) {
+	if s.connections[simLinkKey{conn.from, conn.to}] != conn {
+		return
+	}
+	delete(s.connections, simLinkKey{conn.from, conn.to})
+	close(conn.closed)
+	delete(s.nodes[conn.from].outgoing, conn.to)
+	delete(s.nodes[conn.to].incoming, conn.from)
+}
+
+func (s *Simulator) link(key simLinkKey) *simLink {
+	link, ok := s.links[key]
+	if !ok {
+		cfg, ok := s.linkConfigs[key]
+		if !ok {
+			cfg = s.cfg.Link
+		}
+		h := fnv.New64a()
+		h.Write([]byte(key.from))
+		h.Write([]byte{0})
+		h.Write([]byte(key.to))
+		link = &simLink{cfg: cfg, rng: rand.New(rand.NewSource(s.cfg.Seed ^ int64(h.Sum64())))}
+		s.links[key] = link
+	}
+	return link
+}
+
+// send queues a message on the connection from the node named from.
+func (s *Simulator) send(conn *simConnection, from string, tag protocol.Tag, data []byte) error {
+	to := conn.to
+	if from == conn.to {
+		to = conn.from
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.connections[simLinkKey{conn.from, conn.to}] != conn {
+		return errSimPeerDisconnected
+	}
+
+	link := s.link(simLinkKey{from, to})
+	// always draw both values so that the random sequence of the link only depends on the number of messages
+	loss := link.rng.Float64()
+	var jitter time.Duration
+	if link.cfg.Jitter > 0 {
+		jitter = time.Duration(link.rng.Int63n(int64(link.cfg.Jitter)))
+	} else {
+		link.rng.Int63()
+	}
+	if loss < link.cfg.LossRate || s.partitioned(from, to) {
+		s.dropped++
+		return nil
+	}
+
+	now := s.time.Now()
+	departure := now
+	if link.cfg.Bandwidth > 0 {
+		if link.busyUntil.After(departure) {
+			departure = link.busyUntil
+		}
+		departure = departure.Add(time.Duration(uint64(len(data)+len(tag)) * uint64(time.Second) / link.cfg.Bandwidth))
+		link.busyUntil = departure
+	}
+
+	s.seq++
+	heap.Push(&s.events, simEvent{
+		at:   departure.Add(link.cfg.Latency + jitter),
+		seq:  s.seq,
+		conn: conn,
+		to:   to,
+		tag:  tag,
+		data: bytes.Clone(data),
+	})
+	return nil
+}
+
+func (s *Simulator) deliver(ev simEvent) {
+	s.mu.Lock()
+	n := s.nodes[ev.to]
+	sender := ev.conn.in
+	if ev.to == ev.conn.from {
+		sender = ev.conn.out
+	}
+	if s.connections[simLinkKey{ev.conn.from, ev.conn.to}] != ev.conn || s.partitioned(ev.conn.from, ev.conn.to) {
+		s.dropped++
+		s.mu.Unlock()
+		return
+	}
+	s.delivered++
+	s.mu.Unlock()
+
+	msg := IncomingMessage{Sender: sender, Tag: ev.tag, Data: ev.data, Net: n, Received: s.time.Now().UnixNano()}
+	if ev.tag == protocol.TopicMsgRespTag {
+		sender.handleResponse(msg)
+		return
+	}
+	n.handle(msg)
+}
+
+// SimulatedNetwork is the GossipNode of a node of a Simulator.
+type SimulatedNetwork struct {
+	sim           *Simulator
+	name          string
+	log           logging.Logger
+	genesisID     string
+	relayMessages bool
+	handler       *Multiplexer
+	router        *mux.Router
+
+	// the following fields are protected by sim.mu
+	started  bool
+	outgoing map[string]*simPeer
+	incoming map[string]*simPeer
+}
+
+// Name returns the name of the simulated node.
+func (n *SimulatedNetwork) Name() string {
+	return n.name
+}
+
+// Multiplexer returns the handlers registered on the simulated node, e.g. to replay a capture into them.
+func (n *SimulatedNetwork) Multiplexer() *Multiplexer {
+	return n.handler
+}
+
+// Address implements GossipNode
+func (n *SimulatedNetwork) Address() (string, bool) {
+	return "http://" + n.name, true
+}
+
+// Broadcast sends a message to all the connected peers but except.
+func (n *SimulatedNetwork) Broadcast(ctx context.Context, tag protocol.Tag, data []byte, wait bool, except Peer) error {
+	for _, peer := range n.peers(PeersConnectedOut, PeersConnectedIn) {
+		if peer == except {
+			continue
+		}
+		// a disconnected peer is not an error for a broadcast
+		n.sim.send(peer.conn, n.name, tag, data) //nolint:errcheck
+	}
+	return nil
+}
+
+// Relay broadcasts a message if the node relays messages.
+func (n *SimulatedNetwork) Relay(ctx context.Context, tag protocol.Tag, data []byte, wait bool, except Peer) error {
+	if n.relayMessages {
+		return n.Broadcast(ctx, tag, data, wait, except)
+	}
+	return nil
+}
+
+// Disconnect closes the connection with the given peer.
+func (n *SimulatedNetwork) Disconnect(badnode DisconnectablePeer) {
+	peer, ok := badnode.(*simPeer)
+	if !ok || peer.net != n {
+		return
+	}
+	n.log.Debugf("disconnecting simulated peer %s", peer.remote)
+	n.sim.mu.Lock()
+	defer n.sim.mu.Unlock()
+	n.sim.teardown(peer.conn)
+}
+
+// DisconnectPeers closes all the connections of the node.
+func (n *SimulatedNetwork) DisconnectPeers() {
+	n.sim.mu.Lock()
+	defer n.sim.mu.Unlock()
+	n.disconnectPeers()
+}
+
+func (n *SimulatedNetwork) disconnectPeers() {
+	for _, peer := range n.outgoing {
+		n.sim.teardown(peer.conn)
+	}
+	for _, peer := range n.incoming {
+		n.sim.teardown(peer.conn)
+	}
+}
+
+// RegisterHTTPHandler registers a handler on the in-memory router of the node.
+func (n *SimulatedNetwork) RegisterHTTPHandler(path string, handler http.Handler) {
+	n.router.Handle(path, handler)
+}
+
+// RegisterHTTPHandlerFunc registers a handler function on the in-memory router of the node.
+func (n *SimulatedNetwork) RegisterHTTPHandlerFunc(path string, handler func(http.ResponseWriter, *http.Request)) {
+	n.router.HandleFunc(path, handler)
+}
+
+// RequestConnectOutgoing reestablishes the outgoing connections of the simulation topology.
+func (n *SimulatedNetwork) RequestConnectOutgoing(replace bool, quit <-chan struct{}) {
+	n.sim.mu.Lock()
+	defer n.sim.mu.Unlock()
+	if replace {
+		for _, peer := range n.outgoing {
+			n.sim.teardown(peer.conn)
+		}
+	}
+	n.connect()
+}
+
+// connect establishes the connections of the topology involving this node. The caller holds sim.mu.
+func (n *SimulatedNetwork) connect() {
+	for key := range n.sim.topology {
+		if key.from == n.name || key.to == n.name {
+			n.sim.establish(key)
+		}
+	}
+}
+
+// GetPeers returns the peers matching options, ordered by name so that iterations are deterministic.
+// The phonebook options return HTTP-only peers for the nodes this node connects to in the topology.
+func (n *SimulatedNetwork) GetPeers(options ...PeerOption) []Peer {
+	var peers []Peer
+	var phonebook bool
+	for _, option := range options {
+		if option == PeersPhonebookRelays || option == PeersPhonebookArchivalNodes {
+			phonebook = true
+		}
+	}
+	for _, peer := range n.peers(options...) {
+		peers = append(peers, peer)
+	}
+	if phonebook {
+		n.sim.mu.Lock()
+		var targets []string
+		for key := range n.sim.topology {
+			if key.from == n.name {
+				targets = append(targets, key.to)
+			}
+		}
+		n.sim.mu.Unlock()
+		sort.Strings(targets)
+		for _, target := range targets {
+			peers = append(peers, &simHTTPPeer{net: n, remote: target})
+		}
+	}
+	return peers
+}
+
+// peers returns the connected peers matching options ordered by name.
+func (n *SimulatedNetwork) peers(options ...PeerOption) []*simPeer {
+	n.sim.mu.Lock()
+	defer n.sim.mu.Unlock()
+	var peers []*simPeer
+	seen := make(map[*simPeer]bool)
+	for _, option := range options {
+		var source map[string]*simPeer
+		switch option {
+		case PeersConnectedOut:
+			source = n.outgoing
+		case PeersConnectedIn:
+			source = n.incoming
+		}
+		for _, peer := range source {
+			if !seen[peer] {
+				seen[peer] = true
+				peers = append(peers, peer)
+			}
+		}
+	}
+	sort.Slice(peers, func(i, j int) bool {
+		if peers[i].remote != peers[j].remote {
+			return peers[i].remote < peers[j].remote
+		}
+		return peers[i].outgoing && !peers[j].outgoing
+	})
+	return peers
+}
+
+// Start connects the node to the simulated topology.
+func (n *SimulatedNetwork) Start() error {
+	n.sim.mu.Lock()
+	defer n.sim.mu.Unlock()
+	n.started = true
+	n.connect()
+	return nil
+}
+
+// Stop disconnects the node from the simulated topology.
+func (n *SimulatedNetwork) Stop() {
+	n.sim.mu.Lock()
+	defer n.sim.mu.Unlock()
+	n.started = false
+	n.disconnectPeers()
+}
+
+// RegisterHandlers registers the set of given message handlers.
+func (n *SimulatedNetwork) RegisterHandlers(dispatch []TaggedMessageHandler) {
+	n.handler.RegisterHandlers(dispatch)
+}
+
+// ClearHandlers deregisters all the existing message handlers.
+func (n *SimulatedNetwork) ClearHandlers() {
+	n.handler.ClearHandlers(nil)
+}
+
+// RegisterValidatorHandlers is a no-op: like the websocket network, the simulated network has no pubsub topics.
+func (n *SimulatedNetwork) RegisterValidatorHandlers(dispatch []TaggedMessageValidatorHandler) {
+}
+
+// ClearValidatorHandlers is a no-op.
+func (n *SimulatedNetwork) ClearValidatorHandlers() {
+}
+
+// GetHTTPClient returns a client serving the requests to address from the router of the target node.
+func (n *SimulatedNetwork) GetHTTPClient(address string) (*http.Client, error) {
+	target, err := simNodeName(address)
+	if err != nil {
+		return nil, err
+	}
+	return &http.Client{Transport: &simTransport{net: n, target: target}}, nil
+}
+
+// OnNetworkAdvance is a no-op.
+func (n *SimulatedNetwork) OnNetworkAdvance() {}
+
+// ReportPeerEvent is a no-op: the simulated peers have no reputation.
+func (n *SimulatedNetwork) ReportPeerEvent(peer Peer, event PeerEvent) {}
+
+// GetGenesisID implements GossipNode
+func (n *SimulatedNetwork) GetGenesisID() string {
+	return n.genesisID
+}
+
+func (n *SimulatedNetwork) peerRemoteClose(peer *wsPeer, reason disconnectReason) {
+	panic("wsPeer should only call WebsocketNetwork.peerRemoteClose or P2PNetwork.peerRemoteClose")
+}
+
+func (n *SimulatedNetwork) handle(msg IncomingMessage) {
+	out := n.handler.Handle(msg)
+	sender := msg.Sender.(*simPeer)
+	switch out.Action {
+	case Disconnect:
+		n.Disconnect(sender)
+	case Broadcast:
+		n.Broadcast(context.Background(), msg.Tag, msg.Data, false, sender) //nolint:errcheck
+	case Respond:
+		// Respond releases the message itself
+		sender.Respond(context.Background(), msg, out) //nolint:errcheck
+		return
+	}
+	if out.OnRelease != nil {
+		out.OnRelease()
+	}
+}
+
+// simNodeName extracts the node name from an address returned by SimulatedNetwork.Address.
+func simNodeName(address string) (string, error) {
+	if !strings.Contains(address, "://") {
+		return address, nil
+	}
+	u, err := url.Parse(address)
+	if err != nil {
+		return "", err
+	}
+	return u.Host, nil
+}
+
+// simPeer is a connected peer of a SimulatedNetwork.
+type simPeer struct {
+	net      *SimulatedNetwork
+	conn     *simConnection
+	remote   string
+	outgoing bool
+
+	requestNonce       atomic.Uint64
+	responseChannelsMu deadlock.Mutex
+	responseChannels   map[uint64]chan *Response
+}
+
+// GetAddress returns the address of the remote node.
+func (p *simPeer) GetAddress() string {
+	return "http://" + p.remote
+}
+
+// GetNetwork returns the network of the local node.
+func (p *simPeer) GetNetwork() GossipNode {
+	return p.net
+}
+
+// RoutingAddr returns the name of the remote node, which identifies it like an IP address would.
+func (p *simPeer) RoutingAddr() []byte {
+	return []byte(p.remote)
+}
+
+// Unicast queues a message to the remote node.
+func (p *simPeer) Unicast(ctx context.Context, data []byte, tag protocol.Tag) error {
+	return p.net.sim.send(p.conn, p.net.name, tag, data)
+}
+
+// Version returns the current protocol version.
+func (p *simPeer) Version() string {
+	return ProtocolVersion
+}
+
+// Request sends a topic request to the remote node and waits for its response, which
+// is only delivered as the simulation advances.
+func (p *simPeer) Request(ctx context.Context, tag Tag, topics Topics) (resp *Response, e error) {
+	topics = append(topics, MakeNonceTopic(p.requestNonce.Add(1)))
+	serializedMsg := topics.MarshallTopics()
+	hash := hashTopics(serializedMsg)
+
+	responseChannel := make(chan *Response, 1)
+	p.responseChannelsMu.Lock()
+	p.responseChannels[hash] = responseChannel
+	p.responseChannelsMu.Unlock()
+	defer func() {
+		p.responseChannelsMu.Lock()
+		delete(p.responseChannels, hash)
+		p.responseChannelsMu.Unlock()
+	}()
+
+	if err := p.Unicast(ctx, serializedMsg, tag); err != nil {
+		return nil, err
+	}
+	select {
+	case resp = <-responseChannel:
+		return resp, nil
+	case <-p.conn.closed:
+		return nil, errSimPeerDisconnected
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	}
+}
+
+// Respond sends the response to a topic request back to the remote node.
+func (p *simPeer) Respond(ctx context.Context, reqMsg IncomingMessage, outMsg OutgoingMessage) (e error) {
+	if outMsg.OnRelease != nil {
+		defer outMsg.OnRelease()
+	}
+	requestHashData := make([]byte, binary.MaxVarintLen64)
+	binary.PutUvarint(requestHashData, hashTopics(reqMsg.Data))
+	responseTopics := append(outMsg.Topics, Topic{key: requestHashKey, data: requestHashData})
+	return p.Unicast(ctx, responseTopics.MarshallTopics(), protocol.TopicMsgRespTag)
+}
+
+func (p *simPeer) handleResponse(msg IncomingMessage) {
+	topics, err := UnmarshallTopics(msg.Data)
+	if err != nil {
+		p.net.log.Warnf("could not read the response from simulated peer %s: %v", p.remote, err)
+		return
+	}
+	requestHash, found := topics.GetValue(requestHashKey)
+	if !found {
+		p.net.log.Warnf("response from simulated peer %s is missing the %s", p.remote, requestHashKey)
+		return
+	}
+	hashKey, _ := binary.Uvarint(requestHash)
+	p.responseChannelsMu.Lock()
+	channel, found := p.responseChannels[hashKey]
+	delete(p.responseChannels, hashKey)
+	p.responseChannelsMu.Unlock()
+	if found {
+		channel <- &Response{Topics: topics}
+	}
+}
+
+// GetHTTPClient returns a client for the remote node.
+func (p *simPeer) GetHTTPClient() *http.Client {
+	return &http.Client{Transport: &simTransport{net: p.net, target: p.remote}}
+}
+
+// simHTTPPeer is a phonebook peer of a SimulatedNetwork.
+type simHTTPPeer struct {
+	net    *SimulatedNetwork
+	remote string
+}
+
+// GetAddress returns the address of the remote node.
+func (p *simHTTPPeer) GetAddress() string {
+	return "http://" + p.remote
+}
+
+// GetHTTPClient returns a client for the remote node.
+func (p *simHTTPPeer) GetHTTPClient() *http.Client {
+	return &http.Client{Transport: &simTransport{net: p.net, target: p.remote}}
+}
+
+// simTransport serves HTTP requests from the router of a simulated node.
+type simTransport struct {
+	net    *SimulatedNetwork
+	target string
+}
+
+func (t *simTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	sim := t.net.sim
+	sim.mu.Lock()
+	target := sim.nodes[t.target]
+	reachable := target != nil && target.started && !sim.partitioned(t.net.name, t.target)
+	sim.mu.Unlock()
+	if !reachable {
+		return nil, fmt.Errorf("simulated node %s is unreachable from %s", t.target, t.net.name)
+	}
+
+	req = req.Clone(req.Context())
+	req.RemoteAddr = t.net.name
+	w := &simResponseWriter{header: make(http.Header)}
+	target.router.ServeHTTP(w, req)
+	if w.status == 0 {
+		w.status = http.StatusOK
+	}
+	return &http.Response{
+		Status:        fmt.Sprintf("%d %s", w.status, http.StatusText(w.status)),
+		StatusCode:    w.status,
+		Proto:         "HTTP/1.1",
+		ProtoMajor:    1,
+		ProtoMinor:    1,
+		Header:        w.header,
+		Body:          io.NopCloser(&w.body),
+		ContentLength: int64(w.body.Len()),
+		Request:       req,
+	}, nil
+}
+
+type simResponseWriter struct {
+	header http.Header
+	status int
+	body   bytes.Buffer
+}
+
+func (w *simResponseWriter) Header() http.Header {
+	return w.header
+}
+
+func (w *simResponseWriter) Write(data []byte) (int, error) {
+	if w.status == 0 {
+		w.status = http.StatusOK
+	}
+	return w.body.Write(data)
+}
+
+func (w *simResponseWriter) WriteHeader(status int) {
+	if w.status == 0 {
+		w.status = status
+	}
+}
@@ -0,0 +1,253 @@
+// Copyright (C) 2019-2025 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package network
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/algorand/go-algorand/config"
+	"github.com/algorand/go-algorand/logging"
+	"github.com/algorand/go-algorand/protocol"
+	"github.com/algorand/go-algorand/test/partitiontest"
+)
+
+type simReceived struct {
+	at   time.Duration
+	from string
+	data string
+}
+
+// makeTestSimulation creates a simulation of count relays named n0, n1, ... recording the
+// TxnTag messages they receive, and connects each node to the next one.
+func makeTestSimulation(t *testing.T, cfg SimulatorConfig, count int) (*Simulator, []*SimulatedNetwork, [][]simReceived) {
+	sim := MakeSimulator(cfg)
+	nets := make([]*SimulatedNetwork, count)
+	received := make([][]simReceived, count)
+	for i := range nets {
+		local := config.GetDefaultLocal()
+		local.NetAddress = fmt.Sprintf("n%d", i)
+		net, err := sim.MakeNetwork(local.NetAddress, local, "test", logging.TestingLog(t))
+		require.NoError(t, err)
+		i := i
+		net.RegisterHandlers([]TaggedMessageHandler{{Tag: protocol.TxnTag, MessageHandler: HandlerFunc(func(msg IncomingMessage) OutgoingMessage {
+			peer := msg.Sender.(*simPeer)
+			received[i] = append(received[i], simReceived{at: sim.Now().Sub(sim.cfg.Start), from: peer.remote, data: string(msg.Data)})
+			if string(msg.Data) == "bad" {
+				return OutgoingMessage{Action: Disconnect}
+			}
+			if string(msg.Data) == "relay" {
+				return OutgoingMessage{Action: Broadcast}
+			}
+			return OutgoingMessage{Action: Ignore}
+		})}})
+		nets[i] = net
+	}
+	_, err := sim.MakeNetwork("n0", config.GetDefaultLocal(), "test", logging.TestingLog(t))
+	require.Error(t, err)
+
+	for i := 0; i+1 < count; i++ {
+		sim.Connect(nets[i].Name(), nets[i+1].Name())
+	}
+	for _, net := range nets {
+		require.NoError(t, net.Start())
+	}
+	return sim, nets, received
+}
+
+func TestSimulatorDelivery(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	sim, nets, received := makeTestSimulation(t, SimulatorConfig{Link: LinkConfig{Latency: 50 * time.Millisecond}}, 3)
+	require.Len(t, nets[0].GetPeers(PeersConnectedOut), 1)
+	require.Empty(t, nets[0].GetPeers(PeersConnectedIn))
+	require.Len(t, nets[1].GetPeers(PeersConnectedIn, PeersConnectedOut), 2)
+
+	require.NoError(t, nets[0].Broadcast(context.Background(), protocol.TxnTag, []byte("hello"), false, nil))
+	require.Equal(t, 1, sim.Pending())
+	sim.Advance(49 * time.Millisecond)
+	require.Empty(t, received[1])
+	sim.Advance(time.Millisecond)
+	require.Equal(t, []simReceived{{at: 50 * time.Millisecond, from: "n0", data: "hello"}}, received[1])
+	// only relayed on request of the handler
+	sim.Advance(time.Second)
+	require.Empty(t, received[2])
+
+	require.NoError(t, nets[0].Broadcast(context.Background(), protocol.TxnTag, []byte("relay"), false, nil))
+	sim.Advance(time.Second)
+	require.Len(t, received[1], 2)
+	require.Equal(t, []simReceived{{at: 1150 * time.Millisecond, from: "n1", data: "relay"}}, received[2])
+	// the message is not sent back to its sender
+	require.Empty(t, received[0])
+
+	// a message handled with a disconnection drops the connection
+	peer := nets[1].GetPeers(PeersConnectedIn)[0].(UnicastPeer)
+	require.NoError(t, peer.Unicast(context.Background(), []byte("bad"), protocol.TxnTag))
+	sim.Advance(time.Second)
+	require.Len(t, received[0], 1)
+	require.Empty(t, nets[0].GetPeers(PeersConnectedOut))
+	require.Empty(t, nets[1].GetPeers(PeersConnectedIn))
+	require.ErrorIs(t, peer.Unicast(context.Background(), []byte("late"), protocol.TxnTag), errSimPeerDisconnected)
+
+	nets[0].RequestConnectOutgoing(false, nil)
+	require.Len(t, nets[1].GetPeers(PeersConnectedIn), 1)
+
+	delivered, dropped := sim.Stats()
+	require.Equal(t, uint64(4), delivered)
+	require.Zero(t, dropped)
+}
+
+func TestSimulatorDeterminism(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	run := func(seed int64) [][]simReceived {
+		cfg := SimulatorConfig{Seed: seed, Link: LinkConfig{Latency: 10 * time.Millisecond, Jitter: 20 * time.Millisecond, LossRate: 0.3}}
+		sim, nets, received := makeTestSimulation(t, cfg, 3)
+		for i := 0; i < 100; i++ {
+			for _, net := range nets {
+				net.Broadcast(context.Background(), protocol.TxnTag, []byte(fmt.Sprintf("%s-%d", net.Name(), i)), false, nil)
+			}
+			sim.Advance(time.Millisecond)
+		}
+		sim.Advance(time.Second)
+		return received
+	}
+
+	a := run(1)
+	require.Equal(t, a, run(1))
+	require.NotEqual(t, a, run(2))
+
+	// about 30% of the messages are lost
+	total := 0
+	for _, r := range a {
+		total += len(r)
+	}
+	require.Greater(t, total, 250)
+	require.Less(t, total, 310)
+}
+
+func TestSimulatorBandwidth(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	sim, nets, received := makeTestSimulation(t, SimulatorConfig{}, 2)
+	// 1000 bytes per second: a message of 98 bytes and its 2 bytes tag take 100ms
+	sim.SetLink("n0", "n1", LinkConfig{Latency: 10 * time.Millisecond, Bandwidth: 1000})
+	data := make([]byte, 98)
+	for i := 0; i < 3; i++ {
+		nets[0].Broadcast(context.Background(), protocol.TxnTag, data, false, nil)
+	}
+	sim.Advance(time.Second)
+	require.Len(t, received[1], 3)
+	for i, r := range received[1] {
+		require.Equal(t, time.Duration(i+1)*100*time.Millisecond+10*time.Millisecond, r.at)
+	}
+}
+
+func TestSimulatorPartition(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	sim, nets, received := makeTestSimulation(t, SimulatorConfig{Link: LinkConfig{Latency: 10 * time.Millisecond}}, 3)
+	nets[1].RegisterHTTPHandlerFunc("/v1/{genesisID}/hello", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusAccepted)
+		w.Write([]byte("hello " + r.RemoteAddr))
+	})
+	client := nets[0].GetPeers(PeersPhonebookArchivalNodes)[0].(HTTPPeer).GetHTTPClient()
+	resp, err := client.Get("http://n1/v1/test/hello")
+	require.NoError(t, err)
+	body, err := io.ReadAll(resp.Body)
+	require.NoError(t, err)
+	require.Equal(t, http.StatusAccepted, resp.StatusCode)
+	require.Equal(t, "hello n0", string(body))
+
+	// in flight messages are lost too
+	nets[0].Broadcast(context.Background(), protocol.TxnTag, []byte("relay"), false, nil)
+	sim.Partition([]string{"n0"})
+	sim.Advance(time.Second)
+	require.Empty(t, received[1])
+	_, err = client.Get("http://n1/v1/test/hello")
+	require.Error(t, err)
+	// n1 and n2 are in the same group
+	nets[1].Broadcast(context.Background(), protocol.TxnTag, []byte("hello"), false, nil)
+	sim.Advance(time.Second)
+	require.Empty(t, received[0])
+	require.Len(t, received[2], 1)
+
+	sim.Heal()
+	nets[0].Broadcast(context.Background(), protocol.TxnTag, []byte("hello"), false, nil)
+	sim.Advance(time.Second)
+	require.Len(t, received[1], 1)
+
+	// a stopped node is disconnected and unreachable
+	nets[1].Stop()
+	require.Empty(t, nets[0].GetPeers(PeersConnectedOut))
+	_, err = client.Get("http://n1/v1/test/hello")
+	require.Error(t, err)
+	require.NoError(t, nets[1].Start())
+	require.Len(t, nets[0].GetPeers(PeersConnectedOut), 1)
+	require.Len(t, nets[1].GetPeers(PeersConnectedOut), 1)
+}
+
+func TestSimulatorRequest(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	sim, nets, _ := makeTestSimulation(t, SimulatorConfig{Link: LinkConfig{Latency: 10 * time.Millisecond}}, 2)
+	nets[1].RegisterHandlers([]TaggedMessageHandler{{Tag: protocol.UniEnsBlockReqTag, MessageHandler: HandlerFunc(func(msg IncomingMessage) OutgoingMessage {
+		topics, err := UnmarshallTopics(msg.Data)
+		require.NoError(t, err)
+		value, found := topics.GetValue("q")
+		require.True(t, found)
+		return OutgoingMessage{Action: Respond, Topics: Topics{MakeTopic("a", append(value, '!'))}}
+	})}})
+
+	peer := nets[0].GetPeers(PeersConnectedOut)[0].(UnicastPeer)
+	done := make(chan *Response)
+	go func() {
+		resp, err := peer.Request(context.Background(), protocol.UniEnsBlockReqTag, Topics{MakeTopic("q", []byte("hi"))})
+		require.NoError(t, err)
+		done <- resp
+	}()
+	var resp *Response
+	for resp == nil {
+		select {
+		case resp = <-done:
+		case <-time.After(time.Millisecond):
+			sim.Advance(time.Millisecond)
+		}
+	}
+	value, found := resp.Topics.GetValue("a")
+	require.True(t, found)
+	require.Equal(t, "hi!", string(value))
+	// a round trip over the link
+	require.GreaterOrEqual(t, sim.Now().Sub(sim.cfg.Start), 20*time.Millisecond)
+
+	// pending requests fail when the peer disconnects
+	go func() {
+		_, err := peer.Request(context.Background(), protocol.UniEnsBlockReqTag, Topics{MakeTopic("q", []byte("hi"))})
+		done <- &Response{Topics: Topics{MakeTopic("err", []byte(err.Error()))}}
+	}()
+	require.Eventually(t, func() bool { return sim.Pending() == 1 }, time.Second, time.Millisecond)
+	nets[0].DisconnectPeers()
+	resp = <-done
+	value, _ = resp.Topics.GetValue("err")
+	require.Equal(t, errSimPeerDisconnected.Error(), string(value))
+}
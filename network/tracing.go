@@ -0,0 +1,64 @@
+// Copyright (C) 2019-2025 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package network
+
+import (
+	"context"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+
+	"github.com/algorand/go-algorand/util/tracing"
+)
+
+var forwardingPolicyNames = map[ForwardingPolicy]string{
+	Ignore:     "ignore",
+	Disconnect: "disconnect",
+	Broadcast:  "broadcast",
+	Respond:    "respond",
+	Accept:     "accept",
+}
+
+// startMessageSpan starts the span of the handling of msg, child of the span of the peer that sent it
+// if the peer propagated its trace context, and records the context of the span in msg.
+// The returned context carries the span, so that the messages relayed with it propagate the trace.
+func startMessageSpan(ctx context.Context, name string, msg *IncomingMessage) (context.Context, trace.Span) {
+	if !tracing.Enabled() {
+		return ctx, trace.SpanFromContext(ctx)
+	}
+	if msg.remoteSpanContext.IsValid() {
+		ctx = trace.ContextWithRemoteSpanContext(ctx, msg.remoteSpanContext)
+	}
+	attrs := []attribute.KeyValue{
+		attribute.String("algorand.tag", string(msg.Tag)),
+		attribute.Int("algorand.size", len(msg.Data)),
+	}
+	if peer, ok := msg.Sender.(interface{ GetAddress() string }); ok {
+		attrs = append(attrs, attribute.String("algorand.peer", peer.GetAddress()))
+	}
+	ctx, span := tracing.Start(ctx, name, attrs...)
+	msg.SpanContext = span.SpanContext()
+	return ctx, span
+}
+
+// endMessageSpan records the outcome of the handling of a message and ends its span.
+func endMessageSpan(span trace.Span, action ForwardingPolicy) {
+	if span.IsRecording() {
+		span.SetAttributes(attribute.String("algorand.action", forwardingPolicyNames[action]))
+	}
+	span.End()
+}
@@ -0,0 +1,111 @@
+// Copyright (C) 2019-2025 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package network
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+	"go.opentelemetry.io/otel/trace"
+
+	"github.com/algorand/go-algorand/network/phonebook"
+	"github.com/algorand/go-algorand/protocol"
+	"github.com/algorand/go-algorand/test/partitiontest"
+	"github.com/algorand/go-algorand/util/tracing"
+)
+
+func TestParsePeerFeaturesTraceContext(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	require.Equal(t, pfTraceContext, parsePeerFeatures(PeerFeatureTraceContext))
+	cfg := defaultConfig
+	require.NotContains(t, peerFeatures(cfg, false), PeerFeatureTraceContext)
+	cfg.EnableTracePropagation = true
+	require.Contains(t, peerFeatures(cfg, false), PeerFeatureTraceContext)
+}
+
+// TestTraceContextPropagation checks that the span of the reception of a message is a child of
+// the span of its sender if both peers enabled the propagation of the trace context, and only then.
+func TestTraceContextPropagation(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	recorder := tracetest.NewSpanRecorder()
+	shutdown, err := tracing.SetupWithProcessor(recorder, 100)
+	require.NoError(t, err)
+	defer shutdown(context.Background())
+
+	for _, propagate := range []bool{true, false} {
+		netA := makeTestWebsocketNode(t)
+		netA.config.GossipFanout = 1
+		netA.config.EnableTracePropagation = true
+		netA.Start()
+		netB := makeTestWebsocketNode(t)
+		netB.config.GossipFanout = 1
+		netB.config.EnableTracePropagation = propagate
+		addrA, postListen := netA.Address()
+		require.True(t, postListen)
+		netB.phonebook.ReplacePeerList([]string{addrA}, "default", phonebook.RelayRole)
+		netB.Start()
+
+		received := make(chan trace.SpanContext, 1)
+		netB.RegisterHandlers([]TaggedMessageHandler{{Tag: protocol.TxnTag, MessageHandler: HandlerFunc(func(msg IncomingMessage) OutgoingMessage {
+			received <- msg.SpanContext
+			return OutgoingMessage{Action: Ignore}
+		})}})
+
+		readyTimeout := time.NewTimer(2 * time.Second)
+		waitReady(t, netA, readyTimeout.C)
+		waitReady(t, netB, readyTimeout.C)
+		netA.peersLock.RLock()
+		for _, peer := range netA.peers {
+			require.Equal(t, propagate, peer.traceContext)
+		}
+		netA.peersLock.RUnlock()
+
+		ctx, span := tracing.Start(context.Background(), "sender")
+		require.NoError(t, netA.Broadcast(ctx, protocol.TxnTag, []byte("foo"), false, nil))
+		span.End()
+		var sc trace.SpanContext
+		select {
+		case sc = <-received:
+		case <-time.After(2 * time.Second):
+			require.Fail(t, "timeout waiting for the message")
+		}
+		require.True(t, sc.IsValid())
+		require.Equal(t, propagate, sc.TraceID() == span.SpanContext().TraceID())
+
+		netStop(t, netB, "B")
+		netStop(t, netA, "A")
+
+		var receive tracetest.SpanStub
+		for _, s := range tracetest.SpanStubsFromReadOnlySpans(recorder.Ended()) {
+			if s.SpanContext.SpanID() == sc.SpanID() {
+				receive = s
+			}
+		}
+		require.Equal(t, "network.receive", receive.Name)
+		if propagate {
+			require.True(t, receive.Parent.IsRemote())
+			require.Equal(t, span.SpanContext().SpanID(), receive.Parent.SpanID())
+		} else {
+			require.False(t, receive.Parent.IsValid())
+		}
+	}
+}
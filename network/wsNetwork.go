@@ -40,6 +40,7 @@ import (
 	"github.com/algorand/go-deadlock"
 	"github.com/algorand/websocket"
 	"github.com/gorilla/mux"
+	"github.com/quic-go/quic-go"
 
 	"github.com/algorand/go-algorand/config"
 	"github.com/algorand/go-algorand/crypto"
@@ -48,6 +49,7 @@ import (
 	"github.com/algorand/go-algorand/network/addr"
 	"github.com/algorand/go-algorand/network/limitcaller"
 	"github.com/algorand/go-algorand/network/limitlistener"
+	"github.com/algorand/go-algorand/network/messagetracer"
 	"github.com/algorand/go-algorand/network/p2p"
 	"github.com/algorand/go-algorand/network/phonebook"
 	"github.com/algorand/go-algorand/protocol"
@@ -158,6 +160,18 @@ type WebsocketNetwork struct {
 
 	upgrader websocket.Upgrader
 
+	// quicListener accepts the gossip connections over QUIC, when EnableQUIC is set
+	quicListener *quic.Listener
+	// quicConnections is the number of incoming QUIC connections
+	quicConnections atomic.Int32
+	// quicUnreachable holds the gossip addresses recently unreachable over QUIC, until when they are dialed over websocket only
+	quicUnreachable     map[string]time.Time
+	quicUnreachableLock deadlock.Mutex
+
+	// priorityPeers holds the addresses added by AddPriorityPeer, until when they are kept connected
+	priorityPeers     map[string]time.Time
+	priorityPeersLock deadlock.Mutex
+
 	config config.Local
 
 	log logging.Logger
@@ -202,6 +216,12 @@ type WebsocketNetwork struct {
 	prioTracker      *prioTracker
 	prioResponseChan chan *wsPeer
 
+	// capture records the gossip messages of the peers, if set
+	capture *messagetracer.Capture
+
+	// egressQuotas limits the bandwidth of the messages sent to all the peers, if set
+	egressQuotas *egressQuotas
+
 	// identity challenge scheme for creating challenges and responding
 	identityScheme  identityChallengeScheme
 	identityTracker identityTracker
@@ -609,6 +629,9 @@ func (wn *WebsocketNetwork) setup() {
 	wn.meshUpdateRequests = make(chan meshRequest, 5)
 	wn.readyChan = make(chan struct{})
 	wn.tryConnectAddrs = make(map[string]int64)
+	wn.quicUnreachable = make(map[string]time.Time)
+	wn.priorityPeers = make(map[string]time.Time)
+	wn.egressQuotas = makeEgressQuotas(wn.config)
 	wn.eventualReadyDelay = time.Minute
 	wn.prioTracker = newPrioTracker(wn)
 
@@ -674,6 +697,14 @@ func (wn *WebsocketNetwork) Start() error {
 		// wrap the limited connection listener with a requests tracker listener
 		wn.listener = wn.requestsTracker.Listener(listener)
 		wn.log.Debugf("listening on %s", wn.listener.Addr().String())
+		if wn.config.EnableQUIC {
+			if err = wn.listenQUIC(); err != nil {
+				wn.log.Errorf("network could not listen for QUIC connections on %v: %s", wn.listener.Addr(), err)
+				wn.listener.Close()
+				wn.listener = nil
+				return err
+			}
+		}
 		wn.throttledOutgoingConnections.Store(int32(wn.config.GossipFanout / 2))
 	} else {
 		// on non-relay, all the outgoing connections are throttled.
@@ -714,6 +745,10 @@ func (wn *WebsocketNetwork) Start() error {
 		wn.wg.Add(1)
 		go wn.httpdThread()
 	}
+	if wn.quicListener != nil {
+		wn.wg.Add(1)
+		go wn.quicAcceptThread()
+	}
 	wn.wg.Add(1)
 	go wn.meshThread()
 
@@ -796,7 +831,11 @@ func (wn *WebsocketNetwork) Stop() {
 	if err != nil {
 		wn.log.Warnf("problem shutting down %s: %v", listenAddr, err)
 	}
+	if wn.quicListener != nil {
+		wn.quicListener.Close()
+	}
 	wn.wg.Wait()
+	wn.quicListener = nil
 	if wn.listener != nil {
 		wn.log.Debugf("closed %s", listenAddr)
 	}
@@ -880,7 +919,36 @@ func getCommonHeaders(headers http.Header) (otherTelemetryGUID, otherInstanceNam
 
 // checkIncomingConnectionLimits perform the connection limits counting for the incoming connections.
 func (wn *WebsocketNetwork) checkIncomingConnectionLimits(response http.ResponseWriter, request *http.Request, remoteHost, otherTelemetryGUID, otherInstanceName string) int {
-	if wn.numIncomingPeers() >= wn.config.IncomingConnectionsLimit {
+	if wn.badReputation(remoteHost) {
+		networkConnectionsDroppedTotal.Inc(map[string]string{"reason": "bad_reputation"})
+		networkPeerReputationDrops.Inc(nil)
+		wn.log.EventWithDetails(telemetryspec.Network, telemetryspec.ConnectPeerFailEvent,
+			telemetryspec.ConnectPeerFailEventDetails{
+				Address:       remoteHost,
+				TelemetryGUID: otherTelemetryGUID,
+				Incoming:      true,
+				InstanceName:  otherInstanceName,
+				Reason:        "Bad Reputation",
+			})
+		response.WriteHeader(http.StatusServiceUnavailable)
+		return http.StatusServiceUnavailable
+	}
+
+	if wn.phonebook.Banned(remoteHost) {
+		networkConnectionsDroppedTotal.Inc(map[string]string{"reason": "banned"})
+		wn.log.EventWithDetails(telemetryspec.Network, telemetryspec.ConnectPeerFailEvent,
+			telemetryspec.ConnectPeerFailEventDetails{
+				Address:       remoteHost,
+				TelemetryGUID: otherTelemetryGUID,
+				Incoming:      true,
+				InstanceName:  otherInstanceName,
+				Reason:        "Banned",
+			})
+		response.WriteHeader(http.StatusServiceUnavailable)
+		return http.StatusServiceUnavailable
+	}
+
+	if wn.numIncomingPeers() >= wn.config.IncomingConnectionsLimit && !wn.evictIncomingPeer(remoteHost) {
 		networkConnectionsDroppedTotal.Inc(map[string]string{"reason": "incoming_connection_limit"})
 		wn.log.EventWithDetails(telemetryspec.Network, telemetryspec.ConnectPeerFailEvent,
 			telemetryspec.ConnectPeerFailEventDetails{
@@ -984,7 +1052,7 @@ func (wn *WebsocketNetwork) checkIncomingConnectionVariables(response http.Respo
 	return http.StatusOK
 }
 
-// ServerHTTP handles the gossip network functions over websockets
+// ServerHTTP handles the gossip network functions over websockets, or over QUIC
 func (wn *WebsocketNetwork) ServeHTTP(response http.ResponseWriter, request *http.Request) {
 	if !wn.config.EnableGossipService {
 		response.WriteHeader(http.StatusNotFound)
@@ -1021,7 +1089,7 @@ func (wn *WebsocketNetwork) ServeHTTP(response http.ResponseWriter, request *htt
 	responseHeader.Set(ProtocolVersionHeader, matchingVersion)
 	responseHeader.Set(GenesisHeader, wn.GenesisID)
 	// set the features we support
-	responseHeader.Set(PeerFeaturesHeader, PeerFeatureProposalCompression)
+	responseHeader.Set(PeerFeaturesHeader, peerFeatures(wn.config, true))
 	var challenge string
 	if wn.prioScheme != nil {
 		challenge = wn.prioScheme.NewPrioChallenge()
@@ -1041,7 +1109,7 @@ func (wn *WebsocketNetwork) ServeHTTP(response http.ResponseWriter, request *htt
 		}
 	}
 
-	conn, err := wn.upgrader.Upgrade(response, request, responseHeader)
+	conn, err := wn.upgrade(response, request, responseHeader)
 	if err != nil {
 		wn.log.Info("ws upgrade fail ", err)
 		networkConnectionsDroppedTotal.Inc(map[string]string{"reason": "ws upgrade fail"})
@@ -1056,7 +1124,7 @@ func (wn *WebsocketNetwork) ServeHTTP(response http.ResponseWriter, request *htt
 	client, _ := wn.GetHTTPClient(trackedRequest.remoteAddress())
 	peer := &wsPeer{
 		wsPeerCore:        makePeerCore(wn.ctx, wn, wn.log, wn.handler.readBuffer, trackedRequest.remoteAddress(), client, trackedRequest.remoteHost),
-		conn:              wsPeerWebsocketConnImpl{conn},
+		conn:              conn,
 		outgoing:          false,
 		InstanceName:      trackedRequest.otherInstanceName,
 		incomingMsgFilter: wn.incomingMsgFilter,
@@ -1067,6 +1135,8 @@ func (wn *WebsocketNetwork) ServeHTTP(response http.ResponseWriter, request *htt
 		identityChallenge: peerIDChallenge,
 		identityVerified:  atomic.Uint32{},
 		features:          decodePeerFeatures(matchingVersion, request.Header.Get(PeerFeaturesHeader)),
+		capture:           wn.capture,
+		egressQuotas:      wn.egressQuotas,
 	}
 	peer.TelemetryGUID = trackedRequest.otherTelemetryGUID
 	peer.init(wn.config, wn.outgoingMessagesBufferSize)
@@ -1125,6 +1195,7 @@ func (wn *msgHandler) messageHandlerThread(wg *sync.WaitGroup, peersConnectivity
 			}
 			//wn.log.Debugf("msg handling %#v [%d]byte", msg.Tag, len(msg.Data))
 			start := time.Now()
+			ctx, span := startMessageSpan(wn.ctx, "network.receive", &msg)
 
 			// now, send to global handlers
 			outmsg := wn.Handle(msg)
@@ -1144,7 +1215,7 @@ func (wn *msgHandler) messageHandlerThread(wg *sync.WaitGroup, peersConnectivity
 				}
 				go net.disconnectThread(msg.Sender, reason)
 			case Broadcast:
-				err := net.Broadcast(wn.ctx, msg.Tag, msg.Data, false, msg.Sender)
+				err := net.Broadcast(ctx, msg.Tag, msg.Data, false, msg.Sender)
 				if err != nil && err != errBcastQFull {
 					wn.log.Warnf("WebsocketNetwork.messageHandlerThread: WebsocketNetwork.Broadcast returned unexpected error %v", err)
 				}
@@ -1155,6 +1226,7 @@ func (wn *msgHandler) messageHandlerThread(wg *sync.WaitGroup, peersConnectivity
 				}
 			default:
 			}
+			endMessageSpan(span, outmsg.Action)
 		case <-peersConnectivityCheckCh:
 			// go over the peers and ensure we have some type of communication going on.
 			net.checkPeersConnectivity()
@@ -1400,6 +1472,11 @@ func (wn *msgBroadcaster) innerBroadcast(request broadcastRequest, prio bool, pe
 		if Peer(peer) == request.except {
 			continue
 		}
+		if peer.txReconciliation && !peer.outgoing && request.txnsOnly() {
+			// the incoming peers reconciling transactions with us fetch the ones they are missing
+			// instead of having all of them flooded
+			continue
+		}
 		ok := peer.writeNonBlockMsgs(request.ctx, data, prio, digests, request.enqueueTime)
 		if ok {
 			sentMessageCount++
@@ -1413,6 +1490,16 @@ func (wn *msgBroadcaster) innerBroadcast(request broadcastRequest, prio bool, pe
 	networkBroadcastSendMicros.AddUint64(uint64(dt.Nanoseconds()/1000), nil)
 }
 
+// txnsOnly returns whether the request only broadcasts transactions.
+func (r *broadcastRequest) txnsOnly() bool {
+	for _, tag := range r.tags {
+		if tag != protocol.TxnTag {
+			return false
+		}
+	}
+	return len(r.tags) > 0
+}
+
 // NumPeers returns number of peers we connect to (all peers incoming and outbound).
 func (wn *WebsocketNetwork) NumPeers() int {
 	wn.peersLock.RLock()
@@ -1575,11 +1662,12 @@ func (wn *WebsocketNetwork) updatePhonebookAddresses(relayAddrs []string, archiv
 // note that the determination of needed connection could be inaccurate, and it might return false while
 // more connection should be created.
 func (wn *WebsocketNetwork) checkNewConnectionsNeeded() bool {
+	priorityConnecting := wn.connectPriorityPeers()
 	desired := wn.config.GossipFanout
 	numOutgoingTotal := wn.numOutgoingPeers() + wn.numOutgoingPending()
 	need := desired - numOutgoingTotal
 	if need <= 0 {
-		return false
+		return priorityConnecting
 	}
 	// get more than we need so that we can ignore duplicates
 	newAddrs := wn.phonebook.GetAddresses(desired+numOutgoingTotal, phonebook.RelayRole)
@@ -1588,6 +1676,9 @@ func (wn *WebsocketNetwork) checkNewConnectionsNeeded() bool {
 			// filter out self-public address, so we won't try to connect to ourselves.
 			continue
 		}
+		if wn.badReputation(na) {
+			continue
+		}
 		gossipAddr, ok := wn.tryConnectReserveAddr(na)
 		if ok {
 			wn.wg.Add(1)
@@ -1629,9 +1720,9 @@ func (wn *WebsocketNetwork) checkExistingConnectionsNeedDisconnecting() bool {
 	var leastPerformingPeer *wsPeer = nil
 	for _, stat := range peerStat.peerStatistics {
 		wsPeer := stat.peer.(*wsPeer)
-		wsPeer.peerMessageDelay = stat.peerDelay
+		wsPeer.peerMessageDelay.Store(stat.peerDelay)
 		wn.log.Infof("network performance monitor - peer '%s' delay %d first message portion %d%%", wsPeer.GetAddress(), stat.peerDelay, int(stat.peerFirstMessage*100))
-		if wsPeer.throttledOutgoingConnection && leastPerformingPeer == nil {
+		if wsPeer.throttledOutgoingConnection && leastPerformingPeer == nil && !wn.isPriorityPeer(wsPeer.GetAddress()) {
 			leastPerformingPeer = wsPeer
 		}
 	}
@@ -1660,6 +1751,13 @@ func (wn *WebsocketNetwork) checkNetworkAdvanceDisconnect() bool {
 		// disconnect any existing connection to free up room for another connection.
 		return false
 	}
+	// the priority peers are kept connected
+	outgoingPeers = slices.DeleteFunc(outgoingPeers, func(p Peer) bool {
+		return wn.isPriorityPeer(p.(*wsPeer).GetAddress())
+	})
+	if len(outgoingPeers) == 0 {
+		return false
+	}
 	var peer *wsPeer
 	disconnectPeerIdx := crypto.RandUint63() % uint64(len(outgoingPeers))
 	peer = outgoingPeers[disconnectPeerIdx].(*wsPeer)
@@ -1737,6 +1835,8 @@ func getPeerConnectionTelemetryDetails(now time.Time, peers []*wsPeer) telemetry
 			AVCount:              peer.avMessageCount.Load(),
 			PPCount:              peer.ppMessageCount.Load(),
 			UNKCount:             peer.unkMessageCount.Load(),
+			BytesSent:            peer.sentBytes.Load(),
+			BytesReceived:        peer.receivedBytes.Load(),
 		}
 		if tcpInfo, err := peer.GetUnderlyingConnTCPInfo(); err == nil && tcpInfo != nil {
 			connDetail.TCP = *tcpInfo
@@ -1744,7 +1844,7 @@ func getPeerConnectionTelemetryDetails(now time.Time, peers []*wsPeer) telemetry
 		if peer.outgoing {
 			connDetail.Address = justHost(peer.conn.RemoteAddrString())
 			connDetail.Endpoint = peer.GetAddress()
-			connDetail.MessageDelay = peer.peerMessageDelay
+			connDetail.MessageDelay = peer.peerMessageDelay.Load()
 			connectionDetails.OutgoingPeers = append(connectionDetails.OutgoingPeers, connDetail)
 		} else {
 			connDetail.Address = peer.OriginAddress()
@@ -1906,6 +2006,22 @@ const PeerFeaturesHeader = "X-Algorand-Peer-Features"
 // supports proposal payload compression with zstd
 const PeerFeatureProposalCompression = "ppzstd"
 
+// PeerFeatureVoteCompression is a value for PeerFeaturesHeader indicating peer
+// supports the stateful compression of agreement votes
+const PeerFeatureVoteCompression = "avdict"
+
+// PeerFeatureTxReconciliation is a value for PeerFeaturesHeader indicating peer
+// supports the reconciliation of pending transaction sets with TxnReconciliationTag messages
+const PeerFeatureTxReconciliation = "txrecon"
+
+// PeerFeatureTraceContext is a value for PeerFeaturesHeader indicating peer
+// sends and expects the trace context of every message after its tag
+const PeerFeatureTraceContext = "tracectx"
+
+// PeerFeatureMultiStream is a value for PeerFeaturesHeader indicating peer opens and accepts
+// a stream for each class of messages over the libp2p QUIC connections
+const PeerFeatureMultiStream = "mstream"
+
 var websocketsScheme = map[string]string{"http": "ws", "https": "wss"}
 
 var errBadAddr = errors.New("bad address")
@@ -2038,24 +2154,16 @@ func (wn *WebsocketNetwork) tryConnect(netAddr, gossipAddr string) {
 	// for backward compatibility, include the ProtocolVersion header as well.
 	requestHeader.Set(ProtocolVersionHeader, wn.protocolVersion)
 	// set the features header (comma-separated list)
-	requestHeader.Set(PeerFeaturesHeader, PeerFeatureProposalCompression)
+	requestHeader.Set(PeerFeaturesHeader, peerFeatures(wn.config, true))
 	SetUserAgentHeader(requestHeader)
 	myInstanceName := wn.log.GetInstanceName()
 	requestHeader.Set(InstanceNameHeader, myInstanceName)
-	var websocketDialer = websocket.Dialer{
-		Proxy:             http.ProxyFromEnvironment,
-		HandshakeTimeout:  45 * time.Second,
-		EnableCompression: false,
-		NetDialContext:    wn.dialer.DialContext,
-		NetDial:           wn.dialer.Dial,
-		MaxHeaderSize:     wn.wsMaxHeaderBytes,
-	}
 
-	conn, response, err := websocketDialer.DialContext(wn.ctx, gossipAddr, requestHeader)
+	conn, response, err := wn.dial(gossipAddr, requestHeader)
 
 	if err != nil {
 		if err == websocket.ErrBadHandshake {
-			// reading here from ioutil is safe only because it came from DialContext above, which already finished reading all the data from the network
+			// reading here from ioutil is safe only because it came from dial above, which already finished reading all the data from the network
 			// and placed it all in a ioutil.NopCloser reader.
 			bodyBytes, _ := io.ReadAll(response.Body)
 			errString := string(bodyBytes)
@@ -2091,7 +2199,7 @@ func (wn *WebsocketNetwork) tryConnect(netAddr, gossipAddr string) {
 	// if we abort before making a wsPeer this cleanup logic will close the connection
 	closeEarly := func(msg string) {
 		deadline := time.Now().Add(peerDisconnectionAckDuration)
-		err2 := conn.WriteControl(websocket.CloseMessage, websocket.FormatCloseMessage(websocket.CloseProtocolError, msg), deadline)
+		err2 := conn.CloseWithMessage(websocket.FormatCloseMessage(websocket.CloseProtocolError, msg), deadline)
 		if err2 != nil {
 			wn.log.Infof("tryConnect: failed to write CloseMessage to connection for %s: %v", conn.RemoteAddr().String(), err2)
 		}
@@ -2101,7 +2209,7 @@ func (wn *WebsocketNetwork) tryConnect(netAddr, gossipAddr string) {
 		}
 	}
 
-	// no need to test the response.StatusCode since we know it's going to be http.StatusSwitchingProtocols, as it's already being tested inside websocketDialer.DialContext.
+	// no need to test the response.StatusCode since we know it's going to be http.StatusSwitchingProtocols, as it's already being tested by dial.
 	// we need to examine the headers here to extract which protocol version we should be using.
 	responseHeaderOk, matchingVersion := wn.checkServerResponseVariables(response.Header, gossipAddr)
 	if !responseHeaderOk {
@@ -2134,7 +2242,7 @@ func (wn *WebsocketNetwork) tryConnect(netAddr, gossipAddr string) {
 	client, _ := wn.GetHTTPClient(netAddr)
 	peer := &wsPeer{
 		wsPeerCore:                  makePeerCore(wn.ctx, wn, wn.log, wn.handler.readBuffer, netAddr, client, "" /* origin */),
-		conn:                        wsPeerWebsocketConnImpl{conn},
+		conn:                        conn,
 		outgoing:                    true,
 		incomingMsgFilter:           wn.incomingMsgFilter,
 		createTime:                  time.Now(),
@@ -2143,6 +2251,8 @@ func (wn *WebsocketNetwork) tryConnect(netAddr, gossipAddr string) {
 		version:                     matchingVersion,
 		identity:                    peerID,
 		features:                    decodePeerFeatures(matchingVersion, response.Header.Get(PeerFeaturesHeader)),
+		capture:                     wn.capture,
+		egressQuotas:                wn.egressQuotas,
 	}
 	peer.TelemetryGUID, peer.InstanceName, _ = getCommonHeaders(response.Header)
 
@@ -2272,6 +2382,11 @@ func (wn *WebsocketNetwork) SetPrioScheme(s NetPrioScheme) {
 	wn.prioScheme = s
 }
 
+// SetCapture records the gossip messages sent and received by the peers connected from now on.
+func (wn *WebsocketNetwork) SetCapture(capture *messagetracer.Capture) {
+	wn.capture = capture
+}
+
 // called from wsPeer to report that it has closed
 func (wn *WebsocketNetwork) peerRemoteClose(peer *wsPeer, reason disconnectReason) {
 	wn.removePeer(peer, reason)
@@ -2282,8 +2397,8 @@ func (wn *WebsocketNetwork) removePeer(peer *wsPeer, reason disconnectReason) {
 	// definitely don't change this to do the logging while holding the lock.
 	localAddr, _ := wn.Address()
 	logEntry := wn.log.With("event", "Disconnected").With("remote", peer.GetAddress()).With("local", localAddr)
-	if peer.outgoing && peer.peerMessageDelay > 0 {
-		logEntry = logEntry.With("messageDelay", peer.peerMessageDelay)
+	if messageDelay := peer.peerMessageDelay.Load(); peer.outgoing && messageDelay > 0 {
+		logEntry = logEntry.With("messageDelay", messageDelay)
 	}
 	logEntry.Infof("Peer %s disconnected: %s", peer.GetAddress(), reason)
 	peerAddr := peer.OriginAddress()
@@ -2312,7 +2427,7 @@ func (wn *WebsocketNetwork) removePeer(peer *wsPeer, reason disconnectReason) {
 	}
 	if peer.outgoing {
 		eventDetails.Endpoint = peer.GetAddress()
-		eventDetails.MessageDelay = peer.peerMessageDelay
+		eventDetails.MessageDelay = peer.peerMessageDelay.Load()
 	}
 	wn.log.EventWithDetails(telemetryspec.Network, telemetryspec.DisconnectPeerEvent,
 		telemetryspec.DisconnectPeerEventDetails{
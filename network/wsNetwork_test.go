@@ -490,6 +490,130 @@ func TestWebsocketProposalPayloadCompression(t *testing.T) {
 	}
 }
 
+// Set up two nodes, send votes with and without the vote compression enabled on either end
+func TestWebsocketVoteCompression(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	for _, test := range []struct{ enableA, enableB bool }{{true, true}, {true, false}, {false, true}} {
+		t.Run(fmt.Sprintf("A_%v+B_%v", test.enableA, test.enableB), func(t *testing.T) {
+			netA := makeTestWebsocketNode(t)
+			netA.config.GossipFanout = 1
+			netA.config.EnableVoteCompression = test.enableA
+			netA.Start()
+			defer netStop(t, netA, "A")
+			netB := makeTestWebsocketNode(t)
+			netB.config.GossipFanout = 1
+			netB.config.EnableVoteCompression = test.enableB
+			addrA, postListen := netA.Address()
+			require.True(t, postListen)
+			netB.phonebook.ReplacePeerList([]string{addrA}, "default", phonebook.RelayRole)
+			netB.Start()
+			defer netStop(t, netB, "B")
+
+			voters := []testVoter{makeTestVoter(), makeTestVoter()}
+			proposal := randBytes(32)
+			var messages [][]byte
+			for step := uint64(0); step < 3; step++ {
+				for _, voter := range voters {
+					messages = append(messages, makeTestVote(voter, 1234567, step, proposal))
+				}
+			}
+			matcher := newMessageMatcher(t, messages)
+			counterDone := matcher.done
+			netB.RegisterHandlers([]TaggedMessageHandler{{Tag: protocol.AgreementVoteTag, MessageHandler: matcher}})
+
+			readyTimeout := time.NewTimer(2 * time.Second)
+			waitReady(t, netA, readyTimeout.C)
+			waitReady(t, netB, readyTimeout.C)
+
+			peers := netA.GetPeers(PeersConnectedIn)
+			require.Len(t, peers, 1)
+			require.Equal(t, test.enableA && test.enableB, peers[0].(*wsPeer).voteCompressor != nil)
+
+			for _, msg := range messages {
+				netA.Broadcast(context.Background(), protocol.AgreementVoteTag, msg, true, nil)
+			}
+
+			select {
+			case <-counterDone:
+			case <-time.After(2 * time.Second):
+				t.Errorf("timeout, count=%d, wanted %d", len(matcher.received), len(messages))
+			}
+
+			require.True(t, matcher.Match())
+		})
+	}
+}
+
+// TestWebsocketTxReconciliation checks transactions are not flooded to the incoming peers reconciling them,
+// and reconciliation messages are only sent to such peers
+func TestWebsocketTxReconciliation(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	for _, enableB := range []bool{true, false} {
+		t.Run(fmt.Sprintf("B_%v", enableB), func(t *testing.T) {
+			netA := makeTestWebsocketNode(t)
+			netA.config.GossipFanout = 1
+			netA.config.EnableTxReconciliation = true
+			netA.Start()
+			defer netStop(t, netA, "A")
+			netB := makeTestWebsocketNode(t)
+			netB.config.GossipFanout = 1
+			netB.config.EnableTxReconciliation = enableB
+			addrA, postListen := netA.Address()
+			require.True(t, postListen)
+			netB.phonebook.ReplacePeerList([]string{addrA}, "default", phonebook.RelayRole)
+			netB.Start()
+			defer netStop(t, netB, "B")
+
+			received := make(chan string, 10)
+			handler := HandlerFunc(func(msg IncomingMessage) OutgoingMessage {
+				received <- string(msg.Tag) + ":" + string(msg.Data)
+				return OutgoingMessage{Action: Ignore}
+			})
+			netB.RegisterHandlers([]TaggedMessageHandler{
+				{Tag: protocol.TxnTag, MessageHandler: handler},
+				{Tag: protocol.TxnReconciliationTag, MessageHandler: handler},
+			})
+
+			readyTimeout := time.NewTimer(2 * time.Second)
+			waitReady(t, netA, readyTimeout.C)
+			waitReady(t, netB, readyTimeout.C)
+
+			peers := netA.GetPeers(PeersConnectedIn)
+			require.Len(t, peers, 1)
+			peerB := peers[0].(*wsPeer)
+			require.Equal(t, enableB, peerB.TxReconciliation())
+
+			require.NoError(t, netA.Broadcast(context.Background(), protocol.TxnTag, []byte("flooded"), true, nil))
+			require.NoError(t, peerB.Unicast(context.Background(), []byte("sketch"), protocol.TxnReconciliationTag))
+			require.NoError(t, peerB.Unicast(context.Background(), []byte("missing"), protocol.TxnTag))
+
+			var expected []string
+			if enableB {
+				expected = []string{"TR:sketch", "TX:missing"}
+			} else {
+				expected = []string{"TX:flooded", "TX:missing"}
+			}
+			var got []string
+			for range expected {
+				select {
+				case msg := <-received:
+					got = append(got, msg)
+				case <-time.After(2 * time.Second):
+					t.Fatalf("timeout, received %v, wanted %v", got, expected)
+				}
+			}
+			require.ElementsMatch(t, expected, got)
+			select {
+			case msg := <-received:
+				t.Fatalf("unexpected message %s", msg)
+			case <-time.After(100 * time.Millisecond):
+			}
+		})
+	}
+}
+
 // Repeat basic, but test a unicast
 func TestWebsocketNetworkUnicast(t *testing.T) {
 	partitiontest.PartitionTest(t)
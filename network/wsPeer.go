@@ -38,8 +38,10 @@ import (
 	"github.com/algorand/go-algorand/crypto"
 	"github.com/algorand/go-algorand/data/basics"
 	"github.com/algorand/go-algorand/logging"
+	"github.com/algorand/go-algorand/network/messagetracer"
 	"github.com/algorand/go-algorand/protocol"
 	"github.com/algorand/go-algorand/util"
+	"github.com/algorand/go-algorand/util/tracing"
 )
 
 // MaxMessageLength is the maximum length of a message that can be sent or received, exported to be used in the node.TestMaxSizesCorrect test
@@ -146,6 +148,8 @@ const disconnectStaleWrite disconnectReason = "DisconnectStaleWrite"
 const disconnectDuplicateConnection disconnectReason = "DuplicateConnection"
 const disconnectBadIdentityData disconnectReason = "BadIdentityData"
 const disconnectUnexpectedTopicResp disconnectReason = "UnexpectedTopicResp"
+const disconnectBadReputation disconnectReason = "BadReputation"
+const disconnectAdminRequest disconnectReason = "AdminRequest"
 
 // Response is the structure holding the response from the server
 type Response struct {
@@ -190,6 +194,11 @@ type wsPeer struct {
 	// to filter that it had already sent before.
 	duplicateFilterCount atomic.Uint64
 
+	// recentMessages and repeatedMessageCount detect a peer flooding us with the same messages:
+	// repeatedMessageCount counts the messages the peer sent again while they were still in recentMessages.
+	recentMessages       recentMessages
+	repeatedMessageCount atomic.Uint64
+
 	txMessageCount, miMessageCount, ppMessageCount, avMessageCount, unkMessageCount atomic.Uint64
 
 	wsPeerCore
@@ -243,6 +252,26 @@ type wsPeer struct {
 	// peer features derived from the peer version
 	features peerFeatureFlag
 
+	// voteCompressor compresses the votes sent to the peer; it is only set when both ends announced the
+	// vote compression feature, and is only used by the writeLoop.
+	voteCompressor *voteCompressor
+
+	// txReconciliation is set when both ends announced the transaction set reconciliation feature
+	txReconciliation bool
+
+	// traceContext is set when both ends announced the trace context feature: the tag of every message
+	// exchanged with the peer is then followed by the trace context of the message.
+	traceContext bool
+
+	// capture records the messages exchanged with the peer, if set
+	capture *messagetracer.Capture
+
+	// egressQuotas limits the bandwidth of the messages sent to all the peers of the network, if set
+	egressQuotas *egressQuotas
+
+	// sentBytes and receivedBytes count the bytes of the messages exchanged with the peer
+	sentBytes, receivedBytes atomic.Uint64
+
 	// responseChannels used by the client to wait on the response of the request
 	responseChannels map[uint64]chan *Response
 
@@ -262,7 +291,7 @@ type wsPeer struct {
 	connMonitor *connectionPerformanceMonitor
 
 	// peerMessageDelay is calculated by the connection monitor; it's the relative average per-message delay.
-	peerMessageDelay int64
+	peerMessageDelay atomic.Int64
 
 	// throttledOutgoingConnection determines if this outgoing connection will be throttled based on it's
 	// performance or not. Throttled connections are more likely to be short-lived connections.
@@ -304,6 +333,15 @@ type UnicastPeer interface {
 	Respond(ctx context.Context, reqMsg IncomingMessage, outMsg OutgoingMessage) (e error)
 }
 
+// TxReconciliationPeer is a peer which negotiated the reconciliation of pending transaction sets.
+// Transactions are no longer flooded to the incoming ones, which fetch the transactions
+// they are missing by initiating reconciliations.
+type TxReconciliationPeer interface {
+	UnicastPeer
+	// TxReconciliation returns whether both ends announced the reconciliation feature.
+	TxReconciliation() bool
+}
+
 // TCPInfoUnicastPeer exposes information about the underlying connection if available on the platform
 type TCPInfoUnicastPeer interface {
 	UnicastPeer
@@ -444,6 +482,16 @@ func (wp *wsPeer) Respond(ctx context.Context, reqMsg IncomingMessage, outMsg Ou
 
 	// Serialize the topics
 	serializedMsg := responseTopics.MarshallTopics()
+	onRelease := outMsg.OnRelease
+	if !wp.egressQuotas.allowClass(egressClassCatchup, len(serializedMsg)) {
+		// answer with an error, so that the requesting peer retries with another peer right away
+		if onRelease != nil {
+			onRelease()
+			onRelease = nil
+		}
+		responseTopics = Topics{MakeTopic(ErrorKey, []byte(errCatchupEgressQuotaExceeded)), Topic{key: requestHashKey, data: requestHashData}}
+		serializedMsg = responseTopics.MarshallTopics()
+	}
 
 	// Send serializedMsg
 	msg := make([]sendMessage, 1, 1)
@@ -455,16 +503,16 @@ func (wp *wsPeer) Respond(ctx context.Context, reqMsg IncomingMessage, outMsg Ou
 	}
 
 	select {
-	case wp.sendBufferBulk <- sendMessages{msgs: msg, onRelease: outMsg.OnRelease}:
+	case wp.sendBufferBulk <- sendMessages{msgs: msg, onRelease: onRelease}:
 	case <-wp.closing:
-		if outMsg.OnRelease != nil {
-			outMsg.OnRelease()
+		if onRelease != nil {
+			onRelease()
 		}
 		wp.log.Debugf("peer closing %s", wp.conn.RemoteAddrString())
 		return
 	case <-ctx.Done():
-		if outMsg.OnRelease != nil {
-			outMsg.OnRelease()
+		if onRelease != nil {
+			onRelease()
 		}
 		return ctx.Err()
 	}
@@ -481,6 +529,7 @@ func (wp *wsPeer) init(config config.Local, sendBufferLength int) {
 	wp.responseChannels = make(map[uint64]chan *Response)
 	wp.sendMessageTag = defaultSendMessageTags
 	wp.clientDataStore = make(map[string]interface{})
+	wp.recentMessages = makeRecentMessages()
 
 	// processed is a channel that messageHandlerThread writes to
 	// when it's done with one of our messages, so that we can queue
@@ -495,11 +544,23 @@ func (wp *wsPeer) init(config config.Local, sendBufferLength int) {
 		wp.outgoingMsgFilter = makeMessageFilter(config.OutgoingMessageFilterBucketCount, config.OutgoingMessageFilterBucketSize)
 	}
 
+	if config.EnableVoteCompression && wp.features&pfCompressedVotes != 0 {
+		wp.voteCompressor = makeVoteCompressor()
+	}
+
+	wp.txReconciliation = config.EnableTxReconciliation && wp.features&pfTxReconciliation != 0
+	wp.traceContext = config.EnableTracePropagation && wp.features&pfTraceContext != 0
+
 	wp.wg.Add(2)
 	go wp.readLoop()
 	go wp.writeLoop()
 }
 
+// TxReconciliation returns whether the peer reconciles its pending transactions with this node.
+func (wp *wsPeer) TxReconciliation() bool {
+	return wp.txReconciliation
+}
+
 // returns the originating address of an incoming connection. For outgoing connection this function returns an empty string.
 func (wp *wsPeer) OriginAddress() string {
 	return wp.originAddress
@@ -558,6 +619,19 @@ func (wp *wsPeer) readLoop() {
 			return
 		}
 		msg.Tag = Tag(string(tag[:]))
+		if wp.traceContext {
+			var spanContext [tracing.SpanContextSize]byte
+			if _, err = io.ReadFull(reader, spanContext[:]); err != nil {
+				wp.reportReadErr(err)
+				return
+			}
+			if msg.remoteSpanContext, err = tracing.DecodeSpanContext(spanContext[:]); err != nil {
+				wp.log.Warnf("wsPeer readLoop: invalid trace context from %s: %v", wp.conn.RemoteAddrString(), err)
+				networkConnectionsDroppedTotal.Inc(map[string]string{"reason": "protocol"})
+				cleanupCloseError = disconnectBadData
+				return
+			}
+		}
 
 		// Skip the message if it's a response to a request we didn't make or has timed out
 		if msg.Tag == protocol.TopicMsgRespTag && wp.lenResponseChannels() == 0 {
@@ -592,6 +666,12 @@ func (wp *wsPeer) readLoop() {
 		msg.processing = wp.processed
 		msg.Received = time.Now().UnixNano()
 		msg.Data = slurper.Bytes()
+		// the bytes received from the peer are those of the message before it is decompressed
+		received := len(tag) + len(msg.Data)
+		if wp.traceContext {
+			received += tracing.SpanContextSize
+		}
+		wp.receivedBytes.Add(uint64(received))
 		msg.Data, err = dataConverter.convert(msg.Tag, msg.Data)
 		if err != nil {
 			wp.reportReadErr(err)
@@ -599,6 +679,9 @@ func (wp *wsPeer) readLoop() {
 		}
 		msg.Net = wp.net
 		wp.lastPacketTime.Store(msg.Received)
+		if wp.capture != nil {
+			wp.capture.Record(messagetracer.Incoming, msg.Tag, wp.GetAddress(), msg.Data)
+		}
 		if wp.peerType == peerTypeWs {
 			networkReceivedBytesTotal.AddUint64(uint64(len(msg.Data)+2), nil)
 			networkMessageReceivedTotal.AddUint64(1, nil)
@@ -667,13 +750,19 @@ func (wp *wsPeer) readLoop() {
 		case protocol.ProposalPayloadTag:
 			wp.ppMessageCount.Add(1)
 		// the remaining valid tags: no special handling here
-		case protocol.NetPrioResponseTag, protocol.StateProofSigTag, protocol.UniEnsBlockReqTag, protocol.VoteBundleTag, protocol.NetIDVerificationTag:
+		case protocol.NetPrioResponseTag, protocol.StateProofSigTag, protocol.UniEnsBlockReqTag, protocol.VoteBundleTag, protocol.NetIDVerificationTag, protocol.TxnReconciliationTag:
 		default: // unrecognized tag
 			unknownProtocolTagMessagesTotal.Inc(nil)
 			wp.unkMessageCount.Add(1)
 			continue // drop message, skip adding it to queue
 			// TODO: should disconnect here?
 		}
+		if len(msg.Data) > 0 && dedupSafeTag(msg.Tag) && wp.recentMessages.repeated(msg.Tag, msg.Data) {
+			// relays send every message once: a peer repeating its own messages is abusing our bandwidth
+			if wp.repeatedMessageCount.Add(1)%duplicateFloodThreshold == 0 {
+				wp.net.ReportPeerEvent(wp, PeerEventDuplicateFlood)
+			}
+		}
 		if len(msg.Data) > 0 && wp.incomingMsgFilter != nil && dedupSafeTag(msg.Tag) {
 			if wp.incomingMsgFilter.CheckIncomingMessage(msg.Tag, msg.Data, true, true) {
 				//wp.log.Debugf("dropped incoming duplicate %s(%d)", msg.Tag, len(msg.Data))
@@ -804,8 +893,9 @@ func (wp *wsPeer) writeLoopSendMsg(msg sendMessage) disconnectReason {
 	}
 	// the tags are always 2 char long; note that this is safe since it's only being used for messages that we have generated locally.
 	tag := protocol.Tag(msg.data[:2])
-	if !wp.sendMessageTag[tag] {
-		// the peer isn't interested in this message.
+	if !wp.sendMessageTag[tag] && !(tag == protocol.TxnReconciliationTag && wp.txReconciliation && wp.sendMessageTag[protocol.TxnTag]) {
+		// the peer isn't interested in this message. Transaction reconciliation messages are not part of the
+		// messages of interest, for backward compatibility, and are sent to the peers interested in transactions.
 		return disconnectReasonNone
 	}
 
@@ -818,9 +908,30 @@ func (wp *wsPeer) writeLoopSendMsg(msg sendMessage) disconnectReason {
 		return disconnectStaleWrite
 	}
 
+	data := msg.data
+	if tag == protocol.AgreementVoteTag && wp.voteCompressor != nil {
+		// votes are compressed here rather than when enqueued, since the dictionary of the compressor
+		// must only account for the votes actually sent to the peer, in the order they are sent.
+		data = wp.voteCompressor.compress(msg.data)
+		networkVoteCompressionInputBytesTotal.AddUint64(uint64(len(msg.data)), nil)
+		networkVoteCompressionOutputBytesTotal.AddUint64(uint64(len(data)), nil)
+	}
+	if wp.traceContext {
+		spanContext := tracing.EncodeSpanContext(msg.ctx)
+		withContext := make([]byte, 0, len(data)+len(spanContext))
+		withContext = append(withContext, data[:len(tag)]...)
+		withContext = append(withContext, spanContext[:]...)
+		data = append(withContext, data[len(tag):]...)
+	}
+
+	if !wp.egressQuotas.allow(tag, len(data)) {
+		// the egress quota of the message class is exceeded: drop the message, but keep the connection
+		return disconnectReasonNone
+	}
+
 	wp.intermittentOutgoingMessageEnqueueTime.Store(msg.enqueued.UnixNano())
 	defer wp.intermittentOutgoingMessageEnqueueTime.Store(0)
-	err := wp.conn.WriteMessage(websocket.BinaryMessage, msg.data)
+	err := wp.conn.WriteMessage(websocket.BinaryMessage, data)
 	if err != nil {
 		if wp.didInnerClose.Load() == 0 {
 			wp.log.Warn("peer write error ", err)
@@ -829,15 +940,19 @@ func (wp *wsPeer) writeLoopSendMsg(msg sendMessage) disconnectReason {
 		return disconnectWriteError
 	}
 	wp.lastPacketTime.Store(time.Now().UnixNano())
+	wp.sentBytes.Add(uint64(len(data)))
+	if wp.capture != nil {
+		wp.capture.Record(messagetracer.Outgoing, tag, wp.GetAddress(), msg.data[len(tag):])
+	}
 	if wp.peerType == peerTypeWs {
-		networkSentBytesTotal.AddUint64(uint64(len(msg.data)), nil)
-		networkSentBytesByTag.Add(string(tag), uint64(len(msg.data)))
+		networkSentBytesTotal.AddUint64(uint64(len(data)), nil)
+		networkSentBytesByTag.Add(string(tag), uint64(len(data)))
 		networkMessageSentTotal.AddUint64(1, nil)
 		networkMessageSentByTag.Add(string(tag), 1)
 		networkMessageQueueMicrosTotal.AddUint64(uint64(time.Since(msg.peerEnqueued).Nanoseconds()/1000), nil)
 	} else {
-		networkP2PSentBytesTotal.AddUint64(uint64(len(msg.data)), nil)
-		networkP2PSentBytesByTag.Add(string(tag), uint64(len(msg.data)))
+		networkP2PSentBytesTotal.AddUint64(uint64(len(data)), nil)
+		networkP2PSentBytesByTag.Add(string(tag), uint64(len(data)))
 		networkP2PMessageSentTotal.AddUint64(1, nil)
 		networkP2PMessageSentByTag.Add(string(tag), 1)
 		networkP2PMessageQueueMicrosTotal.AddUint64(uint64(time.Since(msg.peerEnqueued).Nanoseconds()/1000), nil)
@@ -1123,6 +1238,10 @@ type peerFeatureFlag int
 
 const (
 	pfCompressedProposal peerFeatureFlag = 1 << iota
+	pfCompressedVotes
+	pfTxReconciliation
+	pfTraceContext
+	pfMultiStream
 )
 
 // versionPeerFeatures defines protocol version when peer features were introduced
@@ -1160,13 +1279,44 @@ func decodePeerFeatures(version string, announcedFeatures string) peerFeatureFla
 		return 0
 	}
 
+	return parsePeerFeatures(announcedFeatures)
+}
+
+// parsePeerFeatures parses the comma-separated list of features announced by a peer
+func parsePeerFeatures(announcedFeatures string) peerFeatureFlag {
 	var features peerFeatureFlag
 	parts := strings.Split(announcedFeatures, ",")
 	for _, part := range parts {
 		part = strings.TrimSpace(part)
-		if part == PeerFeatureProposalCompression {
+		switch part {
+		case PeerFeatureProposalCompression:
 			features |= pfCompressedProposal
+		case PeerFeatureVoteCompression:
+			features |= pfCompressedVotes
+		case PeerFeatureTxReconciliation:
+			features |= pfTxReconciliation
+		case PeerFeatureTraceContext:
+			features |= pfTraceContext
+		case PeerFeatureMultiStream:
+			features |= pfMultiStream
 		}
 	}
 	return features
 }
+
+// peerFeatures returns the comma-separated list of features the node announces to its peers.
+// Transaction set reconciliation is only announced when txReconciliation is set, since the
+// P2P network gossips transactions over pubsub rather than over the peer streams.
+func peerFeatures(cfg config.Local, txReconciliation bool) string {
+	features := []string{PeerFeatureProposalCompression}
+	if cfg.EnableVoteCompression {
+		features = append(features, PeerFeatureVoteCompression)
+	}
+	if txReconciliation && cfg.EnableTxReconciliation {
+		features = append(features, PeerFeatureTxReconciliation)
+	}
+	if cfg.EnableTracePropagation {
+		features = append(features, PeerFeatureTraceContext)
+	}
+	return strings.Join(features, ",")
+}
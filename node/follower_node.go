@@ -20,6 +20,7 @@ package node
 import (
 	"context"
 	"fmt"
+	"io"
 	"time"
 
 	"github.com/algorand/go-deadlock"
@@ -308,6 +309,16 @@ func (node *AlgorandFollowerNode) ListParticipationKeys() (partKeys []account.Pa
 	return []account.ParticipationRecord{}, nil
 }
 
+// ParticipationHealth returns an empty list in follower mode
+func (node *AlgorandFollowerNode) ParticipationHealth() ([]ParticipationKeyHealth, error) {
+	return []ParticipationKeyHealth{}, nil
+}
+
+// UnlockParticipationKeys returns an error in follower mode
+func (node *AlgorandFollowerNode) UnlockParticipationKeys(_ []byte, _ bool) error {
+	return fmt.Errorf("cannot unlock participation keys in follower mode")
+}
+
 // GetParticipationKey returns an error in follower mode
 func (node *AlgorandFollowerNode) GetParticipationKey(_ account.ParticipationID) (account.ParticipationRecord, error) {
 	return account.ParticipationRecord{}, fmt.Errorf("cannot get participation key in follower mode")
@@ -463,6 +474,24 @@ func (node *AlgorandFollowerNode) UnsetSyncRound() {
 	node.catchupService.UnsetDisableSyncRound()
 }
 
+// ExportLedgerSnapshot writes a consistent snapshot of the ledger databases into w.
+// Follower nodes do not run agreement, and therefore have no crash database to include.
+func (node *AlgorandFollowerNode) ExportLedgerSnapshot(ctx context.Context, w io.Writer) error {
+	return exportLedgerSnapshot(ctx, w, node.ledger, nil, node.genesisDirs, node.genesisID, node.genesisHash)
+}
+
+// PeerAdmin returns the peer management of the node's network, or nil if the network does not support it.
+func (node *AlgorandFollowerNode) PeerAdmin() network.PeerAdmin {
+	admin, _ := node.net.(network.PeerAdmin)
+	return admin
+}
+
+// RollbackLedger rewinds the ledger to the given round.
+// This is only available in dev mode.
+func (node *AlgorandFollowerNode) RollbackLedger(rnd basics.Round) error {
+	return fmt.Errorf("cannot roll back the ledger in follower mode")
+}
+
 // SetBlockTimeStampOffset sets a timestamp offset in the block header.
 // This is only available in dev mode.
 func (node *AlgorandFollowerNode) SetBlockTimeStampOffset(offset int64) error {
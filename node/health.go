@@ -0,0 +1,157 @@
+// Copyright (C) 2019-2025 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package node
+
+import (
+	"errors"
+
+	"github.com/algorand/go-algorand/agreement"
+	"github.com/algorand/go-algorand/config"
+	"github.com/algorand/go-algorand/data/account"
+	"github.com/algorand/go-algorand/data/basics"
+	"github.com/algorand/go-algorand/ledger/apply"
+	"github.com/algorand/go-algorand/ledger/eval"
+	"github.com/algorand/go-algorand/ledger/ledgercore"
+)
+
+// ParticipationHealthWindow is the number of recent rounds the proposals of
+// the accounts are counted over by ParticipationHealth.
+const ParticipationHealthWindow = 1000
+
+// ParticipationKeyHealth reports whether the account of an installed
+// participation key is participating, and whether it is at risk of being
+// suspended, as of the latest round.
+type ParticipationKeyHealth struct {
+	Record account.ParticipationRecord
+
+	// Registered is set when the key is the one the account is registered
+	// online with.
+	Registered        bool
+	IncentiveEligible bool
+	LastProposed      basics.Round
+	LastHeartbeat     basics.Round
+
+	// SuspensionChecked is set when the account is among the accounts the
+	// proposers check for absenteeism, see Ledger.GetKnockOfflineCandidates.
+	SuspensionChecked bool
+	// AbsentAfter is the last round the account may go without proposing
+	// or heartbeating before it is absent, or zero if it cannot be absent.
+	AbsentAfter basics.Round
+	// Absent is set when the account is absent, and will be suspended by the
+	// next proposer checking it.
+	Absent bool
+	// HeartbeatNeeded is set when the account failed the current challenge,
+	// and must heartbeat before the end of its grace period.
+	HeartbeatNeeded bool
+	// HeartbeatPending is set when the heartbeat service sent a heartbeat for
+	// the account which is still valid.
+	HeartbeatPending bool
+
+	// ExpectedProposals is the number of blocks the account is expected to
+	// have proposed over the last ParticipationHealthWindow rounds, given its
+	// share of the online stake, and ActualProposals the number it proposed.
+	// The window is shorter when the node does not have the blocks.
+	ExpectedProposals uint64
+	ActualProposals   uint64
+}
+
+// ParticipationHealth reports the health of each installed participation key.
+func (node *AlgorandFullNode) ParticipationHealth() ([]ParticipationKeyHealth, error) {
+	records := node.accountManager.Registry().GetAll()
+	latest := node.ledger.Latest()
+	hdr, err := node.ledger.BlockHdr(latest)
+	if err != nil {
+		return nil, err
+	}
+	proto := config.Consensus[hdr.CurrentProtocol]
+
+	paramsHdr, err := node.ledger.BlockHdr(agreement.ParamsRound(latest + 1))
+	if err != nil {
+		return nil, err
+	}
+	balanceRound := agreement.BalanceRound(latest+1, config.Consensus[paramsHdr.CurrentProtocol])
+	totalOnline, err := node.ledger.OnlineCirculation(balanceRound, latest+1)
+	if err != nil {
+		return nil, err
+	}
+	candidates, err := node.ledger.GetKnockOfflineCandidates(latest, proto)
+	if err != nil {
+		return nil, err
+	}
+	ch := apply.FindChallenge(proto.Payouts, latest, node.ledger, apply.ChRisky)
+
+	// Count the proposals of the accounts of the node over the window
+	proposals := make(map[basics.Address]uint64)
+	for _, record := range records {
+		proposals[record.Account] = 0
+	}
+	// The window is clamped to the blocks the ledger has, which may start after a catchpoint
+	var window uint64
+	for rnd := latest; rnd >= 1 && window < ParticipationHealthWindow; rnd-- {
+		h, err := node.ledger.BlockHdr(rnd)
+		if errors.As(err, &ledgercore.ErrNoEntry{}) {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+		window++
+		if _, ok := proposals[h.Proposer]; ok {
+			proposals[h.Proposer]++
+		}
+	}
+
+	health := make([]ParticipationKeyHealth, 0, len(records))
+	for _, record := range records {
+		data, _, _, err := node.ledger.LookupAccount(latest, record.Account)
+		if err != nil {
+			return nil, err
+		}
+		h := ParticipationKeyHealth{
+			Record:            record,
+			Registered:        data.Status == basics.Online && data.VoteID == record.VoteID,
+			IncentiveEligible: data.IncentiveEligible,
+			LastProposed:      data.LastProposed,
+			LastHeartbeat:     data.LastHeartbeat,
+			ActualProposals:   proposals[record.Account],
+			HeartbeatPending:  node.heartbeatService.PendingHeartbeat(record.Account, latest),
+		}
+		_, h.SuspensionChecked = candidates[record.Account]
+
+		if data.Status == basics.Online {
+			oad, err := node.ledger.LookupAgreement(latest, record.Account)
+			if err != nil {
+				return nil, err
+			}
+			stake := oad.VotingStake()
+			if !totalOnline.IsZero() {
+				h.ExpectedProposals, _ = basics.Muldiv(window, stake.Raw, totalOnline.Raw)
+			}
+			// Same as the absenteeism checks of the block evaluator
+			lastSeen := max(data.LastProposed, data.LastHeartbeat)
+			if data.IncentiveEligible && lastSeen != 0 {
+				if lag, ok := eval.AllowableAbsence(totalOnline, stake); ok {
+					h.AbsentAfter = lastSeen + lag
+					h.Absent = h.AbsentAfter < latest+1
+				}
+			}
+			h.HeartbeatNeeded = data.IncentiveEligible && ch.Failed(record.Account, lastSeen)
+		}
+		health = append(health, h)
+	}
+	return health, nil
+}
@@ -21,6 +21,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"io"
 	"math"
 	"os"
 	"path/filepath"
@@ -32,6 +33,7 @@ import (
 
 	"github.com/algorand/go-algorand/agreement"
 	"github.com/algorand/go-algorand/agreement/gossip"
+	"github.com/algorand/go-algorand/agreement/signguard"
 	"github.com/algorand/go-algorand/catchup"
 	"github.com/algorand/go-algorand/config"
 	"github.com/algorand/go-algorand/crypto"
@@ -129,6 +131,7 @@ type AlgorandFullNode struct {
 	blockService             *rpcs.BlockService
 	ledgerService            *rpcs.LedgerService
 	txPoolSyncerService      *rpcs.TxSyncer
+	txReconciler             *rpcs.TxReconciler
 
 	genesisDirs     config.ResolvedGenesisDirs
 	genesisID       string
@@ -148,6 +151,8 @@ type AlgorandFullNode struct {
 	lowPriorityCryptoVerificationPool  execpool.BacklogPool
 	highPriorityCryptoVerificationPool execpool.BacklogPool
 	catchupBlockAuth                   blockAuthenticatorImpl
+	// gossipVoteVerifier verifies the agreement messages received over gossip topics, if enabled
+	gossipVoteVerifier *agreement.AsyncVoteVerifier
 
 	oldKeyDeletionNotify        chan struct{}
 	monitoringRoutinesWaitGroup sync.WaitGroup
@@ -156,8 +161,15 @@ type AlgorandFullNode struct {
 
 	stateProofWorker *stateproof.Worker
 	partHandles      []db.Accessor
+	crashAccess      db.Accessor
 
 	heartbeatService *heartbeat.Service
+
+	// partKeyManager is only set when EnableParticipationKeyManager is set
+	partKeyManager *partKeyManager
+
+	// signingGuard is only set when EnableDoubleSignProtection is set
+	signingGuard *signguard.Guard
 }
 
 // TxnWithStatus represents information about a single transaction,
@@ -182,6 +194,18 @@ type TxnWithStatus struct {
 // MakeFull sets up an Algorand full node
 // (i.e., it returns a node that participates in consensus)
 func MakeFull(log logging.Logger, rootDir string, cfg config.Local, phonebookAddresses []string, genesis bookkeeping.Genesis) (*AlgorandFullNode, error) {
+	return makeFull(log, rootDir, cfg, phonebookAddresses, genesis, nil, nil)
+}
+
+// MakeFullWithNetwork sets up an Algorand full node on top of the given network instead of
+// creating one from the configuration, e.g. a network.SimulatedNetwork.
+// If clock is not nil, it drives the timeouts of the agreement service; the other services of the
+// node keep running on the wall clock, so the node is not reproducible even over a simulated network.
+func MakeFullWithNetwork(log logging.Logger, rootDir string, cfg config.Local, net network.GossipNode, clock timers.Clock[agreement.TimeoutType], genesis bookkeeping.Genesis) (*AlgorandFullNode, error) {
+	return makeFull(log, rootDir, cfg, nil, genesis, net, clock)
+}
+
+func makeFull(log logging.Logger, rootDir string, cfg config.Local, phonebookAddresses []string, genesis bookkeeping.Genesis, net network.GossipNode, clock timers.Clock[agreement.TimeoutType]) (*AlgorandFullNode, error) {
 	node := new(AlgorandFullNode)
 	node.log = log.With("name", cfg.NetAddress)
 	node.genesisID = genesis.ID()
@@ -202,7 +226,9 @@ func MakeFull(log logging.Logger, rootDir string, cfg config.Local, phonebookAdd
 
 	// tie network, block fetcher, and agreement services together
 	var p2pNode network.GossipNode
-	if cfg.EnableP2PHybridMode {
+	if net != nil {
+		p2pNode = net
+	} else if cfg.EnableP2PHybridMode {
 		p2pNode, err = network.NewHybridP2PNetwork(node.log, node.config, rootDir, phonebookAddresses, genesis.ID(), genesis.Network, node)
 		if err != nil {
 			log.Errorf("could not create hybrid p2p node: %v", err)
@@ -225,6 +251,13 @@ func MakeFull(log logging.Logger, rootDir string, cfg config.Local, phonebookAdd
 		p2pNode = wsNode
 	}
 	node.net = p2pNode
+	if cfg.EnableNetworkCapture {
+		if capturer, ok := p2pNode.(interface {
+			SetCapture(*messagetracer.Capture)
+		}); ok {
+			capturer.SetCapture(makeNetworkCapture(cfg, rootDir, log))
+		}
+	}
 
 	node.cryptoPool = execpool.MakePool(node, "worker", "cryptoPool")
 	node.lowPriorityCryptoVerificationPool = execpool.MakeBacklog(node.cryptoPool, 2*node.cryptoPool.GetParallelism(), execpool.LowPriority, node, "worker", "lowPriorityCryptoVerificationPool")
@@ -252,6 +285,14 @@ func MakeFull(log logging.Logger, rootDir string, cfg config.Local, phonebookAdd
 		return nil, err
 	}
 
+	if cfg.ParticipationKeysEncryption != "" {
+		err = node.lockParticipationKeys()
+		if err != nil {
+			log.Errorf("Cannot lock participation keys: %v", err)
+			return nil, err
+		}
+	}
+
 	node.oldKeyDeletionNotify = make(chan struct{}, 1)
 
 	node.transactionPool = pools.MakeTransactionPool(node.ledger.Ledger, cfg, node.log, node)
@@ -286,16 +327,27 @@ func MakeFull(log logging.Logger, rootDir string, cfg config.Local, phonebookAdd
 		log.Errorf("Cannot load crash data: %v", err)
 		return nil, err
 	}
+	node.crashAccess = crashAccess
 
 	blockValidator := blockValidatorImpl{l: node.ledger, verificationPool: node.highPriorityCryptoVerificationPool}
 	agreementLedger := makeAgreementLedger(node.ledger, node.net)
 	var agreementClock timers.Clock[agreement.TimeoutType]
-	if node.devMode {
+	if clock != nil {
+		agreementClock = clock
+	} else if node.devMode {
 		agreementClock = timers.MakeFrozenClock[agreement.TimeoutType]()
 	} else {
 		agreementClock = timers.MakeMonotonicClock[agreement.TimeoutType](time.Now())
 	}
 
+	if cfg.EnableDoubleSignProtection {
+		node.signingGuard, err = makeSigningGuard(cfg, node.genesisDirs.ColdGenesisDir, node.log)
+		if err != nil {
+			log.Errorf("unable to initialize double-sign protection: %v", err)
+			return nil, err
+		}
+	}
+
 	agreementParameters := agreement.Parameters{
 		Logger:         log,
 		Accessor:       crashAccess,
@@ -309,6 +361,9 @@ func MakeFull(log logging.Logger, rootDir string, cfg config.Local, phonebookAdd
 		RandomSource:   node,
 		BacklogPool:    node.highPriorityCryptoVerificationPool,
 	}
+	if node.signingGuard != nil {
+		agreementParameters.SigningGuard = node.signingGuard
+	}
 	node.agreementService, err = agreement.MakeService(agreementParameters)
 	if err != nil {
 		log.Errorf("unable to initialize agreement: %v", err)
@@ -318,6 +373,9 @@ func MakeFull(log logging.Logger, rootDir string, cfg config.Local, phonebookAdd
 	node.catchupBlockAuth = blockAuthenticatorImpl{Ledger: node.ledger, AsyncVoteVerifier: agreement.MakeAsyncVoteVerifier(node.lowPriorityCryptoVerificationPool)}
 	node.catchupService = catchup.MakeService(node.log, node.config, p2pNode, node.ledger, node.catchupBlockAuth, agreementLedger.UnmatchedPendingCertificates, node.lowPriorityCryptoVerificationPool)
 	node.txPoolSyncerService = rpcs.MakeTxSyncer(node.transactionPool, node.net, node.txHandler.SolicitedTxHandler(), time.Duration(cfg.TxSyncIntervalSeconds)*time.Second, time.Duration(cfg.TxSyncTimeoutSeconds)*time.Second, cfg.TxSyncServeResponseSize)
+	if cfg.EnableTxReconciliation {
+		node.txReconciler = rpcs.MakeTxReconciler(node.transactionPool, node.net, cfg.TxReconciliationInterval, node.log)
+	}
 
 	catchpointCatchupState, err := node.ledger.GetCatchpointCatchupState(context.Background())
 	if err != nil {
@@ -336,14 +394,43 @@ func MakeFull(log logging.Logger, rootDir string, cfg config.Local, phonebookAdd
 
 	node.tracer = messagetracer.NewTracer(log).Init(cfg)
 	gossip.SetTrace(agreementParameters.Network, node.tracer)
+	if cfg.EnableP2PConsensusTopics {
+		node.gossipVoteVerifier = agreement.MakeAsyncVoteVerifier(node.highPriorityCryptoVerificationPool)
+		gossip.SetValidator(agreementParameters.Network, agreement.MakeGossipValidator(agreementLedger, node.gossipVoteVerifier))
+	}
 
 	node.stateProofWorker = stateproof.NewWorker(node.genesisDirs.StateproofGenesisDir, node.log, node.accountManager, node.ledger.Ledger, node.net, node)
 
 	node.heartbeatService = heartbeat.NewService(node.accountManager, node.ledger, node, node.log)
 
+	if cfg.EnableParticipationKeyManager {
+		node.partKeyManager, err = makePartKeyManager(cfg, node.accountManager.Registry(), node.ledger, node, node.log)
+		if err != nil {
+			log.Errorf("unable to create participation key manager: %v", err)
+			return nil, err
+		}
+	}
+
 	return node, err
 }
 
+// makeNetworkCapture creates the rotating capture file of the gossip messages, following the
+// log archive settings of the node.
+func makeNetworkCapture(cfg config.Local, rootDir string, log logging.Logger) *messagetracer.Capture {
+	liveCapture, archive := cfg.ResolveNetworkCapturePaths(rootDir)
+	var maxAge time.Duration
+	if cfg.LogArchiveMaxAge != "" {
+		var err error
+		maxAge, err = time.ParseDuration(cfg.LogArchiveMaxAge)
+		if err != nil {
+			log.Warnf("invalid config LogArchiveMaxAge, network capture archives are kept: %v", err)
+			maxAge = 0
+		}
+	}
+	log.Infof("Capturing network traffic to %s", liveCapture)
+	return messagetracer.MakeCapture(logging.MakeCyclicFileWriter(liveCapture, archive, cfg.NetworkCaptureSizeLimit, maxAge), log)
+}
+
 // Config returns a copy of the node's Local configuration
 func (node *AlgorandFullNode) Config() config.Local {
 	return node.config
@@ -379,11 +466,17 @@ func (node *AlgorandFullNode) Start() error {
 		node.catchupService.Start()
 		node.agreementService.Start()
 		node.txPoolSyncerService.Start(node.catchupService.InitialSyncDone)
+		if node.txReconciler != nil {
+			node.txReconciler.Start(node.catchupService.InitialSyncDone)
+		}
 		node.blockService.Start()
 		node.ledgerService.Start()
 		node.txHandler.Start()
 		node.stateProofWorker.Start()
 		node.heartbeatService.Start()
+		if node.partKeyManager != nil {
+			node.partKeyManager.Start()
+		}
 		err := startNetwork()
 		if err != nil {
 			return err
@@ -453,6 +546,9 @@ func (node *AlgorandFullNode) Stop() {
 		for h := range node.partHandles {
 			node.partHandles[h].Close()
 		}
+		if node.signingGuard != nil {
+			node.signingGuard.Close()
+		}
 	}()
 
 	node.net.ClearHandlers()
@@ -463,6 +559,9 @@ func (node *AlgorandFullNode) Stop() {
 	if node.catchpointCatchupService != nil {
 		node.catchpointCatchupService.Stop()
 	} else {
+		if node.partKeyManager != nil {
+			node.partKeyManager.Stop()
+		}
 		node.heartbeatService.Stop()
 		node.stateProofWorker.Stop()
 		node.txHandler.Stop()
@@ -470,10 +569,16 @@ func (node *AlgorandFullNode) Stop() {
 		node.agreementService.Accessor.Close()
 		node.catchupService.Stop()
 		node.txPoolSyncerService.Stop()
+		if node.txReconciler != nil {
+			node.txReconciler.Stop()
+		}
 		node.blockService.Stop()
 		node.ledgerService.Stop()
 	}
 	node.catchupBlockAuth.Quit()
+	if node.gossipVoteVerifier != nil {
+		node.gossipVoteVerifier.Quit()
+	}
 	node.log.Debug("crypto worker pools are stopping")
 	node.highPriorityCryptoVerificationPool.Shutdown()
 	node.lowPriorityCryptoVerificationPool.Shutdown()
@@ -1229,12 +1334,18 @@ func (node *AlgorandFullNode) SetCatchpointCatchupMode(catchpointCatchupMode boo
 			}()
 			node.net.ClearHandlers()
 			node.net.ClearValidatorHandlers()
+			if node.partKeyManager != nil {
+				node.partKeyManager.Stop()
+			}
 			node.heartbeatService.Stop()
 			node.stateProofWorker.Stop()
 			node.txHandler.Stop()
 			node.agreementService.Shutdown()
 			node.catchupService.Stop()
 			node.txPoolSyncerService.Stop()
+			if node.txReconciler != nil {
+				node.txReconciler.Stop()
+			}
 			node.blockService.Stop()
 			node.ledgerService.Stop()
 
@@ -1254,11 +1365,17 @@ func (node *AlgorandFullNode) SetCatchpointCatchupMode(catchpointCatchupMode boo
 		node.catchupService.Start()
 		node.agreementService.Start()
 		node.txPoolSyncerService.Start(node.catchupService.InitialSyncDone)
+		if node.txReconciler != nil {
+			node.txReconciler.Start(node.catchupService.InitialSyncDone)
+		}
 		node.blockService.Start()
 		node.ledgerService.Start()
 		node.txHandler.Start()
 		node.stateProofWorker.Start()
 		node.heartbeatService.Start()
+		if node.partKeyManager != nil {
+			node.partKeyManager.Start()
+		}
 
 		// Set up a context we can use to cancel goroutines on Stop()
 		node.ctx, node.cancelCtx = context.WithCancel(context.Background())
@@ -1425,6 +1542,20 @@ func (node *AlgorandFullNode) VotingKeys(votingRound, keysRound basics.Round) []
 	return participations
 }
 
+// makeSigningGuard opens the slashing protection database of the node, shared through the
+// DoubleSignProtectionLockService if set.
+func makeSigningGuard(cfg config.Local, dir string, log logging.Logger) (*signguard.Guard, error) {
+	var lock signguard.LockService
+	if cfg.DoubleSignProtectionLockService != "" {
+		var err error
+		lock, err = signguard.MakeLockService(cfg.DoubleSignProtectionLockService)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return signguard.MakeGuard(filepath.Join(dir, config.SigningGuardFilename), lock, log)
+}
+
 // Record forwards participation record calls to the participation registry.
 func (node *AlgorandFullNode) Record(account basics.Address, round basics.Round, participationType account.ParticipationAction) {
 	node.accountManager.Record(account, round, participationType)
@@ -1461,6 +1592,31 @@ func (node *AlgorandFullNode) GetSyncRound() uint64 {
 func (node *AlgorandFullNode) UnsetSyncRound() {
 }
 
+// ExportLedgerSnapshot writes a consistent snapshot of the ledger and crash databases into w
+func (node *AlgorandFullNode) ExportLedgerSnapshot(ctx context.Context, w io.Writer) error {
+	return exportLedgerSnapshot(ctx, w, node.ledger, &node.crashAccess, node.genesisDirs, node.genesisID, node.genesisHash)
+}
+
+// PeerAdmin returns the peer management of the node's network, or nil if the network does not support it.
+func (node *AlgorandFullNode) PeerAdmin() network.PeerAdmin {
+	admin, _ := node.net.(network.PeerAdmin)
+	return admin
+}
+
+// RollbackLedger rewinds the ledger to the given round, and resets the transaction pool on top of it.
+// This is only available in dev mode.
+func (node *AlgorandFullNode) RollbackLedger(rnd basics.Round) error {
+	if !node.devMode {
+		return fmt.Errorf("cannot roll back the ledger when not in dev mode")
+	}
+	// no dev mode block can be written while holding the lock
+	node.mu.Lock()
+	defer node.mu.Unlock()
+	err := node.ledger.Rollback(rnd)
+	node.transactionPool.Reset()
+	return err
+}
+
 // SetBlockTimeStampOffset sets a timestamp offset in the block header.
 // This is only available in dev mode.
 func (node *AlgorandFullNode) SetBlockTimeStampOffset(offset int64) error {
@@ -41,12 +41,15 @@ import (
 	"github.com/algorand/go-algorand/data/transactions"
 	"github.com/algorand/go-algorand/logging"
 	"github.com/algorand/go-algorand/network"
+	"github.com/algorand/go-algorand/network/messagetracer"
 	"github.com/algorand/go-algorand/network/p2p"
 	"github.com/algorand/go-algorand/protocol"
+	"github.com/algorand/go-algorand/rpcs"
 	"github.com/algorand/go-algorand/stateproof"
 	"github.com/algorand/go-algorand/test/partitiontest"
 	"github.com/algorand/go-algorand/util"
 	"github.com/algorand/go-algorand/util/db"
+	"github.com/algorand/go-algorand/util/timers"
 )
 
 var expectedAgreementTime = 2*config.Protocol.BigLambda + config.Protocol.SmallLambda + config.Consensus[protocol.ConsensusCurrentVersion].AgreementFilterTimeout + 2*time.Second
@@ -86,6 +89,7 @@ func (ni nodeInfo) p2pMultiAddr() string {
 
 type configHook func(ni nodeInfo, cfg config.Local) (nodeInfo, config.Local)
 type phonebookHook func([]nodeInfo, int) []string
+type makeNodeHook func(ni nodeInfo, log logging.Logger, cfg config.Local, phonebook []string, genesis bookkeeping.Genesis) (*AlgorandFullNode, error)
 
 func setupFullNodes(t *testing.T, proto protocol.ConsensusVersion, customConsensus config.ConsensusProtocols) ([]*AlgorandFullNode, []string) {
 	minMoneyAtStart := 10000
@@ -122,6 +126,16 @@ func setupFullNodesEx(
 	t *testing.T, proto protocol.ConsensusVersion, customConsensus config.ConsensusProtocols,
 	acctStake []basics.MicroAlgos, configHook configHook, phonebookHook phonebookHook,
 ) ([]*AlgorandFullNode, []string) {
+	makeNode := func(ni nodeInfo, log logging.Logger, cfg config.Local, phonebook []string, genesis bookkeeping.Genesis) (*AlgorandFullNode, error) {
+		return MakeFull(log, ni.rootDir, cfg, phonebook, genesis)
+	}
+	return setupFullNodesWithHook(t, proto, customConsensus, acctStake, configHook, phonebookHook, makeNode)
+}
+
+func setupFullNodesWithHook(
+	t *testing.T, proto protocol.ConsensusVersion, customConsensus config.ConsensusProtocols,
+	acctStake []basics.MicroAlgos, configHook configHook, phonebookHook phonebookHook, makeNode makeNodeHook,
+) ([]*AlgorandFullNode, []string) {
 
 	util.SetFdSoftLimit(1000)
 
@@ -241,7 +255,7 @@ func setupFullNodesEx(
 		cfg, err := config.LoadConfigFromDisk(rootDirectory)
 		phonebook := phonebookHook(nodeInfos, i)
 		require.NoError(t, err)
-		node, err := MakeFull(logging.Base().With("net", fmt.Sprintf("node%d", i)), rootDirectory, cfg, phonebook, g)
+		node, err := makeNode(nodeInfos[i], logging.Base().With("net", fmt.Sprintf("node%d", i)), cfg, phonebook, g)
 		nodes[i] = node
 		require.NoError(t, err)
 	}
@@ -804,6 +818,8 @@ func TestMaxSizesCorrect(t *testing.T) {
 	require.Equal(t, spSize, protocol.StateProofSigTag.MaxMessageSize())
 	msSize := uint64(crypto.DigestMaxSize())
 	require.Equal(t, msSize, protocol.MsgDigestSkipTag.MaxMessageSize())
+	trSize := uint64(rpcs.TxReconciliationMessageMaxSize())
+	require.Equal(t, trSize, protocol.TxnReconciliationTag.MaxMessageSize())
 
 	// We want to check that the TxnTag's max size is big enough, but it is
 	// foolish to try to be exact here.  We will confirm that it is bigger that
@@ -1262,3 +1278,105 @@ func TestNodeHybridP2PGossipSend(t *testing.T) {
 		require.Fail(t, fmt.Sprintf("no block notification for wallet: %v.", wallets[0]))
 	}
 }
+
+// TestSimulatedFullNodes runs full nodes over the network simulator, with the agreement
+// timeouts driven by the virtual clock of the simulation. The nodes otherwise run in real
+// time, so the test only checks that they agree on the blocks, not that a run is reproducible.
+func TestSimulatedFullNodes(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	if testing.Short() {
+		t.Skip()
+	}
+
+	sim := network.MakeSimulator(network.SimulatorConfig{
+		Seed: 1,
+		Link: network.LinkConfig{Latency: 20 * time.Millisecond, Jitter: 10 * time.Millisecond},
+	})
+
+	const numAccounts = 6
+	acctStake := make([]basics.MicroAlgos, numAccounts)
+	for i := range acctStake {
+		acctStake[i] = basics.MicroAlgos{Raw: 100000}
+	}
+	configHook := func(ni nodeInfo, cfg config.Local) (nodeInfo, config.Local) {
+		// all the nodes are relays
+		cfg.NetAddress = fmt.Sprintf("node%d", ni.idx)
+		cfg.TxSyncIntervalSeconds = 60
+		return ni, cfg
+	}
+	phonebookHook := func(nodes []nodeInfo, nodeIdx int) []string { return nil }
+	makeNode := func(ni nodeInfo, log logging.Logger, cfg config.Local, phonebook []string, genesis bookkeeping.Genesis) (*AlgorandFullNode, error) {
+		net, err := sim.MakeNetwork(cfg.NetAddress, cfg, genesis.ID(), log)
+		if err != nil {
+			return nil, err
+		}
+		return MakeFullWithNetwork(log, ni.rootDir, cfg, net, timers.MakeVirtualClock[agreement.TimeoutType](sim.Time()), genesis)
+	}
+	nodes, wallets := setupFullNodesWithHook(t, protocol.ConsensusCurrentVersion, nil, acctStake, configHook, phonebookHook, makeNode)
+	for i := range nodes {
+		defer os.Remove(wallets[i])
+		defer nodes[i].Stop()
+		sim.Connect(fmt.Sprintf("node%d", i), fmt.Sprintf("node%d", (i+1)%numAccounts))
+		sim.Connect(fmt.Sprintf("node%d", i), fmt.Sprintf("node%d", (i+2)%numAccounts))
+	}
+	for _, node := range nodes {
+		node.Start()
+	}
+
+	// node 5 is isolated for a while, and catches up once the partition heals
+	sim.Partition([]string{"node5"})
+	const targetRound = basics.Round(4)
+	deadline := time.Now().Add(2 * time.Minute)
+	healed := false
+	for {
+		done := true
+		for _, node := range nodes[:numAccounts-1] {
+			done = done && node.ledger.LastRound() >= targetRound
+		}
+		if done && !healed {
+			require.Less(t, nodes[numAccounts-1].ledger.LastRound(), targetRound)
+			sim.Heal()
+			healed = true
+		}
+		if healed && nodes[numAccounts-1].ledger.LastRound() >= targetRound {
+			break
+		}
+		require.True(t, time.Now().Before(deadline), "nodes did not reach round %d", targetRound)
+		// give the nodes some real time to process the delivered messages
+		sim.Advance(10 * time.Millisecond)
+		time.Sleep(time.Millisecond)
+	}
+
+	for r := basics.Round(1); r <= targetRound; r++ {
+		hdr, err := nodes[0].ledger.BlockHdr(r)
+		require.NoError(t, err)
+		for _, node := range nodes[1:] {
+			other, err := node.ledger.BlockHdr(r)
+			require.NoError(t, err)
+			require.Equal(t, hdr.Hash(), other.Hash())
+		}
+	}
+	delivered, _ := sim.Stats()
+	require.NotZero(t, delivered)
+}
+
+func TestMakeNetworkCapture(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	rootDir := t.TempDir()
+	cfg := config.GetDefaultLocal()
+	cfg.EnableNetworkCapture = true
+	cfg.LogArchiveMaxAge = "not a duration"
+	c := makeNetworkCapture(cfg, rootDir, logging.TestingLog(t))
+	c.Record(messagetracer.Incoming, protocol.TxnTag, "peer", []byte("txn"))
+
+	liveCapture, _ := cfg.ResolveNetworkCapturePaths(rootDir)
+	f, err := os.Open(liveCapture)
+	require.NoError(t, err)
+	defer f.Close()
+	rec, err := messagetracer.MakeCaptureReader(f).Next()
+	require.NoError(t, err)
+	require.Equal(t, protocol.TxnTag, rec.Tag)
+	require.Equal(t, "txn", string(rec.Data))
+}
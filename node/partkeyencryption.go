@@ -0,0 +1,75 @@
+// Copyright (C) 2019-2025 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package node
+
+import (
+	"errors"
+
+	"github.com/algorand/go-algorand/data/account"
+)
+
+// errParticipationKeysNotEncrypted is returned when unlocking the participation keys of a node
+// without ParticipationKeysEncryption.
+var errParticipationKeysNotEncrypted = errors.New("participation key encryption is not enabled")
+
+// lockParticipationKeys locks the participation registry at startup, so that the node does not
+// participate until it is unlocked. The registry is unlocked right away if the key material can
+// be read from a file or the key management service, and encrypted with it if it is not yet:
+// unlike a passphrase, that key material is not mistyped.
+func (node *AlgorandFullNode) lockParticipationKeys() error {
+	err := account.ValidateKeySource(node.config.ParticipationKeysEncryption)
+	if err != nil {
+		return err
+	}
+	registry := node.accountManager.Registry()
+	err = registry.Lock()
+	if err != nil {
+		return err
+	}
+
+	err = node.UnlockParticipationKeys(nil, true)
+	if errors.Is(err, account.ErrPassphraseRequired) {
+		node.log.Infof("participation keys are locked until unlocked with a passphrase")
+	} else if err != nil {
+		node.log.Errorf("participation keys are locked, unable to unlock them: %v", err)
+	} else {
+		node.log.Infof("participation keys unlocked")
+	}
+	return nil
+}
+
+// UnlockParticipationKeys unlocks the participation registry with the passphrase, or with the key
+// material read from the source of ParticipationKeysEncryption if passphrase is empty. The
+// secrets of the registry are only encrypted if they are not yet when initialize is set, so that
+// the registry is not encrypted for good with a mistyped passphrase.
+func (node *AlgorandFullNode) UnlockParticipationKeys(passphrase []byte, initialize bool) error {
+	if node.config.ParticipationKeysEncryption == "" {
+		return errParticipationKeysNotEncrypted
+	}
+	material := passphrase
+	if len(material) == 0 {
+		var err error
+		material, err = account.ReadKeyMaterial(node.config.ParticipationKeysEncryption)
+		if err != nil {
+			return err
+		}
+	}
+	if initialize {
+		return node.accountManager.Registry().Initialize(material)
+	}
+	return node.accountManager.Registry().Unlock(material)
+}
@@ -0,0 +1,410 @@
+// Copyright (C) 2019-2025 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package node
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/algorand/go-algorand/config"
+	"github.com/algorand/go-algorand/crypto"
+	"github.com/algorand/go-algorand/daemon/kmd/client"
+	"github.com/algorand/go-algorand/daemon/kmd/server"
+	"github.com/algorand/go-algorand/daemon/kmd/wallet/driver"
+	"github.com/algorand/go-algorand/data/account"
+	"github.com/algorand/go-algorand/data/basics"
+	"github.com/algorand/go-algorand/data/bookkeeping"
+	"github.com/algorand/go-algorand/data/transactions"
+	"github.com/algorand/go-algorand/ledger/ledgercore"
+	"github.com/algorand/go-algorand/libgoal/participation"
+	"github.com/algorand/go-algorand/logging"
+	"github.com/algorand/go-algorand/protocol"
+	"github.com/algorand/go-algorand/util"
+)
+
+const (
+	// partKeyManagerTxnLifetime is the number of rounds the key registration transactions
+	// of the manager are valid for. The manager does not try again before they expire.
+	partKeyManagerTxnLifetime = 100
+	// partKeyManagerSignerTimeout bounds a request to the signer
+	partKeyManagerSignerTimeout = 30 * time.Second
+	// partKeyManagerMaxResponseSize bounds the response of a remote signer
+	partKeyManagerMaxResponseSize = 1 << 20
+
+	kmdSignerPrefix    = "kmd:"
+	remoteSignerPrefix = "unix:"
+)
+
+var errPartKeyManagerNoSigner = errors.New("ParticipationKeyManagerSigner must be kmd:<kmd data dir> or unix:<socket path>")
+var errPartKeyManagerNoToken = errors.New("ParticipationKeyManagerSignerTokenFile must be set for a kmd signer")
+var errPartKeyManagerRounds = errors.New("ParticipationKeyValidityRounds must be greater than ParticipationKeyRenewalRounds")
+
+// keyregSigner signs the key registration transactions of the participation key manager
+type keyregSigner interface {
+	// SignTransaction signs tx with the key of signer, the auth address of its sender
+	SignTransaction(tx transactions.Transaction, signer basics.Address) (transactions.SignedTxn, error)
+}
+
+// partKeyManagerLedger is the part of the ledger the participation key manager observes
+type partKeyManagerLedger interface {
+	LastRound() basics.Round
+	WaitMem(r basics.Round) chan struct{}
+	BlockHdr(r basics.Round) (bookkeeping.BlockHeader, error)
+	LookupAccount(round basics.Round, addr basics.Address) (data ledgercore.AccountData, validThrough basics.Round, withoutRewards basics.MicroAlgos, err error)
+}
+
+// partKeyManagerNode is the part of the node the participation key manager installs keys and
+// broadcasts transactions with
+type partKeyManagerNode interface {
+	InstallParticipationKey(partKeyBinary []byte) (account.ParticipationID, error)
+	BroadcastInternalSignedTxGroup([]transactions.SignedTxn) error
+	SuggestedFee() basics.MicroAlgos
+}
+
+// partKeyManager keeps the accounts online with the participation keys of the node registered:
+// ParticipationKeyRenewalRounds before the registered key of an account expires, it generates
+// and installs its next key, and registers it online once valid with a key registration
+// transaction signed by the configured signer.
+type partKeyManager struct {
+	registry account.ParticipationRegistry
+	ledger   partKeyManagerLedger
+	node     partKeyManagerNode
+	signer   keyregSigner
+
+	renewalRounds  basics.Round
+	validityRounds basics.Round
+
+	// genMu protects generating, and serializes installing a generated key with Stop so
+	// that no key is installed once the manager stopped
+	genMu sync.Mutex
+	// generating holds the accounts whose key is being generated, or failed to, with the
+	// round until which no other key is generated for them
+	generating map[basics.Address]basics.Round
+
+	// infrastructure
+	ctx      context.Context
+	shutdown context.CancelFunc
+	wg       sync.WaitGroup
+	log      logging.Logger
+}
+
+// makePartKeyManager creates the participation key manager from the node config
+func makePartKeyManager(cfg config.Local, registry account.ParticipationRegistry, ledger partKeyManagerLedger, node partKeyManagerNode, log logging.Logger) (*partKeyManager, error) {
+	if cfg.ParticipationKeyValidityRounds <= cfg.ParticipationKeyRenewalRounds {
+		return nil, errPartKeyManagerRounds
+	}
+	signer, err := makeKeyregSigner(cfg)
+	if err != nil {
+		return nil, err
+	}
+	return &partKeyManager{
+		registry:       registry,
+		ledger:         ledger,
+		node:           node,
+		signer:         signer,
+		renewalRounds:  basics.Round(cfg.ParticipationKeyRenewalRounds),
+		validityRounds: basics.Round(cfg.ParticipationKeyValidityRounds),
+		generating:     make(map[basics.Address]basics.Round),
+		log:            log.With("Context", "partkeymanager"),
+	}, nil
+}
+
+// makeKeyregSigner creates the signer configured by ParticipationKeyManagerSigner
+func makeKeyregSigner(cfg config.Local) (keyregSigner, error) {
+	switch {
+	case strings.HasPrefix(cfg.ParticipationKeyManagerSigner, kmdSignerPrefix):
+		dir := strings.TrimPrefix(cfg.ParticipationKeyManagerSigner, kmdSignerPrefix)
+		if !filepath.IsAbs(dir) {
+			return nil, errPartKeyManagerNoSigner
+		}
+		if cfg.ParticipationKeyManagerSignerTokenFile == "" {
+			return nil, errPartKeyManagerNoToken
+		}
+		return &kmdKeyregSigner{dataDir: dir, tokenFile: cfg.ParticipationKeyManagerSignerTokenFile}, nil
+	case strings.HasPrefix(cfg.ParticipationKeyManagerSigner, remoteSignerPrefix):
+		socket := strings.TrimPrefix(cfg.ParticipationKeyManagerSigner, remoteSignerPrefix)
+		if !filepath.IsAbs(socket) {
+			return nil, errPartKeyManagerNoSigner
+		}
+		return &remoteKeyregSigner{socket: socket}, nil
+	default:
+		return nil, errPartKeyManagerNoSigner
+	}
+}
+
+// Start starts the goroutine of the manager.
+func (m *partKeyManager) Start() {
+	m.ctx, m.shutdown = context.WithCancel(context.Background())
+	m.wg.Add(1)
+	m.log.Info("starting participation key manager")
+	go m.loop()
+}
+
+// Stop stops the goroutine of the manager. It does not wait for the keys being generated,
+// which are discarded once generated.
+func (m *partKeyManager) Stop() {
+	m.log.Debug("participation key manager is stopping")
+	defer m.log.Debug("participation key manager has stopped")
+	m.shutdown()
+	m.wg.Wait()
+	// wait for a key being installed, the next ones see the manager stopped
+	m.genMu.Lock()
+	defer m.genMu.Unlock()
+}
+
+func (m *partKeyManager) loop() {
+	defer m.wg.Done()
+	// suppress holds the accounts to leave alone until a round, after a key registration was
+	// sent or an action failed
+	suppress := make(map[basics.Address]basics.Round)
+	latest := m.ledger.LastRound()
+	for {
+		select {
+		case <-m.ctx.Done():
+			return
+		case <-m.ledger.WaitMem(latest + 1):
+		}
+
+		latest = m.ledger.LastRound()
+		if m.registry.Locked() {
+			// no key can be installed until the registry is unlocked
+			continue
+		}
+		hdr, err := m.ledger.BlockHdr(latest)
+		if err != nil {
+			m.log.Errorf("participation key manager could not fetch block header for round %d: %v", latest, err)
+			continue
+		}
+
+		records := make(map[basics.Address][]account.ParticipationRecord)
+		for _, record := range m.registry.GetAll() {
+			records[record.Account] = append(records[record.Account], record)
+		}
+		for addr, recs := range records {
+			if m.ctx.Err() != nil {
+				return
+			}
+			if suppress[addr] > latest {
+				continue
+			}
+			delete(suppress, addr)
+			until, err := m.renew(addr, recs, hdr)
+			if err != nil {
+				m.log.Warnf("participation key manager could not renew the key of %v: %v", addr, err)
+				until = latest + partKeyManagerTxnLifetime
+			}
+			if until > latest {
+				suppress[addr] = until
+			}
+		}
+	}
+}
+
+// renew runs the next step of renewing the participation key of addr. It returns the round
+// until which addr is left alone, if the step takes effect later.
+func (m *partKeyManager) renew(addr basics.Address, records []account.ParticipationRecord, hdr bookkeeping.BlockHeader) (basics.Round, error) {
+	latest := hdr.Round
+	data, _, _, err := m.ledger.LookupAccount(latest, addr)
+	if err != nil {
+		return 0, err
+	}
+	if data.Status != basics.Online {
+		return 0, nil
+	}
+
+	action, next := account.PlanKeyRenewal(records, data.VoteID, data.VoteLastValid, latest, m.renewalRounds)
+	switch action {
+	case account.KeyRenewalGenerate:
+		m.generate(addr, latest, latest+m.validityRounds)
+		return 0, nil
+	case account.KeyRenewalRegister:
+		proto := config.Consensus[hdr.CurrentProtocol]
+		tx := next.GenerateRegistrationTransaction(basics.MicroAlgos{}, latest, latest+partKeyManagerTxnLifetime, proto.EnableStateProofKeyregCheck)
+		tx.GenesisHash = hdr.GenesisHash
+		if proto.SupportGenesisHash {
+			tx.GenesisID = hdr.GenesisID
+		}
+		tx.Fee = basics.MulAIntSaturate(m.node.SuggestedFee(), tx.EstimateEncodedSize())
+		if tx.Fee.Raw < proto.MinTxnFee {
+			tx.Fee.Raw = proto.MinTxnFee
+		}
+
+		signer := addr
+		if !data.AuthAddr.IsZero() {
+			signer = data.AuthAddr
+		}
+		stx, err := m.signer.SignTransaction(tx, signer)
+		if err != nil {
+			return 0, err
+		}
+		m.log.Infof("registering participation key %s of %v, valid from %d to %d", next.ParticipationID, addr, next.FirstValid, next.LastValid)
+		err = m.node.BroadcastInternalSignedTxGroup([]transactions.SignedTxn{stx})
+		if err != nil {
+			return 0, err
+		}
+		// Once the transaction is confirmed there is nothing left to do for
+		// addr; don't register again before it expires.
+		return tx.LastValid, nil
+	}
+	return 0, nil
+}
+
+// generate starts generating and installing a participation key of addr valid from first
+// to last, unless one is being generated already. Generating a key takes long, so it runs in
+// its own goroutine that Stop does not wait for: the key is not installed if the manager
+// stopped meanwhile.
+func (m *partKeyManager) generate(addr basics.Address, first, last basics.Round) {
+	m.genMu.Lock()
+	defer m.genMu.Unlock()
+	if m.generating[addr] > first {
+		return
+	}
+	m.generating[addr] = last
+	m.log.Infof("generating the next participation key of %v, valid from %d to %d", addr, first, last)
+
+	ctx := m.ctx
+	installFunc := func(path string) error {
+		m.genMu.Lock()
+		defer m.genMu.Unlock()
+		if ctx.Err() != nil {
+			return ctx.Err()
+		}
+		partKeyBinary, err := os.ReadFile(path)
+		if err != nil {
+			return err
+		}
+		partID, err := m.node.InstallParticipationKey(partKeyBinary)
+		if err != nil {
+			return err
+		}
+		m.log.Infof("installed participation key %s of %v", partID, addr)
+		return nil
+	}
+	go func() {
+		_, _, err := participation.GenParticipationKeysTo(addr.String(), uint64(first), uint64(last), 0, "", installFunc)
+		m.genMu.Lock()
+		defer m.genMu.Unlock()
+		switch {
+		case err == nil:
+			delete(m.generating, addr)
+		case ctx.Err() != nil:
+			m.log.Infof("discarded the participation key of %v generated while stopping", addr)
+			delete(m.generating, addr)
+		default:
+			m.log.Warnf("participation key manager could not generate the key of %v: %v", addr, err)
+			m.generating[addr] = first + partKeyManagerTxnLifetime
+		}
+	}()
+}
+
+// kmdKeyregSigner signs with kmd, using a token scoped to a wallet holding the signing keys
+// (see goal wallet token create). The address of kmd is read before each request so that
+// kmd may be restarted.
+type kmdKeyregSigner struct {
+	dataDir   string
+	tokenFile string
+}
+
+func (s *kmdKeyregSigner) SignTransaction(tx transactions.Transaction, signer basics.Address) (stx transactions.SignedTxn, err error) {
+	token, err := util.GetFirstLineFromFile(s.tokenFile)
+	if err != nil {
+		return
+	}
+	addr, err := util.GetFirstLineFromFile(filepath.Join(s.dataDir, server.NetFilename))
+	if err != nil {
+		return
+	}
+	kmd, err := client.MakeKMDClient(addr, token)
+	if err != nil {
+		return
+	}
+	// The wallet of a scoped token is the wallet the token was created for
+	resp, err := kmd.SignTransaction(nil, nil, crypto.PublicKey(signer), tx)
+	if err != nil {
+		return
+	}
+	err = protocol.Decode(resp.SignedTransaction, &stx)
+	return
+}
+
+// remoteKeyregSigner signs with a signing service speaking the remote signer protocol of kmd
+// (see driver.RemoteSignerRequest) on a Unix socket.
+type remoteKeyregSigner struct {
+	socket string
+}
+
+func (s *remoteKeyregSigner) SignTransaction(tx transactions.Transaction, signer basics.Address) (stx transactions.SignedTxn, err error) {
+	req := driver.RemoteSignerRequest{
+		Method: driver.RemoteSignerMethodSign,
+		Key:    signer[:],
+		Data:   crypto.HashRep(tx),
+	}
+	conn, err := net.DialTimeout("unix", s.socket, partKeyManagerSignerTimeout)
+	if err != nil {
+		return
+	}
+	defer conn.Close()
+	err = conn.SetDeadline(time.Now().Add(partKeyManagerSignerTimeout))
+	if err != nil {
+		return
+	}
+
+	enc, err := json.Marshal(req)
+	if err != nil {
+		return
+	}
+	_, err = conn.Write(append(enc, '\n'))
+	if err != nil {
+		return
+	}
+	line, err := bufio.NewReader(io.LimitReader(conn, partKeyManagerMaxResponseSize)).ReadBytes('\n')
+	if err != nil {
+		return
+	}
+	var resp driver.RemoteSignerResponse
+	err = json.Unmarshal(line, &resp)
+	if err != nil {
+		return
+	}
+	if resp.Error != "" {
+		return stx, fmt.Errorf("remote signer: %s", resp.Error)
+	}
+
+	var sig crypto.Signature
+	if len(resp.Signature) != len(sig) {
+		return stx, fmt.Errorf("remote signer: invalid signature length %d", len(resp.Signature))
+	}
+	copy(sig[:], resp.Signature)
+	if !crypto.SignatureVerifier(signer).Verify(tx, sig) {
+		return stx, fmt.Errorf("remote signer: invalid signature by %v", signer)
+	}
+	stx = transactions.SignedTxn{Txn: tx, Sig: sig}
+	if signer != tx.Sender {
+		stx.AuthAddr = signer
+	}
+	return stx, nil
+}
@@ -0,0 +1,158 @@
+// Copyright (C) 2019-2025 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package node
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"net"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/algorand/go-algorand/config"
+	"github.com/algorand/go-algorand/crypto"
+	"github.com/algorand/go-algorand/daemon/kmd/wallet/driver"
+	"github.com/algorand/go-algorand/data/account"
+	"github.com/algorand/go-algorand/data/basics"
+	"github.com/algorand/go-algorand/data/transactions"
+	"github.com/algorand/go-algorand/logging"
+	"github.com/algorand/go-algorand/protocol"
+	"github.com/algorand/go-algorand/test/partitiontest"
+)
+
+func TestMakePartKeyManagerConfig(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	cfg := config.GetDefaultLocal()
+	cfg.EnableParticipationKeyManager = true
+	_, err := makePartKeyManager(cfg, nil, nil, nil, logging.TestingLog(t))
+	require.ErrorIs(t, err, errPartKeyManagerNoSigner)
+
+	cfg.ParticipationKeyManagerSigner = "unix:relative.sock"
+	_, err = makePartKeyManager(cfg, nil, nil, nil, logging.TestingLog(t))
+	require.ErrorIs(t, err, errPartKeyManagerNoSigner)
+
+	cfg.ParticipationKeyManagerSigner = "kmd:/var/lib/kmd"
+	_, err = makePartKeyManager(cfg, nil, nil, nil, logging.TestingLog(t))
+	require.ErrorIs(t, err, errPartKeyManagerNoToken)
+
+	cfg.ParticipationKeyManagerSignerTokenFile = "/var/lib/kmd/partkey.token"
+	m, err := makePartKeyManager(cfg, nil, nil, nil, logging.TestingLog(t))
+	require.NoError(t, err)
+	require.IsType(t, &kmdKeyregSigner{}, m.signer)
+
+	cfg.ParticipationKeyManagerSigner = "unix:/run/signer.sock"
+	m, err = makePartKeyManager(cfg, nil, nil, nil, logging.TestingLog(t))
+	require.NoError(t, err)
+	require.IsType(t, &remoteKeyregSigner{}, m.signer)
+
+	cfg.ParticipationKeyRenewalRounds = cfg.ParticipationKeyValidityRounds
+	_, err = makePartKeyManager(cfg, nil, nil, nil, logging.TestingLog(t))
+	require.ErrorIs(t, err, errPartKeyManagerRounds)
+}
+
+func TestRemoteKeyregSigner(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	var seed crypto.Seed
+	crypto.RandBytes(seed[:])
+	secrets := crypto.GenerateSignatureSecrets(seed)
+	signer := basics.Address(secrets.SignatureVerifier)
+
+	socket := filepath.Join(t.TempDir(), "signer.sock")
+	l, err := net.Listen("unix", socket)
+	require.NoError(t, err)
+	defer l.Close()
+	go func() {
+		for {
+			conn, err := l.Accept()
+			if err != nil {
+				return
+			}
+			line, _ := bufio.NewReader(conn).ReadBytes('\n')
+			var req driver.RemoteSignerRequest
+			var resp driver.RemoteSignerResponse
+			if json.Unmarshal(line, &req) != nil || req.Method != driver.RemoteSignerMethodSign || string(req.Key) != string(signer[:]) {
+				resp.Error = "unknown key"
+			} else {
+				sig := secrets.SignBytes(req.Data)
+				resp.Signature = sig[:]
+			}
+			enc, _ := json.Marshal(resp)
+			conn.Write(append(enc, '\n'))
+			conn.Close()
+		}
+	}()
+
+	s := &remoteKeyregSigner{socket: socket}
+	sender := basics.Address{0x01}
+	tx := transactions.Transaction{
+		Type:   protocol.KeyRegistrationTx,
+		Header: transactions.Header{Sender: sender, FirstValid: 1, LastValid: 100},
+	}
+	stx, err := s.SignTransaction(tx, signer)
+	require.NoError(t, err)
+	require.Equal(t, signer, stx.AuthAddr)
+	require.True(t, secrets.SignatureVerifier.Verify(tx, stx.Sig))
+
+	_, err = s.SignTransaction(tx, sender)
+	require.ErrorContains(t, err, "unknown key")
+}
+
+// testPartKeyManagerNode records the participation keys installed by the manager
+type testPartKeyManagerNode struct {
+	partKeyManagerNode
+	installed chan []byte
+}
+
+func (n *testPartKeyManagerNode) InstallParticipationKey(partKeyBinary []byte) (account.ParticipationID, error) {
+	n.installed <- partKeyBinary
+	return account.ParticipationID{}, nil
+}
+
+func TestPartKeyManagerGenerate(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	node := &testPartKeyManagerNode{installed: make(chan []byte, 1)}
+	m := &partKeyManager{node: node, generating: make(map[basics.Address]basics.Round), log: logging.TestingLog(t)}
+	m.ctx, m.shutdown = context.WithCancel(context.Background())
+	generated := func(addr basics.Address) bool {
+		m.genMu.Lock()
+		defer m.genMu.Unlock()
+		_, ok := m.generating[addr]
+		return !ok
+	}
+
+	// a key is generated once, then installed
+	addr := basics.Address{1}
+	m.generate(addr, 1, 1000)
+	m.generate(addr, 2, 1001)
+	require.NotEmpty(t, <-node.installed)
+	require.Eventually(t, func() bool { return generated(addr) }, 10*time.Second, 10*time.Millisecond)
+	require.Empty(t, node.installed)
+
+	// the key being generated when the manager stops is not installed
+	other := basics.Address{2}
+	m.generate(other, 1, 1000)
+	m.Stop()
+	require.Eventually(t, func() bool { return generated(other) }, 10*time.Second, 10*time.Millisecond)
+	require.Empty(t, node.installed)
+}
@@ -0,0 +1,61 @@
+// Copyright (C) 2019-2025 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package node
+
+import (
+	"context"
+	"io"
+	"os"
+	"path/filepath"
+
+	"github.com/algorand/go-algorand/config"
+	"github.com/algorand/go-algorand/crypto"
+	"github.com/algorand/go-algorand/data"
+	"github.com/algorand/go-algorand/ledger"
+	"github.com/algorand/go-algorand/util/db"
+)
+
+// exportLedgerSnapshot writes a snapshot archive of the ledger databases, and the crash database if one is provided, into w.
+// The database copies are staged in a temporary directory under the cold genesis directory, which is removed once the
+// archive is written.
+func exportLedgerSnapshot(ctx context.Context, w io.Writer, l *data.Ledger, crashAccess *db.Accessor, dirs config.ResolvedGenesisDirs, genesisID string, genesisHash crypto.Digest) error {
+	stagingDir, err := os.MkdirTemp(dirs.ColdGenesisDir, "snapshot-")
+	if err != nil {
+		return err
+	}
+	defer os.RemoveAll(stagingDir)
+
+	rnd, err := l.ExportSnapshot(ctx, stagingDir)
+	if err != nil {
+		return err
+	}
+	names := []string{ledger.SnapshotTrackerDBFilename, ledger.SnapshotBlockDBFilename}
+	if crashAccess != nil {
+		err = crashAccess.VacuumInto(ctx, filepath.Join(stagingDir, config.CrashFilename))
+		if err != nil {
+			return err
+		}
+		names = append(names, config.CrashFilename)
+	}
+
+	manifest := ledger.SnapshotManifest{
+		GenesisID:   genesisID,
+		GenesisHash: genesisHash,
+		Round:       rnd,
+	}
+	return ledger.WriteSnapshotArchive(w, manifest, stagingDir, names)
+}
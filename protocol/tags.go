@@ -38,6 +38,7 @@ const (
 	ProposalPayloadTag   Tag = "PP"
 	StateProofSigTag     Tag = "SP"
 	TopicMsgRespTag      Tag = "TS"
+	TxnReconciliationTag Tag = "TR"
 	TxnTag               Tag = "TX"
 	//UniCatchupReqTag   Tag = "UC" was replaced by UniEnsBlockReqTag
 	UniEnsBlockReqTag Tag = "UE"
@@ -54,7 +55,7 @@ const (
 const AgreementVoteTagMaxSize = 1228
 
 // MsgOfInterestTagMaxSize is the maximum size of a MsgOfInterestTag message
-const MsgOfInterestTagMaxSize = 45
+const MsgOfInterestTagMaxSize = 48
 
 // MsgDigestSkipTagMaxSize is the maximum size of a MsgDigestSkipTag message
 const MsgDigestSkipTagMaxSize = 69
@@ -96,6 +97,9 @@ const TopicMsgRespTagMaxSize = 6 * 1024 * 1024
 // reasoning.
 const TxnTagMaxSize = 5_000_000
 
+// TxnReconciliationTagMaxSize is the maximum size of a TxnReconciliationTag message
+const TxnReconciliationTagMaxSize = 409640
+
 // UniEnsBlockReqTagMaxSize is the maximum size of a UniEnsBlockReqTag message
 const UniEnsBlockReqTagMaxSize = 67
 
@@ -122,6 +126,8 @@ func (tag Tag) MaxMessageSize() uint64 {
 		return StateProofSigTagMaxSize
 	case TopicMsgRespTag:
 		return TopicMsgRespTagMaxSize
+	case TxnReconciliationTag:
+		return TxnReconciliationTagMaxSize
 	case TxnTag:
 		return TxnTagMaxSize
 	case UniEnsBlockReqTag:
@@ -143,6 +149,7 @@ var TagList = []Tag{
 	ProposalPayloadTag,
 	StateProofSigTag,
 	TopicMsgRespTag,
+	TxnReconciliationTag,
 	TxnTag,
 	UniEnsBlockReqTag,
 	VoteBundleTag,
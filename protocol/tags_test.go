@@ -286,6 +286,7 @@ func TestLockdownTagList(t *testing.T) {
 		ProposalPayloadTag,
 		StateProofSigTag,
 		TopicMsgRespTag,
+		TxnReconciliationTag,
 		TxnTag,
 		UniEnsBlockReqTag,
 		VoteBundleTag,
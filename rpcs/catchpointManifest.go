@@ -0,0 +1,168 @@
+// Copyright (C) 2019-2025 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package rpcs
+
+import (
+	"archive/tar"
+	"compress/gzip"
+	"errors"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+
+	"github.com/algorand/go-algorand/crypto"
+	"github.com/algorand/go-algorand/data/basics"
+)
+
+const (
+	// MaxCatchpointFileManifestEntries is the maximum number of chunks a catchpoint file manifest may describe
+	MaxCatchpointFileManifestEntries = 1 << 20
+
+	// maxCatchpointFileManifestEntryNameLen is the maximum length of a single chunk name within a catchpoint file
+	maxCatchpointFileManifestEntryNameLen = 256
+
+	// LedgerChunksRangeUnit is the unit of the Range header used for requesting a subset of the catchpoint file chunks
+	LedgerChunksRangeUnit = "chunks"
+)
+
+// CatchpointFileManifestEntry describes a single chunk ( i.e. tar entry ) of a catchpoint file
+type CatchpointFileManifestEntry struct {
+	_struct struct{} `codec:",omitempty,omitemptyarray"`
+
+	Name   string        `codec:"n,allocbound=maxCatchpointFileManifestEntryNameLen"`
+	Size   uint64        `codec:"s"`
+	Digest crypto.Digest `codec:"d"`
+}
+
+// CatchpointFileManifest lists the chunks of a catchpoint file, in the order they appear in the file,
+// along with their digests. It allows a client to download ranges of chunks from different peers and
+// to verify each of the chunks independently.
+type CatchpointFileManifest struct {
+	_struct struct{} `codec:",omitempty,omitemptyarray"`
+
+	Round   basics.Round                  `codec:"rnd"`
+	Entries []CatchpointFileManifestEntry `codec:"ent,allocbound=MaxCatchpointFileManifestEntries"`
+}
+
+// ChunksRange is an inclusive range of catchpoint file chunks indices
+//
+//msgp:ignore ChunksRange
+type ChunksRange struct {
+	First uint64
+	Last  uint64
+}
+
+var errInvalidChunksRange = errors.New("invalid chunks range")
+
+// String returns the Range header representation of the chunks range
+func (r ChunksRange) String() string {
+	return fmt.Sprintf("%s=%d-%d", LedgerChunksRangeUnit, r.First, r.Last)
+}
+
+// ParseChunksRange parses a Range header value of the form "chunks=first-last"
+func ParseChunksRange(header string) (r ChunksRange, err error) {
+	spec, ok := strings.CutPrefix(header, LedgerChunksRangeUnit+"=")
+	if !ok {
+		return ChunksRange{}, errInvalidChunksRange
+	}
+	firstStr, lastStr, ok := strings.Cut(spec, "-")
+	if !ok {
+		return ChunksRange{}, errInvalidChunksRange
+	}
+	r.First, err = strconv.ParseUint(firstStr, 10, 64)
+	if err != nil {
+		return ChunksRange{}, errInvalidChunksRange
+	}
+	r.Last, err = strconv.ParseUint(lastStr, 10, 64)
+	if err != nil || r.Last < r.First {
+		return ChunksRange{}, errInvalidChunksRange
+	}
+	return r, nil
+}
+
+// makeCatchpointFileManifest scans the given compressed catchpoint file and builds its manifest
+func makeCatchpointFileManifest(round basics.Round, catchpointFile io.Reader) (*CatchpointFileManifest, error) {
+	decompressedGzip, err := gzip.NewReader(catchpointFile)
+	if err != nil {
+		return nil, err
+	}
+	defer decompressedGzip.Close()
+	tarReader := tar.NewReader(decompressedGzip)
+	manifest := &CatchpointFileManifest{Round: round}
+	for {
+		header, err := tarReader.Next()
+		if err != nil {
+			if err == io.EOF {
+				return manifest, nil
+			}
+			return nil, err
+		}
+		if len(manifest.Entries) >= MaxCatchpointFileManifestEntries {
+			return nil, fmt.Errorf("catchpoint file for round %d has more than %d chunks", round, MaxCatchpointFileManifestEntries)
+		}
+		if len(header.Name) > maxCatchpointFileManifestEntryNameLen {
+			return nil, fmt.Errorf("catchpoint file for round %d has a chunk with a name of length %d", round, len(header.Name))
+		}
+		hasher := crypto.NewHash()
+		size, err := io.Copy(hasher, tarReader)
+		if err != nil {
+			return nil, err
+		}
+		entry := CatchpointFileManifestEntry{
+			Name: header.Name,
+			Size: uint64(size),
+		}
+		copy(entry.Digest[:], hasher.Sum(nil))
+		manifest.Entries = append(manifest.Entries, entry)
+	}
+}
+
+// writeCatchpointFileChunks writes the chunks within the given range of the compressed catchpoint file as a tar stream
+func writeCatchpointFileChunks(catchpointFile io.Reader, chunks ChunksRange, out io.Writer) error {
+	decompressedGzip, err := gzip.NewReader(catchpointFile)
+	if err != nil {
+		return err
+	}
+	defer decompressedGzip.Close()
+	tarReader := tar.NewReader(decompressedGzip)
+	tarWriter := tar.NewWriter(out)
+	for i := uint64(0); i <= chunks.Last; i++ {
+		header, err := tarReader.Next()
+		if err != nil {
+			if err == io.EOF {
+				return fmt.Errorf("catchpoint file has only %d chunks", i)
+			}
+			return err
+		}
+		if i < chunks.First {
+			continue
+		}
+		err = tarWriter.WriteHeader(&tar.Header{
+			Name: header.Name,
+			Mode: header.Mode,
+			Size: header.Size,
+		})
+		if err != nil {
+			return err
+		}
+		if _, err = io.Copy(tarWriter, tarReader); err != nil {
+			return err
+		}
+	}
+	return tarWriter.Close()
+}
@@ -0,0 +1,44 @@
+// Copyright (C) 2019-2025 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package rpcs
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/algorand/go-algorand/test/partitiontest"
+)
+
+func TestParseChunksRange(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	r, err := ParseChunksRange("chunks=3-7")
+	require.NoError(t, err)
+	require.Equal(t, ChunksRange{First: 3, Last: 7}, r)
+	require.Equal(t, "chunks=3-7", r.String())
+
+	r, err = ParseChunksRange("chunks=5-5")
+	require.NoError(t, err)
+	require.Equal(t, ChunksRange{First: 5, Last: 5}, r)
+
+	for _, header := range []string{"", "chunks=", "chunks=3", "chunks=7-3", "chunks=-3", "chunks=a-b", "bytes=0-10"} {
+		_, err = ParseChunksRange(header)
+		require.ErrorIs(t, err, errInvalidChunksRange, header)
+	}
+}
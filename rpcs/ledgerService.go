@@ -27,6 +27,7 @@ import (
 	"sync/atomic"
 	"time"
 
+	"github.com/algorand/go-deadlock"
 	"github.com/gorilla/mux"
 
 	"github.com/algorand/go-algorand/config"
@@ -34,6 +35,7 @@ import (
 	"github.com/algorand/go-algorand/ledger"
 	"github.com/algorand/go-algorand/ledger/ledgercore"
 	"github.com/algorand/go-algorand/logging"
+	"github.com/algorand/go-algorand/protocol"
 )
 
 const (
@@ -46,6 +48,17 @@ const (
 	// e.g. .Handle(LedgerServiceLedgerPath, &ls)
 	LedgerServiceLedgerPath = "/v{version:[0-9.]+}/{genesisID}/ledger/{round:[0-9a-z]+}"
 
+	// LedgerServiceManifestPath is the path to register LedgerService's catchpoint file manifest handler
+	// e.g. .HandleFunc(LedgerServiceManifestPath, ls.ServeManifestHTTP)
+	LedgerServiceManifestPath = "/v{version:[0-9.]+}/{genesisID}/ledger/{round:[0-9a-z]+}/manifest"
+
+	// LedgerManifestResponseContentType is the HTTP Content-Type header for a catchpoint file manifest
+	LedgerManifestResponseContentType = "application/x-algorand-ledger-manifest-v1"
+
+	// ledgerServiceManifestCacheSize is the number of catchpoint file manifests retained in memory; catchup clients
+	// typically request the manifest of the latest one or two catchpoints only.
+	ledgerServiceManifestCacheSize = 2
+
 	// maxCatchpointFileSize is the default catchpoint file size, if we can't get a concreate number from the ledger.
 	maxCatchpointFileSize = 512 * 1024 * 1024 // 512MB
 
@@ -73,6 +86,10 @@ type LedgerService struct {
 	net           httpGossipNode
 	enableService bool
 	stopping      sync.WaitGroup
+
+	// manifests caches the manifests of the recently requested catchpoint files, as building one requires scanning the entire file
+	manifests   map[basics.Round]*manifestEntry
+	manifestsMu deadlock.Mutex
 }
 
 // MakeLedgerService creates a LedgerService around the provider Ledger and registers it with the HTTP router
@@ -82,10 +99,12 @@ func MakeLedgerService(config config.Local, ledger LedgerForService, net httpGos
 		genesisID:     genesisID,
 		net:           net,
 		enableService: config.EnableLedgerService,
+		manifests:     make(map[basics.Round]*manifestEntry),
 	}
 	// the underlying gorilla/mux doesn't support "unregister", so we're forced to implement it ourselves.
 	if service.enableService {
 		net.RegisterHTTPHandler(LedgerServiceLedgerPath, service)
+		net.RegisterHTTPHandler(LedgerServiceManifestPath, http.HandlerFunc(service.ServeManifestHTTP))
 	}
 	return service
 }
@@ -222,6 +241,11 @@ func (ls *LedgerService) ServeHTTP(response http.ResponseWriter, request *http.R
 		logging.Base().Warnf("LedgerService.ServeHTTP unable to set connection timeout")
 	}
 
+	if rangeHeader := request.Header.Get("Range"); rangeHeader != "" {
+		ls.serveChunksRange(response, request, basics.Round(round), cs, rangeHeader)
+		return
+	}
+
 	requestedCompressedResponse := strings.Contains(request.Header.Get("Accept-Encoding"), "gzip")
 	if requestedCompressedResponse {
 		response.Header().Set("Content-Encoding", "gzip")
@@ -249,3 +273,170 @@ func (ls *LedgerService) ServeHTTP(response http.ResponseWriter, request *http.R
 		logging.Base().Infof("LedgerService.ServeHTTP: served catchpoint round %d in %d sec", round, int(elapsed.Seconds()))
 	}
 }
+
+// serveChunksRange writes the requested range of catchpoint file chunks as a tar stream
+func (ls *LedgerService) serveChunksRange(response http.ResponseWriter, request *http.Request, round basics.Round, cs io.Reader, rangeHeader string) {
+	chunks, err := ParseChunksRange(rangeHeader)
+	if err != nil {
+		logging.Base().Debugf("LedgerService.serveChunksRange: bad range '%s'", rangeHeader)
+		response.WriteHeader(http.StatusBadRequest)
+		response.Write([]byte(fmt.Sprintf("unable to parse range '%s' : %v", rangeHeader, err)))
+		return
+	}
+	manifest, err := ls.getManifest(round)
+	if err != nil {
+		logging.Base().Warnf("LedgerService.serveChunksRange : failed to retrieve catchpoint manifest %d %v", round, err)
+		response.WriteHeader(http.StatusInternalServerError)
+		response.Write([]byte(fmt.Sprintf("catchpoint manifest for round %d could not be retrieved due to internal error : %v", round, err)))
+		return
+	}
+	totalChunks := len(manifest.Entries)
+	if chunks.Last >= uint64(totalChunks) {
+		response.Header().Set("Content-Range", fmt.Sprintf("%s */%d", LedgerChunksRangeUnit, totalChunks))
+		response.WriteHeader(http.StatusRequestedRangeNotSatisfiable)
+		return
+	}
+	response.Header().Set("Content-Range", fmt.Sprintf("%s %d-%d/%d", LedgerChunksRangeUnit, chunks.First, chunks.Last, totalChunks))
+
+	var out io.Writer = response
+	if strings.Contains(request.Header.Get("Accept-Encoding"), "gzip") {
+		response.Header().Set("Content-Encoding", "gzip")
+		compressor, err := gzip.NewWriterLevel(response, gzip.BestSpeed)
+		if err != nil {
+			response.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+		defer compressor.Close()
+		out = compressor
+	}
+	response.WriteHeader(http.StatusPartialContent)
+	err = writeCatchpointFileChunks(cs, chunks, out)
+	if err != nil {
+		logging.Base().Infof("LedgerService.serveChunksRange : unable to write chunks %d-%d of catchpoint file for round %d : %v", chunks.First, chunks.Last, round, err)
+	}
+}
+
+// ServeManifestHTTP returns the manifest of the catchpoint file for a particular round
+// /v{version}/{genesisID}/ledger/{round}/manifest
+// Uses gorilla/mux for path argument parsing.
+func (ls *LedgerService) ServeManifestHTTP(response http.ResponseWriter, request *http.Request) {
+	ls.stopping.Add(1)
+	defer ls.stopping.Done()
+	if ls.running.Add(0) == 0 {
+		response.WriteHeader(http.StatusNotFound)
+		return
+	}
+	pathVars := mux.Vars(request)
+	if versionStr := pathVars["version"]; versionStr != "1" {
+		logging.Base().Debugf("LedgerService.ServeManifestHTTP: bad version '%s'", versionStr)
+		response.WriteHeader(http.StatusBadRequest)
+		response.Write([]byte(fmt.Sprintf("unsupported version '%s'", versionStr)))
+		return
+	}
+	if genesisID := pathVars["genesisID"]; genesisID != ls.genesisID {
+		logging.Base().Debugf("LedgerService.ServeManifestHTTP: bad genesisID mine=%#v theirs=%#v", ls.genesisID, genesisID)
+		response.WriteHeader(http.StatusBadRequest)
+		response.Write([]byte(fmt.Sprintf("mismatching genesisID '%s'", genesisID)))
+		return
+	}
+	round, err := strconv.ParseUint(pathVars["round"], 36, 64)
+	if err != nil {
+		logging.Base().Debugf("LedgerService.ServeManifestHTTP: round parse fail ('%s'): %v", pathVars["round"], err)
+		response.WriteHeader(http.StatusBadRequest)
+		response.Write([]byte(fmt.Sprintf("specified round number could not be parsed using base 36 : %v", err)))
+		return
+	}
+	manifest, err := ls.getManifest(basics.Round(round))
+	if err != nil {
+		switch err.(type) {
+		case ledgercore.ErrNoEntry:
+			response.WriteHeader(http.StatusNotFound)
+			response.Write([]byte(fmt.Sprintf("catchpoint file for round %d is not available", round)))
+		default:
+			logging.Base().Warnf("LedgerService.ServeManifestHTTP : failed to build catchpoint manifest %d %v", round, err)
+			response.WriteHeader(http.StatusInternalServerError)
+			response.Write([]byte(fmt.Sprintf("catchpoint manifest for round %d could not be built due to internal error : %v", round, err)))
+		}
+		return
+	}
+	response.Header().Set("Content-Type", LedgerManifestResponseContentType)
+	response.WriteHeader(http.StatusOK)
+	response.Write(protocol.Encode(manifest))
+}
+
+// manifestEntry is a cached catchpoint file manifest. The manifest and err fields are set only once, before done is closed,
+// so that concurrent requests for the same round wait on a single scan of the catchpoint file.
+type manifestEntry struct {
+	done     chan struct{}
+	manifest *CatchpointFileManifest
+	err      error
+}
+
+// getManifest returns the manifest of the catchpoint file for the given round, building it if it's not cached yet.
+// The catchpoint file is scanned outside of manifestsMu, so that requests for other rounds are not blocked by it.
+func (ls *LedgerService) getManifest(round basics.Round) (*CatchpointFileManifest, error) {
+	ls.manifestsMu.Lock()
+	entry, has := ls.manifests[round]
+	if has {
+		ls.manifestsMu.Unlock()
+		<-entry.done
+		return entry.manifest, entry.err
+	}
+	entry = &manifestEntry{done: make(chan struct{})}
+	ls.manifests[round] = entry
+	ls.manifestsMu.Unlock()
+
+	entry.manifest, entry.err = ls.buildManifest(round)
+	close(entry.done)
+
+	ls.manifestsMu.Lock()
+	defer ls.manifestsMu.Unlock()
+	if entry.err != nil {
+		// don't cache failures; the catchpoint file might become available later on
+		if ls.manifests[round] == entry {
+			delete(ls.manifests, round)
+		}
+		return entry.manifest, entry.err
+	}
+	// Only evict once the manifest is built, so that requests for rounds without a catchpoint
+	// file do not displace the cached manifests.
+	ls.evictManifestsLocked(round)
+	return entry.manifest, entry.err
+}
+
+// evictManifestsLocked evicts the oldest built manifests other than the one of the given round, until
+// ledgerServiceManifestCacheSize of them are left. The manifests being built are not counted.
+func (ls *LedgerService) evictManifestsLocked(round basics.Round) {
+	for {
+		built := 0
+		first := true
+		var oldest basics.Round
+		for rnd, entry := range ls.manifests {
+			select {
+			case <-entry.done:
+			default:
+				continue
+			}
+			built++
+			// evict the oldest catchpoint, as clients are more likely to request the recent ones
+			if rnd != round && (first || rnd < oldest) {
+				oldest = rnd
+				first = false
+			}
+		}
+		if built <= ledgerServiceManifestCacheSize || first {
+			return
+		}
+		delete(ls.manifests, oldest)
+	}
+}
+
+// buildManifest scans the catchpoint file for the given round and returns its manifest
+func (ls *LedgerService) buildManifest(round basics.Round) (*CatchpointFileManifest, error) {
+	cs, err := ls.ledger.GetCatchpointStream(round)
+	if err != nil {
+		return nil, err
+	}
+	defer cs.Close()
+	return makeCatchpointFileManifest(round, cs)
+}
@@ -25,6 +25,8 @@ import (
 	"net/http"
 	"net/http/httptest"
 	"strconv"
+	"sync"
+	"sync/atomic"
 	"testing"
 
 	"github.com/gorilla/mux"
@@ -32,10 +34,12 @@ import (
 	"github.com/stretchr/testify/require"
 
 	"github.com/algorand/go-algorand/config"
+	"github.com/algorand/go-algorand/crypto"
 	"github.com/algorand/go-algorand/data/basics"
 	"github.com/algorand/go-algorand/ledger"
 	"github.com/algorand/go-algorand/ledger/ledgercore"
 	"github.com/algorand/go-algorand/network"
+	"github.com/algorand/go-algorand/protocol"
 	"github.com/algorand/go-algorand/test/partitiontest"
 )
 
@@ -97,6 +101,7 @@ func TestLedgerService(t *testing.T) {
 	// Test LedgerService enabled
 	cfg.EnableLedgerService = true
 	fnet.On("RegisterHTTPHandler", LedgerServiceLedgerPath, mock.Anything).Return()
+	fnet.On("RegisterHTTPHandler", LedgerServiceManifestPath, mock.Anything).Return()
 	ledgerService = MakeLedgerService(cfg, &l, &fnet, genesisID)
 	fnet.AssertCalled(t, "RegisterHTTPHandler", LedgerServiceLedgerPath, ledgerService)
 	ledgerService.Start()
@@ -231,3 +236,168 @@ func TestLedgerServiceP2P(t *testing.T) {
 
 	require.Equal(t, http.StatusOK, resp.StatusCode)
 }
+
+// makeTestCatchpointFile creates a compressed catchpoint file with the given chunks
+func makeTestCatchpointFile(t *testing.T, names []string, chunks [][]byte) []byte {
+	buf := bytes.NewBuffer(nil)
+	gz := gzip.NewWriter(buf)
+	wtar := tar.NewWriter(gz)
+	for i, name := range names {
+		require.NoError(t, wtar.WriteHeader(&tar.Header{Name: name, Mode: 0600, Size: int64(len(chunks[i]))}))
+		_, err := wtar.Write(chunks[i])
+		require.NoError(t, err)
+	}
+	require.NoError(t, wtar.Close())
+	require.NoError(t, gz.Close())
+	return buf.Bytes()
+}
+
+type staticLedgerForService struct {
+	catchpointFile []byte
+}
+
+func (l *staticLedgerForService) GetCatchpointStream(round basics.Round) (ledger.ReadCloseSizer, error) {
+	return mockSizedStream{bytes.NewBuffer(l.catchpointFile)}, nil
+}
+
+// countingLedgerForService counts the catchpoint streams opened per round, and has no catchpoint file
+// after the round latest
+type countingLedgerForService struct {
+	staticLedgerForService
+	mu      sync.Mutex
+	streams map[basics.Round]int
+	latest  basics.Round
+}
+
+func (l *countingLedgerForService) GetCatchpointStream(round basics.Round) (ledger.ReadCloseSizer, error) {
+	l.mu.Lock()
+	l.streams[round]++
+	l.mu.Unlock()
+	if round > l.latest {
+		return nil, ledgercore.ErrNoEntry{Round: round}
+	}
+	return l.staticLedgerForService.GetCatchpointStream(round)
+}
+
+func TestLedgerServiceManifestCache(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	names := []string{"content.msgpack", "balances.1.msgpack"}
+	chunks := [][]byte{[]byte("header"), []byte("first")}
+	l := countingLedgerForService{
+		staticLedgerForService: staticLedgerForService{catchpointFile: makeTestCatchpointFile(t, names, chunks)},
+		streams:                make(map[basics.Round]int),
+		latest:                 1000,
+	}
+	ls := MakeLedgerService(config.GetDefaultLocal(), &l, nil, "testGenesisID")
+
+	// concurrent requests for the same round share a single scan of the catchpoint file
+	var wg sync.WaitGroup
+	var failures atomic.Int32
+	for i := 0; i < 8; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			if manifest, err := ls.getManifest(100); err != nil || len(manifest.Entries) != len(names) {
+				failures.Add(1)
+			}
+		}()
+	}
+	wg.Wait()
+	require.Zero(t, failures.Load())
+	require.Equal(t, 1, l.streams[100])
+
+	// the oldest cached round is evicted, even when the requested round is older than all of the cached ones
+	_, err := ls.getManifest(200)
+	require.NoError(t, err)
+	_, err = ls.getManifest(50)
+	require.NoError(t, err)
+	require.Len(t, ls.manifests, ledgerServiceManifestCacheSize)
+	require.Contains(t, ls.manifests, basics.Round(200))
+	require.Contains(t, ls.manifests, basics.Round(50))
+	_, err = ls.getManifest(100)
+	require.NoError(t, err)
+	require.Equal(t, 2, l.streams[100])
+	require.Len(t, ls.manifests, ledgerServiceManifestCacheSize)
+
+	// requests for rounds without a catchpoint file don't evict the cached manifests
+	for rnd := basics.Round(1001); rnd < 1010; rnd++ {
+		_, err = ls.getManifest(rnd)
+		require.ErrorAs(t, err, &ledgercore.ErrNoEntry{})
+	}
+	require.Len(t, ls.manifests, ledgerServiceManifestCacheSize)
+	_, err = ls.getManifest(100)
+	require.NoError(t, err)
+	_, err = ls.getManifest(200)
+	require.NoError(t, err)
+	require.Equal(t, 2, l.streams[100])
+	require.Equal(t, 1, l.streams[200])
+}
+
+func TestLedgerServiceChunks(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	genesisID := "testGenesisID"
+	cfg := config.GetDefaultLocal()
+	cfg.EnableLedgerService = true
+	names := []string{"content.msgpack", "balances.1.msgpack", "balances.2.msgpack", "balances.3.msgpack"}
+	chunks := [][]byte{[]byte("header"), []byte("first"), []byte("second"), []byte("third")}
+	l := staticLedgerForService{catchpointFile: makeTestCatchpointFile(t, names, chunks)}
+	fnet := fakeNetwork{router: mux.NewRouter(), Mock: &mock.Mock{}}
+	fnet.On("RegisterHTTPHandler", mock.Anything, mock.Anything).Return()
+	ledgerService := MakeLedgerService(cfg, &l, &fnet, genesisID)
+	ledgerService.Start()
+	defer ledgerService.Stop()
+
+	// the manifest lists all the chunks along with their digests
+	rr := httptest.NewRecorder()
+	req, err := http.NewRequest("GET", fmt.Sprintf("/v1/%s/ledger/%s/manifest", genesisID, strconv.FormatUint(100, 36)), nil)
+	require.NoError(t, err)
+	fnet.router.ServeHTTP(rr, req)
+	require.Equal(t, http.StatusOK, rr.Code)
+	require.Equal(t, LedgerManifestResponseContentType, rr.Header().Get("Content-Type"))
+	var manifest CatchpointFileManifest
+	require.NoError(t, protocol.Decode(rr.Body.Bytes(), &manifest))
+	require.Equal(t, basics.Round(100), manifest.Round)
+	require.Len(t, manifest.Entries, len(names))
+	for i, entry := range manifest.Entries {
+		require.Equal(t, names[i], entry.Name)
+		require.Equal(t, uint64(len(chunks[i])), entry.Size)
+		require.Equal(t, crypto.Hash(chunks[i]), entry.Digest)
+	}
+
+	// a range of chunks is returned as a tar stream
+	rr = httptest.NewRecorder()
+	req, err = http.NewRequest("GET", fmt.Sprintf("/v1/%s/ledger/%s", genesisID, strconv.FormatUint(100, 36)), nil)
+	require.NoError(t, err)
+	req.Header.Set("Range", ChunksRange{First: 1, Last: 2}.String())
+	fnet.router.ServeHTTP(rr, req)
+	require.Equal(t, http.StatusPartialContent, rr.Code)
+	require.Equal(t, "chunks 1-2/4", rr.Header().Get("Content-Range"))
+	tarReader := tar.NewReader(rr.Body)
+	for i := 1; i <= 2; i++ {
+		header, err := tarReader.Next()
+		require.NoError(t, err)
+		require.Equal(t, names[i], header.Name)
+		data, err := io.ReadAll(tarReader)
+		require.NoError(t, err)
+		require.Equal(t, chunks[i], data)
+	}
+	_, err = tarReader.Next()
+	require.Equal(t, io.EOF, err)
+
+	// a range beyond the end of the file can't be satisfied
+	rr = httptest.NewRecorder()
+	req.Header.Set("Range", ChunksRange{First: 3, Last: 4}.String())
+	fnet.router.ServeHTTP(rr, req)
+	require.Equal(t, http.StatusRequestedRangeNotSatisfiable, rr.Code)
+	require.Equal(t, "chunks */4", rr.Header().Get("Content-Range"))
+
+	// a malformed range is rejected
+	rr = httptest.NewRecorder()
+	req.Header.Set("Range", "bytes=0-100")
+	fnet.router.ServeHTTP(rr, req)
+	require.Equal(t, http.StatusBadRequest, rr.Code)
+}
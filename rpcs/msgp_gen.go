@@ -6,10 +6,32 @@ import (
 	"github.com/algorand/msgp/msgp"
 
 	"github.com/algorand/go-algorand/agreement"
+	"github.com/algorand/go-algorand/crypto"
+	"github.com/algorand/go-algorand/data/basics"
 	"github.com/algorand/go-algorand/data/bookkeeping"
 )
 
 // The following msgp objects are implemented in this file:
+// CatchpointFileManifest
+//            |-----> (*) MarshalMsg
+//            |-----> (*) CanMarshalMsg
+//            |-----> (*) UnmarshalMsg
+//            |-----> (*) UnmarshalMsgWithState
+//            |-----> (*) CanUnmarshalMsg
+//            |-----> (*) Msgsize
+//            |-----> (*) MsgIsZero
+//            |-----> CatchpointFileManifestMaxSize()
+//
+// CatchpointFileManifestEntry
+//              |-----> (*) MarshalMsg
+//              |-----> (*) CanMarshalMsg
+//              |-----> (*) UnmarshalMsg
+//              |-----> (*) UnmarshalMsgWithState
+//              |-----> (*) CanUnmarshalMsg
+//              |-----> (*) Msgsize
+//              |-----> (*) MsgIsZero
+//              |-----> CatchpointFileManifestEntryMaxSize()
+//
 // EncodedBlockCert
 //         |-----> (*) MarshalMsg
 //         |-----> (*) CanMarshalMsg
@@ -20,6 +42,399 @@ import (
 //         |-----> (*) MsgIsZero
 //         |-----> EncodedBlockCertMaxSize()
 //
+// txReconciliationMessage
+//            |-----> (*) MarshalMsg
+//            |-----> (*) CanMarshalMsg
+//            |-----> (*) UnmarshalMsg
+//            |-----> (*) UnmarshalMsgWithState
+//            |-----> (*) CanUnmarshalMsg
+//            |-----> (*) Msgsize
+//            |-----> (*) MsgIsZero
+//            |-----> TxReconciliationMessageMaxSize()
+//
+
+// MarshalMsg implements msgp.Marshaler
+func (z *CatchpointFileManifest) MarshalMsg(b []byte) (o []byte) {
+	o = msgp.Require(b, z.Msgsize())
+	// omitempty: check for empty values
+	zb0002Len := uint32(2)
+	var zb0002Mask uint8 /* 3 bits */
+	if len((*z).Entries) == 0 {
+		zb0002Len--
+		zb0002Mask |= 0x2
+	}
+	if (*z).Round.MsgIsZero() {
+		zb0002Len--
+		zb0002Mask |= 0x4
+	}
+	// variable map header, size zb0002Len
+	o = append(o, 0x80|uint8(zb0002Len))
+	if zb0002Len != 0 {
+		if (zb0002Mask & 0x2) == 0 { // if not empty
+			// string "ent"
+			o = append(o, 0xa3, 0x65, 0x6e, 0x74)
+			if (*z).Entries == nil {
+				o = msgp.AppendNil(o)
+			} else {
+				o = msgp.AppendArrayHeader(o, uint32(len((*z).Entries)))
+			}
+			for zb0001 := range (*z).Entries {
+				o = (*z).Entries[zb0001].MarshalMsg(o)
+			}
+		}
+		if (zb0002Mask & 0x4) == 0 { // if not empty
+			// string "rnd"
+			o = append(o, 0xa3, 0x72, 0x6e, 0x64)
+			o = (*z).Round.MarshalMsg(o)
+		}
+	}
+	return
+}
+
+func (_ *CatchpointFileManifest) CanMarshalMsg(z interface{}) bool {
+	_, ok := (z).(*CatchpointFileManifest)
+	return ok
+}
+
+// UnmarshalMsg implements msgp.Unmarshaler
+func (z *CatchpointFileManifest) UnmarshalMsgWithState(bts []byte, st msgp.UnmarshalState) (o []byte, err error) {
+	if st.AllowableDepth == 0 {
+		err = msgp.ErrMaxDepthExceeded{}
+		return
+	}
+	st.AllowableDepth--
+	var field []byte
+	_ = field
+	var zb0002 int
+	var zb0003 bool
+	zb0002, zb0003, bts, err = msgp.ReadMapHeaderBytes(bts)
+	if _, ok := err.(msgp.TypeError); ok {
+		zb0002, zb0003, bts, err = msgp.ReadArrayHeaderBytes(bts)
+		if err != nil {
+			err = msgp.WrapError(err)
+			return
+		}
+		if zb0002 > 0 {
+			zb0002--
+			bts, err = (*z).Round.UnmarshalMsgWithState(bts, st)
+			if err != nil {
+				err = msgp.WrapError(err, "struct-from-array", "Round")
+				return
+			}
+		}
+		if zb0002 > 0 {
+			zb0002--
+			var zb0004 int
+			var zb0005 bool
+			zb0004, zb0005, bts, err = msgp.ReadArrayHeaderBytes(bts)
+			if err != nil {
+				err = msgp.WrapError(err, "struct-from-array", "Entries")
+				return
+			}
+			if zb0004 > MaxCatchpointFileManifestEntries {
+				err = msgp.ErrOverflow(uint64(zb0004), uint64(MaxCatchpointFileManifestEntries))
+				err = msgp.WrapError(err, "struct-from-array", "Entries")
+				return
+			}
+			if zb0005 {
+				(*z).Entries = nil
+			} else if (*z).Entries != nil && cap((*z).Entries) >= zb0004 {
+				(*z).Entries = ((*z).Entries)[:zb0004]
+			} else {
+				(*z).Entries = make([]CatchpointFileManifestEntry, zb0004)
+			}
+			for zb0001 := range (*z).Entries {
+				bts, err = (*z).Entries[zb0001].UnmarshalMsgWithState(bts, st)
+				if err != nil {
+					err = msgp.WrapError(err, "struct-from-array", "Entries", zb0001)
+					return
+				}
+			}
+		}
+		if zb0002 > 0 {
+			err = msgp.ErrTooManyArrayFields(zb0002)
+			if err != nil {
+				err = msgp.WrapError(err, "struct-from-array")
+				return
+			}
+		}
+	} else {
+		if err != nil {
+			err = msgp.WrapError(err)
+			return
+		}
+		if zb0003 {
+			(*z) = CatchpointFileManifest{}
+		}
+		for zb0002 > 0 {
+			zb0002--
+			field, bts, err = msgp.ReadMapKeyZC(bts)
+			if err != nil {
+				err = msgp.WrapError(err)
+				return
+			}
+			switch string(field) {
+			case "rnd":
+				bts, err = (*z).Round.UnmarshalMsgWithState(bts, st)
+				if err != nil {
+					err = msgp.WrapError(err, "Round")
+					return
+				}
+			case "ent":
+				var zb0006 int
+				var zb0007 bool
+				zb0006, zb0007, bts, err = msgp.ReadArrayHeaderBytes(bts)
+				if err != nil {
+					err = msgp.WrapError(err, "Entries")
+					return
+				}
+				if zb0006 > MaxCatchpointFileManifestEntries {
+					err = msgp.ErrOverflow(uint64(zb0006), uint64(MaxCatchpointFileManifestEntries))
+					err = msgp.WrapError(err, "Entries")
+					return
+				}
+				if zb0007 {
+					(*z).Entries = nil
+				} else if (*z).Entries != nil && cap((*z).Entries) >= zb0006 {
+					(*z).Entries = ((*z).Entries)[:zb0006]
+				} else {
+					(*z).Entries = make([]CatchpointFileManifestEntry, zb0006)
+				}
+				for zb0001 := range (*z).Entries {
+					bts, err = (*z).Entries[zb0001].UnmarshalMsgWithState(bts, st)
+					if err != nil {
+						err = msgp.WrapError(err, "Entries", zb0001)
+						return
+					}
+				}
+			default:
+				err = msgp.ErrNoField(string(field))
+				if err != nil {
+					err = msgp.WrapError(err)
+					return
+				}
+			}
+		}
+	}
+	o = bts
+	return
+}
+
+func (z *CatchpointFileManifest) UnmarshalMsg(bts []byte) (o []byte, err error) {
+	return z.UnmarshalMsgWithState(bts, msgp.DefaultUnmarshalState)
+}
+func (_ *CatchpointFileManifest) CanUnmarshalMsg(z interface{}) bool {
+	_, ok := (z).(*CatchpointFileManifest)
+	return ok
+}
+
+// Msgsize returns an upper bound estimate of the number of bytes occupied by the serialized message
+func (z *CatchpointFileManifest) Msgsize() (s int) {
+	s = 1 + 4 + (*z).Round.Msgsize() + 4 + msgp.ArrayHeaderSize
+	for zb0001 := range (*z).Entries {
+		s += (*z).Entries[zb0001].Msgsize()
+	}
+	return
+}
+
+// MsgIsZero returns whether this is a zero value
+func (z *CatchpointFileManifest) MsgIsZero() bool {
+	return ((*z).Round.MsgIsZero()) && (len((*z).Entries) == 0)
+}
+
+// MaxSize returns a maximum valid message size for this message type
+func CatchpointFileManifestMaxSize() (s int) {
+	s = 1 + 4 + basics.RoundMaxSize() + 4
+	// Calculating size of slice: z.Entries
+	s += msgp.ArrayHeaderSize + ((MaxCatchpointFileManifestEntries) * (CatchpointFileManifestEntryMaxSize()))
+	return
+}
+
+// MarshalMsg implements msgp.Marshaler
+func (z *CatchpointFileManifestEntry) MarshalMsg(b []byte) (o []byte) {
+	o = msgp.Require(b, z.Msgsize())
+	// omitempty: check for empty values
+	zb0001Len := uint32(3)
+	var zb0001Mask uint8 /* 4 bits */
+	if (*z).Digest.MsgIsZero() {
+		zb0001Len--
+		zb0001Mask |= 0x2
+	}
+	if (*z).Name == "" {
+		zb0001Len--
+		zb0001Mask |= 0x4
+	}
+	if (*z).Size == 0 {
+		zb0001Len--
+		zb0001Mask |= 0x8
+	}
+	// variable map header, size zb0001Len
+	o = append(o, 0x80|uint8(zb0001Len))
+	if zb0001Len != 0 {
+		if (zb0001Mask & 0x2) == 0 { // if not empty
+			// string "d"
+			o = append(o, 0xa1, 0x64)
+			o = (*z).Digest.MarshalMsg(o)
+		}
+		if (zb0001Mask & 0x4) == 0 { // if not empty
+			// string "n"
+			o = append(o, 0xa1, 0x6e)
+			o = msgp.AppendString(o, (*z).Name)
+		}
+		if (zb0001Mask & 0x8) == 0 { // if not empty
+			// string "s"
+			o = append(o, 0xa1, 0x73)
+			o = msgp.AppendUint64(o, (*z).Size)
+		}
+	}
+	return
+}
+
+func (_ *CatchpointFileManifestEntry) CanMarshalMsg(z interface{}) bool {
+	_, ok := (z).(*CatchpointFileManifestEntry)
+	return ok
+}
+
+// UnmarshalMsg implements msgp.Unmarshaler
+func (z *CatchpointFileManifestEntry) UnmarshalMsgWithState(bts []byte, st msgp.UnmarshalState) (o []byte, err error) {
+	if st.AllowableDepth == 0 {
+		err = msgp.ErrMaxDepthExceeded{}
+		return
+	}
+	st.AllowableDepth--
+	var field []byte
+	_ = field
+	var zb0001 int
+	var zb0002 bool
+	zb0001, zb0002, bts, err = msgp.ReadMapHeaderBytes(bts)
+	if _, ok := err.(msgp.TypeError); ok {
+		zb0001, zb0002, bts, err = msgp.ReadArrayHeaderBytes(bts)
+		if err != nil {
+			err = msgp.WrapError(err)
+			return
+		}
+		if zb0001 > 0 {
+			zb0001--
+			var zb0003 int
+			zb0003, err = msgp.ReadBytesBytesHeader(bts)
+			if err != nil {
+				err = msgp.WrapError(err, "struct-from-array", "Name")
+				return
+			}
+			if zb0003 > maxCatchpointFileManifestEntryNameLen {
+				err = msgp.ErrOverflow(uint64(zb0003), uint64(maxCatchpointFileManifestEntryNameLen))
+				return
+			}
+			(*z).Name, bts, err = msgp.ReadStringBytes(bts)
+			if err != nil {
+				err = msgp.WrapError(err, "struct-from-array", "Name")
+				return
+			}
+		}
+		if zb0001 > 0 {
+			zb0001--
+			(*z).Size, bts, err = msgp.ReadUint64Bytes(bts)
+			if err != nil {
+				err = msgp.WrapError(err, "struct-from-array", "Size")
+				return
+			}
+		}
+		if zb0001 > 0 {
+			zb0001--
+			bts, err = (*z).Digest.UnmarshalMsgWithState(bts, st)
+			if err != nil {
+				err = msgp.WrapError(err, "struct-from-array", "Digest")
+				return
+			}
+		}
+		if zb0001 > 0 {
+			err = msgp.ErrTooManyArrayFields(zb0001)
+			if err != nil {
+				err = msgp.WrapError(err, "struct-from-array")
+				return
+			}
+		}
+	} else {
+		if err != nil {
+			err = msgp.WrapError(err)
+			return
+		}
+		if zb0002 {
+			(*z) = CatchpointFileManifestEntry{}
+		}
+		for zb0001 > 0 {
+			zb0001--
+			field, bts, err = msgp.ReadMapKeyZC(bts)
+			if err != nil {
+				err = msgp.WrapError(err)
+				return
+			}
+			switch string(field) {
+			case "n":
+				var zb0004 int
+				zb0004, err = msgp.ReadBytesBytesHeader(bts)
+				if err != nil {
+					err = msgp.WrapError(err, "Name")
+					return
+				}
+				if zb0004 > maxCatchpointFileManifestEntryNameLen {
+					err = msgp.ErrOverflow(uint64(zb0004), uint64(maxCatchpointFileManifestEntryNameLen))
+					return
+				}
+				(*z).Name, bts, err = msgp.ReadStringBytes(bts)
+				if err != nil {
+					err = msgp.WrapError(err, "Name")
+					return
+				}
+			case "s":
+				(*z).Size, bts, err = msgp.ReadUint64Bytes(bts)
+				if err != nil {
+					err = msgp.WrapError(err, "Size")
+					return
+				}
+			case "d":
+				bts, err = (*z).Digest.UnmarshalMsgWithState(bts, st)
+				if err != nil {
+					err = msgp.WrapError(err, "Digest")
+					return
+				}
+			default:
+				err = msgp.ErrNoField(string(field))
+				if err != nil {
+					err = msgp.WrapError(err)
+					return
+				}
+			}
+		}
+	}
+	o = bts
+	return
+}
+
+func (z *CatchpointFileManifestEntry) UnmarshalMsg(bts []byte) (o []byte, err error) {
+	return z.UnmarshalMsgWithState(bts, msgp.DefaultUnmarshalState)
+}
+func (_ *CatchpointFileManifestEntry) CanUnmarshalMsg(z interface{}) bool {
+	_, ok := (z).(*CatchpointFileManifestEntry)
+	return ok
+}
+
+// Msgsize returns an upper bound estimate of the number of bytes occupied by the serialized message
+func (z *CatchpointFileManifestEntry) Msgsize() (s int) {
+	s = 1 + 2 + msgp.StringPrefixSize + len((*z).Name) + 2 + msgp.Uint64Size + 2 + (*z).Digest.Msgsize()
+	return
+}
+
+// MsgIsZero returns whether this is a zero value
+func (z *CatchpointFileManifestEntry) MsgIsZero() bool {
+	return ((*z).Name == "") && ((*z).Size == 0) && ((*z).Digest.MsgIsZero())
+}
+
+// MaxSize returns a maximum valid message size for this message type
+func CatchpointFileManifestEntryMaxSize() (s int) {
+	s = 1 + 2 + msgp.StringPrefixSize + maxCatchpointFileManifestEntryNameLen + 2 + msgp.Uint64Size + 2 + crypto.DigestMaxSize()
+	return
+}
 
 // MarshalMsg implements msgp.Marshaler
 func (z *EncodedBlockCert) MarshalMsg(b []byte) (o []byte) {
@@ -145,3 +560,287 @@ func EncodedBlockCertMaxSize() (s int) {
 	s = 1 + 6 + bookkeeping.BlockMaxSize() + 5 + agreement.CertificateMaxSize()
 	return
 }
+
+// MarshalMsg implements msgp.Marshaler
+func (z *txReconciliationMessage) MarshalMsg(b []byte) (o []byte) {
+	o = msgp.Require(b, z.Msgsize())
+	// omitempty: check for empty values
+	zb0002Len := uint32(5)
+	var zb0002Mask uint8 /* 6 bits */
+	if (*z).Difference == 0 {
+		zb0002Len--
+		zb0002Mask |= 0x2
+	}
+	if (*z).Failed == false {
+		zb0002Len--
+		zb0002Mask |= 0x4
+	}
+	if len((*z).Missing) == 0 {
+		zb0002Len--
+		zb0002Mask |= 0x8
+	}
+	if (*z).Nonce == 0 {
+		zb0002Len--
+		zb0002Mask |= 0x10
+	}
+	if len((*z).Sketch) == 0 {
+		zb0002Len--
+		zb0002Mask |= 0x20
+	}
+	// variable map header, size zb0002Len
+	o = append(o, 0x80|uint8(zb0002Len))
+	if zb0002Len != 0 {
+		if (zb0002Mask & 0x2) == 0 { // if not empty
+			// string "d"
+			o = append(o, 0xa1, 0x64)
+			o = msgp.AppendUint64(o, (*z).Difference)
+		}
+		if (zb0002Mask & 0x4) == 0 { // if not empty
+			// string "f"
+			o = append(o, 0xa1, 0x66)
+			o = msgp.AppendBool(o, (*z).Failed)
+		}
+		if (zb0002Mask & 0x8) == 0 { // if not empty
+			// string "m"
+			o = append(o, 0xa1, 0x6d)
+			if (*z).Missing == nil {
+				o = msgp.AppendNil(o)
+			} else {
+				o = msgp.AppendArrayHeader(o, uint32(len((*z).Missing)))
+			}
+			for zb0001 := range (*z).Missing {
+				o = msgp.AppendUint64(o, (*z).Missing[zb0001])
+			}
+		}
+		if (zb0002Mask & 0x10) == 0 { // if not empty
+			// string "n"
+			o = append(o, 0xa1, 0x6e)
+			o = msgp.AppendUint64(o, (*z).Nonce)
+		}
+		if (zb0002Mask & 0x20) == 0 { // if not empty
+			// string "s"
+			o = append(o, 0xa1, 0x73)
+			o = msgp.AppendBytes(o, (*z).Sketch)
+		}
+	}
+	return
+}
+
+func (_ *txReconciliationMessage) CanMarshalMsg(z interface{}) bool {
+	_, ok := (z).(*txReconciliationMessage)
+	return ok
+}
+
+// UnmarshalMsg implements msgp.Unmarshaler
+func (z *txReconciliationMessage) UnmarshalMsgWithState(bts []byte, st msgp.UnmarshalState) (o []byte, err error) {
+	if st.AllowableDepth == 0 {
+		err = msgp.ErrMaxDepthExceeded{}
+		return
+	}
+	st.AllowableDepth--
+	var field []byte
+	_ = field
+	var zb0002 int
+	var zb0003 bool
+	zb0002, zb0003, bts, err = msgp.ReadMapHeaderBytes(bts)
+	if _, ok := err.(msgp.TypeError); ok {
+		zb0002, zb0003, bts, err = msgp.ReadArrayHeaderBytes(bts)
+		if err != nil {
+			err = msgp.WrapError(err)
+			return
+		}
+		if zb0002 > 0 {
+			zb0002--
+			(*z).Nonce, bts, err = msgp.ReadUint64Bytes(bts)
+			if err != nil {
+				err = msgp.WrapError(err, "struct-from-array", "Nonce")
+				return
+			}
+		}
+		if zb0002 > 0 {
+			zb0002--
+			var zb0004 int
+			zb0004, err = msgp.ReadBytesBytesHeader(bts)
+			if err != nil {
+				err = msgp.WrapError(err, "struct-from-array", "Sketch")
+				return
+			}
+			if zb0004 > txReconciliationMaxSketchSize {
+				err = msgp.ErrOverflow(uint64(zb0004), uint64(txReconciliationMaxSketchSize))
+				return
+			}
+			(*z).Sketch, bts, err = msgp.ReadBytesBytes(bts, (*z).Sketch)
+			if err != nil {
+				err = msgp.WrapError(err, "struct-from-array", "Sketch")
+				return
+			}
+		}
+		if zb0002 > 0 {
+			zb0002--
+			var zb0005 int
+			var zb0006 bool
+			zb0005, zb0006, bts, err = msgp.ReadArrayHeaderBytes(bts)
+			if err != nil {
+				err = msgp.WrapError(err, "struct-from-array", "Missing")
+				return
+			}
+			if zb0005 > txReconciliationMaxCells {
+				err = msgp.ErrOverflow(uint64(zb0005), uint64(txReconciliationMaxCells))
+				err = msgp.WrapError(err, "struct-from-array", "Missing")
+				return
+			}
+			if zb0006 {
+				(*z).Missing = nil
+			} else if (*z).Missing != nil && cap((*z).Missing) >= zb0005 {
+				(*z).Missing = ((*z).Missing)[:zb0005]
+			} else {
+				(*z).Missing = make([]uint64, zb0005)
+			}
+			for zb0001 := range (*z).Missing {
+				(*z).Missing[zb0001], bts, err = msgp.ReadUint64Bytes(bts)
+				if err != nil {
+					err = msgp.WrapError(err, "struct-from-array", "Missing", zb0001)
+					return
+				}
+			}
+		}
+		if zb0002 > 0 {
+			zb0002--
+			(*z).Difference, bts, err = msgp.ReadUint64Bytes(bts)
+			if err != nil {
+				err = msgp.WrapError(err, "struct-from-array", "Difference")
+				return
+			}
+		}
+		if zb0002 > 0 {
+			zb0002--
+			(*z).Failed, bts, err = msgp.ReadBoolBytes(bts)
+			if err != nil {
+				err = msgp.WrapError(err, "struct-from-array", "Failed")
+				return
+			}
+		}
+		if zb0002 > 0 {
+			err = msgp.ErrTooManyArrayFields(zb0002)
+			if err != nil {
+				err = msgp.WrapError(err, "struct-from-array")
+				return
+			}
+		}
+	} else {
+		if err != nil {
+			err = msgp.WrapError(err)
+			return
+		}
+		if zb0003 {
+			(*z) = txReconciliationMessage{}
+		}
+		for zb0002 > 0 {
+			zb0002--
+			field, bts, err = msgp.ReadMapKeyZC(bts)
+			if err != nil {
+				err = msgp.WrapError(err)
+				return
+			}
+			switch string(field) {
+			case "n":
+				(*z).Nonce, bts, err = msgp.ReadUint64Bytes(bts)
+				if err != nil {
+					err = msgp.WrapError(err, "Nonce")
+					return
+				}
+			case "s":
+				var zb0007 int
+				zb0007, err = msgp.ReadBytesBytesHeader(bts)
+				if err != nil {
+					err = msgp.WrapError(err, "Sketch")
+					return
+				}
+				if zb0007 > txReconciliationMaxSketchSize {
+					err = msgp.ErrOverflow(uint64(zb0007), uint64(txReconciliationMaxSketchSize))
+					return
+				}
+				(*z).Sketch, bts, err = msgp.ReadBytesBytes(bts, (*z).Sketch)
+				if err != nil {
+					err = msgp.WrapError(err, "Sketch")
+					return
+				}
+			case "m":
+				var zb0008 int
+				var zb0009 bool
+				zb0008, zb0009, bts, err = msgp.ReadArrayHeaderBytes(bts)
+				if err != nil {
+					err = msgp.WrapError(err, "Missing")
+					return
+				}
+				if zb0008 > txReconciliationMaxCells {
+					err = msgp.ErrOverflow(uint64(zb0008), uint64(txReconciliationMaxCells))
+					err = msgp.WrapError(err, "Missing")
+					return
+				}
+				if zb0009 {
+					(*z).Missing = nil
+				} else if (*z).Missing != nil && cap((*z).Missing) >= zb0008 {
+					(*z).Missing = ((*z).Missing)[:zb0008]
+				} else {
+					(*z).Missing = make([]uint64, zb0008)
+				}
+				for zb0001 := range (*z).Missing {
+					(*z).Missing[zb0001], bts, err = msgp.ReadUint64Bytes(bts)
+					if err != nil {
+						err = msgp.WrapError(err, "Missing", zb0001)
+						return
+					}
+				}
+			case "d":
+				(*z).Difference, bts, err = msgp.ReadUint64Bytes(bts)
+				if err != nil {
+					err = msgp.WrapError(err, "Difference")
+					return
+				}
+			case "f":
+				(*z).Failed, bts, err = msgp.ReadBoolBytes(bts)
+				if err != nil {
+					err = msgp.WrapError(err, "Failed")
+					return
+				}
+			default:
+				err = msgp.ErrNoField(string(field))
+				if err != nil {
+					err = msgp.WrapError(err)
+					return
+				}
+			}
+		}
+	}
+	o = bts
+	return
+}
+
+func (z *txReconciliationMessage) UnmarshalMsg(bts []byte) (o []byte, err error) {
+	return z.UnmarshalMsgWithState(bts, msgp.DefaultUnmarshalState)
+}
+func (_ *txReconciliationMessage) CanUnmarshalMsg(z interface{}) bool {
+	_, ok := (z).(*txReconciliationMessage)
+	return ok
+}
+
+// Msgsize returns an upper bound estimate of the number of bytes occupied by the serialized message
+func (z *txReconciliationMessage) Msgsize() (s int) {
+	s = 1 + 2 + msgp.Uint64Size + 2 + msgp.BytesPrefixSize + len((*z).Sketch) + 2 + msgp.ArrayHeaderSize + (len((*z).Missing) * (msgp.Uint64Size)) + 2 + msgp.Uint64Size + 2 + msgp.BoolSize
+	return
+}
+
+// MsgIsZero returns whether this is a zero value
+func (z *txReconciliationMessage) MsgIsZero() bool {
+	return ((*z).Nonce == 0) && (len((*z).Sketch) == 0) && (len((*z).Missing) == 0) && ((*z).Difference == 0) && ((*z).Failed == false)
+}
+
+// MaxSize returns a maximum valid message size for this message type
+func TxReconciliationMessageMaxSize() (s int) {
+	s = 1 + 2 + msgp.Uint64Size + 2 + msgp.BytesPrefixSize + txReconciliationMaxSketchSize + 2
+	// Calculating size of slice: z.Missing
+	s += msgp.ArrayHeaderSize + ((txReconciliationMaxCells) * (msgp.Uint64Size))
+	s += 2 + msgp.Uint64Size + 2 + msgp.BoolSize
+	return
+}
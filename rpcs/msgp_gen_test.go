@@ -14,6 +14,126 @@ import (
 	"github.com/algorand/go-algorand/test/partitiontest"
 )
 
+func TestMarshalUnmarshalCatchpointFileManifest(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	v := CatchpointFileManifest{}
+	bts := v.MarshalMsg(nil)
+	left, err := v.UnmarshalMsg(bts)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(left) > 0 {
+		t.Errorf("%d bytes left over after UnmarshalMsg(): %q", len(left), left)
+	}
+
+	left, err = msgp.Skip(bts)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(left) > 0 {
+		t.Errorf("%d bytes left over after Skip(): %q", len(left), left)
+	}
+}
+
+func TestRandomizedEncodingCatchpointFileManifest(t *testing.T) {
+	protocol.RunEncodingTest(t, &CatchpointFileManifest{})
+}
+
+func BenchmarkMarshalMsgCatchpointFileManifest(b *testing.B) {
+	v := CatchpointFileManifest{}
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		v.MarshalMsg(nil)
+	}
+}
+
+func BenchmarkAppendMsgCatchpointFileManifest(b *testing.B) {
+	v := CatchpointFileManifest{}
+	bts := make([]byte, 0, v.Msgsize())
+	bts = v.MarshalMsg(bts[0:0])
+	b.SetBytes(int64(len(bts)))
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		bts = v.MarshalMsg(bts[0:0])
+	}
+}
+
+func BenchmarkUnmarshalCatchpointFileManifest(b *testing.B) {
+	v := CatchpointFileManifest{}
+	bts := v.MarshalMsg(nil)
+	b.ReportAllocs()
+	b.SetBytes(int64(len(bts)))
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		_, err := v.UnmarshalMsg(bts)
+		if err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+func TestMarshalUnmarshalCatchpointFileManifestEntry(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	v := CatchpointFileManifestEntry{}
+	bts := v.MarshalMsg(nil)
+	left, err := v.UnmarshalMsg(bts)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(left) > 0 {
+		t.Errorf("%d bytes left over after UnmarshalMsg(): %q", len(left), left)
+	}
+
+	left, err = msgp.Skip(bts)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(left) > 0 {
+		t.Errorf("%d bytes left over after Skip(): %q", len(left), left)
+	}
+}
+
+func TestRandomizedEncodingCatchpointFileManifestEntry(t *testing.T) {
+	protocol.RunEncodingTest(t, &CatchpointFileManifestEntry{})
+}
+
+func BenchmarkMarshalMsgCatchpointFileManifestEntry(b *testing.B) {
+	v := CatchpointFileManifestEntry{}
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		v.MarshalMsg(nil)
+	}
+}
+
+func BenchmarkAppendMsgCatchpointFileManifestEntry(b *testing.B) {
+	v := CatchpointFileManifestEntry{}
+	bts := make([]byte, 0, v.Msgsize())
+	bts = v.MarshalMsg(bts[0:0])
+	b.SetBytes(int64(len(bts)))
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		bts = v.MarshalMsg(bts[0:0])
+	}
+}
+
+func BenchmarkUnmarshalCatchpointFileManifestEntry(b *testing.B) {
+	v := CatchpointFileManifestEntry{}
+	bts := v.MarshalMsg(nil)
+	b.ReportAllocs()
+	b.SetBytes(int64(len(bts)))
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		_, err := v.UnmarshalMsg(bts)
+		if err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
 func TestMarshalUnmarshalEncodedBlockCert(t *testing.T) {
 	partitiontest.PartitionTest(t)
 	v := EncodedBlockCert{}
@@ -73,3 +193,63 @@ func BenchmarkUnmarshalEncodedBlockCert(b *testing.B) {
 		}
 	}
 }
+
+func TestMarshalUnmarshaltxReconciliationMessage(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	v := txReconciliationMessage{}
+	bts := v.MarshalMsg(nil)
+	left, err := v.UnmarshalMsg(bts)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(left) > 0 {
+		t.Errorf("%d bytes left over after UnmarshalMsg(): %q", len(left), left)
+	}
+
+	left, err = msgp.Skip(bts)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(left) > 0 {
+		t.Errorf("%d bytes left over after Skip(): %q", len(left), left)
+	}
+}
+
+func TestRandomizedEncodingtxReconciliationMessage(t *testing.T) {
+	protocol.RunEncodingTest(t, &txReconciliationMessage{})
+}
+
+func BenchmarkMarshalMsgtxReconciliationMessage(b *testing.B) {
+	v := txReconciliationMessage{}
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		v.MarshalMsg(nil)
+	}
+}
+
+func BenchmarkAppendMsgtxReconciliationMessage(b *testing.B) {
+	v := txReconciliationMessage{}
+	bts := make([]byte, 0, v.Msgsize())
+	bts = v.MarshalMsg(bts[0:0])
+	b.SetBytes(int64(len(bts)))
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		bts = v.MarshalMsg(bts[0:0])
+	}
+}
+
+func BenchmarkUnmarshaltxReconciliationMessage(b *testing.B) {
+	v := txReconciliationMessage{}
+	bts := v.MarshalMsg(nil)
+	b.ReportAllocs()
+	b.SetBytes(int64(len(bts)))
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		_, err := v.UnmarshalMsg(bts)
+		if err != nil {
+			b.Fatal(err)
+		}
+	}
+}
@@ -0,0 +1,362 @@
+// Copyright (C) 2019-2025 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package rpcs
+
+import (
+	"bytes"
+	"context"
+	"sync"
+	"time"
+
+	"github.com/algorand/go-deadlock"
+	"github.com/dchest/siphash"
+
+	"github.com/algorand/go-algorand/crypto"
+	"github.com/algorand/go-algorand/data/transactions"
+	"github.com/algorand/go-algorand/logging"
+	"github.com/algorand/go-algorand/network"
+	"github.com/algorand/go-algorand/protocol"
+	"github.com/algorand/go-algorand/util/iblt"
+	"github.com/algorand/go-algorand/util/metrics"
+)
+
+// The transaction set reconciliation protocol lets peers exchange only the pending transactions the other
+// side is missing, instead of flooding all of them. It is run between peers which both announced the
+// network.PeerFeatureTxReconciliation feature:
+//
+//  1. every reconciliation interval, a node sends each of its outgoing peers a sketch of its pending
+//     transactions: an IBLT of their short ids, sized after the difference expected with that peer.
+//  2. the peer subtracts the sketch of its own pending transactions, and decodes their difference. It sends
+//     the transactions the node is missing, and replies with the short ids of the ones it is missing itself.
+//  3. the node sends the requested transactions, and sizes the next sketch after the difference found.
+//
+// Short ids are keyed with a random nonce chosen for each reconciliation, so that colliding transactions
+// can not be crafted to be hidden from the reconciliation.
+
+const (
+	// txReconciliationMaxCells bounds the number of cells of a sketch, and so the size of the differences decoded
+	txReconciliationMaxCells = 16384
+	// txReconciliationMaxSketchSize is the maximum size of an encoded sketch
+	txReconciliationMaxSketchSize = txReconciliationMaxCells * iblt.CellSize
+	// txReconciliationMinDifference is the smallest difference the sketches are sized for
+	txReconciliationMinDifference = 16
+	// txReconciliationMaxDifference is the largest difference the sketches are sized for
+	txReconciliationMaxDifference = (txReconciliationMaxCells - 32) / 2
+)
+
+var txReconciliationSessions = metrics.MakeCounter(metrics.MetricName{Name: "algod_tx_reconciliation_sessions_total", Description: "Number of transaction set reconciliations initiated"})
+var txReconciliationFailures = metrics.MakeCounter(metrics.MetricName{Name: "algod_tx_reconciliation_failures_total", Description: "Number of transaction set reconciliations which could not decode the difference"})
+var txReconciliationSentTxns = metrics.MakeCounter(metrics.MetricName{Name: "algod_tx_reconciliation_sent_txns_total", Description: "Number of transaction groups sent to peers missing them"})
+
+// txReconciliationMessage is a message of the transaction set reconciliation protocol. The initiator of a
+// reconciliation sends the Sketch, and the responder replies with the Missing short ids, or sets Failed.
+type txReconciliationMessage struct {
+	_struct struct{} `codec:",omitempty,omitemptyarray"`
+
+	// Nonce identifies the reconciliation, and keys the short ids of its transactions
+	Nonce uint64 `codec:"n"`
+	// Sketch is the encoded IBLT of the short ids of the initiator's pending transactions
+	Sketch []byte `codec:"s,allocbound=txReconciliationMaxSketchSize"`
+	// Missing lists the short ids of the initiator's transactions that the responder is missing
+	Missing []uint64 `codec:"m,allocbound=txReconciliationMaxCells"`
+	// Difference is the size of the difference between the sets of pending transactions
+	Difference uint64 `codec:"d"`
+	// Failed is set when the difference between the sets was too large to be decoded from the sketch
+	Failed bool `codec:"f"`
+}
+
+// TxReconciliationPool is the pool holding the pending transactions to reconcile
+type TxReconciliationPool interface {
+	PendingTxIDs() []transactions.Txid
+	PendingTxGroupsOf(txids []transactions.Txid) [][]transactions.SignedTxn
+}
+
+//msgp:ignore txReconciliationSession
+type txReconciliationSession struct {
+	// nonce of the outstanding reconciliation, if any
+	nonce   uint64
+	started time.Time
+	// difference is the size of the difference the next sketch is sized for
+	difference int
+	// lastResponse is the time the last sketch sent by the peer was answered
+	lastResponse time.Time
+}
+
+// TxReconciler periodically reconciles the pending transactions with the outgoing peers supporting it,
+// and answers the reconciliations initiated by incoming peers.
+type TxReconciler struct {
+	pool     TxReconciliationPool
+	net      network.GossipNode
+	log      logging.Logger
+	interval time.Duration
+
+	ctx    context.Context
+	cancel context.CancelFunc
+	wg     sync.WaitGroup
+
+	mu       deadlock.Mutex
+	sessions map[network.Peer]*txReconciliationSession
+}
+
+// MakeTxReconciler creates a TxReconciler reconciling the pending transactions of the pool every interval.
+func MakeTxReconciler(pool TxReconciliationPool, net network.GossipNode, interval time.Duration, log logging.Logger) *TxReconciler {
+	return &TxReconciler{
+		pool:     pool,
+		net:      net,
+		log:      log,
+		interval: interval,
+		sessions: make(map[network.Peer]*txReconciliationSession),
+	}
+}
+
+// Start registers the reconciliation message handler, and begins periodically reconciling
+// after the canStart channel indicates it can begin.
+func (r *TxReconciler) Start(canStart chan struct{}) {
+	r.net.RegisterHandlers([]network.TaggedMessageHandler{
+		{Tag: protocol.TxnReconciliationTag, MessageHandler: network.HandlerFunc(r.handle)},
+	})
+	r.ctx, r.cancel = context.WithCancel(context.Background())
+	r.wg.Add(1)
+	go func() {
+		defer r.wg.Done()
+		select {
+		case <-r.ctx.Done():
+			return
+		case <-canStart:
+		}
+		ticker := time.NewTicker(r.interval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-r.ctx.Done():
+				return
+			case <-ticker.C:
+				r.reconcile()
+			}
+		}
+	}()
+}
+
+// Stop stops the periodic reconciliations.
+func (r *TxReconciler) Stop() {
+	r.cancel()
+	r.wg.Wait()
+}
+
+// txShortID returns the short id of a transaction within the reconciliation keyed by nonce.
+func txShortID(nonce uint64, txid transactions.Txid) uint64 {
+	return siphash.Hash(nonce, 0, txid[:])
+}
+
+func makeSketch(nonce uint64, txids []transactions.Txid, cells int) *iblt.Table {
+	sketch := iblt.New(cells)
+	for _, txid := range txids {
+		sketch.Insert(txShortID(nonce, txid))
+	}
+	return sketch
+}
+
+// session returns the reconciliation state of a peer. The caller is assumed to be holding r.mu.
+func (r *TxReconciler) session(peer network.Peer) *txReconciliationSession {
+	s, ok := r.sessions[peer]
+	if !ok {
+		s = &txReconciliationSession{difference: txReconciliationMinDifference}
+		r.sessions[peer] = s
+	}
+	return s
+}
+
+// reconcile initiates a reconciliation with each of the outgoing peers supporting it.
+func (r *TxReconciler) reconcile() {
+	var peers []network.TxReconciliationPeer
+	connected := make(map[network.Peer]bool)
+	for _, p := range r.net.GetPeers(network.PeersConnectedOut, network.PeersConnectedIn) {
+		connected[p] = true
+		if rp, ok := p.(network.TxReconciliationPeer); ok && rp.TxReconciliation() {
+			peers = append(peers, rp)
+		}
+	}
+	outgoing := make(map[network.Peer]bool)
+	for _, p := range r.net.GetPeers(network.PeersConnectedOut) {
+		outgoing[p] = true
+	}
+
+	r.mu.Lock()
+	// forget the peers which disconnected
+	for p := range r.sessions {
+		if !connected[p] {
+			delete(r.sessions, p)
+		}
+	}
+	type target struct {
+		peer       network.TxReconciliationPeer
+		nonce      uint64
+		difference int
+	}
+	var targets []target
+	now := time.Now()
+	for _, p := range peers {
+		if !outgoing[p] {
+			// incoming peers initiate the reconciliations with us
+			continue
+		}
+		s := r.session(p)
+		if s.nonce != 0 && now.Sub(s.started) < 2*r.interval {
+			// the previous reconciliation is still outstanding
+			continue
+		}
+		s.nonce = crypto.RandUint64() | 1
+		s.started = now
+		targets = append(targets, target{peer: p, nonce: s.nonce, difference: s.difference})
+	}
+	r.mu.Unlock()
+	if len(targets) == 0 {
+		return
+	}
+
+	txids := r.pool.PendingTxIDs()
+	for _, t := range targets {
+		sketch, err := makeSketch(t.nonce, txids, iblt.CellsForDifference(t.difference)).MarshalBinary()
+		if err != nil {
+			r.log.Warnf("TxReconciler: unable to encode the sketch for %s: %v", t.peer.GetAddress(), err)
+			continue
+		}
+		msg := txReconciliationMessage{Nonce: t.nonce, Sketch: sketch}
+		if err := t.peer.Unicast(r.ctx, protocol.Encode(&msg), protocol.TxnReconciliationTag); err != nil {
+			r.log.Debugf("TxReconciler: unable to send the sketch to %s: %v", t.peer.GetAddress(), err)
+			continue
+		}
+		txReconciliationSessions.Inc(nil)
+	}
+}
+
+// handle processes the reconciliation messages: sketches sent by the incoming peers, and the replies
+// to the sketches sent to the outgoing ones.
+func (r *TxReconciler) handle(raw network.IncomingMessage) network.OutgoingMessage {
+	peer, ok := raw.Sender.(network.TxReconciliationPeer)
+	if !ok || !peer.TxReconciliation() {
+		return network.OutgoingMessage{Action: network.Ignore}
+	}
+	var msg txReconciliationMessage
+	if err := protocol.Decode(raw.Data, &msg); err != nil {
+		r.log.Infof("TxReconciler: received a malformed message from %s: %v", peer.GetAddress(), err)
+		return network.OutgoingMessage{Action: network.Disconnect}
+	}
+	if msg.Sketch != nil {
+		return r.respond(peer, &msg)
+	}
+	r.complete(peer, &msg)
+	return network.OutgoingMessage{Action: network.Ignore}
+}
+
+// respond decodes the difference between the sketch sent by the peer and the pending transactions,
+// sends the peer the transactions it is missing and requests the ones missing locally.
+func (r *TxReconciler) respond(peer network.TxReconciliationPeer, msg *txReconciliationMessage) network.OutgoingMessage {
+	var remote iblt.Table
+	if err := remote.UnmarshalBinary(msg.Sketch); err != nil {
+		r.log.Infof("TxReconciler: received a malformed sketch from %s: %v", peer.GetAddress(), err)
+		return network.OutgoingMessage{Action: network.Disconnect}
+	}
+
+	r.mu.Lock()
+	s := r.session(peer)
+	now := time.Now()
+	throttled := now.Sub(s.lastResponse) < r.interval/2
+	if !throttled {
+		s.lastResponse = now
+	}
+	r.mu.Unlock()
+	if throttled {
+		// the peer initiates reconciliations faster than expected
+		return network.OutgoingMessage{Action: network.Ignore}
+	}
+
+	txids := r.pool.PendingTxIDs()
+	local := makeSketch(msg.Nonce, txids, remote.Len())
+	reply := txReconciliationMessage{Nonce: msg.Nonce}
+	var theirs, ours []uint64
+	err := remote.Subtract(local)
+	if err == nil {
+		theirs, ours, err = remote.Decode()
+	}
+	if err != nil {
+		txReconciliationFailures.Inc(nil)
+		reply.Failed = true
+	} else {
+		reply.Missing = theirs
+		reply.Difference = uint64(len(theirs) + len(ours))
+	}
+	if err := peer.Unicast(r.ctx, protocol.Encode(&reply), protocol.TxnReconciliationTag); err != nil {
+		r.log.Debugf("TxReconciler: unable to reply to %s: %v", peer.GetAddress(), err)
+	}
+	if !reply.Failed {
+		r.send(peer, msg.Nonce, txids, ours)
+	}
+	return network.OutgoingMessage{Action: network.Ignore}
+}
+
+// complete processes the reply of a peer to the sketch sent to it.
+func (r *TxReconciler) complete(peer network.TxReconciliationPeer, msg *txReconciliationMessage) {
+	r.mu.Lock()
+	s, ok := r.sessions[peer]
+	if !ok || s.nonce == 0 || s.nonce != msg.Nonce {
+		// unsolicited or late reply
+		r.mu.Unlock()
+		return
+	}
+	s.nonce = 0
+	if msg.Failed {
+		txReconciliationFailures.Inc(nil)
+		s.difference = min(2*s.difference, txReconciliationMaxDifference)
+	} else {
+		// leave room for the difference to grow until the next reconciliation
+		s.difference = max(min(2*int(msg.Difference), txReconciliationMaxDifference), txReconciliationMinDifference)
+	}
+	r.mu.Unlock()
+
+	if len(msg.Missing) > 0 {
+		r.send(peer, msg.Nonce, r.pool.PendingTxIDs(), msg.Missing)
+	}
+}
+
+// send sends the peer the pending transaction groups containing the transactions of the given short ids.
+func (r *TxReconciler) send(peer network.TxReconciliationPeer, nonce uint64, txids []transactions.Txid, shortIDs []uint64) {
+	if len(shortIDs) == 0 {
+		return
+	}
+	wanted := make(map[uint64]bool, len(shortIDs))
+	for _, id := range shortIDs {
+		wanted[id] = true
+	}
+	var missing []transactions.Txid
+	for _, txid := range txids {
+		if wanted[txShortID(nonce, txid)] {
+			missing = append(missing, txid)
+		}
+	}
+	for _, txgroup := range r.pool.PendingTxGroupsOf(missing) {
+		var data [][]byte
+		for i := range txgroup {
+			data = append(data, protocol.Encode(&txgroup[i]))
+		}
+		if err := peer.Unicast(r.ctx, bytes.Join(data, nil), protocol.TxnTag); err != nil {
+			r.log.Debugf("TxReconciler: unable to send transactions to %s: %v", peer.GetAddress(), err)
+			return
+		}
+		txReconciliationSentTxns.Inc(nil)
+	}
+}
@@ -0,0 +1,253 @@
+// Copyright (C) 2019-2025 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package rpcs
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/algorand/go-algorand/components/mocks"
+	"github.com/algorand/go-algorand/crypto"
+	"github.com/algorand/go-algorand/data/basics"
+	"github.com/algorand/go-algorand/data/transactions"
+	"github.com/algorand/go-algorand/logging"
+	"github.com/algorand/go-algorand/network"
+	"github.com/algorand/go-algorand/protocol"
+	"github.com/algorand/go-algorand/test/partitiontest"
+)
+
+type testReconciliationPool struct {
+	txns map[transactions.Txid]transactions.SignedTxn
+}
+
+func (p *testReconciliationPool) add(txn transactions.SignedTxn) {
+	p.txns[txn.ID()] = txn
+}
+
+func (p *testReconciliationPool) PendingTxIDs() []transactions.Txid {
+	var ids []transactions.Txid
+	for txid := range p.txns {
+		ids = append(ids, txid)
+	}
+	return ids
+}
+
+func (p *testReconciliationPool) PendingTxGroupsOf(txids []transactions.Txid) [][]transactions.SignedTxn {
+	var txgroups [][]transactions.SignedTxn
+	for _, txid := range txids {
+		if txn, ok := p.txns[txid]; ok {
+			txgroups = append(txgroups, []transactions.SignedTxn{txn})
+		}
+	}
+	return txgroups
+}
+
+type testReconciliationNetwork struct {
+	mocks.MockNetwork
+	outgoing []network.Peer
+	incoming []network.Peer
+}
+
+func (n *testReconciliationNetwork) GetPeers(options ...network.PeerOption) []network.Peer {
+	var peers []network.Peer
+	for _, option := range options {
+		switch option {
+		case network.PeersConnectedOut:
+			peers = append(peers, n.outgoing...)
+		case network.PeersConnectedIn:
+			peers = append(peers, n.incoming...)
+		}
+	}
+	return peers
+}
+
+// testReconciliationPeer delivers the messages sent to it to the reconciler of the remote node,
+// which sees the sender as the remote peer.
+type testReconciliationPeer struct {
+	network.UnicastPeer
+	remote     *TxReconciler
+	remotePeer *testReconciliationPeer
+	// received are the transactions sent to the remote node
+	received []transactions.SignedTxn
+	// sketches is the number of sketches sent to the remote node
+	sketches int
+}
+
+func (p *testReconciliationPeer) GetAddress() string { return "test" }
+
+func (p *testReconciliationPeer) TxReconciliation() bool { return true }
+
+func (p *testReconciliationPeer) GetNetwork() network.GossipNode { return nil }
+
+func (p *testReconciliationPeer) RoutingAddr() []byte { return nil }
+
+func (p *testReconciliationPeer) Unicast(ctx context.Context, data []byte, tag protocol.Tag) error {
+	switch tag {
+	case protocol.TxnTag:
+		var txn transactions.SignedTxn
+		if err := protocol.Decode(data, &txn); err != nil {
+			return err
+		}
+		p.received = append(p.received, txn)
+	case protocol.TxnReconciliationTag:
+		var msg txReconciliationMessage
+		if err := protocol.Decode(data, &msg); err == nil && msg.Sketch != nil {
+			p.sketches++
+		}
+		p.remote.handle(network.IncomingMessage{Sender: p.remotePeer, Tag: tag, Data: data})
+	}
+	return nil
+}
+
+func makeTestReconciliationTxn(i int) transactions.SignedTxn {
+	var txn transactions.SignedTxn
+	txn.Txn.Type = protocol.PaymentTx
+	txn.Txn.Sender = basics.Address(crypto.Hash([]byte{byte(i), byte(i >> 8)}))
+	txn.Txn.FirstValid = basics.Round(i)
+	return txn
+}
+
+func makeTestReconcilers(t *testing.T, interval time.Duration) (a, b *TxReconciler, poolA, poolB *testReconciliationPool, toB, toA *testReconciliationPeer) {
+	poolA = &testReconciliationPool{txns: make(map[transactions.Txid]transactions.SignedTxn)}
+	poolB = &testReconciliationPool{txns: make(map[transactions.Txid]transactions.SignedTxn)}
+	netA := &testReconciliationNetwork{}
+	netB := &testReconciliationNetwork{}
+	a = MakeTxReconciler(poolA, netA, interval, logging.TestingLog(t))
+	b = MakeTxReconciler(poolB, netB, interval, logging.TestingLog(t))
+
+	// A is connected to B
+	toB = &testReconciliationPeer{remote: b}
+	toA = &testReconciliationPeer{remote: a}
+	toB.remotePeer, toA.remotePeer = toA, toB
+	netA.outgoing = []network.Peer{toB}
+	netB.incoming = []network.Peer{toA}
+
+	// the reconciliations are triggered manually
+	a.Start(make(chan struct{}))
+	b.Start(make(chan struct{}))
+	t.Cleanup(a.Stop)
+	t.Cleanup(b.Stop)
+	return
+}
+
+func TestTxReconciliation(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	a, b, poolA, poolB, toB, toA := makeTestReconcilers(t, time.Millisecond)
+
+	onlyA := make(map[transactions.Txid]bool)
+	onlyB := make(map[transactions.Txid]bool)
+	for i := 0; i < 1000; i++ {
+		txn := makeTestReconciliationTxn(i)
+		switch {
+		case i < 5:
+			poolA.add(txn)
+			onlyA[txn.ID()] = true
+		case i < 12:
+			poolB.add(txn)
+			onlyB[txn.ID()] = true
+		default:
+			poolA.add(txn)
+			poolB.add(txn)
+		}
+	}
+
+	a.reconcile()
+	require.Equal(t, 1, toB.sketches)
+	// B only initiates reconciliations with its outgoing peers
+	b.reconcile()
+	require.Zero(t, toA.sketches)
+
+	// each side received the transactions it was missing
+	require.Len(t, toB.received, len(onlyA))
+	for _, txn := range toB.received {
+		require.True(t, onlyA[txn.ID()])
+	}
+	require.Len(t, toA.received, len(onlyB))
+	for _, txn := range toA.received {
+		require.True(t, onlyB[txn.ID()])
+	}
+
+	// the next sketch is sized after the difference found
+	a.mu.Lock()
+	require.Zero(t, a.sessions[toB].nonce)
+	require.Equal(t, 2*(len(onlyA)+len(onlyB)), a.sessions[toB].difference)
+	a.mu.Unlock()
+
+	// a late reply is ignored
+	reply := txReconciliationMessage{Nonce: 1, Missing: []uint64{txShortID(1, makeTestReconciliationTxn(0).ID())}}
+	a.handle(network.IncomingMessage{Sender: toA, Tag: protocol.TxnReconciliationTag, Data: protocol.Encode(&reply)})
+	require.Len(t, toB.received, len(onlyA))
+}
+
+func TestTxReconciliationFailure(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	a, b, poolA, _, toB, toA := makeTestReconcilers(t, time.Millisecond)
+	for i := 0; i < 200; i++ {
+		poolA.add(makeTestReconciliationTxn(i))
+	}
+
+	// the difference is too large to be decoded from the initial sketch
+	a.reconcile()
+	require.Equal(t, 1, toB.sketches)
+	require.Empty(t, toA.received)
+	require.Empty(t, toB.received)
+	a.mu.Lock()
+	require.Zero(t, a.sessions[toB].nonce)
+	require.Equal(t, 2*txReconciliationMinDifference, a.sessions[toB].difference)
+	a.mu.Unlock()
+
+	// the sketches grow until the difference can be decoded
+	for i := 0; i < 5 && len(toB.received) == 0; i++ {
+		b.mu.Lock()
+		b.sessions[toA].lastResponse = time.Time{}
+		b.mu.Unlock()
+		a.reconcile()
+	}
+	require.Len(t, toB.received, 200)
+
+	// a malformed sketch disconnects the peer
+	msg := txReconciliationMessage{Nonce: 1, Sketch: []byte{1, 2, 3}}
+	out := b.handle(network.IncomingMessage{Sender: toA, Tag: protocol.TxnReconciliationTag, Data: protocol.Encode(&msg)})
+	require.Equal(t, network.Disconnect, out.Action)
+}
+
+func TestTxReconciliationThrottle(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	a, _, poolA, _, toB, _ := makeTestReconcilers(t, time.Hour)
+	poolA.add(makeTestReconciliationTxn(0))
+
+	a.reconcile()
+	require.Len(t, toB.received, 1)
+
+	// the peer answers at most one sketch every half interval
+	a.mu.Lock()
+	a.sessions[toB].nonce = 0
+	a.mu.Unlock()
+	poolA.add(makeTestReconciliationTxn(1))
+	a.reconcile()
+	require.Len(t, toB.received, 1)
+
+	// while the previous reconciliation is outstanding, no new one is initiated
+	a.reconcile()
+	require.Equal(t, 2, toB.sketches)
+}
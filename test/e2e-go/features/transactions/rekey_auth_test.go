@@ -0,0 +1,107 @@
+// Copyright (C) 2019-2025 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package transactions
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/algorand/go-algorand/data/basics"
+	"github.com/algorand/go-algorand/test/framework/fixtures"
+	"github.com/algorand/go-algorand/test/partitiontest"
+)
+
+// TestRekeyAuthAddrSigning checks that transactions of rekeyed accounts are
+// signed with the right wallet key or multisig without giving the signer
+func TestRekeyAuthAddrSigning(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	defer fixtures.ShutdownSynchronizedTest(t)
+
+	t.Parallel()
+	a := require.New(fixtures.SynchronizedTest(t))
+
+	var fixture fixtures.RestClientFixture
+	fixture.Setup(t, filepath.Join("nettemplates", "TwoNodes50Each.json"))
+	defer fixture.Shutdown()
+	client := fixture.LibGoalClient
+
+	accountList, err := fixture.GetWalletsSortedByBalance()
+	a.NoError(err)
+	account := accountList[0].Address
+	addr, err := basics.UnmarshalChecksumAddress(account)
+	a.NoError(err)
+	wh, err := client.GetUnencryptedWalletHandle()
+	a.NoError(err)
+
+	sendAndWait := func(rekeyTo basics.Address) {
+		tx, err := client.MakeUnsignedRekeyTx(account, rekeyTo, 0, 0, 0)
+		a.NoError(err)
+		stx, err := client.SignTransactionWithWalletAndAuthAddr(wh, nil, "", tx)
+		a.NoError(err)
+		txid, err := client.BroadcastTransaction(stx)
+		a.NoError(err)
+		_, err = fixture.WaitForConfirmedTxn(uint64(tx.LastValid), txid)
+		a.NoError(err)
+	}
+
+	// Rekey to another key of the wallet
+	key, err := client.GenerateAddress(wh)
+	a.NoError(err)
+	keyAddr, err := basics.UnmarshalChecksumAddress(key)
+	a.NoError(err)
+	a.NoError(client.CheckWalletCanSign(wh, keyAddr))
+	sendAndWait(keyAddr)
+	authAddr, err := client.AuthAddr(account)
+	a.NoError(err)
+	a.Equal(keyAddr, authAddr)
+
+	// Rekey to a 2-of-3 multisig, of which the wallet holds two keys
+	var pks []string
+	for i := 0; i < 2; i++ {
+		pk, err := client.GenerateAddress(wh)
+		a.NoError(err)
+		pks = append(pks, pk)
+	}
+	other := basics.Address{0x01}
+	pks = append(pks, other.String())
+	msig, err := client.CreateMultisigAccount(wh, 2, pks)
+	a.NoError(err)
+	msigAddr, err := basics.UnmarshalChecksumAddress(msig)
+	a.NoError(err)
+	a.NoError(client.CheckWalletCanSign(wh, msigAddr))
+	sendAndWait(msigAddr)
+	authAddr, err = client.AuthAddr(account)
+	a.NoError(err)
+	a.Equal(msigAddr, authAddr)
+
+	// The wallet cannot sign for an address it holds no key of, nor for a
+	// multisig of which it holds too few keys
+	a.Error(client.CheckWalletCanSign(wh, other))
+	weak, err := client.CreateMultisigAccount(wh, 2, []string{pks[0], other.String()})
+	a.NoError(err)
+	weakAddr, err := basics.UnmarshalChecksumAddress(weak)
+	a.NoError(err)
+	a.Error(client.CheckWalletCanSign(wh, weakAddr))
+
+	// Rekey back to the account itself, signing with the multisig
+	sendAndWait(addr)
+	authAddr, err = client.AuthAddr(account)
+	a.NoError(err)
+	a.Equal(addr, authAddr)
+}
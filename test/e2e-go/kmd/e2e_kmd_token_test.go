@@ -0,0 +1,111 @@
+// Copyright (C) 2019-2025 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package kmdtest
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/algorand/go-algorand/config"
+	"github.com/algorand/go-algorand/crypto"
+	"github.com/algorand/go-algorand/daemon/kmd/client"
+	"github.com/algorand/go-algorand/daemon/kmd/lib/kmdapi"
+	"github.com/algorand/go-algorand/data/basics"
+	"github.com/algorand/go-algorand/data/transactions"
+	"github.com/algorand/go-algorand/protocol"
+	"github.com/algorand/go-algorand/test/framework/fixtures"
+	"github.com/algorand/go-algorand/test/partitiontest"
+)
+
+func TestScopedToken(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	defer fixtures.ShutdownSynchronizedTest(t)
+
+	a := require.New(fixtures.SynchronizedTest(t))
+	t.Parallel()
+	var f fixtures.KMDFixture
+	walletHandleToken := f.SetupWithWallet(t)
+	defer f.Shutdown()
+
+	// Generate a key
+	resp0, err := f.Client.GenerateKey([]byte(walletHandleToken))
+	a.NoError(err)
+
+	// Create a token allowing to list keys and sign
+	wallets, err := f.Client.ListWallets()
+	a.NoError(err)
+	a.Len(wallets.Wallets, 1)
+	walletID := wallets.Wallets[0].ID
+	_, err = f.Client.CreateToken([]byte(walletID), []byte("wrong"), []string{"list", "sign"}, 0)
+	a.Error(err)
+	_, err = f.Client.CreateToken([]byte(walletID), []byte(f.WalletPassword), []string{"everything"}, 0)
+	a.Error(err)
+	resp1, err := f.Client.CreateToken([]byte(walletID), []byte(f.WalletPassword), []string{"list", "sign"}, 3600)
+	a.NoError(err)
+	a.Equal(walletID, resp1.TokenInfo.WalletID)
+	a.Equal([]string{"list", "sign"}, resp1.TokenInfo.Scopes)
+
+	scopedClient, err := client.MakeKMDClient(f.Sock, resp1.Token)
+	a.NoError(err)
+
+	// The scoped token lists the keys without a wallet handle token
+	resp2 := kmdapi.APIV1POSTKeyListResponse{}
+	err = scopedClient.DoV1Request(kmdapi.APIV1POSTKeyListRequest{}, &resp2)
+	a.NoError(err)
+	a.Equal([]string{resp0.Address}, resp2.Addresses)
+
+	// and signs without the wallet password
+	sender, err := basics.UnmarshalChecksumAddress(resp0.Address)
+	a.NoError(err)
+	tx := transactions.Transaction{
+		Type: protocol.PaymentTx,
+		Header: transactions.Header{
+			Sender:     sender,
+			Fee:        basics.MicroAlgos{Raw: config.Consensus[protocol.ConsensusCurrentVersion].MinTxnFee},
+			FirstValid: basics.Round(1),
+			LastValid:  basics.Round(1),
+		},
+	}
+	resp3, err := scopedClient.SignTransaction(nil, nil, crypto.PublicKey{}, tx)
+	a.NoError(err)
+	var stx transactions.SignedTxn
+	a.NoError(protocol.Decode(resp3.SignedTransaction, &stx))
+	a.True(crypto.SignatureVerifier(sender).Verify(tx, stx.Sig))
+
+	// but cannot call the operations outside of its scopes
+	_, err = scopedClient.GenerateKey(nil)
+	a.Error(err)
+	_, err = scopedClient.ExportMasterDerivationKey(nil, []byte(f.WalletPassword))
+	a.Error(err)
+	_, err = scopedClient.CreateToken([]byte(walletID), []byte(f.WalletPassword), []string{"manage"}, 0)
+	a.Error(err)
+	_, err = scopedClient.ListWallets()
+	a.Error(err)
+
+	// The token is listed, and stops working once revoked
+	resp4, err := f.Client.ListTokens()
+	a.NoError(err)
+	a.Equal([]kmdapi.APIV1Token{resp1.TokenInfo}, resp4.Tokens)
+	_, err = f.Client.RevokeToken(resp1.TokenInfo.ID)
+	a.NoError(err)
+	err = scopedClient.DoV1Request(kmdapi.APIV1POSTKeyListRequest{}, &resp2)
+	a.Error(err)
+	resp4, err = f.Client.ListTokens()
+	a.NoError(err)
+	a.Empty(resp4.Tokens)
+}
@@ -0,0 +1,104 @@
+// Copyright (C) 2019-2025 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package kmdtest
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/algorand/go-algorand/crypto"
+	"github.com/algorand/go-algorand/test/framework/fixtures"
+	"github.com/algorand/go-algorand/test/partitiontest"
+)
+
+func TestWalletBackupRestore(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	defer fixtures.ShutdownSynchronizedTest(t)
+
+	a := require.New(fixtures.SynchronizedTest(t))
+	t.Parallel()
+	var f fixtures.KMDFixture
+	walletHandleToken := f.SetupWithWallet(t)
+	defer f.Shutdown()
+
+	// Fill the wallet with a generated key, an imported key, and a multisig address
+	resp0, err := f.Client.GenerateKey([]byte(walletHandleToken))
+	a.NoError(err)
+	secrets := crypto.GenerateSignatureSecrets(crypto.Seed{1})
+	_, err = f.Client.ImportKey([]byte(walletHandleToken), crypto.PrivateKey(secrets.SK))
+	a.NoError(err)
+	pks := []crypto.PublicKey{secrets.SignatureVerifier, crypto.GenerateSignatureSecrets(crypto.Seed{2}).SignatureVerifier}
+	resp1, err := f.Client.ImportMultisigAddr([]byte(walletHandleToken), 1, 2, pks)
+	a.NoError(err)
+	keys, err := f.Client.ListKeys([]byte(walletHandleToken))
+	a.NoError(err)
+	a.Len(keys.Addresses, 2)
+
+	// The backup needs the wallet password and a backup password
+	_, err = f.Client.BackupWallet([]byte(walletHandleToken), []byte("wrong"), []byte("backup"))
+	a.Error(err)
+	_, err = f.Client.BackupWallet([]byte(walletHandleToken), []byte(f.WalletPassword), nil)
+	a.Error(err)
+	resp2, err := f.Client.BackupWallet([]byte(walletHandleToken), []byte(f.WalletPassword), []byte("backup"))
+	a.NoError(err)
+
+	// A wrong password, a tampered backup, or the name of an existing wallet are rejected
+	_, err = f.Client.RestoreWallet([]byte("restored"), []byte("pw"), resp2.Backup, []byte("wrong"))
+	a.Error(err)
+	tampered := append([]byte{}, resp2.Backup...)
+	tampered[len(tampered)-1] ^= 1
+	_, err = f.Client.RestoreWallet([]byte("restored"), []byte("pw"), tampered, []byte("backup"))
+	a.Error(err)
+	_, err = f.Client.RestoreWallet(nil, []byte("pw"), resp2.Backup, []byte("backup"))
+	a.Error(err)
+
+	// The restored wallet gets a new ID and holds the same keys and multisig addresses
+	resp3, err := f.Client.RestoreWallet([]byte("restored"), []byte("pw"), resp2.Backup, []byte("backup"))
+	a.NoError(err)
+	a.Equal("restored", resp3.Wallet.Name)
+	wallets, err := f.Client.ListWallets()
+	a.NoError(err)
+	a.Len(wallets.Wallets, 2)
+	a.NotEqual(wallets.Wallets[0].ID, wallets.Wallets[1].ID)
+
+	resp4, err := f.Client.InitWallet([]byte(resp3.Wallet.ID), []byte("pw"))
+	a.NoError(err)
+	restoredHandle := []byte(resp4.WalletHandleToken)
+	restoredKeys, err := f.Client.ListKeys(restoredHandle)
+	a.NoError(err)
+	a.ElementsMatch(keys.Addresses, restoredKeys.Addresses)
+	msigs, err := f.Client.ListMultisigAddrs(restoredHandle)
+	a.NoError(err)
+	a.Equal([]string{resp1.Address}, msigs.Addresses)
+	resp5, err := f.Client.ExportMultisigAddr(restoredHandle, resp1.Address)
+	a.NoError(err)
+	a.Equal(pks, resp5.PKs)
+
+	// The derivation continues after the restored keys
+	mdk0, err := f.Client.ExportMasterDerivationKey([]byte(walletHandleToken), []byte(f.WalletPassword))
+	a.NoError(err)
+	mdk1, err := f.Client.ExportMasterDerivationKey(restoredHandle, []byte("pw"))
+	a.NoError(err)
+	a.Equal(mdk0.MasterDerivationKey, mdk1.MasterDerivationKey)
+	resp6, err := f.Client.GenerateKey([]byte(walletHandleToken))
+	a.NoError(err)
+	resp7, err := f.Client.GenerateKey(restoredHandle)
+	a.NoError(err)
+	a.Equal(resp6.Address, resp7.Address)
+	a.NotEqual(resp0.Address, resp7.Address)
+}
@@ -0,0 +1,126 @@
+// Copyright (C) 2019-2025 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"os"
+	"os/signal"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/algorand/go-algorand/config"
+	"github.com/algorand/go-algorand/data/bookkeeping"
+	"github.com/algorand/go-algorand/logging"
+	"github.com/algorand/go-algorand/network"
+	"github.com/algorand/go-algorand/network/messagetracer"
+	"github.com/algorand/go-algorand/node"
+	"github.com/algorand/go-algorand/protocol"
+)
+
+var dataDir = flag.String("d", "", "Data directory of the node to replay the capture into")
+var captureFiles = flag.String("capture", "", "Comma-separated list of capture files, replayed in order")
+var speed = flag.Float64("speed", 1, "Replay speed relative to the capture, or 0 to replay as fast as possible")
+var tags = flag.String("tags", "*", "Comma-separated list of tags to replay, or * for all")
+var linger = flag.Duration("linger", 5*time.Second, "Time to keep the node running once the capture is replayed")
+
+func main() {
+	flag.Parse()
+	if *dataDir == "" || *captureFiles == "" {
+		fmt.Fprintln(os.Stderr, "both -d and -capture must be specified")
+		flag.Usage()
+		os.Exit(1)
+	}
+
+	opts := network.ReplayOptions{Speed: *speed}
+	if *tags != "*" {
+		opts.Tags = make(map[protocol.Tag]bool)
+		for _, t := range strings.Split(*tags, ",") {
+			opts.Tags[protocol.Tag(t)] = true
+		}
+	}
+
+	absDataDir, err := filepath.Abs(*dataDir)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "cannot resolve data directory %s: %v\n", *dataDir, err)
+		os.Exit(1)
+	}
+	cfg, err := config.LoadConfigFromDisk(absDataDir)
+	if err != nil && !os.IsNotExist(err) {
+		fmt.Fprintf(os.Stderr, "cannot load config: %v\n", err)
+		os.Exit(1)
+	}
+	// the capture of the replaying node would only contain the replayed messages
+	cfg.EnableNetworkCapture = false
+	genesis, err := bookkeeping.LoadGenesisFromFile(filepath.Join(absDataDir, config.GenesisJSONFile))
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "cannot load genesis: %v\n", err)
+		os.Exit(1)
+	}
+
+	log := logging.Base()
+	log.SetOutput(os.Stderr)
+	log.SetLevel(logging.Level(cfg.BaseLoggerDebugLevel))
+
+	// the node has no peers: the handlers of its network only get the replayed messages
+	sim := network.MakeSimulator(network.SimulatorConfig{Start: time.Now()})
+	net, err := sim.MakeNetwork("netreplay", cfg, genesis.ID(), log)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "cannot create network: %v\n", err)
+		os.Exit(1)
+	}
+	fullNode, err := node.MakeFullWithNetwork(log, absDataDir, cfg, net, nil, genesis)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "cannot create node: %v\n", err)
+		os.Exit(1)
+	}
+	if err = fullNode.Start(); err != nil {
+		fmt.Fprintf(os.Stderr, "cannot start node: %v\n", err)
+		os.Exit(1)
+	}
+	defer fullNode.Stop()
+
+	ctx, cancel := signal.NotifyContext(context.Background(), os.Interrupt)
+	defer cancel()
+
+	total := 0
+	for _, file := range strings.Split(*captureFiles, ",") {
+		f, err := os.Open(file)
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "cannot open capture: %v\n", err)
+			return
+		}
+		count, err := network.ReplayCapture(ctx, messagetracer.MakeCaptureReader(f), net.Multiplexer(), net, opts)
+		f.Close()
+		total += count
+		fmt.Printf("%s: replayed %d messages\n", file, count)
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "%s: %v\n", file, err)
+			return
+		}
+	}
+	fmt.Printf("replayed %d messages\n", total)
+
+	select {
+	case <-time.After(*linger):
+	case <-ctx.Done():
+	}
+	fmt.Printf("last round %d\n", fullNode.Ledger().Latest())
+}
@@ -373,6 +373,17 @@ func (db *Accessor) Vacuum(ctx context.Context) (stats VacuumStats, err error) {
 	return
 }
 
+// VacuumInto writes a compacted, transactionally consistent copy of the database into the given file. The copy is taken
+// within a single read transaction, and therefore can be performed while other connections keep writing to the database.
+// The target file must not exist.
+func (db *Accessor) VacuumInto(ctx context.Context, filename string) error {
+	if db.inMemory {
+		return fmt.Errorf("in-memory database cannot be copied into %s", filename)
+	}
+	_, err := db.Handle.ExecContext(ctx, "VACUUM INTO ?", filename)
+	return err
+}
+
 // URI returns the sqlite URI given a db filename as an input.
 func URI(filename string, readOnly bool, memory bool) string {
 	uri := fmt.Sprintf("file:%s?_busy_timeout=%d&_synchronous=full", filename, busy)
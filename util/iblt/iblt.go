@@ -0,0 +1,208 @@
+// Copyright (C) 2019-2025 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+// Package iblt implements invertible bloom lookup tables of 64-bit keys, which allow two parties
+// to find the symmetric difference of their sets by exchanging a table sized after that difference
+// rather than after the sets themselves.
+package iblt
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+)
+
+// NumHashes is the number of cells each key is inserted into. The cells are partitioned into
+// NumHashes subtables so that the cells of a key are always distinct.
+const NumHashes = 4
+
+// CellSize is the size in bytes of an encoded cell.
+const CellSize = 16
+
+// ErrDecodeFailed is returned when a table holds too many keys to be fully decoded.
+var ErrDecodeFailed = errors.New("iblt: unable to decode the table")
+
+// ErrSizeMismatch is returned when subtracting tables of different sizes.
+var ErrSizeMismatch = errors.New("iblt: tables sizes differ")
+
+type cell struct {
+	count   int32
+	keySum  uint64
+	hashSum uint32
+}
+
+// Table is an invertible bloom lookup table.
+type Table struct {
+	cells []cell
+}
+
+// CellsForDifference returns the number of cells needed for a table to decode
+// a symmetric difference of the given size with high probability.
+func CellsForDifference(difference int) int {
+	// about 1.3 cells per key are enough for large differences with four hashes, while small
+	// differences need some slack to decode reliably: this sizing fails about once in a thousand.
+	return 2*difference + 32
+}
+
+// New creates an empty table of at least the given number of cells.
+func New(cells int) *Table {
+	if cells < NumHashes {
+		cells = NumHashes
+	}
+	// round up to a multiple of the number of subtables
+	cells = (cells + NumHashes - 1) / NumHashes * NumHashes
+	return &Table{cells: make([]cell, cells)}
+}
+
+// Len returns the number of cells in the table.
+func (t *Table) Len() int {
+	return len(t.cells)
+}
+
+// mix is the finalizer of splitmix64, used to derive the cell indices and checksums of keys.
+func mix(x uint64) uint64 {
+	x ^= x >> 30
+	x *= 0xbf58476d1ce4e5b9
+	x ^= x >> 27
+	x *= 0x94d049bb133111eb
+	x ^= x >> 31
+	return x
+}
+
+func checksum(key uint64) uint32 {
+	return uint32(mix(key ^ 0x9e3779b97f4a7c15))
+}
+
+func (t *Table) update(key uint64, delta int32) {
+	subtable := uint64(len(t.cells) / NumHashes)
+	hash := checksum(key)
+	for i := uint64(0); i < NumHashes; i++ {
+		c := &t.cells[i*subtable+mix(key+i)%subtable]
+		c.count += delta
+		c.keySum ^= key
+		c.hashSum ^= hash
+	}
+}
+
+// Insert adds a key to the table.
+func (t *Table) Insert(key uint64) {
+	t.update(key, 1)
+}
+
+// Delete removes a key from the table. The key does not need to have been inserted,
+// which is how a table represents the keys of a set difference.
+func (t *Table) Delete(key uint64) {
+	t.update(key, -1)
+}
+
+// Subtract removes the keys of the other table from this one, leaving the symmetric difference of both.
+func (t *Table) Subtract(other *Table) error {
+	if len(t.cells) != len(other.cells) {
+		return ErrSizeMismatch
+	}
+	for i := range t.cells {
+		t.cells[i].count -= other.cells[i].count
+		t.cells[i].keySum ^= other.cells[i].keySum
+		t.cells[i].hashSum ^= other.cells[i].hashSum
+	}
+	return nil
+}
+
+// pure returns whether a cell holds a single key, inserted or deleted.
+func (c *cell) pure() bool {
+	return (c.count == 1 || c.count == -1) && c.hashSum == checksum(c.keySum)
+}
+
+func (c *cell) empty() bool {
+	return c.count == 0 && c.keySum == 0 && c.hashSum == 0
+}
+
+// Decode lists the keys of the table, which are the inserted keys and the deleted ones.
+// It consumes the table, and returns ErrDecodeFailed along with the keys it could decode
+// if the table holds too many keys for its size, or was not built by inserting and deleting
+// keys: a table sent by a peer may hold a key in fewer cells than it should, which peeling
+// would add back to the table forever.
+func (t *Table) Decode() (inserted []uint64, deleted []uint64, err error) {
+	// Peeling a key empties the pure cell it is peeled from for good, so a table built by
+	// inserting and deleting keys decodes to at most one key per cell, each key once.
+	decoded := make(map[uint64]bool)
+	var pure []int
+	for i := range t.cells {
+		if t.cells[i].pure() {
+			pure = append(pure, i)
+		}
+	}
+	for len(pure) > 0 {
+		i := pure[len(pure)-1]
+		pure = pure[:len(pure)-1]
+		c := t.cells[i]
+		if !c.pure() {
+			// already peeled through another cell of the same key
+			continue
+		}
+		if decoded[c.keySum] || len(decoded) >= len(t.cells) {
+			return inserted, deleted, ErrDecodeFailed
+		}
+		decoded[c.keySum] = true
+		if c.count > 0 {
+			inserted = append(inserted, c.keySum)
+		} else {
+			deleted = append(deleted, c.keySum)
+		}
+		t.update(c.keySum, -c.count)
+
+		subtable := uint64(len(t.cells) / NumHashes)
+		for h := uint64(0); h < NumHashes; h++ {
+			j := int(h*subtable + mix(c.keySum+h)%subtable)
+			if t.cells[j].pure() {
+				pure = append(pure, j)
+			}
+		}
+	}
+	for i := range t.cells {
+		if !t.cells[i].empty() {
+			return inserted, deleted, ErrDecodeFailed
+		}
+	}
+	return inserted, deleted, nil
+}
+
+// MarshalBinary encodes the table.
+func (t *Table) MarshalBinary() ([]byte, error) {
+	data := make([]byte, len(t.cells)*CellSize)
+	for i, c := range t.cells {
+		b := data[i*CellSize:]
+		binary.LittleEndian.PutUint32(b, uint32(c.count))
+		binary.LittleEndian.PutUint64(b[4:], c.keySum)
+		binary.LittleEndian.PutUint32(b[12:], c.hashSum)
+	}
+	return data, nil
+}
+
+// UnmarshalBinary decodes a table encoded by MarshalBinary.
+func (t *Table) UnmarshalBinary(data []byte) error {
+	if len(data) == 0 || len(data)%(CellSize*NumHashes) != 0 {
+		return fmt.Errorf("iblt: invalid encoded table length %d", len(data))
+	}
+	t.cells = make([]cell, len(data)/CellSize)
+	for i := range t.cells {
+		b := data[i*CellSize:]
+		t.cells[i].count = int32(binary.LittleEndian.Uint32(b))
+		t.cells[i].keySum = binary.LittleEndian.Uint64(b[4:])
+		t.cells[i].hashSum = binary.LittleEndian.Uint32(b[12:])
+	}
+	return nil
+}
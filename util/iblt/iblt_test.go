@@ -0,0 +1,125 @@
+// Copyright (C) 2019-2025 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package iblt
+
+import (
+	"math/rand"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/algorand/go-algorand/test/partitiontest"
+)
+
+func TestTableSetDifference(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	// decoding fails with a small probability, so use a fixed seed
+	rng := rand.New(rand.NewSource(1))
+	for _, difference := range []int{0, 1, 10, 100, 1000} {
+		common := make([]uint64, 5000)
+		for i := range common {
+			common[i] = rng.Uint64()
+		}
+		onlyA := make(map[uint64]bool)
+		onlyB := make(map[uint64]bool)
+		for i := 0; i < difference; i++ {
+			if i%3 == 0 {
+				onlyB[rng.Uint64()] = true
+			} else {
+				onlyA[rng.Uint64()] = true
+			}
+		}
+
+		a := New(CellsForDifference(difference))
+		b := New(CellsForDifference(difference))
+		for _, k := range common {
+			a.Insert(k)
+			b.Insert(k)
+		}
+		for k := range onlyA {
+			a.Insert(k)
+		}
+		for k := range onlyB {
+			b.Insert(k)
+		}
+
+		// b is transmitted over the network
+		enc, err := b.MarshalBinary()
+		require.NoError(t, err)
+		require.Len(t, enc, b.Len()*CellSize)
+		var remote Table
+		require.NoError(t, remote.UnmarshalBinary(enc))
+
+		require.NoError(t, a.Subtract(&remote))
+		inserted, deleted, err := a.Decode()
+		require.NoError(t, err, "difference %d", difference)
+		require.Len(t, inserted, len(onlyA))
+		require.Len(t, deleted, len(onlyB))
+		for _, k := range inserted {
+			require.True(t, onlyA[k])
+		}
+		for _, k := range deleted {
+			require.True(t, onlyB[k])
+		}
+	}
+}
+
+func TestTableDecodeFailure(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	table := New(CellsForDifference(10))
+	for i := 0; i < 1000; i++ {
+		table.Insert(rand.Uint64())
+	}
+	_, _, err := table.Decode()
+	require.ErrorIs(t, err, ErrDecodeFailed)
+
+	require.ErrorIs(t, New(30).Subtract(New(60)), ErrSizeMismatch)
+}
+
+func TestTableDecodeMalformed(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	// A key held by a single cell is peeled into its other cells, from which
+	// it is peeled back into the first one: decoding must not loop forever.
+	table := New(CellsForDifference(10))
+	key := uint64(42)
+	table.cells[mix(key)%uint64(table.Len()/NumHashes)] = cell{count: 1, keySum: key, hashSum: checksum(key)}
+	done := make(chan error, 1)
+	go func() {
+		_, _, err := table.Decode()
+		done <- err
+	}()
+	select {
+	case err := <-done:
+		require.ErrorIs(t, err, ErrDecodeFailed)
+	case <-time.After(10 * time.Second):
+		require.Fail(t, "decoding a malformed table does not end")
+	}
+}
+
+func TestTableUnmarshalInvalid(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	var table Table
+	require.Error(t, table.UnmarshalBinary(nil))
+	require.Error(t, table.UnmarshalBinary(make([]byte, CellSize)))
+	require.NoError(t, table.UnmarshalBinary(make([]byte, CellSize*NumHashes)))
+	require.Equal(t, NumHashes, table.Len())
+}
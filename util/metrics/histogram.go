@@ -0,0 +1,216 @@
+// Copyright (C) 2019-2025 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package metrics
+
+import (
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/algorand/go-deadlock"
+)
+
+// DurationBuckets are histogram buckets suitable for durations measured in seconds,
+// ranging from 100µs to about 52 seconds.
+var DurationBuckets = ExponentialBuckets(0.0001, 2, 20)
+
+// SizeBuckets are histogram buckets suitable for counts and sizes, ranging from 1 to about a million.
+var SizeBuckets = ExponentialBuckets(1, 4, 11)
+
+// ExponentialBuckets returns count histogram bucket upper bounds, the first of which is start,
+// and each of the following is factor times the previous one.
+func ExponentialBuckets(start, factor float64, count int) []float64 {
+	buckets := make([]float64, count)
+	for i := range buckets {
+		buckets[i] = start
+		start *= factor
+	}
+	return buckets
+}
+
+// Histogram represents a single histogram variable, which samples observations into buckets.
+type Histogram struct {
+	deadlock.Mutex
+	name        string
+	description string
+	buckets     []float64
+	values      []*histogramValues
+	// valuesIndices maps the formatted labels of each set of values to its index within values.
+	valuesIndices map[string]int
+}
+
+type histogramValues struct {
+	// counts holds the number of observations that fell into each of the buckets, followed by
+	// the number of observations above the last bucket upper bound.
+	counts          []uint64
+	sum             float64
+	count           uint64
+	formattedLabels string
+}
+
+// MakeHistogram creates a new histogram with the provided name, description and bucket upper bounds.
+func MakeHistogram(metric MetricName, buckets []float64) *Histogram {
+	h := makeHistogram(metric, buckets)
+	h.Register(nil)
+	return h
+}
+
+// makeHistogram creates a new histogram with the provided name, description and bucket upper bounds
+// but does not register it with the default registry.
+func makeHistogram(metric MetricName, buckets []float64) *Histogram {
+	buckets = append([]float64(nil), buckets...)
+	sort.Float64s(buckets)
+	return &Histogram{
+		name:          metric.Name,
+		description:   metric.Description,
+		buckets:       buckets,
+		valuesIndices: make(map[string]int),
+	}
+}
+
+// Register registers the histogram with the default/specific registry
+func (h *Histogram) Register(reg *Registry) {
+	if reg == nil {
+		DefaultRegistry().Register(h)
+	} else {
+		reg.Register(h)
+	}
+}
+
+// Deregister deregisters the histogram with the default/specific registry
+func (h *Histogram) Deregister(reg *Registry) {
+	if reg == nil {
+		DefaultRegistry().Deregister(h)
+	} else {
+		reg.Deregister(h)
+	}
+}
+
+// Observe adds a single observation of x with the given labels
+func (h *Histogram) Observe(x float64, labels map[string]string) {
+	formattedLabels := formatHistogramLabels(labels)
+	bucket := sort.SearchFloat64s(h.buckets, x)
+
+	h.Lock()
+	defer h.Unlock()
+	idx, has := h.valuesIndices[formattedLabels]
+	if !has {
+		h.values = append(h.values, &histogramValues{
+			counts:          make([]uint64, len(h.buckets)+1),
+			formattedLabels: formattedLabels,
+		})
+		idx = len(h.values) - 1
+		h.valuesIndices[formattedLabels] = idx
+	}
+	v := h.values[idx]
+	v.counts[bucket]++
+	v.sum += x
+	v.count++
+}
+
+// ObserveSecondsSince adds a single observation of the number of seconds elapsed since t
+func (h *Histogram) ObserveSecondsSince(t time.Time, labels map[string]string) {
+	h.Observe(time.Since(t).Seconds(), labels)
+}
+
+// formatHistogramLabels formats the labels in a deterministic order, so that the same set
+// of labels always maps to the same histogram values.
+func formatHistogramLabels(labels map[string]string) string {
+	if len(labels) == 0 {
+		return ""
+	}
+	keys := make([]string, 0, len(labels))
+	for k := range labels {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	var buf strings.Builder
+	for i, k := range keys {
+		if i > 0 {
+			buf.WriteString(",")
+		}
+		buf.WriteString(k + "=\"" + labels[k] + "\"")
+	}
+	return buf.String()
+}
+
+func writeHistogramLine(buf *strings.Builder, name string, labels []string, value string) {
+	buf.WriteString(name)
+	first := true
+	for _, l := range labels {
+		if len(l) == 0 {
+			continue
+		}
+		if first {
+			buf.WriteString("{")
+			first = false
+		} else {
+			buf.WriteString(",")
+		}
+		buf.WriteString(l)
+	}
+	if !first {
+		buf.WriteString("}")
+	}
+	buf.WriteString(" " + value + "\n")
+}
+
+// WriteMetric writes the metric into the output stream
+func (h *Histogram) WriteMetric(buf *strings.Builder, parentLabels string) {
+	h.Lock()
+	defer h.Unlock()
+
+	buf.WriteString("# HELP ")
+	buf.WriteString(h.name)
+	buf.WriteString(" ")
+	buf.WriteString(h.description)
+	buf.WriteString("\n# TYPE ")
+	buf.WriteString(h.name)
+	buf.WriteString(" histogram\n")
+	for _, v := range h.values {
+		var cumulative uint64
+		for i, count := range v.counts {
+			cumulative += count
+			le := "+Inf"
+			if i < len(h.buckets) {
+				le = strconv.FormatFloat(h.buckets[i], 'g', -1, 64)
+			}
+			writeHistogramLine(buf, h.name+"_bucket", []string{parentLabels, v.formattedLabels, "le=\"" + le + "\""}, strconv.FormatUint(cumulative, 10))
+		}
+		writeHistogramLine(buf, h.name+"_sum", []string{parentLabels, v.formattedLabels}, strconv.FormatFloat(v.sum, 'g', -1, 64))
+		writeHistogramLine(buf, h.name+"_count", []string{parentLabels, v.formattedLabels}, strconv.FormatUint(v.count, 10))
+	}
+}
+
+// AddMetric adds the metric into the map. Only the sum and the count of observations are reported,
+// along with their average.
+func (h *Histogram) AddMetric(values map[string]float64) {
+	h.Lock()
+	defer h.Unlock()
+
+	for _, v := range h.values {
+		var suffix string
+		if len(v.formattedLabels) > 0 {
+			suffix = ":" + v.formattedLabels
+		}
+		values[sanitizeTelemetryName(h.name+"_sum"+suffix)] = v.sum
+		values[sanitizeTelemetryName(h.name+"_count"+suffix)] = float64(v.count)
+		// values are only created by Observe, hence count is never zero
+		values[sanitizeTelemetryName(h.name+"_avg"+suffix)] = v.sum / float64(v.count)
+	}
+}
@@ -0,0 +1,76 @@
+// Copyright (C) 2019-2025 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package metrics
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/algorand/go-algorand/test/partitiontest"
+)
+
+func TestHistogramWriteMetric(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	h := makeHistogram(MetricName{Name: "histogram_test", Description: "this is the metric test for histogram object"}, []float64{10, 1, 100})
+	h.Observe(0.5, map[string]string{"tracker": "a"})
+	h.Observe(5, map[string]string{"tracker": "a"})
+	h.Observe(1000, map[string]string{"tracker": "a"})
+	h.Observe(50, map[string]string{"tracker": "b", "phase": "x"})
+
+	var buf strings.Builder
+	h.WriteMetric(&buf, `host="h"`)
+	expected := `# HELP histogram_test this is the metric test for histogram object
+# TYPE histogram_test histogram
+histogram_test_bucket{host="h",tracker="a",le="1"} 1
+histogram_test_bucket{host="h",tracker="a",le="10"} 2
+histogram_test_bucket{host="h",tracker="a",le="100"} 2
+histogram_test_bucket{host="h",tracker="a",le="+Inf"} 3
+histogram_test_sum{host="h",tracker="a"} 1005.5
+histogram_test_count{host="h",tracker="a"} 3
+histogram_test_bucket{host="h",phase="x",tracker="b",le="1"} 0
+histogram_test_bucket{host="h",phase="x",tracker="b",le="10"} 0
+histogram_test_bucket{host="h",phase="x",tracker="b",le="100"} 1
+histogram_test_bucket{host="h",phase="x",tracker="b",le="+Inf"} 1
+histogram_test_sum{host="h",phase="x",tracker="b"} 50
+histogram_test_count{host="h",phase="x",tracker="b"} 1
+`
+	require.Equal(t, expected, buf.String())
+
+	// an observation equal to a bucket upper bound falls into that bucket
+	h = makeHistogram(MetricName{Name: "histogram_test", Description: "test"}, []float64{1, 2})
+	h.Observe(2, nil)
+	buf.Reset()
+	h.WriteMetric(&buf, "")
+	require.Contains(t, buf.String(), "histogram_test_bucket{le=\"1\"} 0\nhistogram_test_bucket{le=\"2\"} 1\n")
+	require.Contains(t, buf.String(), "histogram_test_count 1\n")
+
+	values := make(map[string]float64)
+	h.AddMetric(values)
+	require.Equal(t, map[string]float64{"histogram_test_sum": 2, "histogram_test_count": 1, "histogram_test_avg": 2}, values)
+}
+
+func TestExponentialBuckets(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	require.Equal(t, []float64{1, 4, 16, 64}, ExponentialBuckets(1, 4, 4))
+	require.Len(t, DurationBuckets, 20)
+}
@@ -91,6 +91,18 @@ var (
 	LedgerRound = MetricName{Name: "algod_ledger_round", Description: "Last round written to ledger"}
 	// LedgerDBRound Last round written to ledger
 	LedgerDBRound = MetricName{Name: "algod_ledger_dbround", Description: "Last round written to the ledger DB"}
+	// LedgerTrackerPrepareCommitSeconds Time spent by each of the ledger trackers preparing a commit
+	LedgerTrackerPrepareCommitSeconds = MetricName{Name: "algod_ledger_tracker_prepare_commit_seconds", Description: "Time spent by each of the ledger trackers preparing a commit"}
+	// LedgerTrackerCommitRoundSeconds Time spent by each of the ledger trackers writing a commit to the ledger DB
+	LedgerTrackerCommitRoundSeconds = MetricName{Name: "algod_ledger_tracker_commit_round_seconds", Description: "Time spent by each of the ledger trackers writing a commit to the ledger DB"}
+	// LedgerTrackerPostCommitSeconds Time spent by each of the ledger trackers after a commit, while holding the trackers lock
+	LedgerTrackerPostCommitSeconds = MetricName{Name: "algod_ledger_tracker_post_commit_seconds", Description: "Time spent by each of the ledger trackers after a commit, while holding the trackers lock"}
+	// LedgerTrackerPostCommitUnlockedSeconds Time spent by each of the ledger trackers after a commit, without holding the trackers lock
+	LedgerTrackerPostCommitUnlockedSeconds = MetricName{Name: "algod_ledger_tracker_post_commit_unlocked_seconds", Description: "Time spent by each of the ledger trackers after a commit, without holding the trackers lock"}
+	// LedgerTrackerCommitRows Number of ledger DB rows written by each of the ledger trackers in a commit
+	LedgerTrackerCommitRows = MetricName{Name: "algod_ledger_tracker_commit_rows", Description: "Number of ledger DB rows written by each of the ledger trackers in a commit"}
+	// LedgerDeferredCommitsQueueDepth Number of deferred commits pending in the tracker commit queue
+	LedgerDeferredCommitsQueueDepth = MetricName{Name: "algod_ledger_deferred_commits_queue_depth", Description: "Number of deferred commits pending in the tracker commit queue"}
 
 	// AgreementMessagesHandled "Number of agreement messages handled"
 	AgreementMessagesHandled = MetricName{Name: "algod_agreement_handled", Description: "Number of agreement messages handled"}
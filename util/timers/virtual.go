@@ -0,0 +1,174 @@
+// Copyright (C) 2019-2025 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package timers
+
+import (
+	"container/heap"
+	"sync"
+	"time"
+
+	"github.com/algorand/go-algorand/protocol"
+)
+
+// VirtualTime is a time source which only moves forward when it is explicitly advanced.
+// It is shared by all the Virtual clocks of a simulation.
+type VirtualTime struct {
+	mu      sync.Mutex
+	now     time.Time
+	waiters virtualWaiters
+	seq     uint64
+}
+
+type virtualWaiter struct {
+	deadline time.Time
+	seq      uint64
+	ch       chan time.Time
+}
+
+// virtualWaiters is a min-heap of waiters ordered by deadline, and by registration order for equal deadlines.
+type virtualWaiters []virtualWaiter
+
+func (w virtualWaiters) Len() int { return len(w) }
+func (w virtualWaiters) Less(i, j int) bool {
+	if w[i].deadline.Equal(w[j].deadline) {
+		return w[i].seq < w[j].seq
+	}
+	return w[i].deadline.Before(w[j].deadline)
+}
+func (w virtualWaiters) Swap(i, j int) { w[i], w[j] = w[j], w[i] }
+func (w *virtualWaiters) Push(x any)   { *w = append(*w, x.(virtualWaiter)) }
+func (w *virtualWaiters) Pop() any {
+	old := *w
+	n := len(old)
+	x := old[n-1]
+	*w = old[:n-1]
+	return x
+}
+
+// MakeVirtualTime creates a new virtual time source starting at the given time.
+func MakeVirtualTime(start time.Time) *VirtualTime {
+	return &VirtualTime{now: start}
+}
+
+// Now returns the current virtual time.
+func (v *VirtualTime) Now() time.Time {
+	v.mu.Lock()
+	defer v.mu.Unlock()
+	return v.now
+}
+
+// After returns a channel that fires once the virtual time has advanced by d.
+func (v *VirtualTime) After(d time.Duration) <-chan time.Time {
+	v.mu.Lock()
+	defer v.mu.Unlock()
+	return v.at(v.now.Add(d))
+}
+
+func (v *VirtualTime) at(deadline time.Time) <-chan time.Time {
+	ch := make(chan time.Time, 1)
+	if !deadline.After(v.now) {
+		ch <- v.now
+		return ch
+	}
+	v.seq++
+	heap.Push(&v.waiters, virtualWaiter{deadline: deadline, seq: v.seq, ch: ch})
+	return ch
+}
+
+// NextDeadline returns the earliest pending deadline, if any.
+func (v *VirtualTime) NextDeadline() (time.Time, bool) {
+	v.mu.Lock()
+	defer v.mu.Unlock()
+	if len(v.waiters) == 0 {
+		return time.Time{}, false
+	}
+	return v.waiters[0].deadline, true
+}
+
+// AdvanceTo moves the virtual time forward to t, firing all the channels whose deadline
+// has been reached in deadline order. Moving the time backwards has no effect.
+func (v *VirtualTime) AdvanceTo(t time.Time) {
+	v.mu.Lock()
+	defer v.mu.Unlock()
+	for len(v.waiters) > 0 && !v.waiters[0].deadline.After(t) {
+		w := heap.Pop(&v.waiters).(virtualWaiter)
+		v.now = w.deadline
+		w.ch <- w.deadline
+	}
+	if t.After(v.now) {
+		v.now = t
+	}
+}
+
+// Virtual is a clock driven by a VirtualTime rather than the system clock.
+type Virtual[TimeoutType comparable] struct {
+	source   *VirtualTime
+	zero     time.Time
+	timeouts map[TimeoutType]timeout
+}
+
+// MakeVirtualClock creates a new virtual clock zeroed at the current time of the source.
+func MakeVirtualClock[TimeoutType comparable](source *VirtualTime) Clock[TimeoutType] {
+	return &Virtual[TimeoutType]{
+		source: source,
+		zero:   source.Now(),
+	}
+}
+
+// Zero returns a new Clock reset to the current virtual time.
+func (m *Virtual[TimeoutType]) Zero() Clock[TimeoutType] {
+	return MakeVirtualClock[TimeoutType](m.source)
+}
+
+// TimeoutAt returns a channel that will signal when the duration has elapsed in virtual time.
+func (m *Virtual[TimeoutType]) TimeoutAt(delta time.Duration, timeoutType TimeoutType) <-chan time.Time {
+	if m.timeouts == nil {
+		m.timeouts = make(map[TimeoutType]timeout)
+	}
+
+	tmt, ok := m.timeouts[timeoutType]
+	if ok && tmt.delta == delta {
+		return tmt.ch
+	}
+
+	m.source.mu.Lock()
+	tmt = timeout{delta: delta, ch: m.source.at(m.zero.Add(delta))}
+	m.source.mu.Unlock()
+	m.timeouts[timeoutType] = tmt
+	return tmt.ch
+}
+
+// Encode implements Clock.Encode.
+func (m *Virtual[TimeoutType]) Encode() []byte {
+	return protocol.EncodeReflect(m.zero)
+}
+
+// Decode implements Clock.Decode.
+func (m *Virtual[TimeoutType]) Decode(data []byte) (Clock[TimeoutType], error) {
+	var zero time.Time
+	err := protocol.DecodeReflect(data, &zero)
+	return &Virtual[TimeoutType]{source: m.source, zero: zero}, err
+}
+
+func (m *Virtual[TimeoutType]) String() string {
+	return m.zero.String()
+}
+
+// Since returns the virtual time that has passed since the clock was last zeroed out.
+func (m *Virtual[TimeoutType]) Since() time.Duration {
+	return m.source.Now().Sub(m.zero)
+}
@@ -0,0 +1,86 @@
+// Copyright (C) 2019-2025 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package timers
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/algorand/go-algorand/test/partitiontest"
+)
+
+func TestVirtualTimeout(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	start := time.Unix(1000, 0)
+	source := MakeVirtualTime(start)
+	c := MakeVirtualClock[int](source)
+
+	d := 100 * time.Millisecond
+	ch := c.TimeoutAt(d, 0)
+	require.Equal(t, ch, c.TimeoutAt(d, 0))
+	other := c.TimeoutAt(2*d, 1)
+	require.False(t, polled(ch))
+
+	deadline, ok := source.NextDeadline()
+	require.True(t, ok)
+	require.Equal(t, start.Add(d), deadline)
+
+	source.AdvanceTo(start.Add(d - 1))
+	require.False(t, polled(ch))
+	source.AdvanceTo(start.Add(d))
+	require.True(t, polled(ch))
+	require.False(t, polled(other))
+	require.Equal(t, d, c.Since())
+
+	// an elapsed timeout fires immediately
+	require.True(t, polled(c.TimeoutAt(d/2, 2)))
+
+	// zeroing moves the reference point to the current virtual time
+	z := c.Zero()
+	require.Zero(t, z.Since())
+	ch = z.TimeoutAt(d, 0)
+	source.AdvanceTo(start.Add(3 * d / 2))
+	require.False(t, polled(ch))
+	require.False(t, polled(other))
+	source.AdvanceTo(start.Add(2 * d))
+	require.True(t, polled(ch))
+	require.True(t, polled(other))
+
+	// time never goes backwards
+	source.AdvanceTo(start)
+	require.Equal(t, start.Add(2*d), source.Now())
+}
+
+func TestVirtualEncodeDecode(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	start := time.Unix(1000, 0)
+	source := MakeVirtualTime(start)
+	c := MakeVirtualClock[int](source)
+	source.AdvanceTo(start.Add(time.Second))
+
+	c2, err := c.Decode(c.Encode())
+	require.NoError(t, err)
+	require.Equal(t, time.Second, c2.Since())
+
+	ch := c2.TimeoutAt(2*time.Second, 0)
+	source.AdvanceTo(start.Add(2 * time.Second))
+	require.True(t, polled(ch))
+}
@@ -0,0 +1,153 @@
+// Copyright (C) 2019-2025 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+// Package tracing exports OpenTelemetry spans of the processing of the node to an OTLP collector.
+// Spans are only recorded once Setup has been called, and starting a span is otherwise nearly free.
+package tracing
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync/atomic"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracehttp"
+	"go.opentelemetry.io/otel/sdk/resource"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/trace"
+	"go.opentelemetry.io/otel/trace/noop"
+
+	"github.com/algorand/go-algorand/config"
+)
+
+const instrumentationName = "github.com/algorand/go-algorand"
+
+// current is the tracer of the exported spans, or nil when tracing is not set up.
+var current atomic.Pointer[tracerRef]
+
+type tracerRef struct {
+	trace.Tracer
+}
+
+// Enabled returns whether the spans are exported. Callers may check it to avoid
+// computing the attributes of a span on hot paths.
+func Enabled() bool {
+	return current.Load() != nil
+}
+
+// Setup exports the spans of the node to the OTLP/HTTP collector configured by cfg.TracingEndpoint.
+// The returned function flushes the pending spans and stops the export.
+func Setup(cfg config.Local, attrs ...attribute.KeyValue) (func(context.Context) error, error) {
+	if Enabled() {
+		return nil, errors.New("tracing is already set up")
+	}
+	exporter, err := otlptracehttp.New(context.Background(), otlptracehttp.WithEndpoint(cfg.TracingEndpoint), otlptracehttp.WithInsecure())
+	if err != nil {
+		return nil, fmt.Errorf("unable to create the OTLP exporter: %w", err)
+	}
+	return SetupWithProcessor(sdktrace.NewBatchSpanProcessor(exporter), cfg.TracingSamplingPercent, attrs...)
+}
+
+// SetupWithProcessor records the spans of the node with processor, e.g. an in-memory recorder in tests.
+// The returned function ends the recording and shuts the processor down.
+func SetupWithProcessor(processor sdktrace.SpanProcessor, samplingPercent uint64, attrs ...attribute.KeyValue) (func(context.Context) error, error) {
+	if Enabled() {
+		return nil, errors.New("tracing is already set up")
+	}
+	res := resource.NewSchemaless(append([]attribute.KeyValue{attribute.String("service.name", "algod")}, attrs...)...)
+	sampler := sdktrace.ParentBased(sdktrace.TraceIDRatioBased(float64(samplingPercent) / 100))
+	provider := sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(processor), sdktrace.WithResource(res), sdktrace.WithSampler(sampler))
+	otel.SetTracerProvider(provider)
+	current.Store(&tracerRef{provider.Tracer(instrumentationName)})
+
+	return func(ctx context.Context) error {
+		current.Store(nil)
+		otel.SetTracerProvider(noop.NewTracerProvider())
+		return provider.Shutdown(ctx)
+	}, nil
+}
+
+// Start starts a span, child of the span of ctx if any.
+func Start(ctx context.Context, name string, attrs ...attribute.KeyValue) (context.Context, trace.Span) {
+	t := current.Load()
+	if t == nil {
+		return ctx, trace.SpanFromContext(ctx)
+	}
+	return t.Start(ctx, name, trace.WithAttributes(attrs...))
+}
+
+// StartWithParent starts a span, child of the given span. The parent is typically the span of
+// the reception of a message, which is handled after the context of the reception is gone.
+func StartWithParent(parent trace.SpanContext, name string, attrs ...attribute.KeyValue) (context.Context, trace.Span) {
+	return Start(trace.ContextWithSpanContext(context.Background(), parent), name, attrs...)
+}
+
+// End records err, if not nil, as the error of span and ends it.
+func End(span trace.Span, err error) {
+	if err != nil && span.IsRecording() {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+	}
+	span.End()
+}
+
+// SpanContextSize is the size of a span context encoded by EncodeSpanContext.
+const SpanContextSize = 1 + 16 + 8 + 1
+
+const spanContextVersion = 0
+
+// EncodeSpanContext encodes the span context of ctx as the binary form of a W3C traceparent:
+// a version byte, the trace id, the span id and the trace flags. A context without a valid span is encoded as zeroes.
+func EncodeSpanContext(ctx context.Context) (out [SpanContextSize]byte) {
+	sc := trace.SpanContextFromContext(ctx)
+	if !sc.IsValid() {
+		return
+	}
+	out[0] = spanContextVersion
+	traceID := sc.TraceID()
+	spanID := sc.SpanID()
+	copy(out[1:17], traceID[:])
+	copy(out[17:25], spanID[:])
+	out[25] = byte(sc.TraceFlags())
+	return
+}
+
+// DecodeSpanContext decodes a span context encoded by EncodeSpanContext. The span context
+// returned for zeroes is not valid.
+func DecodeSpanContext(b []byte) (trace.SpanContext, error) {
+	if len(b) != SpanContextSize {
+		return trace.SpanContext{}, fmt.Errorf("invalid span context length %d", len(b))
+	}
+	if b[0] != spanContextVersion {
+		return trace.SpanContext{}, fmt.Errorf("unsupported span context version %d", b[0])
+	}
+	var traceID trace.TraceID
+	var spanID trace.SpanID
+	copy(traceID[:], b[1:17])
+	copy(spanID[:], b[17:25])
+	if !traceID.IsValid() || !spanID.IsValid() {
+		return trace.SpanContext{}, nil
+	}
+	return trace.NewSpanContext(trace.SpanContextConfig{
+		TraceID:    traceID,
+		SpanID:     spanID,
+		TraceFlags: trace.TraceFlags(b[25]),
+		Remote:     true,
+	}), nil
+}
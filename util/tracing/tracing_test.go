@@ -0,0 +1,150 @@
+// Copyright (C) 2019-2025 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package tracing
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+	"go.opentelemetry.io/otel/trace"
+
+	"github.com/algorand/go-algorand/config"
+	"github.com/algorand/go-algorand/test/partitiontest"
+)
+
+func TestSpanContextEncoding(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	sc := trace.NewSpanContext(trace.SpanContextConfig{
+		TraceID:    trace.TraceID{1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 15, 16},
+		SpanID:     trace.SpanID{1, 2, 3, 4, 5, 6, 7, 8},
+		TraceFlags: trace.FlagsSampled,
+	})
+	encoded := EncodeSpanContext(trace.ContextWithSpanContext(context.Background(), sc))
+	decoded, err := DecodeSpanContext(encoded[:])
+	require.NoError(t, err)
+	require.True(t, decoded.IsRemote())
+	require.Equal(t, sc.TraceID(), decoded.TraceID())
+	require.Equal(t, sc.SpanID(), decoded.SpanID())
+	require.True(t, decoded.IsSampled())
+
+	// no span
+	encoded = EncodeSpanContext(context.Background())
+	require.Equal(t, [SpanContextSize]byte{}, encoded)
+	decoded, err = DecodeSpanContext(encoded[:])
+	require.NoError(t, err)
+	require.False(t, decoded.IsValid())
+
+	_, err = DecodeSpanContext(encoded[1:])
+	require.Error(t, err)
+	encoded[0] = 1
+	_, err = DecodeSpanContext(encoded[:])
+	require.Error(t, err)
+}
+
+func TestSetupWithProcessor(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	// spans are not recorded until tracing is set up
+	require.False(t, Enabled())
+	ctx, span := Start(context.Background(), "ignored")
+	require.False(t, span.IsRecording())
+	require.Equal(t, context.Background(), ctx)
+
+	recorder := tracetest.NewSpanRecorder()
+	shutdown, err := SetupWithProcessor(recorder, 100, attribute.String("algorand.node", "test"))
+	require.NoError(t, err)
+	require.True(t, Enabled())
+	_, err = SetupWithProcessor(recorder, 100)
+	require.Error(t, err)
+
+	ctx, parent := Start(context.Background(), "parent", attribute.Int("algorand.size", 1))
+	_, child := Start(ctx, "child")
+	End(child, errors.New("failed"))
+	_, sibling := StartWithParent(parent.SpanContext(), "sibling")
+	End(sibling, nil)
+	parent.End()
+
+	require.NoError(t, shutdown(context.Background()))
+	require.False(t, Enabled())
+	_, span = Start(context.Background(), "ignored")
+	require.False(t, span.IsRecording())
+
+	spans := recorder.Ended()
+	require.Len(t, spans, 3)
+	require.Equal(t, "child", spans[0].Name())
+	require.Equal(t, parent.SpanContext().SpanID(), spans[0].Parent().SpanID())
+	require.Equal(t, codes.Error, spans[0].Status().Code)
+	require.Equal(t, "sibling", spans[1].Name())
+	require.Equal(t, parent.SpanContext().SpanID(), spans[1].Parent().SpanID())
+	require.Equal(t, codes.Unset, spans[1].Status().Code)
+	require.Equal(t, "parent", spans[2].Name())
+	require.Equal(t, []attribute.KeyValue{attribute.Int("algorand.size", 1)}, spans[2].Attributes())
+	require.Contains(t, spans[2].Resource().Attributes(), attribute.String("algorand.node", "test"))
+}
+
+func TestSetupSampling(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	recorder := tracetest.NewSpanRecorder()
+	shutdown, err := SetupWithProcessor(recorder, 0)
+	require.NoError(t, err)
+	defer shutdown(context.Background())
+
+	// the node does not sample its own traces, but follows the decision of its peers
+	_, span := Start(context.Background(), "local")
+	require.False(t, span.IsRecording())
+	remote := trace.NewSpanContext(trace.SpanContextConfig{
+		TraceID:    trace.TraceID{1},
+		SpanID:     trace.SpanID{1},
+		TraceFlags: trace.FlagsSampled,
+		Remote:     true,
+	})
+	_, span = Start(trace.ContextWithRemoteSpanContext(context.Background(), remote), "remote")
+	require.True(t, span.IsRecording())
+	require.Equal(t, remote.TraceID(), span.SpanContext().TraceID())
+}
+
+func TestSetupOTLP(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	var exports atomic.Int32
+	collector := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/v1/traces" {
+			exports.Add(1)
+		}
+		w.Header().Set("Content-Type", "application/x-protobuf")
+	}))
+	defer collector.Close()
+
+	cfg := config.GetDefaultLocal()
+	cfg.TracingEndpoint = collector.Listener.Addr().String()
+	shutdown, err := Setup(cfg)
+	require.NoError(t, err)
+	_, span := Start(context.Background(), "exported")
+	span.End()
+	require.NoError(t, shutdown(context.Background()))
+	require.Equal(t, int32(1), exports.Load())
+}